@@ -0,0 +1,6 @@
+package v11
+
+const (
+	// UpgradeName is the shared upgrade plan name for mainnet
+	UpgradeName = "v11.0.0"
+)
@@ -0,0 +1,53 @@
+package v11
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/module"
+	authkeeper "github.com/cosmos/cosmos-sdk/x/auth/keeper"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	upgradetypes "github.com/cosmos/cosmos-sdk/x/upgrade/types"
+
+	revenuetypes "github.com/evmos/evmos/v10/x/revenue/types"
+)
+
+// CreateUpgradeHandler creates an SDK upgrade handler for v11
+func CreateUpgradeHandler(
+	mm *module.Manager,
+	configurator module.Configurator,
+	ak authkeeper.AccountKeeper,
+) upgradetypes.UpgradeHandler {
+	return func(ctx sdk.Context, _ upgradetypes.Plan, vm module.VersionMap) (module.VersionMap, error) {
+		logger := ctx.Logger().With("upgrade", UpgradeName)
+
+		logger.Debug("creating the revenue module account ...")
+		CreateRevenueModuleAccount(ctx, ak)
+
+		// The nft module is not in the version map, so that its InitGenesis
+		// sets up the new store with the default genesis state.
+		logger.Debug("running module migrations ...")
+		return mm.RunMigrations(ctx, configurator, vm)
+	}
+}
+
+// CreateRevenueModuleAccount creates the module account that escrows the
+// developer revenue. An account that already exists at the module address,
+// e.g. one created by a transfer to that address, is converted into the module
+// account. Its account number and sequence are kept, as well as its balance,
+// which is held by the address.
+func CreateRevenueModuleAccount(ctx sdk.Context, ak authkeeper.AccountKeeper) {
+	addr := authtypes.NewModuleAddress(revenuetypes.ModuleName)
+
+	acc := ak.GetAccount(ctx, addr)
+	if acc == nil {
+		// creates the module account with the permissions of the app
+		ak.GetModuleAccount(ctx, revenuetypes.ModuleName)
+		return
+	}
+
+	if _, ok := acc.(authtypes.ModuleAccountI); ok {
+		return
+	}
+
+	baseAcc := authtypes.NewBaseAccount(addr, nil, acc.GetAccountNumber(), acc.GetSequence())
+	ak.SetModuleAccount(ctx, authtypes.NewModuleAccount(baseAcc, revenuetypes.ModuleName))
+}
@@ -0,0 +1,167 @@
+package v11_test
+
+import (
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/suite"
+
+	"github.com/tendermint/tendermint/crypto/tmhash"
+	tmproto "github.com/tendermint/tendermint/proto/tendermint/types"
+	tmversion "github.com/tendermint/tendermint/proto/tendermint/version"
+	"github.com/tendermint/tendermint/version"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+
+	"github.com/evmos/ethermint/crypto/ethsecp256k1"
+	"github.com/evmos/ethermint/tests"
+	feemarkettypes "github.com/evmos/ethermint/x/feemarket/types"
+
+	"github.com/evmos/evmos/v10/app"
+	v11 "github.com/evmos/evmos/v10/app/upgrades/v11"
+	"github.com/evmos/evmos/v10/testutil"
+	revenuetypes "github.com/evmos/evmos/v10/x/revenue/types"
+)
+
+type UpgradeTestSuite struct {
+	suite.Suite
+
+	ctx         sdk.Context
+	app         *app.Evmos
+	consAddress sdk.ConsAddress
+}
+
+func (suite *UpgradeTestSuite) SetupTest() {
+	checkTx := false
+
+	// consensus key
+	priv, err := ethsecp256k1.GenerateKey()
+	suite.Require().NoError(err)
+	suite.consAddress = sdk.ConsAddress(priv.PubKey().Address())
+
+	// NOTE: this is the new binary, not the old one.
+	suite.app = app.Setup(checkTx, feemarkettypes.DefaultGenesisState())
+	suite.ctx = suite.app.BaseApp.NewContext(checkTx, tmproto.Header{
+		Height:          1,
+		ChainID:         "evmos_9000-1",
+		Time:            time.Date(2022, 5, 9, 8, 0, 0, 0, time.UTC),
+		ProposerAddress: suite.consAddress.Bytes(),
+
+		Version: tmversion.Consensus{
+			Block: version.BlockProtocol,
+		},
+		LastBlockId: tmproto.BlockID{
+			Hash: tmhash.Sum([]byte("block_id")),
+			PartSetHeader: tmproto.PartSetHeader{
+				Total: 11,
+				Hash:  tmhash.Sum([]byte("partset_header")),
+			},
+		},
+		AppHash:            tmhash.Sum([]byte("app")),
+		DataHash:           tmhash.Sum([]byte("data")),
+		EvidenceHash:       tmhash.Sum([]byte("evidence")),
+		ValidatorsHash:     tmhash.Sum([]byte("validators")),
+		NextValidatorsHash: tmhash.Sum([]byte("next_validators")),
+		ConsensusHash:      tmhash.Sum([]byte("consensus")),
+		LastResultsHash:    tmhash.Sum([]byte("last_result")),
+	})
+
+	cp := suite.app.BaseApp.GetConsensusParams(suite.ctx)
+	suite.ctx = suite.ctx.WithConsensusParams(cp)
+}
+
+func TestUpgradeTestSuite(t *testing.T) {
+	s := new(UpgradeTestSuite)
+	suite.Run(t, s)
+}
+
+func (suite *UpgradeTestSuite) TestCreateRevenueModuleAccount() {
+	addr := authtypes.NewModuleAddress(revenuetypes.ModuleName)
+	coins := sdk.NewCoins(sdk.NewInt64Coin("aevmos", 1000))
+
+	testCases := []struct {
+		name        string
+		malleate    func()
+		expAccNum   func() uint64
+		expSequence uint64
+		expBalance  sdk.Coins
+	}{
+		{
+			"no account at the module address",
+			func() {
+				if acc := suite.app.AccountKeeper.GetAccount(suite.ctx, addr); acc != nil {
+					suite.app.AccountKeeper.RemoveAccount(suite.ctx, acc)
+				}
+			},
+			nil,
+			0,
+			sdk.Coins{},
+		},
+		{
+			"account created by a transfer to the module address",
+			func() {
+				if acc := suite.app.AccountKeeper.GetAccount(suite.ctx, addr); acc != nil {
+					suite.app.AccountKeeper.RemoveAccount(suite.ctx, acc)
+				}
+				// the account is created by the transfer, as it isn't blocked
+				// before the upgrade
+				sender := sdk.AccAddress(tests.GenerateAddress().Bytes())
+				err := testutil.FundAccount(suite.ctx, suite.app.BankKeeper, sender, coins)
+				suite.Require().NoError(err)
+				err = suite.app.BankKeeper.SendCoins(suite.ctx, sender, addr, coins)
+				suite.Require().NoError(err)
+
+				acc := suite.app.AccountKeeper.GetAccount(suite.ctx, addr)
+				suite.Require().NotNil(acc)
+				_, isModuleAccount := acc.(authtypes.ModuleAccountI)
+				suite.Require().False(isModuleAccount)
+				err = acc.SetSequence(3)
+				suite.Require().NoError(err)
+				suite.app.AccountKeeper.SetAccount(suite.ctx, acc)
+			},
+			func() uint64 {
+				return suite.app.AccountKeeper.GetAccount(suite.ctx, addr).GetAccountNumber()
+			},
+			3,
+			coins,
+		},
+		{
+			"existing module account",
+			func() {
+				macc := suite.app.AccountKeeper.GetModuleAccount(suite.ctx, revenuetypes.ModuleName)
+				suite.Require().NotNil(macc)
+			},
+			func() uint64 {
+				return suite.app.AccountKeeper.GetAccount(suite.ctx, addr).GetAccountNumber()
+			},
+			0,
+			sdk.Coins{},
+		},
+	}
+	for _, tc := range testCases {
+		suite.Run(fmt.Sprintf("Case %s", tc.name), func() {
+			suite.SetupTest() // reset
+
+			tc.malleate()
+
+			var expAccNum uint64
+			if tc.expAccNum != nil {
+				expAccNum = tc.expAccNum()
+			}
+
+			v11.CreateRevenueModuleAccount(suite.ctx, suite.app.AccountKeeper)
+
+			acc := suite.app.AccountKeeper.GetAccount(suite.ctx, addr)
+			macc, ok := acc.(authtypes.ModuleAccountI)
+			suite.Require().True(ok)
+			suite.Require().Equal(revenuetypes.ModuleName, macc.GetName())
+			if tc.expAccNum != nil {
+				suite.Require().Equal(expAccNum, macc.GetAccountNumber())
+			}
+			suite.Require().Equal(tc.expSequence, macc.GetSequence())
+			suite.Require().Equal(tc.expBalance, suite.app.BankKeeper.GetAllBalances(suite.ctx, addr))
+		})
+	}
+}
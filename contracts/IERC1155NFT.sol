@@ -0,0 +1,12 @@
+// SPDX-License-Identifier: MIT
+
+pragma solidity ^0.8.0;
+
+import "./@openzeppelin/contracts/token/ERC1155/extensions/IERC1155MetadataURI.sol";
+
+/**
+ * @dev Interface of the ERC1155 contracts registered as NFT pairs on the erc20
+ * module. It includes the optional metadata URI extension used to create the
+ * native x/nft tokens.
+ */
+interface IERC1155NFT is IERC1155MetadataURI {}
@@ -0,0 +1,12 @@
+// SPDX-License-Identifier: MIT
+
+pragma solidity ^0.8.0;
+
+import "./@openzeppelin/contracts/token/ERC721/extensions/IERC721Metadata.sol";
+
+/**
+ * @dev Interface of the ERC721 contracts registered as NFT pairs on the erc20
+ * module. It includes the optional metadata extension used to create the
+ * native x/nft class and tokens.
+ */
+interface IERC721NFT is IERC721Metadata {}
@@ -0,0 +1,24 @@
+package contracts
+
+import (
+	_ "embed" // embed compiled smart contract
+	"encoding/json"
+
+	evmtypes "github.com/evmos/ethermint/x/evm/types"
+)
+
+var (
+	//go:embed compiled_contracts/IERC1155NFT.json
+	erc1155NFTJSON []byte
+
+	// ERC1155NFTContract is the compiled interface of the ERC1155 contracts
+	// registered as NFT pairs
+	ERC1155NFTContract evmtypes.CompiledContract
+)
+
+func init() {
+	err := json.Unmarshal(erc1155NFTJSON, &ERC1155NFTContract)
+	if err != nil {
+		panic(err)
+	}
+}
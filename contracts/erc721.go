@@ -0,0 +1,24 @@
+package contracts
+
+import (
+	_ "embed" // embed compiled smart contract
+	"encoding/json"
+
+	evmtypes "github.com/evmos/ethermint/x/evm/types"
+)
+
+var (
+	//go:embed compiled_contracts/IERC721NFT.json
+	erc721NFTJSON []byte
+
+	// ERC721NFTContract is the compiled interface of the ERC721 contracts
+	// registered as NFT pairs
+	ERC721NFTContract evmtypes.CompiledContract
+)
+
+func init() {
+	err := json.Unmarshal(erc721NFTJSON, &ERC721NFTContract)
+	if err != nil {
+		panic(err)
+	}
+}
@@ -333,6 +333,8 @@ func govProposal(priv *ethsecp256k1.PrivKey) uint64 {
 		contractAddress.String(),
 		sdk.DecCoins{sdk.NewDecCoinFromDec(evmtypes.DefaultEVMDenom, sdk.NewDecWithPrec(5, 2))},
 		1000,
+		incentivestypes.DefaultWeighting(),
+		incentivestypes.DefaultEligibility(),
 	)
 
 	deposit := sdk.NewCoins(sdk.NewCoin(stakeDenom, sdk.NewInt(100000000)))
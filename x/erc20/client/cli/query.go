@@ -2,14 +2,23 @@ package cli
 
 import (
 	"context"
+	"fmt"
 
 	"github.com/cosmos/cosmos-sdk/client"
 	"github.com/cosmos/cosmos-sdk/client/flags"
+	"github.com/cosmos/cosmos-sdk/version"
 	"github.com/spf13/cobra"
 
 	"github.com/evmos/evmos/v10/x/erc20/types"
 )
 
+// query filter flags
+const (
+	FlagOwner  = "owner"
+	FlagStatus = "status"
+	FlagSender = "sender"
+)
+
 // GetQueryCmd returns the parent command for all erc20 CLI query commands
 func GetQueryCmd() *cobra.Command {
 	cmd := &cobra.Command{
@@ -23,6 +32,12 @@ func GetQueryCmd() *cobra.Command {
 	cmd.AddCommand(
 		GetTokenPairsCmd(),
 		GetTokenPairCmd(),
+		GetTokenPairRateLimitCmd(),
+		GetTokenPairStatsCmd(),
+		GetERC20TemplatesCmd(),
+		GetNFTPairsCmd(),
+		GetNFTPairCmd(),
+		GetFailedConversionsCmd(),
 		GetParamsCmd(),
 	)
 	return cmd
@@ -33,8 +48,12 @@ func GetTokenPairsCmd() *cobra.Command {
 	cmd := &cobra.Command{
 		Use:   "token-pairs",
 		Short: "Gets registered token pairs",
-		Long:  "Gets registered token pairs",
-		Args:  cobra.NoArgs,
+		Long:  "Gets registered token pairs, optionally filtered by contract owner (module or external) and conversion status (enabled or disabled)",
+		Example: fmt.Sprintf(
+			"$ %s query erc20 token-pairs --owner=external --status=enabled",
+			version.AppName,
+		),
+		Args: cobra.NoArgs,
 		RunE: func(cmd *cobra.Command, _ []string) error {
 			clientCtx, err := client.GetClientQueryContext(cmd)
 			if err != nil {
@@ -48,8 +67,30 @@ func GetTokenPairsCmd() *cobra.Command {
 				return err
 			}
 
+			ownerStr, err := cmd.Flags().GetString(FlagOwner)
+			if err != nil {
+				return err
+			}
+
+			owner, err := parseOwner(ownerStr)
+			if err != nil {
+				return err
+			}
+
+			statusStr, err := cmd.Flags().GetString(FlagStatus)
+			if err != nil {
+				return err
+			}
+
+			status, err := parseTokenPairStatus(statusStr)
+			if err != nil {
+				return err
+			}
+
 			req := &types.QueryTokenPairsRequest{
-				Pagination: pageReq,
+				Pagination:    pageReq,
+				ContractOwner: owner,
+				Status:        status,
 			}
 
 			res, err := queryClient.TokenPairs(context.Background(), req)
@@ -61,7 +102,10 @@ func GetTokenPairsCmd() *cobra.Command {
 		},
 	}
 
+	cmd.Flags().String(FlagOwner, "", "filter token pairs by contract owner (module|external)")
+	cmd.Flags().String(FlagStatus, "", "filter token pairs by conversion status (enabled|disabled)")
 	flags.AddQueryFlagsToCmd(cmd)
+	flags.AddPaginationFlagsToCmd(cmd, "token pairs")
 	return cmd
 }
 
@@ -97,6 +141,228 @@ func GetTokenPairCmd() *cobra.Command {
 	return cmd
 }
 
+// GetTokenPairRateLimitCmd queries the conversion rate limit of a registered
+// token pair
+func GetTokenPairRateLimitCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "token-pair-rate-limit TOKEN",
+		Short: "Get the conversion rate limit of a registered token pair",
+		Long:  "Get the conversion rate limit of a registered token pair",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+
+			req := &types.QueryTokenPairRateLimitRequest{
+				Token: args[0],
+			}
+
+			res, err := queryClient.TokenPairRateLimit(context.Background(), req)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+	return cmd
+}
+
+// GetTokenPairStatsCmd queries the conversion stats of a registered token pair
+func GetTokenPairStatsCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "token-pair-stats TOKEN",
+		Short: "Get the conversion stats of a registered token pair",
+		Long:  "Get the cumulative converted volume in each direction, the escrow balance and the ERC20 total supply of a registered token pair",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+
+			req := &types.QueryTokenPairStatsRequest{
+				Token: args[0],
+			}
+
+			res, err := queryClient.TokenPairStats(context.Background(), req)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+	return cmd
+}
+
+// GetERC20TemplatesCmd queries all registered ERC20 templates
+func GetERC20TemplatesCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "erc20-templates",
+		Short: "Gets registered ERC20 templates",
+		Long:  "Gets the ERC20 templates registered by governance. The default template is built into the module and not listed.",
+		Args:  cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, _ []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+
+			pageReq, err := client.ReadPageRequest(cmd.Flags())
+			if err != nil {
+				return err
+			}
+
+			req := &types.QueryERC20TemplatesRequest{
+				Pagination: pageReq,
+			}
+
+			res, err := queryClient.ERC20Templates(context.Background(), req)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+	flags.AddPaginationFlagsToCmd(cmd, "erc20 templates")
+	return cmd
+}
+
+// GetNFTPairsCmd queries all registered NFT pairs
+func GetNFTPairsCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "nft-pairs",
+		Short: "Gets registered NFT pairs",
+		Long:  "Gets the NFT pairs registered for ERC721 and ERC1155 contracts",
+		Args:  cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, _ []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+
+			pageReq, err := client.ReadPageRequest(cmd.Flags())
+			if err != nil {
+				return err
+			}
+
+			req := &types.QueryNFTPairsRequest{
+				Pagination: pageReq,
+			}
+
+			res, err := queryClient.NFTPairs(context.Background(), req)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+	flags.AddPaginationFlagsToCmd(cmd, "nft pairs")
+	return cmd
+}
+
+// GetNFTPairCmd queries a registered NFT pair
+func GetNFTPairCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "nft-pair TOKEN",
+		Short: "Get a registered NFT pair",
+		Long:  "Get a registered NFT pair from either its contract address or its x/nft class id",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+
+			req := &types.QueryNFTPairRequest{
+				Token: args[0],
+			}
+
+			res, err := queryClient.NFTPair(context.Background(), req)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+	return cmd
+}
+
+// GetFailedConversionsCmd queries the failed EVM hook conversions
+func GetFailedConversionsCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "failed-conversions",
+		Short: "Gets the failed EVM hook conversions",
+		Long:  "Gets the ERC20 -> Cosmos coin conversions of the EVM hook that failed and have not been refunded, optionally filtered by the hex or bech32 address of the sender",
+		Example: fmt.Sprintf(
+			"$ %s query erc20 failed-conversions --sender=<address>",
+			version.AppName,
+		),
+		Args: cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, _ []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+
+			pageReq, err := client.ReadPageRequest(cmd.Flags())
+			if err != nil {
+				return err
+			}
+
+			sender, err := cmd.Flags().GetString(FlagSender)
+			if err != nil {
+				return err
+			}
+
+			req := &types.QueryFailedConversionsRequest{
+				Pagination: pageReq,
+				Sender:     sender,
+			}
+
+			res, err := queryClient.FailedConversions(context.Background(), req)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	cmd.Flags().String(FlagSender, "", "filter failed conversions by sender address")
+	flags.AddQueryFlagsToCmd(cmd)
+	flags.AddPaginationFlagsToCmd(cmd, "failed conversions")
+	return cmd
+}
+
 // GetParamsCmd queries erc20 module params
 func GetParamsCmd() *cobra.Command {
 	cmd := &cobra.Command{
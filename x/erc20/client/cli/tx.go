@@ -2,6 +2,8 @@ package cli
 
 import (
 	"fmt"
+	"strconv"
+	"strings"
 
 	"github.com/spf13/cobra"
 
@@ -20,6 +22,14 @@ import (
 	"github.com/evmos/evmos/v10/x/erc20/types"
 )
 
+// erc20 template flags
+const (
+	FlagTemplate            = "template"
+	FlagTemplateDescription = "template-description"
+	FlagClassURI            = "class-uri"
+	FlagClassData           = "class-data"
+)
+
 // NewTxCmd returns a root CLI command handler for erc20 transaction commands
 func NewTxCmd() *cobra.Command {
 	txCmd := &cobra.Command{
@@ -29,147 +39,931 @@ func NewTxCmd() *cobra.Command {
 		SuggestionsMinimumDistance: 2,
 		RunE:                       client.ValidateCmd,
 	}
-
-	txCmd.AddCommand(
-		NewConvertCoinCmd(),
-		NewConvertERC20Cmd(),
-	)
-	return txCmd
+
+	txCmd.AddCommand(
+		NewConvertCoinCmd(),
+		NewConvertERC20Cmd(),
+		NewRegisterERC20Cmd(),
+		NewDeregisterERC20Cmd(),
+		NewConvertCoinsCmd(),
+		NewConvertERC20SCmd(),
+		NewRefreshTokenPairMetadataCmd(),
+		NewConvertNFTCmd(),
+		NewConvertContractNFTCmd(),
+		NewRefundFailedConversionCmd(),
+	)
+	return txCmd
+}
+
+// NewConvertCoinCmd returns a CLI command handler for converting a Cosmos coin
+func NewConvertCoinCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "convert-coin COIN [RECEIVER_HEX]",
+		Short: "Convert a Cosmos coin to ERC20. When the receiver [optional] is omitted, the ERC20 tokens are transferred to the sender.",
+		Args:  cobra.RangeArgs(1, 2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cliCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			coin, err := sdk.ParseCoinNormalized(args[0])
+			if err != nil {
+				return err
+			}
+
+			var receiver string
+			sender := cliCtx.GetFromAddress()
+
+			if len(args) == 2 {
+				receiver = args[1]
+				if err := ethermint.ValidateAddress(receiver); err != nil {
+					return fmt.Errorf("invalid receiver hex address %w", err)
+				}
+			} else {
+				receiver = common.BytesToAddress(sender).Hex()
+			}
+
+			msg := &types.MsgConvertCoin{
+				Coin:     coin,
+				Receiver: receiver,
+				Sender:   sender.String(),
+			}
+
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(cliCtx, cmd.Flags(), msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+	return cmd
+}
+
+// NewConvertERC20Cmd returns a CLI command handler for converting an ERC20
+func NewConvertERC20Cmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "convert-erc20 CONTRACT_ADDRESS AMOUNT [RECEIVER]",
+		Short: "Convert an ERC20 token to Cosmos coin.  When the receiver [optional] is omitted, the Cosmos coins are transferred to the sender.",
+		Args:  cobra.RangeArgs(2, 3),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cliCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			contract := args[0]
+			if err := ethermint.ValidateAddress(contract); err != nil {
+				return fmt.Errorf("invalid ERC20 contract address %w", err)
+			}
+
+			amount, ok := sdk.NewIntFromString(args[1])
+			if !ok {
+				return fmt.Errorf("invalid amount %s", args[1])
+			}
+
+			from := common.BytesToAddress(cliCtx.GetFromAddress().Bytes())
+
+			receiver := cliCtx.GetFromAddress()
+			if len(args) == 3 {
+				receiver, err = sdk.AccAddressFromBech32(args[2])
+				if err != nil {
+					return err
+				}
+			}
+
+			msg := &types.MsgConvertERC20{
+				ContractAddress: contract,
+				Amount:          amount,
+				Receiver:        receiver.String(),
+				Sender:          from.Hex(),
+			}
+
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(cliCtx, cmd.Flags(), msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+	return cmd
+}
+
+// NewConvertCoinsCmd returns a CLI command handler for converting multiple
+// Cosmos coins in a single message
+func NewConvertCoinsCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "convert-coins COINS [RECEIVER_HEX]",
+		Short:   "Convert multiple Cosmos coins to ERC20. When the receiver [optional] is omitted, the ERC20 tokens are transferred to the sender. If any of the coins fails to convert, none is converted.",
+		Example: fmt.Sprintf("$ %s tx %s convert-coins 100acoin,10ibc/<HASH> --from=<key_or_address>", version.AppName, types.ModuleName),
+		Args:    cobra.RangeArgs(1, 2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cliCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			coins, err := sdk.ParseCoinsNormalized(args[0])
+			if err != nil {
+				return err
+			}
+
+			var receiver string
+			sender := cliCtx.GetFromAddress()
+
+			if len(args) == 2 {
+				receiver = args[1]
+				if err := ethermint.ValidateAddress(receiver); err != nil {
+					return fmt.Errorf("invalid receiver hex address %w", err)
+				}
+			} else {
+				receiver = common.BytesToAddress(sender).Hex()
+			}
+
+			msg := &types.MsgConvertCoins{
+				Coins:    coins,
+				Receiver: receiver,
+				Sender:   sender.String(),
+			}
+
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(cliCtx, cmd.Flags(), msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+	return cmd
+}
+
+// NewConvertERC20SCmd returns a CLI command handler for converting multiple
+// ERC20 tokens in a single message
+func NewConvertERC20SCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "convert-erc20s CONTRACT_ADDRESS:AMOUNT,... [RECEIVER]",
+		Short:   "Convert multiple ERC20 tokens to Cosmos coins. When the receiver [optional] is omitted, the Cosmos coins are transferred to the sender. If any of the tokens fails to convert, none is converted.",
+		Example: fmt.Sprintf("$ %s tx %s convert-erc20s <contract-address1>:100,<contract-address2>:10 --from=<key_or_address>", version.AppName, types.ModuleName),
+		Args:    cobra.RangeArgs(1, 2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cliCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			var tokens []types.ERC20Amount
+			for _, token := range strings.Split(args[0], ",") {
+				contractAmount := strings.Split(token, ":")
+				if len(contractAmount) != 2 {
+					return fmt.Errorf("invalid token %s, expected CONTRACT_ADDRESS:AMOUNT", token)
+				}
+
+				contract := contractAmount[0]
+				if err := ethermint.ValidateAddress(contract); err != nil {
+					return fmt.Errorf("invalid ERC20 contract address %w", err)
+				}
+
+				amount, ok := sdk.NewIntFromString(contractAmount[1])
+				if !ok {
+					return fmt.Errorf("invalid amount %s", contractAmount[1])
+				}
+
+				tokens = append(tokens, types.ERC20Amount{ContractAddress: contract, Amount: amount})
+			}
+
+			from := common.BytesToAddress(cliCtx.GetFromAddress().Bytes())
+
+			receiver := cliCtx.GetFromAddress()
+			if len(args) == 2 {
+				receiver, err = sdk.AccAddressFromBech32(args[1])
+				if err != nil {
+					return err
+				}
+			}
+
+			msg := &types.MsgConvertERC20S{
+				Tokens:   tokens,
+				Receiver: receiver.String(),
+				Sender:   from.Hex(),
+			}
+
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(cliCtx, cmd.Flags(), msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+	return cmd
+}
+
+// NewRegisterERC20Cmd returns a CLI command handler for registering an ERC20
+// token pair by escrowing the registration deposit
+func NewRegisterERC20Cmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "register-erc20 CONTRACT_ADDRESS",
+		Short: "Register an ERC20 token pair without a governance proposal. The registration deposit is escrowed from the sender.",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cliCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			contract := args[0]
+			if err := ethermint.ValidateAddress(contract); err != nil {
+				return fmt.Errorf("invalid ERC20 contract address %w", err)
+			}
+
+			msg := &types.MsgRegisterERC20{
+				ContractAddress: contract,
+				Sender:          cliCtx.GetFromAddress().String(),
+			}
+
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(cliCtx, cmd.Flags(), msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+	return cmd
+}
+
+// NewDeregisterERC20Cmd returns a CLI command handler for deregistering an
+// ERC20 token pair registered with a deposit and reclaiming the deposit
+func NewDeregisterERC20Cmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "deregister-erc20 CONTRACT_ADDRESS",
+		Short: "Deregister an ERC20 token pair registered with a deposit. The escrowed balances are unwound and the deposit is refunded to the sender.",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cliCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			contract := args[0]
+			if err := ethermint.ValidateAddress(contract); err != nil {
+				return fmt.Errorf("invalid ERC20 contract address %w", err)
+			}
+
+			msg := &types.MsgDeregisterERC20{
+				ContractAddress: contract,
+				Sender:          cliCtx.GetFromAddress().String(),
+			}
+
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(cliCtx, cmd.Flags(), msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+	return cmd
+}
+
+// NewRefreshTokenPairMetadataCmd returns a CLI command handler for refreshing
+// the metadata of an ERC20 token pair
+func NewRefreshTokenPairMetadataCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "refresh-metadata CONTRACT_ADDRESS",
+		Short: "Update the coin metadata of an ERC20 token pair with the current name and symbol of its contract",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cliCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			contract := args[0]
+			if err := ethermint.ValidateAddress(contract); err != nil {
+				return fmt.Errorf("invalid ERC20 contract address %w", err)
+			}
+
+			msg := &types.MsgRefreshTokenPairMetadata{
+				ContractAddress: contract,
+				Sender:          cliCtx.GetFromAddress().String(),
+			}
+
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(cliCtx, cmd.Flags(), msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+	return cmd
+}
+
+// NewConvertNFTCmd returns a CLI command handler for converting a native x/nft
+// token to the token of its ERC721 or ERC1155 contract
+func NewConvertNFTCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "convert-nft CLASS_ID NFT_ID [RECEIVER_HEX]",
+		Short: "Convert a native NFT to the token of its ERC721 or ERC1155 contract. When the receiver [optional] is omitted, the contract token is transferred to the sender.",
+		Args:  cobra.RangeArgs(2, 3),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cliCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			var receiver string
+			sender := cliCtx.GetFromAddress()
+
+			if len(args) == 3 {
+				receiver = args[2]
+				if err := ethermint.ValidateAddress(receiver); err != nil {
+					return fmt.Errorf("invalid receiver hex address %w", err)
+				}
+			} else {
+				receiver = common.BytesToAddress(sender).Hex()
+			}
+
+			msg := &types.MsgConvertNFT{
+				ClassID:  args[0],
+				NFTID:    args[1],
+				Receiver: receiver,
+				Sender:   sender.String(),
+			}
+
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(cliCtx, cmd.Flags(), msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+	return cmd
+}
+
+// NewConvertContractNFTCmd returns a CLI command handler for converting the
+// token of an ERC721 or ERC1155 contract to a native x/nft token
+func NewConvertContractNFTCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "convert-contract-nft CONTRACT_ADDRESS TOKEN_ID [RECEIVER]",
+		Short: "Convert an ERC721 or ERC1155 token to a native NFT. When the receiver [optional] is omitted, the native NFT is transferred to the sender.",
+		Args:  cobra.RangeArgs(2, 3),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cliCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			contract := args[0]
+			if err := ethermint.ValidateAddress(contract); err != nil {
+				return fmt.Errorf("invalid NFT contract address %w", err)
+			}
+
+			tokenID, ok := sdk.NewIntFromString(args[1])
+			if !ok {
+				return fmt.Errorf("invalid token id %s", args[1])
+			}
+
+			from := common.BytesToAddress(cliCtx.GetFromAddress().Bytes())
+
+			receiver := cliCtx.GetFromAddress()
+			if len(args) == 3 {
+				receiver, err = sdk.AccAddressFromBech32(args[2])
+				if err != nil {
+					return err
+				}
+			}
+
+			msg := &types.MsgConvertContractNFT{
+				ContractAddress: contract,
+				TokenID:         tokenID,
+				Receiver:        receiver.String(),
+				Sender:          from.Hex(),
+			}
+
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(cliCtx, cmd.Flags(), msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+	return cmd
+}
+
+// NewRefundFailedConversionCmd returns a CLI command handler for refunding the
+// ERC20 tokens of a failed EVM hook conversion
+func NewRefundFailedConversionCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "refund-failed-conversion TX_HASH LOG_INDEX",
+		Short: "Refund the ERC20 tokens of a failed EVM hook conversion from the module address to the sender",
+		Args:  cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cliCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			logIndex, err := strconv.ParseUint(args[1], 10, 64)
+			if err != nil {
+				return fmt.Errorf("invalid log index %s: %w", args[1], err)
+			}
+
+			msg := &types.MsgRefundFailedConversion{
+				TxHash:   args[0],
+				LogIndex: logIndex,
+				Sender:   cliCtx.GetFromAddress().String(),
+			}
+
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(cliCtx, cmd.Flags(), msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+	return cmd
+}
+
+// NewRegisterCoinProposalCmd implements the command to submit a community-pool-spend proposal
+// nolint:staticcheck
+func NewRegisterCoinProposalCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "register-coin METADATA_FILE",
+		Args:  cobra.ExactArgs(1),
+		Short: "Submit a register coin proposal",
+		Long:  `Submit a proposal to register a Cosmos coin to the erc20 along with an initial deposit. The proposal details must be supplied via a JSON file.`,
+		Example: fmt.Sprintf(`$ %s tx gov submit-proposal register-coin metadata.json --from=<key_or_address>
+
+Where metadata.json contains (example):
+
+{
+  "metadata": [
+    {
+			"description": "The native staking and governance token of the Osmosis chain",
+			"denom_units": [
+				{
+						"denom": "ibc/<HASH>",
+						"exponent": 0,
+						"aliases": ["ibcuosmo"]
+				},
+				{
+						"denom": "OSMO",
+						"exponent": 6
+				}
+			],
+			"base": "ibc/<HASH>",
+			"display": "OSMO",
+			"name": "Osmo",
+			"symbol": "OSMO"
+		}
+	]
+}`, version.AppName,
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			title, err := cmd.Flags().GetString(cli.FlagTitle)
+			if err != nil {
+				return err
+			}
+
+			description, err := cmd.Flags().GetString(cli.FlagDescription)
+			if err != nil {
+				return err
+			}
+
+			depositStr, err := cmd.Flags().GetString(cli.FlagDeposit)
+			if err != nil {
+				return err
+			}
+
+			deposit, err := sdk.ParseCoinsNormalized(depositStr)
+			if err != nil {
+				return err
+			}
+
+			metadata, err := ParseMetadata(clientCtx.Codec, args[0])
+			if err != nil {
+				return err
+			}
+
+			templateID, err := cmd.Flags().GetString(FlagTemplate)
+			if err != nil {
+				return err
+			}
+
+			from := clientCtx.GetFromAddress()
+
+			content := types.NewRegisterCoinProposal(title, description, templateID, metadata...)
+
+			msg, err := govv1beta1.NewMsgSubmitProposal(content, deposit, from)
+			if err != nil {
+				return err
+			}
+
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	cmd.Flags().String(cli.FlagTitle, "", "title of proposal")
+	cmd.Flags().String(cli.FlagDescription, "", "description of proposal")
+	cmd.Flags().String(cli.FlagDeposit, "1aevmos", "deposit of proposal")
+	cmd.Flags().String(FlagTemplate, "", "identifier of the ERC20 template to deploy (default template if empty)")
+	if err := cmd.MarkFlagRequired(cli.FlagTitle); err != nil {
+		panic(err)
+	}
+	if err := cmd.MarkFlagRequired(cli.FlagDescription); err != nil {
+		panic(err)
+	}
+	if err := cmd.MarkFlagRequired(cli.FlagDeposit); err != nil {
+		panic(err)
+	}
+	return cmd
+}
+
+// NewRegisterERC20ProposalCmd implements the command to submit a community-pool-spend proposal
+// nolint:staticcheck
+func NewRegisterERC20ProposalCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "register-erc20 ERC20_ADDRESS...",
+		Args:    cobra.MinimumNArgs(1),
+		Short:   "Submit a proposal to register ERC20 token",
+		Long:    "Submit a proposal to register ERC20 tokens along with an initial deposit. To register multiple tokens in one proposal pass them after each other e.g. `register-erc20 <contract-address1> <contract-address2>` ",
+		Example: fmt.Sprintf("$ %s tx gov submit-proposal register-erc20 <contract-address> --from=<key_or_address>", version.AppName),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			title, err := cmd.Flags().GetString(cli.FlagTitle)
+			if err != nil {
+				return err
+			}
+
+			description, err := cmd.Flags().GetString(cli.FlagDescription)
+			if err != nil {
+				return err
+			}
+
+			depositStr, err := cmd.Flags().GetString(cli.FlagDeposit)
+			if err != nil {
+				return err
+			}
+
+			deposit, err := sdk.ParseCoinsNormalized(depositStr)
+			if err != nil {
+				return err
+			}
+
+			erc20Addresses := args
+			from := clientCtx.GetFromAddress()
+			content := types.NewRegisterERC20Proposal(title, description, erc20Addresses...)
+
+			msg, err := govv1beta1.NewMsgSubmitProposal(content, deposit, from)
+			if err != nil {
+				return err
+			}
+
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	cmd.Flags().String(cli.FlagTitle, "", "title of proposal")
+	cmd.Flags().String(cli.FlagDescription, "", "description of proposal")
+	cmd.Flags().String(cli.FlagDeposit, "1aevmos", "deposit of proposal")
+	if err := cmd.MarkFlagRequired(cli.FlagTitle); err != nil {
+		panic(err)
+	}
+	if err := cmd.MarkFlagRequired(cli.FlagDescription); err != nil {
+		panic(err)
+	}
+	if err := cmd.MarkFlagRequired(cli.FlagDeposit); err != nil {
+		panic(err)
+	}
+	return cmd
+}
+
+// NewToggleTokenConversionProposalCmd implements the command to submit a community-pool-spend proposal
+// nolint:staticcheck
+func NewToggleTokenConversionProposalCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "toggle-token-conversion TOKEN",
+		Args:    cobra.ExactArgs(1),
+		Short:   "Submit a toggle token conversion proposal",
+		Long:    "Submit a proposal to toggle the conversion of a token pair along with an initial deposit.",
+		Example: fmt.Sprintf("$ %s tx gov submit-proposal toggle-token-conversion DENOM_OR_CONTRACT --from=<key_or_address>", version.AppName),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			title, err := cmd.Flags().GetString(cli.FlagTitle)
+			if err != nil {
+				return err
+			}
+
+			description, err := cmd.Flags().GetString(cli.FlagDescription)
+			if err != nil {
+				return err
+			}
+
+			depositStr, err := cmd.Flags().GetString(cli.FlagDeposit)
+			if err != nil {
+				return err
+			}
+
+			deposit, err := sdk.ParseCoinsNormalized(depositStr)
+			if err != nil {
+				return err
+			}
+
+			from := clientCtx.GetFromAddress()
+			token := args[0]
+			content := types.NewToggleTokenConversionProposal(title, description, token)
+
+			msg, err := govv1beta1.NewMsgSubmitProposal(content, deposit, from)
+			if err != nil {
+				return err
+			}
+
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	cmd.Flags().String(cli.FlagTitle, "", "title of proposal")
+	cmd.Flags().String(cli.FlagDescription, "", "description of proposal")
+	cmd.Flags().String(cli.FlagDeposit, "1aevmos", "deposit of proposal")
+	if err := cmd.MarkFlagRequired(cli.FlagTitle); err != nil {
+		panic(err)
+	}
+	if err := cmd.MarkFlagRequired(cli.FlagDescription); err != nil {
+		panic(err)
+	}
+	if err := cmd.MarkFlagRequired(cli.FlagDeposit); err != nil {
+		panic(err)
+	}
+	return cmd
 }
 
-// NewConvertCoinCmd returns a CLI command handler for converting a Cosmos coin
-func NewConvertCoinCmd() *cobra.Command {
+// NewSlashRegistrationDepositProposalCmd implements the command to submit a slash-registration-deposit proposal
+// nolint:staticcheck
+func NewSlashRegistrationDepositProposalCmd() *cobra.Command {
 	cmd := &cobra.Command{
-		Use:   "convert-coin COIN [RECEIVER_HEX]",
-		Short: "Convert a Cosmos coin to ERC20. When the receiver [optional] is omitted, the ERC20 tokens are transferred to the sender.",
-		Args:  cobra.RangeArgs(1, 2),
+		Use:     "slash-registration-deposit ERC20_ADDRESS...",
+		Args:    cobra.MinimumNArgs(1),
+		Short:   "Submit a proposal to slash the registration deposit of ERC20 token pairs",
+		Long:    "Submit a proposal to burn the registration deposit of ERC20 token pairs registered without a proposal and remove them from the token mapping, along with an initial deposit. To slash multiple token pairs in one proposal pass them after each other e.g. `slash-registration-deposit <contract-address1> <contract-address2>` ",
+		Example: fmt.Sprintf("$ %s tx gov submit-proposal slash-registration-deposit <contract-address> --from=<key_or_address>", version.AppName),
 		RunE: func(cmd *cobra.Command, args []string) error {
-			cliCtx, err := client.GetClientTxContext(cmd)
+			clientCtx, err := client.GetClientTxContext(cmd)
 			if err != nil {
 				return err
 			}
 
-			coin, err := sdk.ParseCoinNormalized(args[0])
+			title, err := cmd.Flags().GetString(cli.FlagTitle)
 			if err != nil {
 				return err
 			}
 
-			var receiver string
-			sender := cliCtx.GetFromAddress()
+			description, err := cmd.Flags().GetString(cli.FlagDescription)
+			if err != nil {
+				return err
+			}
 
-			if len(args) == 2 {
-				receiver = args[1]
-				if err := ethermint.ValidateAddress(receiver); err != nil {
-					return fmt.Errorf("invalid receiver hex address %w", err)
-				}
-			} else {
-				receiver = common.BytesToAddress(sender).Hex()
+			depositStr, err := cmd.Flags().GetString(cli.FlagDeposit)
+			if err != nil {
+				return err
 			}
 
-			msg := &types.MsgConvertCoin{
-				Coin:     coin,
-				Receiver: receiver,
-				Sender:   sender.String(),
+			deposit, err := sdk.ParseCoinsNormalized(depositStr)
+			if err != nil {
+				return err
+			}
+
+			erc20Addresses := args
+			from := clientCtx.GetFromAddress()
+			content := types.NewSlashRegistrationDepositProposal(title, description, erc20Addresses...)
+
+			msg, err := govv1beta1.NewMsgSubmitProposal(content, deposit, from)
+			if err != nil {
+				return err
 			}
 
 			if err := msg.ValidateBasic(); err != nil {
 				return err
 			}
 
-			return tx.GenerateOrBroadcastTxCLI(cliCtx, cmd.Flags(), msg)
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
 		},
 	}
 
-	flags.AddTxFlagsToCmd(cmd)
+	cmd.Flags().String(cli.FlagTitle, "", "title of proposal")
+	cmd.Flags().String(cli.FlagDescription, "", "description of proposal")
+	cmd.Flags().String(cli.FlagDeposit, "1aevmos", "deposit of proposal")
+	if err := cmd.MarkFlagRequired(cli.FlagTitle); err != nil {
+		panic(err)
+	}
+	if err := cmd.MarkFlagRequired(cli.FlagDescription); err != nil {
+		panic(err)
+	}
+	if err := cmd.MarkFlagRequired(cli.FlagDeposit); err != nil {
+		panic(err)
+	}
 	return cmd
 }
 
-// NewConvertERC20Cmd returns a CLI command handler for converting an ERC20
-func NewConvertERC20Cmd() *cobra.Command {
+// NewDeregisterTokenPairProposalCmd implements the command to submit a deregister-token-pair proposal
+// nolint:staticcheck
+func NewDeregisterTokenPairProposalCmd() *cobra.Command {
 	cmd := &cobra.Command{
-		Use:   "convert-erc20 CONTRACT_ADDRESS AMOUNT [RECEIVER]",
-		Short: "Convert an ERC20 token to Cosmos coin.  When the receiver [optional] is omitted, the Cosmos coins are transferred to the sender.",
-		Args:  cobra.RangeArgs(2, 3),
+		Use:     "deregister-token-pair TOKEN",
+		Args:    cobra.ExactArgs(1),
+		Short:   "Submit a deregister token pair proposal",
+		Long:    "Submit a proposal to unwind the escrowed balances of a token pair and remove it from the token mapping, along with an initial deposit.",
+		Example: fmt.Sprintf("$ %s tx gov submit-proposal deregister-token-pair DENOM_OR_CONTRACT --from=<key_or_address>", version.AppName),
 		RunE: func(cmd *cobra.Command, args []string) error {
-			cliCtx, err := client.GetClientTxContext(cmd)
+			clientCtx, err := client.GetClientTxContext(cmd)
 			if err != nil {
 				return err
 			}
 
-			contract := args[0]
-			if err := ethermint.ValidateAddress(contract); err != nil {
-				return fmt.Errorf("invalid ERC20 contract address %w", err)
+			title, err := cmd.Flags().GetString(cli.FlagTitle)
+			if err != nil {
+				return err
 			}
 
-			amount, ok := sdk.NewIntFromString(args[1])
-			if !ok {
-				return fmt.Errorf("invalid amount %s", args[1])
+			description, err := cmd.Flags().GetString(cli.FlagDescription)
+			if err != nil {
+				return err
 			}
 
-			from := common.BytesToAddress(cliCtx.GetFromAddress().Bytes())
+			depositStr, err := cmd.Flags().GetString(cli.FlagDeposit)
+			if err != nil {
+				return err
+			}
 
-			receiver := cliCtx.GetFromAddress()
-			if len(args) == 3 {
-				receiver, err = sdk.AccAddressFromBech32(args[2])
-				if err != nil {
-					return err
-				}
+			deposit, err := sdk.ParseCoinsNormalized(depositStr)
+			if err != nil {
+				return err
 			}
 
-			msg := &types.MsgConvertERC20{
-				ContractAddress: contract,
-				Amount:          amount,
-				Receiver:        receiver.String(),
-				Sender:          from.Hex(),
+			from := clientCtx.GetFromAddress()
+			token := args[0]
+			content := types.NewDeregisterTokenPairProposal(title, description, token)
+
+			msg, err := govv1beta1.NewMsgSubmitProposal(content, deposit, from)
+			if err != nil {
+				return err
 			}
 
 			if err := msg.ValidateBasic(); err != nil {
 				return err
 			}
 
-			return tx.GenerateOrBroadcastTxCLI(cliCtx, cmd.Flags(), msg)
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
 		},
 	}
 
-	flags.AddTxFlagsToCmd(cmd)
+	cmd.Flags().String(cli.FlagTitle, "", "title of proposal")
+	cmd.Flags().String(cli.FlagDescription, "", "description of proposal")
+	cmd.Flags().String(cli.FlagDeposit, "1aevmos", "deposit of proposal")
+	if err := cmd.MarkFlagRequired(cli.FlagTitle); err != nil {
+		panic(err)
+	}
+	if err := cmd.MarkFlagRequired(cli.FlagDescription); err != nil {
+		panic(err)
+	}
+	if err := cmd.MarkFlagRequired(cli.FlagDeposit); err != nil {
+		panic(err)
+	}
 	return cmd
 }
 
-// NewRegisterCoinProposalCmd implements the command to submit a community-pool-spend proposal
+// NewSetTokenPairRateLimitProposalCmd implements the command to submit a set-token-pair-rate-limit proposal
 // nolint:staticcheck
-func NewRegisterCoinProposalCmd() *cobra.Command {
+func NewSetTokenPairRateLimitProposalCmd() *cobra.Command {
 	cmd := &cobra.Command{
-		Use:   "register-coin METADATA_FILE",
-		Args:  cobra.ExactArgs(1),
-		Short: "Submit a register coin proposal",
-		Long:  `Submit a proposal to register a Cosmos coin to the erc20 along with an initial deposit. The proposal details must be supplied via a JSON file.`,
-		Example: fmt.Sprintf(`$ %s tx gov submit-proposal register-coin metadata.json --from=<key_or_address>
+		Use:   "set-token-pair-rate-limit TOKEN EPOCH_IDENTIFIER COIN_TO_ERC20_LIMIT ERC20_TO_COIN_LIMIT",
+		Args:  cobra.ExactArgs(4),
+		Short: "Submit a proposal to set the conversion rate limit of a token pair",
+		Long: `Submit a proposal to set the maximum conversion volume per epoch of a token pair in each direction, along with an initial deposit.
+A zero limit disables the limit for its direction and setting both limits to zero removes the rate limit.
+The converted volumes are reset and the token pair is enabled again if it was paused by its rate limit.`,
+		Example: fmt.Sprintf("$ %s tx gov submit-proposal set-token-pair-rate-limit DENOM_OR_CONTRACT day 1000000 0 --from=<key_or_address>", version.AppName),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
 
-Where metadata.json contains (example):
+			title, err := cmd.Flags().GetString(cli.FlagTitle)
+			if err != nil {
+				return err
+			}
 
-{
-  "metadata": [
-    {
-			"description": "The native staking and governance token of the Osmosis chain",
-			"denom_units": [
-				{
-						"denom": "ibc/<HASH>",
-						"exponent": 0,
-						"aliases": ["ibcuosmo"]
-				},
-				{
-						"denom": "OSMO",
-						"exponent": 6
-				}
-			],
-			"base": "ibc/<HASH>",
-			"display": "OSMO",
-			"name": "Osmo",
-			"symbol": "OSMO"
-		}
-	]
-}`, version.AppName,
-		),
+			description, err := cmd.Flags().GetString(cli.FlagDescription)
+			if err != nil {
+				return err
+			}
+
+			depositStr, err := cmd.Flags().GetString(cli.FlagDeposit)
+			if err != nil {
+				return err
+			}
+
+			deposit, err := sdk.ParseCoinsNormalized(depositStr)
+			if err != nil {
+				return err
+			}
+
+			coinToERC20Limit, ok := sdk.NewIntFromString(args[2])
+			if !ok {
+				return fmt.Errorf("invalid coin to ERC20 limit %s", args[2])
+			}
+
+			erc20ToCoinLimit, ok := sdk.NewIntFromString(args[3])
+			if !ok {
+				return fmt.Errorf("invalid ERC20 to coin limit %s", args[3])
+			}
+
+			from := clientCtx.GetFromAddress()
+			content := types.NewSetTokenPairRateLimitProposal(
+				title, description, args[0], args[1], coinToERC20Limit, erc20ToCoinLimit,
+			)
+
+			msg, err := govv1beta1.NewMsgSubmitProposal(content, deposit, from)
+			if err != nil {
+				return err
+			}
+
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	cmd.Flags().String(cli.FlagTitle, "", "title of proposal")
+	cmd.Flags().String(cli.FlagDescription, "", "description of proposal")
+	cmd.Flags().String(cli.FlagDeposit, "1aevmos", "deposit of proposal")
+	if err := cmd.MarkFlagRequired(cli.FlagTitle); err != nil {
+		panic(err)
+	}
+	if err := cmd.MarkFlagRequired(cli.FlagDescription); err != nil {
+		panic(err)
+	}
+	if err := cmd.MarkFlagRequired(cli.FlagDeposit); err != nil {
+		panic(err)
+	}
+	return cmd
+}
+
+// NewRegisterERC20TemplateProposalCmd implements the command to submit a
+// register-erc20-template proposal
+func NewRegisterERC20TemplateProposalCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "register-erc20-template TEMPLATE_ID BYTECODE_FILE",
+		Args:  cobra.ExactArgs(2),
+		Short: "Submit a proposal to register an ERC20 template",
+		Long: `Submit a proposal to add an ERC20 contract template to the registry, along with an initial deposit.
+The bytecode file must contain the hex encoded contract creation bytecode without constructor arguments (e.g. the .bin output of solc).
+The contract constructor must take the name, symbol and decimals of the coin and the contract must allow the erc20 module to mint and burn tokens.`,
+		Example: fmt.Sprintf("$ %s tx gov submit-proposal register-erc20-template erc20-permit ERC20Permit.bin --template-description=<description> --from=<key_or_address>", version.AppName),
 		RunE: func(cmd *cobra.Command, args []string) error {
 			clientCtx, err := client.GetClientTxContext(cmd)
 			if err != nil {
@@ -196,14 +990,19 @@ Where metadata.json contains (example):
 				return err
 			}
 
-			metadata, err := ParseMetadata(clientCtx.Codec, args[0])
+			templateDescription, err := cmd.Flags().GetString(FlagTemplateDescription)
 			if err != nil {
 				return err
 			}
 
-			from := clientCtx.GetFromAddress()
+			bytecode, err := ParseBytecode(args[1])
+			if err != nil {
+				return err
+			}
 
-			content := types.NewRegisterCoinProposal(title, description, metadata...)
+			from := clientCtx.GetFromAddress()
+			template := types.NewERC20Template(args[0], templateDescription, bytecode)
+			content := types.NewRegisterERC20TemplateProposal(title, description, template)
 
 			msg, err := govv1beta1.NewMsgSubmitProposal(content, deposit, from)
 			if err != nil {
@@ -221,6 +1020,7 @@ Where metadata.json contains (example):
 	cmd.Flags().String(cli.FlagTitle, "", "title of proposal")
 	cmd.Flags().String(cli.FlagDescription, "", "description of proposal")
 	cmd.Flags().String(cli.FlagDeposit, "1aevmos", "deposit of proposal")
+	cmd.Flags().String(FlagTemplateDescription, "", "description of the ERC20 template")
 	if err := cmd.MarkFlagRequired(cli.FlagTitle); err != nil {
 		panic(err)
 	}
@@ -233,15 +1033,15 @@ Where metadata.json contains (example):
 	return cmd
 }
 
-// NewRegisterERC20ProposalCmd implements the command to submit a community-pool-spend proposal
-// nolint:staticcheck
-func NewRegisterERC20ProposalCmd() *cobra.Command {
+// NewRemoveERC20TemplateProposalCmd implements the command to submit a
+// remove-erc20-template proposal
+func NewRemoveERC20TemplateProposalCmd() *cobra.Command {
 	cmd := &cobra.Command{
-		Use:     "register-erc20 ERC20_ADDRESS...",
-		Args:    cobra.MinimumNArgs(1),
-		Short:   "Submit a proposal to register ERC20 token",
-		Long:    "Submit a proposal to register ERC20 tokens along with an initial deposit. To register multiple tokens in one proposal pass them after each other e.g. `register-erc20 <contract-address1> <contract-address2>` ",
-		Example: fmt.Sprintf("$ %s tx gov submit-proposal register-erc20 <contract-address> --from=<key_or_address>", version.AppName),
+		Use:     "remove-erc20-template TEMPLATE_ID",
+		Args:    cobra.ExactArgs(1),
+		Short:   "Submit a proposal to remove an ERC20 template",
+		Long:    "Submit a proposal to remove an ERC20 template from the registry, along with an initial deposit. Token pairs deployed from the template are not affected.",
+		Example: fmt.Sprintf("$ %s tx gov submit-proposal remove-erc20-template erc20-permit --from=<key_or_address>", version.AppName),
 		RunE: func(cmd *cobra.Command, args []string) error {
 			clientCtx, err := client.GetClientTxContext(cmd)
 			if err != nil {
@@ -268,9 +1068,8 @@ func NewRegisterERC20ProposalCmd() *cobra.Command {
 				return err
 			}
 
-			erc20Addresses := args
 			from := clientCtx.GetFromAddress()
-			content := types.NewRegisterERC20Proposal(title, description, erc20Addresses...)
+			content := types.NewRemoveERC20TemplateProposal(title, description, args[0])
 
 			msg, err := govv1beta1.NewMsgSubmitProposal(content, deposit, from)
 			if err != nil {
@@ -300,15 +1099,16 @@ func NewRegisterERC20ProposalCmd() *cobra.Command {
 	return cmd
 }
 
-// NewToggleTokenConversionProposalCmd implements the command to submit a community-pool-spend proposal
+// NewRegisterNFTPairProposalCmd implements the command to submit a
+// register-nft-pair proposal
 // nolint:staticcheck
-func NewToggleTokenConversionProposalCmd() *cobra.Command {
+func NewRegisterNFTPairProposalCmd() *cobra.Command {
 	cmd := &cobra.Command{
-		Use:     "toggle-token-conversion TOKEN",
-		Args:    cobra.ExactArgs(1),
-		Short:   "Submit a toggle token conversion proposal",
-		Long:    "Submit a proposal to toggle the conversion of a token pair along with an initial deposit.",
-		Example: fmt.Sprintf("$ %s tx gov submit-proposal toggle-token-conversion DENOM_OR_CONTRACT --from=<key_or_address>", version.AppName),
+		Use:     "register-nft-pair CONTRACT_ADDRESS STANDARD",
+		Args:    cobra.ExactArgs(2),
+		Short:   "Submit a proposal to register an NFT pair for an ERC721 or ERC1155 contract",
+		Long:    "Submit a proposal to register an NFT pair for an ERC721 or ERC1155 contract along with an initial deposit. The standard must be either erc721 or erc1155.",
+		Example: fmt.Sprintf("$ %s tx gov submit-proposal register-nft-pair <contract-address> erc721 --class-uri=<uri> --from=<key_or_address>", version.AppName),
 		RunE: func(cmd *cobra.Command, args []string) error {
 			clientCtx, err := client.GetClientTxContext(cmd)
 			if err != nil {
@@ -335,9 +1135,23 @@ func NewToggleTokenConversionProposalCmd() *cobra.Command {
 				return err
 			}
 
+			classURI, err := cmd.Flags().GetString(FlagClassURI)
+			if err != nil {
+				return err
+			}
+
+			classData, err := cmd.Flags().GetString(FlagClassData)
+			if err != nil {
+				return err
+			}
+
+			standard, err := parseNFTStandard(args[1])
+			if err != nil {
+				return err
+			}
+
 			from := clientCtx.GetFromAddress()
-			token := args[0]
-			content := types.NewToggleTokenConversionProposal(title, description, token)
+			content := types.NewRegisterNFTPairProposal(title, description, args[0], standard, classURI, classData)
 
 			msg, err := govv1beta1.NewMsgSubmitProposal(content, deposit, from)
 			if err != nil {
@@ -355,6 +1169,8 @@ func NewToggleTokenConversionProposalCmd() *cobra.Command {
 	cmd.Flags().String(cli.FlagTitle, "", "title of proposal")
 	cmd.Flags().String(cli.FlagDescription, "", "description of proposal")
 	cmd.Flags().String(cli.FlagDeposit, "1aevmos", "deposit of proposal")
+	cmd.Flags().String(FlagClassURI, "", "ICS-721 class URI of the collection")
+	cmd.Flags().String(FlagClassData, "", "ICS-721 class data of the collection")
 	if err := cmd.MarkFlagRequired(cli.FlagTitle); err != nil {
 		panic(err)
 	}
@@ -4,9 +4,12 @@ import (
 	"fmt"
 	"os"
 	"path/filepath"
+	"strings"
 
 	"github.com/cosmos/cosmos-sdk/codec"
 	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+
 	"github.com/evmos/evmos/v10/x/erc20/types"
 )
 
@@ -25,3 +28,65 @@ func ParseMetadata(cdc codec.JSONCodec, metadataFile string) ([]banktypes.Metada
 
 	return proposalMetadata.Metadata, nil
 }
+
+// ParseBytecode reads and decodes the hex encoded contract bytecode from a
+// file, e.g. the .bin output of solc.
+func ParseBytecode(bytecodeFile string) ([]byte, error) {
+	contents, err := os.ReadFile(filepath.Clean(bytecodeFile))
+	if err != nil {
+		return nil, err
+	}
+
+	bytecode := strings.TrimSpace(string(contents))
+	if !strings.HasPrefix(bytecode, "0x") {
+		bytecode = "0x" + bytecode
+	}
+
+	bz, err := hexutil.Decode(bytecode)
+	if err != nil {
+		return nil, fmt.Errorf("failed to decode contract bytecode: %w", err)
+	}
+
+	return bz, nil
+}
+
+// parseOwner parses the contract owner filter of the token pairs query
+func parseOwner(owner string) (types.Owner, error) {
+	switch strings.ToLower(owner) {
+	case "":
+		return types.OWNER_UNSPECIFIED, nil
+	case "module":
+		return types.OWNER_MODULE, nil
+	case "external":
+		return types.OWNER_EXTERNAL, nil
+	default:
+		return types.OWNER_UNSPECIFIED, fmt.Errorf("invalid owner '%s', expected module or external", owner)
+	}
+}
+
+// parseTokenPairStatus parses the conversion status filter of the token pairs
+// query
+func parseTokenPairStatus(status string) (types.TokenPairStatus, error) {
+	switch strings.ToLower(status) {
+	case "":
+		return types.TOKEN_PAIR_STATUS_UNSPECIFIED, nil
+	case "enabled":
+		return types.TOKEN_PAIR_STATUS_ENABLED, nil
+	case "disabled":
+		return types.TOKEN_PAIR_STATUS_DISABLED, nil
+	default:
+		return types.TOKEN_PAIR_STATUS_UNSPECIFIED, fmt.Errorf("invalid status '%s', expected enabled or disabled", status)
+	}
+}
+
+// parseNFTStandard parses the token standard of an NFT pair
+func parseNFTStandard(standard string) (types.NFTStandard, error) {
+	switch strings.ToLower(standard) {
+	case "erc721":
+		return types.NFT_STANDARD_ERC721, nil
+	case "erc1155":
+		return types.NFT_STANDARD_ERC1155, nil
+	default:
+		return types.NFT_STANDARD_UNSPECIFIED, fmt.Errorf("invalid standard '%s', expected erc721 or erc1155", standard)
+	}
+}
@@ -7,7 +7,13 @@ import (
 )
 
 var (
-	RegisterCoinProposalHandler          = govclient.NewProposalHandler(cli.NewRegisterCoinProposalCmd)
-	RegisterERC20ProposalHandler         = govclient.NewProposalHandler(cli.NewRegisterERC20ProposalCmd)
-	ToggleTokenConversionProposalHandler = govclient.NewProposalHandler(cli.NewToggleTokenConversionProposalCmd)
+	RegisterCoinProposalHandler             = govclient.NewProposalHandler(cli.NewRegisterCoinProposalCmd)
+	RegisterERC20ProposalHandler            = govclient.NewProposalHandler(cli.NewRegisterERC20ProposalCmd)
+	ToggleTokenConversionProposalHandler    = govclient.NewProposalHandler(cli.NewToggleTokenConversionProposalCmd)
+	SlashRegistrationDepositProposalHandler = govclient.NewProposalHandler(cli.NewSlashRegistrationDepositProposalCmd)
+	DeregisterTokenPairProposalHandler      = govclient.NewProposalHandler(cli.NewDeregisterTokenPairProposalCmd)
+	SetTokenPairRateLimitProposalHandler    = govclient.NewProposalHandler(cli.NewSetTokenPairRateLimitProposalCmd)
+	RegisterERC20TemplateProposalHandler    = govclient.NewProposalHandler(cli.NewRegisterERC20TemplateProposalCmd)
+	RemoveERC20TemplateProposalHandler      = govclient.NewProposalHandler(cli.NewRemoveERC20TemplateProposalCmd)
+	RegisterNFTPairProposalHandler          = govclient.NewProposalHandler(cli.NewRegisterNFTPairProposalCmd)
 )
@@ -29,6 +29,30 @@ func InitGenesis(
 		k.SetDenomMap(ctx, pair.Denom, id)
 		k.SetERC20Map(ctx, pair.GetERC20Contract(), id)
 	}
+
+	for _, deposit := range data.Deposits {
+		k.SetTokenPairDeposit(ctx, deposit)
+	}
+
+	for _, rateLimit := range data.RateLimits {
+		k.SetTokenPairRateLimit(ctx, rateLimit)
+	}
+
+	for _, template := range data.Templates {
+		k.SetERC20Template(ctx, template)
+	}
+
+	for _, stats := range data.Stats {
+		k.SetTokenPairStats(ctx, stats)
+	}
+
+	for _, pair := range data.NFTPairs {
+		k.SetNFTPair(ctx, pair)
+	}
+
+	for _, fc := range data.FailedConversions {
+		k.SetFailedConversion(ctx, fc)
+	}
 }
 
 // ExportGenesis export module status
@@ -36,5 +60,12 @@ func ExportGenesis(ctx sdk.Context, k keeper.Keeper) *types.GenesisState {
 	return &types.GenesisState{
 		Params:     k.GetParams(ctx),
 		TokenPairs: k.GetTokenPairs(ctx),
+		Deposits:   k.GetTokenPairDeposits(ctx),
+		RateLimits: k.GetTokenPairRateLimits(ctx),
+		Templates:  k.GetERC20Templates(ctx),
+		Stats:      k.GetAllTokenPairStats(ctx),
+		NFTPairs:   k.GetNFTPairs(ctx),
+
+		FailedConversions: k.GetAllFailedConversions(ctx),
 	}
 }
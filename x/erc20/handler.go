@@ -20,6 +20,21 @@ func NewHandler(server types.MsgServer) sdk.Handler {
 		case *types.MsgConvertERC20:
 			res, err := server.ConvertERC20(sdk.WrapSDKContext(ctx), msg)
 			return sdk.WrapServiceResult(ctx, res, err)
+		case *types.MsgRegisterERC20:
+			res, err := server.RegisterERC20WithDeposit(sdk.WrapSDKContext(ctx), msg)
+			return sdk.WrapServiceResult(ctx, res, err)
+		case *types.MsgDeregisterERC20:
+			res, err := server.DeregisterERC20(sdk.WrapSDKContext(ctx), msg)
+			return sdk.WrapServiceResult(ctx, res, err)
+		case *types.MsgConvertCoins:
+			res, err := server.ConvertCoins(sdk.WrapSDKContext(ctx), msg)
+			return sdk.WrapServiceResult(ctx, res, err)
+		case *types.MsgConvertERC20S:
+			res, err := server.ConvertERC20S(sdk.WrapSDKContext(ctx), msg)
+			return sdk.WrapServiceResult(ctx, res, err)
+		case *types.MsgRefreshTokenPairMetadata:
+			res, err := server.RefreshTokenPairMetadata(sdk.WrapSDKContext(ctx), msg)
+			return sdk.WrapServiceResult(ctx, res, err)
 		default:
 			err := errorsmod.Wrapf(errortypes.ErrUnknownRequest, "unrecognized %s message type: %T", types.ModuleName, msg)
 			return nil, err
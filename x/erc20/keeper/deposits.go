@@ -0,0 +1,111 @@
+package keeper
+
+import (
+	errorsmod "cosmossdk.io/errors"
+	"github.com/cosmos/cosmos-sdk/store/prefix"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/ethereum/go-ethereum/common"
+
+	"github.com/evmos/evmos/v10/x/erc20/types"
+)
+
+// GetTokenPairDeposits returns all the registration deposits escrowed on the
+// module account
+func (k Keeper) GetTokenPairDeposits(ctx sdk.Context) []types.TokenPairDeposit {
+	deposits := []types.TokenPairDeposit{}
+
+	k.IterateTokenPairDeposits(ctx, func(deposit types.TokenPairDeposit) (stop bool) {
+		deposits = append(deposits, deposit)
+		return false
+	})
+
+	return deposits
+}
+
+// IterateTokenPairDeposits iterates over all the stored registration deposits
+func (k Keeper) IterateTokenPairDeposits(ctx sdk.Context, cb func(deposit types.TokenPairDeposit) (stop bool)) {
+	store := ctx.KVStore(k.storeKey)
+	iterator := sdk.KVStorePrefixIterator(store, types.KeyPrefixTokenPairDeposit)
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		var deposit types.TokenPairDeposit
+		k.cdc.MustUnmarshal(iterator.Value(), &deposit)
+
+		if cb(deposit) {
+			break
+		}
+	}
+}
+
+// GetTokenPairDeposit returns the registration deposit for the given ERC20
+// contract
+func (k Keeper) GetTokenPairDeposit(ctx sdk.Context, erc20 common.Address) (types.TokenPairDeposit, bool) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefixTokenPairDeposit)
+	bz := store.Get(erc20.Bytes())
+	if len(bz) == 0 {
+		return types.TokenPairDeposit{}, false
+	}
+
+	var deposit types.TokenPairDeposit
+	k.cdc.MustUnmarshal(bz, &deposit)
+	return deposit, true
+}
+
+// SetTokenPairDeposit stores a registration deposit
+func (k Keeper) SetTokenPairDeposit(ctx sdk.Context, deposit types.TokenPairDeposit) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefixTokenPairDeposit)
+	bz := k.cdc.MustMarshal(&deposit)
+	store.Set(deposit.GetERC20Contract().Bytes(), bz)
+}
+
+// DeleteTokenPairDeposit removes the registration deposit for the given ERC20
+// contract
+func (k Keeper) DeleteTokenPairDeposit(ctx sdk.Context, erc20 common.Address) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefixTokenPairDeposit)
+	store.Delete(erc20.Bytes())
+}
+
+// RefundTokenPairDeposit returns the registration deposit of the given ERC20
+// contract to its depositor. It performs a no-op if the token pair was not
+// registered with a deposit.
+func (k Keeper) RefundTokenPairDeposit(ctx sdk.Context, erc20 common.Address) error {
+	deposit, found := k.GetTokenPairDeposit(ctx, erc20)
+	if !found {
+		return nil
+	}
+
+	depositor := sdk.MustAccAddressFromBech32(deposit.Depositor)
+	if !deposit.Amount.IsZero() {
+		if err := k.bankKeeper.SendCoinsFromModuleToAccount(ctx, types.ModuleName, depositor, deposit.Amount); err != nil {
+			return errorsmod.Wrap(err, "failed to refund registration deposit")
+		}
+	}
+
+	k.DeleteTokenPairDeposit(ctx, erc20)
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeRefundDeposit,
+			sdk.NewAttribute(types.AttributeKeyDepositor, deposit.Depositor),
+			sdk.NewAttribute(sdk.AttributeKeyAmount, deposit.Amount.String()),
+			sdk.NewAttribute(types.AttributeKeyERC20Token, deposit.Erc20Address),
+		),
+	)
+
+	return nil
+}
+
+// GetEscrowedBalance returns the module account balance of the given denom
+// that is escrowed for conversions, i.e excluding the registration deposits.
+func (k Keeper) GetEscrowedBalance(ctx sdk.Context, denom string) sdk.Coin {
+	moduleAddr := k.accountKeeper.GetModuleAddress(types.ModuleName)
+	balance := k.bankKeeper.GetBalance(ctx, moduleAddr, denom)
+
+	k.IterateTokenPairDeposits(ctx, func(deposit types.TokenPairDeposit) (stop bool) {
+		balance.Amount = balance.Amount.Sub(deposit.Amount.AmountOf(denom))
+		return false
+	})
+
+	return balance
+}
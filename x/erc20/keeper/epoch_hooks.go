@@ -0,0 +1,40 @@
+package keeper
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	epochstypes "github.com/evmos/evmos/v10/x/epochs/types"
+)
+
+// BeforeEpochStart performs a no-op
+func (k Keeper) BeforeEpochStart(_ sdk.Context, _ string, _ int64) {}
+
+// AfterEpochEnd resets the converted volumes of the token pair rate limits
+// tracked against the ended epoch
+func (k Keeper) AfterEpochEnd(ctx sdk.Context, epochIdentifier string, _ int64) {
+	k.ResetRateLimitVolumes(ctx, epochIdentifier)
+}
+
+// ___________________________________________________________________________________________________
+
+// EpochHooks wrapper struct for erc20 keeper
+type EpochHooks struct {
+	k Keeper
+}
+
+var _ epochstypes.EpochHooks = EpochHooks{}
+
+// EpochHooks returns the wrapper struct
+func (k Keeper) EpochHooks() EpochHooks {
+	return EpochHooks{k}
+}
+
+// BeforeEpochStart implements EpochHooks
+func (h EpochHooks) BeforeEpochStart(ctx sdk.Context, epochIdentifier string, epochNumber int64) {
+	h.k.BeforeEpochStart(ctx, epochIdentifier, epochNumber)
+}
+
+// AfterEpochEnd implements EpochHooks
+func (h EpochHooks) AfterEpochEnd(ctx sdk.Context, epochIdentifier string, epochNumber int64) {
+	h.k.AfterEpochEnd(ctx, epochIdentifier, epochNumber)
+}
@@ -21,10 +21,13 @@ import (
 )
 
 // DeployERC20Contract creates and deploys an ERC20 contract on the EVM with the
-// erc20 module account as owner.
+// erc20 module account as owner. The contract is created from the given
+// template bytecode, which must take the name, symbol and decimals of the coin
+// as constructor arguments.
 func (k Keeper) DeployERC20Contract(
 	ctx sdk.Context,
 	coinMetadata banktypes.Metadata,
+	bytecode []byte,
 ) (common.Address, error) {
 	decimals := uint8(0)
 	if len(coinMetadata.DenomUnits) > 0 {
@@ -41,9 +44,9 @@ func (k Keeper) DeployERC20Contract(
 		return common.Address{}, errorsmod.Wrapf(types.ErrABIPack, "coin metadata is invalid %s: %s", coinMetadata.Name, err.Error())
 	}
 
-	data := make([]byte, len(contracts.ERC20MinterBurnerDecimalsContract.Bin)+len(ctorArgs))
-	copy(data[:len(contracts.ERC20MinterBurnerDecimalsContract.Bin)], contracts.ERC20MinterBurnerDecimalsContract.Bin)
-	copy(data[len(contracts.ERC20MinterBurnerDecimalsContract.Bin):], ctorArgs)
+	data := make([]byte, len(bytecode)+len(ctorArgs))
+	copy(data[:len(bytecode)], bytecode)
+	copy(data[len(bytecode):], ctorArgs)
 
 	nonce, err := k.accountKeeper.GetSequence(ctx, types.ModuleAddress.Bytes())
 	if err != nil {
@@ -135,6 +138,30 @@ func (k Keeper) BalanceOf(
 	return balance
 }
 
+// TotalSupply queries the total supply of a given ERC20 contract
+func (k Keeper) TotalSupply(
+	ctx sdk.Context,
+	abi abi.ABI,
+	contract common.Address,
+) *big.Int {
+	res, err := k.CallEVM(ctx, abi, types.ModuleAddress, contract, false, "totalSupply")
+	if err != nil {
+		return nil
+	}
+
+	unpacked, err := abi.Unpack("totalSupply", res.Ret)
+	if err != nil || len(unpacked) == 0 {
+		return nil
+	}
+
+	supply, ok := unpacked[0].(*big.Int)
+	if !ok {
+		return nil
+	}
+
+	return supply
+}
+
 // CallEVM performs a smart contract method call using given args
 func (k Keeper) CallEVM(
 	ctx sdk.Context,
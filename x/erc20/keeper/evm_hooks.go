@@ -5,6 +5,7 @@ import (
 	// nolint: typecheck
 	"math/big"
 
+	errorsmod "cosmossdk.io/errors"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/ethereum/go-ethereum/common"
 	"github.com/ethereum/go-ethereum/core"
@@ -41,6 +42,16 @@ func (h Hooks) PostTxProcessing(ctx sdk.Context, msg core.Message, receipt *etht
 //   - coin -> burn tokens and transfer escrowed coins on module to sender
 //   - token -> escrow tokens on module account and mint & transfer coins to sender
 //
+// If the conversion fails, e.g. because the pair is disabled or the recipient
+// is blocked, the tokens remain on the module address and the failure is
+// recorded so that the sender can refund them with MsgRefundFailedConversion.
+// The same applies to the tokens exceeding the rate limit of the pair.
+//
+// The tokens of registered NFT pairs that are transferred to the module address
+// with an ERC721 `Transfer` or an ERC1155 `TransferSingle` event are escrowed and
+// the native x/nft token is minted to the sender. ERC1155 `TransferBatch` events
+// and multi-unit transfers to the module address revert the tx.
+//
 // Note that the PostTxProcessing hook is only called by sending an EVM
 // transaction that triggers `ApplyTransaction`. A cosmos tx with a
 // `ConvertERC20` msg does not trigger the hook as it only calls `ApplyMessage`.
@@ -59,6 +70,15 @@ func (k Keeper) PostTxProcessing(
 	erc20 := contracts.ERC20MinterBurnerDecimalsContract.ABI
 
 	for i, log := range receipt.Logs {
+		if pair, found := k.GetNFTPair(ctx, log.Address); found {
+			// revert the tx if the conversion fails, as the token would otherwise
+			// remain escrowed on the module address
+			if err := k.convertEscrowedNFT(ctx, pair, log); err != nil {
+				return err
+			}
+			continue
+		}
+
 		// Note: the `Transfer` event contains 3 topics (id, from, to)
 		if len(log.Topics) != 3 {
 			continue
@@ -111,53 +131,181 @@ func (k Keeper) PostTxProcessing(
 			continue
 		}
 
-		// Check that conversion for the pair is enabled. Fail
+		// Only need last 20 bytes from log.topics
+		from := common.BytesToAddress(log.Topics[1].Bytes())
+
+		// Check that conversion for the pair is enabled
 		if !pair.Enabled {
-			// continue to allow transfers for the ERC20 in case the token pair is
-			// disabled
-			k.Logger(ctx).Debug(
+			// record the failure instead of reverting the tx to allow transfers for
+			// the ERC20 in case the token pair is disabled
+			k.RecordFailedConversion(
+				ctx, receipt.TxHash, uint64(i), pair, from, tokens,
 				"ERC20 token -> Cosmos coin conversion is disabled for pair",
-				"coin", pair.Denom, "contract", pair.Erc20Address,
 			)
 			continue
 		}
 
-		// create the corresponding sdk.Coin that is paired with ERC20
-		coins := sdk.Coins{{Denom: pair.Denom, Amount: sdk.NewIntFromBigInt(tokens)}}
-
-		// Perform token conversion. We can now assume that the sender of a
-		// registered token wants to mint a Cosmos coin.
-		switch pair.ContractOwner {
-		case types.OWNER_MODULE:
-			_, err = k.CallEVM(ctx, erc20, types.ModuleAddress, contractAddr, true, "burn", tokens)
-		case types.OWNER_EXTERNAL:
-			err = k.bankKeeper.MintCoins(ctx, types.ModuleName, coins)
-		default:
-			err = types.ErrUndefinedOwner
-		}
+		// The conversion is performed on a cached context, so that a failure
+		// leaves the tokens on the module address where the sender can refund
+		// them
+		cacheCtx, writeCache := ctx.CacheContext()
 
+		// record the failure instead of reverting the tx if there is no volume
+		// left in the rate limit of the pair, so that the sender can refund the
+		// tokens
+		accepted, err := k.TrackConversion(cacheCtx, pair, types.ERC20ToCoin, sdk.NewIntFromBigInt(tokens))
 		if err != nil {
-			k.Logger(ctx).Debug(
-				"failed to process EVM hook for ER20 -> coin conversion",
-				"coin", pair.Denom, "contract", pair.Erc20Address, "error", err.Error(),
-			)
+			k.RecordFailedConversion(ctx, receipt.TxHash, uint64(i), pair, from, tokens, err.Error())
 			continue
 		}
 
-		// Only need last 20 bytes from log.topics
-		from := common.BytesToAddress(log.Topics[1].Bytes())
-		recipient := sdk.AccAddress(from.Bytes())
+		// create the corresponding sdk.Coin that is paired with ERC20
+		coins := sdk.Coins{{Denom: pair.Denom, Amount: accepted}}
 
-		// transfer the tokens from ModuleAccount to sender address
-		if err := k.bankKeeper.SendCoinsFromModuleToAccount(ctx, types.ModuleName, recipient, coins); err != nil {
+		if err := k.convertHookERC20(cacheCtx, pair, from, coins); err != nil {
 			k.Logger(ctx).Debug(
 				"failed to process EVM hook for ER20 -> coin conversion",
 				"tx-hash", receipt.TxHash.Hex(), "log-idx", i,
 				"coin", pair.Denom, "contract", pair.Erc20Address, "error", err.Error(),
 			)
+			k.RecordFailedConversion(ctx, receipt.TxHash, uint64(i), pair, from, tokens, err.Error())
 			continue
 		}
+
+		writeCache()
+
+		// the tokens exceeding the rate limit of the pair remain on the module
+		// address and can be refunded to the sender
+		if excess := new(big.Int).Sub(tokens, accepted.BigInt()); excess.Sign() == 1 {
+			k.RecordFailedConversion(
+				ctx, receipt.TxHash, uint64(i), pair, from, excess,
+				"ERC20 token -> Cosmos coin conversion exceeds the rate limit of the pair",
+			)
+		}
+	}
+
+	return nil
+}
+
+// convertHookERC20 burns or escrows the ERC20 tokens transferred to the module
+// address and sends the paired Cosmos coins to the sender of the transfer
+func (k Keeper) convertHookERC20(
+	ctx sdk.Context,
+	pair types.TokenPair,
+	from common.Address,
+	coins sdk.Coins,
+) error {
+	var err error
+
+	// Perform token conversion. We can now assume that the sender of a
+	// registered token wants to mint a Cosmos coin.
+	switch pair.ContractOwner {
+	case types.OWNER_MODULE:
+		erc20 := contracts.ERC20MinterBurnerDecimalsContract.ABI
+		_, err = k.CallEVM(ctx, erc20, types.ModuleAddress, pair.GetERC20Contract(), true, "burn", coins[0].Amount.BigInt())
+	case types.OWNER_EXTERNAL:
+		err = k.bankKeeper.MintCoins(ctx, types.ModuleName, coins)
+	default:
+		err = types.ErrUndefinedOwner
+	}
+
+	if err != nil {
+		return err
+	}
+
+	// transfer the tokens from ModuleAccount to sender address
+	recipient := sdk.AccAddress(from.Bytes())
+	if err := k.bankKeeper.SendCoinsFromModuleToAccount(ctx, types.ModuleName, recipient, coins); err != nil {
+		return err
 	}
 
+	k.AddConvertedVolume(ctx, pair, types.ERC20ToCoin, coins[0].Amount)
 	return nil
 }
+
+// convertEscrowedNFT mints the x/nft token of an ERC721 or ERC1155 token that
+// was transferred to the module address to the sender of the transfer. Other
+// events of the contract, as well as transfers between other accounts, are
+// ignored. ERC1155 transfers to the module address that can't be converted,
+// i.e. `TransferBatch` events or transfers of more than a single unit, return
+// an error so that the tx is reverted and the tokens don't remain on the module
+// address.
+func (k Keeper) convertEscrowedNFT(ctx sdk.Context, pair types.NFTPair, log *ethtypes.Log) error {
+	var from, to common.Address
+
+	// Note: the ERC721 `Transfer` and the ERC1155 `TransferSingle` and
+	// `TransferBatch` events contain 4 topics
+	if len(log.Topics) != 4 {
+		return nil
+	}
+
+	switch pair.Standard {
+	case types.NFT_STANDARD_ERC721:
+		erc721 := contracts.ERC721NFTContract.ABI
+		if log.Topics[0] != erc721.Events[types.ERC721EventTransfer].ID {
+			return nil
+		}
+
+		from = common.BytesToAddress(log.Topics[1].Bytes())
+		to = common.BytesToAddress(log.Topics[2].Bytes())
+	case types.NFT_STANDARD_ERC1155:
+		erc1155 := contracts.ERC1155NFTContract.ABI
+		if log.Topics[0] != erc1155.Events[types.ERC1155EventTransferSingle].ID &&
+			log.Topics[0] != erc1155.Events[types.ERC1155EventTransferBatch].ID {
+			return nil
+		}
+
+		from = common.BytesToAddress(log.Topics[2].Bytes())
+		to = common.BytesToAddress(log.Topics[3].Bytes())
+	default:
+		return types.ErrUndefinedNFTStandard
+	}
+
+	// tokens minted to the module address or sent to other accounts are not
+	// conversions
+	if to != types.ModuleAddress || from == (common.Address{}) {
+		return nil
+	}
+
+	tokenID, err := escrowedNFTTokenID(pair, log)
+	if err != nil {
+		return err
+	}
+
+	_, err = k.mintNFT(ctx, pair, tokenID, from.Bytes())
+	return err
+}
+
+// escrowedNFTTokenID returns the token identifier of a contract token
+// transferred to the module address. ERC1155 transfers are only accepted for a
+// single unit with a `TransferSingle` event.
+func escrowedNFTTokenID(pair types.NFTPair, log *ethtypes.Log) (*big.Int, error) {
+	if pair.Standard == types.NFT_STANDARD_ERC721 {
+		return log.Topics[3].Big(), nil
+	}
+
+	erc1155 := contracts.ERC1155NFTContract.ABI
+	if log.Topics[0] == erc1155.Events[types.ERC1155EventTransferBatch].ID {
+		return nil, errorsmod.Wrap(
+			types.ErrUnexpectedEvent, "ERC1155 batch transfers can't be converted, transfer each token with safeTransferFrom",
+		)
+	}
+
+	transferEvent, err := erc1155.Unpack(types.ERC1155EventTransferSingle, log.Data)
+	if err != nil || len(transferEvent) != 2 {
+		return nil, errorsmod.Wrap(types.ErrABIUnpack, "failed to unpack transfer single event")
+	}
+
+	tokenID, ok := transferEvent[0].(*big.Int)
+	if !ok {
+		return nil, errorsmod.Wrap(types.ErrABIUnpack, "failed to unpack token id")
+	}
+
+	if value, ok := transferEvent[1].(*big.Int); !ok || value.Cmp(big.NewInt(1)) != 0 {
+		return nil, errorsmod.Wrapf(
+			types.ErrUnexpectedEvent, "only single ERC1155 tokens can be converted, got value %v", transferEvent[1],
+		)
+	}
+
+	return tokenID, nil
+}
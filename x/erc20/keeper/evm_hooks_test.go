@@ -4,12 +4,14 @@ import (
 	"fmt"
 	"math/big"
 
+	"cosmossdk.io/math"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/ethereum/go-ethereum/common"
 	ethtypes "github.com/ethereum/go-ethereum/core/types"
 
 	"github.com/evmos/ethermint/tests"
 	"github.com/evmos/evmos/v10/contracts"
+	epochstypes "github.com/evmos/evmos/v10/x/epochs/types"
 	"github.com/evmos/evmos/v10/x/erc20/types"
 )
 
@@ -131,6 +133,59 @@ func (suite *KeeperTestSuite) TestEvmHooksRegisteredERC20() {
 	suite.mintFeeCollector = false
 }
 
+func (suite *KeeperTestSuite) TestEvmHooksRateLimitedERC20() {
+	suite.mintFeeCollector = true
+	suite.SetupTest()
+	suite.ensureHooksSet()
+
+	contractAddr, err := suite.DeployContract("coin test erc20", "token", erc20Decimals)
+	suite.Require().NoError(err)
+	suite.Commit()
+
+	pair, err := suite.app.Erc20Keeper.RegisterERC20(suite.ctx, contractAddr)
+	suite.Require().NoError(err)
+
+	_, err = suite.app.Erc20Keeper.SetRateLimit(suite.ctx, pair.Denom, epochstypes.DayEpochID, math.ZeroInt(), math.NewInt(4))
+	suite.Require().NoError(err)
+
+	_ = suite.MintERC20Token(contractAddr, suite.address, suite.address, big.NewInt(13))
+	suite.Commit()
+
+	tx := suite.TransferERC20TokenToModule(contractAddr, suite.address, big.NewInt(10))
+
+	// the transfer is converted up to the limit and the pair is paused
+	balance := suite.app.BankKeeper.GetBalance(suite.ctx, sdk.AccAddress(suite.address.Bytes()), pair.Denom)
+	suite.Require().Equal(int64(4), balance.Amount.Int64())
+
+	paused, _ := suite.app.Erc20Keeper.GetTokenPair(suite.ctx, pair.GetID())
+	suite.Require().False(paused.Enabled)
+
+	// the excess can be refunded to the sender
+	fc, found := suite.app.Erc20Keeper.GetFailedConversion(suite.ctx, common.HexToHash(tx.Hash), 0)
+	suite.Require().True(found)
+	suite.Require().Equal(int64(6), fc.Amount.Int64())
+
+	// a transfer without remaining volume doesn't revert the tx once the pair is
+	// enabled again by governance
+	_, err = suite.app.Erc20Keeper.ToggleConversion(suite.ctx, pair.Denom)
+	suite.Require().NoError(err)
+
+	tx = suite.TransferERC20TokenToModule(contractAddr, suite.address, big.NewInt(3))
+
+	fc, found = suite.app.Erc20Keeper.GetFailedConversion(suite.ctx, common.HexToHash(tx.Hash), 0)
+	suite.Require().True(found)
+	suite.Require().Equal(int64(3), fc.Amount.Int64())
+
+	erc20 := contracts.ERC20MinterBurnerDecimalsContract.ABI
+	escrowed := suite.app.Erc20Keeper.BalanceOf(suite.ctx, erc20, contractAddr, types.ModuleAddress)
+	suite.Require().Equal(int64(13), escrowed.Int64())
+
+	balance = suite.app.BankKeeper.GetBalance(suite.ctx, sdk.AccAddress(suite.address.Bytes()), pair.Denom)
+	suite.Require().Equal(int64(4), balance.Amount.Int64())
+
+	suite.mintFeeCollector = false
+}
+
 func (suite *KeeperTestSuite) TestEvmHooksRegisteredCoin() {
 	testCases := []struct {
 		name      string
@@ -186,6 +241,11 @@ func (suite *KeeperTestSuite) TestEvmHooksRegisteredCoin() {
 				// Check if the execution was successful
 				suite.Require().NoError(err)
 				suite.Require().Equal(cosmosBalance.Amount, sdk.NewInt(tc.mint-tc.burn+tc.reconvert))
+
+				// the conversions are tracked in the token pair stats
+				stats := suite.app.Erc20Keeper.GetTokenPairStats(suite.ctx, contractAddr)
+				suite.Require().Equal(sdk.NewInt(tc.burn), stats.CoinToERC20Volume)
+				suite.Require().Equal(sdk.NewInt(tc.reconvert), stats.ERC20ToCoinVolume)
 			} else {
 				// Check that no changes were made to the account
 				suite.Require().Error(err)
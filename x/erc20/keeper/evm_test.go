@@ -90,7 +90,7 @@ func (suite *KeeperTestSuite) TestBalanceOf() {
 		mockEVMKeeper = &MockEVMKeeper{}
 		sp, found := suite.app.ParamsKeeper.GetSubspace(types.ModuleName)
 		suite.Require().True(found)
-		suite.app.Erc20Keeper = keeper.NewKeeper(suite.app.GetKey("erc20"), suite.app.AppCodec(), sp, suite.app.AccountKeeper, suite.app.BankKeeper,
+		suite.app.Erc20Keeper = keeper.NewKeeper(suite.app.GetKey("erc20"), suite.app.AppCodec(), sp, suite.app.AccountKeeper, suite.app.BankKeeper, suite.app.NFTKeeper,
 			mockEVMKeeper, suite.app.StakingKeeper, suite.app.ClaimsKeeper)
 
 		tc.malleate()
@@ -279,7 +279,7 @@ func (suite *KeeperTestSuite) TestForceFail() {
 			sp, found := suite.app.ParamsKeeper.GetSubspace(types.ModuleName)
 			suite.Require().True(found)
 			suite.app.Erc20Keeper = keeper.NewKeeper(suite.app.GetKey("erc20"), suite.app.AppCodec(), sp, suite.app.AccountKeeper,
-				suite.app.BankKeeper, mockEVMKeeper, suite.app.StakingKeeper, suite.app.ClaimsKeeper)
+				suite.app.BankKeeper, suite.app.NFTKeeper, mockEVMKeeper, suite.app.StakingKeeper, suite.app.ClaimsKeeper)
 
 			tc.malleate()
 
@@ -368,7 +368,7 @@ func (suite *KeeperTestSuite) TestQueryERC20ForceFail() {
 		sp, found := suite.app.ParamsKeeper.GetSubspace(types.ModuleName)
 		suite.Require().True(found)
 		suite.app.Erc20Keeper = keeper.NewKeeper(suite.app.GetKey("erc20"), suite.app.AppCodec(), sp, suite.app.AccountKeeper,
-			suite.app.BankKeeper, mockEVMKeeper, suite.app.StakingKeeper, suite.app.ClaimsKeeper)
+			suite.app.BankKeeper, suite.app.NFTKeeper, mockEVMKeeper, suite.app.StakingKeeper, suite.app.ClaimsKeeper)
 
 		tc.malleate()
 
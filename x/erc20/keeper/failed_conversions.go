@@ -0,0 +1,187 @@
+package keeper
+
+import (
+	"context"
+	"math/big"
+
+	errorsmod "cosmossdk.io/errors"
+	"github.com/cosmos/cosmos-sdk/store/prefix"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	errortypes "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/ethereum/go-ethereum/common"
+
+	"github.com/evmos/evmos/v10/contracts"
+	"github.com/evmos/evmos/v10/x/erc20/types"
+)
+
+// GetAllFailedConversions returns all the failed EVM hook conversions that have
+// not been refunded
+func (k Keeper) GetAllFailedConversions(ctx sdk.Context) []types.FailedConversion {
+	failedConversions := []types.FailedConversion{}
+
+	k.IterateFailedConversions(ctx, func(fc types.FailedConversion) (stop bool) {
+		failedConversions = append(failedConversions, fc)
+		return false
+	})
+
+	return failedConversions
+}
+
+// IterateFailedConversions iterates over all the stored failed conversions
+func (k Keeper) IterateFailedConversions(ctx sdk.Context, cb func(fc types.FailedConversion) (stop bool)) {
+	store := ctx.KVStore(k.storeKey)
+	iterator := sdk.KVStorePrefixIterator(store, types.KeyPrefixFailedConversion)
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		var fc types.FailedConversion
+		k.cdc.MustUnmarshal(iterator.Value(), &fc)
+
+		if cb(fc) {
+			break
+		}
+	}
+}
+
+// GetFailedConversion returns the failed conversion of the transfer log with
+// the given index in the receipt of the given tx
+func (k Keeper) GetFailedConversion(ctx sdk.Context, txHash common.Hash, logIndex uint64) (types.FailedConversion, bool) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefixFailedConversion)
+	bz := store.Get(types.FailedConversionKey(txHash, logIndex))
+	if len(bz) == 0 {
+		return types.FailedConversion{}, false
+	}
+
+	var fc types.FailedConversion
+	k.cdc.MustUnmarshal(bz, &fc)
+	return fc, true
+}
+
+// SetFailedConversion stores a failed conversion
+func (k Keeper) SetFailedConversion(ctx sdk.Context, fc types.FailedConversion) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefixFailedConversion)
+	bz := k.cdc.MustMarshal(&fc)
+	store.Set(types.FailedConversionKey(fc.GetEthTxHash(), fc.LogIndex), bz)
+}
+
+// DeleteFailedConversion removes a failed conversion
+func (k Keeper) DeleteFailedConversion(ctx sdk.Context, fc types.FailedConversion) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefixFailedConversion)
+	store.Delete(types.FailedConversionKey(fc.GetEthTxHash(), fc.LogIndex))
+}
+
+// RecordFailedConversion stores the ERC20 -> Cosmos coin conversion of a
+// transfer to the module address that failed on the EVM hook, so that the
+// sender can refund the tokens
+func (k Keeper) RecordFailedConversion(
+	ctx sdk.Context,
+	txHash common.Hash,
+	logIndex uint64,
+	pair types.TokenPair,
+	sender common.Address,
+	tokens *big.Int,
+	reason string,
+) {
+	fc := types.NewFailedConversion(
+		txHash, logIndex, pair.GetERC20Contract(), sender, sdk.NewIntFromBigInt(tokens), reason, ctx.BlockHeight(),
+	)
+	k.SetFailedConversion(ctx, fc)
+
+	ctx.EventManager().EmitEvents(
+		sdk.Events{
+			sdk.NewEvent(
+				types.EventTypeFailedConversion,
+				sdk.NewAttribute(types.AttributeKeyTxHash, fc.TxHash),
+				sdk.NewAttribute(types.AttributeKeyLogIndex, sdk.NewIntFromUint64(logIndex).String()),
+				sdk.NewAttribute(sdk.AttributeKeySender, fc.Sender),
+				sdk.NewAttribute(sdk.AttributeKeyAmount, fc.Amount.String()),
+				sdk.NewAttribute(types.AttributeKeyCosmosCoin, pair.Denom),
+				sdk.NewAttribute(types.AttributeKeyERC20Token, fc.Erc20Address),
+				sdk.NewAttribute(types.AttributeKeyError, reason),
+			),
+		},
+	)
+}
+
+// RefundFailedConversion transfers the ERC20 tokens of a failed EVM hook
+// conversion from the module address back to the sender. Refunds are allowed
+// while the module is disabled and after the token pair is removed.
+func (k Keeper) RefundFailedConversion(
+	goCtx context.Context,
+	msg *types.MsgRefundFailedConversion,
+) (*types.MsgRefundFailedConversionResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	// Error checked during msg validation
+	sender := common.BytesToAddress(sdk.MustAccAddressFromBech32(msg.Sender))
+
+	fc, found := k.GetFailedConversion(ctx, common.HexToHash(msg.TxHash), msg.LogIndex)
+	if !found {
+		return nil, errorsmod.Wrapf(
+			types.ErrFailedConversionNotFound, "tx %s, log index %d", msg.TxHash, msg.LogIndex,
+		)
+	}
+
+	if fc.GetSenderAddress() != sender {
+		return nil, errorsmod.Wrapf(
+			errortypes.ErrUnauthorized, "%s is not the sender of the failed conversion", msg.Sender,
+		)
+	}
+
+	erc20 := contracts.ERC20MinterBurnerDecimalsContract.ABI
+	contract := fc.GetERC20Contract()
+	tokens := fc.Amount.BigInt()
+
+	balanceToken := k.BalanceOf(ctx, erc20, contract, sender)
+	if balanceToken == nil {
+		return nil, errorsmod.Wrap(types.ErrEVMCall, "failed to retrieve balance")
+	}
+
+	res, err := k.CallEVM(ctx, erc20, types.ModuleAddress, contract, true, "transfer", sender, tokens)
+	if err != nil {
+		return nil, err
+	}
+
+	var unpackedRet types.ERC20BoolResponse
+	if err := erc20.UnpackIntoInterface(&unpackedRet, "transfer", res.Ret); err != nil {
+		return nil, err
+	}
+
+	if !unpackedRet.Value {
+		return nil, errorsmod.Wrap(errortypes.ErrLogic, "failed to refund tokens from module address")
+	}
+
+	balanceTokenAfter := k.BalanceOf(ctx, erc20, contract, sender)
+	if balanceTokenAfter == nil {
+		return nil, errorsmod.Wrap(types.ErrEVMCall, "failed to retrieve balance")
+	}
+
+	if exp := new(big.Int).Add(balanceToken, tokens); balanceTokenAfter.Cmp(exp) != 0 {
+		return nil, errorsmod.Wrapf(
+			types.ErrBalanceInvariance,
+			"invalid token balance - expected: %v, actual: %v", exp, balanceTokenAfter,
+		)
+	}
+
+	// Check for unexpected `Approval` event in logs
+	if err := k.monitorApprovalEvent(res); err != nil {
+		return nil, err
+	}
+
+	k.DeleteFailedConversion(ctx, fc)
+
+	ctx.EventManager().EmitEvents(
+		sdk.Events{
+			sdk.NewEvent(
+				types.EventTypeRefundFailedConversion,
+				sdk.NewAttribute(types.AttributeKeyTxHash, fc.TxHash),
+				sdk.NewAttribute(types.AttributeKeyLogIndex, sdk.NewIntFromUint64(fc.LogIndex).String()),
+				sdk.NewAttribute(sdk.AttributeKeySender, msg.Sender),
+				sdk.NewAttribute(sdk.AttributeKeyAmount, fc.Amount.String()),
+				sdk.NewAttribute(types.AttributeKeyERC20Token, fc.Erc20Address),
+			),
+		},
+	)
+
+	return &types.MsgRefundFailedConversionResponse{}, nil
+}
@@ -0,0 +1,213 @@
+package keeper_test
+
+import (
+	"fmt"
+	"math/big"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
+	"github.com/ethereum/go-ethereum/common"
+	ethtypes "github.com/ethereum/go-ethereum/core/types"
+
+	"github.com/evmos/ethermint/tests"
+	"github.com/evmos/evmos/v10/contracts"
+	"github.com/evmos/evmos/v10/x/erc20/types"
+)
+
+func (suite *KeeperTestSuite) TestPostTxProcessingFailedConversion() {
+	erc20 := contracts.ERC20MinterBurnerDecimalsContract.ABI
+	transferEvent := erc20.Events["Transfer"]
+	transferData := make([]byte, 32)
+	transferData[31] = uint8(10)
+
+	msg := ethtypes.NewMessage(
+		types.ModuleAddress,
+		&common.Address{},
+		0,
+		big.NewInt(0), // amount
+		uint64(0),     // gasLimit
+		big.NewInt(0), // gasFeeCap
+		big.NewInt(0), // gasTipCap
+		big.NewInt(0), // gasPrice
+		[]byte{},
+		ethtypes.AccessList{}, // AccessList
+		true,                  // checkNonce
+	)
+
+	// module accounts are not allowed to receive the converted coins
+	blocked := common.BytesToAddress(authtypes.NewModuleAddress(stakingtypes.NotBondedPoolName))
+	txHash := common.BytesToHash([]byte("tx"))
+
+	suite.mintFeeCollector = true
+	suite.SetupTest()
+
+	contractAddr, err := suite.DeployContract("coin", "token", erc20Decimals)
+	suite.Require().NoError(err)
+	suite.Commit()
+
+	pair, err := suite.app.Erc20Keeper.RegisterERC20(suite.ctx, contractAddr)
+	suite.Require().NoError(err)
+
+	topics := []common.Hash{transferEvent.ID, blocked.Hash(), types.ModuleAddress.Hash()}
+	receipt := &ethtypes.Receipt{
+		TxHash: txHash,
+		Logs: []*ethtypes.Log{
+			{}, // the log index is the position of the log in the receipt
+			{Topics: topics, Data: transferData, Address: contractAddr},
+		},
+	}
+
+	err = suite.app.Erc20Keeper.Hooks().PostTxProcessing(suite.ctx, msg, receipt)
+	suite.Require().NoError(err)
+
+	// the minted coins and the tracked volume are reverted
+	suite.Require().True(suite.app.BankKeeper.GetSupply(suite.ctx, pair.Denom).IsZero())
+	suite.Require().True(suite.app.Erc20Keeper.GetTokenPairStats(suite.ctx, contractAddr).ERC20ToCoinVolume.IsZero())
+
+	fc, found := suite.app.Erc20Keeper.GetFailedConversion(suite.ctx, txHash, 1)
+	suite.Require().True(found)
+	suite.Require().Equal(blocked, fc.GetSenderAddress())
+	suite.Require().Equal(contractAddr, fc.GetERC20Contract())
+	suite.Require().Equal(int64(10), fc.Amount.Int64())
+	suite.Require().Contains(fc.Error, "not allowed to receive funds")
+	suite.Require().Equal(suite.ctx.BlockHeight(), fc.Height)
+
+	var emitted bool
+	for _, event := range suite.ctx.EventManager().Events() {
+		if event.Type == types.EventTypeFailedConversion {
+			emitted = true
+		}
+	}
+	suite.Require().True(emitted)
+
+	suite.mintFeeCollector = false
+}
+
+func (suite *KeeperTestSuite) TestRefundFailedConversion() {
+	var (
+		contractAddr common.Address
+		txHash       common.Hash
+		logIndex     uint64
+		sender       sdk.AccAddress
+	)
+
+	testCases := []struct {
+		name     string
+		malleate func()
+		expPass  bool
+	}{
+		{
+			"fail - failed conversion not found",
+			func() {
+				logIndex = 1
+			},
+			false,
+		},
+		{
+			"fail - not the sender of the transfer",
+			func() {
+				sender = sdk.AccAddress(tests.GenerateAddress().Bytes())
+			},
+			false,
+		},
+		{
+			"ok - refund while the module is disabled",
+			func() {
+				params := suite.app.Erc20Keeper.GetParams(suite.ctx)
+				params.EnableErc20 = false
+				suite.app.Erc20Keeper.SetParams(suite.ctx, params)
+			},
+			true,
+		},
+		{
+			"ok",
+			func() {},
+			true,
+		},
+	}
+	for _, tc := range testCases {
+		suite.Run(fmt.Sprintf("Case %s", tc.name), func() {
+			suite.mintFeeCollector = true
+			suite.SetupTest()
+			suite.ensureHooksSet()
+
+			var err error
+			contractAddr, err = suite.DeployContract("coin", "token", erc20Decimals)
+			suite.Require().NoError(err)
+			suite.Commit()
+
+			pair, err := suite.app.Erc20Keeper.RegisterERC20(suite.ctx, contractAddr)
+			suite.Require().NoError(err)
+
+			// transfers to the module address are not converted while the pair is
+			// disabled
+			pair.Enabled = false
+			suite.app.Erc20Keeper.SetTokenPair(suite.ctx, *pair)
+
+			_ = suite.MintERC20Token(contractAddr, suite.address, suite.address, big.NewInt(10))
+			suite.Commit()
+
+			tx := suite.TransferERC20TokenToModule(contractAddr, suite.address, big.NewInt(10))
+			suite.Commit()
+
+			txHash = common.HexToHash(tx.Hash)
+			logIndex = 0
+			sender = suite.address.Bytes()
+
+			fc, found := suite.app.Erc20Keeper.GetFailedConversion(suite.ctx, txHash, logIndex)
+			suite.Require().True(found)
+			suite.Require().Equal(suite.address, fc.GetSenderAddress())
+			suite.Require().Equal("0", suite.BalanceOf(contractAddr, suite.address).(*big.Int).String())
+
+			tc.malleate()
+
+			msg := types.NewMsgRefundFailedConversion(txHash, logIndex, sender)
+			_, err = suite.app.Erc20Keeper.RefundFailedConversion(sdk.WrapSDKContext(suite.ctx), msg)
+			if tc.expPass {
+				suite.Require().NoError(err, tc.name)
+				suite.Require().Equal("10", suite.BalanceOf(contractAddr, suite.address).(*big.Int).String())
+
+				_, found = suite.app.Erc20Keeper.GetFailedConversion(suite.ctx, txHash, logIndex)
+				suite.Require().False(found)
+
+				// the tokens can only be refunded once
+				_, err = suite.app.Erc20Keeper.RefundFailedConversion(sdk.WrapSDKContext(suite.ctx), msg)
+				suite.Require().Error(err)
+			} else {
+				suite.Require().Error(err, tc.name)
+				suite.Require().Equal("0", suite.BalanceOf(contractAddr, suite.address).(*big.Int).String())
+			}
+		})
+	}
+	suite.mintFeeCollector = false
+}
+
+func (suite *KeeperTestSuite) TestQueryFailedConversions() {
+	suite.SetupTest()
+	ctx := sdk.WrapSDKContext(suite.ctx)
+
+	sender := tests.GenerateAddress()
+	fc := types.NewFailedConversion(
+		common.BytesToHash([]byte("tx")), 0, tests.GenerateAddress(), sender, sdk.NewInt(10), "conversion disabled", 1,
+	)
+	other := types.NewFailedConversion(
+		common.BytesToHash([]byte("tx")), 1, tests.GenerateAddress(), tests.GenerateAddress(), sdk.NewInt(10), "conversion disabled", 1,
+	)
+	suite.app.Erc20Keeper.SetFailedConversion(suite.ctx, fc)
+	suite.app.Erc20Keeper.SetFailedConversion(suite.ctx, other)
+
+	res, err := suite.app.Erc20Keeper.FailedConversions(ctx, &types.QueryFailedConversionsRequest{})
+	suite.Require().NoError(err)
+	suite.Require().Len(res.FailedConversions, 2)
+
+	// filter by hex and by bech32 sender address
+	for _, addr := range []string{sender.Hex(), sdk.AccAddress(sender.Bytes()).String()} {
+		res, err = suite.app.Erc20Keeper.FailedConversions(ctx, &types.QueryFailedConversionsRequest{Sender: addr})
+		suite.Require().NoError(err)
+		suite.Require().Equal([]types.FailedConversion{fc}, res.FailedConversions)
+	}
+
+	_, err = suite.app.Erc20Keeper.FailedConversions(ctx, &types.QueryFailedConversionsRequest{Sender: "invalid"})
+	suite.Require().Error(err)
+}
@@ -2,6 +2,7 @@ package keeper
 
 import (
 	"context"
+	"fmt"
 
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
@@ -9,8 +10,11 @@ import (
 	"github.com/cosmos/cosmos-sdk/store/prefix"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/cosmos/cosmos-sdk/types/query"
+	"github.com/cosmos/cosmos-sdk/x/nft"
+	"github.com/ethereum/go-ethereum/common"
 	ethermint "github.com/evmos/ethermint/types"
 
+	"github.com/evmos/evmos/v10/contracts"
 	"github.com/evmos/evmos/v10/x/erc20/types"
 )
 
@@ -27,13 +31,25 @@ func (k Keeper) TokenPairs(c context.Context, req *types.QueryTokenPairsRequest)
 	var pairs []types.TokenPair
 	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefixTokenPair)
 
-	pageRes, err := query.Paginate(store, req.Pagination, func(_, value []byte) error {
+	pageRes, err := query.FilteredPaginate(store, req.Pagination, func(_, value []byte, accumulate bool) (bool, error) {
 		var pair types.TokenPair
 		if err := k.cdc.Unmarshal(value, &pair); err != nil {
-			return err
+			return false, err
 		}
-		pairs = append(pairs, pair)
-		return nil
+
+		if req.ContractOwner != types.OWNER_UNSPECIFIED && pair.ContractOwner != req.ContractOwner {
+			return false, nil
+		}
+
+		if (req.Status == types.TOKEN_PAIR_STATUS_ENABLED && !pair.Enabled) ||
+			(req.Status == types.TOKEN_PAIR_STATUS_DISABLED && pair.Enabled) {
+			return false, nil
+		}
+
+		if accumulate {
+			pairs = append(pairs, pair)
+		}
+		return true, nil
 	})
 	if err != nil {
 		return nil, status.Error(codes.Internal, err.Error())
@@ -77,9 +93,254 @@ func (k Keeper) TokenPair(c context.Context, req *types.QueryTokenPairRequest) (
 	return &types.QueryTokenPairResponse{TokenPair: pair}, nil
 }
 
+// TokenPairRateLimit returns the conversion rate limit of a registered token
+// pair
+func (k Keeper) TokenPairRateLimit(
+	c context.Context,
+	req *types.QueryTokenPairRateLimitRequest,
+) (*types.QueryTokenPairRateLimitResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+
+	// check if the token is a hex address, if not, check if it is a valid SDK
+	// denom
+	if err := ethermint.ValidateAddress(req.Token); err != nil {
+		if err := sdk.ValidateDenom(req.Token); err != nil {
+			return nil, status.Errorf(
+				codes.InvalidArgument,
+				"invalid format for token %s, should be either hex ('0x...') cosmos denom", req.Token,
+			)
+		}
+	}
+
+	id := k.GetTokenPairID(ctx, req.Token)
+
+	if len(id) == 0 {
+		return nil, status.Errorf(codes.NotFound, "token pair with token '%s'", req.Token)
+	}
+
+	pair, found := k.GetTokenPair(ctx, id)
+	if !found {
+		return nil, status.Errorf(codes.NotFound, "token pair with token '%s'", req.Token)
+	}
+
+	rateLimit, found := k.GetTokenPairRateLimit(ctx, pair.GetERC20Contract())
+	if !found {
+		return nil, status.Errorf(codes.NotFound, "rate limit for token pair with token '%s'", req.Token)
+	}
+
+	return &types.QueryTokenPairRateLimitResponse{RateLimit: rateLimit}, nil
+}
+
+// TokenPairStats returns the cumulative conversion volumes, the escrow balance
+// and the ERC20 total supply of a registered token pair
+func (k Keeper) TokenPairStats(
+	c context.Context,
+	req *types.QueryTokenPairStatsRequest,
+) (*types.QueryTokenPairStatsResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+
+	// check if the token is a hex address, if not, check if it is a valid SDK
+	// denom
+	if err := ethermint.ValidateAddress(req.Token); err != nil {
+		if err := sdk.ValidateDenom(req.Token); err != nil {
+			return nil, status.Errorf(
+				codes.InvalidArgument,
+				"invalid format for token %s, should be either hex ('0x...') cosmos denom", req.Token,
+			)
+		}
+	}
+
+	id := k.GetTokenPairID(ctx, req.Token)
+
+	if len(id) == 0 {
+		return nil, status.Errorf(codes.NotFound, "token pair with token '%s'", req.Token)
+	}
+
+	pair, found := k.GetTokenPair(ctx, id)
+	if !found {
+		return nil, status.Errorf(codes.NotFound, "token pair with token '%s'", req.Token)
+	}
+
+	erc20 := contracts.ERC20MinterBurnerDecimalsContract.ABI
+	supply := k.TotalSupply(ctx, erc20, pair.GetERC20Contract())
+	if supply == nil {
+		return nil, status.Errorf(codes.Internal, "failed to query total supply of ERC20 contract %s", pair.Erc20Address)
+	}
+
+	escrow, err := k.GetEscrowBalance(ctx, pair)
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	stats := k.GetTokenPairStats(ctx, pair.GetERC20Contract())
+
+	return &types.QueryTokenPairStatsResponse{
+		TokenPair:         pair,
+		CoinToERC20Volume: stats.CoinToERC20Volume,
+		ERC20ToCoinVolume: stats.ERC20ToCoinVolume,
+		EscrowBalance:     escrow,
+		ERC20TotalSupply:  sdk.NewIntFromBigInt(supply),
+	}, nil
+}
+
+// ERC20Templates returns all the registered ERC20 templates
+func (k Keeper) ERC20Templates(c context.Context, req *types.QueryERC20TemplatesRequest) (*types.QueryERC20TemplatesResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+
+	var templates []types.ERC20Template
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefixERC20Template)
+
+	pageRes, err := query.Paginate(store, req.Pagination, func(_, value []byte) error {
+		var template types.ERC20Template
+		if err := k.cdc.Unmarshal(value, &template); err != nil {
+			return err
+		}
+		templates = append(templates, template)
+		return nil
+	})
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+	return &types.QueryERC20TemplatesResponse{
+		Templates:  templates,
+		Pagination: pageRes,
+	}, nil
+}
+
+// NFTPairs returns all the registered NFT pairs
+func (k Keeper) NFTPairs(c context.Context, req *types.QueryNFTPairsRequest) (*types.QueryNFTPairsResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+
+	var pairs []types.NFTPair
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefixNFTPair)
+
+	pageRes, err := query.Paginate(store, req.Pagination, func(_, value []byte) error {
+		var pair types.NFTPair
+		if err := k.cdc.Unmarshal(value, &pair); err != nil {
+			return err
+		}
+		pairs = append(pairs, pair)
+		return nil
+	})
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+	return &types.QueryNFTPairsResponse{
+		NFTPairs:   pairs,
+		Pagination: pageRes,
+	}, nil
+}
+
+// NFTPair returns a given registered NFT pair
+func (k Keeper) NFTPair(c context.Context, req *types.QueryNFTPairRequest) (*types.QueryNFTPairResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+
+	// check if the token is a hex address, if not, check if it is a valid
+	// x/nft class id
+	if err := ethermint.ValidateAddress(req.Token); err != nil {
+		if err := nft.ValidateClassID(req.Token); err != nil {
+			return nil, status.Errorf(
+				codes.InvalidArgument,
+				"invalid format for token %s, should be either hex ('0x...') or x/nft class id", req.Token,
+			)
+		}
+	}
+
+	pair, found := k.GetNFTPairByToken(ctx, req.Token)
+	if !found {
+		return nil, status.Errorf(codes.NotFound, "NFT pair with token '%s'", req.Token)
+	}
+
+	return &types.QueryNFTPairResponse{NFTPair: pair}, nil
+}
+
 // Params returns the params of the erc20 module
 func (k Keeper) Params(c context.Context, _ *types.QueryParamsRequest) (*types.QueryParamsResponse, error) {
 	ctx := sdk.UnwrapSDKContext(c)
 	params := k.GetParams(ctx)
 	return &types.QueryParamsResponse{Params: params}, nil
 }
+
+// FailedConversions returns the failed EVM hook conversions that have not been
+// refunded, optionally filtered by sender
+func (k Keeper) FailedConversions(
+	c context.Context,
+	req *types.QueryFailedConversionsRequest,
+) (*types.QueryFailedConversionsResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+
+	var sender *common.Address
+	if req.Sender != "" {
+		addr, err := parseHexOrBech32Address(req.Sender)
+		if err != nil {
+			return nil, status.Error(codes.InvalidArgument, err.Error())
+		}
+		sender = &addr
+	}
+
+	var failedConversions []types.FailedConversion
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefixFailedConversion)
+
+	pageRes, err := query.FilteredPaginate(store, req.Pagination, func(_, value []byte, accumulate bool) (bool, error) {
+		var fc types.FailedConversion
+		if err := k.cdc.Unmarshal(value, &fc); err != nil {
+			return false, err
+		}
+
+		if sender != nil && fc.GetSenderAddress() != *sender {
+			return false, nil
+		}
+
+		if accumulate {
+			failedConversions = append(failedConversions, fc)
+		}
+		return true, nil
+	})
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &types.QueryFailedConversionsResponse{
+		FailedConversions: failedConversions,
+		Pagination:        pageRes,
+	}, nil
+}
+
+// parseHexOrBech32Address parses an account address given either in hex or in
+// bech32 format
+func parseHexOrBech32Address(address string) (common.Address, error) {
+	if common.IsHexAddress(address) {
+		return common.HexToAddress(address), nil
+	}
+
+	addr, err := sdk.AccAddressFromBech32(address)
+	if err != nil {
+		return common.Address{}, fmt.Errorf("invalid address %s, expected hex or bech32 format", address)
+	}
+
+	return common.BytesToAddress(addr), nil
+}
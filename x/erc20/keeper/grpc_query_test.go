@@ -61,6 +61,71 @@ func (suite *KeeperTestSuite) TestTokenPairs() {
 			},
 			true,
 		},
+		{
+			"filter by contract owner",
+			func() {
+				req = &types.QueryTokenPairsRequest{
+					Pagination:    &query.PageRequest{Limit: 10, CountTotal: true},
+					ContractOwner: types.OWNER_EXTERNAL,
+				}
+				pair := types.NewTokenPair(tests.GenerateAddress(), "coin", true, types.OWNER_MODULE)
+				pair2 := types.NewTokenPair(tests.GenerateAddress(), "coin2", true, types.OWNER_EXTERNAL)
+				suite.app.Erc20Keeper.SetTokenPair(suite.ctx, pair)
+				suite.app.Erc20Keeper.SetTokenPair(suite.ctx, pair2)
+
+				expRes = &types.QueryTokenPairsResponse{
+					Pagination: &query.PageResponse{Total: 1},
+					TokenPairs: []types.TokenPair{pair2},
+				}
+			},
+			true,
+		},
+		{
+			"filter by contract owner and status",
+			func() {
+				req = &types.QueryTokenPairsRequest{
+					Pagination:    &query.PageRequest{Limit: 10, CountTotal: true},
+					ContractOwner: types.OWNER_MODULE,
+					Status:        types.TOKEN_PAIR_STATUS_DISABLED,
+				}
+				pair := types.NewTokenPair(tests.GenerateAddress(), "coin", true, types.OWNER_MODULE)
+				pair2 := types.NewTokenPair(tests.GenerateAddress(), "coin2", true, types.OWNER_MODULE)
+				pair2.Enabled = false
+				pair3 := types.NewTokenPair(tests.GenerateAddress(), "coin3", true, types.OWNER_EXTERNAL)
+				pair3.Enabled = false
+				suite.app.Erc20Keeper.SetTokenPair(suite.ctx, pair)
+				suite.app.Erc20Keeper.SetTokenPair(suite.ctx, pair2)
+				suite.app.Erc20Keeper.SetTokenPair(suite.ctx, pair3)
+
+				expRes = &types.QueryTokenPairsResponse{
+					Pagination: &query.PageResponse{Total: 1},
+					TokenPairs: []types.TokenPair{pair2},
+				}
+			},
+			true,
+		},
+		{
+			"filter by enabled status",
+			func() {
+				req = &types.QueryTokenPairsRequest{
+					Pagination: &query.PageRequest{Limit: 10, CountTotal: true},
+					Status:     types.TOKEN_PAIR_STATUS_ENABLED,
+				}
+				pair := types.NewTokenPair(tests.GenerateAddress(), "coin", true, types.OWNER_MODULE)
+				pair2 := types.NewTokenPair(tests.GenerateAddress(), "coin2", true, types.OWNER_EXTERNAL)
+				pair3 := types.NewTokenPair(tests.GenerateAddress(), "coin3", true, types.OWNER_EXTERNAL)
+				pair3.Enabled = false
+				suite.app.Erc20Keeper.SetTokenPair(suite.ctx, pair)
+				suite.app.Erc20Keeper.SetTokenPair(suite.ctx, pair2)
+				suite.app.Erc20Keeper.SetTokenPair(suite.ctx, pair3)
+
+				expRes = &types.QueryTokenPairsResponse{
+					Pagination: &query.PageResponse{Total: 2},
+					TokenPairs: []types.TokenPair{pair, pair2},
+				}
+			},
+			true,
+		},
 	}
 	for _, tc := range testCases {
 		suite.Run(fmt.Sprintf("Case %s", tc.name), func() {
@@ -160,6 +225,146 @@ func (suite *KeeperTestSuite) TestTokenPair() {
 	}
 }
 
+func (suite *KeeperTestSuite) TestTokenPairRateLimit() {
+	var (
+		req    *types.QueryTokenPairRateLimitRequest
+		expRes *types.QueryTokenPairRateLimitResponse
+	)
+
+	testCases := []struct {
+		name     string
+		malleate func()
+		expPass  bool
+	}{
+		{
+			"invalid token address",
+			func() {
+				req = &types.QueryTokenPairRateLimitRequest{}
+				expRes = &types.QueryTokenPairRateLimitResponse{}
+			},
+			false,
+		},
+		{
+			"token pair not found",
+			func() {
+				req = &types.QueryTokenPairRateLimitRequest{
+					Token: tests.GenerateAddress().Hex(),
+				}
+				expRes = &types.QueryTokenPairRateLimitResponse{}
+			},
+			false,
+		},
+		{
+			"rate limit not found",
+			func() {
+				addr := tests.GenerateAddress()
+				pair := types.NewTokenPair(addr, "coin", true, types.OWNER_MODULE)
+				suite.app.Erc20Keeper.SetTokenPair(suite.ctx, pair)
+				suite.app.Erc20Keeper.SetERC20Map(suite.ctx, addr, pair.GetID())
+				suite.app.Erc20Keeper.SetDenomMap(suite.ctx, pair.Denom, pair.GetID())
+
+				req = &types.QueryTokenPairRateLimitRequest{
+					Token: pair.Denom,
+				}
+				expRes = &types.QueryTokenPairRateLimitResponse{}
+			},
+			false,
+		},
+		{
+			"rate limit found",
+			func() {
+				addr := tests.GenerateAddress()
+				pair := types.NewTokenPair(addr, "coin", true, types.OWNER_MODULE)
+				suite.app.Erc20Keeper.SetTokenPair(suite.ctx, pair)
+				suite.app.Erc20Keeper.SetERC20Map(suite.ctx, addr, pair.GetID())
+				suite.app.Erc20Keeper.SetDenomMap(suite.ctx, pair.Denom, pair.GetID())
+
+				rateLimit := types.NewTokenPairRateLimit(addr, "day", sdk.NewInt(100), sdk.ZeroInt())
+				suite.app.Erc20Keeper.SetTokenPairRateLimit(suite.ctx, rateLimit)
+
+				req = &types.QueryTokenPairRateLimitRequest{
+					Token: pair.Denom,
+				}
+				expRes = &types.QueryTokenPairRateLimitResponse{RateLimit: rateLimit}
+			},
+			true,
+		},
+	}
+	for _, tc := range testCases {
+		suite.Run(fmt.Sprintf("Case %s", tc.name), func() {
+			suite.SetupTest() // reset
+
+			ctx := sdk.WrapSDKContext(suite.ctx)
+			tc.malleate()
+
+			res, err := suite.queryClient.TokenPairRateLimit(ctx, req)
+			if tc.expPass {
+				suite.Require().NoError(err)
+				suite.Require().Equal(expRes.RateLimit.String(), res.RateLimit.String())
+			} else {
+				suite.Require().Error(err)
+			}
+		})
+	}
+}
+
+func (suite *KeeperTestSuite) TestERC20Templates() {
+	var (
+		req    *types.QueryERC20TemplatesRequest
+		expRes *types.QueryERC20TemplatesResponse
+	)
+
+	testCases := []struct {
+		name     string
+		malleate func()
+		expPass  bool
+	}{
+		{
+			"no templates registered",
+			func() {
+				req = &types.QueryERC20TemplatesRequest{}
+				expRes = &types.QueryERC20TemplatesResponse{Pagination: &query.PageResponse{}}
+			},
+			true,
+		},
+		{
+			"2 templates registered w/pagination",
+			func() {
+				req = &types.QueryERC20TemplatesRequest{
+					Pagination: &query.PageRequest{Limit: 10, CountTotal: true},
+				}
+				template := types.NewERC20Template("erc20-permit", "permit", []byte{0x60, 0x80})
+				template2 := types.NewERC20Template("erc20-pauser", "pauser", []byte{0x60, 0x80})
+				suite.app.Erc20Keeper.SetERC20Template(suite.ctx, template)
+				suite.app.Erc20Keeper.SetERC20Template(suite.ctx, template2)
+
+				expRes = &types.QueryERC20TemplatesResponse{
+					Pagination: &query.PageResponse{Total: 2},
+					Templates:  []types.ERC20Template{template, template2},
+				}
+			},
+			true,
+		},
+	}
+	for _, tc := range testCases {
+		suite.Run(fmt.Sprintf("Case %s", tc.name), func() {
+			suite.SetupTest() // reset
+
+			ctx := sdk.WrapSDKContext(suite.ctx)
+			tc.malleate()
+
+			res, err := suite.queryClient.ERC20Templates(ctx, req)
+			if tc.expPass {
+				suite.Require().NoError(err)
+				suite.Require().Equal(expRes.Pagination, res.Pagination)
+				suite.Require().ElementsMatch(expRes.Templates, res.Templates)
+			} else {
+				suite.Require().Error(err)
+			}
+		})
+	}
+}
+
 func (suite *KeeperTestSuite) TestQueryParams() {
 	ctx := sdk.WrapSDKContext(suite.ctx)
 	expParams := types.DefaultParams()
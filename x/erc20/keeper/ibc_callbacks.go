@@ -29,6 +29,14 @@ import (
 // - ERC20s are disabled
 // - Denomination is native staking token
 // - The base denomination is not registered as ERC20
+// - The packet memo opts out of the conversion
+//
+// Only the received coins are converted, unless the EnableIBCReceiveSweep param
+// is set, in which case the recipient's whole balance of the coin is converted.
+// The packet memo can also set the hex address that receives the ERC20 tokens
+// (see types.ERC20Memo). In that case only the received coins are converted
+// regardless of the param. An invalid memo returns an error acknowledgement so
+// that the sender is refunded.
 func (k Keeper) OnRecvPacket(
 	ctx sdk.Context,
 	packet channeltypes.Packet,
@@ -51,6 +59,16 @@ func (k Keeper) OnRecvPacket(
 		return ack
 	}
 
+	memo, err := types.ParseERC20Memo(data.Memo)
+	if err != nil {
+		return channeltypes.NewErrorAcknowledgement(err)
+	}
+
+	if memo != nil && memo.SkipConversion {
+		// no-op: the sender opted out of the conversion
+		return ack
+	}
+
 	// Get addresses in `evmos1` and the original bech32 format
 	sender, recipient, _, _, err := ibc.GetTransferSenderRecipient(packet)
 	if err != nil {
@@ -99,21 +117,52 @@ func (k Keeper) OnRecvPacket(
 		return ack
 	}
 
-	// Instead of converting just the received coins, convert the whole user balance
-	// which includes the received coins.
-	balance := k.bankKeeper.GetBalance(ctx, recipient, coin.Denom)
+	converted := coin
+	receiver := common.BytesToAddress(recipient.Bytes())
+
+	switch {
+	case memo != nil && memo.Receiver != "":
+		// A receiver set on the memo is chosen by the packet sender, so only the
+		// received coins are converted to it
+		receiver = common.HexToAddress(memo.Receiver)
+	case k.GetParams(ctx).EnableIBCReceiveSweep:
+		// Instead of converting just the received coins, convert the whole user
+		// balance which includes the received coins.
+		converted = k.bankKeeper.GetBalance(ctx, recipient, coin.Denom)
+	}
 
-	// Build MsgConvertCoin, from recipient to recipient since IBC transfer already occurred
-	msg := types.NewMsgConvertCoin(balance, common.BytesToAddress(recipient.Bytes()), recipient)
+	// Build MsgConvertCoin, from recipient since IBC transfer already occurred
+	msg := types.NewMsgConvertCoin(converted, receiver, recipient)
 
 	// NOTE: we don't use ValidateBasic the msg since we've already validated
 	// the ICS20 packet data
 
+	balance := k.bankKeeper.GetBalance(ctx, recipient, coin.Denom)
+
 	// Use MsgConvertCoin to convert the Cosmos Coin to an ERC20
 	if _, err = k.ConvertCoin(sdk.WrapSDKContext(ctx), msg); err != nil {
+		// no-op: keep the received coins without conversion if they exceed the
+		// remaining volume of the rate limit of the pair
+		if errorsmod.IsOf(err, types.ErrRateLimitExceeded) {
+			return ack
+		}
 		return channeltypes.NewErrorAcknowledgement(err)
 	}
 
+	converted = balance.Sub(k.bankKeeper.GetBalance(ctx, recipient, coin.Denom))
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeIBCReceiveConversion,
+			sdk.NewAttribute(sdk.AttributeKeySender, recipient.String()),
+			sdk.NewAttribute(types.AttributeKeyReceiver, receiver.Hex()),
+			sdk.NewAttribute(types.AttributeKeyCosmosCoin, coin.Denom),
+			sdk.NewAttribute(types.AttributeKeyERC20Token, pair.Erc20Address),
+			sdk.NewAttribute(types.AttributeKeyReceived, coin.Amount.String()),
+			sdk.NewAttribute(types.AttributeKeyConverted, converted.Amount.String()),
+		),
+	)
+
 	defer func() {
 		telemetry.IncrCounterWithLabels(
 			[]string{types.ModuleName, "ibc", "on_recv", "total"},
@@ -196,6 +245,11 @@ func (k Keeper) ConvertCoinToERC20FromPacket(ctx sdk.Context, data transfertypes
 
 	// convert Coin to ERC20
 	if _, err = k.ConvertCoin(sdk.WrapSDKContext(ctx), msg); err != nil {
+		// no-op: keep the refunded coins without conversion if they exceed the
+		// remaining volume of the rate limit of the pair
+		if errorsmod.IsOf(err, types.ErrRateLimitExceeded) {
+			return nil
+		}
 		return err
 	}
 
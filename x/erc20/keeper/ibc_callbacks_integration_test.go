@@ -395,6 +395,10 @@ var _ = Describe("Convert receiving IBC to Erc20", Ordered, func() {
 			s.Require().Equal(amount, balanceToken.Int64())
 		})
 		It("should convert full available balance of erc20 coin to original erc20 token", func() {
+			erc20params := s.app.Erc20Keeper.GetParams(s.EvmosChain.GetContext())
+			erc20params.EnableIBCReceiveSweep = true
+			s.app.Erc20Keeper.SetParams(s.EvmosChain.GetContext(), erc20params)
+
 			// Mint tokens and send to receiver
 			_, err := s.app.Erc20Keeper.CallEVM(s.EvmosChain.GetContext(), contracts.ERC20MinterBurnerDecimalsContract.ABI, common.BytesToAddress(senderAcc.Bytes()), pair.GetERC20Contract(), true, "mint", common.BytesToAddress(senderAcc.Bytes()), big.NewInt(amount))
 			s.Require().NoError(err)
@@ -486,7 +490,7 @@ var _ = Describe("Convert receiving IBC to Erc20", Ordered, func() {
 			erc20CoinsBalance = s.app.BankKeeper.GetBalance(s.EvmosChain.GetContext(), senderAcc, pair.Denom)
 			s.Require().Equal(int64(0), erc20CoinsBalance.Amount.Int64())
 
-		})		
+		})
 	})
 })
 
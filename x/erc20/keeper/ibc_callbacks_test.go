@@ -11,6 +11,7 @@ import (
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
 	"github.com/evmos/ethermint/crypto/ethsecp256k1"
+	"github.com/evmos/ethermint/tests"
 	"github.com/evmos/evmos/v10/testutil"
 
 	transfertypes "github.com/cosmos/ibc-go/v5/modules/apps/transfer/types"
@@ -70,6 +71,7 @@ func (suite *KeeperTestSuite) TestOnRecvPacket() {
 		checkBalances    bool
 		disableERC20     bool
 		disableTokenPair bool
+		enableSweep      bool
 	}{
 		{
 			name: "error - non ics-20 packet",
@@ -255,10 +257,10 @@ func (suite *KeeperTestSuite) TestOnRecvPacket() {
 			receiver:      secpAddr,
 			ackSuccess:    true,
 			checkBalances: true,
-			expErc20s:     big.NewInt(1000),
+			expErc20s:     big.NewInt(100),
 			expCoins: sdk.NewCoins(
 				sdk.NewCoin(claimstypes.DefaultClaimsDenom, sdk.NewInt(1000)),
-				sdk.NewCoin(registeredDenom, sdk.NewInt(0)),
+				sdk.NewCoin(registeredDenom, sdk.NewInt(900)),
 				sdk.NewCoin(ibcBase, sdk.NewInt(1000)),
 			),
 		},
@@ -275,6 +277,28 @@ func (suite *KeeperTestSuite) TestOnRecvPacket() {
 			},
 			receiver:      ethsecpAddr,
 			ackSuccess:    true,
+			expErc20s:     big.NewInt(500),
+			checkBalances: true,
+			expCoins: sdk.NewCoins(
+				sdk.NewCoin(claimstypes.DefaultClaimsDenom, sdk.NewInt(1000)),
+				sdk.NewCoin(registeredDenom, sdk.NewInt(500)),
+				sdk.NewCoin(ibcBase, sdk.NewInt(1000)),
+			),
+		},
+		{
+			name: "ibc conversion - sweep the whole balance",
+			malleate: func() {
+				pk1 := secp256k1.GenPrivKey()
+				sourcePrefix := transfertypes.GetDenomPrefix(transfertypes.PortID, sourceChannel)
+				prefixedDenom := sourcePrefix + registeredDenom
+				otherSecpAddrEvmos := sdk.AccAddress(pk1.PubKey().Address()).String()
+				transfer := transfertypes.NewFungibleTokenPacketData(prefixedDenom, "500", otherSecpAddrEvmos, ethsecpAddrEvmos)
+				bz := transfertypes.ModuleCdc.MustMarshalJSON(&transfer)
+				packet = channeltypes.NewPacket(bz, 100, transfertypes.PortID, sourceChannel, transfertypes.PortID, evmosChannel, timeoutHeight, 0)
+			},
+			receiver:      ethsecpAddr,
+			ackSuccess:    true,
+			enableSweep:   true,
 			expErc20s:     big.NewInt(1000),
 			checkBalances: true,
 			expCoins: sdk.NewCoins(
@@ -350,6 +374,12 @@ func (suite *KeeperTestSuite) TestOnRecvPacket() {
 				suite.app.Erc20Keeper.SetParams(suite.ctx, params)
 			}
 
+			if tc.enableSweep {
+				params := suite.app.Erc20Keeper.GetParams(suite.ctx)
+				params.EnableIBCReceiveSweep = true
+				suite.app.Erc20Keeper.SetParams(suite.ctx, params)
+			}
+
 			if tc.disableTokenPair {
 				_, err := suite.app.Erc20Keeper.ToggleConversion(suite.ctx, pair.Denom)
 				suite.Require().NoError(err)
@@ -378,6 +408,161 @@ func (suite *KeeperTestSuite) TestOnRecvPacket() {
 	}
 }
 
+func (suite *KeeperTestSuite) TestOnRecvPacketMemo() {
+	senderAddr := sdk.AccAddress(secp256k1.GenPrivKey().PubKey().Address())
+	receiverAddr := sdk.AccAddress(tests.GenerateAddress().Bytes())
+	memoReceiver := tests.GenerateAddress()
+
+	sourceChannel := "channel-292"
+	evmosChannel := claimstypes.DefaultAuthorizedChannels[1]
+	timeoutHeight := clienttypes.NewHeight(0, 100)
+	expAck := ibcmock.MockAcknowledgement
+
+	registeredDenom := cosmosTokenBase
+	prefixedDenom := transfertypes.GetDenomPrefix(transfertypes.PortID, sourceChannel) + registeredDenom
+	coins := sdk.NewCoins(sdk.NewCoin(registeredDenom, sdk.NewInt(1000)))
+
+	testCases := []struct {
+		name              string
+		memo              string
+		enableSweep       bool
+		ackSuccess        bool
+		expReceiverERC20s int64
+		expMemoERC20s     int64
+		expCoins          int64
+	}{
+		{
+			"convert received coins - empty memo",
+			"",
+			false,
+			true,
+			500,
+			0,
+			500,
+		},
+		{
+			"convert received coins - plain text memo",
+			"{not json}",
+			false,
+			true,
+			500,
+			0,
+			500,
+		},
+		{
+			"convert received coins - memo for another middleware",
+			`{"wasm":{"contract":"evmos1"}}`,
+			false,
+			true,
+			500,
+			0,
+			500,
+		},
+		{
+			"no-op - conversion skipped",
+			`{"erc20":{"skip_conversion":true}}`,
+			false,
+			true,
+			0,
+			0,
+			1000,
+		},
+		{
+			"convert received coins - memo receiver",
+			fmt.Sprintf(`{"erc20":{"receiver":"%s"}}`, memoReceiver),
+			false,
+			true,
+			0,
+			500,
+			500,
+		},
+		{
+			"convert received coins - memo receiver with sweep enabled",
+			fmt.Sprintf(`{"erc20":{"receiver":"%s"}}`, memoReceiver),
+			true,
+			true,
+			0,
+			500,
+			500,
+		},
+		{
+			"error - invalid memo receiver",
+			`{"erc20":{"receiver":"0x0000000000000000000000000000000000000000"}}`,
+			false,
+			false,
+			0,
+			0,
+			1000,
+		},
+		{
+			"error - unknown memo field",
+			`{"erc20":{"recipient":"0x0000000000000000000000000000000000000001"}}`,
+			false,
+			false,
+			0,
+			0,
+			1000,
+		},
+	}
+	for _, tc := range testCases {
+		suite.Run(fmt.Sprintf("Case %s", tc.name), func() {
+			suite.mintFeeCollector = true
+			suite.SetupTest() // reset
+
+			transfer := transfertypes.NewFungibleTokenPacketData(prefixedDenom, "500", senderAddr.String(), receiverAddr.String())
+			transfer.Memo = tc.memo
+			bz := transfertypes.ModuleCdc.MustMarshalJSON(&transfer)
+			packet := channeltypes.NewPacket(bz, 100, transfertypes.PortID, sourceChannel, transfertypes.PortID, evmosChannel, timeoutHeight, 0)
+
+			// the received coins are already on the receiver account
+			err := testutil.FundAccount(suite.ctx, suite.app.BankKeeper, receiverAddr, coins)
+			suite.Require().NoError(err)
+
+			pair := suite.setupRegisterCoin(metadataCoin)
+			suite.Require().NotNil(pair)
+
+			params := suite.app.Erc20Keeper.GetParams(suite.ctx)
+			params.EnableIBCReceiveSweep = tc.enableSweep
+			suite.app.Erc20Keeper.SetParams(suite.ctx, params)
+
+			ack := suite.app.Erc20Keeper.OnRecvPacket(suite.ctx, packet, expAck)
+			if tc.ackSuccess {
+				suite.Require().True(ack.Success(), string(ack.Acknowledgement()))
+				suite.Require().Equal(expAck, ack)
+			} else {
+				suite.Require().False(ack.Success(), string(ack.Acknowledgement()))
+			}
+
+			erc20 := contracts.ERC20MinterBurnerDecimalsContract.ABI
+			balance := suite.app.Erc20Keeper.BalanceOf(suite.ctx, erc20, pair.GetERC20Contract(), common.BytesToAddress(receiverAddr))
+			suite.Require().Equal(tc.expReceiverERC20s, balance.Int64())
+
+			balance = suite.app.Erc20Keeper.BalanceOf(suite.ctx, erc20, pair.GetERC20Contract(), memoReceiver)
+			suite.Require().Equal(tc.expMemoERC20s, balance.Int64())
+
+			coin := suite.app.BankKeeper.GetBalance(suite.ctx, receiverAddr, registeredDenom)
+			suite.Require().Equal(tc.expCoins, coin.Amount.Int64())
+
+			converted := ""
+			for _, event := range suite.ctx.EventManager().Events() {
+				if event.Type != types.EventTypeIBCReceiveConversion {
+					continue
+				}
+				for _, attr := range event.Attributes {
+					if string(attr.Key) == types.AttributeKeyConverted {
+						converted = string(attr.Value)
+					}
+				}
+			}
+			if expConverted := tc.expReceiverERC20s + tc.expMemoERC20s; expConverted > 0 {
+				suite.Require().Equal(fmt.Sprint(expConverted), converted)
+			} else {
+				suite.Require().Empty(converted)
+			}
+		})
+	}
+}
+
 func (suite *KeeperTestSuite) TestConvertCoinToERC20FromPacket() {
 	senderAddr := "evmos1x2w87cvt5mqjncav4lxy8yfreynn273xn5335v"
 
@@ -362,7 +362,7 @@ var _ = Describe("ERC20:", Ordered, func() {
 })
 
 func submitRegisterCoinProposal(ctx sdk.Context, appEvmos *app.Evmos, pk *ethsecp256k1.PrivKey, metadata []banktypes.Metadata) (id uint64, err error) {
-	content := types.NewRegisterCoinProposal("test Coin", "foo", metadata...)
+	content := types.NewRegisterCoinProposal("test Coin", "foo", "", metadata...)
 	return testutil.SubmitProposal(ctx, appEvmos, pk, content, 8)
 }
 
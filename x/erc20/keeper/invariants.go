@@ -0,0 +1,150 @@
+package keeper
+
+import (
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/evmos/evmos/v10/contracts"
+	"github.com/evmos/evmos/v10/x/erc20/types"
+)
+
+// RegisterInvariants registers the erc20 module invariants
+func RegisterInvariants(ir sdk.InvariantRegistry, k Keeper) {
+	ir.RegisterRoute(types.ModuleName, "escrow-backing", k.EscrowBackingInvariant())
+	ir.RegisterRoute(types.ModuleName, "native-coin-supply", k.NativeCoinSupplyInvariant())
+	ir.RegisterRoute(types.ModuleName, "native-erc20-supply", k.NativeERC20SupplyInvariant())
+}
+
+// EscrowBackingInvariant checks that every coin held by the module account is
+// either a registration deposit or escrowed for a registered token pair with a
+// native Cosmos coin, i.e no escrow is left behind by a deregistered pair.
+func (k Keeper) EscrowBackingInvariant() sdk.Invariant {
+	return func(ctx sdk.Context) (string, bool) {
+		var (
+			msg    string
+			broken bool
+		)
+
+		deposits := sdk.Coins{}
+		k.IterateTokenPairDeposits(ctx, func(deposit types.TokenPairDeposit) (stop bool) {
+			deposits = deposits.Add(deposit.Amount...)
+			return false
+		})
+
+		moduleAddr := k.accountKeeper.GetModuleAddress(types.ModuleName)
+		balances := k.bankKeeper.GetAllBalances(ctx, moduleAddr)
+
+		if !balances.IsAllGTE(deposits) {
+			broken = true
+			msg += fmt.Sprintf("\tmodule balance %s does not cover registration deposits %s\n", balances, deposits)
+		}
+
+		for _, balance := range balances {
+			escrow := balance.Amount.Sub(deposits.AmountOf(balance.Denom))
+			if !escrow.IsPositive() {
+				continue
+			}
+
+			pair, found := k.GetTokenPair(ctx, k.GetDenomMap(ctx, balance.Denom))
+			if !found || !pair.IsNativeCoin() {
+				broken = true
+				msg += fmt.Sprintf("\t%s%s escrowed without a registered native coin token pair\n", escrow, balance.Denom)
+			}
+		}
+
+		return sdk.FormatInvariant(types.ModuleName, "escrow-backing", msg), broken
+	}
+}
+
+// NativeCoinSupplyInvariant checks that, for every token pair with a native
+// Cosmos coin, the coins escrowed on the module account back the total supply
+// of the ERC20 token minted by the module.
+//
+// NOTE: the escrowed coins are allowed to exceed the ERC20 supply, as any holder
+// can burn its tokens with the public burn methods of the ERC20 contract.
+func (k Keeper) NativeCoinSupplyInvariant() sdk.Invariant {
+	return func(ctx sdk.Context) (string, bool) {
+		var (
+			msg    string
+			broken bool
+		)
+
+		erc20 := contracts.ERC20MinterBurnerDecimalsContract.ABI
+
+		k.IterateTokenPairs(ctx, func(pair types.TokenPair) (stop bool) {
+			if !pair.IsNativeCoin() {
+				return false
+			}
+
+			escrow := k.GetEscrowedBalance(ctx, pair.Denom)
+			supply := k.TotalSupply(ctx, erc20, pair.GetERC20Contract())
+
+			switch {
+			case supply == nil:
+				broken = true
+				msg += fmt.Sprintf("\t%s: failed to retrieve ERC20 total supply of %s\n", pair.Denom, pair.Erc20Address)
+			case escrow.Amount.BigInt().Cmp(supply) < 0:
+				broken = true
+				msg += fmt.Sprintf(
+					"\t%s: escrowed coins %s, ERC20 total supply of %s %s\n",
+					pair.Denom, escrow.Amount, pair.Erc20Address, supply,
+				)
+			}
+
+			return false
+		})
+
+		return sdk.FormatInvariant(types.ModuleName, "native-coin-supply", msg), broken
+	}
+}
+
+// NativeERC20SupplyInvariant checks that, for every token pair with a native
+// ERC20 token, the Cosmos coin supply minted by the module is backed by the
+// ERC20 tokens escrowed on the module address.
+//
+// NOTE: the escrowed balance is allowed to exceed the coin supply, as anyone
+// can transfer tokens to the module address without converting them.
+//
+// Token pairs registered permissionlessly with a deposit are not checked, as
+// their contracts are not reviewed by governance and could report arbitrary
+// balances to halt the chain. Pairs whose escrowed balance can't be retrieved,
+// e.g. because the contract is paused, are skipped as well.
+func (k Keeper) NativeERC20SupplyInvariant() sdk.Invariant {
+	return func(ctx sdk.Context) (string, bool) {
+		var (
+			msg    string
+			broken bool
+		)
+
+		erc20 := contracts.ERC20MinterBurnerDecimalsContract.ABI
+
+		k.IterateTokenPairs(ctx, func(pair types.TokenPair) (stop bool) {
+			if !pair.IsNativeERC20() {
+				return false
+			}
+
+			if _, found := k.GetTokenPairDeposit(ctx, pair.GetERC20Contract()); found {
+				return false
+			}
+
+			supply := k.bankKeeper.GetSupply(ctx, pair.Denom)
+			escrow := k.BalanceOf(ctx, erc20, pair.GetERC20Contract(), types.ModuleAddress)
+			if escrow == nil {
+				return false
+			}
+
+			if escrow.Cmp(supply.Amount.BigInt()) < 0 {
+				broken = true
+				msg += fmt.Sprintf(
+					"\t%s: coin supply %s, escrowed ERC20 balance of %s %s\n",
+					pair.Denom, supply.Amount, pair.Erc20Address, escrow,
+				)
+			}
+
+			return false
+		})
+
+		return sdk.FormatInvariant(types.ModuleName, "native-erc20-supply", msg), broken
+	}
+}
@@ -0,0 +1,223 @@
+package keeper_test
+
+import (
+	"math/big"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/ethereum/go-ethereum/common"
+
+	"github.com/evmos/ethermint/tests"
+
+	"github.com/evmos/evmos/v10/contracts"
+	"github.com/evmos/evmos/v10/testutil"
+	"github.com/evmos/evmos/v10/x/erc20/types"
+)
+
+func (suite *KeeperTestSuite) TestEscrowBackingInvariant() {
+	coins := sdk.NewCoins(sdk.NewCoin(cosmosTokenBase, sdk.NewInt(100)))
+
+	testCases := []struct {
+		name      string
+		malleate  func()
+		expBroken bool
+	}{
+		{
+			"no escrow",
+			func() {},
+			false,
+		},
+		{
+			"escrow for a registered native coin pair",
+			func() {
+				suite.setupRegisterCoin(metadataCoin)
+				err := testutil.FundModuleAccount(suite.ctx, suite.app.BankKeeper, types.ModuleName, coins)
+				suite.Require().NoError(err)
+			},
+			false,
+		},
+		{
+			"registration deposit",
+			func() {
+				contract := suite.setupRegisterERC20Pair(contractMinterBurner)
+				deposit := sdk.NewCoins(sdk.NewCoin("aevmos", sdk.NewInt(1000)))
+				err := testutil.FundModuleAccount(suite.ctx, suite.app.BankKeeper, types.ModuleName, deposit)
+				suite.Require().NoError(err)
+
+				depositor := sdk.AccAddress(tests.GenerateAddress().Bytes())
+				suite.app.Erc20Keeper.SetTokenPairDeposit(suite.ctx, types.NewTokenPairDeposit(contract, depositor, deposit))
+			},
+			false,
+		},
+		{
+			"invariant broken - deposit not covered by the module balance",
+			func() {
+				contract := suite.setupRegisterERC20Pair(contractMinterBurner)
+				deposit := sdk.NewCoins(sdk.NewCoin("aevmos", sdk.NewInt(1000)))
+
+				depositor := sdk.AccAddress(tests.GenerateAddress().Bytes())
+				suite.app.Erc20Keeper.SetTokenPairDeposit(suite.ctx, types.NewTokenPairDeposit(contract, depositor, deposit))
+			},
+			true,
+		},
+		{
+			"invariant broken - escrow without a registered pair",
+			func() {
+				err := testutil.FundModuleAccount(suite.ctx, suite.app.BankKeeper, types.ModuleName, coins)
+				suite.Require().NoError(err)
+			},
+			true,
+		},
+	}
+
+	for _, tc := range testCases {
+		suite.Run(tc.name, func() {
+			suite.SetupTest() // reset
+
+			tc.malleate()
+
+			_, broken := suite.app.Erc20Keeper.EscrowBackingInvariant()(suite.ctx)
+			suite.Require().Equal(tc.expBroken, broken)
+		})
+	}
+}
+
+func (suite *KeeperTestSuite) TestNativeCoinSupplyInvariant() {
+	sender := sdk.AccAddress(tests.GenerateAddress().Bytes())
+
+	testCases := []struct {
+		name      string
+		malleate  func(pair *types.TokenPair)
+		expBroken bool
+	}{
+		{
+			"no conversions",
+			func(*types.TokenPair) {},
+			false,
+		},
+		{
+			"escrow backs converted coins",
+			func(*types.TokenPair) {
+				coins := sdk.NewCoins(sdk.NewCoin(cosmosTokenBase, sdk.NewInt(100)))
+				err := testutil.FundAccount(suite.ctx, suite.app.BankKeeper, sender, coins)
+				suite.Require().NoError(err)
+
+				msg := types.NewMsgConvertCoin(sdk.NewCoin(cosmosTokenBase, sdk.NewInt(10)), suite.address, sender)
+				_, err = suite.app.Erc20Keeper.ConvertCoin(sdk.WrapSDKContext(suite.ctx), msg)
+				suite.Require().NoError(err)
+			},
+			false,
+		},
+		{
+			"escrow exceeds ERC20 supply burned by the holder",
+			func(pair *types.TokenPair) {
+				coins := sdk.NewCoins(sdk.NewCoin(cosmosTokenBase, sdk.NewInt(100)))
+				err := testutil.FundAccount(suite.ctx, suite.app.BankKeeper, sender, coins)
+				suite.Require().NoError(err)
+
+				holder := common.BytesToAddress(sender.Bytes())
+				msg := types.NewMsgConvertCoin(sdk.NewCoin(cosmosTokenBase, sdk.NewInt(10)), holder, sender)
+				_, err = suite.app.Erc20Keeper.ConvertCoin(sdk.WrapSDKContext(suite.ctx), msg)
+				suite.Require().NoError(err)
+
+				abi := contracts.ERC20MinterBurnerDecimalsContract.ABI
+				_, err = suite.app.Erc20Keeper.CallEVM(suite.ctx, abi, holder, pair.GetERC20Contract(), true, "burn", big.NewInt(5))
+				suite.Require().NoError(err)
+			},
+			false,
+		},
+		{
+			"invariant broken - ERC20 supply without escrow",
+			func(pair *types.TokenPair) {
+				abi := contracts.ERC20MinterBurnerDecimalsContract.ABI
+				_, err := suite.app.Erc20Keeper.CallEVM(suite.ctx, abi, types.ModuleAddress, pair.GetERC20Contract(), true, "mint", suite.address, big.NewInt(10))
+				suite.Require().NoError(err)
+			},
+			true,
+		},
+	}
+
+	for _, tc := range testCases {
+		suite.Run(tc.name, func() {
+			suite.mintFeeCollector = true
+			suite.SetupTest() // reset
+
+			pair := suite.setupRegisterCoin(metadataCoin)
+			tc.malleate(pair)
+
+			_, broken := suite.app.Erc20Keeper.NativeCoinSupplyInvariant()(suite.ctx)
+			suite.Require().Equal(tc.expBroken, broken)
+		})
+	}
+	suite.mintFeeCollector = false
+}
+
+func (suite *KeeperTestSuite) TestNativeERC20SupplyInvariant() {
+	sender := sdk.AccAddress(tests.GenerateAddress().Bytes())
+
+	testCases := []struct {
+		name      string
+		malleate  func(contract common.Address)
+		expBroken bool
+	}{
+		{
+			"no conversions",
+			func(common.Address) {},
+			false,
+		},
+		{
+			"escrow backs converted tokens",
+			func(contract common.Address) {
+				msg := types.NewMsgConvertERC20(sdk.NewInt(10), sender, contract, suite.address)
+				_, err := suite.app.Erc20Keeper.ConvertERC20(sdk.WrapSDKContext(suite.ctx), msg)
+				suite.Require().NoError(err)
+			},
+			false,
+		},
+		{
+			"tokens transferred to the module address without converting",
+			func(contract common.Address) {
+				suite.TransferERC20Token(contract, suite.address, types.ModuleAddress, big.NewInt(10))
+				suite.Commit()
+			},
+			false,
+		},
+		{
+			"invariant broken - coin supply without escrow",
+			func(contract common.Address) {
+				coins := sdk.NewCoins(sdk.NewCoin(types.CreateDenom(contract.String()), sdk.NewInt(10)))
+				err := testutil.FundAccount(suite.ctx, suite.app.BankKeeper, sender, coins)
+				suite.Require().NoError(err)
+			},
+			true,
+		},
+		{
+			"permissionless pair with coin supply without escrow",
+			func(contract common.Address) {
+				deposit := sdk.NewCoins(sdk.NewCoin("aevmos", sdk.NewInt(1000)))
+				suite.app.Erc20Keeper.SetTokenPairDeposit(suite.ctx, types.NewTokenPairDeposit(contract, sender, deposit))
+
+				coins := sdk.NewCoins(sdk.NewCoin(types.CreateDenom(contract.String()), sdk.NewInt(10)))
+				err := testutil.FundAccount(suite.ctx, suite.app.BankKeeper, sender, coins)
+				suite.Require().NoError(err)
+			},
+			false,
+		},
+	}
+
+	for _, tc := range testCases {
+		suite.Run(tc.name, func() {
+			suite.mintFeeCollector = true
+			suite.SetupTest() // reset
+
+			contract := suite.setupRegisterERC20Pair(contractMinterBurner)
+			suite.MintERC20Token(contract, suite.address, suite.address, big.NewInt(100))
+			suite.Commit()
+
+			tc.malleate(contract)
+
+			_, broken := suite.app.Erc20Keeper.NativeERC20SupplyInvariant()(suite.ctx)
+			suite.Require().Equal(tc.expBroken, broken)
+		})
+	}
+	suite.mintFeeCollector = false
+}
@@ -20,6 +20,7 @@ type Keeper struct {
 
 	accountKeeper types.AccountKeeper
 	bankKeeper    types.BankKeeper
+	nftKeeper     types.NFTKeeper
 	evmKeeper     types.EVMKeeper
 	stakingKeeper types.StakingKeeper
 	claimsKeeper  types.ClaimsKeeper
@@ -32,6 +33,7 @@ func NewKeeper(
 	ps paramtypes.Subspace,
 	ak types.AccountKeeper,
 	bk types.BankKeeper,
+	nk types.NFTKeeper,
 	evmKeeper types.EVMKeeper,
 	sk types.StakingKeeper,
 	ck types.ClaimsKeeper,
@@ -47,6 +49,7 @@ func NewKeeper(
 		paramstore:    ps,
 		accountKeeper: ak,
 		bankKeeper:    bk,
+		nftKeeper:     nk,
 		evmKeeper:     evmKeeper,
 		stakingKeeper: sk,
 		claimsKeeper:  ck,
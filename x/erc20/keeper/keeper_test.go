@@ -284,10 +284,10 @@ func (suite *KeeperTestSuite) SetupIBCTest() {
 
 	// we need some coins in the bankkeeper to be able to register the coins later
 	coins = sdk.NewCoins(sdk.NewCoin(teststypes.UosmoIbcdenom, sdk.NewInt(100)))
-	err = s.app.BankKeeper.MintCoins(s.EvmosChain.GetContext(), types.ModuleName, coins)
+	err = s.app.BankKeeper.MintCoins(s.EvmosChain.GetContext(), inflationtypes.ModuleName, coins)
 	s.Require().NoError(err)
 	coins = sdk.NewCoins(sdk.NewCoin(teststypes.UatomIbcdenom, sdk.NewInt(100)))
-	err = s.app.BankKeeper.MintCoins(s.EvmosChain.GetContext(), types.ModuleName, coins)
+	err = s.app.BankKeeper.MintCoins(s.EvmosChain.GetContext(), inflationtypes.ModuleName, coins)
 	s.Require().NoError(err)
 
 	// Mint coins on the osmosis side which we'll use to unlock our aevmos
@@ -704,6 +704,26 @@ func (b *MockBankKeeper) GetBalance(ctx sdk.Context, addr sdk.AccAddress, denom
 	return args.Get(0).(sdk.Coin)
 }
 
+func (b *MockBankKeeper) GetAllBalances(ctx sdk.Context, addr sdk.AccAddress) sdk.Coins {
+	args := b.Called(mock.Anything, mock.Anything)
+	return args.Get(0).(sdk.Coins)
+}
+
+func (b *MockBankKeeper) SpendableCoins(ctx sdk.Context, addr sdk.AccAddress) sdk.Coins {
+	args := b.Called(mock.Anything, mock.Anything)
+	return args.Get(0).(sdk.Coins)
+}
+
+func (b *MockBankKeeper) GetSupply(ctx sdk.Context, denom string) sdk.Coin {
+	args := b.Called(mock.Anything, mock.Anything)
+	return args.Get(0).(sdk.Coin)
+}
+
+func (b *MockBankKeeper) DenomOwners(goCtx context.Context, req *banktypes.QueryDenomOwnersRequest) (*banktypes.QueryDenomOwnersResponse, error) {
+	args := b.Called(mock.Anything, mock.Anything)
+	return args.Get(0).(*banktypes.QueryDenomOwnersResponse), args.Error(1)
+}
+
 // DeployContract deploys the ERC20MinterBurnerDecimalsContract.
 func (suite *KeeperTestSuite) DeployContractToChain(name, symbol string, decimals uint8) (common.Address, error) {
 	ctx := sdk.WrapSDKContext(s.EvmosChain.GetContext())
@@ -5,9 +5,13 @@ import (
 	"github.com/cosmos/cosmos-sdk/types/module"
 
 	v2 "github.com/evmos/evmos/v10/x/erc20/migrations/v2"
+	v3 "github.com/evmos/evmos/v10/x/erc20/migrations/v3"
 )
 
-var _ module.MigrationHandler = Migrator{}.Migrate1to2
+var (
+	_ module.MigrationHandler = Migrator{}.Migrate1to2
+	_ module.MigrationHandler = Migrator{}.Migrate2to3
+)
 
 // Migrator is a struct for handling in-place store migrations.
 type Migrator struct {
@@ -25,3 +29,12 @@ func NewMigrator(keeper Keeper) Migrator {
 func (m Migrator) Migrate1to2(ctx sdk.Context) error {
 	return v2.UpdateParams(ctx, &m.keeper.paramstore)
 }
+
+// Migrate2to3 migrates from consensus version 2 to 3.
+func (m Migrator) Migrate2to3(ctx sdk.Context) error {
+	if err := v3.UpdateParams(ctx, &m.keeper.paramstore); err != nil {
+		return err
+	}
+
+	return v3.MigrateTokenPairTemplates(ctx, m.keeper.storeKey, m.keeper.cdc)
+}
@@ -44,19 +44,53 @@ func (k Keeper) ConvertCoin(
 			"deleting selfdestructed token pair from state",
 			"contract", pair.Erc20Address,
 		)
+		if err := k.RefundTokenPairDeposit(ctx, erc20); err != nil {
+			k.Logger(ctx).Error(
+				"failed to refund deposit of selfdestructed token pair",
+				"contract", pair.Erc20Address, "error", err.Error(),
+			)
+		}
 		// NOTE: return nil error to persist the changes from the deletion
 		return nil, nil
 	}
 
+	// reject the conversion if it exceeds the remaining volume of the rate limit
+	// of the pair, so that the requested amount is either fully converted or not
+	// at all
+	cacheCtx, writeCache := ctx.CacheContext()
+	accepted, err := k.TrackConversion(cacheCtx, pair, types.CoinToERC20, msg.Coin.Amount)
+	if err != nil {
+		return nil, err
+	}
+
+	if accepted.LT(msg.Coin.Amount) {
+		return nil, errorsmod.Wrapf(
+			types.ErrRateLimitExceeded,
+			"conversion of %s exceeds the remaining volume of the rate limit of the pair: %s",
+			msg.Coin, accepted,
+		)
+	}
+
+	writeCache()
+
+	var res *types.MsgConvertCoinResponse
+
 	// Check ownership and execute conversion
 	switch {
 	case pair.IsNativeCoin():
-		return k.convertCoinNativeCoin(ctx, pair, msg, receiver, sender) // case 1.1
+		res, err = k.convertCoinNativeCoin(ctx, pair, msg, receiver, sender) // case 1.1
 	case pair.IsNativeERC20():
-		return k.convertCoinNativeERC20(ctx, pair, msg, receiver, sender) // case 2.2
+		res, err = k.convertCoinNativeERC20(ctx, pair, msg, receiver, sender) // case 2.2
 	default:
-		return nil, types.ErrUndefinedOwner
+		err = types.ErrUndefinedOwner
+	}
+
+	if err != nil {
+		return nil, err
 	}
+
+	k.AddConvertedVolume(ctx, pair, types.CoinToERC20, msg.Coin.Amount)
+	return res, nil
 }
 
 // ConvertERC20 converts ERC20 tokens into native Cosmos coins for both
@@ -86,19 +120,295 @@ func (k Keeper) ConvertERC20(
 			"deleting selfdestructed token pair from state",
 			"contract", pair.Erc20Address,
 		)
+		if err := k.RefundTokenPairDeposit(ctx, erc20); err != nil {
+			k.Logger(ctx).Error(
+				"failed to refund deposit of selfdestructed token pair",
+				"contract", pair.Erc20Address, "error", err.Error(),
+			)
+		}
 		// NOTE: return nil error to persist the changes from the deletion
 		return nil, nil
 	}
 
+	// reject the conversion if it exceeds the remaining volume of the rate limit
+	// of the pair, so that the requested amount is either fully converted or not
+	// at all
+	cacheCtx, writeCache := ctx.CacheContext()
+	accepted, err := k.TrackConversion(cacheCtx, pair, types.ERC20ToCoin, msg.Amount)
+	if err != nil {
+		return nil, err
+	}
+
+	if accepted.LT(msg.Amount) {
+		return nil, errorsmod.Wrapf(
+			types.ErrRateLimitExceeded,
+			"conversion of %s %s exceeds the remaining volume of the rate limit of the pair: %s",
+			msg.Amount, pair.Denom, accepted,
+		)
+	}
+
+	writeCache()
+
+	var res *types.MsgConvertERC20Response
+
 	// Check ownership and execute conversion
 	switch {
 	case pair.IsNativeCoin():
-		return k.convertERC20NativeCoin(ctx, pair, msg, receiver, sender) // case 1.2
+		res, err = k.convertERC20NativeCoin(ctx, pair, msg, receiver, sender) // case 1.2
 	case pair.IsNativeERC20():
-		return k.convertERC20NativeToken(ctx, pair, msg, receiver, sender) // case 2.1
+		res, err = k.convertERC20NativeToken(ctx, pair, msg, receiver, sender) // case 2.1
 	default:
-		return nil, types.ErrUndefinedOwner
+		err = types.ErrUndefinedOwner
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	k.AddConvertedVolume(ctx, pair, types.ERC20ToCoin, msg.Amount)
+	return res, nil
+}
+
+// ConvertCoins converts multiple native Cosmos coins into ERC20 tokens. The
+// conversions are applied atomically: if any of the coins fails to convert, e.g.
+// because it exceeds the remaining volume of the rate limit of its pair, none of
+// the conversions is persisted.
+func (k Keeper) ConvertCoins(
+	goCtx context.Context,
+	msg *types.MsgConvertCoins,
+) (*types.MsgConvertCoinsResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	cacheCtx, writeCache := ctx.CacheContext()
+
+	// Error checked during msg validation
+	receiver := common.HexToAddress(msg.Receiver)
+	sender := sdk.MustAccAddressFromBech32(msg.Sender)
+
+	for _, coin := range msg.Coins {
+		res, err := k.ConvertCoin(
+			sdk.WrapSDKContext(cacheCtx),
+			types.NewMsgConvertCoin(coin, receiver, sender),
+		)
+		if err != nil {
+			return nil, errorsmod.Wrapf(err, "failed to convert %s", coin)
+		}
+
+		// a nil response without error means the token pair was removed
+		if res == nil {
+			return nil, errorsmod.Wrapf(
+				types.ErrTokenPairNotFound, "token pair for %s was removed as its contract selfdestructed", coin.Denom,
+			)
+		}
+	}
+
+	denoms := make([]string, len(msg.Coins))
+	for i, coin := range msg.Coins {
+		denoms[i] = coin.Denom
+	}
+
+	balances, err := k.getTokenPairBalances(cacheCtx, denoms, sender, receiver)
+	if err != nil {
+		return nil, err
+	}
+
+	writeCache()
+
+	return &types.MsgConvertCoinsResponse{Balances: balances}, nil
+}
+
+// ConvertERC20S converts multiple ERC20 tokens into native Cosmos coins. The
+// conversions are applied atomically: if any of the tokens fails to convert,
+// e.g. because it exceeds the remaining volume of the rate limit of its pair,
+// none of the conversions is persisted.
+func (k Keeper) ConvertERC20S(
+	goCtx context.Context,
+	msg *types.MsgConvertERC20S,
+) (*types.MsgConvertERC20SResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	cacheCtx, writeCache := ctx.CacheContext()
+
+	// Error checked during msg validation
+	receiver := sdk.MustAccAddressFromBech32(msg.Receiver)
+	sender := common.HexToAddress(msg.Sender)
+
+	tokens := make([]string, len(msg.Tokens))
+	for i, token := range msg.Tokens {
+		contract := common.HexToAddress(token.ContractAddress)
+		res, err := k.ConvertERC20(
+			sdk.WrapSDKContext(cacheCtx),
+			types.NewMsgConvertERC20(token.Amount, receiver, contract, sender),
+		)
+		if err != nil {
+			return nil, errorsmod.Wrapf(err, "failed to convert %s tokens of %s", token.Amount, token.ContractAddress)
+		}
+
+		// a nil response without error means the token pair was removed
+		if res == nil {
+			return nil, errorsmod.Wrapf(
+				types.ErrTokenPairNotFound, "token pair for %s was removed as its contract selfdestructed", token.ContractAddress,
+			)
+		}
+
+		tokens[i] = token.ContractAddress
+	}
+
+	balances, err := k.getTokenPairBalances(cacheCtx, tokens, receiver, sender)
+	if err != nil {
+		return nil, err
+	}
+
+	writeCache()
+
+	return &types.MsgConvertERC20SResponse{Balances: balances}, nil
+}
+
+// getTokenPairBalances returns the Cosmos coin balance of the bech32 account
+// and the ERC20 token balance of the hex account for each of the given tokens
+func (k Keeper) getTokenPairBalances(
+	ctx sdk.Context,
+	tokens []string,
+	cosmosAddr sdk.AccAddress,
+	evmAddr common.Address,
+) ([]types.TokenPairBalance, error) {
+	erc20 := contracts.ERC20MinterBurnerDecimalsContract.ABI
+	balances := make([]types.TokenPairBalance, len(tokens))
+
+	for i, token := range tokens {
+		pair, found := k.GetTokenPair(ctx, k.GetTokenPairID(ctx, token))
+		if !found {
+			return nil, errorsmod.Wrapf(types.ErrTokenPairNotFound, "token '%s' not registered", token)
+		}
+
+		balanceToken := k.BalanceOf(ctx, erc20, pair.GetERC20Contract(), evmAddr)
+		if balanceToken == nil {
+			return nil, errorsmod.Wrap(types.ErrEVMCall, "failed to retrieve balance")
+		}
+
+		balances[i] = types.TokenPairBalance{
+			Denom:         pair.Denom,
+			Erc20Address:  pair.Erc20Address,
+			CosmosBalance: k.bankKeeper.GetBalance(ctx, cosmosAddr, pair.Denom).Amount,
+			Erc20Balance:  sdk.NewIntFromBigInt(balanceToken),
+		}
+	}
+
+	return balances, nil
+}
+
+// RegisterERC20WithDeposit registers a token pair for an ERC20 contract
+// without a governance proposal. The registration deposit defined in the
+// module params is escrowed on the module account until the pair is removed.
+func (k Keeper) RegisterERC20WithDeposit(
+	goCtx context.Context,
+	msg *types.MsgRegisterERC20,
+) (*types.MsgRegisterERC20Response, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	params := k.GetParams(ctx)
+	if !params.EnableErc20 {
+		return nil, errorsmod.Wrap(
+			types.ErrERC20Disabled, "registration is currently disabled by governance",
+		)
+	}
+
+	if !params.EnablePermissionlessRegistration {
+		return nil, errorsmod.Wrap(
+			types.ErrRegistrationDisabled, "registration without a proposal is currently disabled by governance",
+		)
+	}
+
+	// Error checked during msg validation
+	sender := sdk.MustAccAddressFromBech32(msg.Sender)
+	contract := common.HexToAddress(msg.ContractAddress)
+
+	// Escrow the deposit on module account
+	if !params.RegistrationDeposit.IsZero() {
+		if err := k.bankKeeper.SendCoinsFromAccountToModule(ctx, sender, types.ModuleName, params.RegistrationDeposit); err != nil {
+			return nil, errorsmod.Wrap(err, "failed to escrow registration deposit")
+		}
+	}
+
+	pair, err := k.RegisterERC20(ctx, contract)
+	if err != nil {
+		return nil, err
+	}
+
+	k.SetTokenPairDeposit(ctx, types.NewTokenPairDeposit(contract, sender, params.RegistrationDeposit))
+
+	ctx.EventManager().EmitEvents(
+		sdk.Events{
+			sdk.NewEvent(
+				types.EventTypeRegisterERC20,
+				sdk.NewAttribute(sdk.AttributeKeySender, msg.Sender),
+				sdk.NewAttribute(sdk.AttributeKeyAmount, params.RegistrationDeposit.String()),
+				sdk.NewAttribute(types.AttributeKeyCosmosCoin, pair.Denom),
+				sdk.NewAttribute(types.AttributeKeyERC20Token, pair.Erc20Address),
+			),
+		},
+	)
+
+	return &types.MsgRegisterERC20Response{}, nil
+}
+
+// DeregisterERC20 removes a token pair registered with MsgRegisterERC20 from
+// the token mapping on behalf of its depositor and refunds the registration
+// deposit. Unlike governance, the depositor can't convert the coins of other
+// holders, so the pair can only be deregistered once no Cosmos coin of the
+// pair is left in circulation.
+func (k Keeper) DeregisterERC20(
+	goCtx context.Context,
+	msg *types.MsgDeregisterERC20,
+) (*types.MsgDeregisterERC20Response, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	// Error checked during msg validation
+	contract := common.HexToAddress(msg.ContractAddress)
+
+	deposit, found := k.GetTokenPairDeposit(ctx, contract)
+	if !found {
+		return nil, errorsmod.Wrapf(
+			types.ErrDepositNotFound, "token '%s' not registered with a deposit", contract,
+		)
+	}
+
+	if deposit.Depositor != msg.Sender {
+		return nil, errorsmod.Wrapf(
+			errortypes.ErrUnauthorized, "%s is not the depositor of token '%s'", msg.Sender, contract,
+		)
+	}
+
+	pair, found := k.GetTokenPair(ctx, k.GetERC20Map(ctx, contract))
+	if !found {
+		return nil, errorsmod.Wrapf(
+			types.ErrTokenPairNotFound, "token '%s' not registered", contract,
+		)
+	}
+
+	supply := k.bankKeeper.GetSupply(ctx, pair.Denom)
+	if supply.IsPositive() {
+		return nil, errorsmod.Wrapf(
+			types.ErrUnwindTokenPair,
+			"coin supply is %s, holders must convert their coins back to %s before deregistration",
+			supply, contract,
+		)
 	}
+
+	pair, unwound, err := k.DeregisterTokenPair(ctx, contract.String())
+	if err != nil {
+		return nil, err
+	}
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeDeregisterTokenPair,
+			sdk.NewAttribute(sdk.AttributeKeySender, msg.Sender),
+			sdk.NewAttribute(types.AttributeKeyCosmosCoin, pair.Denom),
+			sdk.NewAttribute(types.AttributeKeyERC20Token, pair.Erc20Address),
+			sdk.NewAttribute(sdk.AttributeKeyAmount, unwound.String()),
+		),
+	)
+
+	return &types.MsgDeregisterERC20Response{}, nil
 }
 
 // convertCoinNativeCoin handles the coin conversion for a native Cosmos coin
@@ -516,3 +826,42 @@ func (k Keeper) convertCoinNativeERC20(
 
 	return &types.MsgConvertCoinResponse{}, nil
 }
+
+// RefreshTokenPairMetadata updates the bank metadata of an externally owned
+// token pair with the current details of its ERC20 contract
+func (k Keeper) RefreshTokenPairMetadata(
+	goCtx context.Context,
+	msg *types.MsgRefreshTokenPairMetadata,
+) (*types.MsgRefreshTokenPairMetadataResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	if !k.IsERC20Enabled(ctx) {
+		return nil, errorsmod.Wrap(
+			types.ErrERC20Disabled, "module is currently disabled by governance",
+		)
+	}
+
+	id := k.GetTokenPairID(ctx, msg.ContractAddress)
+	if len(id) == 0 {
+		return nil, errorsmod.Wrapf(
+			types.ErrTokenPairNotFound, "token '%s' not registered by id", msg.ContractAddress,
+		)
+	}
+
+	pair, found := k.GetTokenPair(ctx, id)
+	if !found {
+		return nil, errorsmod.Wrapf(
+			types.ErrTokenPairNotFound, "token '%s' not registered", msg.ContractAddress,
+		)
+	}
+
+	metadata, updated, err := k.RefreshCoinMetadata(ctx, pair)
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.MsgRefreshTokenPairMetadataResponse{
+		Metadata: metadata,
+		Updated:  updated,
+	}, nil
+}
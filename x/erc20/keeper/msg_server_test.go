@@ -9,8 +9,11 @@ import (
 
 	"github.com/ethereum/go-ethereum/common"
 
+	"github.com/evmos/ethermint/tests"
 	"github.com/evmos/ethermint/x/evm/statedb"
 	evmtypes "github.com/evmos/ethermint/x/evm/types"
+	"github.com/evmos/evmos/v10/testutil"
+	epochstypes "github.com/evmos/evmos/v10/x/epochs/types"
 	"github.com/evmos/evmos/v10/x/erc20/keeper"
 	"github.com/evmos/evmos/v10/x/erc20/types"
 )
@@ -93,7 +96,7 @@ func (suite *KeeperTestSuite) TestConvertCoinNativeCoin() {
 				sp, found := suite.app.ParamsKeeper.GetSubspace(types.ModuleName)
 				suite.Require().True(found)
 				suite.app.Erc20Keeper = keeper.NewKeeper(suite.app.GetKey("erc20"), suite.app.AppCodec(), sp,
-					suite.app.AccountKeeper, suite.app.BankKeeper, mockEVMKeeper, suite.app.StakingKeeper, suite.app.ClaimsKeeper)
+					suite.app.AccountKeeper, suite.app.BankKeeper, suite.app.NFTKeeper, mockEVMKeeper, suite.app.StakingKeeper, suite.app.ClaimsKeeper)
 
 				existingAcc := &statedb.Account{Nonce: uint64(1), Balance: common.Big1}
 				balance := make([]uint8, 32)
@@ -110,7 +113,7 @@ func (suite *KeeperTestSuite) TestConvertCoinNativeCoin() {
 				sp, found := suite.app.ParamsKeeper.GetSubspace(types.ModuleName)
 				suite.Require().True(found)
 				suite.app.Erc20Keeper = keeper.NewKeeper(suite.app.GetKey("erc20"), suite.app.AppCodec(), sp,
-					suite.app.AccountKeeper, suite.app.BankKeeper, mockEVMKeeper, suite.app.StakingKeeper, suite.app.ClaimsKeeper)
+					suite.app.AccountKeeper, suite.app.BankKeeper, suite.app.NFTKeeper, mockEVMKeeper, suite.app.StakingKeeper, suite.app.ClaimsKeeper)
 
 				existingAcc := &statedb.Account{Nonce: uint64(1), Balance: common.Big1}
 				balance := make([]uint8, 32)
@@ -133,7 +136,7 @@ func (suite *KeeperTestSuite) TestConvertCoinNativeCoin() {
 				sp, found := suite.app.ParamsKeeper.GetSubspace(types.ModuleName)
 				suite.Require().True(found)
 				suite.app.Erc20Keeper = keeper.NewKeeper(suite.app.GetKey("erc20"), suite.app.AppCodec(), sp,
-					suite.app.AccountKeeper, suite.app.BankKeeper, mockEVMKeeper, suite.app.StakingKeeper, suite.app.ClaimsKeeper)
+					suite.app.AccountKeeper, suite.app.BankKeeper, suite.app.NFTKeeper, mockEVMKeeper, suite.app.StakingKeeper, suite.app.ClaimsKeeper)
 
 				existingAcc := &statedb.Account{Nonce: uint64(1), Balance: common.Big1}
 				balance := make([]uint8, 32)
@@ -227,7 +230,7 @@ func (suite *KeeperTestSuite) TestConvertERC20NativeCoin() {
 				sp, found := suite.app.ParamsKeeper.GetSubspace(types.ModuleName)
 				suite.Require().True(found)
 				suite.app.Erc20Keeper = keeper.NewKeeper(suite.app.GetKey("erc20"), suite.app.AppCodec(), sp,
-					suite.app.AccountKeeper, suite.app.BankKeeper, mockEVMKeeper, suite.app.StakingKeeper, suite.app.ClaimsKeeper)
+					suite.app.AccountKeeper, suite.app.BankKeeper, suite.app.NFTKeeper, mockEVMKeeper, suite.app.StakingKeeper, suite.app.ClaimsKeeper)
 
 				existingAcc := &statedb.Account{Nonce: uint64(1), Balance: common.Big1}
 				balance := make([]uint8, 32)
@@ -245,7 +248,7 @@ func (suite *KeeperTestSuite) TestConvertERC20NativeCoin() {
 				sp, found := suite.app.ParamsKeeper.GetSubspace(types.ModuleName)
 				suite.Require().True(found)
 				suite.app.Erc20Keeper = keeper.NewKeeper(suite.app.GetKey("erc20"), suite.app.AppCodec(), sp,
-					suite.app.AccountKeeper, suite.app.BankKeeper, mockEVMKeeper, suite.app.StakingKeeper, suite.app.ClaimsKeeper)
+					suite.app.AccountKeeper, suite.app.BankKeeper, suite.app.NFTKeeper, mockEVMKeeper, suite.app.StakingKeeper, suite.app.ClaimsKeeper)
 
 				existingAcc := &statedb.Account{Nonce: uint64(1), Balance: common.Big1}
 				balance := make([]uint8, 32)
@@ -269,7 +272,7 @@ func (suite *KeeperTestSuite) TestConvertERC20NativeCoin() {
 				sp, found := suite.app.ParamsKeeper.GetSubspace(types.ModuleName)
 				suite.Require().True(found)
 				suite.app.Erc20Keeper = keeper.NewKeeper(suite.app.GetKey("erc20"), suite.app.AppCodec(), sp,
-					suite.app.AccountKeeper, suite.app.BankKeeper, mockEVMKeeper, suite.app.StakingKeeper, suite.app.ClaimsKeeper)
+					suite.app.AccountKeeper, suite.app.BankKeeper, suite.app.NFTKeeper, mockEVMKeeper, suite.app.StakingKeeper, suite.app.ClaimsKeeper)
 
 				existingAcc := &statedb.Account{Nonce: uint64(1), Balance: common.Big1}
 				balance := make([]uint8, 32)
@@ -293,7 +296,7 @@ func (suite *KeeperTestSuite) TestConvertERC20NativeCoin() {
 				sp, found := suite.app.ParamsKeeper.GetSubspace(types.ModuleName)
 				suite.Require().True(found)
 				suite.app.Erc20Keeper = keeper.NewKeeper(suite.app.GetKey("erc20"), suite.app.AppCodec(), sp,
-					suite.app.AccountKeeper, mockBankKeeper, suite.app.EvmKeeper, suite.app.StakingKeeper, suite.app.ClaimsKeeper)
+					suite.app.AccountKeeper, mockBankKeeper, suite.app.NFTKeeper, suite.app.EvmKeeper, suite.app.StakingKeeper, suite.app.ClaimsKeeper)
 
 				mockBankKeeper.On("SendCoinsFromModuleToAccount", mock.Anything, mock.Anything, mock.Anything, mock.Anything).Return(fmt.Errorf("failed to unescrow"))
 				mockBankKeeper.On("BlockedAddr", mock.Anything, mock.Anything, mock.Anything, mock.Anything).Return(false)
@@ -308,7 +311,7 @@ func (suite *KeeperTestSuite) TestConvertERC20NativeCoin() {
 				sp, found := suite.app.ParamsKeeper.GetSubspace(types.ModuleName)
 				suite.Require().True(found)
 				suite.app.Erc20Keeper = keeper.NewKeeper(suite.app.GetKey("erc20"), suite.app.AppCodec(), sp,
-					suite.app.AccountKeeper, mockBankKeeper, suite.app.EvmKeeper, suite.app.StakingKeeper, suite.app.ClaimsKeeper)
+					suite.app.AccountKeeper, mockBankKeeper, suite.app.NFTKeeper, suite.app.EvmKeeper, suite.app.StakingKeeper, suite.app.ClaimsKeeper)
 
 				mockBankKeeper.On("SendCoinsFromModuleToAccount", mock.Anything, mock.Anything, mock.Anything, mock.Anything).Return(nil)
 				mockBankKeeper.On("BlockedAddr", mock.Anything, mock.Anything, mock.Anything, mock.Anything).Return(false)
@@ -511,7 +514,7 @@ func (suite *KeeperTestSuite) TestConvertERC20NativeERC20() {
 				sp, found := suite.app.ParamsKeeper.GetSubspace(types.ModuleName)
 				suite.Require().True(found)
 				suite.app.Erc20Keeper = keeper.NewKeeper(suite.app.GetKey("erc20"), suite.app.AppCodec(), sp,
-					suite.app.AccountKeeper, suite.app.BankKeeper, mockEVMKeeper, suite.app.StakingKeeper, suite.app.ClaimsKeeper)
+					suite.app.AccountKeeper, suite.app.BankKeeper, suite.app.NFTKeeper, mockEVMKeeper, suite.app.StakingKeeper, suite.app.ClaimsKeeper)
 
 				existingAcc := &statedb.Account{Nonce: uint64(1), Balance: common.Big1}
 				balance := make([]uint8, 32)
@@ -534,7 +537,7 @@ func (suite *KeeperTestSuite) TestConvertERC20NativeERC20() {
 				sp, found := suite.app.ParamsKeeper.GetSubspace(types.ModuleName)
 				suite.Require().True(found)
 				suite.app.Erc20Keeper = keeper.NewKeeper(suite.app.GetKey("erc20"), suite.app.AppCodec(), sp,
-					suite.app.AccountKeeper, suite.app.BankKeeper, mockEVMKeeper, suite.app.StakingKeeper, suite.app.ClaimsKeeper)
+					suite.app.AccountKeeper, suite.app.BankKeeper, suite.app.NFTKeeper, mockEVMKeeper, suite.app.StakingKeeper, suite.app.ClaimsKeeper)
 
 				existingAcc := &statedb.Account{Nonce: uint64(1), Balance: common.Big1}
 				balance := make([]uint8, 32)
@@ -558,7 +561,7 @@ func (suite *KeeperTestSuite) TestConvertERC20NativeERC20() {
 				sp, found := suite.app.ParamsKeeper.GetSubspace(types.ModuleName)
 				suite.Require().True(found)
 				suite.app.Erc20Keeper = keeper.NewKeeper(suite.app.GetKey("erc20"), suite.app.AppCodec(), sp,
-					suite.app.AccountKeeper, suite.app.BankKeeper, mockEVMKeeper, suite.app.StakingKeeper, suite.app.ClaimsKeeper)
+					suite.app.AccountKeeper, suite.app.BankKeeper, suite.app.NFTKeeper, mockEVMKeeper, suite.app.StakingKeeper, suite.app.ClaimsKeeper)
 
 				existingAcc := &statedb.Account{Nonce: uint64(1), Balance: common.Big1}
 				balance := make([]uint8, 32)
@@ -582,7 +585,7 @@ func (suite *KeeperTestSuite) TestConvertERC20NativeERC20() {
 				sp, found := suite.app.ParamsKeeper.GetSubspace(types.ModuleName)
 				suite.Require().True(found)
 				suite.app.Erc20Keeper = keeper.NewKeeper(suite.app.GetKey("erc20"), suite.app.AppCodec(), sp,
-					suite.app.AccountKeeper, suite.app.BankKeeper, mockEVMKeeper, suite.app.StakingKeeper, suite.app.ClaimsKeeper)
+					suite.app.AccountKeeper, suite.app.BankKeeper, suite.app.NFTKeeper, mockEVMKeeper, suite.app.StakingKeeper, suite.app.ClaimsKeeper)
 
 				existingAcc := &statedb.Account{Nonce: uint64(1), Balance: common.Big1}
 				balance := make([]uint8, 32)
@@ -605,7 +608,7 @@ func (suite *KeeperTestSuite) TestConvertERC20NativeERC20() {
 				sp, found := suite.app.ParamsKeeper.GetSubspace(types.ModuleName)
 				suite.Require().True(found)
 				suite.app.Erc20Keeper = keeper.NewKeeper(suite.app.GetKey("erc20"), suite.app.AppCodec(), sp,
-					suite.app.AccountKeeper, mockBankKeeper, suite.app.EvmKeeper, suite.app.StakingKeeper, suite.app.ClaimsKeeper)
+					suite.app.AccountKeeper, mockBankKeeper, suite.app.NFTKeeper, suite.app.EvmKeeper, suite.app.StakingKeeper, suite.app.ClaimsKeeper)
 
 				mockBankKeeper.On("MintCoins", mock.Anything, mock.Anything, mock.Anything).Return(fmt.Errorf("failed to mint"))
 				mockBankKeeper.On("SendCoinsFromModuleToAccount", mock.Anything, mock.Anything, mock.Anything, mock.Anything).Return(fmt.Errorf("failed to unescrow"))
@@ -626,7 +629,7 @@ func (suite *KeeperTestSuite) TestConvertERC20NativeERC20() {
 				sp, found := suite.app.ParamsKeeper.GetSubspace(types.ModuleName)
 				suite.Require().True(found)
 				suite.app.Erc20Keeper = keeper.NewKeeper(suite.app.GetKey("erc20"), suite.app.AppCodec(), sp,
-					suite.app.AccountKeeper, mockBankKeeper, suite.app.EvmKeeper, suite.app.StakingKeeper, suite.app.ClaimsKeeper)
+					suite.app.AccountKeeper, mockBankKeeper, suite.app.NFTKeeper, suite.app.EvmKeeper, suite.app.StakingKeeper, suite.app.ClaimsKeeper)
 
 				mockBankKeeper.On("MintCoins", mock.Anything, mock.Anything, mock.Anything).Return(nil)
 				mockBankKeeper.On("SendCoinsFromModuleToAccount", mock.Anything, mock.Anything, mock.Anything, mock.Anything).Return(fmt.Errorf("failed to unescrow"))
@@ -647,7 +650,7 @@ func (suite *KeeperTestSuite) TestConvertERC20NativeERC20() {
 				sp, found := suite.app.ParamsKeeper.GetSubspace(types.ModuleName)
 				suite.Require().True(found)
 				suite.app.Erc20Keeper = keeper.NewKeeper(suite.app.GetKey("erc20"), suite.app.AppCodec(), sp,
-					suite.app.AccountKeeper, mockBankKeeper, suite.app.EvmKeeper, suite.app.StakingKeeper, suite.app.ClaimsKeeper)
+					suite.app.AccountKeeper, mockBankKeeper, suite.app.NFTKeeper, suite.app.EvmKeeper, suite.app.StakingKeeper, suite.app.ClaimsKeeper)
 
 				mockBankKeeper.On("MintCoins", mock.Anything, mock.Anything, mock.Anything).Return(nil)
 				mockBankKeeper.On("SendCoinsFromModuleToAccount", mock.Anything, mock.Anything, mock.Anything, mock.Anything).Return(nil)
@@ -796,7 +799,7 @@ func (suite *KeeperTestSuite) TestConvertCoinNativeERC20() {
 				sp, found := suite.app.ParamsKeeper.GetSubspace(types.ModuleName)
 				suite.Require().True(found)
 				suite.app.Erc20Keeper = keeper.NewKeeper(suite.app.GetKey("erc20"), suite.app.AppCodec(), sp,
-					suite.app.AccountKeeper, suite.app.BankKeeper, mockEVMKeeper, suite.app.StakingKeeper, suite.app.ClaimsKeeper)
+					suite.app.AccountKeeper, suite.app.BankKeeper, suite.app.NFTKeeper, mockEVMKeeper, suite.app.StakingKeeper, suite.app.ClaimsKeeper)
 
 				existingAcc := &statedb.Account{Nonce: uint64(1), Balance: common.Big1}
 				balance := make([]uint8, 32)
@@ -818,7 +821,7 @@ func (suite *KeeperTestSuite) TestConvertCoinNativeERC20() {
 				sp, found := suite.app.ParamsKeeper.GetSubspace(types.ModuleName)
 				suite.Require().True(found)
 				suite.app.Erc20Keeper = keeper.NewKeeper(suite.app.GetKey("erc20"), suite.app.AppCodec(), sp,
-					suite.app.AccountKeeper, suite.app.BankKeeper, mockEVMKeeper, suite.app.StakingKeeper, suite.app.ClaimsKeeper)
+					suite.app.AccountKeeper, suite.app.BankKeeper, suite.app.NFTKeeper, mockEVMKeeper, suite.app.StakingKeeper, suite.app.ClaimsKeeper)
 
 				existingAcc := &statedb.Account{Nonce: uint64(1), Balance: common.Big1}
 				balance := make([]uint8, 32)
@@ -840,7 +843,7 @@ func (suite *KeeperTestSuite) TestConvertCoinNativeERC20() {
 				sp, found := suite.app.ParamsKeeper.GetSubspace(types.ModuleName)
 				suite.Require().True(found)
 				suite.app.Erc20Keeper = keeper.NewKeeper(suite.app.GetKey("erc20"), suite.app.AppCodec(), sp,
-					suite.app.AccountKeeper, suite.app.BankKeeper, mockEVMKeeper, suite.app.StakingKeeper, suite.app.ClaimsKeeper)
+					suite.app.AccountKeeper, suite.app.BankKeeper, suite.app.NFTKeeper, mockEVMKeeper, suite.app.StakingKeeper, suite.app.ClaimsKeeper)
 
 				existingAcc := &statedb.Account{Nonce: uint64(1), Balance: common.Big1}
 				balance := make([]uint8, 32)
@@ -863,7 +866,7 @@ func (suite *KeeperTestSuite) TestConvertCoinNativeERC20() {
 				sp, found := suite.app.ParamsKeeper.GetSubspace(types.ModuleName)
 				suite.Require().True(found)
 				suite.app.Erc20Keeper = keeper.NewKeeper(suite.app.GetKey("erc20"), suite.app.AppCodec(), sp,
-					suite.app.AccountKeeper, suite.app.BankKeeper, mockEVMKeeper, suite.app.StakingKeeper, suite.app.ClaimsKeeper)
+					suite.app.AccountKeeper, suite.app.BankKeeper, suite.app.NFTKeeper, mockEVMKeeper, suite.app.StakingKeeper, suite.app.ClaimsKeeper)
 
 				existingAcc := &statedb.Account{Nonce: uint64(1), Balance: common.Big1}
 				balance := make([]uint8, 32)
@@ -1062,7 +1065,7 @@ func (suite *KeeperTestSuite) TestConvertCoinNativeIBCVoucher() {
 				sp, found := suite.app.ParamsKeeper.GetSubspace(types.ModuleName)
 				suite.Require().True(found)
 				suite.app.Erc20Keeper = keeper.NewKeeper(suite.app.GetKey("erc20"), suite.app.AppCodec(), sp,
-					suite.app.AccountKeeper, suite.app.BankKeeper, mockEVMKeeper, suite.app.StakingKeeper, suite.app.ClaimsKeeper)
+					suite.app.AccountKeeper, suite.app.BankKeeper, suite.app.NFTKeeper, mockEVMKeeper, suite.app.StakingKeeper, suite.app.ClaimsKeeper)
 
 				existingAcc := &statedb.Account{Nonce: uint64(1), Balance: common.Big1}
 				balance := make([]uint8, 32)
@@ -1079,7 +1082,7 @@ func (suite *KeeperTestSuite) TestConvertCoinNativeIBCVoucher() {
 				sp, found := suite.app.ParamsKeeper.GetSubspace(types.ModuleName)
 				suite.Require().True(found)
 				suite.app.Erc20Keeper = keeper.NewKeeper(suite.app.GetKey("erc20"), suite.app.AppCodec(), sp,
-					suite.app.AccountKeeper, suite.app.BankKeeper, mockEVMKeeper, suite.app.StakingKeeper, suite.app.ClaimsKeeper)
+					suite.app.AccountKeeper, suite.app.BankKeeper, suite.app.NFTKeeper, mockEVMKeeper, suite.app.StakingKeeper, suite.app.ClaimsKeeper)
 
 				existingAcc := &statedb.Account{Nonce: uint64(1), Balance: common.Big1}
 				balance := make([]uint8, 32)
@@ -1102,7 +1105,7 @@ func (suite *KeeperTestSuite) TestConvertCoinNativeIBCVoucher() {
 				sp, found := suite.app.ParamsKeeper.GetSubspace(types.ModuleName)
 				suite.Require().True(found)
 				suite.app.Erc20Keeper = keeper.NewKeeper(suite.app.GetKey("erc20"), suite.app.AppCodec(), sp,
-					suite.app.AccountKeeper, suite.app.BankKeeper, mockEVMKeeper, suite.app.StakingKeeper, suite.app.ClaimsKeeper)
+					suite.app.AccountKeeper, suite.app.BankKeeper, suite.app.NFTKeeper, mockEVMKeeper, suite.app.StakingKeeper, suite.app.ClaimsKeeper)
 
 				existingAcc := &statedb.Account{Nonce: uint64(1), Balance: common.Big1}
 				balance := make([]uint8, 32)
@@ -1196,7 +1199,7 @@ func (suite *KeeperTestSuite) TestConvertERC20NativeIBCVoucher() {
 				sp, found := suite.app.ParamsKeeper.GetSubspace(types.ModuleName)
 				suite.Require().True(found)
 				suite.app.Erc20Keeper = keeper.NewKeeper(suite.app.GetKey("erc20"), suite.app.AppCodec(), sp,
-					suite.app.AccountKeeper, suite.app.BankKeeper, mockEVMKeeper, suite.app.StakingKeeper, suite.app.ClaimsKeeper)
+					suite.app.AccountKeeper, suite.app.BankKeeper, suite.app.NFTKeeper, mockEVMKeeper, suite.app.StakingKeeper, suite.app.ClaimsKeeper)
 
 				existingAcc := &statedb.Account{Nonce: uint64(1), Balance: common.Big1}
 				balance := make([]uint8, 32)
@@ -1214,7 +1217,7 @@ func (suite *KeeperTestSuite) TestConvertERC20NativeIBCVoucher() {
 				sp, found := suite.app.ParamsKeeper.GetSubspace(types.ModuleName)
 				suite.Require().True(found)
 				suite.app.Erc20Keeper = keeper.NewKeeper(suite.app.GetKey("erc20"), suite.app.AppCodec(), sp,
-					suite.app.AccountKeeper, suite.app.BankKeeper, mockEVMKeeper, suite.app.StakingKeeper, suite.app.ClaimsKeeper)
+					suite.app.AccountKeeper, suite.app.BankKeeper, suite.app.NFTKeeper, mockEVMKeeper, suite.app.StakingKeeper, suite.app.ClaimsKeeper)
 
 				existingAcc := &statedb.Account{Nonce: uint64(1), Balance: common.Big1}
 				balance := make([]uint8, 32)
@@ -1238,7 +1241,7 @@ func (suite *KeeperTestSuite) TestConvertERC20NativeIBCVoucher() {
 				sp, found := suite.app.ParamsKeeper.GetSubspace(types.ModuleName)
 				suite.Require().True(found)
 				suite.app.Erc20Keeper = keeper.NewKeeper(suite.app.GetKey("erc20"), suite.app.AppCodec(), sp,
-					suite.app.AccountKeeper, suite.app.BankKeeper, mockEVMKeeper, suite.app.StakingKeeper, suite.app.ClaimsKeeper)
+					suite.app.AccountKeeper, suite.app.BankKeeper, suite.app.NFTKeeper, mockEVMKeeper, suite.app.StakingKeeper, suite.app.ClaimsKeeper)
 
 				existingAcc := &statedb.Account{Nonce: uint64(1), Balance: common.Big1}
 				balance := make([]uint8, 32)
@@ -1262,7 +1265,7 @@ func (suite *KeeperTestSuite) TestConvertERC20NativeIBCVoucher() {
 				sp, found := suite.app.ParamsKeeper.GetSubspace(types.ModuleName)
 				suite.Require().True(found)
 				suite.app.Erc20Keeper = keeper.NewKeeper(suite.app.GetKey("erc20"), suite.app.AppCodec(), sp,
-					suite.app.AccountKeeper, mockBankKeeper, suite.app.EvmKeeper, suite.app.StakingKeeper, suite.app.ClaimsKeeper)
+					suite.app.AccountKeeper, mockBankKeeper, suite.app.NFTKeeper, suite.app.EvmKeeper, suite.app.StakingKeeper, suite.app.ClaimsKeeper)
 
 				mockBankKeeper.On("SendCoinsFromModuleToAccount", mock.Anything, mock.Anything, mock.Anything, mock.Anything).Return(fmt.Errorf("failed to unescrow"))
 				mockBankKeeper.On("BlockedAddr", mock.Anything, mock.Anything, mock.Anything, mock.Anything).Return(false)
@@ -1277,7 +1280,7 @@ func (suite *KeeperTestSuite) TestConvertERC20NativeIBCVoucher() {
 				sp, found := suite.app.ParamsKeeper.GetSubspace(types.ModuleName)
 				suite.Require().True(found)
 				suite.app.Erc20Keeper = keeper.NewKeeper(suite.app.GetKey("erc20"), suite.app.AppCodec(), sp,
-					suite.app.AccountKeeper, mockBankKeeper, suite.app.EvmKeeper, suite.app.StakingKeeper, suite.app.ClaimsKeeper)
+					suite.app.AccountKeeper, mockBankKeeper, suite.app.NFTKeeper, suite.app.EvmKeeper, suite.app.StakingKeeper, suite.app.ClaimsKeeper)
 
 				mockBankKeeper.On("SendCoinsFromModuleToAccount", mock.Anything, mock.Anything, mock.Anything, mock.Anything).Return(nil)
 				mockBankKeeper.On("BlockedAddr", mock.Anything, mock.Anything, mock.Anything, mock.Anything).Return(false)
@@ -1342,3 +1345,439 @@ func (suite *KeeperTestSuite) TestConvertERC20NativeIBCVoucher() {
 	}
 	suite.mintFeeCollector = false
 }
+
+func (suite *KeeperTestSuite) TestRegisterERC20WithDeposit() {
+	var contractAddr common.Address
+	deposit := sdk.NewCoins(sdk.NewCoin("aevmos", sdk.NewInt(1000)))
+
+	testCases := []struct {
+		name     string
+		malleate func()
+		expPass  bool
+	}{
+		{
+			"fail - permissionless registration disabled",
+			func() {
+				params := types.DefaultParams()
+				params.EnablePermissionlessRegistration = false
+				suite.app.Erc20Keeper.SetParams(suite.ctx, params)
+			},
+			false,
+		},
+		{
+			"fail - module disabled",
+			func() {
+				params := types.DefaultParams()
+				params.EnableErc20 = false
+				params.EnablePermissionlessRegistration = true
+				suite.app.Erc20Keeper.SetParams(suite.ctx, params)
+			},
+			false,
+		},
+		{
+			"fail - insufficient funds for deposit",
+			func() {
+				params := types.DefaultParams()
+				params.EnablePermissionlessRegistration = true
+				params.RegistrationDeposit = deposit.Add(deposit...)
+				suite.app.Erc20Keeper.SetParams(suite.ctx, params)
+			},
+			false,
+		},
+		{
+			"fail - token already registered",
+			func() {
+				_, err := suite.app.Erc20Keeper.RegisterERC20(suite.ctx, contractAddr)
+				suite.Require().NoError(err)
+			},
+			false,
+		},
+		{
+			"ok - no deposit",
+			func() {
+				params := types.DefaultParams()
+				params.EnablePermissionlessRegistration = true
+				params.RegistrationDeposit = sdk.Coins{}
+				suite.app.Erc20Keeper.SetParams(suite.ctx, params)
+			},
+			true,
+		},
+		{
+			"ok",
+			func() {},
+			true,
+		},
+	}
+	for _, tc := range testCases {
+		suite.Run(fmt.Sprintf("Case %s", tc.name), func() {
+			suite.SetupTest() // reset
+
+			var err error
+			contractAddr, err = suite.DeployContract(erc20Name, erc20Symbol, cosmosDecimals)
+			suite.Require().NoError(err)
+			suite.Commit()
+
+			params := types.DefaultParams()
+			params.EnablePermissionlessRegistration = true
+			params.RegistrationDeposit = deposit
+			suite.app.Erc20Keeper.SetParams(suite.ctx, params)
+
+			sender := sdk.AccAddress(suite.address.Bytes())
+			err = testutil.FundAccount(suite.ctx, suite.app.BankKeeper, sender, deposit)
+			suite.Require().NoError(err)
+
+			tc.malleate()
+			expDeposit := suite.app.Erc20Keeper.GetParams(suite.ctx).RegistrationDeposit
+
+			msg := types.NewMsgRegisterERC20(contractAddr, sender)
+			ctx := sdk.WrapSDKContext(suite.ctx)
+			res, err := suite.app.Erc20Keeper.RegisterERC20WithDeposit(ctx, msg)
+			if tc.expPass {
+				suite.Require().NoError(err, tc.name)
+				suite.Require().Equal(&types.MsgRegisterERC20Response{}, res)
+
+				suite.Require().True(suite.app.Erc20Keeper.IsERC20Registered(suite.ctx, contractAddr))
+				stored, found := suite.app.Erc20Keeper.GetTokenPairDeposit(suite.ctx, contractAddr)
+				suite.Require().True(found)
+				suite.Require().Equal(sender.String(), stored.Depositor)
+				suite.Require().True(expDeposit.IsEqual(stored.Amount))
+
+				balance := suite.app.BankKeeper.GetBalance(suite.ctx, sender, "aevmos")
+				suite.Require().Equal(deposit.Sub(expDeposit...).AmountOf("aevmos"), balance.Amount)
+			} else {
+				suite.Require().Error(err, tc.name)
+				_, found := suite.app.Erc20Keeper.GetTokenPairDeposit(suite.ctx, contractAddr)
+				suite.Require().False(found)
+			}
+		})
+	}
+}
+
+func (suite *KeeperTestSuite) TestDeregisterERC20() {
+	var (
+		contractAddr common.Address
+		sender       sdk.AccAddress
+		holder       sdk.AccAddress
+	)
+	depositor := sdk.AccAddress(tests.GenerateAddress().Bytes())
+	deposit := sdk.NewCoins(sdk.NewCoin("aevmos", sdk.NewInt(1000)))
+
+	testCases := []struct {
+		name     string
+		malleate func()
+		expPass  bool
+	}{
+		{
+			"fail - pair registered without deposit",
+			func() {
+				contractAddr = suite.setupRegisterERC20Pair(contractMinterBurner)
+			},
+			false,
+		},
+		{
+			"fail - sender is not the depositor",
+			func() {
+				contractAddr = suite.setupRegisterERC20Pair(contractMinterBurner)
+				err := testutil.FundModuleAccount(suite.ctx, suite.app.BankKeeper, types.ModuleName, deposit)
+				suite.Require().NoError(err)
+				suite.app.Erc20Keeper.SetTokenPairDeposit(suite.ctx, types.NewTokenPairDeposit(contractAddr, depositor, deposit))
+				sender = sdk.AccAddress(tests.GenerateAddress().Bytes())
+			},
+			false,
+		},
+		{
+			"fail - coins of other holders in circulation",
+			func() {
+				contractAddr = suite.setupRegisterERC20Pair(contractMinterBurner)
+				err := testutil.FundModuleAccount(suite.ctx, suite.app.BankKeeper, types.ModuleName, deposit)
+				suite.Require().NoError(err)
+				suite.app.Erc20Keeper.SetTokenPairDeposit(suite.ctx, types.NewTokenPairDeposit(contractAddr, depositor, deposit))
+
+				id := suite.app.Erc20Keeper.GetERC20Map(suite.ctx, contractAddr)
+				pair, _ := suite.app.Erc20Keeper.GetTokenPair(suite.ctx, id)
+				holder = sdk.AccAddress(tests.GenerateAddress().Bytes())
+				coins := sdk.NewCoins(sdk.NewCoin(pair.Denom, sdk.NewInt(10)))
+				err = testutil.FundAccount(suite.ctx, suite.app.BankKeeper, holder, coins)
+				suite.Require().NoError(err)
+			},
+			false,
+		},
+		{
+			"ok",
+			func() {
+				contractAddr = suite.setupRegisterERC20Pair(contractMinterBurner)
+				err := testutil.FundModuleAccount(suite.ctx, suite.app.BankKeeper, types.ModuleName, deposit)
+				suite.Require().NoError(err)
+				suite.app.Erc20Keeper.SetTokenPairDeposit(suite.ctx, types.NewTokenPairDeposit(contractAddr, depositor, deposit))
+			},
+			true,
+		},
+	}
+	for _, tc := range testCases {
+		suite.Run(fmt.Sprintf("Case %s", tc.name), func() {
+			suite.SetupTest() // reset
+			sender = depositor
+			holder = nil
+
+			tc.malleate()
+
+			msg := types.NewMsgDeregisterERC20(contractAddr, sender)
+			ctx := sdk.WrapSDKContext(suite.ctx)
+			res, err := suite.app.Erc20Keeper.DeregisterERC20(ctx, msg)
+			if tc.expPass {
+				suite.Require().NoError(err, tc.name)
+				suite.Require().Equal(&types.MsgDeregisterERC20Response{}, res)
+
+				suite.Require().False(suite.app.Erc20Keeper.IsERC20Registered(suite.ctx, contractAddr))
+				_, found := suite.app.Erc20Keeper.GetTokenPairDeposit(suite.ctx, contractAddr)
+				suite.Require().False(found)
+				suite.Require().Equal(deposit, suite.app.BankKeeper.GetAllBalances(suite.ctx, depositor))
+			} else {
+				suite.Require().Error(err, tc.name)
+				suite.Require().True(suite.app.Erc20Keeper.IsERC20Registered(suite.ctx, contractAddr))
+				if holder != nil {
+					suite.Require().False(suite.app.BankKeeper.GetAllBalances(suite.ctx, holder).IsZero())
+				}
+			}
+		})
+	}
+}
+
+func (suite *KeeperTestSuite) TestConvertCoins() {
+	testCases := []struct {
+		name      string
+		mint      int64
+		amounts   []int64
+		rateLimit int64
+		expPass   bool
+	}{
+		{"ok - sufficient funds", 100, []int64{10, 20}, 0, true},
+		{"ok - equal funds", 10, []int64{10, 10}, 0, true},
+		{"ok - within the rate limit of one of the pairs", 100, []int64{10, 20}, 20, true},
+		{"fail - insufficient funds for one of the coins", 10, []int64{5, 20}, 0, false},
+		{"fail - rate limit of one of the pairs exceeded", 100, []int64{10, 20}, 15, false},
+	}
+	for _, tc := range testCases {
+		suite.Run(fmt.Sprintf("Case %s", tc.name), func() {
+			suite.mintFeeCollector = true
+			suite.SetupTest()
+
+			pairs := []*types.TokenPair{
+				suite.setupRegisterCoin(metadataCoin),
+				suite.setupRegisterCoin(metadataIbc),
+			}
+
+			sender := sdk.AccAddress(suite.address.Bytes())
+			coins := sdk.NewCoins(
+				sdk.NewCoin(metadataCoin.Base, sdk.NewInt(tc.mint)),
+				sdk.NewCoin(metadataIbc.Base, sdk.NewInt(tc.mint)),
+			)
+			err := testutil.FundAccount(suite.ctx, suite.app.BankKeeper, sender, coins)
+			suite.Require().NoError(err)
+
+			_, err = suite.app.Erc20Keeper.SetRateLimit(suite.ctx, pairs[1].Denom, epochstypes.DayEpochID, sdk.NewInt(tc.rateLimit), sdk.ZeroInt())
+			suite.Require().NoError(err)
+
+			msg := types.NewMsgConvertCoins(
+				sdk.NewCoins(
+					sdk.NewCoin(metadataCoin.Base, sdk.NewInt(tc.amounts[0])),
+					sdk.NewCoin(metadataIbc.Base, sdk.NewInt(tc.amounts[1])),
+				),
+				suite.address,
+				sender,
+			)
+
+			res, err := suite.app.Erc20Keeper.ConvertCoins(sdk.WrapSDKContext(suite.ctx), msg)
+			if tc.expPass {
+				suite.Require().NoError(err, tc.name)
+				suite.Require().Len(res.Balances, 2)
+
+				for _, balance := range res.Balances {
+					converted := msg.Coins.AmountOf(balance.Denom)
+					suite.Require().True(sdk.NewInt(tc.mint).Sub(converted).Equal(balance.CosmosBalance))
+					suite.Require().True(converted.Equal(balance.Erc20Balance))
+				}
+			} else {
+				suite.Require().Error(err, tc.name)
+
+				// no conversion is persisted
+				for _, pair := range pairs {
+					suite.Require().Equal(sdk.NewInt(tc.mint), suite.app.BankKeeper.GetBalance(suite.ctx, sender, pair.Denom).Amount)
+					balance := suite.BalanceOf(pair.GetERC20Contract(), suite.address)
+					suite.Require().Equal(int64(0), balance.(*big.Int).Int64())
+
+					rateLimit, found := suite.app.Erc20Keeper.GetTokenPairRateLimit(suite.ctx, pair.GetERC20Contract())
+					if found {
+						suite.Require().True(rateLimit.CoinToERC20Volume.IsZero())
+						suite.Require().False(rateLimit.Paused)
+					}
+				}
+			}
+		})
+	}
+	suite.mintFeeCollector = false
+}
+
+func (suite *KeeperTestSuite) TestConvertERC20S() {
+	testCases := []struct {
+		name      string
+		mint      int64
+		amounts   []int64
+		rateLimit int64
+		expPass   bool
+	}{
+		{"ok - sufficient funds", 100, []int64{10, 20}, 0, true},
+		{"ok - equal funds", 10, []int64{10, 10}, 0, true},
+		{"ok - within the rate limit of one of the tokens", 100, []int64{10, 20}, 20, true},
+		{"fail - insufficient funds for one of the tokens", 10, []int64{5, 20}, 0, false},
+		{"fail - rate limit of one of the tokens exceeded", 100, []int64{10, 20}, 15, false},
+	}
+	for _, tc := range testCases {
+		suite.Run(fmt.Sprintf("Case %s", tc.name), func() {
+			suite.mintFeeCollector = true
+			suite.SetupTest()
+
+			var contracts []common.Address
+			for i := 0; i < 2; i++ {
+				contract, err := suite.DeployContract(fmt.Sprintf("%s %d", erc20Name, i), fmt.Sprintf("%s%d", erc20Symbol, i), erc20Decimals)
+				suite.Require().NoError(err)
+				suite.Commit()
+
+				_, err = suite.app.Erc20Keeper.RegisterERC20(suite.ctx, contract)
+				suite.Require().NoError(err)
+
+				suite.MintERC20Token(contract, suite.address, suite.address, big.NewInt(tc.mint))
+				suite.Commit()
+
+				contracts = append(contracts, contract)
+			}
+
+			_, err := suite.app.Erc20Keeper.SetRateLimit(suite.ctx, contracts[1].String(), epochstypes.DayEpochID, sdk.ZeroInt(), sdk.NewInt(tc.rateLimit))
+			suite.Require().NoError(err)
+
+			receiver := sdk.AccAddress(suite.address.Bytes())
+			msg := types.NewMsgConvertERC20S(
+				[]types.ERC20Amount{
+					types.NewERC20Amount(contracts[0], sdk.NewInt(tc.amounts[0])),
+					types.NewERC20Amount(contracts[1], sdk.NewInt(tc.amounts[1])),
+				},
+				receiver,
+				suite.address,
+			)
+
+			res, err := suite.app.Erc20Keeper.ConvertERC20S(sdk.WrapSDKContext(suite.ctx), msg)
+			if tc.expPass {
+				suite.Require().NoError(err, tc.name)
+				suite.Require().Len(res.Balances, 2)
+
+				for i, balance := range res.Balances {
+					converted := sdk.NewInt(tc.amounts[i])
+					suite.Require().Equal(contracts[i].String(), balance.Erc20Address)
+					suite.Require().True(converted.Equal(balance.CosmosBalance))
+					suite.Require().True(sdk.NewInt(tc.mint).Sub(converted).Equal(balance.Erc20Balance))
+				}
+			} else {
+				suite.Require().Error(err, tc.name)
+
+				// no conversion is persisted
+				for _, contract := range contracts {
+					denom := types.CreateDenom(contract.String())
+					suite.Require().True(suite.app.BankKeeper.GetBalance(suite.ctx, receiver, denom).IsZero())
+					balance := suite.BalanceOf(contract, suite.address)
+					suite.Require().Equal(tc.mint, balance.(*big.Int).Int64())
+
+					rateLimit, found := suite.app.Erc20Keeper.GetTokenPairRateLimit(suite.ctx, contract)
+					if found {
+						suite.Require().True(rateLimit.ERC20ToCoinVolume.IsZero())
+						suite.Require().False(rateLimit.Paused)
+					}
+				}
+			}
+		})
+	}
+	suite.mintFeeCollector = false
+}
+
+func (suite *KeeperTestSuite) TestRefreshTokenPairMetadata() {
+	testCases := []struct {
+		name       string
+		malleate   func(contract common.Address) string
+		expPass    bool
+		expUpdated bool
+	}{
+		{
+			"fail - token pair not registered",
+			func(common.Address) string { return tests.GenerateAddress().String() },
+			false,
+			false,
+		},
+		{
+			"fail - native coin token pair",
+			func(common.Address) string {
+				pair := suite.setupRegisterCoin(metadataCoin)
+				return pair.Erc20Address
+			},
+			false,
+			false,
+		},
+		{
+			"fail - decimals changed",
+			func(contract common.Address) string {
+				denom := types.CreateDenom(contract.String())
+				metadata, _ := suite.app.BankKeeper.GetDenomMetaData(suite.ctx, denom)
+				metadata.DenomUnits[1].Exponent = uint32(erc20Decimals) + 1
+				suite.app.BankKeeper.SetDenomMetaData(suite.ctx, metadata)
+				return contract.String()
+			},
+			false,
+			false,
+		},
+		{
+			"ok - metadata up to date",
+			func(contract common.Address) string { return contract.String() },
+			true,
+			false,
+		},
+		{
+			"ok - stale symbol and display",
+			func(contract common.Address) string {
+				denom := types.CreateDenom(contract.String())
+				metadata, _ := suite.app.BankKeeper.GetDenomMetaData(suite.ctx, denom)
+				metadata.Symbol = "OLD"
+				metadata.DenomUnits[1].Denom = "old"
+				metadata.Display = "old"
+				suite.app.BankKeeper.SetDenomMetaData(suite.ctx, metadata)
+				return contract.String()
+			},
+			true,
+			true,
+		},
+	}
+	for _, tc := range testCases {
+		suite.Run(fmt.Sprintf("Case %s", tc.name), func() {
+			suite.mintFeeCollector = true
+			suite.SetupTest()
+
+			contract := suite.setupRegisterERC20Pair(contractMinterBurner)
+			token := tc.malleate(contract)
+
+			sender := sdk.AccAddress(suite.address.Bytes())
+			msg := types.NewMsgRefreshTokenPairMetadata(common.HexToAddress(token), sender)
+
+			res, err := suite.app.Erc20Keeper.RefreshTokenPairMetadata(sdk.WrapSDKContext(suite.ctx), msg)
+			if !tc.expPass {
+				suite.Require().Error(err, tc.name)
+				return
+			}
+
+			suite.Require().NoError(err, tc.name)
+			suite.Require().Equal(tc.expUpdated, res.Updated)
+			suite.Require().Equal(erc20Symbol, res.Metadata.Symbol)
+
+			metadata, found := suite.app.BankKeeper.GetDenomMetaData(suite.ctx, types.CreateDenom(contract.String()))
+			suite.Require().True(found)
+			suite.Require().NoError(types.EqualMetadata(res.Metadata, metadata))
+		})
+	}
+	suite.mintFeeCollector = false
+}
@@ -0,0 +1,287 @@
+package keeper
+
+import (
+	"context"
+	"math/big"
+
+	errorsmod "cosmossdk.io/errors"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	errortypes "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/cosmos/cosmos-sdk/x/nft"
+	"github.com/ethereum/go-ethereum/common"
+
+	"github.com/evmos/evmos/v10/contracts"
+	"github.com/evmos/evmos/v10/x/erc20/types"
+)
+
+// ConvertNFT converts a native x/nft token into the ERC721 or ERC1155 token of
+// the paired contract. The x/nft token is burned and the contract token, that
+// was escrowed on the module address when converted to the Cosmos side, is
+// transferred to the receiver.
+func (k Keeper) ConvertNFT(
+	goCtx context.Context,
+	msg *types.MsgConvertNFT,
+) (*types.MsgConvertNFTResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	// Error checked during msg validation
+	receiver := common.HexToAddress(msg.Receiver)
+	sender := sdk.MustAccAddressFromBech32(msg.Sender)
+	tokenID, _ := types.ParseNFTID(msg.NFTID)
+
+	pair, err := k.NFTConversionEnabled(ctx, receiver.Bytes(), msg.ClassID)
+	if err != nil {
+		return nil, err
+	}
+
+	if owner := k.nftKeeper.GetOwner(ctx, pair.ClassID, msg.NFTID); !sender.Equals(owner) {
+		return nil, errorsmod.Wrapf(
+			errortypes.ErrUnauthorized, "%s is not the owner of nft %s/%s", sender, pair.ClassID, msg.NFTID,
+		)
+	}
+
+	if err := k.nftKeeper.Burn(ctx, pair.ClassID, msg.NFTID); err != nil {
+		return nil, err
+	}
+
+	// Unescrow the contract token and check that the receiver got it
+	balance, err := k.NFTBalanceOf(ctx, pair, receiver, tokenID)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := k.transferContractNFT(ctx, pair, types.ModuleAddress, receiver, tokenID); err != nil {
+		return nil, err
+	}
+
+	balanceAfter, err := k.NFTBalanceOf(ctx, pair, receiver, tokenID)
+	if err != nil {
+		return nil, err
+	}
+
+	if expBalance := new(big.Int).Add(balance, big.NewInt(1)); balanceAfter.Cmp(expBalance) != 0 {
+		return nil, errorsmod.Wrapf(
+			types.ErrBalanceInvariance,
+			"invalid token balance - expected: %v, actual: %v",
+			expBalance, balanceAfter,
+		)
+	}
+
+	ctx.EventManager().EmitEvents(
+		sdk.Events{
+			sdk.NewEvent(
+				types.EventTypeConvertNFT,
+				sdk.NewAttribute(sdk.AttributeKeySender, msg.Sender),
+				sdk.NewAttribute(types.AttributeKeyReceiver, msg.Receiver),
+				sdk.NewAttribute(types.AttributeKeyClassID, pair.ClassID),
+				sdk.NewAttribute(types.AttributeKeyNFTID, msg.NFTID),
+				sdk.NewAttribute(types.AttributeKeyContract, pair.ContractAddress),
+				sdk.NewAttribute(types.AttributeKeyTokenID, tokenID.String()),
+			),
+		},
+	)
+
+	return &types.MsgConvertNFTResponse{}, nil
+}
+
+// ConvertContractNFT converts an ERC721 or ERC1155 token into a native x/nft
+// token. The contract token is escrowed on the module address and the x/nft
+// token is minted to the receiver.
+func (k Keeper) ConvertContractNFT(
+	goCtx context.Context,
+	msg *types.MsgConvertContractNFT,
+) (*types.MsgConvertContractNFTResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	// Error checked during msg validation
+	receiver := sdk.MustAccAddressFromBech32(msg.Receiver)
+	sender := common.HexToAddress(msg.Sender)
+	tokenID := msg.TokenID.BigInt()
+
+	pair, err := k.NFTConversionEnabled(ctx, receiver, msg.ContractAddress)
+	if err != nil {
+		return nil, err
+	}
+
+	// Escrow the contract token and check that the module address got it
+	balance, err := k.NFTBalanceOf(ctx, pair, types.ModuleAddress, tokenID)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := k.transferContractNFT(ctx, pair, sender, types.ModuleAddress, tokenID); err != nil {
+		return nil, err
+	}
+
+	balanceAfter, err := k.NFTBalanceOf(ctx, pair, types.ModuleAddress, tokenID)
+	if err != nil {
+		return nil, err
+	}
+
+	if expBalance := new(big.Int).Add(balance, big.NewInt(1)); balanceAfter.Cmp(expBalance) != 0 {
+		return nil, errorsmod.Wrapf(
+			types.ErrBalanceInvariance,
+			"invalid token balance - expected: %v, actual: %v",
+			expBalance, balanceAfter,
+		)
+	}
+
+	nftID, err := k.mintNFT(ctx, pair, tokenID, receiver)
+	if err != nil {
+		return nil, err
+	}
+
+	ctx.EventManager().EmitEvents(
+		sdk.Events{
+			sdk.NewEvent(
+				types.EventTypeConvertContractNFT,
+				sdk.NewAttribute(sdk.AttributeKeySender, msg.Sender),
+				sdk.NewAttribute(types.AttributeKeyReceiver, msg.Receiver),
+				sdk.NewAttribute(types.AttributeKeyContract, pair.ContractAddress),
+				sdk.NewAttribute(types.AttributeKeyTokenID, tokenID.String()),
+				sdk.NewAttribute(types.AttributeKeyClassID, pair.ClassID),
+				sdk.NewAttribute(types.AttributeKeyNFTID, nftID),
+			),
+		},
+	)
+
+	return &types.MsgConvertContractNFTResponse{}, nil
+}
+
+// NFTConversionEnabled checks that the module is enabled, that the NFT pair of
+// the given contract address or class identifier is registered and that the
+// receiver can receive the converted token
+func (k Keeper) NFTConversionEnabled(
+	ctx sdk.Context,
+	receiver sdk.AccAddress,
+	token string,
+) (types.NFTPair, error) {
+	if !k.IsERC20Enabled(ctx) {
+		return types.NFTPair{}, errorsmod.Wrap(
+			types.ErrERC20Disabled, "module is currently disabled by governance",
+		)
+	}
+
+	pair, found := k.GetNFTPairByToken(ctx, token)
+	if !found {
+		return types.NFTPair{}, errorsmod.Wrapf(
+			types.ErrNFTPairNotFound, "token '%s' not registered", token,
+		)
+	}
+
+	if k.bankKeeper.BlockedAddr(receiver) {
+		return types.NFTPair{}, errorsmod.Wrapf(
+			errortypes.ErrUnauthorized, "%s is not allowed to receive transactions", receiver,
+		)
+	}
+
+	return pair, nil
+}
+
+// NFTBalanceOf returns the balance of the given contract token held by the
+// account. The balance of an ERC721 token is one if the account owns it and
+// zero otherwise.
+func (k Keeper) NFTBalanceOf(
+	ctx sdk.Context,
+	pair types.NFTPair,
+	account common.Address,
+	tokenID *big.Int,
+) (*big.Int, error) {
+	contract := pair.GetContract()
+
+	switch pair.Standard {
+	case types.NFT_STANDARD_ERC721:
+		erc721 := contracts.ERC721NFTContract.ABI
+		res, err := k.CallEVM(ctx, erc721, types.ModuleAddress, contract, false, "ownerOf", tokenID)
+		if err != nil {
+			return nil, err
+		}
+
+		unpacked, err := erc721.Unpack("ownerOf", res.Ret)
+		if err != nil || len(unpacked) == 0 {
+			return nil, errorsmod.Wrap(types.ErrABIUnpack, "failed to unpack owner")
+		}
+
+		owner, ok := unpacked[0].(common.Address)
+		if !ok {
+			return nil, errorsmod.Wrap(types.ErrABIUnpack, "failed to unpack owner")
+		}
+
+		if owner == account {
+			return big.NewInt(1), nil
+		}
+		return big.NewInt(0), nil
+	case types.NFT_STANDARD_ERC1155:
+		erc1155 := contracts.ERC1155NFTContract.ABI
+		res, err := k.CallEVM(ctx, erc1155, types.ModuleAddress, contract, false, "balanceOf", account, tokenID)
+		if err != nil {
+			return nil, err
+		}
+
+		unpacked, err := erc1155.Unpack("balanceOf", res.Ret)
+		if err != nil || len(unpacked) == 0 {
+			return nil, errorsmod.Wrap(types.ErrABIUnpack, "failed to unpack balance")
+		}
+
+		balance, ok := unpacked[0].(*big.Int)
+		if !ok {
+			return nil, errorsmod.Wrap(types.ErrABIUnpack, "failed to unpack balance")
+		}
+
+		return balance, nil
+	default:
+		return nil, types.ErrUndefinedNFTStandard
+	}
+}
+
+// transferContractNFT transfers a single unit of the contract token with the
+// sender as the EVM caller
+func (k Keeper) transferContractNFT(
+	ctx sdk.Context,
+	pair types.NFTPair,
+	from, to common.Address,
+	tokenID *big.Int,
+) error {
+	contract := pair.GetContract()
+
+	var err error
+	switch pair.Standard {
+	case types.NFT_STANDARD_ERC721:
+		erc721 := contracts.ERC721NFTContract.ABI
+		_, err = k.CallEVM(ctx, erc721, from, contract, true, "transferFrom", from, to, tokenID)
+	case types.NFT_STANDARD_ERC1155:
+		erc1155 := contracts.ERC1155NFTContract.ABI
+		_, err = k.CallEVM(ctx, erc1155, from, contract, true, "safeTransferFrom", from, to, tokenID, big.NewInt(1), []byte{})
+	default:
+		err = types.ErrUndefinedNFTStandard
+	}
+
+	return err
+}
+
+// mintNFT mints the x/nft token of a contract token escrowed on the module
+// address to the receiver. The token URI is queried from the contract metadata
+// extension, if implemented. It returns the identifier of the x/nft token.
+func (k Keeper) mintNFT(
+	ctx sdk.Context,
+	pair types.NFTPair,
+	tokenID *big.Int,
+	receiver sdk.AccAddress,
+) (string, error) {
+	method := "tokenURI"
+	if pair.Standard == types.NFT_STANDARD_ERC1155 {
+		method = "uri"
+	}
+
+	token := nft.NFT{
+		ClassId: pair.ClassID,
+		Id:      types.CreateNFTID(tokenID),
+		Uri:     k.queryNFTString(ctx, pair, method, tokenID),
+	}
+
+	if err := k.nftKeeper.Mint(ctx, token, receiver); err != nil {
+		return "", errorsmod.Wrapf(err, "failed to mint nft %s/%s", token.ClassId, token.Id)
+	}
+
+	return token.Id, nil
+}
@@ -0,0 +1,180 @@
+package keeper
+
+import (
+	"fmt"
+
+	errorsmod "cosmossdk.io/errors"
+	"github.com/cosmos/cosmos-sdk/store/prefix"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	errortypes "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/cosmos/cosmos-sdk/x/nft"
+	"github.com/ethereum/go-ethereum/common"
+
+	"github.com/evmos/evmos/v10/contracts"
+	"github.com/evmos/evmos/v10/x/erc20/types"
+)
+
+// GetNFTPairs returns all the registered NFT pairs
+func (k Keeper) GetNFTPairs(ctx sdk.Context) []types.NFTPair {
+	pairs := []types.NFTPair{}
+
+	k.IterateNFTPairs(ctx, func(pair types.NFTPair) (stop bool) {
+		pairs = append(pairs, pair)
+		return false
+	})
+
+	return pairs
+}
+
+// IterateNFTPairs iterates over all the registered NFT pairs
+func (k Keeper) IterateNFTPairs(ctx sdk.Context, cb func(pair types.NFTPair) (stop bool)) {
+	store := ctx.KVStore(k.storeKey)
+	iterator := sdk.KVStorePrefixIterator(store, types.KeyPrefixNFTPair)
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		var pair types.NFTPair
+		k.cdc.MustUnmarshal(iterator.Value(), &pair)
+
+		if cb(pair) {
+			break
+		}
+	}
+}
+
+// GetNFTPair returns the NFT pair registered for the given contract
+func (k Keeper) GetNFTPair(ctx sdk.Context, contract common.Address) (types.NFTPair, bool) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefixNFTPair)
+	bz := store.Get(contract.Bytes())
+	if len(bz) == 0 {
+		return types.NFTPair{}, false
+	}
+
+	var pair types.NFTPair
+	k.cdc.MustUnmarshal(bz, &pair)
+	return pair, true
+}
+
+// GetNFTPairByClass returns the NFT pair registered for the given x/nft class
+func (k Keeper) GetNFTPairByClass(ctx sdk.Context, classID string) (types.NFTPair, bool) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefixNFTPairByClass)
+	bz := store.Get([]byte(classID))
+	if len(bz) == 0 {
+		return types.NFTPair{}, false
+	}
+
+	return k.GetNFTPair(ctx, common.BytesToAddress(bz))
+}
+
+// GetNFTPairByToken returns the NFT pair from either its contract hex address
+// or its x/nft class identifier
+func (k Keeper) GetNFTPairByToken(ctx sdk.Context, token string) (types.NFTPair, bool) {
+	if common.IsHexAddress(token) {
+		return k.GetNFTPair(ctx, common.HexToAddress(token))
+	}
+	return k.GetNFTPairByClass(ctx, token)
+}
+
+// SetNFTPair stores an NFT pair together with its class mapping
+func (k Keeper) SetNFTPair(ctx sdk.Context, pair types.NFTPair) {
+	contract := pair.GetContract()
+
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefixNFTPair)
+	bz := k.cdc.MustMarshal(&pair)
+	store.Set(contract.Bytes(), bz)
+
+	classStore := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefixNFTPairByClass)
+	classStore.Set([]byte(pair.ClassID), contract.Bytes())
+}
+
+// DeleteNFTPair removes an NFT pair together with its class mapping. The x/nft
+// class and tokens are not affected.
+func (k Keeper) DeleteNFTPair(ctx sdk.Context, pair types.NFTPair) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefixNFTPair)
+	store.Delete(pair.GetContract().Bytes())
+
+	classStore := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefixNFTPairByClass)
+	classStore.Delete([]byte(pair.ClassID))
+}
+
+// IsNFTContractRegistered checks if an NFT pair is registered for the contract
+func (k Keeper) IsNFTContractRegistered(ctx sdk.Context, contract common.Address) bool {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefixNFTPair)
+	return store.Has(contract.Bytes())
+}
+
+// RegisterNFTPair registers an NFT pair for an ERC721 or ERC1155 contract and
+// creates the x/nft class that represents its tokens on the Cosmos side
+func (k Keeper) RegisterNFTPair(
+	ctx sdk.Context,
+	contract common.Address,
+	standard types.NFTStandard,
+	classURI, classData string,
+) (*types.NFTPair, error) {
+	if err := types.ValidateNFTStandard(standard); err != nil {
+		return nil, err
+	}
+
+	if k.IsNFTContractRegistered(ctx, contract) {
+		return nil, errorsmod.Wrapf(
+			types.ErrNFTPairAlreadyExists, "NFT contract already registered: %s", contract,
+		)
+	}
+
+	if k.IsERC20Registered(ctx, contract) {
+		return nil, errorsmod.Wrapf(
+			types.ErrTokenPairAlreadyExists, "contract already registered as token ERC20 contract: %s", contract,
+		)
+	}
+
+	acc := k.evmKeeper.GetAccountWithoutBalance(ctx, contract)
+	if acc == nil || !acc.IsContract() {
+		return nil, errorsmod.Wrapf(
+			errortypes.ErrInvalidAddress, "NFT contract '%s' is not deployed", contract,
+		)
+	}
+
+	pair := types.NewNFTPair(contract, standard, classURI, classData)
+
+	class := nft.Class{
+		Id:          pair.ClassID,
+		Description: fmt.Sprintf("Cosmos NFT representation of %s", contract),
+		Uri:         classURI,
+	}
+
+	// ERC1155 contracts do not define a name and symbol. The metadata extension
+	// of ERC721 contracts is optional, so the class is created without a name
+	// and symbol if the contract does not implement it.
+	if standard == types.NFT_STANDARD_ERC721 {
+		class.Name = k.queryNFTString(ctx, pair, "name")
+		class.Symbol = k.queryNFTString(ctx, pair, "symbol")
+	}
+
+	if err := k.nftKeeper.SaveClass(ctx, class); err != nil {
+		return nil, errorsmod.Wrapf(err, "failed to create class for NFT contract %s", contract)
+	}
+
+	k.SetNFTPair(ctx, pair)
+	return &pair, nil
+}
+
+// queryNFTString calls a view method without arguments on the contract of an
+// NFT pair and returns its string result, or an empty string if the call fails
+func (k Keeper) queryNFTString(ctx sdk.Context, pair types.NFTPair, method string, args ...interface{}) string {
+	abi := contracts.ERC721NFTContract.ABI
+	if pair.Standard == types.NFT_STANDARD_ERC1155 {
+		abi = contracts.ERC1155NFTContract.ABI
+	}
+
+	res, err := k.CallEVM(ctx, abi, types.ModuleAddress, pair.GetContract(), false, method, args...)
+	if err != nil {
+		return ""
+	}
+
+	var strRes types.ERC20StringResponse
+	if err := abi.UnpackIntoInterface(&strRes, method, res.Ret); err != nil {
+		return ""
+	}
+
+	return strRes.Value
+}
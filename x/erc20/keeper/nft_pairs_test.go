@@ -0,0 +1,404 @@
+package keeper_test
+
+import (
+	"fmt"
+	"math/big"
+
+	"cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/nft"
+	"github.com/ethereum/go-ethereum/common"
+	ethtypes "github.com/ethereum/go-ethereum/core/types"
+
+	"github.com/evmos/ethermint/tests"
+	"github.com/evmos/evmos/v10/contracts"
+	"github.com/evmos/evmos/v10/x/erc20/types"
+)
+
+func (suite *KeeperTestSuite) TestRegisterNFTPair() {
+	var contractAddr common.Address
+
+	testCases := []struct {
+		name     string
+		standard types.NFTStandard
+		malleate func()
+		expPass  bool
+	}{
+		{
+			"fail - unspecified standard",
+			types.NFT_STANDARD_UNSPECIFIED,
+			func() {},
+			false,
+		},
+		{
+			"fail - not a deployed contract",
+			types.NFT_STANDARD_ERC721,
+			func() {
+				contractAddr = tests.GenerateAddress()
+			},
+			false,
+		},
+		{
+			"fail - NFT pair already registered",
+			types.NFT_STANDARD_ERC721,
+			func() {
+				_, err := suite.app.Erc20Keeper.RegisterNFTPair(suite.ctx, contractAddr, types.NFT_STANDARD_ERC1155, "", "")
+				suite.Require().NoError(err)
+			},
+			false,
+		},
+		{
+			"fail - contract registered as token pair",
+			types.NFT_STANDARD_ERC721,
+			func() {
+				_, err := suite.app.Erc20Keeper.RegisterERC20(suite.ctx, contractAddr)
+				suite.Require().NoError(err)
+			},
+			false,
+		},
+		{
+			"ok - erc721",
+			types.NFT_STANDARD_ERC721,
+			func() {},
+			true,
+		},
+		{
+			"ok - erc1155",
+			types.NFT_STANDARD_ERC1155,
+			func() {},
+			true,
+		},
+	}
+	for _, tc := range testCases {
+		suite.Run(fmt.Sprintf("Case %s", tc.name), func() {
+			suite.SetupTest() // reset
+
+			var err error
+			contractAddr, err = suite.DeployContract("coin", "token", erc20Decimals)
+			suite.Require().NoError(err)
+			suite.Commit()
+
+			tc.malleate()
+
+			pair, err := suite.app.Erc20Keeper.RegisterNFTPair(suite.ctx, contractAddr, tc.standard, "ipfs://class", "")
+			if tc.expPass {
+				suite.Require().NoError(err, tc.name)
+				suite.Require().Equal(types.NewNFTPair(contractAddr, tc.standard, "ipfs://class", ""), *pair)
+
+				stored, found := suite.app.Erc20Keeper.GetNFTPairByToken(suite.ctx, pair.ClassID)
+				suite.Require().True(found)
+				suite.Require().Equal(*pair, stored)
+
+				class, found := suite.app.NFTKeeper.GetClass(suite.ctx, pair.ClassID)
+				suite.Require().True(found)
+				suite.Require().Equal("ipfs://class", class.Uri)
+				if tc.standard == types.NFT_STANDARD_ERC721 {
+					suite.Require().Equal("coin", class.Name)
+					suite.Require().Equal("token", class.Symbol)
+				} else {
+					suite.Require().Empty(class.Name)
+				}
+			} else {
+				suite.Require().Error(err, tc.name)
+			}
+		})
+	}
+}
+
+func (suite *KeeperTestSuite) TestQueryNFTPairs() {
+	suite.SetupTest()
+	ctx := sdk.WrapSDKContext(suite.ctx)
+
+	contract := tests.GenerateAddress()
+	pair := types.NewNFTPair(contract, types.NFT_STANDARD_ERC721, "", "")
+	suite.app.Erc20Keeper.SetNFTPair(suite.ctx, pair)
+
+	res, err := suite.app.Erc20Keeper.NFTPairs(ctx, &types.QueryNFTPairsRequest{})
+	suite.Require().NoError(err)
+	suite.Require().Equal([]types.NFTPair{pair}, res.NFTPairs)
+
+	// query by contract address and by class id
+	for _, token := range []string{contract.String(), pair.ClassID} {
+		pairRes, err := suite.app.Erc20Keeper.NFTPair(ctx, &types.QueryNFTPairRequest{Token: token})
+		suite.Require().NoError(err)
+		suite.Require().Equal(pair, pairRes.NFTPair)
+	}
+
+	_, err = suite.app.Erc20Keeper.NFTPair(ctx, &types.QueryNFTPairRequest{Token: tests.GenerateAddress().String()})
+	suite.Require().Error(err)
+
+	_, err = suite.app.Erc20Keeper.NFTPair(ctx, &types.QueryNFTPairRequest{Token: ""})
+	suite.Require().Error(err)
+
+	// deleting the pair also removes the class mapping
+	suite.app.Erc20Keeper.DeleteNFTPair(suite.ctx, pair)
+	_, found := suite.app.Erc20Keeper.GetNFTPairByClass(suite.ctx, pair.ClassID)
+	suite.Require().False(found)
+}
+
+func (suite *KeeperTestSuite) TestConvertContractNFT() {
+	var contractAddr common.Address
+
+	testCases := []struct {
+		name     string
+		malleate func()
+	}{
+		{
+			"fail - module disabled",
+			func() {
+				params := suite.app.Erc20Keeper.GetParams(suite.ctx)
+				params.EnableErc20 = false
+				suite.app.Erc20Keeper.SetParams(suite.ctx, params)
+			},
+		},
+		{
+			"fail - NFT pair not registered",
+			func() {
+				contractAddr = tests.GenerateAddress()
+			},
+		},
+		{
+			"fail - contract does not implement the standard",
+			func() {},
+		},
+	}
+	for _, tc := range testCases {
+		suite.Run(fmt.Sprintf("Case %s", tc.name), func() {
+			suite.mintFeeCollector = true
+			suite.SetupTest() // reset
+
+			var err error
+			contractAddr, err = suite.DeployContract("coin", "token", erc20Decimals)
+			suite.Require().NoError(err)
+			suite.Commit()
+
+			_, err = suite.app.Erc20Keeper.RegisterNFTPair(suite.ctx, contractAddr, types.NFT_STANDARD_ERC721, "", "")
+			suite.Require().NoError(err)
+
+			tc.malleate()
+
+			msg := types.NewMsgConvertContractNFT(
+				math.NewInt(1),
+				sdk.AccAddress(suite.address.Bytes()),
+				contractAddr,
+				suite.address,
+			)
+			_, err = suite.app.Erc20Keeper.ConvertContractNFT(sdk.WrapSDKContext(suite.ctx), msg)
+			suite.Require().Error(err, tc.name)
+		})
+	}
+	suite.mintFeeCollector = false
+}
+
+func (suite *KeeperTestSuite) TestConvertNFTNotOwner() {
+	suite.SetupTest()
+
+	contractAddr, err := suite.DeployContract("coin", "token", erc20Decimals)
+	suite.Require().NoError(err)
+	suite.Commit()
+
+	pair, err := suite.app.Erc20Keeper.RegisterNFTPair(suite.ctx, contractAddr, types.NFT_STANDARD_ERC721, "", "")
+	suite.Require().NoError(err)
+
+	// mint the x/nft token to another account
+	owner := sdk.AccAddress(tests.GenerateAddress().Bytes())
+	nftID := types.CreateNFTID(big.NewInt(1))
+	err = suite.app.NFTKeeper.Mint(suite.ctx, nft.NFT{ClassId: pair.ClassID, Id: nftID}, owner)
+	suite.Require().NoError(err)
+
+	msg := types.NewMsgConvertNFT(pair.ClassID, nftID, suite.address, sdk.AccAddress(suite.address.Bytes()))
+	_, err = suite.app.Erc20Keeper.ConvertNFT(sdk.WrapSDKContext(suite.ctx), msg)
+	suite.Require().Error(err)
+	suite.Require().True(owner.Equals(suite.app.NFTKeeper.GetOwner(suite.ctx, pair.ClassID, nftID)))
+}
+
+func (suite *KeeperTestSuite) TestPostTxProcessingNFT() {
+	var (
+		receipt *ethtypes.Receipt
+		pair    *types.NFTPair
+	)
+
+	msg := ethtypes.NewMessage(
+		types.ModuleAddress,
+		&common.Address{},
+		0,
+		big.NewInt(0), // amount
+		uint64(0),     // gasLimit
+		big.NewInt(0), // gasFeeCap
+		big.NewInt(0), // gasTipCap
+		big.NewInt(0), // gasPrice
+		[]byte{},
+		ethtypes.AccessList{}, // AccessList
+		true,                  // checkNonce
+	)
+
+	account := tests.GenerateAddress()
+	tokenID := big.NewInt(42)
+	erc721Transfer := contracts.ERC721NFTContract.ABI.Events[types.ERC721EventTransfer]
+	erc1155Transfer := contracts.ERC1155NFTContract.ABI.Events[types.ERC1155EventTransferSingle]
+	erc1155Batch := contracts.ERC1155NFTContract.ABI.Events[types.ERC1155EventTransferBatch]
+
+	erc1155Data := func(value int64) []byte {
+		data, err := erc1155Transfer.Inputs.NonIndexed().Pack(tokenID, big.NewInt(value))
+		suite.Require().NoError(err)
+		return data
+	}
+
+	testCases := []struct {
+		name     string
+		standard types.NFTStandard
+		malleate func()
+		expPass  bool
+		expMint  bool
+	}{
+		{
+			"erc721 - transfer to the module address",
+			types.NFT_STANDARD_ERC721,
+			func() {
+				topics := []common.Hash{erc721Transfer.ID, account.Hash(), types.ModuleAddress.Hash(), common.BigToHash(tokenID)}
+				receipt = &ethtypes.Receipt{
+					Logs: []*ethtypes.Log{{Address: pair.GetContract(), Topics: topics}},
+				}
+			},
+			true,
+			true,
+		},
+		{
+			"erc721 - transfer to another account",
+			types.NFT_STANDARD_ERC721,
+			func() {
+				topics := []common.Hash{erc721Transfer.ID, account.Hash(), tests.GenerateAddress().Hash(), common.BigToHash(tokenID)}
+				receipt = &ethtypes.Receipt{
+					Logs: []*ethtypes.Log{{Address: pair.GetContract(), Topics: topics}},
+				}
+			},
+			true,
+			false,
+		},
+		{
+			"erc721 - mint to the module address",
+			types.NFT_STANDARD_ERC721,
+			func() {
+				topics := []common.Hash{erc721Transfer.ID, {}, types.ModuleAddress.Hash(), common.BigToHash(tokenID)}
+				receipt = &ethtypes.Receipt{
+					Logs: []*ethtypes.Log{{Address: pair.GetContract(), Topics: topics}},
+				}
+			},
+			true,
+			false,
+		},
+		{
+			"erc721 - missing token id topic",
+			types.NFT_STANDARD_ERC721,
+			func() {
+				topics := []common.Hash{erc721Transfer.ID, account.Hash(), types.ModuleAddress.Hash()}
+				receipt = &ethtypes.Receipt{
+					Logs: []*ethtypes.Log{{Address: pair.GetContract(), Topics: topics}},
+				}
+			},
+			true,
+			false,
+		},
+		{
+			"erc1155 - single unit transfer to the module address",
+			types.NFT_STANDARD_ERC1155,
+			func() {
+				topics := []common.Hash{erc1155Transfer.ID, account.Hash(), account.Hash(), types.ModuleAddress.Hash()}
+				receipt = &ethtypes.Receipt{
+					Logs: []*ethtypes.Log{{Address: pair.GetContract(), Topics: topics, Data: erc1155Data(1)}},
+				}
+			},
+			true,
+			true,
+		},
+		{
+			"erc1155 - multiple units transfer to the module address",
+			types.NFT_STANDARD_ERC1155,
+			func() {
+				topics := []common.Hash{erc1155Transfer.ID, account.Hash(), account.Hash(), types.ModuleAddress.Hash()}
+				receipt = &ethtypes.Receipt{
+					Logs: []*ethtypes.Log{{Address: pair.GetContract(), Topics: topics, Data: erc1155Data(2)}},
+				}
+			},
+			false,
+			false,
+		},
+		{
+			"erc1155 - multiple units transfer to another account",
+			types.NFT_STANDARD_ERC1155,
+			func() {
+				topics := []common.Hash{erc1155Transfer.ID, account.Hash(), account.Hash(), tests.GenerateAddress().Hash()}
+				receipt = &ethtypes.Receipt{
+					Logs: []*ethtypes.Log{{Address: pair.GetContract(), Topics: topics, Data: erc1155Data(2)}},
+				}
+			},
+			true,
+			false,
+		},
+		{
+			"erc1155 - undecodable transfer to another account",
+			types.NFT_STANDARD_ERC1155,
+			func() {
+				topics := []common.Hash{erc1155Transfer.ID, account.Hash(), account.Hash(), tests.GenerateAddress().Hash()}
+				receipt = &ethtypes.Receipt{
+					Logs: []*ethtypes.Log{{Address: pair.GetContract(), Topics: topics}},
+				}
+			},
+			true,
+			false,
+		},
+		{
+			"erc1155 - batch transfer to the module address",
+			types.NFT_STANDARD_ERC1155,
+			func() {
+				topics := []common.Hash{erc1155Batch.ID, account.Hash(), account.Hash(), types.ModuleAddress.Hash()}
+				receipt = &ethtypes.Receipt{
+					Logs: []*ethtypes.Log{{Address: pair.GetContract(), Topics: topics}},
+				}
+			},
+			false,
+			false,
+		},
+		{
+			"erc1155 - batch transfer to another account",
+			types.NFT_STANDARD_ERC1155,
+			func() {
+				topics := []common.Hash{erc1155Batch.ID, account.Hash(), account.Hash(), tests.GenerateAddress().Hash()}
+				receipt = &ethtypes.Receipt{
+					Logs: []*ethtypes.Log{{Address: pair.GetContract(), Topics: topics}},
+				}
+			},
+			true,
+			false,
+		},
+	}
+
+	for _, tc := range testCases {
+		suite.Run(fmt.Sprintf("Case %s", tc.name), func() {
+			suite.SetupTest()
+
+			contractAddr, err := suite.DeployContract("coin", "token", erc20Decimals)
+			suite.Require().NoError(err)
+			suite.Commit()
+
+			pair, err = suite.app.Erc20Keeper.RegisterNFTPair(suite.ctx, contractAddr, tc.standard, "", "")
+			suite.Require().NoError(err)
+
+			tc.malleate()
+
+			err = suite.app.Erc20Keeper.Hooks().PostTxProcessing(suite.ctx, msg, receipt)
+			if tc.expPass {
+				suite.Require().NoError(err, tc.name)
+			} else {
+				suite.Require().Error(err, tc.name)
+			}
+
+			owner := suite.app.NFTKeeper.GetOwner(suite.ctx, pair.ClassID, types.CreateNFTID(tokenID))
+			if tc.expMint {
+				suite.Require().Equal(sdk.AccAddress(account.Bytes()), owner)
+			} else {
+				suite.Require().Nil(owner)
+			}
+		})
+	}
+}
@@ -2,19 +2,34 @@ package keeper
 
 import (
 	errorsmod "cosmossdk.io/errors"
+	"cosmossdk.io/math"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	errortypes "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/cosmos/cosmos-sdk/types/query"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
 	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
 	"github.com/ethereum/go-ethereum/common"
 
+	"github.com/evmos/evmos/v10/contracts"
 	"github.com/evmos/evmos/v10/x/erc20/types"
 )
 
-// RegisterCoin deploys an erc20 contract and creates the token pair for the
-// existing cosmos coin
+// RegisterCoin deploys an erc20 contract from the default template and creates
+// the token pair for the existing cosmos coin
 func (k Keeper) RegisterCoin(
 	ctx sdk.Context,
 	coinMetadata banktypes.Metadata,
+) (*types.TokenPair, error) {
+	return k.RegisterCoinWithTemplate(ctx, coinMetadata, types.DefaultERC20TemplateID)
+}
+
+// RegisterCoinWithTemplate deploys an erc20 contract from the given template
+// and creates the token pair for the existing cosmos coin. The default template
+// is used if the template ID is empty.
+func (k Keeper) RegisterCoinWithTemplate(
+	ctx sdk.Context,
+	coinMetadata banktypes.Metadata,
+	templateID string,
 ) (*types.TokenPair, error) {
 	// Check if denomination is already registered
 	if k.IsDenomRegistered(ctx, coinMetadata.Name) {
@@ -36,7 +51,16 @@ func (k Keeper) RegisterCoin(
 		)
 	}
 
-	addr, err := k.DeployERC20Contract(ctx, coinMetadata)
+	if templateID == "" {
+		templateID = types.DefaultERC20TemplateID
+	}
+
+	bytecode, err := k.GetERC20TemplateBytecode(ctx, templateID)
+	if err != nil {
+		return nil, err
+	}
+
+	addr, err := k.DeployERC20Contract(ctx, coinMetadata, bytecode)
 	if err != nil {
 		return nil, errorsmod.Wrap(
 			err, "failed to create wrapped coin denom metadata for ERC20",
@@ -44,6 +68,7 @@ func (k Keeper) RegisterCoin(
 	}
 
 	pair := types.NewTokenPair(addr, coinMetadata.Base, true, types.OWNER_MODULE)
+	pair.TemplateID = templateID
 	k.SetTokenPair(ctx, pair)
 	k.SetDenomMap(ctx, pair.Denom, pair.GetID())
 	k.SetERC20Map(ctx, common.HexToAddress(pair.Erc20Address), pair.GetID())
@@ -64,6 +89,12 @@ func (k Keeper) RegisterERC20(
 		)
 	}
 
+	if k.IsNFTContractRegistered(ctx, contract) {
+		return nil, errorsmod.Wrapf(
+			types.ErrNFTPairAlreadyExists, "contract already registered as NFT contract: %s", contract.String(),
+		)
+	}
+
 	metadata, err := k.CreateCoinMetadata(ctx, contract)
 	if err != nil {
 		return nil, errorsmod.Wrap(
@@ -105,10 +136,84 @@ func (k Keeper) CreateCoinMetadata(
 		)
 	}
 
+	metadata, err := newCoinMetadata(contract, erc20Data)
+	if err != nil {
+		return nil, err
+	}
+
+	k.bankKeeper.SetDenomMetaData(ctx, metadata)
+
+	return &metadata, nil
+}
+
+// RefreshCoinMetadata queries the ERC20 contract of an externally owned token
+// pair and updates the bank metadata of its coin if the token details changed.
+// The refresh fails if the decimals of the ERC20 differ from the stored
+// metadata, since the converted amounts would no longer match.
+func (k Keeper) RefreshCoinMetadata(
+	ctx sdk.Context,
+	pair types.TokenPair,
+) (banktypes.Metadata, bool, error) {
+	if !pair.IsNativeERC20() {
+		return banktypes.Metadata{}, false, errorsmod.Wrapf(
+			errortypes.ErrInvalidRequest, "token pair %s is not owned by an external contract", pair.Erc20Address,
+		)
+	}
+
+	previous, found := k.bankKeeper.GetDenomMetaData(ctx, pair.Denom)
+	if !found {
+		return banktypes.Metadata{}, false, errorsmod.Wrapf(
+			types.ErrInternalTokenPair, "denom metadata not registered for %s", pair.Denom,
+		)
+	}
+
+	contract := pair.GetERC20Contract()
+	erc20Data, err := k.QueryERC20(ctx, contract)
+	if err != nil {
+		return banktypes.Metadata{}, false, err
+	}
+
+	if decimals := types.GetMetadataDecimals(previous); decimals != uint32(erc20Data.Decimals) {
+		return banktypes.Metadata{}, false, errorsmod.Wrapf(
+			types.ErrDecimalsMismatch,
+			"contract %s decimals changed from %d to %d", pair.Erc20Address, decimals, erc20Data.Decimals,
+		)
+	}
+
+	metadata, err := newCoinMetadata(contract, erc20Data)
+	if err != nil {
+		return banktypes.Metadata{}, false, err
+	}
+
+	if err := types.EqualMetadata(previous, metadata); err == nil {
+		return metadata, false, nil
+	}
+
+	k.bankKeeper.SetDenomMetaData(ctx, metadata)
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeRefreshMetadata,
+			sdk.NewAttribute(types.AttributeKeyCosmosCoin, pair.Denom),
+			sdk.NewAttribute(types.AttributeKeyERC20Token, pair.Erc20Address),
+			sdk.NewAttribute(types.AttributeKeyPreviousSymbol, previous.Symbol),
+			sdk.NewAttribute(types.AttributeKeySymbol, metadata.Symbol),
+			sdk.NewAttribute(types.AttributeKeyPreviousDisplay, previous.Display),
+			sdk.NewAttribute(types.AttributeKeyDisplay, metadata.Display),
+		),
+	)
+
+	return metadata, true, nil
+}
+
+// newCoinMetadata creates a bank denom metadata based on the ERC20 token ABI
+// details
+func newCoinMetadata(contract common.Address, erc20Data types.ERC20Data) (banktypes.Metadata, error) {
+	strContract := contract.String()
+
 	// base denomination
 	base := types.CreateDenom(strContract)
 
-	// create a bank denom metadata based on the ERC20 token ABI details
 	// metadata name is should always be the contract since it's the key
 	// to the bank store
 	metadata := banktypes.Metadata{
@@ -140,14 +245,12 @@ func (k Keeper) CreateCoinMetadata(
 	}
 
 	if err := metadata.Validate(); err != nil {
-		return nil, errorsmod.Wrapf(
+		return banktypes.Metadata{}, errorsmod.Wrapf(
 			err, "ERC20 token data is invalid for contract %s", strContract,
 		)
 	}
 
-	k.bankKeeper.SetDenomMetaData(ctx, metadata)
-
-	return &metadata, nil
+	return metadata, nil
 }
 
 // ToggleConversion toggles conversion for a given token pair
@@ -171,10 +274,256 @@ func (k Keeper) ToggleConversion(
 
 	pair.Enabled = !pair.Enabled
 
+	// the conversion of the pair is decided by governance from now on, so the
+	// epoch reset must not enable it again
+	if rateLimit, found := k.GetTokenPairRateLimit(ctx, pair.GetERC20Contract()); found && rateLimit.Paused {
+		rateLimit.Paused = false
+		k.SetTokenPairRateLimit(ctx, rateLimit)
+	}
+
 	k.SetTokenPair(ctx, pair)
 	return pair, nil
 }
 
+// SlashRegistrationDeposit burns the registration deposit of a token pair
+// registered through MsgRegisterERC20 and removes the pair from the token
+// mapping. The balances escrowed for the pair are unwound as in
+// DeregisterTokenPair, so that the holders of the Cosmos coin representation
+// receive the escrowed ERC20 tokens.
+func (k Keeper) SlashRegistrationDeposit(
+	ctx sdk.Context,
+	contract common.Address,
+) (types.TokenPairDeposit, error) {
+	deposit, found := k.GetTokenPairDeposit(ctx, contract)
+	if !found {
+		return types.TokenPairDeposit{}, errorsmod.Wrapf(
+			types.ErrDepositNotFound, "token '%s' not registered with a deposit", contract,
+		)
+	}
+
+	pair, found := k.GetTokenPair(ctx, k.GetERC20Map(ctx, contract))
+	if !found {
+		return types.TokenPairDeposit{}, errorsmod.Wrapf(
+			types.ErrTokenPairNotFound, "token '%s' not registered", contract,
+		)
+	}
+
+	if _, err := k.unwindTokenPair(ctx, pair); err != nil {
+		return types.TokenPairDeposit{}, err
+	}
+
+	if !deposit.Amount.IsZero() {
+		if err := k.bankKeeper.BurnCoins(ctx, types.ModuleName, deposit.Amount); err != nil {
+			return types.TokenPairDeposit{}, errorsmod.Wrap(err, "failed to burn registration deposit")
+		}
+	}
+
+	k.DeleteTokenPairDeposit(ctx, contract)
+	k.DeleteTokenPair(ctx, pair)
+
+	return deposit, nil
+}
+
+// DeregisterTokenPair removes a token pair from the token mapping after
+// unwinding the balances escrowed by the module:
+//   - OWNER_MODULE: the ERC20 token supply must be zero, i.e. all the holders
+//     converted back to Cosmos coins. The coins left on the module account are
+//     no longer backing any token and are burned.
+//   - OWNER_EXTERNAL: every holder of the Cosmos coin representation gets its
+//     coins burned and receives the escrowed ERC20 tokens held by the module
+//     address on its hex address
+//
+// The registration deposit, if any, is refunded to the depositor. The function
+// returns the token pair and the amount that was unwound.
+func (k Keeper) DeregisterTokenPair(
+	ctx sdk.Context,
+	token string,
+) (types.TokenPair, math.Int, error) {
+	id := k.GetTokenPairID(ctx, token)
+	if len(id) == 0 {
+		return types.TokenPair{}, math.Int{}, errorsmod.Wrapf(
+			types.ErrTokenPairNotFound, "token '%s' not registered by id", token,
+		)
+	}
+
+	pair, found := k.GetTokenPair(ctx, id)
+	if !found {
+		return types.TokenPair{}, math.Int{}, errorsmod.Wrapf(
+			types.ErrTokenPairNotFound, "token '%s' not registered", token,
+		)
+	}
+
+	unwound, err := k.unwindTokenPair(ctx, pair)
+	if err != nil {
+		return types.TokenPair{}, math.Int{}, err
+	}
+
+	contract := pair.GetERC20Contract()
+	if err := k.RefundTokenPairDeposit(ctx, contract); err != nil {
+		return types.TokenPair{}, math.Int{}, err
+	}
+
+	k.DeleteTokenPair(ctx, pair)
+
+	return pair, unwound, nil
+}
+
+// unwindTokenPair unwinds the balances escrowed by the module for the given
+// token pair and returns the unwound amount
+func (k Keeper) unwindTokenPair(ctx sdk.Context, pair types.TokenPair) (math.Int, error) {
+	switch {
+	case pair.IsNativeCoin():
+		return k.unwindNativeCoinEscrow(ctx, pair)
+	case pair.IsNativeERC20():
+		return k.unwindNativeERC20Escrow(ctx, pair)
+	default:
+		return math.Int{}, types.ErrUndefinedOwner
+	}
+}
+
+// unwindNativeCoinEscrow checks that no ERC20 token of a token pair with a
+// native Cosmos coin is left in circulation, so that the escrowed coins don't
+// back any token anymore. The coins left on the module account, if any, are
+// the counterpart of tokens that holders burned directly on the ERC20 contract
+// and are burned as well.
+func (k Keeper) unwindNativeCoinEscrow(ctx sdk.Context, pair types.TokenPair) (math.Int, error) {
+	erc20 := contracts.ERC20MinterBurnerDecimalsContract.ABI
+	supply := k.TotalSupply(ctx, erc20, pair.GetERC20Contract())
+	if supply == nil {
+		return math.Int{}, errorsmod.Wrap(types.ErrEVMCall, "failed to retrieve total supply")
+	}
+
+	if supply.Sign() > 0 {
+		return math.Int{}, errorsmod.Wrapf(
+			types.ErrUnwindTokenPair,
+			"ERC20 total supply is %s, holders must convert their tokens back to %s before deregistration",
+			supply, pair.Denom,
+		)
+	}
+
+	escrow := k.GetEscrowedBalance(ctx, pair.Denom)
+	if escrow.IsPositive() {
+		if err := k.bankKeeper.BurnCoins(ctx, types.ModuleName, sdk.Coins{escrow}); err != nil {
+			return math.Int{}, errorsmod.Wrap(err, "failed to burn unbacked escrowed coins")
+		}
+	}
+
+	return escrow.Amount, nil
+}
+
+// unwindNativeERC20Escrow settles the ERC20 tokens escrowed on the module
+// address for a token pair with a native ERC20 token. The coins of every holder
+// of the Cosmos coin representation are burned and the equivalent amount of
+// tokens is transferred to the holder's hex address.
+//
+// The unwinding fails without modifying any balance if the coins are held by
+// more than MaxDeregistrationHolders accounts, by a module or blocked account,
+// e.g. the IBC transfer escrow, or are locked by a vesting schedule. In that
+// case, the holders have to convert their coins back with MsgConvertCoin, or
+// the coins have to be returned to their owners, before the pair can be
+// deregistered.
+func (k Keeper) unwindNativeERC20Escrow(ctx sdk.Context, pair types.TokenPair) (math.Int, error) {
+	erc20 := contracts.ERC20MinterBurnerDecimalsContract.ABI
+	contract := pair.GetERC20Contract()
+
+	escrow := k.BalanceOf(ctx, erc20, contract, types.ModuleAddress)
+	if escrow == nil {
+		return math.Int{}, errorsmod.Wrapf(
+			types.ErrEVMCall, "failed to retrieve escrowed balance, contract %s might be destroyed", contract,
+		)
+	}
+
+	supply := k.bankKeeper.GetSupply(ctx, pair.Denom)
+	if escrow.Cmp(supply.Amount.BigInt()) < 0 {
+		return math.Int{}, errorsmod.Wrapf(
+			types.ErrEscrowMismatch,
+			"escrowed tokens %s, coin supply %s", escrow, supply,
+		)
+	}
+
+	// collect the holders before settling, as burning the coins modifies the
+	// denom owners index
+	req := &banktypes.QueryDenomOwnersRequest{
+		Denom:      pair.Denom,
+		Pagination: &query.PageRequest{Limit: types.MaxDeregistrationHolders + 1},
+	}
+	res, err := k.bankKeeper.DenomOwners(sdk.WrapSDKContext(ctx), req)
+	if err != nil {
+		return math.Int{}, err
+	}
+
+	if len(res.DenomOwners) > types.MaxDeregistrationHolders {
+		return math.Int{}, errorsmod.Wrapf(
+			types.ErrUnwindTokenPair,
+			"%s is held by more than %d accounts, holders must convert their coins back before deregistration",
+			pair.Denom, types.MaxDeregistrationHolders,
+		)
+	}
+
+	for _, owner := range res.DenomOwners {
+		if err := k.validateUnwindHolder(ctx, owner); err != nil {
+			return math.Int{}, err
+		}
+	}
+
+	for _, owner := range res.DenomOwners {
+		holder := sdk.MustAccAddressFromBech32(owner.Address)
+		coins := sdk.Coins{owner.Balance}
+
+		if err := k.bankKeeper.SendCoinsFromAccountToModule(ctx, holder, types.ModuleName, coins); err != nil {
+			return math.Int{}, errorsmod.Wrap(err, "failed to escrow coins")
+		}
+
+		if err := k.bankKeeper.BurnCoins(ctx, types.ModuleName, coins); err != nil {
+			return math.Int{}, errorsmod.Wrap(err, "failed to burn coins")
+		}
+
+		receiver := common.BytesToAddress(holder.Bytes())
+		res, err := k.CallEVM(ctx, erc20, types.ModuleAddress, contract, true, "transfer", receiver, owner.Balance.Amount.BigInt())
+		if err != nil {
+			return math.Int{}, errorsmod.Wrapf(
+				err, "failed to transfer escrowed tokens to %s, contract %s might be paused", receiver, contract,
+			)
+		}
+
+		var unpackedRet types.ERC20BoolResponse
+		if err := erc20.UnpackIntoInterface(&unpackedRet, "transfer", res.Ret); err != nil {
+			return math.Int{}, err
+		}
+
+		if !unpackedRet.Value {
+			return math.Int{}, errorsmod.Wrap(errortypes.ErrLogic, "failed to execute unescrow tokens to holder")
+		}
+	}
+
+	return supply.Amount, nil
+}
+
+// validateUnwindHolder checks that the Cosmos coins of a native ERC20 token
+// pair held by the given owner can be burned and exchanged for the escrowed
+// tokens. Module and blocked accounts, such as the IBC transfer escrow, hold
+// coins on behalf of other accounts and can't receive the tokens.
+func (k Keeper) validateUnwindHolder(ctx sdk.Context, owner *banktypes.DenomOwner) error {
+	holder := sdk.MustAccAddressFromBech32(owner.Address)
+
+	if _, isModule := k.accountKeeper.GetAccount(ctx, holder).(authtypes.ModuleAccountI); isModule || k.bankKeeper.BlockedAddr(holder) {
+		return errorsmod.Wrapf(
+			types.ErrUnwindTokenPair,
+			"%s are held by module or blocked account %s and can't be unwound", owner.Balance, holder,
+		)
+	}
+
+	spendable := k.bankKeeper.SpendableCoins(ctx, holder).AmountOf(owner.Balance.Denom)
+	if spendable.LT(owner.Balance.Amount) {
+		return errorsmod.Wrapf(
+			types.ErrUnwindTokenPair,
+			"%s of %s are locked, only %s are spendable", owner.Balance, holder, spendable,
+		)
+	}
+
+	return nil
+}
+
 // verifyMetadata verifies if the metadata matches the existing one, if not it
 // sets it to the store
 func (k Keeper) verifyMetadata(
@@ -2,9 +2,11 @@ package keeper_test
 
 import (
 	"fmt"
+	"math/big"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+	ibctransfertypes "github.com/cosmos/ibc-go/v5/modules/apps/transfer/types"
 	"github.com/stretchr/testify/mock"
 
 	"github.com/ethereum/go-ethereum/common"
@@ -12,6 +14,8 @@ import (
 	"github.com/evmos/ethermint/tests"
 	evmtypes "github.com/evmos/ethermint/x/evm/types"
 
+	"github.com/evmos/evmos/v10/contracts"
+	"github.com/evmos/evmos/v10/testutil"
 	"github.com/evmos/evmos/v10/x/erc20/keeper"
 	"github.com/evmos/evmos/v10/x/erc20/types"
 	inflationtypes "github.com/evmos/evmos/v10/x/inflation/types"
@@ -198,7 +202,7 @@ func (suite KeeperTestSuite) TestRegisterCoin() {
 				sp, found := suite.app.ParamsKeeper.GetSubspace(types.ModuleName)
 				suite.Require().True(found)
 				suite.app.Erc20Keeper = keeper.NewKeeper(suite.app.GetKey("erc20"), suite.app.AppCodec(), sp,
-					suite.app.AccountKeeper, suite.app.BankKeeper, mockEVMKeeper, suite.app.StakingKeeper, suite.app.ClaimsKeeper)
+					suite.app.AccountKeeper, suite.app.BankKeeper, suite.app.NFTKeeper, mockEVMKeeper, suite.app.StakingKeeper, suite.app.ClaimsKeeper)
 				mockEVMKeeper.On("EstimateGas", mock.Anything, mock.Anything).Return(&evmtypes.EstimateGasResponse{Gas: uint64(200)}, nil)
 				mockEVMKeeper.On("ApplyMessage", mock.Anything, mock.Anything, mock.Anything, mock.Anything).Return(nil, fmt.Errorf("forced ApplyMessage error"))
 			},
@@ -231,6 +235,7 @@ func (suite KeeperTestSuite) TestRegisterCoin() {
 				Denom:         "acoin",
 				Enabled:       true,
 				ContractOwner: 1,
+				TemplateID:    types.DefaultERC20TemplateID,
 			}
 
 			if tc.expPass {
@@ -286,7 +291,7 @@ func (suite KeeperTestSuite) TestRegisterERC20() {
 				sp, found := suite.app.ParamsKeeper.GetSubspace(types.ModuleName)
 				suite.Require().True(found)
 				suite.app.Erc20Keeper = keeper.NewKeeper(suite.app.GetKey("erc20"), suite.app.AppCodec(), sp,
-					suite.app.AccountKeeper, suite.app.BankKeeper, mockEVMKeeper, suite.app.StakingKeeper, suite.app.ClaimsKeeper)
+					suite.app.AccountKeeper, suite.app.BankKeeper, suite.app.NFTKeeper, mockEVMKeeper, suite.app.StakingKeeper, suite.app.ClaimsKeeper)
 				mockEVMKeeper.On("EstimateGas", mock.Anything, mock.Anything).Return(&evmtypes.EstimateGasResponse{Gas: uint64(200)}, nil)
 				mockEVMKeeper.On("ApplyMessage", mock.Anything, mock.Anything, mock.Anything, mock.Anything).Return(nil, fmt.Errorf("forced ApplyMessage error"))
 			},
@@ -411,3 +416,253 @@ func (suite KeeperTestSuite) TestToggleConverision() {
 		})
 	}
 }
+
+func (suite *KeeperTestSuite) TestSlashRegistrationDeposit() {
+	var (
+		contractAddr common.Address
+		converted    int64
+	)
+	depositor := sdk.AccAddress(tests.GenerateAddress().Bytes())
+	deposit := sdk.NewCoins(sdk.NewCoin("aevmos", sdk.NewInt(1000)))
+	sender := sdk.AccAddress(tests.GenerateAddress().Bytes())
+
+	testCases := []struct {
+		name     string
+		malleate func()
+		expPass  bool
+	}{
+		{
+			"fail - pair registered without deposit",
+			func() {
+				contractAddr = suite.setupRegisterERC20Pair(contractMinterBurner)
+			},
+			false,
+		},
+		{
+			"fail - deposit without token pair",
+			func() {
+				contractAddr = tests.GenerateAddress()
+				suite.app.Erc20Keeper.SetTokenPairDeposit(suite.ctx, types.NewTokenPairDeposit(contractAddr, depositor, deposit))
+			},
+			false,
+		},
+		{
+			"ok",
+			func() {
+				contractAddr = suite.setupRegisterERC20Pair(contractMinterBurner)
+				err := testutil.FundModuleAccount(suite.ctx, suite.app.BankKeeper, types.ModuleName, deposit)
+				suite.Require().NoError(err)
+				suite.app.Erc20Keeper.SetTokenPairDeposit(suite.ctx, types.NewTokenPairDeposit(contractAddr, depositor, deposit))
+			},
+			true,
+		},
+		{
+			"ok - converted coins are unwound",
+			func() {
+				contractAddr = suite.setupRegisterERC20Pair(contractMinterBurner)
+				err := testutil.FundModuleAccount(suite.ctx, suite.app.BankKeeper, types.ModuleName, deposit)
+				suite.Require().NoError(err)
+				suite.app.Erc20Keeper.SetTokenPairDeposit(suite.ctx, types.NewTokenPairDeposit(contractAddr, depositor, deposit))
+
+				suite.MintERC20Token(contractAddr, suite.address, suite.address, big.NewInt(100))
+				suite.Commit()
+
+				converted = 10
+				msg := types.NewMsgConvertERC20(sdk.NewInt(converted), sender, contractAddr, suite.address)
+				_, err = suite.app.Erc20Keeper.ConvertERC20(sdk.WrapSDKContext(suite.ctx), msg)
+				suite.Require().NoError(err)
+			},
+			true,
+		},
+	}
+	for _, tc := range testCases {
+		suite.Run(fmt.Sprintf("Case %s", tc.name), func() {
+			suite.SetupTest() // reset
+			converted = 0
+
+			tc.malleate()
+			supply := suite.app.BankKeeper.GetSupply(suite.ctx, "aevmos")
+
+			slashed, err := suite.app.Erc20Keeper.SlashRegistrationDeposit(suite.ctx, contractAddr)
+			if tc.expPass {
+				suite.Require().NoError(err, tc.name)
+				suite.Require().Equal(depositor.String(), slashed.Depositor)
+
+				_, found := suite.app.Erc20Keeper.GetTokenPairDeposit(suite.ctx, contractAddr)
+				suite.Require().False(found)
+				suite.Require().False(suite.app.Erc20Keeper.IsERC20Registered(suite.ctx, contractAddr))
+
+				supplyAfter := suite.app.BankKeeper.GetSupply(suite.ctx, "aevmos")
+				suite.Require().Equal(supply.Sub(deposit[0]), supplyAfter)
+
+				denom := types.CreateDenom(contractAddr.String())
+				suite.Require().True(suite.app.BankKeeper.GetSupply(suite.ctx, denom).IsZero())
+				balance := suite.BalanceOf(contractAddr, common.BytesToAddress(sender.Bytes()))
+				suite.Require().Equal(converted, balance.(*big.Int).Int64())
+			} else {
+				suite.Require().Error(err, tc.name)
+			}
+		})
+	}
+}
+
+func (suite *KeeperTestSuite) TestDeregisterTokenPair() {
+	var (
+		token      string
+		erc20      common.Address
+		denom      string
+		nativeCoin bool
+	)
+	depositor := sdk.AccAddress(tests.GenerateAddress().Bytes())
+	deposit := sdk.NewCoins(sdk.NewCoin("aevmos", sdk.NewInt(1000)))
+	sender := sdk.AccAddress(tests.GenerateAddress().Bytes())
+
+	testCases := []struct {
+		name     string
+		malleate func()
+		expPass  bool
+	}{
+		{
+			"fail - token pair not registered",
+			func() {
+				token = cosmosTokenBase
+			},
+			false,
+		},
+		{
+			"fail - native coin pair with unbacked ERC20 supply",
+			func() {
+				pair := suite.setupRegisterCoin(metadataCoin)
+				erc20 = pair.GetERC20Contract()
+				token = pair.Denom
+
+				abi := contracts.ERC20MinterBurnerDecimalsContract.ABI
+				_, err := suite.app.Erc20Keeper.CallEVM(suite.ctx, abi, types.ModuleAddress, erc20, true, "mint", suite.address, big.NewInt(10))
+				suite.Require().NoError(err)
+			},
+			false,
+		},
+		{
+			"fail - native coin pair with ERC20 tokens in circulation",
+			func() {
+				pair := suite.setupRegisterCoin(metadataCoin)
+				erc20 = pair.GetERC20Contract()
+				token = erc20.String()
+
+				coins := sdk.NewCoins(sdk.NewCoin(cosmosTokenBase, sdk.NewInt(100)))
+				err := testutil.FundAccount(suite.ctx, suite.app.BankKeeper, sender, coins)
+				suite.Require().NoError(err)
+
+				msg := types.NewMsgConvertCoin(sdk.NewCoin(cosmosTokenBase, sdk.NewInt(10)), suite.address, sender)
+				_, err = suite.app.Erc20Keeper.ConvertCoin(sdk.WrapSDKContext(suite.ctx), msg)
+				suite.Require().NoError(err)
+			},
+			false,
+		},
+		{
+			"ok - native coin pair with ERC20 tokens burned by the holder",
+			func() {
+				pair := suite.setupRegisterCoin(metadataCoin)
+				erc20 = pair.GetERC20Contract()
+				token = erc20.String()
+				denom = pair.Denom
+				nativeCoin = true
+
+				coins := sdk.NewCoins(sdk.NewCoin(cosmosTokenBase, sdk.NewInt(100)))
+				err := testutil.FundAccount(suite.ctx, suite.app.BankKeeper, sender, coins)
+				suite.Require().NoError(err)
+
+				holder := common.BytesToAddress(sender.Bytes())
+				msg := types.NewMsgConvertCoin(sdk.NewCoin(cosmosTokenBase, sdk.NewInt(10)), holder, sender)
+				_, err = suite.app.Erc20Keeper.ConvertCoin(sdk.WrapSDKContext(suite.ctx), msg)
+				suite.Require().NoError(err)
+
+				abi := contracts.ERC20MinterBurnerDecimalsContract.ABI
+				_, err = suite.app.Erc20Keeper.CallEVM(suite.ctx, abi, holder, erc20, true, "burn", big.NewInt(10))
+				suite.Require().NoError(err)
+			},
+			true,
+		},
+		{
+			"fail - native ERC20 pair with coins held by a module account",
+			func() {
+				erc20 = suite.setupRegisterERC20Pair(contractMinterBurner)
+				token = erc20.String()
+				denom = types.CreateDenom(erc20.String())
+
+				suite.MintERC20Token(erc20, suite.address, suite.address, big.NewInt(100))
+				suite.Commit()
+
+				msg := types.NewMsgConvertERC20(sdk.NewInt(10), sender, erc20, suite.address)
+				_, err := suite.app.Erc20Keeper.ConvertERC20(sdk.WrapSDKContext(suite.ctx), msg)
+				suite.Require().NoError(err)
+
+				coins := sdk.NewCoins(sdk.NewCoin(denom, sdk.NewInt(5)))
+				err = suite.app.BankKeeper.SendCoinsFromAccountToModule(suite.ctx, sender, ibctransfertypes.ModuleName, coins)
+				suite.Require().NoError(err)
+			},
+			false,
+		},
+		{
+			"ok - native ERC20 pair with deposit",
+			func() {
+				erc20 = suite.setupRegisterERC20Pair(contractMinterBurner)
+				token = erc20.String()
+				denom = types.CreateDenom(erc20.String())
+				nativeCoin = false
+
+				err := testutil.FundModuleAccount(suite.ctx, suite.app.BankKeeper, types.ModuleName, deposit)
+				suite.Require().NoError(err)
+				suite.app.Erc20Keeper.SetTokenPairDeposit(suite.ctx, types.NewTokenPairDeposit(erc20, depositor, deposit))
+
+				suite.MintERC20Token(erc20, suite.address, suite.address, big.NewInt(100))
+				suite.Commit()
+
+				msg := types.NewMsgConvertERC20(sdk.NewInt(10), sender, erc20, suite.address)
+				_, err = suite.app.Erc20Keeper.ConvertERC20(sdk.WrapSDKContext(suite.ctx), msg)
+				suite.Require().NoError(err)
+			},
+			true,
+		},
+	}
+	for _, tc := range testCases {
+		suite.Run(fmt.Sprintf("Case %s", tc.name), func() {
+			suite.mintFeeCollector = true
+			suite.SetupTest() // reset
+
+			tc.malleate()
+
+			pair, unwound, err := suite.app.Erc20Keeper.DeregisterTokenPair(suite.ctx, token)
+			if tc.expPass {
+				suite.Require().NoError(err, tc.name)
+				suite.Require().Equal(sdk.NewInt(10), unwound)
+				suite.Require().Equal(erc20.String(), pair.Erc20Address)
+
+				suite.Require().False(suite.app.Erc20Keeper.IsERC20Registered(suite.ctx, erc20))
+				suite.Require().False(suite.app.Erc20Keeper.IsDenomRegistered(suite.ctx, denom))
+				_, found := suite.app.Erc20Keeper.GetTokenPairDeposit(suite.ctx, erc20)
+				suite.Require().False(found)
+
+				moduleAddr := suite.app.AccountKeeper.GetModuleAddress(types.ModuleName)
+				suite.Require().True(suite.app.BankKeeper.GetAllBalances(suite.ctx, moduleAddr).IsZero())
+
+				if nativeCoin {
+					supply := suite.app.BankKeeper.GetSupply(suite.ctx, denom)
+					suite.Require().Equal(int64(91), supply.Amount.Int64())
+				} else {
+					suite.Require().True(suite.app.BankKeeper.GetSupply(suite.ctx, denom).IsZero())
+					suite.Require().Equal(deposit, suite.app.BankKeeper.GetAllBalances(suite.ctx, depositor))
+
+					balance := suite.BalanceOf(erc20, common.BytesToAddress(sender.Bytes()))
+					suite.Require().Equal(int64(10), balance.(*big.Int).Int64())
+				}
+
+				_, broken := suite.app.Erc20Keeper.EscrowBackingInvariant()(suite.ctx)
+				suite.Require().False(broken)
+			} else {
+				suite.Require().Error(err, tc.name)
+			}
+		})
+	}
+	suite.mintFeeCollector = false
+}
@@ -0,0 +1,200 @@
+package keeper
+
+import (
+	errorsmod "cosmossdk.io/errors"
+	"cosmossdk.io/math"
+	"github.com/cosmos/cosmos-sdk/store/prefix"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/ethereum/go-ethereum/common"
+
+	"github.com/evmos/evmos/v10/x/erc20/types"
+)
+
+// GetTokenPairRateLimits returns all the token pair conversion rate limits
+func (k Keeper) GetTokenPairRateLimits(ctx sdk.Context) []types.TokenPairRateLimit {
+	rateLimits := []types.TokenPairRateLimit{}
+
+	k.IterateTokenPairRateLimits(ctx, func(rateLimit types.TokenPairRateLimit) (stop bool) {
+		rateLimits = append(rateLimits, rateLimit)
+		return false
+	})
+
+	return rateLimits
+}
+
+// IterateTokenPairRateLimits iterates over all the stored rate limits
+func (k Keeper) IterateTokenPairRateLimits(ctx sdk.Context, cb func(rateLimit types.TokenPairRateLimit) (stop bool)) {
+	store := ctx.KVStore(k.storeKey)
+	iterator := sdk.KVStorePrefixIterator(store, types.KeyPrefixTokenPairRateLimit)
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		var rateLimit types.TokenPairRateLimit
+		k.cdc.MustUnmarshal(iterator.Value(), &rateLimit)
+
+		if cb(rateLimit) {
+			break
+		}
+	}
+}
+
+// GetTokenPairRateLimit returns the conversion rate limit for the given ERC20
+// contract
+func (k Keeper) GetTokenPairRateLimit(ctx sdk.Context, erc20 common.Address) (types.TokenPairRateLimit, bool) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefixTokenPairRateLimit)
+	bz := store.Get(erc20.Bytes())
+	if len(bz) == 0 {
+		return types.TokenPairRateLimit{}, false
+	}
+
+	var rateLimit types.TokenPairRateLimit
+	k.cdc.MustUnmarshal(bz, &rateLimit)
+	return rateLimit, true
+}
+
+// SetTokenPairRateLimit stores a conversion rate limit
+func (k Keeper) SetTokenPairRateLimit(ctx sdk.Context, rateLimit types.TokenPairRateLimit) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefixTokenPairRateLimit)
+	bz := k.cdc.MustMarshal(&rateLimit)
+	store.Set(rateLimit.GetERC20Contract().Bytes(), bz)
+}
+
+// DeleteTokenPairRateLimit removes the conversion rate limit for the given
+// ERC20 contract
+func (k Keeper) DeleteTokenPairRateLimit(ctx sdk.Context, erc20 common.Address) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefixTokenPairRateLimit)
+	store.Delete(erc20.Bytes())
+}
+
+// SetRateLimit sets the conversion rate limit of a token pair, resetting the
+// volumes converted during the current epoch. The rate limit is removed if
+// both limits are zero. A token pair that was paused by its rate limit is
+// enabled again.
+func (k Keeper) SetRateLimit(
+	ctx sdk.Context,
+	token, epochIdentifier string,
+	coinToERC20Limit, erc20ToCoinLimit math.Int,
+) (types.TokenPair, error) {
+	id := k.GetTokenPairID(ctx, token)
+	if len(id) == 0 {
+		return types.TokenPair{}, errorsmod.Wrapf(
+			types.ErrTokenPairNotFound, "token '%s' not registered by id", token,
+		)
+	}
+
+	pair, found := k.GetTokenPair(ctx, id)
+	if !found {
+		return types.TokenPair{}, errorsmod.Wrapf(
+			types.ErrTokenPairNotFound, "token '%s' not registered", token,
+		)
+	}
+
+	erc20 := pair.GetERC20Contract()
+
+	if current, found := k.GetTokenPairRateLimit(ctx, erc20); found && current.Paused && !pair.Enabled {
+		pair.Enabled = true
+		k.SetTokenPair(ctx, pair)
+	}
+
+	if !coinToERC20Limit.IsPositive() && !erc20ToCoinLimit.IsPositive() {
+		k.DeleteTokenPairRateLimit(ctx, erc20)
+		return pair, nil
+	}
+
+	rateLimit := types.NewTokenPairRateLimit(erc20, epochIdentifier, coinToERC20Limit, erc20ToCoinLimit)
+	k.SetTokenPairRateLimit(ctx, rateLimit)
+	return pair, nil
+}
+
+// TrackConversion adds the amount to the volume converted during the current
+// epoch for the given token pair and direction and returns the amount that can
+// be converted. A conversion exceeding the remaining volume of the epoch is
+// capped to it, so that the excess is rejected, and the token pair is paused
+// once the limit is reached. It fails if there is no volume left. Callers that
+// don't accept a capped amount must track the conversion on a cached context.
+func (k Keeper) TrackConversion(
+	ctx sdk.Context,
+	pair types.TokenPair,
+	direction types.ConversionDirection,
+	amount math.Int,
+) (math.Int, error) {
+	rateLimit, found := k.GetTokenPairRateLimit(ctx, pair.GetERC20Contract())
+	if !found || !rateLimit.IsLimited(direction) {
+		return amount, nil
+	}
+
+	limit := rateLimit.Limit(direction)
+	remaining := limit.Sub(rateLimit.Volume(direction))
+
+	if !remaining.IsPositive() {
+		return math.Int{}, errorsmod.Wrapf(
+			types.ErrRateLimitExceeded,
+			"%s conversion of %s %s exceeds the remaining volume for epoch '%s': %s",
+			direction, amount, pair.Denom, rateLimit.EpochIdentifier, remaining,
+		)
+	}
+
+	accepted := math.MinInt(amount, remaining)
+	rateLimit = rateLimit.AddVolume(direction, accepted)
+
+	if rateLimit.Volume(direction).GTE(limit) {
+		rateLimit.Paused = true
+		pair.Enabled = false
+		k.SetTokenPair(ctx, pair)
+
+		ctx.EventManager().EmitEvent(
+			sdk.NewEvent(
+				types.EventTypeRateLimitReached,
+				sdk.NewAttribute(types.AttributeKeyCosmosCoin, pair.Denom),
+				sdk.NewAttribute(types.AttributeKeyERC20Token, pair.Erc20Address),
+				sdk.NewAttribute(types.AttributeKeyDirection, direction.String()),
+				sdk.NewAttribute(types.AttributeKeyEpochID, rateLimit.EpochIdentifier),
+			),
+		)
+	}
+
+	k.SetTokenPairRateLimit(ctx, rateLimit)
+	return accepted, nil
+}
+
+// ResetRateLimitVolumes resets the converted volumes of the rate limits tracked
+// against the given epoch identifier. Token pairs paused by their rate limit
+// are enabled again.
+func (k Keeper) ResetRateLimitVolumes(ctx sdk.Context, epochIdentifier string) {
+	k.IterateTokenPairRateLimits(ctx, func(rateLimit types.TokenPairRateLimit) (stop bool) {
+		if rateLimit.EpochIdentifier != epochIdentifier {
+			return false
+		}
+
+		if rateLimit.Paused {
+			k.resumeRateLimitedPair(ctx, rateLimit)
+			rateLimit.Paused = false
+		}
+
+		rateLimit.CoinToERC20Volume = math.ZeroInt()
+		rateLimit.ERC20ToCoinVolume = math.ZeroInt()
+		k.SetTokenPairRateLimit(ctx, rateLimit)
+		return false
+	})
+}
+
+// resumeRateLimitedPair enables the token pair that was paused by the given
+// rate limit
+func (k Keeper) resumeRateLimitedPair(ctx sdk.Context, rateLimit types.TokenPairRateLimit) {
+	pair, found := k.GetTokenPair(ctx, k.GetERC20Map(ctx, rateLimit.GetERC20Contract()))
+	if !found || pair.Enabled {
+		return
+	}
+
+	pair.Enabled = true
+	k.SetTokenPair(ctx, pair)
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeRateLimitReset,
+			sdk.NewAttribute(types.AttributeKeyCosmosCoin, pair.Denom),
+			sdk.NewAttribute(types.AttributeKeyERC20Token, pair.Erc20Address),
+			sdk.NewAttribute(types.AttributeKeyEpochID, rateLimit.EpochIdentifier),
+		),
+	)
+}
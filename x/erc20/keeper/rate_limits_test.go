@@ -0,0 +1,192 @@
+package keeper_test
+
+import (
+	"fmt"
+
+	errorsmod "cosmossdk.io/errors"
+	"cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/evmos/evmos/v10/testutil"
+	epochstypes "github.com/evmos/evmos/v10/x/epochs/types"
+	"github.com/evmos/evmos/v10/x/erc20/types"
+)
+
+func (suite *KeeperTestSuite) TestSetRateLimit() {
+	testCases := []struct {
+		name     string
+		malleate func(pair *types.TokenPair) string
+		limit    math.Int
+		expPass  bool
+		expFound bool
+	}{
+		{
+			"fail - token pair not registered",
+			func(*types.TokenPair) string { return "unregistered" },
+			math.NewInt(100),
+			false,
+			false,
+		},
+		{
+			"ok - set rate limit",
+			func(pair *types.TokenPair) string { return pair.Denom },
+			math.NewInt(100),
+			true,
+			true,
+		},
+		{
+			"ok - reset volumes of existing rate limit",
+			func(pair *types.TokenPair) string {
+				rateLimit := types.NewTokenPairRateLimit(pair.GetERC20Contract(), epochstypes.DayEpochID, math.NewInt(100), math.ZeroInt())
+				rateLimit.CoinToERC20Volume = math.NewInt(50)
+				suite.app.Erc20Keeper.SetTokenPairRateLimit(suite.ctx, rateLimit)
+				return pair.Erc20Address
+			},
+			math.NewInt(100),
+			true,
+			true,
+		},
+		{
+			"ok - re-enable paused token pair",
+			func(pair *types.TokenPair) string {
+				rateLimit := types.NewTokenPairRateLimit(pair.GetERC20Contract(), epochstypes.DayEpochID, math.NewInt(100), math.ZeroInt())
+				rateLimit.CoinToERC20Volume = math.NewInt(100)
+				rateLimit.Paused = true
+				suite.app.Erc20Keeper.SetTokenPairRateLimit(suite.ctx, rateLimit)
+
+				pair.Enabled = false
+				suite.app.Erc20Keeper.SetTokenPair(suite.ctx, *pair)
+				return pair.Denom
+			},
+			math.NewInt(100),
+			true,
+			true,
+		},
+		{
+			"ok - remove rate limit",
+			func(pair *types.TokenPair) string {
+				rateLimit := types.NewTokenPairRateLimit(pair.GetERC20Contract(), epochstypes.DayEpochID, math.NewInt(100), math.ZeroInt())
+				suite.app.Erc20Keeper.SetTokenPairRateLimit(suite.ctx, rateLimit)
+				return pair.Denom
+			},
+			math.ZeroInt(),
+			true,
+			false,
+		},
+	}
+	for _, tc := range testCases {
+		suite.Run(fmt.Sprintf("Case %s", tc.name), func() {
+			suite.SetupTest()
+
+			pair := suite.setupRegisterCoin(metadataCoin)
+			suite.Require().NotNil(pair)
+			token := tc.malleate(pair)
+
+			res, err := suite.app.Erc20Keeper.SetRateLimit(suite.ctx, token, epochstypes.DayEpochID, tc.limit, math.ZeroInt())
+			if !tc.expPass {
+				suite.Require().Error(err, tc.name)
+				return
+			}
+
+			suite.Require().NoError(err, tc.name)
+			suite.Require().True(res.Enabled)
+
+			rateLimit, found := suite.app.Erc20Keeper.GetTokenPairRateLimit(suite.ctx, pair.GetERC20Contract())
+			suite.Require().Equal(tc.expFound, found)
+			if tc.expFound {
+				suite.Require().True(tc.limit.Equal(rateLimit.CoinToERC20Limit))
+				suite.Require().True(rateLimit.CoinToERC20Volume.IsZero())
+				suite.Require().False(rateLimit.Paused)
+			}
+		})
+	}
+}
+
+func (suite *KeeperTestSuite) TestTrackConversion() {
+	suite.mintFeeCollector = true
+	suite.SetupTest()
+
+	pair := suite.setupRegisterCoin(metadataCoin)
+	suite.Require().NotNil(pair)
+
+	_, err := suite.app.Erc20Keeper.SetRateLimit(suite.ctx, pair.Denom, epochstypes.DayEpochID, math.NewInt(100), math.ZeroInt())
+	suite.Require().NoError(err)
+
+	sender := sdk.AccAddress(suite.address.Bytes())
+	err = testutil.FundAccount(suite.ctx, suite.app.BankKeeper, sender, sdk.NewCoins(sdk.NewCoin(pair.Denom, sdk.NewInt(1000))))
+	suite.Require().NoError(err)
+
+	convert := func(amount int64) error {
+		msg := types.NewMsgConvertCoin(sdk.NewCoin(pair.Denom, sdk.NewInt(amount)), suite.address, sender)
+		_, err := suite.app.Erc20Keeper.ConvertCoin(sdk.WrapSDKContext(suite.ctx), msg)
+		return err
+	}
+
+	// conversion within the limit is tracked
+	suite.Require().NoError(convert(60))
+	rateLimit, _ := suite.app.Erc20Keeper.GetTokenPairRateLimit(suite.ctx, pair.GetERC20Contract())
+	suite.Require().True(math.NewInt(60).Equal(rateLimit.CoinToERC20Volume))
+
+	// conversion in the unlimited direction is not tracked
+	accepted, err := suite.app.Erc20Keeper.TrackConversion(suite.ctx, *pair, types.ERC20ToCoin, math.NewInt(1000))
+	suite.Require().NoError(err)
+	suite.Require().Equal(math.NewInt(1000), accepted)
+
+	// conversion exceeding the remaining volume is rejected without being
+	// tracked
+	suite.Require().True(errorsmod.IsOf(convert(50), types.ErrRateLimitExceeded))
+	rateLimit, _ = suite.app.Erc20Keeper.GetTokenPairRateLimit(suite.ctx, pair.GetERC20Contract())
+	suite.Require().False(rateLimit.Paused)
+	suite.Require().True(math.NewInt(60).Equal(rateLimit.CoinToERC20Volume))
+
+	// conversion reaching the limit pauses the token pair
+	suite.Require().NoError(convert(40))
+	rateLimit, _ = suite.app.Erc20Keeper.GetTokenPairRateLimit(suite.ctx, pair.GetERC20Contract())
+	suite.Require().True(rateLimit.Paused)
+	suite.Require().True(math.NewInt(100).Equal(rateLimit.CoinToERC20Volume))
+	suite.Require().True(rateLimit.ERC20ToCoinVolume.IsZero())
+
+	balance := suite.app.BankKeeper.GetBalance(suite.ctx, sender, pair.Denom)
+	suite.Require().Equal(int64(900), balance.Amount.Int64())
+
+	// conversion without remaining volume fails
+	_, err = suite.app.Erc20Keeper.TrackConversion(suite.ctx, *pair, types.CoinToERC20, math.NewInt(1))
+	suite.Require().True(errorsmod.IsOf(err, types.ErrRateLimitExceeded))
+
+	id := suite.app.Erc20Keeper.GetTokenPairID(suite.ctx, pair.Denom)
+	paused, _ := suite.app.Erc20Keeper.GetTokenPair(suite.ctx, id)
+	suite.Require().False(paused.Enabled)
+	suite.Require().True(errorsmod.IsOf(convert(1), types.ErrERC20TokenPairDisabled))
+
+	// volumes are only reset at the end of the tracked epoch
+	suite.app.Erc20Keeper.AfterEpochEnd(suite.ctx, epochstypes.WeekEpochID, 1)
+	rateLimit, _ = suite.app.Erc20Keeper.GetTokenPairRateLimit(suite.ctx, pair.GetERC20Contract())
+	suite.Require().True(math.NewInt(100).Equal(rateLimit.CoinToERC20Volume))
+
+	paused, _ = suite.app.Erc20Keeper.GetTokenPair(suite.ctx, id)
+	suite.Require().False(paused.Enabled)
+
+	// the token pair paused by the rate limit is enabled at the end of the epoch
+	suite.app.Erc20Keeper.AfterEpochEnd(suite.ctx, epochstypes.DayEpochID, 1)
+	rateLimit, _ = suite.app.Erc20Keeper.GetTokenPairRateLimit(suite.ctx, pair.GetERC20Contract())
+	suite.Require().True(rateLimit.CoinToERC20Volume.IsZero())
+	suite.Require().False(rateLimit.Paused)
+
+	resumed, _ := suite.app.Erc20Keeper.GetTokenPair(suite.ctx, id)
+	suite.Require().True(resumed.Enabled)
+	suite.Require().NoError(convert(10))
+
+	// a paused token pair enabled and disabled again by governance stays
+	// disabled at the end of the epoch
+	suite.Require().NoError(convert(90))
+	for i := 0; i < 2; i++ {
+		_, err = suite.app.Erc20Keeper.ToggleConversion(suite.ctx, pair.Denom)
+		suite.Require().NoError(err)
+	}
+
+	suite.app.Erc20Keeper.AfterEpochEnd(suite.ctx, epochstypes.DayEpochID, 2)
+	disabled, _ := suite.app.Erc20Keeper.GetTokenPair(suite.ctx, id)
+	suite.Require().False(disabled.Enabled)
+
+	suite.mintFeeCollector = false
+}
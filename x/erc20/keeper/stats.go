@@ -0,0 +1,100 @@
+package keeper
+
+import (
+	errorsmod "cosmossdk.io/errors"
+	"cosmossdk.io/math"
+	"github.com/cosmos/cosmos-sdk/store/prefix"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/ethereum/go-ethereum/common"
+
+	"github.com/evmos/evmos/v10/contracts"
+	"github.com/evmos/evmos/v10/x/erc20/types"
+)
+
+// GetAllTokenPairStats returns the conversion stats of all token pairs
+func (k Keeper) GetAllTokenPairStats(ctx sdk.Context) []types.TokenPairStats {
+	allStats := []types.TokenPairStats{}
+
+	k.IterateTokenPairStats(ctx, func(stats types.TokenPairStats) (stop bool) {
+		allStats = append(allStats, stats)
+		return false
+	})
+
+	return allStats
+}
+
+// IterateTokenPairStats iterates over all the stored token pair stats
+func (k Keeper) IterateTokenPairStats(ctx sdk.Context, cb func(stats types.TokenPairStats) (stop bool)) {
+	store := ctx.KVStore(k.storeKey)
+	iterator := sdk.KVStorePrefixIterator(store, types.KeyPrefixTokenPairStats)
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		var stats types.TokenPairStats
+		k.cdc.MustUnmarshal(iterator.Value(), &stats)
+
+		if cb(stats) {
+			break
+		}
+	}
+}
+
+// GetTokenPairStats returns the conversion stats for the given ERC20 contract.
+// Zero volumes are returned if no conversion was tracked.
+func (k Keeper) GetTokenPairStats(ctx sdk.Context, erc20 common.Address) types.TokenPairStats {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefixTokenPairStats)
+	bz := store.Get(erc20.Bytes())
+	if len(bz) == 0 {
+		return types.NewTokenPairStats(erc20)
+	}
+
+	var stats types.TokenPairStats
+	k.cdc.MustUnmarshal(bz, &stats)
+	return stats
+}
+
+// SetTokenPairStats stores the conversion stats of a token pair
+func (k Keeper) SetTokenPairStats(ctx sdk.Context, stats types.TokenPairStats) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefixTokenPairStats)
+	bz := k.cdc.MustMarshal(&stats)
+	store.Set(stats.GetERC20Contract().Bytes(), bz)
+}
+
+// DeleteTokenPairStats removes the conversion stats for the given ERC20
+// contract
+func (k Keeper) DeleteTokenPairStats(ctx sdk.Context, erc20 common.Address) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefixTokenPairStats)
+	store.Delete(erc20.Bytes())
+}
+
+// AddConvertedVolume adds the amount of a successful conversion to the
+// cumulative volume of the token pair in the given direction
+func (k Keeper) AddConvertedVolume(
+	ctx sdk.Context,
+	pair types.TokenPair,
+	direction types.ConversionDirection,
+	amount math.Int,
+) {
+	stats := k.GetTokenPairStats(ctx, pair.GetERC20Contract())
+	k.SetTokenPairStats(ctx, stats.AddVolume(direction, amount))
+}
+
+// GetEscrowBalance returns the balance escrowed by the module for a token
+// pair: the Cosmos coins on the module account, excluding the registration
+// deposits, for native coins and the ERC20 tokens on the module address for
+// native ERC20s. It returns an error if the ERC20 balance can't be queried.
+func (k Keeper) GetEscrowBalance(ctx sdk.Context, pair types.TokenPair) (math.Int, error) {
+	if pair.IsNativeCoin() {
+		return k.GetEscrowedBalance(ctx, pair.Denom).Amount, nil
+	}
+
+	erc20 := contracts.ERC20MinterBurnerDecimalsContract.ABI
+	contract := pair.GetERC20Contract()
+	balance := k.BalanceOf(ctx, erc20, contract, types.ModuleAddress)
+	if balance == nil {
+		return math.Int{}, errorsmod.Wrapf(
+			types.ErrEVMCall, "failed to retrieve escrowed balance, contract %s might be destroyed", contract,
+		)
+	}
+	return math.NewIntFromBigInt(balance), nil
+}
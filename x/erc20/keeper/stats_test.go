@@ -0,0 +1,82 @@
+package keeper_test
+
+import (
+	"math/big"
+
+	"cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/evmos/ethermint/tests"
+
+	"github.com/evmos/evmos/v10/testutil"
+	"github.com/evmos/evmos/v10/x/erc20/types"
+)
+
+func (suite *KeeperTestSuite) TestTokenPairStats() {
+	suite.mintFeeCollector = true
+	suite.SetupTest()
+
+	ctx := sdk.WrapSDKContext(suite.ctx)
+
+	_, err := suite.app.Erc20Keeper.TokenPairStats(ctx, &types.QueryTokenPairStatsRequest{Token: metadataCoin.Base})
+	suite.Require().Error(err)
+
+	pair := suite.setupRegisterCoin(metadataCoin)
+	suite.Require().NotNil(pair)
+	ctx = sdk.WrapSDKContext(suite.ctx)
+
+	// the stats of a token pair without conversions are zero
+	res, err := suite.app.Erc20Keeper.TokenPairStats(ctx, &types.QueryTokenPairStatsRequest{Token: pair.Erc20Address})
+	suite.Require().NoError(err)
+	suite.Require().Equal(*pair, res.TokenPair)
+	suite.Require().True(res.CoinToERC20Volume.IsZero())
+	suite.Require().True(res.ERC20ToCoinVolume.IsZero())
+	suite.Require().True(res.EscrowBalance.IsZero())
+	suite.Require().True(res.ERC20TotalSupply.IsZero())
+
+	sender := sdk.AccAddress(suite.address.Bytes())
+	err = testutil.FundAccount(suite.ctx, suite.app.BankKeeper, sender, sdk.NewCoins(sdk.NewCoin(pair.Denom, sdk.NewInt(1000))))
+	suite.Require().NoError(err)
+
+	// failed conversions are not tracked
+	msgConvertCoin := types.NewMsgConvertCoin(sdk.NewCoin(pair.Denom, sdk.NewInt(2000)), suite.address, sender)
+	_, err = suite.app.Erc20Keeper.ConvertCoin(ctx, msgConvertCoin)
+	suite.Require().Error(err)
+
+	for _, amount := range []int64{100, 50} {
+		msgConvertCoin = types.NewMsgConvertCoin(sdk.NewCoin(pair.Denom, sdk.NewInt(amount)), suite.address, sender)
+		_, err = suite.app.Erc20Keeper.ConvertCoin(ctx, msgConvertCoin)
+		suite.Require().NoError(err)
+	}
+
+	msgConvertERC20 := types.NewMsgConvertERC20(math.NewInt(40), sender, pair.GetERC20Contract(), suite.address)
+	_, err = suite.app.Erc20Keeper.ConvertERC20(ctx, msgConvertERC20)
+	suite.Require().NoError(err)
+
+	stats := suite.app.Erc20Keeper.GetTokenPairStats(suite.ctx, pair.GetERC20Contract())
+	suite.Require().True(math.NewInt(150).Equal(stats.CoinToERC20Volume))
+	suite.Require().True(math.NewInt(40).Equal(stats.ERC20ToCoinVolume))
+
+	res, err = suite.app.Erc20Keeper.TokenPairStats(ctx, &types.QueryTokenPairStatsRequest{Token: pair.Denom})
+	suite.Require().NoError(err)
+	suite.Require().True(math.NewInt(150).Equal(res.CoinToERC20Volume))
+	suite.Require().True(math.NewInt(40).Equal(res.ERC20ToCoinVolume))
+	suite.Require().True(math.NewInt(110).Equal(res.EscrowBalance))
+	suite.Require().Equal(big.NewInt(110), res.ERC20TotalSupply.BigInt())
+
+	// the registration deposits held by the module are not part of the escrow
+	deposit := sdk.NewCoins(sdk.NewCoin(pair.Denom, sdk.NewInt(5)))
+	err = testutil.FundModuleAccount(suite.ctx, suite.app.BankKeeper, types.ModuleName, deposit)
+	suite.Require().NoError(err)
+	suite.app.Erc20Keeper.SetTokenPairDeposit(suite.ctx, types.NewTokenPairDeposit(tests.GenerateAddress(), sender, deposit))
+
+	res, err = suite.app.Erc20Keeper.TokenPairStats(ctx, &types.QueryTokenPairStatsRequest{Token: pair.Denom})
+	suite.Require().NoError(err)
+	suite.Require().True(math.NewInt(110).Equal(res.EscrowBalance))
+
+	// the stats are removed together with the token pair
+	suite.app.Erc20Keeper.DeleteTokenPair(suite.ctx, *pair)
+	suite.Require().Empty(suite.app.Erc20Keeper.GetAllTokenPairStats(suite.ctx))
+
+	suite.mintFeeCollector = false
+}
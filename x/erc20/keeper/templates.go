@@ -0,0 +1,162 @@
+package keeper
+
+import (
+	"math/big"
+
+	errorsmod "cosmossdk.io/errors"
+	"github.com/cosmos/cosmos-sdk/store/prefix"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+
+	"github.com/evmos/evmos/v10/contracts"
+	"github.com/evmos/evmos/v10/x/erc20/types"
+)
+
+// GetERC20Templates returns all the registered ERC20 templates. The default
+// template is built into the module and not included.
+func (k Keeper) GetERC20Templates(ctx sdk.Context) []types.ERC20Template {
+	templates := []types.ERC20Template{}
+
+	k.IterateERC20Templates(ctx, func(template types.ERC20Template) (stop bool) {
+		templates = append(templates, template)
+		return false
+	})
+
+	return templates
+}
+
+// IterateERC20Templates iterates over all the registered ERC20 templates
+func (k Keeper) IterateERC20Templates(ctx sdk.Context, cb func(template types.ERC20Template) (stop bool)) {
+	store := ctx.KVStore(k.storeKey)
+	iterator := sdk.KVStorePrefixIterator(store, types.KeyPrefixERC20Template)
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		var template types.ERC20Template
+		k.cdc.MustUnmarshal(iterator.Value(), &template)
+
+		if cb(template) {
+			break
+		}
+	}
+}
+
+// GetERC20Template returns the registered ERC20 template for the given
+// identifier
+func (k Keeper) GetERC20Template(ctx sdk.Context, id string) (types.ERC20Template, bool) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefixERC20Template)
+	bz := store.Get([]byte(id))
+	if len(bz) == 0 {
+		return types.ERC20Template{}, false
+	}
+
+	var template types.ERC20Template
+	k.cdc.MustUnmarshal(bz, &template)
+	return template, true
+}
+
+// SetERC20Template stores an ERC20 template
+func (k Keeper) SetERC20Template(ctx sdk.Context, template types.ERC20Template) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefixERC20Template)
+	bz := k.cdc.MustMarshal(&template)
+	store.Set([]byte(template.ID), bz)
+}
+
+// DeleteERC20Template removes the ERC20 template for the given identifier
+func (k Keeper) DeleteERC20Template(ctx sdk.Context, id string) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefixERC20Template)
+	store.Delete([]byte(id))
+}
+
+// GetERC20TemplateBytecode returns the contract creation bytecode of the ERC20
+// template for the given identifier. An empty identifier resolves to the
+// default template.
+func (k Keeper) GetERC20TemplateBytecode(ctx sdk.Context, id string) ([]byte, error) {
+	if id == "" || id == types.DefaultERC20TemplateID {
+		return contracts.ERC20MinterBurnerDecimalsContract.Bin, nil
+	}
+
+	template, found := k.GetERC20Template(ctx, id)
+	if !found {
+		return nil, errorsmod.Wrapf(types.ErrERC20TemplateNotFound, "template '%s' not registered", id)
+	}
+
+	return template.Bytecode, nil
+}
+
+// RegisterERC20Template adds a template to the registry after checking that it
+// can be deployed and used by the module. The check deploys the template on a
+// cached context, so that it does not modify the state.
+func (k Keeper) RegisterERC20Template(ctx sdk.Context, template types.ERC20Template) error {
+	if template.ID == types.DefaultERC20TemplateID {
+		return errorsmod.Wrapf(types.ErrInvalidERC20Template, "template id '%s' is reserved for the default template", template.ID)
+	}
+
+	if _, found := k.GetERC20Template(ctx, template.ID); found {
+		return errorsmod.Wrapf(types.ErrInvalidERC20Template, "template '%s' already registered", template.ID)
+	}
+
+	cacheCtx, _ := ctx.CacheContext()
+	if err := k.verifyERC20Template(cacheCtx, template); err != nil {
+		return err
+	}
+
+	k.SetERC20Template(ctx, template)
+	return nil
+}
+
+// RemoveERC20Template removes a template from the registry. Token pairs already
+// deployed from the template are not affected.
+func (k Keeper) RemoveERC20Template(ctx sdk.Context, id string) error {
+	if _, found := k.GetERC20Template(ctx, id); !found {
+		return errorsmod.Wrapf(types.ErrERC20TemplateNotFound, "template '%s' not registered", id)
+	}
+
+	k.DeleteERC20Template(ctx, id)
+	return nil
+}
+
+// verifyERC20Template deploys the template bytecode and checks that the
+// contract reports the constructor arguments and that the module can mint and
+// burn tokens. Upgradeable templates that expect an initializer call after the
+// deployment fail the check, as no initializer is called.
+func (k Keeper) verifyERC20Template(ctx sdk.Context, template types.ERC20Template) error {
+	metadata := banktypes.Metadata{
+		Name:   "Template Check",
+		Symbol: "TMPL",
+		DenomUnits: []*banktypes.DenomUnit{
+			{Denom: "atmpl", Exponent: 0},
+			{Denom: "tmpl", Exponent: 18},
+		},
+	}
+
+	contract, err := k.DeployERC20Contract(ctx, metadata, template.Bytecode)
+	if err != nil {
+		return errorsmod.Wrapf(types.ErrInvalidERC20Template, "failed to deploy template '%s': %s", template.ID, err)
+	}
+
+	data, err := k.QueryERC20(ctx, contract)
+	if err != nil {
+		return errorsmod.Wrapf(types.ErrInvalidERC20Template, "failed to query template '%s': %s", template.ID, err)
+	}
+
+	if data.Name != metadata.Name || data.Symbol != metadata.Symbol || data.Decimals != 18 {
+		return errorsmod.Wrapf(
+			types.ErrInvalidERC20Template,
+			"template '%s' does not set the name, symbol and decimals from its constructor", template.ID,
+		)
+	}
+
+	erc20 := contracts.ERC20MinterBurnerDecimalsContract.ABI
+	amount := big.NewInt(1)
+
+	if _, err := k.CallEVM(ctx, erc20, types.ModuleAddress, contract, true, "mint", types.ModuleAddress, amount); err != nil {
+		return errorsmod.Wrapf(types.ErrInvalidERC20Template, "module cannot mint tokens of template '%s': %s", template.ID, err)
+	}
+
+	if _, err := k.CallEVM(ctx, erc20, types.ModuleAddress, contract, true, "burnCoins", types.ModuleAddress, amount); err != nil {
+		return errorsmod.Wrapf(types.ErrInvalidERC20Template, "module cannot burn tokens of template '%s': %s", template.ID, err)
+	}
+
+	return nil
+}
@@ -0,0 +1,166 @@
+package keeper_test
+
+import (
+	"fmt"
+
+	errorsmod "cosmossdk.io/errors"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/evmos/evmos/v10/contracts"
+	"github.com/evmos/evmos/v10/x/erc20/types"
+	inflationtypes "github.com/evmos/evmos/v10/x/inflation/types"
+)
+
+func (suite *KeeperTestSuite) TestRegisterERC20Template() {
+	testCases := []struct {
+		name     string
+		template types.ERC20Template
+		malleate func()
+		expPass  bool
+	}{
+		{
+			"fail - default template id",
+			types.NewERC20Template(types.DefaultERC20TemplateID, "", contracts.ERC20MinterBurnerDecimalsContract.Bin),
+			func() {},
+			false,
+		},
+		{
+			"fail - template already registered",
+			types.NewERC20Template("minter-burner", "", contracts.ERC20MinterBurnerDecimalsContract.Bin),
+			func() {
+				suite.app.Erc20Keeper.SetERC20Template(
+					suite.ctx,
+					types.NewERC20Template("minter-burner", "", contracts.ERC20MinterBurnerDecimalsContract.Bin),
+				)
+			},
+			false,
+		},
+		{
+			"fail - deployment reverts",
+			// PUSH1 0x00 PUSH1 0x00 REVERT
+			types.NewERC20Template("reverting", "", []byte{0x60, 0x00, 0x60, 0x00, 0xfd}),
+			func() {},
+			false,
+		},
+		{
+			"fail - constructor does not take the coin metadata",
+			types.NewERC20Template("direct-balance", "", contracts.ERC20DirectBalanceManipulationContract.Bin),
+			func() {},
+			false,
+		},
+		{
+			"ok",
+			types.NewERC20Template("minter-burner", "minter burner", contracts.ERC20MinterBurnerDecimalsContract.Bin),
+			func() {},
+			true,
+		},
+	}
+	for _, tc := range testCases {
+		suite.Run(fmt.Sprintf("Case %s", tc.name), func() {
+			suite.SetupTest()
+			tc.malleate()
+
+			nonce, err := suite.app.AccountKeeper.GetSequence(suite.ctx, types.ModuleAddress.Bytes())
+			suite.Require().NoError(err)
+
+			err = suite.app.Erc20Keeper.RegisterERC20Template(suite.ctx, tc.template)
+			if !tc.expPass {
+				suite.Require().Error(err, tc.name)
+				return
+			}
+
+			suite.Require().NoError(err, tc.name)
+
+			template, found := suite.app.Erc20Keeper.GetERC20Template(suite.ctx, tc.template.ID)
+			suite.Require().True(found)
+			suite.Require().Equal(tc.template, template)
+
+			// the verification deployment is discarded
+			newNonce, err := suite.app.AccountKeeper.GetSequence(suite.ctx, types.ModuleAddress.Bytes())
+			suite.Require().NoError(err)
+			suite.Require().Equal(nonce, newNonce)
+		})
+	}
+}
+
+func (suite *KeeperTestSuite) TestRemoveERC20Template() {
+	suite.SetupTest()
+
+	err := suite.app.Erc20Keeper.RemoveERC20Template(suite.ctx, "minter-burner")
+	suite.Require().True(errorsmod.IsOf(err, types.ErrERC20TemplateNotFound))
+
+	suite.app.Erc20Keeper.SetERC20Template(
+		suite.ctx,
+		types.NewERC20Template("minter-burner", "", contracts.ERC20MinterBurnerDecimalsContract.Bin),
+	)
+
+	err = suite.app.Erc20Keeper.RemoveERC20Template(suite.ctx, "minter-burner")
+	suite.Require().NoError(err)
+
+	_, found := suite.app.Erc20Keeper.GetERC20Template(suite.ctx, "minter-burner")
+	suite.Require().False(found)
+}
+
+func (suite *KeeperTestSuite) TestRegisterCoinWithTemplate() {
+	testCases := []struct {
+		name          string
+		templateID    string
+		malleate      func()
+		expPass       bool
+		expTemplateID string
+	}{
+		{
+			"fail - template not registered",
+			"minter-burner",
+			func() {},
+			false,
+			"",
+		},
+		{
+			"ok - empty template id uses the default template",
+			"",
+			func() {},
+			true,
+			types.DefaultERC20TemplateID,
+		},
+		{
+			"ok - registered template",
+			"minter-burner",
+			func() {
+				suite.app.Erc20Keeper.SetERC20Template(
+					suite.ctx,
+					types.NewERC20Template("minter-burner", "", contracts.ERC20MinterBurnerDecimalsContract.Bin),
+				)
+			},
+			true,
+			"minter-burner",
+		},
+	}
+	for _, tc := range testCases {
+		suite.Run(fmt.Sprintf("Case %s", tc.name), func() {
+			suite.SetupTest()
+			tc.malleate()
+
+			err := suite.app.BankKeeper.MintCoins(suite.ctx, inflationtypes.ModuleName, sdk.Coins{sdk.NewInt64Coin(metadataCoin.Base, 1)})
+			suite.Require().NoError(err)
+
+			pair, err := suite.app.Erc20Keeper.RegisterCoinWithTemplate(suite.ctx, metadataCoin, tc.templateID)
+			if !tc.expPass {
+				suite.Require().True(errorsmod.IsOf(err, types.ErrERC20TemplateNotFound), tc.name)
+				return
+			}
+
+			suite.Require().NoError(err, tc.name)
+			suite.Require().Equal(tc.expTemplateID, pair.TemplateID)
+
+			id := suite.app.Erc20Keeper.GetTokenPairID(suite.ctx, metadataCoin.Base)
+			stored, found := suite.app.Erc20Keeper.GetTokenPair(suite.ctx, id)
+			suite.Require().True(found)
+			suite.Require().Equal(tc.expTemplateID, stored.TemplateID)
+
+			data, err := suite.app.Erc20Keeper.QueryERC20(suite.ctx, pair.GetERC20Contract())
+			suite.Require().NoError(err)
+			suite.Require().Equal(metadataCoin.Symbol, data.Symbol)
+		})
+	}
+}
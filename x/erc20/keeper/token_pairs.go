@@ -77,6 +77,8 @@ func (k Keeper) DeleteTokenPair(ctx sdk.Context, tokenPair types.TokenPair) {
 	k.deleteTokenPair(ctx, id)
 	k.deleteERC20Map(ctx, tokenPair.GetERC20Contract())
 	k.deleteDenomMap(ctx, tokenPair.Denom)
+	k.DeleteTokenPairRateLimit(ctx, tokenPair.GetERC20Contract())
+	k.DeleteTokenPairStats(ctx, tokenPair.GetERC20Contract())
 }
 
 // deleteTokenPair deletes the token pair for the given id
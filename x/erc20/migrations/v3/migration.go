@@ -0,0 +1,58 @@
+package v3
+
+import (
+	"github.com/cosmos/cosmos-sdk/codec"
+	"github.com/cosmos/cosmos-sdk/store/prefix"
+	storetypes "github.com/cosmos/cosmos-sdk/store/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	paramtypes "github.com/cosmos/cosmos-sdk/x/params/types"
+
+	"github.com/evmos/evmos/v10/x/erc20/types"
+)
+
+// UpdateParams sets the default values of the module parameters introduced
+// in consensus version 3.
+func UpdateParams(ctx sdk.Context, paramstore *paramtypes.Subspace) error {
+	if !paramstore.HasKeyTable() {
+		ps := paramstore.WithKeyTable(types.ParamKeyTable())
+		paramstore = &ps
+	}
+
+	params := types.DefaultParams()
+	paramstore.Set(ctx, types.ParamStoreKeyEnablePermissionlessRegistration, params.EnablePermissionlessRegistration)
+	paramstore.Set(ctx, types.ParamStoreKeyRegistrationDeposit, params.RegistrationDeposit)
+	paramstore.Set(ctx, types.ParamStoreKeyEnableIBCReceiveSweep, params.EnableIBCReceiveSweep)
+	return nil
+}
+
+// MigrateTokenPairTemplates sets the default ERC20 template on the token pairs
+// of native Cosmos coins, whose contracts were all deployed from the
+// ERC20MinterBurnerDecimals contract before templates were introduced.
+func MigrateTokenPairTemplates(ctx sdk.Context, storeKey storetypes.StoreKey, cdc codec.BinaryCodec) error {
+	store := prefix.NewStore(ctx.KVStore(storeKey), types.KeyPrefixTokenPair)
+	iterator := store.Iterator(nil, nil)
+	defer iterator.Close()
+
+	var pairs []types.TokenPair
+	for ; iterator.Valid(); iterator.Next() {
+		var pair types.TokenPair
+		if err := cdc.Unmarshal(iterator.Value(), &pair); err != nil {
+			return err
+		}
+
+		if pair.IsNativeCoin() && pair.TemplateID == "" {
+			pairs = append(pairs, pair)
+		}
+	}
+
+	for _, pair := range pairs {
+		pair.TemplateID = types.DefaultERC20TemplateID
+		bz, err := cdc.Marshal(&pair)
+		if err != nil {
+			return err
+		}
+		store.Set(pair.GetID(), bz)
+	}
+
+	return nil
+}
@@ -0,0 +1,89 @@
+package v3_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/cosmos/cosmos-sdk/testutil"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	paramtypes "github.com/cosmos/cosmos-sdk/x/params/types"
+
+	"github.com/evmos/ethermint/encoding"
+	"github.com/evmos/ethermint/tests"
+
+	"github.com/evmos/evmos/v10/app"
+	v3 "github.com/evmos/evmos/v10/x/erc20/migrations/v3"
+	erc20types "github.com/evmos/evmos/v10/x/erc20/types"
+)
+
+func TestUpdateParams(t *testing.T) {
+	encCfg := encoding.MakeConfig(app.ModuleBasics)
+	erc20Key := sdk.NewKVStoreKey(erc20types.StoreKey)
+	tErc20Key := sdk.NewTransientStoreKey(fmt.Sprintf("%s_test", erc20types.StoreKey))
+	ctx := testutil.DefaultContext(erc20Key, tErc20Key)
+	paramstore := paramtypes.NewSubspace(
+		encCfg.Codec, encCfg.Amino, erc20Key, tErc20Key, "erc20",
+	)
+	paramstore = paramstore.WithKeyTable(erc20types.ParamKeyTable())
+	require.True(t, paramstore.HasKeyTable())
+
+	// check no params
+	require.False(t, paramstore.Has(ctx, erc20types.ParamStoreKeyEnablePermissionlessRegistration))
+	require.False(t, paramstore.Has(ctx, erc20types.ParamStoreKeyRegistrationDeposit))
+	require.False(t, paramstore.Has(ctx, erc20types.ParamStoreKeyEnableIBCReceiveSweep))
+
+	// Run migrations
+	err := v3.UpdateParams(ctx, &paramstore)
+	require.NoError(t, err)
+
+	// Make sure the params are set
+	require.True(t, paramstore.Has(ctx, erc20types.ParamStoreKeyEnablePermissionlessRegistration))
+	require.True(t, paramstore.Has(ctx, erc20types.ParamStoreKeyRegistrationDeposit))
+	require.True(t, paramstore.Has(ctx, erc20types.ParamStoreKeyEnableIBCReceiveSweep))
+
+	var (
+		enablePermissionlessRegistration bool
+		registrationDeposit              sdk.Coins
+		enableIBCReceiveSweep            bool
+	)
+
+	// Make sure the new params are set
+	require.NotPanics(t, func() {
+		paramstore.Get(ctx, erc20types.ParamStoreKeyEnablePermissionlessRegistration, &enablePermissionlessRegistration)
+		paramstore.Get(ctx, erc20types.ParamStoreKeyRegistrationDeposit, &registrationDeposit)
+		paramstore.Get(ctx, erc20types.ParamStoreKeyEnableIBCReceiveSweep, &enableIBCReceiveSweep)
+	})
+
+	// check the params are updated
+	require.False(t, enablePermissionlessRegistration)
+	require.Equal(t, erc20types.DefaultRegistrationDeposit, registrationDeposit)
+	require.False(t, enableIBCReceiveSweep)
+}
+
+func TestMigrateTokenPairTemplates(t *testing.T) {
+	encCfg := encoding.MakeConfig(app.ModuleBasics)
+	erc20Key := sdk.NewKVStoreKey(erc20types.StoreKey)
+	tErc20Key := sdk.NewTransientStoreKey(fmt.Sprintf("%s_test", erc20types.StoreKey))
+	ctx := testutil.DefaultContext(erc20Key, tErc20Key)
+	store := ctx.KVStore(erc20Key)
+
+	nativeCoin := erc20types.NewTokenPair(tests.GenerateAddress(), "acoin", true, erc20types.OWNER_MODULE)
+	nativeERC20 := erc20types.NewTokenPair(tests.GenerateAddress(), "erc20/token", true, erc20types.OWNER_EXTERNAL)
+
+	for _, pair := range []erc20types.TokenPair{nativeCoin, nativeERC20} {
+		pair := pair
+		store.Set(append(erc20types.KeyPrefixTokenPair, pair.GetID()...), encCfg.Codec.MustMarshal(&pair))
+	}
+
+	err := v3.MigrateTokenPairTemplates(ctx, erc20Key, encCfg.Codec)
+	require.NoError(t, err)
+
+	var coinPair, erc20Pair erc20types.TokenPair
+	encCfg.Codec.MustUnmarshal(store.Get(append(erc20types.KeyPrefixTokenPair, nativeCoin.GetID()...)), &coinPair)
+	require.Equal(t, erc20types.DefaultERC20TemplateID, coinPair.TemplateID)
+
+	encCfg.Codec.MustUnmarshal(store.Get(append(erc20types.KeyPrefixTokenPair, nativeERC20.GetID()...)), &erc20Pair)
+	require.Empty(t, erc20Pair.TemplateID)
+}
@@ -28,6 +28,18 @@ func NewErc20ProposalHandler(k *keeper.Keeper) govv1beta1.Handler {
 			return handleRegisterERC20Proposal(ctx, k, c)
 		case *types.ToggleTokenConversionProposal:
 			return handleToggleConversionProposal(ctx, k, c)
+		case *types.SlashRegistrationDepositProposal:
+			return handleSlashRegistrationDepositProposal(ctx, k, c)
+		case *types.DeregisterTokenPairProposal:
+			return handleDeregisterTokenPairProposal(ctx, k, c)
+		case *types.SetTokenPairRateLimitProposal:
+			return handleSetTokenPairRateLimitProposal(ctx, k, c)
+		case *types.RegisterERC20TemplateProposal:
+			return handleRegisterERC20TemplateProposal(ctx, k, c)
+		case *types.RemoveERC20TemplateProposal:
+			return handleRemoveERC20TemplateProposal(ctx, k, c)
+		case *types.RegisterNFTPairProposal:
+			return handleRegisterNFTPairProposal(ctx, k, c)
 
 		default:
 			return errorsmod.Wrapf(errortypes.ErrUnknownRequest, "unrecognized %s proposal content type: %T", types.ModuleName, c)
@@ -43,7 +55,7 @@ func handleRegisterCoinProposal(
 	p *types.RegisterCoinProposal,
 ) error {
 	for _, metadata := range p.Metadata {
-		pair, err := k.RegisterCoin(ctx, metadata)
+		pair, err := k.RegisterCoinWithTemplate(ctx, metadata, p.TemplateID)
 		if err != nil {
 			return err
 		}
@@ -53,6 +65,7 @@ func handleRegisterCoinProposal(
 				types.EventTypeRegisterCoin,
 				sdk.NewAttribute(types.AttributeKeyCosmosCoin, pair.Denom),
 				sdk.NewAttribute(types.AttributeKeyERC20Token, pair.Erc20Address),
+				sdk.NewAttribute(types.AttributeKeyTemplateID, pair.TemplateID),
 			),
 		)
 	}
@@ -106,3 +119,143 @@ func handleToggleConversionProposal(
 
 	return nil
 }
+
+// handleSlashRegistrationDepositProposal handles the slashing proposal for
+// multiple token pairs registered with a deposit
+func handleSlashRegistrationDepositProposal(
+	ctx sdk.Context,
+	k *keeper.Keeper,
+	p *types.SlashRegistrationDepositProposal,
+) error {
+	for _, address := range p.Erc20Addresses {
+		deposit, err := k.SlashRegistrationDeposit(ctx, common.HexToAddress(address))
+		if err != nil {
+			return err
+		}
+
+		ctx.EventManager().EmitEvent(
+			sdk.NewEvent(
+				types.EventTypeSlashDeposit,
+				sdk.NewAttribute(types.AttributeKeyDepositor, deposit.Depositor),
+				sdk.NewAttribute(sdk.AttributeKeyAmount, deposit.Amount.String()),
+				sdk.NewAttribute(types.AttributeKeyERC20Token, deposit.Erc20Address),
+			),
+		)
+	}
+
+	return nil
+}
+
+// handleDeregisterTokenPairProposal handles the deregistration proposal for a
+// token pair
+func handleDeregisterTokenPairProposal(
+	ctx sdk.Context,
+	k *keeper.Keeper,
+	p *types.DeregisterTokenPairProposal,
+) error {
+	pair, unwound, err := k.DeregisterTokenPair(ctx, p.Token)
+	if err != nil {
+		return err
+	}
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeDeregisterTokenPair,
+			sdk.NewAttribute(types.AttributeKeyCosmosCoin, pair.Denom),
+			sdk.NewAttribute(types.AttributeKeyERC20Token, pair.Erc20Address),
+			sdk.NewAttribute(sdk.AttributeKeyAmount, unwound.String()),
+		),
+	)
+
+	return nil
+}
+
+// handleSetTokenPairRateLimitProposal handles the rate limit proposal for a
+// token pair
+func handleSetTokenPairRateLimitProposal(
+	ctx sdk.Context,
+	k *keeper.Keeper,
+	p *types.SetTokenPairRateLimitProposal,
+) error {
+	pair, err := k.SetRateLimit(ctx, p.Token, p.EpochIdentifier, p.CoinToERC20Limit, p.ERC20ToCoinLimit)
+	if err != nil {
+		return err
+	}
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeSetRateLimit,
+			sdk.NewAttribute(types.AttributeKeyCosmosCoin, pair.Denom),
+			sdk.NewAttribute(types.AttributeKeyERC20Token, pair.Erc20Address),
+			sdk.NewAttribute(types.AttributeKeyEpochID, p.EpochIdentifier),
+		),
+	)
+
+	return nil
+}
+
+// handleRegisterERC20TemplateProposal handles the proposal to add an ERC20
+// template to the registry
+func handleRegisterERC20TemplateProposal(
+	ctx sdk.Context,
+	k *keeper.Keeper,
+	p *types.RegisterERC20TemplateProposal,
+) error {
+	if err := k.RegisterERC20Template(ctx, p.Template); err != nil {
+		return err
+	}
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeRegisterERC20Template,
+			sdk.NewAttribute(types.AttributeKeyTemplateID, p.Template.ID),
+		),
+	)
+
+	return nil
+}
+
+// handleRemoveERC20TemplateProposal handles the proposal to remove an ERC20
+// template from the registry
+func handleRemoveERC20TemplateProposal(
+	ctx sdk.Context,
+	k *keeper.Keeper,
+	p *types.RemoveERC20TemplateProposal,
+) error {
+	if err := k.RemoveERC20Template(ctx, p.TemplateID); err != nil {
+		return err
+	}
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeRemoveERC20Template,
+			sdk.NewAttribute(types.AttributeKeyTemplateID, p.TemplateID),
+		),
+	)
+
+	return nil
+}
+
+// handleRegisterNFTPairProposal handles the registration proposal for an
+// ERC721 or ERC1155 contract
+func handleRegisterNFTPairProposal(
+	ctx sdk.Context,
+	k *keeper.Keeper,
+	p *types.RegisterNFTPairProposal,
+) error {
+	pair, err := k.RegisterNFTPair(ctx, common.HexToAddress(p.ContractAddress), p.Standard, p.ClassURI, p.ClassData)
+	if err != nil {
+		return err
+	}
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeRegisterNFTPair,
+			sdk.NewAttribute(types.AttributeKeyContract, pair.ContractAddress),
+			sdk.NewAttribute(types.AttributeKeyStandard, pair.Standard.String()),
+			sdk.NewAttribute(types.AttributeKeyClassID, pair.ClassID),
+		),
+	)
+
+	return nil
+}
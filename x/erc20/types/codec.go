@@ -24,8 +24,17 @@ var (
 
 const (
 	// Amino names
-	convertERC20Name = "evmos/MsgConvertERC20"
-	convertCoinName  = "evmos/MsgConvertCoin"
+	convertERC20Name    = "evmos/MsgConvertERC20"
+	convertCoinName     = "evmos/MsgConvertCoin"
+	registerERC20Name   = "evmos/MsgRegisterERC20"
+	deregisterERC20Name = "evmos/MsgDeregisterERC20"
+	convertCoinsName    = "evmos/MsgConvertCoins"
+	convertERC20sName   = "evmos/MsgConvertERC20S"
+
+	refreshTokenPairMetadataName = "evmos/MsgRefreshTokenPairMetadata"
+	convertNFTName               = "evmos/MsgConvertNFT"
+	convertContractNFTName       = "evmos/MsgConvertContractNFT"
+	refundFailedConversionName   = "evmos/MsgRefundFailedConversion"
 )
 
 // NOTE: This is required for the GetSignBytes function
@@ -40,12 +49,26 @@ func RegisterInterfaces(registry codectypes.InterfaceRegistry) {
 		(*sdk.Msg)(nil),
 		&MsgConvertCoin{},
 		&MsgConvertERC20{},
+		&MsgRegisterERC20{},
+		&MsgDeregisterERC20{},
+		&MsgConvertCoins{},
+		&MsgConvertERC20S{},
+		&MsgRefreshTokenPairMetadata{},
+		&MsgConvertNFT{},
+		&MsgConvertContractNFT{},
+		&MsgRefundFailedConversion{},
 	)
 	registry.RegisterImplementations(
 		(*govv1beta1.Content)(nil),
 		&RegisterCoinProposal{},
 		&RegisterERC20Proposal{},
 		&ToggleTokenConversionProposal{},
+		&SlashRegistrationDepositProposal{},
+		&DeregisterTokenPairProposal{},
+		&SetTokenPairRateLimitProposal{},
+		&RegisterERC20TemplateProposal{},
+		&RemoveERC20TemplateProposal{},
+		&RegisterNFTPairProposal{},
 	)
 
 	msgservice.RegisterMsgServiceDesc(registry, &_Msg_serviceDesc)
@@ -57,4 +80,12 @@ func RegisterInterfaces(registry codectypes.InterfaceRegistry) {
 func RegisterLegacyAminoCodec(cdc *codec.LegacyAmino) {
 	cdc.RegisterConcrete(&MsgConvertERC20{}, convertERC20Name, nil)
 	cdc.RegisterConcrete(&MsgConvertCoin{}, convertCoinName, nil)
+	cdc.RegisterConcrete(&MsgRegisterERC20{}, registerERC20Name, nil)
+	cdc.RegisterConcrete(&MsgDeregisterERC20{}, deregisterERC20Name, nil)
+	cdc.RegisterConcrete(&MsgConvertCoins{}, convertCoinsName, nil)
+	cdc.RegisterConcrete(&MsgConvertERC20S{}, convertERC20sName, nil)
+	cdc.RegisterConcrete(&MsgRefreshTokenPairMetadata{}, refreshTokenPairMetadataName, nil)
+	cdc.RegisterConcrete(&MsgConvertNFT{}, convertNFTName, nil)
+	cdc.RegisterConcrete(&MsgConvertContractNFT{}, convertContractNFTName, nil)
+	cdc.RegisterConcrete(&MsgRefundFailedConversion{}, refundFailedConversionName, nil)
 }
@@ -5,6 +5,8 @@ package types
 
 import (
 	fmt "fmt"
+	github_com_cosmos_cosmos_sdk_types "github.com/cosmos/cosmos-sdk/types"
+	types1 "github.com/cosmos/cosmos-sdk/types"
 	types "github.com/cosmos/cosmos-sdk/x/bank/types"
 	_ "github.com/cosmos/gogoproto/gogoproto"
 	proto "github.com/gogo/protobuf/proto"
@@ -56,6 +58,38 @@ func (Owner) EnumDescriptor() ([]byte, []int) {
 	return fileDescriptor_668d5dc537f45142, []int{0}
 }
 
+// NFTStandard enumerates the token standards of the contracts of NFT pairs.
+type NFTStandard int32
+
+const (
+	// NFT_STANDARD_UNSPECIFIED defines an invalid/undefined token standard.
+	NFT_STANDARD_UNSPECIFIED NFTStandard = 0
+	// NFT_STANDARD_ERC721 defines an ERC721 non-fungible token contract.
+	NFT_STANDARD_ERC721 NFTStandard = 1
+	// NFT_STANDARD_ERC1155 defines an ERC1155 multi token contract.
+	NFT_STANDARD_ERC1155 NFTStandard = 2
+)
+
+var NFTStandard_name = map[int32]string{
+	0: "NFT_STANDARD_UNSPECIFIED",
+	1: "NFT_STANDARD_ERC721",
+	2: "NFT_STANDARD_ERC1155",
+}
+
+var NFTStandard_value = map[string]int32{
+	"NFT_STANDARD_UNSPECIFIED": 0,
+	"NFT_STANDARD_ERC721":      1,
+	"NFT_STANDARD_ERC1155":     2,
+}
+
+func (x NFTStandard) String() string {
+	return proto.EnumName(NFTStandard_name, int32(x))
+}
+
+func (NFTStandard) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_668d5dc537f45142, []int{1}
+}
+
 // TokenPair defines an instance that records a pairing consisting of a native
 //
 //	Cosmos Coin and an ERC20 token address.
@@ -68,6 +102,9 @@ type TokenPair struct {
 	Enabled bool `protobuf:"varint,3,opt,name=enabled,proto3" json:"enabled,omitempty"`
 	// contract_owner is the an ENUM specifying the type of ERC20 owner (0 invalid, 1 ModuleAccount, 2 external address)
 	ContractOwner Owner `protobuf:"varint,4,opt,name=contract_owner,json=contractOwner,proto3,enum=evmos.erc20.v1.Owner" json:"contract_owner,omitempty"`
+	// template_id is the identifier of the ERC20 template deployed for a native
+	// Cosmos coin. It is empty for token pairs registered from an ERC20 contract.
+	TemplateID string `protobuf:"bytes,5,opt,name=template_id,json=templateId,proto3" json:"template_id,omitempty"`
 }
 
 func (m *TokenPair) Reset()         { *m = TokenPair{} }
@@ -131,6 +168,13 @@ func (m *TokenPair) GetContractOwner() Owner {
 	return OWNER_UNSPECIFIED
 }
 
+func (m *TokenPair) GetTemplateID() string {
+	if m != nil {
+		return m.TemplateID
+	}
+	return ""
+}
+
 // RegisterCoinProposal is a gov Content type to register a token pair for a
 // native Cosmos coin.
 type RegisterCoinProposal struct {
@@ -140,6 +184,9 @@ type RegisterCoinProposal struct {
 	Description string `protobuf:"bytes,2,opt,name=description,proto3" json:"description,omitempty"`
 	// metadata slice of the native Cosmos coins
 	Metadata []types.Metadata `protobuf:"bytes,3,rep,name=metadata,proto3" json:"metadata"`
+	// template_id is the identifier of the ERC20 template to deploy for the coins.
+	// The default template is used if empty.
+	TemplateID string `protobuf:"bytes,4,opt,name=template_id,json=templateId,proto3" json:"template_id,omitempty"`
 }
 
 func (m *RegisterCoinProposal) Reset()         { *m = RegisterCoinProposal{} }
@@ -196,6 +243,13 @@ func (m *RegisterCoinProposal) GetMetadata() []types.Metadata {
 	return nil
 }
 
+func (m *RegisterCoinProposal) GetTemplateID() string {
+	if m != nil {
+		return m.TemplateID
+	}
+	return ""
+}
+
 // RegisterERC20Proposal is a gov Content type to register a token pair for an
 // ERC20 token
 type RegisterERC20Proposal struct {
@@ -327,25 +381,30 @@ func (m *ToggleTokenConversionProposal) GetToken() string {
 	return ""
 }
 
-// ProposalMetadata is used to parse a slice of denom metadata and generate
-// the RegisterCoinProposal content.
-type ProposalMetadata struct {
-	// metadata slice of the native Cosmos coins
-	Metadata []types.Metadata `protobuf:"bytes,1,rep,name=metadata,proto3" json:"metadata"`
+// SlashRegistrationDepositProposal is a gov Content type to slash the
+// registration deposit of token pairs registered through MsgRegisterERC20 and
+// remove them from the token mapping.
+type SlashRegistrationDepositProposal struct {
+	// title of the proposal
+	Title string `protobuf:"bytes,1,opt,name=title,proto3" json:"title,omitempty"`
+	// description of the proposal
+	Description string `protobuf:"bytes,2,opt,name=description,proto3" json:"description,omitempty"`
+	// erc20addresses is a slice of ERC20 token contract addresses whose deposits are slashed
+	Erc20Addresses []string `protobuf:"bytes,3,rep,name=erc20addresses,proto3" json:"erc20addresses,omitempty"`
 }
 
-func (m *ProposalMetadata) Reset()         { *m = ProposalMetadata{} }
-func (m *ProposalMetadata) String() string { return proto.CompactTextString(m) }
-func (*ProposalMetadata) ProtoMessage()    {}
-func (*ProposalMetadata) Descriptor() ([]byte, []int) {
+func (m *SlashRegistrationDepositProposal) Reset()         { *m = SlashRegistrationDepositProposal{} }
+func (m *SlashRegistrationDepositProposal) String() string { return proto.CompactTextString(m) }
+func (*SlashRegistrationDepositProposal) ProtoMessage()    {}
+func (*SlashRegistrationDepositProposal) Descriptor() ([]byte, []int) {
 	return fileDescriptor_668d5dc537f45142, []int{4}
 }
-func (m *ProposalMetadata) XXX_Unmarshal(b []byte) error {
+func (m *SlashRegistrationDepositProposal) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *ProposalMetadata) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *SlashRegistrationDepositProposal) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_ProposalMetadata.Marshal(b, m, deterministic)
+		return xxx_messageInfo_SlashRegistrationDepositProposal.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -355,488 +414,4691 @@ func (m *ProposalMetadata) XXX_Marshal(b []byte, deterministic bool) ([]byte, er
 		return b[:n], nil
 	}
 }
-func (m *ProposalMetadata) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_ProposalMetadata.Merge(m, src)
+func (m *SlashRegistrationDepositProposal) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SlashRegistrationDepositProposal.Merge(m, src)
 }
-func (m *ProposalMetadata) XXX_Size() int {
+func (m *SlashRegistrationDepositProposal) XXX_Size() int {
 	return m.Size()
 }
-func (m *ProposalMetadata) XXX_DiscardUnknown() {
-	xxx_messageInfo_ProposalMetadata.DiscardUnknown(m)
+func (m *SlashRegistrationDepositProposal) XXX_DiscardUnknown() {
+	xxx_messageInfo_SlashRegistrationDepositProposal.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_ProposalMetadata proto.InternalMessageInfo
+var xxx_messageInfo_SlashRegistrationDepositProposal proto.InternalMessageInfo
 
-func (m *ProposalMetadata) GetMetadata() []types.Metadata {
+func (m *SlashRegistrationDepositProposal) GetTitle() string {
 	if m != nil {
-		return m.Metadata
+		return m.Title
 	}
-	return nil
+	return ""
 }
 
-func init() {
-	proto.RegisterEnum("evmos.erc20.v1.Owner", Owner_name, Owner_value)
-	proto.RegisterType((*TokenPair)(nil), "evmos.erc20.v1.TokenPair")
-	proto.RegisterType((*RegisterCoinProposal)(nil), "evmos.erc20.v1.RegisterCoinProposal")
-	proto.RegisterType((*RegisterERC20Proposal)(nil), "evmos.erc20.v1.RegisterERC20Proposal")
-	proto.RegisterType((*ToggleTokenConversionProposal)(nil), "evmos.erc20.v1.ToggleTokenConversionProposal")
-	proto.RegisterType((*ProposalMetadata)(nil), "evmos.erc20.v1.ProposalMetadata")
+func (m *SlashRegistrationDepositProposal) GetDescription() string {
+	if m != nil {
+		return m.Description
+	}
+	return ""
 }
 
-func init() { proto.RegisterFile("evmos/erc20/v1/erc20.proto", fileDescriptor_668d5dc537f45142) }
-
-var fileDescriptor_668d5dc537f45142 = []byte{
-	// 503 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xa4, 0x53, 0xcf, 0x8b, 0xd3, 0x40,
-	0x14, 0xce, 0x6c, 0xbb, 0xba, 0x9d, 0xdd, 0x0d, 0x71, 0x68, 0x21, 0x14, 0x36, 0x1b, 0x2a, 0x48,
-	0xf0, 0x90, 0x34, 0xf5, 0x26, 0x82, 0x6c, 0xbb, 0x11, 0x56, 0x76, 0xdb, 0x92, 0xed, 0xa2, 0x78,
-	0x29, 0x93, 0x64, 0x88, 0x61, 0xdb, 0x4c, 0x99, 0x19, 0xa3, 0x1e, 0xbc, 0x7b, 0xf4, 0xe2, 0x5d,
-	0xd0, 0x3f, 0x66, 0x8f, 0x7b, 0xf4, 0x24, 0xd2, 0x5e, 0xfc, 0x33, 0x24, 0x33, 0x89, 0xb8, 0x1e,
-	0xdd, 0x4b, 0x78, 0xdf, 0xf7, 0x7e, 0xe4, 0x9b, 0xef, 0xf1, 0x60, 0x97, 0x14, 0x4b, 0xca, 0x3d,
-	0xc2, 0xe2, 0x41, 0xdf, 0x2b, 0x7c, 0x15, 0xb8, 0x2b, 0x46, 0x05, 0x45, 0xba, 0xcc, 0xb9, 0x8a,
-	0x2a, 0xfc, 0xae, 0x15, 0x53, 0x5e, 0x16, 0x47, 0x38, 0xbf, 0xf4, 0x0a, 0x3f, 0x22, 0x02, 0xfb,
-	0x12, 0xa8, 0xfa, 0x6e, 0x3b, 0xa5, 0x29, 0x95, 0xa1, 0x57, 0x46, 0x8a, 0xed, 0x7d, 0x03, 0xb0,
-	0x35, 0xa3, 0x97, 0x24, 0x9f, 0xe2, 0x8c, 0xa1, 0xfb, 0x70, 0x5f, 0xce, 0x9b, 0xe3, 0x24, 0x61,
-	0x84, 0x73, 0x13, 0xd8, 0xc0, 0x69, 0x85, 0x7b, 0x92, 0x3c, 0x52, 0x1c, 0x6a, 0xc3, 0xed, 0x84,
-	0xe4, 0x74, 0x69, 0x6e, 0xc9, 0xa4, 0x02, 0xc8, 0x84, 0x77, 0x49, 0x8e, 0xa3, 0x05, 0x49, 0xcc,
-	0x86, 0x0d, 0x9c, 0x9d, 0xb0, 0x86, 0xe8, 0x09, 0xd4, 0x63, 0x9a, 0x0b, 0x86, 0x63, 0x31, 0xa7,
-	0x6f, 0x73, 0xc2, 0xcc, 0xa6, 0x0d, 0x1c, 0x7d, 0xd0, 0x71, 0x6f, 0xbe, 0xc0, 0x9d, 0x94, 0xc9,
-	0x70, 0xbf, 0x2e, 0x96, 0xf0, 0x71, 0xf3, 0xd7, 0x97, 0x43, 0xd0, 0xfb, 0x0c, 0x60, 0x3b, 0x24,
-	0x69, 0xc6, 0x05, 0x61, 0x23, 0x9a, 0xe5, 0x53, 0x46, 0x57, 0x94, 0xe3, 0x45, 0x29, 0x46, 0x64,
-	0x62, 0x41, 0x2a, 0xa5, 0x0a, 0x20, 0x1b, 0xee, 0x26, 0x84, 0xc7, 0x2c, 0x5b, 0x89, 0x8c, 0xe6,
-	0x95, 0xd0, 0xbf, 0x29, 0xf4, 0x14, 0xee, 0x2c, 0x89, 0xc0, 0x09, 0x16, 0xd8, 0x6c, 0xd8, 0x0d,
-	0x67, 0x77, 0x70, 0xe0, 0x2a, 0x03, 0x5d, 0xe9, 0x59, 0x65, 0xa0, 0x7b, 0x56, 0x15, 0x0d, 0x9b,
-	0x57, 0x3f, 0x0e, 0xb5, 0xf0, 0x4f, 0x93, 0xd4, 0xa5, 0xf5, 0x3e, 0xc0, 0x4e, 0x2d, 0x2b, 0x08,
-	0x47, 0x83, 0xfe, 0xad, 0x75, 0x3d, 0x80, 0xba, 0xf4, 0xa3, 0x5a, 0x00, 0xe1, 0x52, 0x5d, 0x2b,
-	0xfc, 0x87, 0xad, 0x7e, 0xcf, 0xe1, 0xc1, 0x8c, 0xa6, 0xe9, 0x82, 0xc8, 0x15, 0x8e, 0x68, 0x5e,
-	0x10, 0xc6, 0x33, 0x7a, 0x7b, 0x7b, 0xca, 0xbe, 0x72, 0xa4, 0xdc, 0x65, 0xd9, 0x57, 0x82, 0x6a,
-	0x17, 0xe7, 0xd0, 0xa8, 0xe7, 0xd7, 0xee, 0xdc, 0xb0, 0x13, 0xfc, 0x87, 0x9d, 0x0f, 0x9f, 0xc3,
-	0x6d, 0xb9, 0x6f, 0xd4, 0x81, 0xf7, 0x26, 0x2f, 0xc6, 0x41, 0x38, 0xbf, 0x18, 0x9f, 0x4f, 0x83,
-	0xd1, 0xc9, 0xb3, 0x93, 0xe0, 0xd8, 0xd0, 0x90, 0x01, 0xf7, 0x14, 0x7d, 0x36, 0x39, 0xbe, 0x38,
-	0x0d, 0x0c, 0x80, 0x10, 0xd4, 0x15, 0x13, 0xbc, 0x9c, 0x05, 0xe1, 0xf8, 0xe8, 0xd4, 0xd8, 0xea,
-	0x36, 0x3f, 0x7e, 0xb5, 0xb4, 0xe1, 0xf0, 0x6a, 0x6d, 0x81, 0xeb, 0xb5, 0x05, 0x7e, 0xae, 0x2d,
-	0xf0, 0x69, 0x63, 0x69, 0xd7, 0x1b, 0x4b, 0xfb, 0xbe, 0xb1, 0xb4, 0x57, 0x4e, 0x9a, 0x89, 0xd7,
-	0x6f, 0x22, 0x37, 0xa6, 0x4b, 0xaf, 0x3a, 0x2d, 0xf9, 0x2d, 0xfc, 0xbe, 0xf7, 0xae, 0x3a, 0x33,
-	0xf1, 0x7e, 0x45, 0x78, 0x74, 0x47, 0x9e, 0xc7, 0xa3, 0xdf, 0x01, 0x00, 0x00, 0xff, 0xff, 0x6d,
-	0xcc, 0xf9, 0x4c, 0x82, 0x03, 0x00, 0x00,
+func (m *SlashRegistrationDepositProposal) GetErc20Addresses() []string {
+	if m != nil {
+		return m.Erc20Addresses
+	}
+	return nil
 }
 
-func (this *TokenPair) Equal(that interface{}) bool {
-	if that == nil {
-		return this == nil
-	}
+// DeregisterTokenPairProposal is a gov Content type to remove a token pair from
+// the token mapping after unwinding the coins and tokens escrowed by the module.
+type DeregisterTokenPairProposal struct {
+	// title of the proposal
+	Title string `protobuf:"bytes,1,opt,name=title,proto3" json:"title,omitempty"`
+	// description of the proposal
+	Description string `protobuf:"bytes,2,opt,name=description,proto3" json:"description,omitempty"`
+	// token identifier can be either the hex contract address of the ERC20 or the
+	// Cosmos base denomination
+	Token string `protobuf:"bytes,3,opt,name=token,proto3" json:"token,omitempty"`
+}
 
-	that1, ok := that.(*TokenPair)
-	if !ok {
-		that2, ok := that.(TokenPair)
-		if ok {
-			that1 = &that2
-		} else {
-			return false
+func (m *DeregisterTokenPairProposal) Reset()         { *m = DeregisterTokenPairProposal{} }
+func (m *DeregisterTokenPairProposal) String() string { return proto.CompactTextString(m) }
+func (*DeregisterTokenPairProposal) ProtoMessage()    {}
+func (*DeregisterTokenPairProposal) Descriptor() ([]byte, []int) {
+	return fileDescriptor_668d5dc537f45142, []int{5}
+}
+func (m *DeregisterTokenPairProposal) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *DeregisterTokenPairProposal) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_DeregisterTokenPairProposal.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
+		return b[:n], nil
 	}
-	if that1 == nil {
-		return this == nil
-	} else if this == nil {
-		return false
-	}
-	if this.Erc20Address != that1.Erc20Address {
-		return false
-	}
-	if this.Denom != that1.Denom {
-		return false
-	}
-	if this.Enabled != that1.Enabled {
-		return false
+}
+func (m *DeregisterTokenPairProposal) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_DeregisterTokenPairProposal.Merge(m, src)
+}
+func (m *DeregisterTokenPairProposal) XXX_Size() int {
+	return m.Size()
+}
+func (m *DeregisterTokenPairProposal) XXX_DiscardUnknown() {
+	xxx_messageInfo_DeregisterTokenPairProposal.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_DeregisterTokenPairProposal proto.InternalMessageInfo
+
+func (m *DeregisterTokenPairProposal) GetTitle() string {
+	if m != nil {
+		return m.Title
 	}
-	if this.ContractOwner != that1.ContractOwner {
-		return false
+	return ""
+}
+
+func (m *DeregisterTokenPairProposal) GetDescription() string {
+	if m != nil {
+		return m.Description
 	}
-	return true
+	return ""
 }
-func (this *ToggleTokenConversionProposal) Equal(that interface{}) bool {
-	if that == nil {
-		return this == nil
+
+func (m *DeregisterTokenPairProposal) GetToken() string {
+	if m != nil {
+		return m.Token
 	}
+	return ""
+}
 
-	that1, ok := that.(*ToggleTokenConversionProposal)
-	if !ok {
-		that2, ok := that.(ToggleTokenConversionProposal)
-		if ok {
-			that1 = &that2
-		} else {
-			return false
+// TokenPairDeposit defines the refundable deposit escrowed by the account that
+// registered an ERC20 token pair through MsgRegisterERC20.
+type TokenPairDeposit struct {
+	// erc20_address is the hex address of the registered ERC20 token contract
+	Erc20Address string `protobuf:"bytes,1,opt,name=erc20_address,json=erc20Address,proto3" json:"erc20_address,omitempty"`
+	// depositor is the bech32 address of the account that escrowed the deposit
+	Depositor string `protobuf:"bytes,2,opt,name=depositor,proto3" json:"depositor,omitempty"`
+	// amount of coins escrowed on the module account
+	Amount github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,3,rep,name=amount,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"amount"`
+}
+
+func (m *TokenPairDeposit) Reset()         { *m = TokenPairDeposit{} }
+func (m *TokenPairDeposit) String() string { return proto.CompactTextString(m) }
+func (*TokenPairDeposit) ProtoMessage()    {}
+func (*TokenPairDeposit) Descriptor() ([]byte, []int) {
+	return fileDescriptor_668d5dc537f45142, []int{6}
+}
+func (m *TokenPairDeposit) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *TokenPairDeposit) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_TokenPairDeposit.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
+		return b[:n], nil
 	}
-	if that1 == nil {
-		return this == nil
-	} else if this == nil {
-		return false
-	}
-	if this.Title != that1.Title {
-		return false
-	}
-	if this.Description != that1.Description {
-		return false
+}
+func (m *TokenPairDeposit) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_TokenPairDeposit.Merge(m, src)
+}
+func (m *TokenPairDeposit) XXX_Size() int {
+	return m.Size()
+}
+func (m *TokenPairDeposit) XXX_DiscardUnknown() {
+	xxx_messageInfo_TokenPairDeposit.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_TokenPairDeposit proto.InternalMessageInfo
+
+func (m *TokenPairDeposit) GetErc20Address() string {
+	if m != nil {
+		return m.Erc20Address
 	}
-	if this.Token != that1.Token {
-		return false
+	return ""
+}
+
+func (m *TokenPairDeposit) GetDepositor() string {
+	if m != nil {
+		return m.Depositor
 	}
-	return true
+	return ""
 }
-func (m *TokenPair) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+
+func (m *TokenPairDeposit) GetAmount() github_com_cosmos_cosmos_sdk_types.Coins {
+	if m != nil {
+		return m.Amount
 	}
-	return dAtA[:n], nil
+	return nil
 }
 
-func (m *TokenPair) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+// TokenPairRateLimit defines the maximum volume of a token pair that can be
+// converted in each direction during an epoch, together with the volume
+// converted so far in the current epoch.
+type TokenPairRateLimit struct {
+	// erc20_address is the hex address of the rate limited ERC20 token contract
+	Erc20Address string `protobuf:"bytes,1,opt,name=erc20_address,json=erc20Address,proto3" json:"erc20_address,omitempty"`
+	// epoch_identifier is the identifier of the x/epochs epoch after which the
+	// converted volumes are reset
+	EpochIdentifier string `protobuf:"bytes,2,opt,name=epoch_identifier,json=epochIdentifier,proto3" json:"epoch_identifier,omitempty"`
+	// coin_to_erc20_limit is the maximum amount of Cosmos coins that can be
+	// converted to ERC20 tokens per epoch. A zero value disables the limit.
+	CoinToERC20Limit github_com_cosmos_cosmos_sdk_types.Int `protobuf:"bytes,3,opt,name=coin_to_erc20_limit,json=coinToErc20Limit,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Int" json:"coin_to_erc20_limit"`
+	// erc20_to_coin_limit is the maximum amount of ERC20 tokens that can be
+	// converted to Cosmos coins per epoch. A zero value disables the limit.
+	ERC20ToCoinLimit github_com_cosmos_cosmos_sdk_types.Int `protobuf:"bytes,4,opt,name=erc20_to_coin_limit,json=erc20ToCoinLimit,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Int" json:"erc20_to_coin_limit"`
+	// coin_to_erc20_volume is the amount of Cosmos coins converted to ERC20
+	// tokens during the current epoch
+	CoinToERC20Volume github_com_cosmos_cosmos_sdk_types.Int `protobuf:"bytes,5,opt,name=coin_to_erc20_volume,json=coinToErc20Volume,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Int" json:"coin_to_erc20_volume"`
+	// erc20_to_coin_volume is the amount of ERC20 tokens converted to Cosmos
+	// coins during the current epoch
+	ERC20ToCoinVolume github_com_cosmos_cosmos_sdk_types.Int `protobuf:"bytes,6,opt,name=erc20_to_coin_volume,json=erc20ToCoinVolume,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Int" json:"erc20_to_coin_volume"`
+	// paused is true if the token pair conversions were disabled because one
+	// of the limits was reached
+	Paused bool `protobuf:"varint,7,opt,name=paused,proto3" json:"paused,omitempty"`
 }
 
-func (m *TokenPair) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.ContractOwner != 0 {
-		i = encodeVarintErc20(dAtA, i, uint64(m.ContractOwner))
-		i--
-		dAtA[i] = 0x20
-	}
-	if m.Enabled {
-		i--
-		if m.Enabled {
-			dAtA[i] = 1
-		} else {
-			dAtA[i] = 0
+func (m *TokenPairRateLimit) Reset()         { *m = TokenPairRateLimit{} }
+func (m *TokenPairRateLimit) String() string { return proto.CompactTextString(m) }
+func (*TokenPairRateLimit) ProtoMessage()    {}
+func (*TokenPairRateLimit) Descriptor() ([]byte, []int) {
+	return fileDescriptor_668d5dc537f45142, []int{7}
+}
+func (m *TokenPairRateLimit) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *TokenPairRateLimit) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_TokenPairRateLimit.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
-		i--
-		dAtA[i] = 0x18
-	}
-	if len(m.Denom) > 0 {
-		i -= len(m.Denom)
-		copy(dAtA[i:], m.Denom)
-		i = encodeVarintErc20(dAtA, i, uint64(len(m.Denom)))
-		i--
-		dAtA[i] = 0x12
-	}
-	if len(m.Erc20Address) > 0 {
-		i -= len(m.Erc20Address)
-		copy(dAtA[i:], m.Erc20Address)
-		i = encodeVarintErc20(dAtA, i, uint64(len(m.Erc20Address)))
-		i--
-		dAtA[i] = 0xa
+		return b[:n], nil
 	}
-	return len(dAtA) - i, nil
+}
+func (m *TokenPairRateLimit) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_TokenPairRateLimit.Merge(m, src)
+}
+func (m *TokenPairRateLimit) XXX_Size() int {
+	return m.Size()
+}
+func (m *TokenPairRateLimit) XXX_DiscardUnknown() {
+	xxx_messageInfo_TokenPairRateLimit.DiscardUnknown(m)
 }
 
-func (m *RegisterCoinProposal) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_TokenPairRateLimit proto.InternalMessageInfo
+
+func (m *TokenPairRateLimit) GetErc20Address() string {
+	if m != nil {
+		return m.Erc20Address
 	}
-	return dAtA[:n], nil
+	return ""
 }
 
-func (m *RegisterCoinProposal) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
-}
-
-func (m *RegisterCoinProposal) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.Metadata) > 0 {
-		for iNdEx := len(m.Metadata) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.Metadata[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintErc20(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0x1a
-		}
-	}
-	if len(m.Description) > 0 {
-		i -= len(m.Description)
-		copy(dAtA[i:], m.Description)
-		i = encodeVarintErc20(dAtA, i, uint64(len(m.Description)))
-		i--
-		dAtA[i] = 0x12
-	}
-	if len(m.Title) > 0 {
-		i -= len(m.Title)
-		copy(dAtA[i:], m.Title)
-		i = encodeVarintErc20(dAtA, i, uint64(len(m.Title)))
-		i--
-		dAtA[i] = 0xa
+func (m *TokenPairRateLimit) GetEpochIdentifier() string {
+	if m != nil {
+		return m.EpochIdentifier
 	}
-	return len(dAtA) - i, nil
+	return ""
 }
 
-func (m *RegisterERC20Proposal) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *TokenPairRateLimit) GetPaused() bool {
+	if m != nil {
+		return m.Paused
 	}
-	return dAtA[:n], nil
+	return false
 }
 
-func (m *RegisterERC20Proposal) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+// SetTokenPairRateLimitProposal is a gov Content type to set or remove the
+// conversion rate limit of a token pair. The converted volumes of the pair are
+// reset and the pair is enabled again if it was paused by the rate limit.
+type SetTokenPairRateLimitProposal struct {
+	// title of the proposal
+	Title string `protobuf:"bytes,1,opt,name=title,proto3" json:"title,omitempty"`
+	// description of the proposal
+	Description string `protobuf:"bytes,2,opt,name=description,proto3" json:"description,omitempty"`
+	// token identifier can be either the hex contract address of the ERC20 or the
+	// Cosmos base denomination
+	Token string `protobuf:"bytes,3,opt,name=token,proto3" json:"token,omitempty"`
+	// epoch_identifier is the identifier of the x/epochs epoch after which the
+	// converted volumes are reset
+	EpochIdentifier string `protobuf:"bytes,4,opt,name=epoch_identifier,json=epochIdentifier,proto3" json:"epoch_identifier,omitempty"`
+	// coin_to_erc20_limit is the maximum amount of Cosmos coins that can be
+	// converted to ERC20 tokens per epoch. A zero value disables the limit.
+	CoinToERC20Limit github_com_cosmos_cosmos_sdk_types.Int `protobuf:"bytes,5,opt,name=coin_to_erc20_limit,json=coinToErc20Limit,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Int" json:"coin_to_erc20_limit"`
+	// erc20_to_coin_limit is the maximum amount of ERC20 tokens that can be
+	// converted to Cosmos coins per epoch. A zero value disables the limit.
+	ERC20ToCoinLimit github_com_cosmos_cosmos_sdk_types.Int `protobuf:"bytes,6,opt,name=erc20_to_coin_limit,json=erc20ToCoinLimit,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Int" json:"erc20_to_coin_limit"`
 }
 
-func (m *RegisterERC20Proposal) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.Erc20Addresses) > 0 {
-		for iNdEx := len(m.Erc20Addresses) - 1; iNdEx >= 0; iNdEx-- {
-			i -= len(m.Erc20Addresses[iNdEx])
-			copy(dAtA[i:], m.Erc20Addresses[iNdEx])
-			i = encodeVarintErc20(dAtA, i, uint64(len(m.Erc20Addresses[iNdEx])))
-			i--
-			dAtA[i] = 0x1a
+func (m *SetTokenPairRateLimitProposal) Reset()         { *m = SetTokenPairRateLimitProposal{} }
+func (m *SetTokenPairRateLimitProposal) String() string { return proto.CompactTextString(m) }
+func (*SetTokenPairRateLimitProposal) ProtoMessage()    {}
+func (*SetTokenPairRateLimitProposal) Descriptor() ([]byte, []int) {
+	return fileDescriptor_668d5dc537f45142, []int{8}
+}
+func (m *SetTokenPairRateLimitProposal) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *SetTokenPairRateLimitProposal) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_SetTokenPairRateLimitProposal.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
+		return b[:n], nil
 	}
-	if len(m.Description) > 0 {
-		i -= len(m.Description)
-		copy(dAtA[i:], m.Description)
-		i = encodeVarintErc20(dAtA, i, uint64(len(m.Description)))
-		i--
-		dAtA[i] = 0x12
-	}
-	if len(m.Title) > 0 {
-		i -= len(m.Title)
-		copy(dAtA[i:], m.Title)
-		i = encodeVarintErc20(dAtA, i, uint64(len(m.Title)))
-		i--
-		dAtA[i] = 0xa
-	}
-	return len(dAtA) - i, nil
+}
+func (m *SetTokenPairRateLimitProposal) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SetTokenPairRateLimitProposal.Merge(m, src)
+}
+func (m *SetTokenPairRateLimitProposal) XXX_Size() int {
+	return m.Size()
+}
+func (m *SetTokenPairRateLimitProposal) XXX_DiscardUnknown() {
+	xxx_messageInfo_SetTokenPairRateLimitProposal.DiscardUnknown(m)
 }
 
-func (m *ToggleTokenConversionProposal) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_SetTokenPairRateLimitProposal proto.InternalMessageInfo
+
+func (m *SetTokenPairRateLimitProposal) GetTitle() string {
+	if m != nil {
+		return m.Title
 	}
-	return dAtA[:n], nil
+	return ""
 }
 
-func (m *ToggleTokenConversionProposal) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *SetTokenPairRateLimitProposal) GetDescription() string {
+	if m != nil {
+		return m.Description
+	}
+	return ""
 }
 
-func (m *ToggleTokenConversionProposal) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.Token) > 0 {
-		i -= len(m.Token)
-		copy(dAtA[i:], m.Token)
-		i = encodeVarintErc20(dAtA, i, uint64(len(m.Token)))
-		i--
-		dAtA[i] = 0x1a
-	}
-	if len(m.Description) > 0 {
-		i -= len(m.Description)
-		copy(dAtA[i:], m.Description)
-		i = encodeVarintErc20(dAtA, i, uint64(len(m.Description)))
-		i--
-		dAtA[i] = 0x12
-	}
-	if len(m.Title) > 0 {
-		i -= len(m.Title)
-		copy(dAtA[i:], m.Title)
-		i = encodeVarintErc20(dAtA, i, uint64(len(m.Title)))
-		i--
-		dAtA[i] = 0xa
+func (m *SetTokenPairRateLimitProposal) GetToken() string {
+	if m != nil {
+		return m.Token
 	}
-	return len(dAtA) - i, nil
+	return ""
 }
 
-func (m *ProposalMetadata) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *SetTokenPairRateLimitProposal) GetEpochIdentifier() string {
+	if m != nil {
+		return m.EpochIdentifier
 	}
-	return dAtA[:n], nil
+	return ""
 }
 
-func (m *ProposalMetadata) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+// TokenPairStats defines the cumulative volumes converted through a token
+// pair since it was registered.
+type TokenPairStats struct {
+	// erc20_address is the hex address of the ERC20 token contract
+	Erc20Address string `protobuf:"bytes,1,opt,name=erc20_address,json=erc20Address,proto3" json:"erc20_address,omitempty"`
+	// coin_to_erc20_volume is the total amount of Cosmos coins converted to
+	// ERC20 tokens
+	CoinToERC20Volume github_com_cosmos_cosmos_sdk_types.Int `protobuf:"bytes,2,opt,name=coin_to_erc20_volume,json=coinToErc20Volume,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Int" json:"coin_to_erc20_volume"`
+	// erc20_to_coin_volume is the total amount of ERC20 tokens converted to
+	// Cosmos coins
+	ERC20ToCoinVolume github_com_cosmos_cosmos_sdk_types.Int `protobuf:"bytes,3,opt,name=erc20_to_coin_volume,json=erc20ToCoinVolume,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Int" json:"erc20_to_coin_volume"`
 }
 
-func (m *ProposalMetadata) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.Metadata) > 0 {
-		for iNdEx := len(m.Metadata) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.Metadata[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintErc20(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0xa
+func (m *TokenPairStats) Reset()         { *m = TokenPairStats{} }
+func (m *TokenPairStats) String() string { return proto.CompactTextString(m) }
+func (*TokenPairStats) ProtoMessage()    {}
+func (*TokenPairStats) Descriptor() ([]byte, []int) {
+	return fileDescriptor_668d5dc537f45142, []int{9}
+}
+func (m *TokenPairStats) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *TokenPairStats) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_TokenPairStats.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
+		return b[:n], nil
 	}
-	return len(dAtA) - i, nil
+}
+func (m *TokenPairStats) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_TokenPairStats.Merge(m, src)
+}
+func (m *TokenPairStats) XXX_Size() int {
+	return m.Size()
+}
+func (m *TokenPairStats) XXX_DiscardUnknown() {
+	xxx_messageInfo_TokenPairStats.DiscardUnknown(m)
 }
 
-func encodeVarintErc20(dAtA []byte, offset int, v uint64) int {
-	offset -= sovErc20(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
+var xxx_messageInfo_TokenPairStats proto.InternalMessageInfo
+
+func (m *TokenPairStats) GetErc20Address() string {
+	if m != nil {
+		return m.Erc20Address
 	}
-	dAtA[offset] = uint8(v)
-	return base
+	return ""
 }
-func (m *TokenPair) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.Erc20Address)
-	if l > 0 {
-		n += 1 + l + sovErc20(uint64(l))
-	}
-	l = len(m.Denom)
-	if l > 0 {
-		n += 1 + l + sovErc20(uint64(l))
+
+// ERC20Template defines a governance approved ERC20 contract bytecode that can be
+// deployed for native Cosmos coins. The contract constructor must take the
+// name, symbol and decimals of the coin, and the contract must implement the
+// methods of the ERC20MinterBurnerDecimals contract used by the module.
+// Upgradeable contracts that are set up with an initializer call instead of
+// their constructor are not supported, as the module does not call an
+// initializer after the deployment.
+type ERC20Template struct {
+	// id is the unique identifier of the template
+	ID string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	// description of the template
+	Description string `protobuf:"bytes,2,opt,name=description,proto3" json:"description,omitempty"`
+	// bytecode is the contract creation bytecode without constructor arguments
+	Bytecode []byte `protobuf:"bytes,3,opt,name=bytecode,proto3" json:"bytecode,omitempty"`
+}
+
+func (m *ERC20Template) Reset()         { *m = ERC20Template{} }
+func (m *ERC20Template) String() string { return proto.CompactTextString(m) }
+func (*ERC20Template) ProtoMessage()    {}
+func (*ERC20Template) Descriptor() ([]byte, []int) {
+	return fileDescriptor_668d5dc537f45142, []int{10}
+}
+func (m *ERC20Template) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ERC20Template) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ERC20Template.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	if m.Enabled {
-		n += 2
+}
+func (m *ERC20Template) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ERC20Template.Merge(m, src)
+}
+func (m *ERC20Template) XXX_Size() int {
+	return m.Size()
+}
+func (m *ERC20Template) XXX_DiscardUnknown() {
+	xxx_messageInfo_ERC20Template.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ERC20Template proto.InternalMessageInfo
+
+func (m *ERC20Template) GetID() string {
+	if m != nil {
+		return m.ID
 	}
-	if m.ContractOwner != 0 {
-		n += 1 + sovErc20(uint64(m.ContractOwner))
+	return ""
+}
+
+func (m *ERC20Template) GetDescription() string {
+	if m != nil {
+		return m.Description
 	}
-	return n
+	return ""
 }
 
-func (m *RegisterCoinProposal) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *ERC20Template) GetBytecode() []byte {
+	if m != nil {
+		return m.Bytecode
 	}
-	var l int
-	_ = l
-	l = len(m.Title)
-	if l > 0 {
-		n += 1 + l + sovErc20(uint64(l))
+	return nil
+}
+
+// RegisterERC20TemplateProposal is a gov Content type to add an ERC20 template
+// to the registry.
+type RegisterERC20TemplateProposal struct {
+	// title of the proposal
+	Title string `protobuf:"bytes,1,opt,name=title,proto3" json:"title,omitempty"`
+	// description of the proposal
+	Description string `protobuf:"bytes,2,opt,name=description,proto3" json:"description,omitempty"`
+	// template to register
+	Template ERC20Template `protobuf:"bytes,3,opt,name=template,proto3" json:"template"`
+}
+
+func (m *RegisterERC20TemplateProposal) Reset()         { *m = RegisterERC20TemplateProposal{} }
+func (m *RegisterERC20TemplateProposal) String() string { return proto.CompactTextString(m) }
+func (*RegisterERC20TemplateProposal) ProtoMessage()    {}
+func (*RegisterERC20TemplateProposal) Descriptor() ([]byte, []int) {
+	return fileDescriptor_668d5dc537f45142, []int{11}
+}
+func (m *RegisterERC20TemplateProposal) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *RegisterERC20TemplateProposal) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_RegisterERC20TemplateProposal.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	l = len(m.Description)
-	if l > 0 {
-		n += 1 + l + sovErc20(uint64(l))
+}
+func (m *RegisterERC20TemplateProposal) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_RegisterERC20TemplateProposal.Merge(m, src)
+}
+func (m *RegisterERC20TemplateProposal) XXX_Size() int {
+	return m.Size()
+}
+func (m *RegisterERC20TemplateProposal) XXX_DiscardUnknown() {
+	xxx_messageInfo_RegisterERC20TemplateProposal.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_RegisterERC20TemplateProposal proto.InternalMessageInfo
+
+func (m *RegisterERC20TemplateProposal) GetTitle() string {
+	if m != nil {
+		return m.Title
 	}
-	if len(m.Metadata) > 0 {
-		for _, e := range m.Metadata {
-			l = e.Size()
-			n += 1 + l + sovErc20(uint64(l))
+	return ""
+}
+
+func (m *RegisterERC20TemplateProposal) GetDescription() string {
+	if m != nil {
+		return m.Description
+	}
+	return ""
+}
+
+func (m *RegisterERC20TemplateProposal) GetTemplate() ERC20Template {
+	if m != nil {
+		return m.Template
+	}
+	return ERC20Template{}
+}
+
+// RemoveERC20TemplateProposal is a gov Content type to remove an ERC20 template
+// from the registry. Token pairs deployed from the template are not affected.
+type RemoveERC20TemplateProposal struct {
+	// title of the proposal
+	Title string `protobuf:"bytes,1,opt,name=title,proto3" json:"title,omitempty"`
+	// description of the proposal
+	Description string `protobuf:"bytes,2,opt,name=description,proto3" json:"description,omitempty"`
+	// template_id is the identifier of the template to remove
+	TemplateID string `protobuf:"bytes,3,opt,name=template_id,json=templateId,proto3" json:"template_id,omitempty"`
+}
+
+func (m *RemoveERC20TemplateProposal) Reset()         { *m = RemoveERC20TemplateProposal{} }
+func (m *RemoveERC20TemplateProposal) String() string { return proto.CompactTextString(m) }
+func (*RemoveERC20TemplateProposal) ProtoMessage()    {}
+func (*RemoveERC20TemplateProposal) Descriptor() ([]byte, []int) {
+	return fileDescriptor_668d5dc537f45142, []int{12}
+}
+func (m *RemoveERC20TemplateProposal) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *RemoveERC20TemplateProposal) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_RemoveERC20TemplateProposal.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
+		return b[:n], nil
 	}
-	return n
+}
+func (m *RemoveERC20TemplateProposal) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_RemoveERC20TemplateProposal.Merge(m, src)
+}
+func (m *RemoveERC20TemplateProposal) XXX_Size() int {
+	return m.Size()
+}
+func (m *RemoveERC20TemplateProposal) XXX_DiscardUnknown() {
+	xxx_messageInfo_RemoveERC20TemplateProposal.DiscardUnknown(m)
 }
 
-func (m *RegisterERC20Proposal) Size() (n int) {
-	if m == nil {
-		return 0
+var xxx_messageInfo_RemoveERC20TemplateProposal proto.InternalMessageInfo
+
+func (m *RemoveERC20TemplateProposal) GetTitle() string {
+	if m != nil {
+		return m.Title
 	}
-	var l int
-	_ = l
-	l = len(m.Title)
-	if l > 0 {
-		n += 1 + l + sovErc20(uint64(l))
+	return ""
+}
+
+func (m *RemoveERC20TemplateProposal) GetDescription() string {
+	if m != nil {
+		return m.Description
 	}
-	l = len(m.Description)
-	if l > 0 {
-		n += 1 + l + sovErc20(uint64(l))
+	return ""
+}
+
+func (m *RemoveERC20TemplateProposal) GetTemplateID() string {
+	if m != nil {
+		return m.TemplateID
 	}
-	if len(m.Erc20Addresses) > 0 {
-		for _, s := range m.Erc20Addresses {
-			l = len(s)
-			n += 1 + l + sovErc20(uint64(l))
+	return ""
+}
+
+// NFTPair defines an instance that records a pairing consisting of an ERC721 or
+// ERC1155 token contract and a native x/nft class. The class metadata follows
+// the ICS-721 non-fungible token transfer specification.
+type NFTPair struct {
+	// contract_address is the hex address of the ERC721 or ERC1155 contract
+	ContractAddress string `protobuf:"bytes,1,opt,name=contract_address,json=contractAddress,proto3" json:"contract_address,omitempty"`
+	// class_id is the identifier of the x/nft class that represents the contract
+	ClassID string `protobuf:"bytes,2,opt,name=class_id,json=classId,proto3" json:"class_id,omitempty"`
+	// standard is the token standard implemented by the contract
+	Standard NFTStandard `protobuf:"varint,3,opt,name=standard,proto3,enum=evmos.erc20.v1.NFTStandard" json:"standard,omitempty"`
+	// class_uri is the ICS-721 class URI of the collection
+	ClassURI string `protobuf:"bytes,4,opt,name=class_uri,json=classUri,proto3" json:"class_uri,omitempty"`
+	// class_data is the ICS-721 class data of the collection
+	ClassData string `protobuf:"bytes,5,opt,name=class_data,json=classData,proto3" json:"class_data,omitempty"`
+}
+
+func (m *NFTPair) Reset()         { *m = NFTPair{} }
+func (m *NFTPair) String() string { return proto.CompactTextString(m) }
+func (*NFTPair) ProtoMessage()    {}
+func (*NFTPair) Descriptor() ([]byte, []int) {
+	return fileDescriptor_668d5dc537f45142, []int{13}
+}
+func (m *NFTPair) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *NFTPair) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_NFTPair.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
+		return b[:n], nil
 	}
-	return n
+}
+func (m *NFTPair) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_NFTPair.Merge(m, src)
+}
+func (m *NFTPair) XXX_Size() int {
+	return m.Size()
+}
+func (m *NFTPair) XXX_DiscardUnknown() {
+	xxx_messageInfo_NFTPair.DiscardUnknown(m)
 }
 
-func (m *ToggleTokenConversionProposal) Size() (n int) {
-	if m == nil {
-		return 0
+var xxx_messageInfo_NFTPair proto.InternalMessageInfo
+
+func (m *NFTPair) GetContractAddress() string {
+	if m != nil {
+		return m.ContractAddress
 	}
-	var l int
-	_ = l
-	l = len(m.Title)
-	if l > 0 {
-		n += 1 + l + sovErc20(uint64(l))
+	return ""
+}
+
+func (m *NFTPair) GetClassID() string {
+	if m != nil {
+		return m.ClassID
 	}
-	l = len(m.Description)
-	if l > 0 {
-		n += 1 + l + sovErc20(uint64(l))
+	return ""
+}
+
+func (m *NFTPair) GetStandard() NFTStandard {
+	if m != nil {
+		return m.Standard
 	}
-	l = len(m.Token)
-	if l > 0 {
-		n += 1 + l + sovErc20(uint64(l))
+	return NFT_STANDARD_UNSPECIFIED
+}
+
+func (m *NFTPair) GetClassURI() string {
+	if m != nil {
+		return m.ClassURI
 	}
-	return n
+	return ""
 }
 
-func (m *ProposalMetadata) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *NFTPair) GetClassData() string {
+	if m != nil {
+		return m.ClassData
 	}
-	var l int
-	_ = l
-	if len(m.Metadata) > 0 {
-		for _, e := range m.Metadata {
-			l = e.Size()
-			n += 1 + l + sovErc20(uint64(l))
+	return ""
+}
+
+// RegisterNFTPairProposal is a gov Content type to register an NFT pair for an
+// ERC721 or ERC1155 token contract.
+type RegisterNFTPairProposal struct {
+	// title of the proposal
+	Title string `protobuf:"bytes,1,opt,name=title,proto3" json:"title,omitempty"`
+	// description of the proposal
+	Description string `protobuf:"bytes,2,opt,name=description,proto3" json:"description,omitempty"`
+	// contract_address is the hex address of the ERC721 or ERC1155 contract
+	ContractAddress string `protobuf:"bytes,3,opt,name=contract_address,json=contractAddress,proto3" json:"contract_address,omitempty"`
+	// standard is the token standard implemented by the contract
+	Standard NFTStandard `protobuf:"varint,4,opt,name=standard,proto3,enum=evmos.erc20.v1.NFTStandard" json:"standard,omitempty"`
+	// class_uri is the ICS-721 class URI of the collection
+	ClassURI string `protobuf:"bytes,5,opt,name=class_uri,json=classUri,proto3" json:"class_uri,omitempty"`
+	// class_data is the ICS-721 class data of the collection
+	ClassData string `protobuf:"bytes,6,opt,name=class_data,json=classData,proto3" json:"class_data,omitempty"`
+}
+
+func (m *RegisterNFTPairProposal) Reset()         { *m = RegisterNFTPairProposal{} }
+func (m *RegisterNFTPairProposal) String() string { return proto.CompactTextString(m) }
+func (*RegisterNFTPairProposal) ProtoMessage()    {}
+func (*RegisterNFTPairProposal) Descriptor() ([]byte, []int) {
+	return fileDescriptor_668d5dc537f45142, []int{14}
+}
+func (m *RegisterNFTPairProposal) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *RegisterNFTPairProposal) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_RegisterNFTPairProposal.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
+		return b[:n], nil
 	}
-	return n
+}
+func (m *RegisterNFTPairProposal) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_RegisterNFTPairProposal.Merge(m, src)
+}
+func (m *RegisterNFTPairProposal) XXX_Size() int {
+	return m.Size()
+}
+func (m *RegisterNFTPairProposal) XXX_DiscardUnknown() {
+	xxx_messageInfo_RegisterNFTPairProposal.DiscardUnknown(m)
 }
 
-func sovErc20(x uint64) (n int) {
-	return (math_bits.Len64(x|1) + 6) / 7
+var xxx_messageInfo_RegisterNFTPairProposal proto.InternalMessageInfo
+
+func (m *RegisterNFTPairProposal) GetTitle() string {
+	if m != nil {
+		return m.Title
+	}
+	return ""
 }
-func sozErc20(x uint64) (n int) {
-	return sovErc20(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+
+func (m *RegisterNFTPairProposal) GetDescription() string {
+	if m != nil {
+		return m.Description
+	}
+	return ""
+}
+
+func (m *RegisterNFTPairProposal) GetContractAddress() string {
+	if m != nil {
+		return m.ContractAddress
+	}
+	return ""
+}
+
+func (m *RegisterNFTPairProposal) GetStandard() NFTStandard {
+	if m != nil {
+		return m.Standard
+	}
+	return NFT_STANDARD_UNSPECIFIED
+}
+
+func (m *RegisterNFTPairProposal) GetClassURI() string {
+	if m != nil {
+		return m.ClassURI
+	}
+	return ""
+}
+
+func (m *RegisterNFTPairProposal) GetClassData() string {
+	if m != nil {
+		return m.ClassData
+	}
+	return ""
+}
+
+// FailedConversion defines an ERC20 -> Cosmos coin conversion of the EVM hook
+// that failed after the tokens were transferred to the module address. The
+// tokens remain on the module address until the sender refunds them.
+type FailedConversion struct {
+	// tx_hash is the hex hash of the Ethereum tx that transferred the tokens
+	TxHash string `protobuf:"bytes,1,opt,name=tx_hash,json=txHash,proto3" json:"tx_hash,omitempty"`
+	// log_index is the index of the transfer log in the tx receipt
+	LogIndex uint64 `protobuf:"varint,2,opt,name=log_index,json=logIndex,proto3" json:"log_index,omitempty"`
+	// erc20_address is the hex address of the ERC20 token contract
+	Erc20Address string `protobuf:"bytes,3,opt,name=erc20_address,json=erc20Address,proto3" json:"erc20_address,omitempty"`
+	// sender is the hex address that transferred the tokens to the module address
+	Sender string `protobuf:"bytes,4,opt,name=sender,proto3" json:"sender,omitempty"`
+	// amount of ERC20 tokens transferred to the module address
+	Amount github_com_cosmos_cosmos_sdk_types.Int `protobuf:"bytes,5,opt,name=amount,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Int" json:"amount"`
+	// error is the reason of the conversion failure
+	Error string `protobuf:"bytes,6,opt,name=error,proto3" json:"error,omitempty"`
+	// height is the block height at which the conversion failed
+	Height int64 `protobuf:"varint,7,opt,name=height,proto3" json:"height,omitempty"`
+}
+
+func (m *FailedConversion) Reset()         { *m = FailedConversion{} }
+func (m *FailedConversion) String() string { return proto.CompactTextString(m) }
+func (*FailedConversion) ProtoMessage()    {}
+func (*FailedConversion) Descriptor() ([]byte, []int) {
+	return fileDescriptor_668d5dc537f45142, []int{15}
+}
+func (m *FailedConversion) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *FailedConversion) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_FailedConversion.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *FailedConversion) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_FailedConversion.Merge(m, src)
+}
+func (m *FailedConversion) XXX_Size() int {
+	return m.Size()
+}
+func (m *FailedConversion) XXX_DiscardUnknown() {
+	xxx_messageInfo_FailedConversion.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_FailedConversion proto.InternalMessageInfo
+
+func (m *FailedConversion) GetTxHash() string {
+	if m != nil {
+		return m.TxHash
+	}
+	return ""
+}
+
+func (m *FailedConversion) GetLogIndex() uint64 {
+	if m != nil {
+		return m.LogIndex
+	}
+	return 0
+}
+
+func (m *FailedConversion) GetErc20Address() string {
+	if m != nil {
+		return m.Erc20Address
+	}
+	return ""
+}
+
+func (m *FailedConversion) GetSender() string {
+	if m != nil {
+		return m.Sender
+	}
+	return ""
+}
+
+func (m *FailedConversion) GetError() string {
+	if m != nil {
+		return m.Error
+	}
+	return ""
+}
+
+func (m *FailedConversion) GetHeight() int64 {
+	if m != nil {
+		return m.Height
+	}
+	return 0
+}
+
+// ProposalMetadata is used to parse a slice of denom metadata and generate
+// the RegisterCoinProposal content.
+type ProposalMetadata struct {
+	// metadata slice of the native Cosmos coins
+	Metadata []types.Metadata `protobuf:"bytes,1,rep,name=metadata,proto3" json:"metadata"`
+}
+
+func (m *ProposalMetadata) Reset()         { *m = ProposalMetadata{} }
+func (m *ProposalMetadata) String() string { return proto.CompactTextString(m) }
+func (*ProposalMetadata) ProtoMessage()    {}
+func (*ProposalMetadata) Descriptor() ([]byte, []int) {
+	return fileDescriptor_668d5dc537f45142, []int{16}
+}
+func (m *ProposalMetadata) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ProposalMetadata) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ProposalMetadata.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *ProposalMetadata) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ProposalMetadata.Merge(m, src)
+}
+func (m *ProposalMetadata) XXX_Size() int {
+	return m.Size()
+}
+func (m *ProposalMetadata) XXX_DiscardUnknown() {
+	xxx_messageInfo_ProposalMetadata.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ProposalMetadata proto.InternalMessageInfo
+
+func (m *ProposalMetadata) GetMetadata() []types.Metadata {
+	if m != nil {
+		return m.Metadata
+	}
+	return nil
+}
+
+func init() {
+	proto.RegisterEnum("evmos.erc20.v1.Owner", Owner_name, Owner_value)
+	proto.RegisterEnum("evmos.erc20.v1.NFTStandard", NFTStandard_name, NFTStandard_value)
+	proto.RegisterType((*TokenPair)(nil), "evmos.erc20.v1.TokenPair")
+	proto.RegisterType((*RegisterCoinProposal)(nil), "evmos.erc20.v1.RegisterCoinProposal")
+	proto.RegisterType((*RegisterERC20Proposal)(nil), "evmos.erc20.v1.RegisterERC20Proposal")
+	proto.RegisterType((*ToggleTokenConversionProposal)(nil), "evmos.erc20.v1.ToggleTokenConversionProposal")
+	proto.RegisterType((*SlashRegistrationDepositProposal)(nil), "evmos.erc20.v1.SlashRegistrationDepositProposal")
+	proto.RegisterType((*DeregisterTokenPairProposal)(nil), "evmos.erc20.v1.DeregisterTokenPairProposal")
+	proto.RegisterType((*TokenPairDeposit)(nil), "evmos.erc20.v1.TokenPairDeposit")
+	proto.RegisterType((*TokenPairRateLimit)(nil), "evmos.erc20.v1.TokenPairRateLimit")
+	proto.RegisterType((*SetTokenPairRateLimitProposal)(nil), "evmos.erc20.v1.SetTokenPairRateLimitProposal")
+	proto.RegisterType((*TokenPairStats)(nil), "evmos.erc20.v1.TokenPairStats")
+	proto.RegisterType((*ERC20Template)(nil), "evmos.erc20.v1.ERC20Template")
+	proto.RegisterType((*RegisterERC20TemplateProposal)(nil), "evmos.erc20.v1.RegisterERC20TemplateProposal")
+	proto.RegisterType((*RemoveERC20TemplateProposal)(nil), "evmos.erc20.v1.RemoveERC20TemplateProposal")
+	proto.RegisterType((*NFTPair)(nil), "evmos.erc20.v1.NFTPair")
+	proto.RegisterType((*RegisterNFTPairProposal)(nil), "evmos.erc20.v1.RegisterNFTPairProposal")
+	proto.RegisterType((*FailedConversion)(nil), "evmos.erc20.v1.FailedConversion")
+	proto.RegisterType((*ProposalMetadata)(nil), "evmos.erc20.v1.ProposalMetadata")
+}
+
+func init() { proto.RegisterFile("evmos/erc20/v1/erc20.proto", fileDescriptor_668d5dc537f45142) }
+
+var fileDescriptor_668d5dc537f45142 = []byte{
+	// 1255 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xc4, 0x57, 0xcf, 0x6e, 0x5b, 0xc5,
+	0x17, 0xf6, 0xb5, 0x1d, 0xc7, 0x39, 0x49, 0x5d, 0x77, 0x9a, 0xb6, 0xfe, 0x25, 0x8d, 0x1d, 0xf9,
+	0x27, 0x55, 0x69, 0x25, 0xec, 0x38, 0xa8, 0xaa, 0x84, 0x90, 0x50, 0xfc, 0x27, 0xc2, 0xa8, 0x75,
+	0xab, 0xb1, 0x03, 0x88, 0x8d, 0x35, 0xbe, 0x77, 0xb0, 0xaf, 0x6a, 0xdf, 0x71, 0xef, 0x4c, 0x4c,
+	0xba, 0x60, 0x07, 0x52, 0x17, 0x2c, 0x78, 0x02, 0x04, 0x62, 0xc7, 0x23, 0xf0, 0x00, 0xa8, 0x2b,
+	0xd4, 0x0d, 0x12, 0x62, 0x61, 0x90, 0x2b, 0x24, 0xde, 0x81, 0x0d, 0x9a, 0x3f, 0xf7, 0xd6, 0x76,
+	0x83, 0xe4, 0xa4, 0x4d, 0xd9, 0xd8, 0xf7, 0x9c, 0x39, 0x33, 0xdf, 0x7c, 0x67, 0xbe, 0x99, 0x39,
+	0x03, 0x1b, 0x74, 0x34, 0x60, 0xbc, 0x48, 0x7d, 0x7b, 0x6f, 0xb7, 0x38, 0x2a, 0xe9, 0x8f, 0xc2,
+	0xd0, 0x67, 0x82, 0xa1, 0x94, 0x6a, 0x2b, 0x68, 0xd7, 0xa8, 0xb4, 0x91, 0xb5, 0x19, 0x97, 0xc1,
+	0x1d, 0xe2, 0x3d, 0x2c, 0x8e, 0x4a, 0x1d, 0x2a, 0x48, 0x49, 0x19, 0x3a, 0x7e, 0xaa, 0x9d, 0xd3,
+	0xb0, 0xdd, 0x66, 0xae, 0x67, 0xda, 0xd7, 0xbb, 0xac, 0xcb, 0xd4, 0x67, 0x51, 0x7e, 0x69, 0x6f,
+	0xfe, 0x17, 0x0b, 0x56, 0x5a, 0xec, 0x21, 0xf5, 0x1e, 0x10, 0xd7, 0x47, 0xff, 0x87, 0x0b, 0x0a,
+	0xaf, 0x4d, 0x1c, 0xc7, 0xa7, 0x9c, 0x67, 0xac, 0x6d, 0x6b, 0x67, 0x05, 0xaf, 0x29, 0xe7, 0xbe,
+	0xf6, 0xa1, 0x75, 0x58, 0x72, 0xa8, 0xc7, 0x06, 0x99, 0xa8, 0x6a, 0xd4, 0x06, 0xca, 0xc0, 0x32,
+	0xf5, 0x48, 0xa7, 0x4f, 0x9d, 0x4c, 0x6c, 0xdb, 0xda, 0x49, 0xe2, 0xc0, 0x44, 0xef, 0x42, 0xca,
+	0x66, 0x9e, 0xf0, 0x89, 0x2d, 0xda, 0xec, 0x33, 0x8f, 0xfa, 0x99, 0xf8, 0xb6, 0xb5, 0x93, 0xda,
+	0xbb, 0x52, 0x98, 0x65, 0x58, 0xb8, 0x2f, 0x1b, 0xf1, 0x85, 0x20, 0x58, 0x99, 0xa8, 0x08, 0xab,
+	0x82, 0x0e, 0x86, 0x7d, 0x22, 0x68, 0xdb, 0x75, 0x32, 0x4b, 0x12, 0xb3, 0x9c, 0x9a, 0x8c, 0x73,
+	0xd0, 0x32, 0xee, 0x7a, 0x15, 0x43, 0x10, 0x52, 0x77, 0xde, 0x89, 0xff, 0xf5, 0x6d, 0xce, 0xca,
+	0xff, 0x64, 0xc1, 0x3a, 0xa6, 0x5d, 0x97, 0x0b, 0xea, 0x57, 0x98, 0xeb, 0x3d, 0xf0, 0xd9, 0x90,
+	0x71, 0xd2, 0x97, 0xb3, 0x17, 0xae, 0xe8, 0x53, 0x43, 0x4d, 0x1b, 0x68, 0x1b, 0x56, 0x1d, 0xca,
+	0x6d, 0xdf, 0x1d, 0x0a, 0x97, 0x79, 0x86, 0xd9, 0xb4, 0x0b, 0xbd, 0x07, 0xc9, 0x01, 0x15, 0xc4,
+	0x21, 0x82, 0x64, 0x62, 0xdb, 0xb1, 0x9d, 0xd5, 0xbd, 0xad, 0x82, 0xce, 0x78, 0x41, 0x2d, 0x82,
+	0xc9, 0x78, 0xe1, 0x9e, 0x09, 0x2a, 0xc7, 0x9f, 0x8e, 0x73, 0x11, 0x1c, 0x76, 0x9a, 0x27, 0x12,
+	0x5f, 0x88, 0x48, 0x24, 0xff, 0x39, 0x5c, 0x09, 0x78, 0xd4, 0x70, 0x65, 0x6f, 0xf7, 0x95, 0x89,
+	0xdc, 0x80, 0x94, 0xca, 0xb8, 0x59, 0x62, 0xca, 0x15, 0x9d, 0x15, 0x3c, 0xe7, 0x35, 0xf0, 0x1c,
+	0xb6, 0x5a, 0xac, 0xdb, 0xed, 0x53, 0x25, 0x92, 0x0a, 0xf3, 0x46, 0xd4, 0xe7, 0x2e, 0x7b, 0xf5,
+	0x7c, 0xca, 0x7e, 0x72, 0xc8, 0x4c, 0xcc, 0xf4, 0x93, 0x86, 0x59, 0xbc, 0x27, 0x16, 0x6c, 0x37,
+	0xfb, 0x84, 0xf7, 0x34, 0x73, 0x9f, 0xc8, 0x1e, 0x55, 0x3a, 0x64, 0xdc, 0x15, 0x6f, 0x98, 0xff,
+	0x23, 0xd8, 0xac, 0x52, 0xdf, 0x2c, 0x40, 0xb8, 0x51, 0xce, 0x95, 0xfd, 0x8f, 0x16, 0xa4, 0x43,
+	0x24, 0xc3, 0x7a, 0xb1, 0x9d, 0x79, 0x1d, 0x56, 0x1c, 0x1d, 0xcf, 0x7c, 0x83, 0xfa, 0xc2, 0x81,
+	0x6c, 0x48, 0x90, 0x01, 0x3b, 0xf2, 0x84, 0xd1, 0xef, 0xff, 0x5e, 0xe8, 0x97, 0xd3, 0x50, 0xbf,
+	0x72, 0xb3, 0x94, 0x77, 0xa5, 0x76, 0x7f, 0xf8, 0x3d, 0xb7, 0xd3, 0x75, 0x45, 0xef, 0xa8, 0x53,
+	0xb0, 0xd9, 0xa0, 0x68, 0x8e, 0x17, 0xfd, 0xf7, 0x16, 0x77, 0x1e, 0x16, 0xc5, 0xe3, 0x21, 0xe5,
+	0xaa, 0x03, 0xc7, 0x66, 0xe8, 0xfc, 0xcf, 0x71, 0x40, 0xe1, 0xe4, 0x31, 0x11, 0xf4, 0xae, 0x3b,
+	0x58, 0x74, 0xfa, 0x37, 0x21, 0x4d, 0x87, 0xcc, 0xee, 0xb5, 0x5d, 0x87, 0x7a, 0xc2, 0xfd, 0xd4,
+	0xa5, 0x01, 0x8b, 0x8b, 0xca, 0x5f, 0x0f, 0xdd, 0xe8, 0x11, 0x5c, 0x96, 0x47, 0x5b, 0x5b, 0xb0,
+	0xb6, 0x1e, 0xb7, 0x2f, 0x61, 0x74, 0x36, 0xcb, 0x65, 0x39, 0xfb, 0xdf, 0xc6, 0xb9, 0x1b, 0x0b,
+	0xcc, 0xbe, 0xee, 0x89, 0xc9, 0x38, 0x97, 0x96, 0x24, 0x5a, 0x4c, 0x6d, 0x30, 0x35, 0x61, 0x9c,
+	0xb6, 0xb5, 0xc7, 0xb7, 0x8d, 0x47, 0x42, 0x6a, 0x28, 0xc1, 0xda, 0x0a, 0x5b, 0x43, 0xc6, 0xcf,
+	0x0a, 0xa9, 0xc0, 0x5a, 0x4c, 0x22, 0x1b, 0x48, 0x35, 0xfc, 0x94, 0x07, 0x09, 0x58, 0x9f, 0x65,
+	0x39, 0x62, 0xfd, 0xa3, 0x01, 0x35, 0x87, 0x60, 0xe5, 0xd4, 0x98, 0x97, 0xa6, 0x68, 0x7e, 0xa8,
+	0x86, 0xc2, 0x97, 0xa6, 0x78, 0x6a, 0x97, 0x44, 0x9d, 0x25, 0x6a, 0x50, 0x13, 0x67, 0x45, 0x9d,
+	0x62, 0x1a, 0xa0, 0x4e, 0x51, 0x35, 0xa8, 0x57, 0x21, 0x31, 0x24, 0x47, 0x9c, 0x3a, 0x99, 0x65,
+	0x75, 0x7d, 0x18, 0x2b, 0xff, 0x45, 0x0c, 0xb6, 0x9a, 0x54, 0xbc, 0xac, 0xa9, 0xf3, 0xd9, 0x83,
+	0x27, 0x8a, 0x30, 0x7e, 0x2a, 0x11, 0x2e, 0xbd, 0x79, 0x11, 0x26, 0xce, 0x4f, 0x84, 0xe6, 0x1c,
+	0xfc, 0x2e, 0x0a, 0xa9, 0x70, 0x0d, 0x9a, 0x82, 0x08, 0xbe, 0xd8, 0x9e, 0xfe, 0x37, 0x09, 0x47,
+	0xff, 0x13, 0x09, 0xc7, 0xce, 0x53, 0xc2, 0x79, 0x0a, 0x17, 0x74, 0x9c, 0xb9, 0xc3, 0xd1, 0x55,
+	0x88, 0xba, 0x8e, 0x4e, 0x4b, 0x39, 0x31, 0x19, 0xe7, 0xa2, 0xf5, 0x2a, 0x8e, 0xba, 0xce, 0x02,
+	0xda, 0xdc, 0x80, 0x64, 0xe7, 0xb1, 0xa0, 0x36, 0x73, 0xf4, 0xa4, 0xd7, 0x70, 0x68, 0xe7, 0xbf,
+	0xb1, 0x60, 0x6b, 0xa6, 0x24, 0x08, 0xf0, 0x5e, 0x47, 0x8d, 0x13, 0xd4, 0x1f, 0x0a, 0x55, 0xd6,
+	0x38, 0x73, 0x35, 0xda, 0x0c, 0x60, 0x50, 0xe3, 0x04, 0x9d, 0x8c, 0x56, 0xbe, 0xb2, 0x60, 0x13,
+	0xd3, 0x01, 0x1b, 0xd1, 0xd7, 0x3b, 0xbd, 0xb9, 0x0a, 0x2a, 0xb6, 0x60, 0x05, 0xf5, 0xa7, 0x05,
+	0xcb, 0x8d, 0x83, 0x96, 0x2a, 0x70, 0x6f, 0x42, 0x3a, 0xac, 0x45, 0x67, 0x65, 0x7b, 0x31, 0xf0,
+	0x07, 0xca, 0xbd, 0x01, 0x49, 0xbb, 0x4f, 0x38, 0x97, 0x50, 0x5a, 0xad, 0xab, 0x93, 0x71, 0x6e,
+	0xb9, 0x22, 0x7d, 0xf5, 0x2a, 0x5e, 0x56, 0x8d, 0x75, 0x07, 0xdd, 0x81, 0x24, 0x17, 0xc4, 0x73,
+	0x88, 0xaf, 0xa7, 0x94, 0xda, 0xdb, 0x9c, 0x4f, 0x5a, 0xe3, 0xa0, 0xd5, 0x34, 0x21, 0x38, 0x0c,
+	0x46, 0x37, 0x61, 0x45, 0x03, 0x1c, 0xf9, 0xae, 0xb9, 0x46, 0xd6, 0x26, 0xe3, 0x5c, 0x52, 0x21,
+	0x1c, 0xe2, 0x3a, 0xd6, 0xf8, 0x87, 0xbe, 0x8b, 0xb6, 0x00, 0x74, 0xa8, 0x2a, 0x3f, 0x97, 0xf4,
+	0xcd, 0xae, 0x3c, 0x55, 0x22, 0x88, 0xa9, 0x1b, 0xbe, 0x8c, 0xc2, 0xb5, 0x40, 0x17, 0x86, 0xef,
+	0x2b, 0xa7, 0xfc, 0xa4, 0x7c, 0xc5, 0x4e, 0xce, 0xd7, 0x74, 0x1e, 0xe2, 0x67, 0xce, 0xc3, 0xd2,
+	0x29, 0xf2, 0x90, 0x38, 0x29, 0x0f, 0x91, 0xfc, 0xdf, 0x16, 0xa4, 0x0f, 0x88, 0xdb, 0xa7, 0xce,
+	0x8b, 0x72, 0x15, 0x5d, 0x83, 0x65, 0x71, 0xdc, 0xee, 0x11, 0xde, 0x33, 0x29, 0x48, 0x88, 0xe3,
+	0xf7, 0x09, 0xef, 0xa1, 0x4d, 0x58, 0xe9, 0xb3, 0x6e, 0xdb, 0xf5, 0x1c, 0x7a, 0xac, 0x32, 0x10,
+	0xc7, 0xc9, 0x3e, 0xeb, 0xd6, 0xa5, 0xfd, 0xf2, 0x11, 0x17, 0x3b, 0xe1, 0x88, 0xbb, 0x0a, 0x09,
+	0x4e, 0x3d, 0x27, 0xbc, 0x27, 0x8c, 0x85, 0x0e, 0xc2, 0x7a, 0x4b, 0x93, 0x2a, 0x9c, 0xee, 0xd8,
+	0x09, 0x4a, 0x2a, 0xb9, 0x76, 0xd4, 0xf7, 0x99, 0x6f, 0xf8, 0x6a, 0x43, 0xa2, 0xf6, 0xa8, 0xdb,
+	0xed, 0x09, 0x75, 0x5f, 0xc6, 0xb0, 0xb1, 0xf2, 0x4d, 0x48, 0x07, 0xab, 0x1e, 0x3c, 0x45, 0x66,
+	0xde, 0x2e, 0xd6, 0x19, 0xde, 0x2e, 0xb7, 0x3e, 0x80, 0x25, 0xfd, 0x1a, 0xbb, 0x02, 0x97, 0xee,
+	0x7f, 0xd4, 0xa8, 0xe1, 0xf6, 0x61, 0xa3, 0xf9, 0xa0, 0x56, 0xa9, 0x1f, 0xd4, 0x6b, 0xd5, 0x74,
+	0x04, 0xa5, 0x61, 0x4d, 0xbb, 0xef, 0xdd, 0xaf, 0x1e, 0xde, 0xad, 0xa5, 0x2d, 0x84, 0x20, 0xa5,
+	0x3d, 0xb5, 0x8f, 0x5b, 0x35, 0xdc, 0xd8, 0xbf, 0x9b, 0x8e, 0x6e, 0xc4, 0x9f, 0x7c, 0x9f, 0x8d,
+	0xdc, 0x72, 0x60, 0x75, 0x4a, 0x07, 0xe8, 0x3a, 0x64, 0x1a, 0x07, 0xad, 0x76, 0xb3, 0xb5, 0xdf,
+	0xa8, 0xee, 0xe3, 0xea, 0xdc, 0xc0, 0xd7, 0xe0, 0xf2, 0x4c, 0x6b, 0x0d, 0x57, 0xee, 0xec, 0x95,
+	0xd2, 0x16, 0xca, 0xc0, 0xfa, 0x7c, 0x43, 0xa9, 0x74, 0xfb, 0x76, 0x80, 0x52, 0x2e, 0x3f, 0x9d,
+	0x64, 0xad, 0x67, 0x93, 0xac, 0xf5, 0xc7, 0x24, 0x6b, 0x7d, 0xfd, 0x3c, 0x1b, 0x79, 0xf6, 0x3c,
+	0x1b, 0xf9, 0xf5, 0x79, 0x36, 0xf2, 0xc9, 0x74, 0x4d, 0x6b, 0x9e, 0xdf, 0xea, 0x77, 0x54, 0xda,
+	0x2d, 0x1e, 0x9b, 0xa7, 0xb8, 0x5a, 0x84, 0x4e, 0x42, 0x3d, 0x91, 0xdf, 0xfe, 0x67, 0x00, 0x22,
+	0x79, 0xeb, 0xfa, 0xa6, 0x0f, 0x00, 0x00,
+}
+
+func (this *TokenPair) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*TokenPair)
+	if !ok {
+		that2, ok := that.(TokenPair)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.Erc20Address != that1.Erc20Address {
+		return false
+	}
+	if this.Denom != that1.Denom {
+		return false
+	}
+	if this.Enabled != that1.Enabled {
+		return false
+	}
+	if this.ContractOwner != that1.ContractOwner {
+		return false
+	}
+	if this.TemplateID != that1.TemplateID {
+		return false
+	}
+	return true
+}
+func (this *ToggleTokenConversionProposal) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*ToggleTokenConversionProposal)
+	if !ok {
+		that2, ok := that.(ToggleTokenConversionProposal)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.Title != that1.Title {
+		return false
+	}
+	if this.Description != that1.Description {
+		return false
+	}
+	if this.Token != that1.Token {
+		return false
+	}
+	return true
+}
+func (this *DeregisterTokenPairProposal) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*DeregisterTokenPairProposal)
+	if !ok {
+		that2, ok := that.(DeregisterTokenPairProposal)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.Title != that1.Title {
+		return false
+	}
+	if this.Description != that1.Description {
+		return false
+	}
+	if this.Token != that1.Token {
+		return false
+	}
+	return true
+}
+func (this *NFTPair) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*NFTPair)
+	if !ok {
+		that2, ok := that.(NFTPair)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.ContractAddress != that1.ContractAddress {
+		return false
+	}
+	if this.ClassID != that1.ClassID {
+		return false
+	}
+	if this.Standard != that1.Standard {
+		return false
+	}
+	if this.ClassURI != that1.ClassURI {
+		return false
+	}
+	if this.ClassData != that1.ClassData {
+		return false
+	}
+	return true
+}
+func (m *TokenPair) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *TokenPair) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *TokenPair) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.TemplateID) > 0 {
+		i -= len(m.TemplateID)
+		copy(dAtA[i:], m.TemplateID)
+		i = encodeVarintErc20(dAtA, i, uint64(len(m.TemplateID)))
+		i--
+		dAtA[i] = 0x2a
+	}
+	if m.ContractOwner != 0 {
+		i = encodeVarintErc20(dAtA, i, uint64(m.ContractOwner))
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.Enabled {
+		i--
+		if m.Enabled {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.Denom) > 0 {
+		i -= len(m.Denom)
+		copy(dAtA[i:], m.Denom)
+		i = encodeVarintErc20(dAtA, i, uint64(len(m.Denom)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Erc20Address) > 0 {
+		i -= len(m.Erc20Address)
+		copy(dAtA[i:], m.Erc20Address)
+		i = encodeVarintErc20(dAtA, i, uint64(len(m.Erc20Address)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *RegisterCoinProposal) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *RegisterCoinProposal) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *RegisterCoinProposal) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.TemplateID) > 0 {
+		i -= len(m.TemplateID)
+		copy(dAtA[i:], m.TemplateID)
+		i = encodeVarintErc20(dAtA, i, uint64(len(m.TemplateID)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.Metadata) > 0 {
+		for iNdEx := len(m.Metadata) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Metadata[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintErc20(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	if len(m.Description) > 0 {
+		i -= len(m.Description)
+		copy(dAtA[i:], m.Description)
+		i = encodeVarintErc20(dAtA, i, uint64(len(m.Description)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Title) > 0 {
+		i -= len(m.Title)
+		copy(dAtA[i:], m.Title)
+		i = encodeVarintErc20(dAtA, i, uint64(len(m.Title)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *RegisterERC20Proposal) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *RegisterERC20Proposal) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *RegisterERC20Proposal) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Erc20Addresses) > 0 {
+		for iNdEx := len(m.Erc20Addresses) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.Erc20Addresses[iNdEx])
+			copy(dAtA[i:], m.Erc20Addresses[iNdEx])
+			i = encodeVarintErc20(dAtA, i, uint64(len(m.Erc20Addresses[iNdEx])))
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	if len(m.Description) > 0 {
+		i -= len(m.Description)
+		copy(dAtA[i:], m.Description)
+		i = encodeVarintErc20(dAtA, i, uint64(len(m.Description)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Title) > 0 {
+		i -= len(m.Title)
+		copy(dAtA[i:], m.Title)
+		i = encodeVarintErc20(dAtA, i, uint64(len(m.Title)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *ToggleTokenConversionProposal) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ToggleTokenConversionProposal) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ToggleTokenConversionProposal) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Token) > 0 {
+		i -= len(m.Token)
+		copy(dAtA[i:], m.Token)
+		i = encodeVarintErc20(dAtA, i, uint64(len(m.Token)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Description) > 0 {
+		i -= len(m.Description)
+		copy(dAtA[i:], m.Description)
+		i = encodeVarintErc20(dAtA, i, uint64(len(m.Description)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Title) > 0 {
+		i -= len(m.Title)
+		copy(dAtA[i:], m.Title)
+		i = encodeVarintErc20(dAtA, i, uint64(len(m.Title)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *SlashRegistrationDepositProposal) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *SlashRegistrationDepositProposal) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *SlashRegistrationDepositProposal) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Erc20Addresses) > 0 {
+		for iNdEx := len(m.Erc20Addresses) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.Erc20Addresses[iNdEx])
+			copy(dAtA[i:], m.Erc20Addresses[iNdEx])
+			i = encodeVarintErc20(dAtA, i, uint64(len(m.Erc20Addresses[iNdEx])))
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	if len(m.Description) > 0 {
+		i -= len(m.Description)
+		copy(dAtA[i:], m.Description)
+		i = encodeVarintErc20(dAtA, i, uint64(len(m.Description)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Title) > 0 {
+		i -= len(m.Title)
+		copy(dAtA[i:], m.Title)
+		i = encodeVarintErc20(dAtA, i, uint64(len(m.Title)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *DeregisterTokenPairProposal) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *DeregisterTokenPairProposal) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *DeregisterTokenPairProposal) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Token) > 0 {
+		i -= len(m.Token)
+		copy(dAtA[i:], m.Token)
+		i = encodeVarintErc20(dAtA, i, uint64(len(m.Token)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Description) > 0 {
+		i -= len(m.Description)
+		copy(dAtA[i:], m.Description)
+		i = encodeVarintErc20(dAtA, i, uint64(len(m.Description)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Title) > 0 {
+		i -= len(m.Title)
+		copy(dAtA[i:], m.Title)
+		i = encodeVarintErc20(dAtA, i, uint64(len(m.Title)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *TokenPairDeposit) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *TokenPairDeposit) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *TokenPairDeposit) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Amount) > 0 {
+		for iNdEx := len(m.Amount) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Amount[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintErc20(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	if len(m.Depositor) > 0 {
+		i -= len(m.Depositor)
+		copy(dAtA[i:], m.Depositor)
+		i = encodeVarintErc20(dAtA, i, uint64(len(m.Depositor)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Erc20Address) > 0 {
+		i -= len(m.Erc20Address)
+		copy(dAtA[i:], m.Erc20Address)
+		i = encodeVarintErc20(dAtA, i, uint64(len(m.Erc20Address)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *TokenPairRateLimit) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *TokenPairRateLimit) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *TokenPairRateLimit) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Paused {
+		i--
+		if m.Paused {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x38
+	}
+	{
+		size := m.ERC20ToCoinVolume.Size()
+		i -= size
+		if _, err := m.ERC20ToCoinVolume.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintErc20(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x32
+	{
+		size := m.CoinToERC20Volume.Size()
+		i -= size
+		if _, err := m.CoinToERC20Volume.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintErc20(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x2a
+	{
+		size := m.ERC20ToCoinLimit.Size()
+		i -= size
+		if _, err := m.ERC20ToCoinLimit.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintErc20(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x22
+	{
+		size := m.CoinToERC20Limit.Size()
+		i -= size
+		if _, err := m.CoinToERC20Limit.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintErc20(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	if len(m.EpochIdentifier) > 0 {
+		i -= len(m.EpochIdentifier)
+		copy(dAtA[i:], m.EpochIdentifier)
+		i = encodeVarintErc20(dAtA, i, uint64(len(m.EpochIdentifier)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Erc20Address) > 0 {
+		i -= len(m.Erc20Address)
+		copy(dAtA[i:], m.Erc20Address)
+		i = encodeVarintErc20(dAtA, i, uint64(len(m.Erc20Address)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *SetTokenPairRateLimitProposal) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *SetTokenPairRateLimitProposal) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *SetTokenPairRateLimitProposal) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size := m.ERC20ToCoinLimit.Size()
+		i -= size
+		if _, err := m.ERC20ToCoinLimit.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintErc20(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x32
+	{
+		size := m.CoinToERC20Limit.Size()
+		i -= size
+		if _, err := m.CoinToERC20Limit.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintErc20(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x2a
+	if len(m.EpochIdentifier) > 0 {
+		i -= len(m.EpochIdentifier)
+		copy(dAtA[i:], m.EpochIdentifier)
+		i = encodeVarintErc20(dAtA, i, uint64(len(m.EpochIdentifier)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.Token) > 0 {
+		i -= len(m.Token)
+		copy(dAtA[i:], m.Token)
+		i = encodeVarintErc20(dAtA, i, uint64(len(m.Token)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Description) > 0 {
+		i -= len(m.Description)
+		copy(dAtA[i:], m.Description)
+		i = encodeVarintErc20(dAtA, i, uint64(len(m.Description)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Title) > 0 {
+		i -= len(m.Title)
+		copy(dAtA[i:], m.Title)
+		i = encodeVarintErc20(dAtA, i, uint64(len(m.Title)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *TokenPairStats) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *TokenPairStats) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *TokenPairStats) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size := m.ERC20ToCoinVolume.Size()
+		i -= size
+		if _, err := m.ERC20ToCoinVolume.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintErc20(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	{
+		size := m.CoinToERC20Volume.Size()
+		i -= size
+		if _, err := m.CoinToERC20Volume.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintErc20(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if len(m.Erc20Address) > 0 {
+		i -= len(m.Erc20Address)
+		copy(dAtA[i:], m.Erc20Address)
+		i = encodeVarintErc20(dAtA, i, uint64(len(m.Erc20Address)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *ERC20Template) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ERC20Template) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ERC20Template) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Bytecode) > 0 {
+		i -= len(m.Bytecode)
+		copy(dAtA[i:], m.Bytecode)
+		i = encodeVarintErc20(dAtA, i, uint64(len(m.Bytecode)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Description) > 0 {
+		i -= len(m.Description)
+		copy(dAtA[i:], m.Description)
+		i = encodeVarintErc20(dAtA, i, uint64(len(m.Description)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.ID) > 0 {
+		i -= len(m.ID)
+		copy(dAtA[i:], m.ID)
+		i = encodeVarintErc20(dAtA, i, uint64(len(m.ID)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *RegisterERC20TemplateProposal) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *RegisterERC20TemplateProposal) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *RegisterERC20TemplateProposal) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.Template.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintErc20(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	if len(m.Description) > 0 {
+		i -= len(m.Description)
+		copy(dAtA[i:], m.Description)
+		i = encodeVarintErc20(dAtA, i, uint64(len(m.Description)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Title) > 0 {
+		i -= len(m.Title)
+		copy(dAtA[i:], m.Title)
+		i = encodeVarintErc20(dAtA, i, uint64(len(m.Title)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *RemoveERC20TemplateProposal) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *RemoveERC20TemplateProposal) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *RemoveERC20TemplateProposal) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.TemplateID) > 0 {
+		i -= len(m.TemplateID)
+		copy(dAtA[i:], m.TemplateID)
+		i = encodeVarintErc20(dAtA, i, uint64(len(m.TemplateID)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Description) > 0 {
+		i -= len(m.Description)
+		copy(dAtA[i:], m.Description)
+		i = encodeVarintErc20(dAtA, i, uint64(len(m.Description)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Title) > 0 {
+		i -= len(m.Title)
+		copy(dAtA[i:], m.Title)
+		i = encodeVarintErc20(dAtA, i, uint64(len(m.Title)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *NFTPair) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *NFTPair) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *NFTPair) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.ClassData) > 0 {
+		i -= len(m.ClassData)
+		copy(dAtA[i:], m.ClassData)
+		i = encodeVarintErc20(dAtA, i, uint64(len(m.ClassData)))
+		i--
+		dAtA[i] = 0x2a
+	}
+	if len(m.ClassURI) > 0 {
+		i -= len(m.ClassURI)
+		copy(dAtA[i:], m.ClassURI)
+		i = encodeVarintErc20(dAtA, i, uint64(len(m.ClassURI)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if m.Standard != 0 {
+		i = encodeVarintErc20(dAtA, i, uint64(m.Standard))
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.ClassID) > 0 {
+		i -= len(m.ClassID)
+		copy(dAtA[i:], m.ClassID)
+		i = encodeVarintErc20(dAtA, i, uint64(len(m.ClassID)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.ContractAddress) > 0 {
+		i -= len(m.ContractAddress)
+		copy(dAtA[i:], m.ContractAddress)
+		i = encodeVarintErc20(dAtA, i, uint64(len(m.ContractAddress)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *RegisterNFTPairProposal) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *RegisterNFTPairProposal) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *RegisterNFTPairProposal) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.ClassData) > 0 {
+		i -= len(m.ClassData)
+		copy(dAtA[i:], m.ClassData)
+		i = encodeVarintErc20(dAtA, i, uint64(len(m.ClassData)))
+		i--
+		dAtA[i] = 0x32
+	}
+	if len(m.ClassURI) > 0 {
+		i -= len(m.ClassURI)
+		copy(dAtA[i:], m.ClassURI)
+		i = encodeVarintErc20(dAtA, i, uint64(len(m.ClassURI)))
+		i--
+		dAtA[i] = 0x2a
+	}
+	if m.Standard != 0 {
+		i = encodeVarintErc20(dAtA, i, uint64(m.Standard))
+		i--
+		dAtA[i] = 0x20
+	}
+	if len(m.ContractAddress) > 0 {
+		i -= len(m.ContractAddress)
+		copy(dAtA[i:], m.ContractAddress)
+		i = encodeVarintErc20(dAtA, i, uint64(len(m.ContractAddress)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Description) > 0 {
+		i -= len(m.Description)
+		copy(dAtA[i:], m.Description)
+		i = encodeVarintErc20(dAtA, i, uint64(len(m.Description)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Title) > 0 {
+		i -= len(m.Title)
+		copy(dAtA[i:], m.Title)
+		i = encodeVarintErc20(dAtA, i, uint64(len(m.Title)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *FailedConversion) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *FailedConversion) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *FailedConversion) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Height != 0 {
+		i = encodeVarintErc20(dAtA, i, uint64(m.Height))
+		i--
+		dAtA[i] = 0x38
+	}
+	if len(m.Error) > 0 {
+		i -= len(m.Error)
+		copy(dAtA[i:], m.Error)
+		i = encodeVarintErc20(dAtA, i, uint64(len(m.Error)))
+		i--
+		dAtA[i] = 0x32
+	}
+	{
+		size := m.Amount.Size()
+		i -= size
+		if _, err := m.Amount.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintErc20(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x2a
+	if len(m.Sender) > 0 {
+		i -= len(m.Sender)
+		copy(dAtA[i:], m.Sender)
+		i = encodeVarintErc20(dAtA, i, uint64(len(m.Sender)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.Erc20Address) > 0 {
+		i -= len(m.Erc20Address)
+		copy(dAtA[i:], m.Erc20Address)
+		i = encodeVarintErc20(dAtA, i, uint64(len(m.Erc20Address)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if m.LogIndex != 0 {
+		i = encodeVarintErc20(dAtA, i, uint64(m.LogIndex))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.TxHash) > 0 {
+		i -= len(m.TxHash)
+		copy(dAtA[i:], m.TxHash)
+		i = encodeVarintErc20(dAtA, i, uint64(len(m.TxHash)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *ProposalMetadata) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ProposalMetadata) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ProposalMetadata) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Metadata) > 0 {
+		for iNdEx := len(m.Metadata) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Metadata[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintErc20(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintErc20(dAtA []byte, offset int, v uint64) int {
+	offset -= sovErc20(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *TokenPair) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Erc20Address)
+	if l > 0 {
+		n += 1 + l + sovErc20(uint64(l))
+	}
+	l = len(m.Denom)
+	if l > 0 {
+		n += 1 + l + sovErc20(uint64(l))
+	}
+	if m.Enabled {
+		n += 2
+	}
+	if m.ContractOwner != 0 {
+		n += 1 + sovErc20(uint64(m.ContractOwner))
+	}
+	l = len(m.TemplateID)
+	if l > 0 {
+		n += 1 + l + sovErc20(uint64(l))
+	}
+	return n
+}
+
+func (m *RegisterCoinProposal) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Title)
+	if l > 0 {
+		n += 1 + l + sovErc20(uint64(l))
+	}
+	l = len(m.Description)
+	if l > 0 {
+		n += 1 + l + sovErc20(uint64(l))
+	}
+	if len(m.Metadata) > 0 {
+		for _, e := range m.Metadata {
+			l = e.Size()
+			n += 1 + l + sovErc20(uint64(l))
+		}
+	}
+	l = len(m.TemplateID)
+	if l > 0 {
+		n += 1 + l + sovErc20(uint64(l))
+	}
+	return n
+}
+
+func (m *RegisterERC20Proposal) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Title)
+	if l > 0 {
+		n += 1 + l + sovErc20(uint64(l))
+	}
+	l = len(m.Description)
+	if l > 0 {
+		n += 1 + l + sovErc20(uint64(l))
+	}
+	if len(m.Erc20Addresses) > 0 {
+		for _, s := range m.Erc20Addresses {
+			l = len(s)
+			n += 1 + l + sovErc20(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *ToggleTokenConversionProposal) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Title)
+	if l > 0 {
+		n += 1 + l + sovErc20(uint64(l))
+	}
+	l = len(m.Description)
+	if l > 0 {
+		n += 1 + l + sovErc20(uint64(l))
+	}
+	l = len(m.Token)
+	if l > 0 {
+		n += 1 + l + sovErc20(uint64(l))
+	}
+	return n
+}
+
+func (m *SlashRegistrationDepositProposal) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Title)
+	if l > 0 {
+		n += 1 + l + sovErc20(uint64(l))
+	}
+	l = len(m.Description)
+	if l > 0 {
+		n += 1 + l + sovErc20(uint64(l))
+	}
+	if len(m.Erc20Addresses) > 0 {
+		for _, s := range m.Erc20Addresses {
+			l = len(s)
+			n += 1 + l + sovErc20(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *DeregisterTokenPairProposal) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Title)
+	if l > 0 {
+		n += 1 + l + sovErc20(uint64(l))
+	}
+	l = len(m.Description)
+	if l > 0 {
+		n += 1 + l + sovErc20(uint64(l))
+	}
+	l = len(m.Token)
+	if l > 0 {
+		n += 1 + l + sovErc20(uint64(l))
+	}
+	return n
+}
+
+func (m *TokenPairDeposit) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Erc20Address)
+	if l > 0 {
+		n += 1 + l + sovErc20(uint64(l))
+	}
+	l = len(m.Depositor)
+	if l > 0 {
+		n += 1 + l + sovErc20(uint64(l))
+	}
+	if len(m.Amount) > 0 {
+		for _, e := range m.Amount {
+			l = e.Size()
+			n += 1 + l + sovErc20(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *TokenPairRateLimit) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Erc20Address)
+	if l > 0 {
+		n += 1 + l + sovErc20(uint64(l))
+	}
+	l = len(m.EpochIdentifier)
+	if l > 0 {
+		n += 1 + l + sovErc20(uint64(l))
+	}
+	l = m.CoinToERC20Limit.Size()
+	n += 1 + l + sovErc20(uint64(l))
+	l = m.ERC20ToCoinLimit.Size()
+	n += 1 + l + sovErc20(uint64(l))
+	l = m.CoinToERC20Volume.Size()
+	n += 1 + l + sovErc20(uint64(l))
+	l = m.ERC20ToCoinVolume.Size()
+	n += 1 + l + sovErc20(uint64(l))
+	if m.Paused {
+		n += 2
+	}
+	return n
+}
+
+func (m *SetTokenPairRateLimitProposal) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Title)
+	if l > 0 {
+		n += 1 + l + sovErc20(uint64(l))
+	}
+	l = len(m.Description)
+	if l > 0 {
+		n += 1 + l + sovErc20(uint64(l))
+	}
+	l = len(m.Token)
+	if l > 0 {
+		n += 1 + l + sovErc20(uint64(l))
+	}
+	l = len(m.EpochIdentifier)
+	if l > 0 {
+		n += 1 + l + sovErc20(uint64(l))
+	}
+	l = m.CoinToERC20Limit.Size()
+	n += 1 + l + sovErc20(uint64(l))
+	l = m.ERC20ToCoinLimit.Size()
+	n += 1 + l + sovErc20(uint64(l))
+	return n
+}
+
+func (m *TokenPairStats) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Erc20Address)
+	if l > 0 {
+		n += 1 + l + sovErc20(uint64(l))
+	}
+	l = m.CoinToERC20Volume.Size()
+	n += 1 + l + sovErc20(uint64(l))
+	l = m.ERC20ToCoinVolume.Size()
+	n += 1 + l + sovErc20(uint64(l))
+	return n
+}
+
+func (m *ERC20Template) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ID)
+	if l > 0 {
+		n += 1 + l + sovErc20(uint64(l))
+	}
+	l = len(m.Description)
+	if l > 0 {
+		n += 1 + l + sovErc20(uint64(l))
+	}
+	l = len(m.Bytecode)
+	if l > 0 {
+		n += 1 + l + sovErc20(uint64(l))
+	}
+	return n
+}
+
+func (m *RegisterERC20TemplateProposal) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Title)
+	if l > 0 {
+		n += 1 + l + sovErc20(uint64(l))
+	}
+	l = len(m.Description)
+	if l > 0 {
+		n += 1 + l + sovErc20(uint64(l))
+	}
+	l = m.Template.Size()
+	n += 1 + l + sovErc20(uint64(l))
+	return n
+}
+
+func (m *RemoveERC20TemplateProposal) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Title)
+	if l > 0 {
+		n += 1 + l + sovErc20(uint64(l))
+	}
+	l = len(m.Description)
+	if l > 0 {
+		n += 1 + l + sovErc20(uint64(l))
+	}
+	l = len(m.TemplateID)
+	if l > 0 {
+		n += 1 + l + sovErc20(uint64(l))
+	}
+	return n
+}
+
+func (m *NFTPair) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ContractAddress)
+	if l > 0 {
+		n += 1 + l + sovErc20(uint64(l))
+	}
+	l = len(m.ClassID)
+	if l > 0 {
+		n += 1 + l + sovErc20(uint64(l))
+	}
+	if m.Standard != 0 {
+		n += 1 + sovErc20(uint64(m.Standard))
+	}
+	l = len(m.ClassURI)
+	if l > 0 {
+		n += 1 + l + sovErc20(uint64(l))
+	}
+	l = len(m.ClassData)
+	if l > 0 {
+		n += 1 + l + sovErc20(uint64(l))
+	}
+	return n
+}
+
+func (m *RegisterNFTPairProposal) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Title)
+	if l > 0 {
+		n += 1 + l + sovErc20(uint64(l))
+	}
+	l = len(m.Description)
+	if l > 0 {
+		n += 1 + l + sovErc20(uint64(l))
+	}
+	l = len(m.ContractAddress)
+	if l > 0 {
+		n += 1 + l + sovErc20(uint64(l))
+	}
+	if m.Standard != 0 {
+		n += 1 + sovErc20(uint64(m.Standard))
+	}
+	l = len(m.ClassURI)
+	if l > 0 {
+		n += 1 + l + sovErc20(uint64(l))
+	}
+	l = len(m.ClassData)
+	if l > 0 {
+		n += 1 + l + sovErc20(uint64(l))
+	}
+	return n
+}
+
+func (m *FailedConversion) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.TxHash)
+	if l > 0 {
+		n += 1 + l + sovErc20(uint64(l))
+	}
+	if m.LogIndex != 0 {
+		n += 1 + sovErc20(uint64(m.LogIndex))
+	}
+	l = len(m.Erc20Address)
+	if l > 0 {
+		n += 1 + l + sovErc20(uint64(l))
+	}
+	l = len(m.Sender)
+	if l > 0 {
+		n += 1 + l + sovErc20(uint64(l))
+	}
+	l = m.Amount.Size()
+	n += 1 + l + sovErc20(uint64(l))
+	l = len(m.Error)
+	if l > 0 {
+		n += 1 + l + sovErc20(uint64(l))
+	}
+	if m.Height != 0 {
+		n += 1 + sovErc20(uint64(m.Height))
+	}
+	return n
+}
+
+func (m *ProposalMetadata) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Metadata) > 0 {
+		for _, e := range m.Metadata {
+			l = e.Size()
+			n += 1 + l + sovErc20(uint64(l))
+		}
+	}
+	return n
+}
+
+func sovErc20(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozErc20(x uint64) (n int) {
+	return sovErc20(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *TokenPair) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowErc20
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: TokenPair: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: TokenPair: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Erc20Address", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowErc20
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthErc20
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthErc20
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Erc20Address = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Denom", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowErc20
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthErc20
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthErc20
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Denom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Enabled", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowErc20
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Enabled = bool(v != 0)
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ContractOwner", wireType)
+			}
+			m.ContractOwner = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowErc20
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ContractOwner |= Owner(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TemplateID", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowErc20
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthErc20
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthErc20
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.TemplateID = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipErc20(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthErc20
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *RegisterCoinProposal) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowErc20
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: RegisterCoinProposal: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: RegisterCoinProposal: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Title", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowErc20
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthErc20
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthErc20
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Title = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Description", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowErc20
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthErc20
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthErc20
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Description = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Metadata", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowErc20
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthErc20
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthErc20
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Metadata = append(m.Metadata, types.Metadata{})
+			if err := m.Metadata[len(m.Metadata)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TemplateID", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowErc20
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthErc20
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthErc20
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.TemplateID = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipErc20(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthErc20
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *RegisterERC20Proposal) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowErc20
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: RegisterERC20Proposal: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: RegisterERC20Proposal: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Title", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowErc20
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthErc20
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthErc20
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Title = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Description", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowErc20
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthErc20
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthErc20
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Description = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Erc20Addresses", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowErc20
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthErc20
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthErc20
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Erc20Addresses = append(m.Erc20Addresses, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipErc20(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthErc20
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ToggleTokenConversionProposal) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowErc20
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ToggleTokenConversionProposal: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ToggleTokenConversionProposal: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Title", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowErc20
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthErc20
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthErc20
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Title = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Description", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowErc20
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthErc20
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthErc20
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Description = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Token", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowErc20
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthErc20
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthErc20
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Token = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipErc20(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthErc20
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *SlashRegistrationDepositProposal) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowErc20
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: SlashRegistrationDepositProposal: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: SlashRegistrationDepositProposal: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Title", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowErc20
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthErc20
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthErc20
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Title = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Description", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowErc20
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthErc20
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthErc20
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Description = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Erc20Addresses", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowErc20
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthErc20
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthErc20
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Erc20Addresses = append(m.Erc20Addresses, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipErc20(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthErc20
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *DeregisterTokenPairProposal) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowErc20
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: DeregisterTokenPairProposal: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: DeregisterTokenPairProposal: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Title", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowErc20
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthErc20
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthErc20
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Title = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Description", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowErc20
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthErc20
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthErc20
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Description = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Token", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowErc20
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthErc20
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthErc20
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Token = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipErc20(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthErc20
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *TokenPairDeposit) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowErc20
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: TokenPairDeposit: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: TokenPairDeposit: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Erc20Address", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowErc20
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthErc20
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthErc20
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Erc20Address = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Depositor", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowErc20
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthErc20
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthErc20
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Depositor = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowErc20
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthErc20
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthErc20
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Amount = append(m.Amount, types1.Coin{})
+			if err := m.Amount[len(m.Amount)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipErc20(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthErc20
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *TokenPairRateLimit) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowErc20
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: TokenPairRateLimit: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: TokenPairRateLimit: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Erc20Address", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowErc20
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthErc20
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthErc20
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Erc20Address = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EpochIdentifier", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowErc20
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthErc20
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthErc20
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.EpochIdentifier = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CoinToERC20Limit", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowErc20
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthErc20
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthErc20
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.CoinToERC20Limit.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ERC20ToCoinLimit", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowErc20
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthErc20
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthErc20
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.ERC20ToCoinLimit.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CoinToERC20Volume", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowErc20
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthErc20
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthErc20
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.CoinToERC20Volume.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ERC20ToCoinVolume", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowErc20
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthErc20
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthErc20
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.ERC20ToCoinVolume.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 7:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Paused", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowErc20
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Paused = bool(v != 0)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipErc20(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthErc20
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *SetTokenPairRateLimitProposal) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowErc20
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: SetTokenPairRateLimitProposal: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: SetTokenPairRateLimitProposal: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Title", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowErc20
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthErc20
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthErc20
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Title = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Description", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowErc20
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthErc20
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthErc20
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Description = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Token", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowErc20
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthErc20
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthErc20
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Token = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EpochIdentifier", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowErc20
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthErc20
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthErc20
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.EpochIdentifier = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CoinToERC20Limit", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowErc20
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthErc20
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthErc20
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.CoinToERC20Limit.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ERC20ToCoinLimit", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowErc20
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthErc20
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthErc20
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.ERC20ToCoinLimit.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipErc20(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthErc20
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *TokenPairStats) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowErc20
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: TokenPairStats: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: TokenPairStats: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Erc20Address", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowErc20
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthErc20
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthErc20
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Erc20Address = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CoinToERC20Volume", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowErc20
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthErc20
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthErc20
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.CoinToERC20Volume.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ERC20ToCoinVolume", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowErc20
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthErc20
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthErc20
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.ERC20ToCoinVolume.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipErc20(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthErc20
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ERC20Template) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowErc20
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ERC20Template: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ERC20Template: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ID", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowErc20
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthErc20
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthErc20
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ID = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Description", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowErc20
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthErc20
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthErc20
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Description = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Bytecode", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowErc20
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthErc20
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthErc20
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Bytecode = append(m.Bytecode[:0], dAtA[iNdEx:postIndex]...)
+			if m.Bytecode == nil {
+				m.Bytecode = []byte{}
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipErc20(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthErc20
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *RegisterERC20TemplateProposal) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowErc20
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: RegisterERC20TemplateProposal: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: RegisterERC20TemplateProposal: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Title", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowErc20
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthErc20
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthErc20
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Title = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Description", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowErc20
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthErc20
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthErc20
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Description = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Template", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowErc20
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthErc20
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthErc20
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Template.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipErc20(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthErc20
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *RemoveERC20TemplateProposal) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowErc20
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: RemoveERC20TemplateProposal: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: RemoveERC20TemplateProposal: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Title", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowErc20
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthErc20
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthErc20
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Title = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Description", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowErc20
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthErc20
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthErc20
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Description = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TemplateID", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowErc20
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthErc20
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthErc20
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.TemplateID = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipErc20(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthErc20
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
 }
-func (m *TokenPair) Unmarshal(dAtA []byte) error {
+func (m *NFTPair) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -859,15 +5121,15 @@ func (m *TokenPair) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: TokenPair: wiretype end group for non-group")
+			return fmt.Errorf("proto: NFTPair: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: TokenPair: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: NFTPair: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Erc20Address", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ContractAddress", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -895,11 +5157,11 @@ func (m *TokenPair) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Erc20Address = string(dAtA[iNdEx:postIndex])
+			m.ContractAddress = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Denom", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ClassID", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -927,13 +5189,13 @@ func (m *TokenPair) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Denom = string(dAtA[iNdEx:postIndex])
+			m.ClassID = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 3:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Enabled", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Standard", wireType)
 			}
-			var v int
+			m.Standard = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowErc20
@@ -943,17 +5205,16 @@ func (m *TokenPair) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				v |= int(b&0x7F) << shift
+				m.Standard |= NFTStandard(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			m.Enabled = bool(v != 0)
 		case 4:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ContractOwner", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ClassURI", wireType)
 			}
-			m.ContractOwner = 0
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowErc20
@@ -963,11 +5224,56 @@ func (m *TokenPair) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.ContractOwner |= Owner(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthErc20
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthErc20
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ClassURI = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ClassData", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowErc20
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthErc20
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthErc20
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ClassData = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipErc20(dAtA[iNdEx:])
@@ -989,7 +5295,7 @@ func (m *TokenPair) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *RegisterCoinProposal) Unmarshal(dAtA []byte) error {
+func (m *RegisterNFTPairProposal) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1012,10 +5318,10 @@ func (m *RegisterCoinProposal) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: RegisterCoinProposal: wiretype end group for non-group")
+			return fmt.Errorf("proto: RegisterNFTPairProposal: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: RegisterCoinProposal: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: RegisterNFTPairProposal: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -1084,9 +5390,9 @@ func (m *RegisterCoinProposal) Unmarshal(dAtA []byte) error {
 			iNdEx = postIndex
 		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Metadata", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ContractAddress", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowErc20
@@ -1096,25 +5402,106 @@ func (m *RegisterCoinProposal) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthErc20
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthErc20
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Metadata = append(m.Metadata, types.Metadata{})
-			if err := m.Metadata[len(m.Metadata)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			m.ContractAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Standard", wireType)
+			}
+			m.Standard = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowErc20
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Standard |= NFTStandard(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ClassURI", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowErc20
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthErc20
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthErc20
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ClassURI = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ClassData", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowErc20
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthErc20
 			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthErc20
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ClassData = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -1137,7 +5524,7 @@ func (m *RegisterCoinProposal) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *RegisterERC20Proposal) Unmarshal(dAtA []byte) error {
+func (m *FailedConversion) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1160,15 +5547,15 @@ func (m *RegisterERC20Proposal) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: RegisterERC20Proposal: wiretype end group for non-group")
+			return fmt.Errorf("proto: FailedConversion: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: RegisterERC20Proposal: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: FailedConversion: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Title", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field TxHash", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -1196,13 +5583,13 @@ func (m *RegisterERC20Proposal) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Title = string(dAtA[iNdEx:postIndex])
+			m.TxHash = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Description", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LogIndex", wireType)
 			}
-			var stringLen uint64
+			m.LogIndex = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowErc20
@@ -1212,27 +5599,14 @@ func (m *RegisterERC20Proposal) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				m.LogIndex |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthErc20
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthErc20
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Description = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
 		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Erc20Addresses", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Erc20Address", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -1260,61 +5634,11 @@ func (m *RegisterERC20Proposal) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Erc20Addresses = append(m.Erc20Addresses, string(dAtA[iNdEx:postIndex]))
+			m.Erc20Address = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipErc20(dAtA[iNdEx:])
-			if err != nil {
-				return err
-			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
-				return ErrInvalidLengthErc20
-			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *ToggleTokenConversionProposal) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowErc20
-			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
-			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
-			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: ToggleTokenConversionProposal: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ToggleTokenConversionProposal: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Title", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Sender", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -1342,11 +5666,11 @@ func (m *ToggleTokenConversionProposal) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Title = string(dAtA[iNdEx:postIndex])
+			m.Sender = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 2:
+		case 5:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Description", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -1374,11 +5698,13 @@ func (m *ToggleTokenConversionProposal) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Description = string(dAtA[iNdEx:postIndex])
+			if err := m.Amount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
-		case 3:
+		case 6:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Token", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Error", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -1406,8 +5732,27 @@ func (m *ToggleTokenConversionProposal) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Token = string(dAtA[iNdEx:postIndex])
+			m.Error = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
+		case 7:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Height", wireType)
+			}
+			m.Height = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowErc20
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Height |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipErc20(dAtA[iNdEx:])
@@ -0,0 +1,55 @@
+package types
+
+import (
+	"fmt"
+	"regexp"
+
+	errorsmod "cosmossdk.io/errors"
+)
+
+// DefaultERC20TemplateID is the identifier of the ERC20MinterBurnerDecimals
+// contract built into the module. It is used to deploy the ERC20 contract of
+// native Cosmos coins registered without a template and cannot be registered
+// or removed by governance.
+const DefaultERC20TemplateID = "erc20-minter-burner-decimals"
+
+// reTemplateID restricts template identifiers to lowercase alphanumeric
+// characters, dashes and underscores, starting with a letter
+var reTemplateID = regexp.MustCompile(`^[a-z][a-z0-9_-]{2,63}$`)
+
+// NewERC20Template returns an instance of ERC20Template
+func NewERC20Template(id, description string, bytecode []byte) ERC20Template {
+	return ERC20Template{
+		ID:          id,
+		Description: description,
+		Bytecode:    bytecode,
+	}
+}
+
+// Validate performs a stateless validation of an ERC20Template
+func (t ERC20Template) Validate() error {
+	if err := ValidateERC20TemplateID(t.ID); err != nil {
+		return err
+	}
+
+	if t.ID == DefaultERC20TemplateID {
+		return errorsmod.Wrapf(ErrInvalidERC20Template, "template id '%s' is reserved for the default template", t.ID)
+	}
+
+	if len(t.Bytecode) == 0 {
+		return errorsmod.Wrapf(ErrInvalidERC20Template, "bytecode of template '%s' cannot be empty", t.ID)
+	}
+
+	return nil
+}
+
+// ValidateERC20TemplateID checks that the template identifier is well formed
+func ValidateERC20TemplateID(id string) error {
+	if !reTemplateID.MatchString(id) {
+		return errorsmod.Wrap(
+			ErrInvalidERC20Template,
+			fmt.Sprintf("invalid template id '%s', must match %s", id, reTemplateID.String()),
+		)
+	}
+	return nil
+}
@@ -6,16 +6,29 @@ import (
 
 // errors
 var (
-	ErrERC20Disabled          = errorsmod.Register(ModuleName, 2, "erc20 module is disabled")
-	ErrInternalTokenPair      = errorsmod.Register(ModuleName, 3, "internal ethereum token mapping error")
-	ErrTokenPairNotFound      = errorsmod.Register(ModuleName, 4, "token pair not found")
-	ErrTokenPairAlreadyExists = errorsmod.Register(ModuleName, 5, "token pair already exists")
-	ErrUndefinedOwner         = errorsmod.Register(ModuleName, 6, "undefined owner of contract pair")
-	ErrBalanceInvariance      = errorsmod.Register(ModuleName, 7, "post transfer balance invariant failed")
-	ErrUnexpectedEvent        = errorsmod.Register(ModuleName, 8, "unexpected event")
-	ErrABIPack                = errorsmod.Register(ModuleName, 9, "contract ABI pack failed")
-	ErrABIUnpack              = errorsmod.Register(ModuleName, 10, "contract ABI unpack failed")
-	ErrEVMDenom               = errorsmod.Register(ModuleName, 11, "EVM denomination registration")
-	ErrEVMCall                = errorsmod.Register(ModuleName, 12, "EVM call unexpected error")
-	ErrERC20TokenPairDisabled = errorsmod.Register(ModuleName, 13, "erc20 token pair is disabled")
+	ErrERC20Disabled            = errorsmod.Register(ModuleName, 2, "erc20 module is disabled")
+	ErrInternalTokenPair        = errorsmod.Register(ModuleName, 3, "internal ethereum token mapping error")
+	ErrTokenPairNotFound        = errorsmod.Register(ModuleName, 4, "token pair not found")
+	ErrTokenPairAlreadyExists   = errorsmod.Register(ModuleName, 5, "token pair already exists")
+	ErrUndefinedOwner           = errorsmod.Register(ModuleName, 6, "undefined owner of contract pair")
+	ErrBalanceInvariance        = errorsmod.Register(ModuleName, 7, "post transfer balance invariant failed")
+	ErrUnexpectedEvent          = errorsmod.Register(ModuleName, 8, "unexpected event")
+	ErrABIPack                  = errorsmod.Register(ModuleName, 9, "contract ABI pack failed")
+	ErrABIUnpack                = errorsmod.Register(ModuleName, 10, "contract ABI unpack failed")
+	ErrEVMDenom                 = errorsmod.Register(ModuleName, 11, "EVM denomination registration")
+	ErrEVMCall                  = errorsmod.Register(ModuleName, 12, "EVM call unexpected error")
+	ErrERC20TokenPairDisabled   = errorsmod.Register(ModuleName, 13, "erc20 token pair is disabled")
+	ErrRegistrationDisabled     = errorsmod.Register(ModuleName, 14, "permissionless registration is disabled")
+	ErrDepositNotFound          = errorsmod.Register(ModuleName, 15, "token pair deposit not found")
+	ErrEscrowMismatch           = errorsmod.Register(ModuleName, 16, "escrowed balance does not back the token pair supply")
+	ErrRateLimitExceeded        = errorsmod.Register(ModuleName, 17, "token pair conversion rate limit exceeded")
+	ErrDecimalsMismatch         = errorsmod.Register(ModuleName, 18, "ERC20 decimals do not match the coin metadata")
+	ErrERC20TemplateNotFound    = errorsmod.Register(ModuleName, 19, "ERC20 template not found")
+	ErrInvalidERC20Template     = errorsmod.Register(ModuleName, 20, "invalid ERC20 template")
+	ErrNFTPairNotFound          = errorsmod.Register(ModuleName, 21, "NFT pair not found")
+	ErrNFTPairAlreadyExists     = errorsmod.Register(ModuleName, 22, "NFT pair already exists")
+	ErrUndefinedNFTStandard     = errorsmod.Register(ModuleName, 23, "undefined token standard of NFT pair")
+	ErrFailedConversionNotFound = errorsmod.Register(ModuleName, 24, "failed conversion not found")
+	ErrInvalidMemo              = errorsmod.Register(ModuleName, 25, "invalid ICS-20 packet memo")
+	ErrUnwindTokenPair          = errorsmod.Register(ModuleName, 26, "token pair escrow can't be unwound")
 )
@@ -8,21 +8,59 @@ import (
 
 // erc20 events
 const (
-	EventTypeTokenLock             = "token_lock"
-	EventTypeTokenUnlock           = "token_unlock"
-	EventTypeMint                  = "mint"
-	EventTypeConvertCoin           = "convert_coin"
-	EventTypeConvertERC20          = "convert_erc20"
-	EventTypeBurn                  = "burn"
-	EventTypeRegisterCoin          = "register_coin"
-	EventTypeRegisterERC20         = "register_erc20"
-	EventTypeToggleTokenConversion = "toggle_token_conversion" // #nosec
+	EventTypeTokenLock              = "token_lock"
+	EventTypeTokenUnlock            = "token_unlock"
+	EventTypeMint                   = "mint"
+	EventTypeConvertCoin            = "convert_coin"
+	EventTypeConvertERC20           = "convert_erc20"
+	EventTypeBurn                   = "burn"
+	EventTypeRegisterCoin           = "register_coin"
+	EventTypeRegisterERC20          = "register_erc20"
+	EventTypeToggleTokenConversion  = "toggle_token_conversion" // #nosec
+	EventTypeRefundDeposit          = "refund_deposit"
+	EventTypeSlashDeposit           = "slash_deposit"
+	EventTypeDeregisterTokenPair    = "deregister_token_pair"
+	EventTypeSetRateLimit           = "set_rate_limit"
+	EventTypeRateLimitReached       = "rate_limit_reached"
+	EventTypeRateLimitReset         = "rate_limit_reset"
+	EventTypeRefreshMetadata        = "refresh_token_pair_metadata"
+	EventTypeRegisterERC20Template  = "register_erc20_template"
+	EventTypeRemoveERC20Template    = "remove_erc20_template"
+	EventTypeRegisterNFTPair        = "register_nft_pair"
+	EventTypeConvertNFT             = "convert_nft"
+	EventTypeConvertContractNFT     = "convert_contract_nft"
+	EventTypeFailedConversion       = "failed_conversion"
+	EventTypeRefundFailedConversion = "refund_failed_conversion"
+	EventTypeIBCReceiveConversion   = "ibc_receive_conversion"
 
 	AttributeKeyCosmosCoin = "cosmos_coin"
 	AttributeKeyERC20Token = "erc20_token" // #nosec
 	AttributeKeyReceiver   = "receiver"
+	AttributeKeyDepositor  = "depositor"
+	AttributeKeyDirection  = "direction"
+	AttributeKeyEpochID    = "epoch_identifier"
+	AttributeKeyTemplateID = "template_id"
+	AttributeKeyContract   = "contract"
+	AttributeKeyStandard   = "standard"
+	AttributeKeyClassID    = "class_id"
+	AttributeKeyNFTID      = "nft_id"
+	AttributeKeyTokenID    = "token_id"
+	AttributeKeyTxHash     = "tx_hash"
+	AttributeKeyLogIndex   = "log_index"
+	AttributeKeyError      = "error"
+	AttributeKeyReceived   = "received"
+	AttributeKeyConverted  = "converted"
+
+	AttributeKeyPreviousSymbol  = "previous_symbol"
+	AttributeKeySymbol          = "symbol"
+	AttributeKeyPreviousDisplay = "previous_display"
+	AttributeKeyDisplay         = "display"
 
 	ERC20EventTransfer = "Transfer"
+
+	ERC721EventTransfer        = "Transfer"
+	ERC1155EventTransferSingle = "TransferSingle"
+	ERC1155EventTransferBatch  = "TransferBatch"
 )
 
 // Event type for Transfer(address from, address to, uint256 value)
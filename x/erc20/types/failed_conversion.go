@@ -0,0 +1,92 @@
+package types
+
+import (
+	"fmt"
+
+	"cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	ethermint "github.com/evmos/ethermint/types"
+)
+
+// NewFailedConversion returns an instance of FailedConversion
+func NewFailedConversion(
+	txHash common.Hash,
+	logIndex uint64,
+	erc20Address, sender common.Address,
+	amount math.Int,
+	reason string,
+	height int64,
+) FailedConversion {
+	return FailedConversion{
+		TxHash:       txHash.Hex(),
+		LogIndex:     logIndex,
+		Erc20Address: erc20Address.String(),
+		Sender:       sender.String(),
+		Amount:       amount,
+		Error:        reason,
+		Height:       height,
+	}
+}
+
+// GetEthTxHash casts the hex string hash of the tx to common.Hash
+func (fc FailedConversion) GetEthTxHash() common.Hash {
+	return common.HexToHash(fc.TxHash)
+}
+
+// GetERC20Contract casts the hex string address of the ERC20 to common.Address
+func (fc FailedConversion) GetERC20Contract() common.Address {
+	return common.HexToAddress(fc.Erc20Address)
+}
+
+// GetSenderAddress casts the hex string address of the sender to common.Address
+func (fc FailedConversion) GetSenderAddress() common.Address {
+	return common.HexToAddress(fc.Sender)
+}
+
+// Validate performs a stateless validation of a FailedConversion
+func (fc FailedConversion) Validate() error {
+	if err := ValidateTxHash(fc.TxHash); err != nil {
+		return err
+	}
+
+	if err := ethermint.ValidateAddress(fc.Erc20Address); err != nil {
+		return err
+	}
+
+	if err := ethermint.ValidateAddress(fc.Sender); err != nil {
+		return err
+	}
+
+	if fc.Amount.IsNil() || !fc.Amount.IsPositive() {
+		return fmt.Errorf("failed conversion amount must be positive: %s", fc.Amount)
+	}
+
+	if fc.Height < 0 {
+		return fmt.Errorf("failed conversion height cannot be negative: %d", fc.Height)
+	}
+
+	return nil
+}
+
+// ValidateTxHash checks that the given string is a 0x prefixed hex encoded
+// Ethereum tx hash
+func ValidateTxHash(txHash string) error {
+	bz, err := hexutil.Decode(txHash)
+	if err != nil {
+		return fmt.Errorf("invalid tx hash %s: %w", txHash, err)
+	}
+
+	if len(bz) != common.HashLength {
+		return fmt.Errorf("invalid tx hash %s, expected %d bytes, got %d", txHash, common.HashLength, len(bz))
+	}
+
+	return nil
+}
+
+// FailedConversionKey returns the store key of a failed conversion from the tx
+// hash and the index of the transfer log in the tx receipt
+func FailedConversionKey(txHash common.Hash, logIndex uint64) []byte {
+	return append(txHash.Bytes(), sdk.Uint64ToBigEndian(logIndex)...)
+}
@@ -0,0 +1,84 @@
+package types
+
+import (
+	"testing"
+
+	"cosmossdk.io/math"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/stretchr/testify/suite"
+
+	"github.com/evmos/ethermint/tests"
+)
+
+type FailedConversionTestSuite struct {
+	suite.Suite
+}
+
+func TestFailedConversionSuite(t *testing.T) {
+	suite.Run(t, new(FailedConversionTestSuite))
+}
+
+func (suite *FailedConversionTestSuite) TestFailedConversionValidate() {
+	txHash := common.BytesToHash([]byte("tx"))
+
+	testCases := []struct {
+		msg        string
+		fc         FailedConversion
+		expectPass bool
+	}{
+		{
+			"invalid tx hash",
+			FailedConversion{"0x1234", 0, tests.GenerateAddress().String(), tests.GenerateAddress().String(), math.NewInt(10), "", 1},
+			false,
+		},
+		{
+			"invalid erc20 address",
+			FailedConversion{txHash.Hex(), 0, "0x5dCA2483280D9727c80b5518faC4556617fb19ZZ", tests.GenerateAddress().String(), math.NewInt(10), "", 1},
+			false,
+		},
+		{
+			"invalid sender address",
+			FailedConversion{txHash.Hex(), 0, tests.GenerateAddress().String(), "evmos1", math.NewInt(10), "", 1},
+			false,
+		},
+		{
+			"nil amount",
+			FailedConversion{txHash.Hex(), 0, tests.GenerateAddress().String(), tests.GenerateAddress().String(), math.Int{}, "", 1},
+			false,
+		},
+		{
+			"zero amount",
+			FailedConversion{txHash.Hex(), 0, tests.GenerateAddress().String(), tests.GenerateAddress().String(), math.ZeroInt(), "", 1},
+			false,
+		},
+		{
+			"negative height",
+			FailedConversion{txHash.Hex(), 0, tests.GenerateAddress().String(), tests.GenerateAddress().String(), math.NewInt(10), "", -1},
+			false,
+		},
+		{
+			"pass",
+			NewFailedConversion(txHash, 3, tests.GenerateAddress(), tests.GenerateAddress(), math.NewInt(10), "conversion disabled", 1),
+			true,
+		},
+	}
+
+	for i, tc := range testCases {
+		err := tc.fc.Validate()
+
+		if tc.expectPass {
+			suite.Require().NoError(err, "valid test %d failed: %s, %v", i, tc.msg)
+		} else {
+			suite.Require().Error(err, "invalid test %d passed: %s, %v", i, tc.msg)
+		}
+	}
+}
+
+func (suite *FailedConversionTestSuite) TestFailedConversionKey() {
+	txHash := common.BytesToHash([]byte("tx"))
+
+	key := FailedConversionKey(txHash, 1)
+	suite.Require().Len(key, common.HashLength+8)
+	suite.Require().Equal(txHash.Bytes(), key[:common.HashLength])
+	suite.Require().NotEqual(key, FailedConversionKey(txHash, 256))
+}
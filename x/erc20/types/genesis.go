@@ -1,6 +1,10 @@
 package types
 
-import "fmt"
+import (
+	"fmt"
+
+	"github.com/ethereum/go-ethereum/common"
+)
 
 // NewGenesisState creates a new genesis state.
 func NewGenesisState(params Params, pairs []TokenPair) GenesisState {
@@ -40,5 +44,109 @@ func (gs GenesisState) Validate() error {
 		seenDenom[b.Denom] = true
 	}
 
+	seenDeposit := make(map[string]bool)
+
+	for _, d := range gs.Deposits {
+		if seenDeposit[d.Erc20Address] {
+			return fmt.Errorf("token pair deposit duplicated on genesis '%s'", d.Erc20Address)
+		}
+		if !seenErc20[d.Erc20Address] {
+			return fmt.Errorf("token pair deposit for unregistered ERC20 contract on genesis '%s'", d.Erc20Address)
+		}
+
+		if err := d.Validate(); err != nil {
+			return err
+		}
+
+		seenDeposit[d.Erc20Address] = true
+	}
+
+	seenRateLimit := make(map[string]bool)
+
+	for _, rl := range gs.RateLimits {
+		if seenRateLimit[rl.Erc20Address] {
+			return fmt.Errorf("token pair rate limit duplicated on genesis '%s'", rl.Erc20Address)
+		}
+		if !seenErc20[rl.Erc20Address] {
+			return fmt.Errorf("token pair rate limit for unregistered ERC20 contract on genesis '%s'", rl.Erc20Address)
+		}
+
+		if err := rl.Validate(); err != nil {
+			return err
+		}
+
+		seenRateLimit[rl.Erc20Address] = true
+	}
+
+	seenStats := make(map[string]bool)
+
+	for _, s := range gs.Stats {
+		if seenStats[s.Erc20Address] {
+			return fmt.Errorf("token pair stats duplicated on genesis '%s'", s.Erc20Address)
+		}
+		if !seenErc20[s.Erc20Address] {
+			return fmt.Errorf("token pair stats for unregistered ERC20 contract on genesis '%s'", s.Erc20Address)
+		}
+
+		if err := s.Validate(); err != nil {
+			return err
+		}
+
+		seenStats[s.Erc20Address] = true
+	}
+
+	seenTemplate := make(map[string]bool)
+
+	for _, t := range gs.Templates {
+		if seenTemplate[t.ID] {
+			return fmt.Errorf("ERC20 template duplicated on genesis '%s'", t.ID)
+		}
+
+		if err := t.Validate(); err != nil {
+			return err
+		}
+
+		seenTemplate[t.ID] = true
+	}
+
+	// token pair addresses are not required to be checksummed, so the NFT
+	// contracts are compared against them in their canonical form
+	tokenPairContracts := make(map[common.Address]bool)
+	for _, b := range gs.TokenPairs {
+		tokenPairContracts[common.HexToAddress(b.Erc20Address)] = true
+	}
+
+	seenNFTContract := make(map[string]bool)
+
+	for _, np := range gs.NFTPairs {
+		if seenNFTContract[np.ContractAddress] {
+			return fmt.Errorf("NFT contract duplicated on genesis '%s'", np.ContractAddress)
+		}
+		if tokenPairContracts[np.GetContract()] {
+			return fmt.Errorf("NFT contract registered as token ERC20 contract on genesis '%s'", np.ContractAddress)
+		}
+
+		if err := np.Validate(); err != nil {
+			return err
+		}
+
+		seenNFTContract[np.ContractAddress] = true
+	}
+
+	seenFailedConversion := make(map[string]bool)
+
+	for _, fc := range gs.FailedConversions {
+		if err := fc.Validate(); err != nil {
+			return err
+		}
+
+		key := string(FailedConversionKey(fc.GetEthTxHash(), fc.LogIndex))
+		if seenFailedConversion[key] {
+			return fmt.Errorf("failed conversion duplicated on genesis '%s/%d'", fc.TxHash, fc.LogIndex)
+		}
+
+		seenFailedConversion[key] = true
+	}
+
 	return gs.Params.Validate()
 }
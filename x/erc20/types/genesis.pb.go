@@ -5,6 +5,8 @@ package types
 
 import (
 	fmt "fmt"
+	github_com_cosmos_cosmos_sdk_types "github.com/cosmos/cosmos-sdk/types"
+	types "github.com/cosmos/cosmos-sdk/types"
 	_ "github.com/cosmos/gogoproto/gogoproto"
 	proto "github.com/gogo/protobuf/proto"
 	io "io"
@@ -29,6 +31,18 @@ type GenesisState struct {
 	Params Params `protobuf:"bytes,1,opt,name=params,proto3" json:"params"`
 	// token_pairs is a slice of the registered token pairs at genesis
 	TokenPairs []TokenPair `protobuf:"bytes,2,rep,name=token_pairs,json=tokenPairs,proto3" json:"token_pairs"`
+	// deposits is a slice of the registration deposits escrowed at genesis
+	Deposits []TokenPairDeposit `protobuf:"bytes,3,rep,name=deposits,proto3" json:"deposits"`
+	// rate_limits is a slice of the token pair conversion rate limits at genesis
+	RateLimits []TokenPairRateLimit `protobuf:"bytes,4,rep,name=rate_limits,json=rateLimits,proto3" json:"rate_limits"`
+	// templates is a slice of the registered ERC20 templates at genesis
+	Templates []ERC20Template `protobuf:"bytes,5,rep,name=templates,proto3" json:"templates"`
+	// stats is a slice of the cumulative conversion volumes of token pairs
+	Stats []TokenPairStats `protobuf:"bytes,6,rep,name=stats,proto3" json:"stats"`
+	// nft_pairs is a slice of the registered NFT pairs at genesis
+	NFTPairs []NFTPair `protobuf:"bytes,7,rep,name=nft_pairs,json=nftPairs,proto3" json:"nft_pairs"`
+	// failed_conversions is a slice of the unrefunded failed EVM hook conversions
+	FailedConversions []FailedConversion `protobuf:"bytes,8,rep,name=failed_conversions,json=failedConversions,proto3" json:"failed_conversions"`
 }
 
 func (m *GenesisState) Reset()         { *m = GenesisState{} }
@@ -78,6 +92,48 @@ func (m *GenesisState) GetTokenPairs() []TokenPair {
 	return nil
 }
 
+func (m *GenesisState) GetDeposits() []TokenPairDeposit {
+	if m != nil {
+		return m.Deposits
+	}
+	return nil
+}
+
+func (m *GenesisState) GetRateLimits() []TokenPairRateLimit {
+	if m != nil {
+		return m.RateLimits
+	}
+	return nil
+}
+
+func (m *GenesisState) GetTemplates() []ERC20Template {
+	if m != nil {
+		return m.Templates
+	}
+	return nil
+}
+
+func (m *GenesisState) GetStats() []TokenPairStats {
+	if m != nil {
+		return m.Stats
+	}
+	return nil
+}
+
+func (m *GenesisState) GetNFTPairs() []NFTPair {
+	if m != nil {
+		return m.NFTPairs
+	}
+	return nil
+}
+
+func (m *GenesisState) GetFailedConversions() []FailedConversion {
+	if m != nil {
+		return m.FailedConversions
+	}
+	return nil
+}
+
 // Params defines the erc20 module params
 type Params struct {
 	// enable_erc20 is the parameter to enable the conversion of Cosmos coins <--> ERC20 tokens.
@@ -85,6 +141,14 @@ type Params struct {
 	// enable_evm_hook is the parameter to enable the EVM hook that converts an ERC20 token to a Cosmos
 	// Coin by transferring the Tokens through a MsgEthereumTx to the ModuleAddress Ethereum address.
 	EnableEVMHook bool `protobuf:"varint,2,opt,name=enable_evm_hook,json=enableEvmHook,proto3" json:"enable_evm_hook,omitempty"`
+	// enable_permissionless_registration is the parameter to enable the registration of ERC20 token
+	// pairs through MsgRegisterERC20 without a governance proposal.
+	EnablePermissionlessRegistration bool `protobuf:"varint,3,opt,name=enable_permissionless_registration,json=enablePermissionlessRegistration,proto3" json:"enable_permissionless_registration,omitempty"`
+	// registration_deposit is the refundable deposit escrowed from the sender of a MsgRegisterERC20.
+	RegistrationDeposit github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,4,rep,name=registration_deposit,json=registrationDeposit,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"registration_deposit"`
+	// enable_ibc_receive_sweep is the parameter to convert the recipient's whole balance of a received
+	// IBC coin to ERC20 tokens, instead of only the amount of the packet.
+	EnableIBCReceiveSweep bool `protobuf:"varint,5,opt,name=enable_ibc_receive_sweep,json=enableIbcReceiveSweep,proto3" json:"enable_ibc_receive_sweep,omitempty"`
 }
 
 func (m *Params) Reset()         { *m = Params{} }
@@ -134,6 +198,27 @@ func (m *Params) GetEnableEVMHook() bool {
 	return false
 }
 
+func (m *Params) GetEnablePermissionlessRegistration() bool {
+	if m != nil {
+		return m.EnablePermissionlessRegistration
+	}
+	return false
+}
+
+func (m *Params) GetRegistrationDeposit() github_com_cosmos_cosmos_sdk_types.Coins {
+	if m != nil {
+		return m.RegistrationDeposit
+	}
+	return nil
+}
+
+func (m *Params) GetEnableIBCReceiveSweep() bool {
+	if m != nil {
+		return m.EnableIBCReceiveSweep
+	}
+	return false
+}
+
 func init() {
 	proto.RegisterType((*GenesisState)(nil), "evmos.erc20.v1.GenesisState")
 	proto.RegisterType((*Params)(nil), "evmos.erc20.v1.Params")
@@ -142,26 +227,46 @@ func init() {
 func init() { proto.RegisterFile("evmos/erc20/v1/genesis.proto", fileDescriptor_2f4674601b0d6987) }
 
 var fileDescriptor_2f4674601b0d6987 = []byte{
-	// 302 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xe2, 0x92, 0x49, 0x2d, 0xcb, 0xcd,
-	0x2f, 0xd6, 0x4f, 0x2d, 0x4a, 0x36, 0x32, 0xd0, 0x2f, 0x33, 0xd4, 0x4f, 0x4f, 0xcd, 0x4b, 0x2d,
-	0xce, 0x2c, 0xd6, 0x2b, 0x28, 0xca, 0x2f, 0xc9, 0x17, 0xe2, 0x03, 0xcb, 0xea, 0x81, 0x65, 0xf5,
-	0xca, 0x0c, 0xa5, 0xa4, 0xd0, 0x54, 0x43, 0x24, 0xc0, 0x6a, 0xa5, 0x44, 0xd2, 0xf3, 0xd3, 0xf3,
-	0xc1, 0x4c, 0x7d, 0x10, 0x0b, 0x22, 0xaa, 0xd4, 0xc6, 0xc8, 0xc5, 0xe3, 0x0e, 0x31, 0x33, 0xb8,
-	0x24, 0xb1, 0x24, 0x55, 0xc8, 0x84, 0x8b, 0xad, 0x20, 0xb1, 0x28, 0x31, 0xb7, 0x58, 0x82, 0x51,
-	0x81, 0x51, 0x83, 0xdb, 0x48, 0x4c, 0x0f, 0xd5, 0x0e, 0xbd, 0x00, 0xb0, 0xac, 0x13, 0xcb, 0x89,
-	0x7b, 0xf2, 0x0c, 0x41, 0x50, 0xb5, 0x42, 0x0e, 0x5c, 0xdc, 0x25, 0xf9, 0xd9, 0xa9, 0x79, 0xf1,
-	0x05, 0x89, 0x99, 0x45, 0xc5, 0x12, 0x4c, 0x0a, 0xcc, 0x1a, 0xdc, 0x46, 0x92, 0xe8, 0x5a, 0x43,
-	0x40, 0x4a, 0x02, 0x12, 0x33, 0x8b, 0xa0, 0xba, 0xb9, 0x4a, 0x60, 0x02, 0xc5, 0x4a, 0x69, 0x5c,
-	0x6c, 0x10, 0x93, 0x85, 0x14, 0xb9, 0x78, 0x52, 0xf3, 0x12, 0x93, 0x72, 0x52, 0xe3, 0xc1, 0x1a,
-	0xc1, 0xee, 0xe0, 0x08, 0xe2, 0x86, 0x88, 0xb9, 0x82, 0x84, 0x84, 0x2c, 0xb9, 0xf8, 0x61, 0x4a,
-	0xca, 0x72, 0xe3, 0x33, 0xf2, 0xf3, 0xb3, 0x25, 0x98, 0x40, 0xaa, 0x9c, 0x04, 0x1f, 0xdd, 0x93,
-	0xe7, 0x75, 0x85, 0xa8, 0x0c, 0xf3, 0xf5, 0xc8, 0xcf, 0xcf, 0x0e, 0xe2, 0x85, 0x6a, 0x2c, 0xcb,
-	0x05, 0x71, 0x9d, 0x9c, 0x4e, 0x3c, 0x92, 0x63, 0xbc, 0xf0, 0x48, 0x8e, 0xf1, 0xc1, 0x23, 0x39,
-	0xc6, 0x09, 0x8f, 0xe5, 0x18, 0x2e, 0x3c, 0x96, 0x63, 0xb8, 0xf1, 0x58, 0x8e, 0x21, 0x4a, 0x23,
-	0x3d, 0xb3, 0x24, 0xa3, 0x34, 0x49, 0x2f, 0x39, 0x3f, 0x57, 0x1f, 0x1a, 0x8e, 0x60, 0xb2, 0xcc,
-	0xd0, 0x40, 0xbf, 0x02, 0x1a, 0xa6, 0x25, 0x95, 0x05, 0xa9, 0xc5, 0x49, 0x6c, 0xe0, 0xb0, 0x33,
-	0x06, 0x04, 0x00, 0x00, 0xff, 0xff, 0xc8, 0xc5, 0x52, 0x8e, 0x9d, 0x01, 0x00, 0x00,
+	// 621 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x74, 0x94, 0x31, 0x73, 0xd3, 0x30,
+	0x14, 0xc7, 0x93, 0xa6, 0x0d, 0xa9, 0xd2, 0x02, 0x15, 0x2d, 0xb8, 0x3d, 0x70, 0x42, 0xa6, 0x2e,
+	0xd8, 0x49, 0x60, 0x81, 0x09, 0x1c, 0x5a, 0xe8, 0x5d, 0xe1, 0x7a, 0x6e, 0x61, 0x60, 0xf1, 0xc9,
+	0xee, 0x4b, 0xaa, 0x4b, 0x6c, 0xf9, 0xfc, 0x84, 0x81, 0x85, 0x99, 0x91, 0xcf, 0xc1, 0xca, 0x97,
+	0xe8, 0xd8, 0x91, 0x29, 0x70, 0xe9, 0x17, 0xe1, 0x2c, 0x29, 0x25, 0xcd, 0x91, 0x25, 0x51, 0x9e,
+	0xfe, 0xbf, 0xbf, 0x5e, 0xfe, 0xf7, 0x24, 0x72, 0x1f, 0xf2, 0x58, 0xa0, 0x0b, 0x59, 0xd4, 0x6d,
+	0xbb, 0x79, 0xc7, 0x1d, 0x40, 0x02, 0xc8, 0xd1, 0x49, 0x33, 0x21, 0x05, 0xbd, 0xa9, 0x76, 0x1d,
+	0xb5, 0xeb, 0xe4, 0x9d, 0x1d, 0x3b, 0x12, 0x58, 0xc8, 0x43, 0x86, 0xe0, 0xe6, 0x9d, 0x10, 0x24,
+	0xeb, 0xb8, 0x91, 0xe0, 0x89, 0xd6, 0xef, 0xec, 0xcc, 0xb9, 0x69, 0x50, 0xef, 0x6d, 0x0e, 0xc4,
+	0x40, 0xa8, 0xa5, 0x5b, 0xac, 0x74, 0xb5, 0xf5, 0x73, 0x99, 0xac, 0xbd, 0xd2, 0x67, 0x1e, 0x4b,
+	0x26, 0x81, 0x3e, 0x21, 0xd5, 0x94, 0x65, 0x2c, 0x46, 0xab, 0xdc, 0x2c, 0xef, 0xd6, 0xbb, 0x77,
+	0x9d, 0xeb, 0x3d, 0x38, 0x47, 0x6a, 0xd7, 0x5b, 0x3e, 0x1f, 0x37, 0x4a, 0xbe, 0xd1, 0xd2, 0xe7,
+	0xa4, 0x2e, 0xc5, 0x10, 0x92, 0x20, 0x65, 0x3c, 0x43, 0x6b, 0xa9, 0x59, 0xd9, 0xad, 0x77, 0xb7,
+	0xe7, 0xd1, 0x93, 0x42, 0x72, 0xc4, 0x78, 0x66, 0x68, 0x22, 0xa7, 0x05, 0xa4, 0x1e, 0xa9, 0x9d,
+	0x42, 0x2a, 0x90, 0x4b, 0xb4, 0x2a, 0x0a, 0x6f, 0x2e, 0xc4, 0x5f, 0x6a, 0xa1, 0x71, 0xb9, 0xe2,
+	0xe8, 0x01, 0xa9, 0x67, 0x4c, 0x42, 0x30, 0xe2, 0x71, 0x61, 0xb3, 0xac, 0x6c, 0x5a, 0x0b, 0x6d,
+	0x7c, 0x26, 0xe1, 0x90, 0xc7, 0x57, 0x46, 0x24, 0x9b, 0x16, 0x90, 0xbe, 0x20, 0xab, 0x12, 0xe2,
+	0x74, 0xc4, 0x24, 0xa0, 0xb5, 0xa2, 0x8c, 0x1e, 0xcc, 0x1b, 0xed, 0xf9, 0xbd, 0x6e, 0xfb, 0xc4,
+	0xa8, 0x8c, 0xc7, 0x3f, 0x8a, 0x3e, 0x23, 0x2b, 0x28, 0x99, 0x44, 0xab, 0xaa, 0x70, 0x7b, 0x61,
+	0x1f, 0x45, 0xf0, 0xd3, 0x40, 0x35, 0x42, 0xf7, 0xc9, 0x6a, 0xd2, 0x97, 0x26, 0xcd, 0x1b, 0x8a,
+	0xbf, 0x37, 0xcf, 0xbf, 0xdd, 0x3f, 0x51, 0x59, 0xde, 0x2e, 0xc0, 0xc9, 0xb8, 0x51, 0x33, 0x05,
+	0xf4, 0x6b, 0x49, 0x5f, 0xea, 0x54, 0xdf, 0x11, 0xda, 0x67, 0x7c, 0x04, 0xa7, 0x41, 0x24, 0x92,
+	0x1c, 0x32, 0xe4, 0x22, 0x41, 0xab, 0xf6, 0xff, 0x7c, 0xf7, 0x95, 0xb2, 0x77, 0x25, 0x34, 0x2d,
+	0x6d, 0xf4, 0xe7, 0xea, 0xd8, 0xfa, 0x56, 0x21, 0x55, 0x3d, 0x07, 0xf4, 0x21, 0x59, 0x83, 0x84,
+	0x85, 0x23, 0x08, 0x94, 0x8f, 0x9a, 0x9a, 0x9a, 0x5f, 0xd7, 0xb5, 0xbd, 0xa2, 0x44, 0x9f, 0x92,
+	0x5b, 0x53, 0x49, 0x1e, 0x07, 0x67, 0x42, 0x0c, 0xad, 0xa5, 0x42, 0xe5, 0x6d, 0x4c, 0xc6, 0x8d,
+	0xf5, 0x3d, 0xad, 0x7c, 0xff, 0xe6, 0xb5, 0x10, 0x43, 0x7f, 0xdd, 0x80, 0x79, 0x5c, 0xfc, 0xa4,
+	0x87, 0xa4, 0x65, 0xd0, 0x14, 0xb2, 0x98, 0x63, 0x71, 0xfc, 0x08, 0x10, 0x83, 0x0c, 0x06, 0x1c,
+	0x65, 0xc6, 0x24, 0x17, 0x89, 0x55, 0x51, 0x67, 0x36, 0xb5, 0xf2, 0xe8, 0x9a, 0xd0, 0x9f, 0xd1,
+	0xd1, 0xaf, 0x64, 0x73, 0x96, 0x0b, 0xcc, 0xe0, 0x98, 0x41, 0xd9, 0x76, 0xf4, 0xed, 0x72, 0x8a,
+	0xdb, 0xe5, 0x98, 0xdb, 0xe5, 0xf4, 0x04, 0x4f, 0xbc, 0x76, 0x11, 0xc4, 0x8f, 0xdf, 0x8d, 0xdd,
+	0x01, 0x97, 0x67, 0x1f, 0x43, 0x27, 0x12, 0xb1, 0x6b, 0xae, 0xa2, 0xfe, 0x7a, 0x84, 0xa7, 0x43,
+	0x57, 0x7e, 0x49, 0x01, 0x15, 0x80, 0xfe, 0x9d, 0xd9, 0x83, 0xcc, 0xbc, 0x52, 0x9f, 0x58, 0xe6,
+	0xdf, 0xf0, 0x30, 0x0a, 0x32, 0x88, 0x80, 0xe7, 0x10, 0xe0, 0x27, 0x80, 0xd4, 0x5a, 0x51, 0x89,
+	0x6c, 0x4f, 0xc6, 0x8d, 0x2d, 0x9d, 0xc8, 0x81, 0xd7, 0xf3, 0xb5, 0xe2, 0xb8, 0x10, 0xf8, 0x5b,
+	0x1a, 0x3d, 0x08, 0xa3, 0xd9, 0xb2, 0xe7, 0x9d, 0x4f, 0xec, 0xf2, 0xc5, 0xc4, 0x2e, 0xff, 0x99,
+	0xd8, 0xe5, 0xef, 0x97, 0x76, 0xe9, 0xe2, 0xd2, 0x2e, 0xfd, 0xba, 0xb4, 0x4b, 0x1f, 0x66, 0x9b,
+	0x35, 0xef, 0x82, 0xfa, 0xcc, 0x3b, 0x6d, 0xf7, 0xb3, 0x79, 0x23, 0x54, 0xcb, 0x61, 0x55, 0xbd,
+	0x05, 0x8f, 0xff, 0x0e, 0x00, 0x74, 0x85, 0xe7, 0x67, 0x8d, 0x04, 0x00, 0x00,
 }
 
 func (m *GenesisState) Marshal() (dAtA []byte, err error) {
@@ -184,6 +289,90 @@ func (m *GenesisState) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.FailedConversions) > 0 {
+		for iNdEx := len(m.FailedConversions) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.FailedConversions[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x42
+		}
+	}
+	if len(m.NFTPairs) > 0 {
+		for iNdEx := len(m.NFTPairs) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.NFTPairs[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x3a
+		}
+	}
+	if len(m.Stats) > 0 {
+		for iNdEx := len(m.Stats) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Stats[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x32
+		}
+	}
+	if len(m.Templates) > 0 {
+		for iNdEx := len(m.Templates) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Templates[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x2a
+		}
+	}
+	if len(m.RateLimits) > 0 {
+		for iNdEx := len(m.RateLimits) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.RateLimits[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x22
+		}
+	}
+	if len(m.Deposits) > 0 {
+		for iNdEx := len(m.Deposits) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Deposits[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
 	if len(m.TokenPairs) > 0 {
 		for iNdEx := len(m.TokenPairs) - 1; iNdEx >= 0; iNdEx-- {
 			{
@@ -231,6 +420,40 @@ func (m *Params) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.EnableIBCReceiveSweep {
+		i--
+		if m.EnableIBCReceiveSweep {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x28
+	}
+	if len(m.RegistrationDeposit) > 0 {
+		for iNdEx := len(m.RegistrationDeposit) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.RegistrationDeposit[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x22
+		}
+	}
+	if m.EnablePermissionlessRegistration {
+		i--
+		if m.EnablePermissionlessRegistration {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x18
+	}
 	if m.EnableEVMHook {
 		i--
 		if m.EnableEVMHook {
@@ -279,6 +502,42 @@ func (m *GenesisState) Size() (n int) {
 			n += 1 + l + sovGenesis(uint64(l))
 		}
 	}
+	if len(m.Deposits) > 0 {
+		for _, e := range m.Deposits {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.RateLimits) > 0 {
+		for _, e := range m.RateLimits {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.Templates) > 0 {
+		for _, e := range m.Templates {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.Stats) > 0 {
+		for _, e := range m.Stats {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.NFTPairs) > 0 {
+		for _, e := range m.NFTPairs {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.FailedConversions) > 0 {
+		for _, e := range m.FailedConversions {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
 	return n
 }
 
@@ -294,6 +553,18 @@ func (m *Params) Size() (n int) {
 	if m.EnableEVMHook {
 		n += 2
 	}
+	if m.EnablePermissionlessRegistration {
+		n += 2
+	}
+	if len(m.RegistrationDeposit) > 0 {
+		for _, e := range m.RegistrationDeposit {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if m.EnableIBCReceiveSweep {
+		n += 2
+	}
 	return n
 }
 
@@ -399,6 +670,210 @@ func (m *GenesisState) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Deposits", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Deposits = append(m.Deposits, TokenPairDeposit{})
+			if err := m.Deposits[len(m.Deposits)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RateLimits", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.RateLimits = append(m.RateLimits, TokenPairRateLimit{})
+			if err := m.RateLimits[len(m.RateLimits)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Templates", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Templates = append(m.Templates, ERC20Template{})
+			if err := m.Templates[len(m.Templates)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Stats", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Stats = append(m.Stats, TokenPairStats{})
+			if err := m.Stats[len(m.Stats)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NFTPairs", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.NFTPairs = append(m.NFTPairs, NFTPair{})
+			if err := m.NFTPairs[len(m.NFTPairs)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FailedConversions", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.FailedConversions = append(m.FailedConversions, FailedConversion{})
+			if err := m.FailedConversions[len(m.FailedConversions)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenesis(dAtA[iNdEx:])
@@ -489,6 +964,80 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 				}
 			}
 			m.EnableEVMHook = bool(v != 0)
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EnablePermissionlessRegistration", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.EnablePermissionlessRegistration = bool(v != 0)
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RegistrationDeposit", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.RegistrationDeposit = append(m.RegistrationDeposit, types.Coin{})
+			if err := m.RegistrationDeposit[len(m.RegistrationDeposit)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EnableIBCReceiveSweep", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.EnableIBCReceiveSweep = bool(v != 0)
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenesis(dAtA[iNdEx:])
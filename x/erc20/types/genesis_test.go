@@ -3,7 +3,12 @@ package types
 import (
 	"testing"
 
+	"cosmossdk.io/math"
 	"github.com/stretchr/testify/suite"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/evmos/ethermint/tests"
 )
 
 type GenesisTestSuite struct {
@@ -19,6 +24,7 @@ func TestGenesisTestSuite(t *testing.T) {
 
 func (suite *GenesisTestSuite) TestValidateGenesis() {
 	newGen := NewGenesisState(DefaultParams(), []TokenPair{})
+	depositor := sdk.AccAddress(tests.GenerateAddress().Bytes()).String()
 
 	testCases := []struct {
 		name     string
@@ -128,6 +134,411 @@ func (suite *GenesisTestSuite) TestValidateGenesis() {
 			},
 			expPass: false,
 		},
+		{
+			name: "valid genesis - with deposits",
+			genState: &GenesisState{
+				Params: DefaultParams(),
+				TokenPairs: []TokenPair{
+					{
+						Erc20Address: "0xdac17f958d2ee523a2206206994597c13d831ec7",
+						Denom:        "usdt",
+						Enabled:      true,
+					},
+				},
+				Deposits: []TokenPairDeposit{
+					{
+						Erc20Address: "0xdac17f958d2ee523a2206206994597c13d831ec7",
+						Depositor:    depositor,
+						Amount:       DefaultRegistrationDeposit,
+					},
+				},
+			},
+			expPass: true,
+		},
+		{
+			name: "invalid genesis - duplicated deposit",
+			genState: &GenesisState{
+				Params: DefaultParams(),
+				TokenPairs: []TokenPair{
+					{
+						Erc20Address: "0xdac17f958d2ee523a2206206994597c13d831ec7",
+						Denom:        "usdt",
+						Enabled:      true,
+					},
+				},
+				Deposits: []TokenPairDeposit{
+					{
+						Erc20Address: "0xdac17f958d2ee523a2206206994597c13d831ec7",
+						Depositor:    depositor,
+						Amount:       DefaultRegistrationDeposit,
+					},
+					{
+						Erc20Address: "0xdac17f958d2ee523a2206206994597c13d831ec7",
+						Depositor:    depositor,
+						Amount:       DefaultRegistrationDeposit,
+					},
+				},
+			},
+			expPass: false,
+		},
+		{
+			name: "invalid genesis - deposit for unregistered token pair",
+			genState: &GenesisState{
+				Params: DefaultParams(),
+				Deposits: []TokenPairDeposit{
+					{
+						Erc20Address: "0xdac17f958d2ee523a2206206994597c13d831ec7",
+						Depositor:    depositor,
+						Amount:       DefaultRegistrationDeposit,
+					},
+				},
+			},
+			expPass: false,
+		},
+		{
+			name: "invalid genesis - invalid depositor",
+			genState: &GenesisState{
+				Params: DefaultParams(),
+				TokenPairs: []TokenPair{
+					{
+						Erc20Address: "0xdac17f958d2ee523a2206206994597c13d831ec7",
+						Denom:        "usdt",
+						Enabled:      true,
+					},
+				},
+				Deposits: []TokenPairDeposit{
+					{
+						Erc20Address: "0xdac17f958d2ee523a2206206994597c13d831ec7",
+						Depositor:    "",
+						Amount:       DefaultRegistrationDeposit,
+					},
+				},
+			},
+			expPass: false,
+		},
+		{
+			name: "valid genesis - with rate limits",
+			genState: &GenesisState{
+				Params: DefaultParams(),
+				TokenPairs: []TokenPair{
+					{
+						Erc20Address: "0xdac17f958d2ee523a2206206994597c13d831ec7",
+						Denom:        "usdt",
+						Enabled:      true,
+					},
+				},
+				RateLimits: []TokenPairRateLimit{
+					{
+						Erc20Address:      "0xdac17f958d2ee523a2206206994597c13d831ec7",
+						EpochIdentifier:   "day",
+						CoinToERC20Limit:  math.NewInt(100),
+						ERC20ToCoinLimit:  math.ZeroInt(),
+						CoinToERC20Volume: math.ZeroInt(),
+						ERC20ToCoinVolume: math.ZeroInt(),
+					},
+				},
+			},
+			expPass: true,
+		},
+		{
+			name: "invalid genesis - duplicated rate limit",
+			genState: &GenesisState{
+				Params: DefaultParams(),
+				TokenPairs: []TokenPair{
+					{
+						Erc20Address: "0xdac17f958d2ee523a2206206994597c13d831ec7",
+						Denom:        "usdt",
+						Enabled:      true,
+					},
+				},
+				RateLimits: []TokenPairRateLimit{
+					{
+						Erc20Address:      "0xdac17f958d2ee523a2206206994597c13d831ec7",
+						EpochIdentifier:   "day",
+						CoinToERC20Limit:  math.NewInt(100),
+						ERC20ToCoinLimit:  math.ZeroInt(),
+						CoinToERC20Volume: math.ZeroInt(),
+						ERC20ToCoinVolume: math.ZeroInt(),
+					},
+					{
+						Erc20Address:      "0xdac17f958d2ee523a2206206994597c13d831ec7",
+						EpochIdentifier:   "day",
+						CoinToERC20Limit:  math.NewInt(100),
+						ERC20ToCoinLimit:  math.ZeroInt(),
+						CoinToERC20Volume: math.ZeroInt(),
+						ERC20ToCoinVolume: math.ZeroInt(),
+					},
+				},
+			},
+			expPass: false,
+		},
+		{
+			name: "invalid genesis - rate limit for unregistered token pair",
+			genState: &GenesisState{
+				Params: DefaultParams(),
+				RateLimits: []TokenPairRateLimit{
+					{
+						Erc20Address:      "0xdac17f958d2ee523a2206206994597c13d831ec7",
+						EpochIdentifier:   "day",
+						CoinToERC20Limit:  math.NewInt(100),
+						ERC20ToCoinLimit:  math.ZeroInt(),
+						CoinToERC20Volume: math.ZeroInt(),
+						ERC20ToCoinVolume: math.ZeroInt(),
+					},
+				},
+			},
+			expPass: false,
+		},
+		{
+			name: "invalid genesis - rate limit without limits",
+			genState: &GenesisState{
+				Params: DefaultParams(),
+				TokenPairs: []TokenPair{
+					{
+						Erc20Address: "0xdac17f958d2ee523a2206206994597c13d831ec7",
+						Denom:        "usdt",
+						Enabled:      true,
+					},
+				},
+				RateLimits: []TokenPairRateLimit{
+					{
+						Erc20Address:      "0xdac17f958d2ee523a2206206994597c13d831ec7",
+						EpochIdentifier:   "day",
+						CoinToERC20Limit:  math.ZeroInt(),
+						ERC20ToCoinLimit:  math.ZeroInt(),
+						CoinToERC20Volume: math.ZeroInt(),
+						ERC20ToCoinVolume: math.ZeroInt(),
+					},
+				},
+			},
+			expPass: false,
+		},
+		{
+			name: "invalid genesis - rate limit with blank epoch identifier",
+			genState: &GenesisState{
+				Params: DefaultParams(),
+				TokenPairs: []TokenPair{
+					{
+						Erc20Address: "0xdac17f958d2ee523a2206206994597c13d831ec7",
+						Denom:        "usdt",
+						Enabled:      true,
+					},
+				},
+				RateLimits: []TokenPairRateLimit{
+					{
+						Erc20Address:      "0xdac17f958d2ee523a2206206994597c13d831ec7",
+						EpochIdentifier:   "",
+						CoinToERC20Limit:  math.NewInt(100),
+						ERC20ToCoinLimit:  math.ZeroInt(),
+						CoinToERC20Volume: math.ZeroInt(),
+						ERC20ToCoinVolume: math.ZeroInt(),
+					},
+				},
+			},
+			expPass: false,
+		},
+		{
+			name: "valid genesis - with templates",
+			genState: &GenesisState{
+				Params: DefaultParams(),
+				TokenPairs: []TokenPair{
+					{
+						Erc20Address: "0xdac17f958d2ee523a2206206994597c13d831ec7",
+						Denom:        "usdt",
+						Enabled:      true,
+						TemplateID:   "erc20-permit",
+					},
+				},
+				Templates: []ERC20Template{
+					{ID: "erc20-permit", Bytecode: []byte{0x60, 0x80}},
+					{ID: "erc20-pauser", Bytecode: []byte{0x60, 0x80}},
+				},
+			},
+			expPass: true,
+		},
+		{
+			name: "valid genesis - with stats",
+			genState: &GenesisState{
+				Params: DefaultParams(),
+				TokenPairs: []TokenPair{
+					{
+						Erc20Address: "0xdac17f958d2ee523a2206206994597c13d831ec7",
+						Denom:        "usdt",
+						Enabled:      true,
+					},
+				},
+				Stats: []TokenPairStats{
+					{
+						Erc20Address:      "0xdac17f958d2ee523a2206206994597c13d831ec7",
+						CoinToERC20Volume: math.NewInt(100),
+						ERC20ToCoinVolume: math.ZeroInt(),
+					},
+				},
+			},
+			expPass: true,
+		},
+		{
+			name: "invalid genesis - stats for unregistered token pair",
+			genState: &GenesisState{
+				Params: DefaultParams(),
+				Stats: []TokenPairStats{
+					{
+						Erc20Address:      "0xdac17f958d2ee523a2206206994597c13d831ec7",
+						CoinToERC20Volume: math.NewInt(100),
+						ERC20ToCoinVolume: math.ZeroInt(),
+					},
+				},
+			},
+			expPass: false,
+		},
+		{
+			name: "invalid genesis - stats with negative volume",
+			genState: &GenesisState{
+				Params: DefaultParams(),
+				TokenPairs: []TokenPair{
+					{
+						Erc20Address: "0xdac17f958d2ee523a2206206994597c13d831ec7",
+						Denom:        "usdt",
+						Enabled:      true,
+					},
+				},
+				Stats: []TokenPairStats{
+					{
+						Erc20Address:      "0xdac17f958d2ee523a2206206994597c13d831ec7",
+						CoinToERC20Volume: math.NewInt(-1),
+						ERC20ToCoinVolume: math.ZeroInt(),
+					},
+				},
+			},
+			expPass: false,
+		},
+		{
+			name: "invalid genesis - duplicated template",
+			genState: &GenesisState{
+				Params: DefaultParams(),
+				Templates: []ERC20Template{
+					{ID: "erc20-permit", Bytecode: []byte{0x60, 0x80}},
+					{ID: "erc20-permit", Bytecode: []byte{0x60, 0x80}},
+				},
+			},
+			expPass: false,
+		},
+		{
+			name: "invalid genesis - default template id",
+			genState: &GenesisState{
+				Params: DefaultParams(),
+				Templates: []ERC20Template{
+					{ID: DefaultERC20TemplateID, Bytecode: []byte{0x60, 0x80}},
+				},
+			},
+			expPass: false,
+		},
+		{
+			name: "invalid genesis - template without bytecode",
+			genState: &GenesisState{
+				Params: DefaultParams(),
+				Templates: []ERC20Template{
+					{ID: "erc20-permit"},
+				},
+			},
+			expPass: false,
+		},
+		{
+			name: "invalid genesis - token pair with invalid template id",
+			genState: &GenesisState{
+				Params: DefaultParams(),
+				TokenPairs: []TokenPair{
+					{
+						Erc20Address: "0xdac17f958d2ee523a2206206994597c13d831ec7",
+						Denom:        "usdt",
+						Enabled:      true,
+						TemplateID:   "ERC20 Permit",
+					},
+				},
+			},
+			expPass: false,
+		},
+		{
+			name: "valid genesis - nft pairs",
+			genState: &GenesisState{
+				Params: DefaultParams(),
+				NFTPairs: []NFTPair{
+					NewNFTPair(common.HexToAddress("0xBC4CA0EdA7647A8aB7C2061c2E118A18a936f13D"), NFT_STANDARD_ERC721, "", ""),
+					NewNFTPair(common.HexToAddress("0x76BE3b62873462d2142405439777e971754E8E77"), NFT_STANDARD_ERC1155, "", ""),
+				},
+			},
+			expPass: true,
+		},
+		{
+			name: "invalid genesis - duplicated nft pair",
+			genState: &GenesisState{
+				Params: DefaultParams(),
+				NFTPairs: []NFTPair{
+					NewNFTPair(common.HexToAddress("0xBC4CA0EdA7647A8aB7C2061c2E118A18a936f13D"), NFT_STANDARD_ERC721, "", ""),
+					NewNFTPair(common.HexToAddress("0xBC4CA0EdA7647A8aB7C2061c2E118A18a936f13D"), NFT_STANDARD_ERC721, "", ""),
+				},
+			},
+			expPass: false,
+		},
+		{
+			name: "invalid genesis - nft pair contract registered as token pair",
+			genState: &GenesisState{
+				Params: DefaultParams(),
+				TokenPairs: []TokenPair{
+					{
+						Erc20Address: "0xdac17f958d2ee523a2206206994597c13d831ec7",
+						Denom:        "usdt",
+						Enabled:      true,
+					},
+				},
+				NFTPairs: []NFTPair{
+					NewNFTPair(common.HexToAddress("0xdac17f958d2ee523a2206206994597c13d831ec7"), NFT_STANDARD_ERC721, "", ""),
+				},
+			},
+			expPass: false,
+		},
+		{
+			name: "invalid genesis - invalid nft pair",
+			genState: &GenesisState{
+				Params: DefaultParams(),
+				NFTPairs: []NFTPair{
+					NewNFTPair(common.HexToAddress("0xBC4CA0EdA7647A8aB7C2061c2E118A18a936f13D"), NFT_STANDARD_UNSPECIFIED, "", ""),
+				},
+			},
+			expPass: false,
+		},
+		{
+			name: "valid genesis - failed conversions",
+			genState: &GenesisState{
+				Params: DefaultParams(),
+				FailedConversions: []FailedConversion{
+					NewFailedConversion(common.BytesToHash([]byte("tx")), 0, common.HexToAddress("0xdac17f958d2ee523a2206206994597c13d831ec7"), common.HexToAddress("0xBC4CA0EdA7647A8aB7C2061c2E118A18a936f13D"), math.NewInt(10), "conversion disabled", 1),
+					NewFailedConversion(common.BytesToHash([]byte("tx")), 1, common.HexToAddress("0xdac17f958d2ee523a2206206994597c13d831ec7"), common.HexToAddress("0xBC4CA0EdA7647A8aB7C2061c2E118A18a936f13D"), math.NewInt(10), "conversion disabled", 1),
+				},
+			},
+			expPass: true,
+		},
+		{
+			name: "invalid genesis - duplicated failed conversion",
+			genState: &GenesisState{
+				Params: DefaultParams(),
+				FailedConversions: []FailedConversion{
+					NewFailedConversion(common.BytesToHash([]byte("tx")), 0, common.HexToAddress("0xdac17f958d2ee523a2206206994597c13d831ec7"), common.HexToAddress("0xBC4CA0EdA7647A8aB7C2061c2E118A18a936f13D"), math.NewInt(10), "conversion disabled", 1),
+					NewFailedConversion(common.BytesToHash([]byte("tx")), 0, common.HexToAddress("0xdac17f958d2ee523a2206206994597c13d831ec7"), common.HexToAddress("0xBC4CA0EdA7647A8aB7C2061c2E118A18a936f13D"), math.NewInt(5), "conversion disabled", 2),
+				},
+			},
+			expPass: false,
+		},
+		{
+			name: "invalid genesis - invalid failed conversion",
+			genState: &GenesisState{
+				Params: DefaultParams(),
+				FailedConversions: []FailedConversion{
+					NewFailedConversion(common.BytesToHash([]byte("tx")), 0, common.HexToAddress("0xdac17f958d2ee523a2206206994597c13d831ec7"), common.HexToAddress("0xBC4CA0EdA7647A8aB7C2061c2E118A18a936f13D"), math.ZeroInt(), "conversion disabled", 1),
+				},
+			},
+			expPass: false,
+		},
 		{
 			// Voting period cant be zero
 			name:     "empty genesis",
@@ -6,6 +6,7 @@ import (
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
 	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+	"github.com/cosmos/cosmos-sdk/x/nft"
 
 	"github.com/ethereum/go-ethereum/common"
 	"github.com/ethereum/go-ethereum/core"
@@ -35,6 +36,20 @@ type BankKeeper interface {
 	SetDenomMetaData(ctx sdk.Context, denomMetaData banktypes.Metadata)
 	HasSupply(ctx sdk.Context, denom string) bool
 	GetBalance(ctx sdk.Context, addr sdk.AccAddress, denom string) sdk.Coin
+	GetAllBalances(ctx sdk.Context, addr sdk.AccAddress) sdk.Coins
+	SpendableCoins(ctx sdk.Context, addr sdk.AccAddress) sdk.Coins
+	GetSupply(ctx sdk.Context, denom string) sdk.Coin
+	DenomOwners(goCtx context.Context, req *banktypes.QueryDenomOwnersRequest) (*banktypes.QueryDenomOwnersResponse, error)
+}
+
+// NFTKeeper defines the expected interface needed to mint and burn the native
+// x/nft tokens of NFT pairs.
+type NFTKeeper interface {
+	SaveClass(ctx sdk.Context, class nft.Class) error
+	HasClass(ctx sdk.Context, classID string) bool
+	Mint(ctx sdk.Context, token nft.NFT, receiver sdk.AccAddress) error
+	Burn(ctx sdk.Context, classID string, nftID string) error
+	GetOwner(ctx sdk.Context, classID string, nftID string) sdk.AccAddress
 }
 
 // StakingKeeper defines the expected interface needed to retrieve the staking denom.
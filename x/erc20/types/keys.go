@@ -29,11 +29,25 @@ const (
 	prefixTokenPair = iota + 1
 	prefixTokenPairByERC20
 	prefixTokenPairByDenom
+	prefixTokenPairDeposit
+	prefixTokenPairRateLimit
+	prefixERC20Template
+	prefixTokenPairStats
+	prefixNFTPair
+	prefixNFTPairByClass
+	prefixFailedConversion
 )
 
 // KVStore key prefixes
 var (
-	KeyPrefixTokenPair        = []byte{prefixTokenPair}
-	KeyPrefixTokenPairByERC20 = []byte{prefixTokenPairByERC20}
-	KeyPrefixTokenPairByDenom = []byte{prefixTokenPairByDenom}
+	KeyPrefixTokenPair          = []byte{prefixTokenPair}
+	KeyPrefixTokenPairByERC20   = []byte{prefixTokenPairByERC20}
+	KeyPrefixTokenPairByDenom   = []byte{prefixTokenPairByDenom}
+	KeyPrefixTokenPairDeposit   = []byte{prefixTokenPairDeposit}
+	KeyPrefixTokenPairRateLimit = []byte{prefixTokenPairRateLimit}
+	KeyPrefixERC20Template      = []byte{prefixERC20Template}
+	KeyPrefixTokenPairStats     = []byte{prefixTokenPairStats}
+	KeyPrefixNFTPair            = []byte{prefixNFTPair}
+	KeyPrefixNFTPairByClass     = []byte{prefixNFTPairByClass}
+	KeyPrefixFailedConversion   = []byte{prefixFailedConversion}
 )
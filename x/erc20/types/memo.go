@@ -0,0 +1,81 @@
+package types
+
+import (
+	"bytes"
+	"encoding/json"
+	"strings"
+
+	errorsmod "cosmossdk.io/errors"
+	ethermint "github.com/evmos/ethermint/types"
+)
+
+// MemoKey is the key of the erc20 instructions in the JSON object of an ICS-20
+// packet memo, e.g.
+//
+//	{"erc20": {"receiver": "0x..."}}
+//	{"erc20": {"skip_conversion": true}}
+//
+// Memos that are not a JSON object or that don't contain the key are ignored,
+// so that other middlewares and plain text memos keep working.
+const MemoKey = "erc20"
+
+// ERC20Memo defines the instructions of an ICS-20 packet memo for the
+// conversion of the received coins to ERC20 tokens
+type ERC20Memo struct {
+	// Receiver is the hex address that receives the ERC20 tokens instead of the
+	// hex address of the packet receiver
+	Receiver string `json:"receiver,omitempty"`
+	// SkipConversion keeps the received coins without converting them
+	SkipConversion bool `json:"skip_conversion,omitempty"`
+}
+
+// ParseERC20Memo returns the erc20 instructions of an ICS-20 packet memo, or nil
+// if the memo doesn't contain any. An error is returned if the instructions
+// don't follow the expected schema.
+func ParseERC20Memo(memo string) (*ERC20Memo, error) {
+	memo = strings.TrimSpace(memo)
+	if !strings.HasPrefix(memo, "{") {
+		return nil, nil
+	}
+
+	var fields map[string]json.RawMessage
+	if err := json.Unmarshal([]byte(memo), &fields); err != nil {
+		// not a JSON object, e.g. plain text between braces
+		return nil, nil
+	}
+
+	raw, found := fields[MemoKey]
+	if !found {
+		return nil, nil
+	}
+
+	var erc20Memo ERC20Memo
+	decoder := json.NewDecoder(bytes.NewReader(raw))
+	decoder.DisallowUnknownFields()
+	if err := decoder.Decode(&erc20Memo); err != nil {
+		return nil, errorsmod.Wrapf(ErrInvalidMemo, "failed to decode %s memo: %s", MemoKey, err)
+	}
+
+	if err := erc20Memo.Validate(); err != nil {
+		return nil, err
+	}
+
+	return &erc20Memo, nil
+}
+
+// Validate performs a stateless validation of the memo instructions
+func (m ERC20Memo) Validate() error {
+	if m.Receiver == "" {
+		return nil
+	}
+
+	if m.SkipConversion {
+		return errorsmod.Wrap(ErrInvalidMemo, "receiver cannot be set when the conversion is skipped")
+	}
+
+	if err := ethermint.ValidateNonZeroAddress(m.Receiver); err != nil {
+		return errorsmod.Wrapf(ErrInvalidMemo, "invalid receiver: %s", err)
+	}
+
+	return nil
+}
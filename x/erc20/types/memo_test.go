@@ -0,0 +1,63 @@
+package types
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/stretchr/testify/suite"
+
+	"github.com/evmos/ethermint/tests"
+)
+
+type MemoTestSuite struct {
+	suite.Suite
+}
+
+func TestMemoSuite(t *testing.T) {
+	suite.Run(t, new(MemoTestSuite))
+}
+
+func (suite *MemoTestSuite) TestParseERC20Memo() {
+	receiver := tests.GenerateAddress()
+
+	testCases := []struct {
+		msg        string
+		memo       string
+		expMemo    *ERC20Memo
+		expectPass bool
+	}{
+		{"empty memo", "", nil, true},
+		{"plain text memo", "hello", nil, true},
+		{"invalid JSON object", "{hello}", nil, true},
+		{"memo without erc20 key", `{"forward":{"receiver":"evmos1"}}`, nil, true},
+		{"empty erc20 memo", `{"erc20":{}}`, &ERC20Memo{}, true},
+		{
+			"receiver",
+			fmt.Sprintf(`{"erc20":{"receiver":"%s"}}`, receiver),
+			&ERC20Memo{Receiver: receiver.String()},
+			true,
+		},
+		{"skip conversion", `{"erc20":{"skip_conversion":true}}`, &ERC20Memo{SkipConversion: true}, true},
+		{"erc20 memo is not an object", `{"erc20":"0x"}`, nil, false},
+		{"unknown field", `{"erc20":{"recipient":"0x"}}`, nil, false},
+		{"invalid receiver", `{"erc20":{"receiver":"evmos1"}}`, nil, false},
+		{"zero receiver", `{"erc20":{"receiver":"0x0000000000000000000000000000000000000000"}}`, nil, false},
+		{
+			"receiver with skipped conversion",
+			fmt.Sprintf(`{"erc20":{"receiver":"%s","skip_conversion":true}}`, receiver),
+			nil,
+			false,
+		},
+	}
+
+	for _, tc := range testCases {
+		memo, err := ParseERC20Memo(tc.memo)
+		if tc.expectPass {
+			suite.Require().NoError(err, tc.msg)
+			suite.Require().Equal(tc.expMemo, memo, tc.msg)
+		} else {
+			suite.Require().Error(err, tc.msg)
+			suite.Require().ErrorIs(err, ErrInvalidMemo, tc.msg)
+		}
+	}
+}
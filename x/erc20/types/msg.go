@@ -6,6 +6,7 @@ import (
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	errortypes "github.com/cosmos/cosmos-sdk/types/errors"
 
+	"github.com/cosmos/cosmos-sdk/x/nft"
 	ibctransfertypes "github.com/cosmos/ibc-go/v5/modules/apps/transfer/types"
 	"github.com/ethereum/go-ethereum/common"
 )
@@ -13,11 +14,28 @@ import (
 var (
 	_ sdk.Msg = &MsgConvertCoin{}
 	_ sdk.Msg = &MsgConvertERC20{}
+	_ sdk.Msg = &MsgRegisterERC20{}
+	_ sdk.Msg = &MsgDeregisterERC20{}
+	_ sdk.Msg = &MsgConvertCoins{}
+	_ sdk.Msg = &MsgConvertERC20S{}
+	_ sdk.Msg = &MsgRefreshTokenPairMetadata{}
+	_ sdk.Msg = &MsgConvertNFT{}
+	_ sdk.Msg = &MsgConvertContractNFT{}
+	_ sdk.Msg = &MsgRefundFailedConversion{}
 )
 
 const (
-	TypeMsgConvertCoin  = "convert_coin"
-	TypeMsgConvertERC20 = "convert_ERC20"
+	TypeMsgConvertCoin     = "convert_coin"
+	TypeMsgConvertERC20    = "convert_ERC20"
+	TypeMsgRegisterERC20   = "register_ERC20"
+	TypeMsgDeregisterERC20 = "deregister_ERC20"
+	TypeMsgConvertCoins    = "convert_coins"
+	TypeMsgConvertERC20S   = "convert_ERC20s"
+
+	TypeMsgRefreshTokenPairMetadata = "refresh_token_pair_metadata"
+	TypeMsgConvertNFT               = "convert_nft"
+	TypeMsgConvertContractNFT       = "convert_contract_nft"
+	TypeMsgRefundFailedConversion   = "refund_failed_conversion"
 )
 
 // NewMsgConvertCoin creates a new instance of MsgConvertCoin
@@ -111,3 +129,352 @@ func (msg MsgConvertERC20) GetSigners() []sdk.AccAddress {
 	addr := common.HexToAddress(msg.Sender)
 	return []sdk.AccAddress{addr.Bytes()}
 }
+
+// NewMsgRegisterERC20 creates a new instance of MsgRegisterERC20
+func NewMsgRegisterERC20(contract common.Address, sender sdk.AccAddress) *MsgRegisterERC20 { // nolint: interfacer
+	return &MsgRegisterERC20{
+		ContractAddress: contract.String(),
+		Sender:          sender.String(),
+	}
+}
+
+// Route should return the name of the module
+func (msg MsgRegisterERC20) Route() string { return RouterKey }
+
+// Type should return the action
+func (msg MsgRegisterERC20) Type() string { return TypeMsgRegisterERC20 }
+
+// ValidateBasic runs stateless checks on the message
+func (msg MsgRegisterERC20) ValidateBasic() error {
+	if !common.IsHexAddress(msg.ContractAddress) {
+		return errorsmod.Wrapf(errortypes.ErrInvalidAddress, "invalid contract hex address '%s'", msg.ContractAddress)
+	}
+	if _, err := sdk.AccAddressFromBech32(msg.Sender); err != nil {
+		return errorsmod.Wrap(err, "invalid sender address")
+	}
+	return nil
+}
+
+// GetSignBytes encodes the message for signing
+func (msg MsgRegisterERC20) GetSignBytes() []byte {
+	return sdk.MustSortJSON(AminoCdc.MustMarshalJSON(&msg))
+}
+
+// GetSigners defines whose signature is required
+func (msg MsgRegisterERC20) GetSigners() []sdk.AccAddress {
+	addr := sdk.MustAccAddressFromBech32(msg.Sender)
+	return []sdk.AccAddress{addr}
+}
+
+// NewMsgDeregisterERC20 creates a new instance of MsgDeregisterERC20
+func NewMsgDeregisterERC20(contract common.Address, sender sdk.AccAddress) *MsgDeregisterERC20 { // nolint: interfacer
+	return &MsgDeregisterERC20{
+		ContractAddress: contract.String(),
+		Sender:          sender.String(),
+	}
+}
+
+// Route should return the name of the module
+func (msg MsgDeregisterERC20) Route() string { return RouterKey }
+
+// Type should return the action
+func (msg MsgDeregisterERC20) Type() string { return TypeMsgDeregisterERC20 }
+
+// ValidateBasic runs stateless checks on the message
+func (msg MsgDeregisterERC20) ValidateBasic() error {
+	if !common.IsHexAddress(msg.ContractAddress) {
+		return errorsmod.Wrapf(errortypes.ErrInvalidAddress, "invalid contract hex address '%s'", msg.ContractAddress)
+	}
+	if _, err := sdk.AccAddressFromBech32(msg.Sender); err != nil {
+		return errorsmod.Wrap(err, "invalid sender address")
+	}
+	return nil
+}
+
+// GetSignBytes encodes the message for signing
+func (msg MsgDeregisterERC20) GetSignBytes() []byte {
+	return sdk.MustSortJSON(AminoCdc.MustMarshalJSON(&msg))
+}
+
+// GetSigners defines whose signature is required
+func (msg MsgDeregisterERC20) GetSigners() []sdk.AccAddress {
+	addr := sdk.MustAccAddressFromBech32(msg.Sender)
+	return []sdk.AccAddress{addr}
+}
+
+// NewMsgConvertCoins creates a new instance of MsgConvertCoins
+func NewMsgConvertCoins(coins sdk.Coins, receiver common.Address, sender sdk.AccAddress) *MsgConvertCoins { // nolint: interfacer
+	return &MsgConvertCoins{
+		Coins:    coins,
+		Receiver: receiver.Hex(),
+		Sender:   sender.String(),
+	}
+}
+
+// Route should return the name of the module
+func (msg MsgConvertCoins) Route() string { return RouterKey }
+
+// Type should return the action
+func (msg MsgConvertCoins) Type() string { return TypeMsgConvertCoins }
+
+// ValidateBasic runs stateless checks on the message
+func (msg MsgConvertCoins) ValidateBasic() error {
+	if msg.Coins.Empty() {
+		return errorsmod.Wrap(errortypes.ErrInvalidCoins, "coins cannot be empty")
+	}
+	if err := msg.Coins.Validate(); err != nil {
+		return errorsmod.Wrap(errortypes.ErrInvalidCoins, err.Error())
+	}
+	for _, coin := range msg.Coins {
+		if err := ValidateErc20Denom(coin.Denom); err != nil {
+			if err := ibctransfertypes.ValidateIBCDenom(coin.Denom); err != nil {
+				return err
+			}
+		}
+	}
+	_, err := sdk.AccAddressFromBech32(msg.Sender)
+	if err != nil {
+		return errorsmod.Wrap(err, "invalid sender address")
+	}
+	if !common.IsHexAddress(msg.Receiver) {
+		return errorsmod.Wrapf(errortypes.ErrInvalidAddress, "invalid receiver hex address %s", msg.Receiver)
+	}
+	return nil
+}
+
+// GetSignBytes encodes the message for signing
+func (msg MsgConvertCoins) GetSignBytes() []byte {
+	return sdk.MustSortJSON(AminoCdc.MustMarshalJSON(&msg))
+}
+
+// GetSigners defines whose signature is required
+func (msg MsgConvertCoins) GetSigners() []sdk.AccAddress {
+	addr := sdk.MustAccAddressFromBech32(msg.Sender)
+	return []sdk.AccAddress{addr}
+}
+
+// NewMsgConvertERC20S creates a new instance of MsgConvertERC20S
+func NewMsgConvertERC20S(tokens []ERC20Amount, receiver sdk.AccAddress, sender common.Address) *MsgConvertERC20S { // nolint: interfacer
+	return &MsgConvertERC20S{
+		Tokens:   tokens,
+		Receiver: receiver.String(),
+		Sender:   sender.Hex(),
+	}
+}
+
+// Route should return the name of the module
+func (msg MsgConvertERC20S) Route() string { return RouterKey }
+
+// Type should return the action
+func (msg MsgConvertERC20S) Type() string { return TypeMsgConvertERC20S }
+
+// ValidateBasic runs stateless checks on the message
+func (msg MsgConvertERC20S) ValidateBasic() error {
+	if len(msg.Tokens) == 0 {
+		return errorsmod.Wrap(errortypes.ErrInvalidCoins, "tokens cannot be empty")
+	}
+
+	seenContracts := make(map[common.Address]bool)
+	for _, token := range msg.Tokens {
+		if !common.IsHexAddress(token.ContractAddress) {
+			return errorsmod.Wrapf(errortypes.ErrInvalidAddress, "invalid contract hex address '%s'", token.ContractAddress)
+		}
+		contract := common.HexToAddress(token.ContractAddress)
+		if seenContracts[contract] {
+			return errorsmod.Wrapf(errortypes.ErrInvalidCoins, "duplicate contract address '%s'", token.ContractAddress)
+		}
+		seenContracts[contract] = true
+
+		if token.Amount.IsNil() || !token.Amount.IsPositive() {
+			return errorsmod.Wrapf(errortypes.ErrInvalidCoins, "cannot mint a non-positive amount")
+		}
+	}
+	_, err := sdk.AccAddressFromBech32(msg.Receiver)
+	if err != nil {
+		return errorsmod.Wrap(err, "invalid receiver address")
+	}
+	if !common.IsHexAddress(msg.Sender) {
+		return errorsmod.Wrapf(errortypes.ErrInvalidAddress, "invalid sender hex address %s", msg.Sender)
+	}
+	return nil
+}
+
+// GetSignBytes encodes the message for signing
+func (msg MsgConvertERC20S) GetSignBytes() []byte {
+	return sdk.MustSortJSON(AminoCdc.MustMarshalJSON(&msg))
+}
+
+// GetSigners defines whose signature is required
+func (msg MsgConvertERC20S) GetSigners() []sdk.AccAddress {
+	addr := common.HexToAddress(msg.Sender)
+	return []sdk.AccAddress{addr.Bytes()}
+}
+
+// NewERC20Amount creates a new instance of ERC20Amount
+func NewERC20Amount(contract common.Address, amount math.Int) ERC20Amount {
+	return ERC20Amount{
+		ContractAddress: contract.String(),
+		Amount:          amount,
+	}
+}
+
+// NewMsgRefreshTokenPairMetadata creates a new instance of MsgRefreshTokenPairMetadata
+func NewMsgRefreshTokenPairMetadata(contract common.Address, sender sdk.AccAddress) *MsgRefreshTokenPairMetadata { // nolint: interfacer
+	return &MsgRefreshTokenPairMetadata{
+		ContractAddress: contract.String(),
+		Sender:          sender.String(),
+	}
+}
+
+// Route should return the name of the module
+func (msg MsgRefreshTokenPairMetadata) Route() string { return RouterKey }
+
+// Type should return the action
+func (msg MsgRefreshTokenPairMetadata) Type() string { return TypeMsgRefreshTokenPairMetadata }
+
+// ValidateBasic runs stateless checks on the message
+func (msg MsgRefreshTokenPairMetadata) ValidateBasic() error {
+	if !common.IsHexAddress(msg.ContractAddress) {
+		return errorsmod.Wrapf(errortypes.ErrInvalidAddress, "invalid contract hex address '%s'", msg.ContractAddress)
+	}
+	if _, err := sdk.AccAddressFromBech32(msg.Sender); err != nil {
+		return errorsmod.Wrap(err, "invalid sender address")
+	}
+	return nil
+}
+
+// GetSignBytes encodes the message for signing
+func (msg MsgRefreshTokenPairMetadata) GetSignBytes() []byte {
+	return sdk.MustSortJSON(AminoCdc.MustMarshalJSON(&msg))
+}
+
+// GetSigners defines whose signature is required
+func (msg MsgRefreshTokenPairMetadata) GetSigners() []sdk.AccAddress {
+	addr := sdk.MustAccAddressFromBech32(msg.Sender)
+	return []sdk.AccAddress{addr}
+}
+
+// NewMsgConvertNFT creates a new instance of MsgConvertNFT
+func NewMsgConvertNFT(classID, nftID string, receiver common.Address, sender sdk.AccAddress) *MsgConvertNFT { // nolint: interfacer
+	return &MsgConvertNFT{
+		ClassID:  classID,
+		NFTID:    nftID,
+		Receiver: receiver.Hex(),
+		Sender:   sender.String(),
+	}
+}
+
+// Route should return the name of the module
+func (msg MsgConvertNFT) Route() string { return RouterKey }
+
+// Type should return the action
+func (msg MsgConvertNFT) Type() string { return TypeMsgConvertNFT }
+
+// ValidateBasic runs stateless checks on the message
+func (msg MsgConvertNFT) ValidateBasic() error {
+	if err := nft.ValidateClassID(msg.ClassID); err != nil {
+		return err
+	}
+	if _, err := ParseNFTID(msg.NFTID); err != nil {
+		return errorsmod.Wrap(nft.ErrInvalidID, err.Error())
+	}
+	if _, err := sdk.AccAddressFromBech32(msg.Sender); err != nil {
+		return errorsmod.Wrap(err, "invalid sender address")
+	}
+	if !common.IsHexAddress(msg.Receiver) {
+		return errorsmod.Wrapf(errortypes.ErrInvalidAddress, "invalid receiver hex address %s", msg.Receiver)
+	}
+	return nil
+}
+
+// GetSignBytes encodes the message for signing
+func (msg MsgConvertNFT) GetSignBytes() []byte {
+	return sdk.MustSortJSON(AminoCdc.MustMarshalJSON(&msg))
+}
+
+// GetSigners defines whose signature is required
+func (msg MsgConvertNFT) GetSigners() []sdk.AccAddress {
+	addr := sdk.MustAccAddressFromBech32(msg.Sender)
+	return []sdk.AccAddress{addr}
+}
+
+// NewMsgConvertContractNFT creates a new instance of MsgConvertContractNFT
+func NewMsgConvertContractNFT(tokenID math.Int, receiver sdk.AccAddress, contract, sender common.Address) *MsgConvertContractNFT { // nolint: interfacer
+	return &MsgConvertContractNFT{
+		ContractAddress: contract.String(),
+		TokenID:         tokenID,
+		Receiver:        receiver.String(),
+		Sender:          sender.Hex(),
+	}
+}
+
+// Route should return the name of the module
+func (msg MsgConvertContractNFT) Route() string { return RouterKey }
+
+// Type should return the action
+func (msg MsgConvertContractNFT) Type() string { return TypeMsgConvertContractNFT }
+
+// ValidateBasic runs stateless checks on the message
+func (msg MsgConvertContractNFT) ValidateBasic() error {
+	if !common.IsHexAddress(msg.ContractAddress) {
+		return errorsmod.Wrapf(errortypes.ErrInvalidAddress, "invalid contract hex address '%s'", msg.ContractAddress)
+	}
+	if msg.TokenID.IsNil() || msg.TokenID.IsNegative() {
+		return errorsmod.Wrapf(nft.ErrInvalidID, "token id cannot be nil or negative")
+	}
+	if _, err := sdk.AccAddressFromBech32(msg.Receiver); err != nil {
+		return errorsmod.Wrap(err, "invalid receiver address")
+	}
+	if !common.IsHexAddress(msg.Sender) {
+		return errorsmod.Wrapf(errortypes.ErrInvalidAddress, "invalid sender hex address %s", msg.Sender)
+	}
+	return nil
+}
+
+// GetSignBytes encodes the message for signing
+func (msg MsgConvertContractNFT) GetSignBytes() []byte {
+	return sdk.MustSortJSON(AminoCdc.MustMarshalJSON(&msg))
+}
+
+// GetSigners defines whose signature is required
+func (msg MsgConvertContractNFT) GetSigners() []sdk.AccAddress {
+	addr := common.HexToAddress(msg.Sender)
+	return []sdk.AccAddress{addr.Bytes()}
+}
+
+// NewMsgRefundFailedConversion creates a new instance of MsgRefundFailedConversion
+func NewMsgRefundFailedConversion(txHash common.Hash, logIndex uint64, sender sdk.AccAddress) *MsgRefundFailedConversion { // nolint: interfacer
+	return &MsgRefundFailedConversion{
+		TxHash:   txHash.Hex(),
+		LogIndex: logIndex,
+		Sender:   sender.String(),
+	}
+}
+
+// Route should return the name of the module
+func (msg MsgRefundFailedConversion) Route() string { return RouterKey }
+
+// Type should return the action
+func (msg MsgRefundFailedConversion) Type() string { return TypeMsgRefundFailedConversion }
+
+// ValidateBasic runs stateless checks on the message
+func (msg MsgRefundFailedConversion) ValidateBasic() error {
+	if err := ValidateTxHash(msg.TxHash); err != nil {
+		return errorsmod.Wrap(errortypes.ErrInvalidRequest, err.Error())
+	}
+	if _, err := sdk.AccAddressFromBech32(msg.Sender); err != nil {
+		return errorsmod.Wrap(err, "invalid sender address")
+	}
+	return nil
+}
+
+// GetSignBytes encodes the message for signing
+func (msg MsgRefundFailedConversion) GetSignBytes() []byte {
+	return sdk.MustSortJSON(AminoCdc.MustMarshalJSON(&msg))
+}
+
+// GetSigners defines whose signature is required
+func (msg MsgRefundFailedConversion) GetSigners() []sdk.AccAddress {
+	addr := sdk.MustAccAddressFromBech32(msg.Sender)
+	return []sdk.AccAddress{addr}
+}
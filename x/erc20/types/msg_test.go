@@ -246,3 +246,555 @@ func (suite *MsgsTestSuite) TestMsgConvertERC20() {
 		}
 	}
 }
+
+func (suite *MsgsTestSuite) TestMsgRegisterERC20Getters() {
+	msgInvalid := MsgRegisterERC20{}
+	msg := NewMsgRegisterERC20(
+		tests.GenerateAddress(),
+		sdk.AccAddress(tests.GenerateAddress().Bytes()),
+	)
+	suite.Require().Equal(RouterKey, msg.Route())
+	suite.Require().Equal(TypeMsgRegisterERC20, msg.Type())
+	suite.Require().NotNil(msgInvalid.GetSignBytes())
+	suite.Require().NotNil(msg.GetSigners())
+}
+
+func (suite *MsgsTestSuite) TestMsgRegisterERC20() {
+	testCases := []struct {
+		msg        string
+		contract   string
+		sender     string
+		expectPass bool
+	}{
+		{
+			"invalid contract hex address",
+			sdk.AccAddress{}.String(),
+			sdk.AccAddress(tests.GenerateAddress().Bytes()).String(),
+			false,
+		},
+		{
+			"invalid sender address",
+			tests.GenerateAddress().String(),
+			tests.GenerateAddress().String(),
+			false,
+		},
+		{
+			"msg register erc20 - pass",
+			tests.GenerateAddress().String(),
+			sdk.AccAddress(tests.GenerateAddress().Bytes()).String(),
+			true,
+		},
+	}
+
+	for i, tc := range testCases {
+		tx := MsgRegisterERC20{tc.contract, tc.sender}
+		err := tx.ValidateBasic()
+
+		if tc.expectPass {
+			suite.Require().NoError(err, "valid test %d failed: %s, %v", i, tc.msg)
+		} else {
+			suite.Require().Error(err, "invalid test %d passed: %s, %v", i, tc.msg)
+		}
+	}
+}
+
+func (suite *MsgsTestSuite) TestMsgDeregisterERC20Getters() {
+	msgInvalid := MsgDeregisterERC20{}
+	msg := NewMsgDeregisterERC20(
+		tests.GenerateAddress(),
+		sdk.AccAddress(tests.GenerateAddress().Bytes()),
+	)
+	suite.Require().Equal(RouterKey, msg.Route())
+	suite.Require().Equal(TypeMsgDeregisterERC20, msg.Type())
+	suite.Require().NotNil(msgInvalid.GetSignBytes())
+	suite.Require().NotNil(msg.GetSigners())
+}
+
+func (suite *MsgsTestSuite) TestMsgDeregisterERC20() {
+	testCases := []struct {
+		msg        string
+		contract   string
+		sender     string
+		expectPass bool
+	}{
+		{
+			"invalid contract hex address",
+			sdk.AccAddress{}.String(),
+			sdk.AccAddress(tests.GenerateAddress().Bytes()).String(),
+			false,
+		},
+		{
+			"invalid sender address",
+			tests.GenerateAddress().String(),
+			tests.GenerateAddress().String(),
+			false,
+		},
+		{
+			"msg deregister erc20 - pass",
+			tests.GenerateAddress().String(),
+			sdk.AccAddress(tests.GenerateAddress().Bytes()).String(),
+			true,
+		},
+	}
+
+	for i, tc := range testCases {
+		tx := MsgDeregisterERC20{tc.contract, tc.sender}
+		err := tx.ValidateBasic()
+
+		if tc.expectPass {
+			suite.Require().NoError(err, "valid test %d failed: %s, %v", i, tc.msg)
+		} else {
+			suite.Require().Error(err, "invalid test %d passed: %s, %v", i, tc.msg)
+		}
+	}
+}
+
+func (suite *MsgsTestSuite) TestMsgConvertCoinsGetters() {
+	msgInvalid := MsgConvertCoins{}
+	msg := NewMsgConvertCoins(
+		sdk.NewCoins(sdk.NewCoin("test", sdk.NewInt(100))),
+		tests.GenerateAddress(),
+		sdk.AccAddress(tests.GenerateAddress().Bytes()),
+	)
+	suite.Require().Equal(RouterKey, msg.Route())
+	suite.Require().Equal(TypeMsgConvertCoins, msg.Type())
+	suite.Require().NotNil(msgInvalid.GetSignBytes())
+	suite.Require().NotNil(msg.GetSigners())
+}
+
+func (suite *MsgsTestSuite) TestMsgConvertCoins() {
+	testCases := []struct {
+		msg        string
+		coins      sdk.Coins
+		receiver   string
+		sender     string
+		expectPass bool
+	}{
+		{
+			"empty coins",
+			sdk.Coins{},
+			tests.GenerateAddress().String(),
+			sdk.AccAddress(tests.GenerateAddress().Bytes()).String(),
+			false,
+		},
+		{
+			"unsorted coins",
+			sdk.Coins{sdk.NewCoin("test2", sdk.NewInt(100)), sdk.NewCoin("test1", sdk.NewInt(100))},
+			tests.GenerateAddress().String(),
+			sdk.AccAddress(tests.GenerateAddress().Bytes()).String(),
+			false,
+		},
+		{
+			"non-positive amount",
+			sdk.Coins{sdk.Coin{Denom: "test", Amount: sdk.NewInt(0)}},
+			tests.GenerateAddress().String(),
+			sdk.AccAddress(tests.GenerateAddress().Bytes()).String(),
+			false,
+		},
+		{
+			"invalid denom",
+			sdk.Coins{sdk.Coin{Denom: "ibc/1", Amount: sdk.NewInt(100)}, sdk.NewCoin("ibc/7F1D3FCF4AE79E1554D670D1AD949A9BA4E4A3C76C63093E17E446A46061A7A2", sdk.NewInt(100))},
+			tests.GenerateAddress().String(),
+			sdk.AccAddress(tests.GenerateAddress().Bytes()).String(),
+			false,
+		},
+		{
+			"invalid sender address",
+			sdk.NewCoins(sdk.NewCoin("test", sdk.NewInt(100))),
+			tests.GenerateAddress().String(),
+			tests.GenerateAddress().String(),
+			false,
+		},
+		{
+			"invalid receiver hex address",
+			sdk.NewCoins(sdk.NewCoin("test", sdk.NewInt(100))),
+			"0x0000",
+			sdk.AccAddress(tests.GenerateAddress().Bytes()).String(),
+			false,
+		},
+		{
+			"msg convert coins - pass",
+			sdk.NewCoins(sdk.NewCoin("test1", sdk.NewInt(100)), sdk.NewCoin("test2", sdk.NewInt(10))),
+			tests.GenerateAddress().String(),
+			sdk.AccAddress(tests.GenerateAddress().Bytes()).String(),
+			true,
+		},
+	}
+
+	for i, tc := range testCases {
+		tx := MsgConvertCoins{tc.coins, tc.receiver, tc.sender}
+		err := tx.ValidateBasic()
+
+		if tc.expectPass {
+			suite.Require().NoError(err, "valid test %d failed: %s, %v", i, tc.msg)
+		} else {
+			suite.Require().Error(err, "invalid test %d passed: %s, %v", i, tc.msg)
+		}
+	}
+}
+
+func (suite *MsgsTestSuite) TestMsgConvertERC20SGetters() {
+	msgInvalid := MsgConvertERC20S{}
+	msg := NewMsgConvertERC20S(
+		[]ERC20Amount{NewERC20Amount(tests.GenerateAddress(), sdk.NewInt(100))},
+		sdk.AccAddress(tests.GenerateAddress().Bytes()),
+		tests.GenerateAddress(),
+	)
+	suite.Require().Equal(RouterKey, msg.Route())
+	suite.Require().Equal(TypeMsgConvertERC20S, msg.Type())
+	suite.Require().NotNil(msgInvalid.GetSignBytes())
+	suite.Require().NotNil(msg.GetSigners())
+}
+
+func (suite *MsgsTestSuite) TestMsgConvertERC20S() {
+	contract := tests.GenerateAddress()
+
+	testCases := []struct {
+		msg        string
+		tokens     []ERC20Amount
+		receiver   string
+		sender     string
+		expectPass bool
+	}{
+		{
+			"empty tokens",
+			[]ERC20Amount{},
+			sdk.AccAddress(tests.GenerateAddress().Bytes()).String(),
+			tests.GenerateAddress().String(),
+			false,
+		},
+		{
+			"invalid contract hex address",
+			[]ERC20Amount{{ContractAddress: "0x0000", Amount: sdk.NewInt(100)}},
+			sdk.AccAddress(tests.GenerateAddress().Bytes()).String(),
+			tests.GenerateAddress().String(),
+			false,
+		},
+		{
+			"duplicate contract",
+			[]ERC20Amount{NewERC20Amount(contract, sdk.NewInt(100)), NewERC20Amount(contract, sdk.NewInt(10))},
+			sdk.AccAddress(tests.GenerateAddress().Bytes()).String(),
+			tests.GenerateAddress().String(),
+			false,
+		},
+		{
+			"nil amount",
+			[]ERC20Amount{{ContractAddress: contract.String()}},
+			sdk.AccAddress(tests.GenerateAddress().Bytes()).String(),
+			tests.GenerateAddress().String(),
+			false,
+		},
+		{
+			"non-positive amount",
+			[]ERC20Amount{NewERC20Amount(contract, sdk.NewInt(-1))},
+			sdk.AccAddress(tests.GenerateAddress().Bytes()).String(),
+			tests.GenerateAddress().String(),
+			false,
+		},
+		{
+			"invalid receiver address",
+			[]ERC20Amount{NewERC20Amount(contract, sdk.NewInt(100))},
+			tests.GenerateAddress().String(),
+			tests.GenerateAddress().String(),
+			false,
+		},
+		{
+			"invalid sender hex address",
+			[]ERC20Amount{NewERC20Amount(contract, sdk.NewInt(100))},
+			sdk.AccAddress(tests.GenerateAddress().Bytes()).String(),
+			sdk.AccAddress(tests.GenerateAddress().Bytes()).String(),
+			false,
+		},
+		{
+			"msg convert erc20s - pass",
+			[]ERC20Amount{NewERC20Amount(contract, sdk.NewInt(100)), NewERC20Amount(tests.GenerateAddress(), sdk.NewInt(10))},
+			sdk.AccAddress(tests.GenerateAddress().Bytes()).String(),
+			tests.GenerateAddress().String(),
+			true,
+		},
+	}
+
+	for i, tc := range testCases {
+		tx := MsgConvertERC20S{tc.tokens, tc.receiver, tc.sender}
+		err := tx.ValidateBasic()
+
+		if tc.expectPass {
+			suite.Require().NoError(err, "valid test %d failed: %s, %v", i, tc.msg)
+		} else {
+			suite.Require().Error(err, "invalid test %d passed: %s, %v", i, tc.msg)
+		}
+	}
+}
+
+func (suite *MsgsTestSuite) TestMsgRefreshTokenPairMetadataGetters() {
+	msgInvalid := MsgRefreshTokenPairMetadata{}
+	msg := NewMsgRefreshTokenPairMetadata(
+		tests.GenerateAddress(),
+		sdk.AccAddress(tests.GenerateAddress().Bytes()),
+	)
+	suite.Require().Equal(RouterKey, msg.Route())
+	suite.Require().Equal(TypeMsgRefreshTokenPairMetadata, msg.Type())
+	suite.Require().NotNil(msgInvalid.GetSignBytes())
+	suite.Require().NotNil(msg.GetSigners())
+}
+
+func (suite *MsgsTestSuite) TestMsgRefreshTokenPairMetadata() {
+	testCases := []struct {
+		msg        string
+		contract   string
+		sender     string
+		expectPass bool
+	}{
+		{
+			"invalid contract hex address",
+			sdk.AccAddress{}.String(),
+			sdk.AccAddress(tests.GenerateAddress().Bytes()).String(),
+			false,
+		},
+		{
+			"invalid sender address",
+			tests.GenerateAddress().String(),
+			tests.GenerateAddress().String(),
+			false,
+		},
+		{
+			"msg refresh token pair metadata - pass",
+			tests.GenerateAddress().String(),
+			sdk.AccAddress(tests.GenerateAddress().Bytes()).String(),
+			true,
+		},
+	}
+
+	for i, tc := range testCases {
+		tx := MsgRefreshTokenPairMetadata{tc.contract, tc.sender}
+		err := tx.ValidateBasic()
+
+		if tc.expectPass {
+			suite.Require().NoError(err, "valid test %d failed: %s, %v", i, tc.msg)
+		} else {
+			suite.Require().Error(err, "invalid test %d passed: %s, %v", i, tc.msg)
+		}
+	}
+}
+
+func (suite *MsgsTestSuite) TestMsgConvertNFTGetters() {
+	msgInvalid := MsgConvertNFT{}
+	msg := NewMsgConvertNFT(
+		CreateNFTClassID(NFT_STANDARD_ERC721, tests.GenerateAddress()),
+		"id:1",
+		tests.GenerateAddress(),
+		sdk.AccAddress(tests.GenerateAddress().Bytes()),
+	)
+	suite.Require().Equal(RouterKey, msg.Route())
+	suite.Require().Equal(TypeMsgConvertNFT, msg.Type())
+	suite.Require().NotNil(msgInvalid.GetSignBytes())
+	suite.Require().NotNil(msg.GetSigners())
+}
+
+func (suite *MsgsTestSuite) TestMsgConvertNFT() {
+	classID := CreateNFTClassID(NFT_STANDARD_ERC721, tests.GenerateAddress())
+
+	testCases := []struct {
+		msg        string
+		classID    string
+		nftID      string
+		receiver   string
+		sender     string
+		expectPass bool
+	}{
+		{
+			"invalid class id",
+			"1erc721",
+			"id:1",
+			tests.GenerateAddress().String(),
+			sdk.AccAddress(tests.GenerateAddress().Bytes()).String(),
+			false,
+		},
+		{
+			"invalid nft id",
+			classID,
+			"1",
+			tests.GenerateAddress().String(),
+			sdk.AccAddress(tests.GenerateAddress().Bytes()).String(),
+			false,
+		},
+		{
+			"invalid receiver hex address",
+			classID,
+			"id:1",
+			sdk.AccAddress(tests.GenerateAddress().Bytes()).String(),
+			sdk.AccAddress(tests.GenerateAddress().Bytes()).String(),
+			false,
+		},
+		{
+			"invalid sender address",
+			classID,
+			"id:1",
+			tests.GenerateAddress().String(),
+			tests.GenerateAddress().String(),
+			false,
+		},
+		{
+			"msg convert nft - pass",
+			classID,
+			"id:1",
+			tests.GenerateAddress().String(),
+			sdk.AccAddress(tests.GenerateAddress().Bytes()).String(),
+			true,
+		},
+	}
+
+	for i, tc := range testCases {
+		tx := MsgConvertNFT{tc.classID, tc.nftID, tc.receiver, tc.sender}
+		err := tx.ValidateBasic()
+
+		if tc.expectPass {
+			suite.Require().NoError(err, "valid test %d failed: %s, %v", i, tc.msg)
+		} else {
+			suite.Require().Error(err, "invalid test %d passed: %s, %v", i, tc.msg)
+		}
+	}
+}
+
+func (suite *MsgsTestSuite) TestMsgConvertContractNFTGetters() {
+	msgInvalid := MsgConvertContractNFT{}
+	msg := NewMsgConvertContractNFT(
+		math.NewInt(1),
+		sdk.AccAddress(tests.GenerateAddress().Bytes()),
+		tests.GenerateAddress(),
+		tests.GenerateAddress(),
+	)
+	suite.Require().Equal(RouterKey, msg.Route())
+	suite.Require().Equal(TypeMsgConvertContractNFT, msg.Type())
+	suite.Require().NotNil(msgInvalid.GetSignBytes())
+	suite.Require().NotNil(msg.GetSigners())
+}
+
+func (suite *MsgsTestSuite) TestMsgConvertContractNFT() {
+	testCases := []struct {
+		msg        string
+		contract   string
+		tokenID    math.Int
+		receiver   string
+		sender     string
+		expectPass bool
+	}{
+		{
+			"invalid contract hex address",
+			sdk.AccAddress{}.String(),
+			math.NewInt(1),
+			sdk.AccAddress(tests.GenerateAddress().Bytes()).String(),
+			tests.GenerateAddress().String(),
+			false,
+		},
+		{
+			"nil token id",
+			tests.GenerateAddress().String(),
+			math.Int{},
+			sdk.AccAddress(tests.GenerateAddress().Bytes()).String(),
+			tests.GenerateAddress().String(),
+			false,
+		},
+		{
+			"negative token id",
+			tests.GenerateAddress().String(),
+			math.NewInt(-1),
+			sdk.AccAddress(tests.GenerateAddress().Bytes()).String(),
+			tests.GenerateAddress().String(),
+			false,
+		},
+		{
+			"invalid receiver address",
+			tests.GenerateAddress().String(),
+			math.NewInt(1),
+			tests.GenerateAddress().String(),
+			tests.GenerateAddress().String(),
+			false,
+		},
+		{
+			"invalid sender hex address",
+			tests.GenerateAddress().String(),
+			math.NewInt(1),
+			sdk.AccAddress(tests.GenerateAddress().Bytes()).String(),
+			sdk.AccAddress(tests.GenerateAddress().Bytes()).String(),
+			false,
+		},
+		{
+			"msg convert contract nft - zero token id",
+			tests.GenerateAddress().String(),
+			math.ZeroInt(),
+			sdk.AccAddress(tests.GenerateAddress().Bytes()).String(),
+			tests.GenerateAddress().String(),
+			true,
+		},
+	}
+
+	for i, tc := range testCases {
+		tx := MsgConvertContractNFT{tc.contract, tc.tokenID, tc.receiver, tc.sender}
+		err := tx.ValidateBasic()
+
+		if tc.expectPass {
+			suite.Require().NoError(err, "valid test %d failed: %s, %v", i, tc.msg)
+		} else {
+			suite.Require().Error(err, "invalid test %d passed: %s, %v", i, tc.msg)
+		}
+	}
+}
+
+func (suite *MsgsTestSuite) TestMsgRefundFailedConversionGetters() {
+	msgInvalid := MsgRefundFailedConversion{}
+	msg := NewMsgRefundFailedConversion(
+		common.BytesToHash([]byte("tx")),
+		0,
+		sdk.AccAddress(tests.GenerateAddress().Bytes()),
+	)
+	suite.Require().Equal(RouterKey, msg.Route())
+	suite.Require().Equal(TypeMsgRefundFailedConversion, msg.Type())
+	suite.Require().NotNil(msgInvalid.GetSignBytes())
+	suite.Require().NotNil(msg.GetSigners())
+}
+
+func (suite *MsgsTestSuite) TestMsgRefundFailedConversion() {
+	testCases := []struct {
+		msg        string
+		txHash     string
+		sender     string
+		expectPass bool
+	}{
+		{
+			"invalid tx hash",
+			"0x1234",
+			sdk.AccAddress(tests.GenerateAddress().Bytes()).String(),
+			false,
+		},
+		{
+			"tx hash without prefix",
+			"1234567890123456789012345678901234567890123456789012345678901234",
+			sdk.AccAddress(tests.GenerateAddress().Bytes()).String(),
+			false,
+		},
+		{
+			"invalid sender address",
+			common.BytesToHash([]byte("tx")).Hex(),
+			tests.GenerateAddress().String(),
+			false,
+		},
+		{
+			"msg refund failed conversion - pass",
+			common.BytesToHash([]byte("tx")).Hex(),
+			sdk.AccAddress(tests.GenerateAddress().Bytes()).String(),
+			true,
+		},
+	}
+
+	for i, tc := range testCases {
+		tx := MsgRefundFailedConversion{tc.txHash, 1, tc.sender}
+		err := tx.ValidateBasic()
+
+		if tc.expectPass {
+			suite.Require().NoError(err, "valid test %d failed: %s, %v", i, tc.msg)
+		} else {
+			suite.Require().Error(err, "invalid test %d passed: %s, %v", i, tc.msg)
+		}
+	}
+}
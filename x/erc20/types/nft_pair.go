@@ -0,0 +1,85 @@
+package types
+
+import (
+	"fmt"
+	"math/big"
+	"strings"
+
+	errorsmod "cosmossdk.io/errors"
+	"github.com/ethereum/go-ethereum/common"
+	ethermint "github.com/evmos/ethermint/types"
+)
+
+// nftIDPrefix is prepended to the decimal contract token identifier, as x/nft
+// token identifiers must start with a letter
+const nftIDPrefix = "id:"
+
+// NewNFTPair returns an instance of NFTPair
+func NewNFTPair(contract common.Address, standard NFTStandard, classURI, classData string) NFTPair {
+	return NFTPair{
+		ContractAddress: contract.String(),
+		ClassID:         CreateNFTClassID(standard, contract),
+		Standard:        standard,
+		ClassURI:        classURI,
+		ClassData:       classData,
+	}
+}
+
+// GetContract casts the hex string address of the contract to common.Address
+func (np NFTPair) GetContract() common.Address {
+	return common.HexToAddress(np.ContractAddress)
+}
+
+// Validate performs a stateless validation of an NFTPair
+func (np NFTPair) Validate() error {
+	if err := ethermint.ValidateAddress(np.ContractAddress); err != nil {
+		return err
+	}
+
+	if err := ValidateNFTStandard(np.Standard); err != nil {
+		return err
+	}
+
+	if expClassID := CreateNFTClassID(np.Standard, np.GetContract()); np.ClassID != expClassID {
+		return fmt.Errorf("invalid class id for NFT pair, expected %s, got %s", expClassID, np.ClassID)
+	}
+
+	return nil
+}
+
+// ValidateNFTStandard checks that the token standard of an NFT pair is defined
+func ValidateNFTStandard(standard NFTStandard) error {
+	switch standard {
+	case NFT_STANDARD_ERC721, NFT_STANDARD_ERC1155:
+		return nil
+	default:
+		return errorsmod.Wrapf(ErrUndefinedNFTStandard, "%s", standard)
+	}
+}
+
+// CreateNFTClassID generates the x/nft class identifier of an ERC721 or ERC1155
+// contract, e.g. erc721:0x...
+func CreateNFTClassID(standard NFTStandard, contract common.Address) string {
+	name := strings.ToLower(strings.TrimPrefix(standard.String(), "NFT_STANDARD_"))
+	return fmt.Sprintf("%s:%s", name, contract.String())
+}
+
+// CreateNFTID generates the x/nft token identifier of a contract token
+// identifier, e.g. id:42
+func CreateNFTID(tokenID *big.Int) string {
+	return nftIDPrefix + tokenID.String()
+}
+
+// ParseNFTID returns the contract token identifier of an x/nft token identifier
+func ParseNFTID(nftID string) (*big.Int, error) {
+	if !strings.HasPrefix(nftID, nftIDPrefix) {
+		return nil, fmt.Errorf("invalid nft id %s, must have the prefix %s", nftID, nftIDPrefix)
+	}
+
+	tokenID, ok := new(big.Int).SetString(strings.TrimPrefix(nftID, nftIDPrefix), 10)
+	if !ok || tokenID.Sign() < 0 || tokenID.BitLen() > 256 {
+		return nil, fmt.Errorf("invalid nft id %s, must be followed by a uint256 token id", nftID)
+	}
+
+	return tokenID, nil
+}
@@ -0,0 +1,83 @@
+package types
+
+import (
+	"math/big"
+	"testing"
+
+	"github.com/stretchr/testify/suite"
+
+	"github.com/evmos/ethermint/tests"
+)
+
+type NFTPairTestSuite struct {
+	suite.Suite
+}
+
+func TestNFTPairSuite(t *testing.T) {
+	suite.Run(t, new(NFTPairTestSuite))
+}
+
+func (suite *NFTPairTestSuite) TestNFTPairValidate() {
+	contract := tests.GenerateAddress()
+
+	testCases := []struct {
+		msg        string
+		pair       NFTPair
+		expectPass bool
+	}{
+		{msg: "invalid address (no hex)", pair: NFTPair{"0x5dCA2483280D9727c80b5518faC4556617fb19ZZ", "erc721:0x5dCA2483280D9727c80b5518faC4556617fb19ZZ", NFT_STANDARD_ERC721, "", ""}, expectPass: false},
+		{msg: "unspecified standard", pair: NewNFTPair(contract, NFT_STANDARD_UNSPECIFIED, "", ""), expectPass: false},
+		{msg: "class id of another contract", pair: NFTPair{contract.String(), CreateNFTClassID(NFT_STANDARD_ERC721, tests.GenerateAddress()), NFT_STANDARD_ERC721, "", ""}, expectPass: false},
+		{msg: "class id of another standard", pair: NFTPair{contract.String(), CreateNFTClassID(NFT_STANDARD_ERC1155, contract), NFT_STANDARD_ERC721, "", ""}, expectPass: false},
+		{msg: "pass - erc721", pair: NewNFTPair(contract, NFT_STANDARD_ERC721, "ipfs://class", "{}"), expectPass: true},
+		{msg: "pass - erc1155", pair: NewNFTPair(contract, NFT_STANDARD_ERC1155, "", ""), expectPass: true},
+	}
+
+	for i, tc := range testCases {
+		err := tc.pair.Validate()
+
+		if tc.expectPass {
+			suite.Require().NoError(err, "valid test %d failed: %s, %v", i, tc.msg)
+		} else {
+			suite.Require().Error(err, "invalid test %d passed: %s, %v", i, tc.msg)
+		}
+	}
+}
+
+func (suite *NFTPairTestSuite) TestCreateNFTClassID() {
+	contract := tests.GenerateAddress()
+
+	suite.Require().Equal("erc721:"+contract.String(), CreateNFTClassID(NFT_STANDARD_ERC721, contract))
+	suite.Require().Equal("erc1155:"+contract.String(), CreateNFTClassID(NFT_STANDARD_ERC1155, contract))
+}
+
+func (suite *NFTPairTestSuite) TestParseNFTID() {
+	maxUint256 := new(big.Int).Sub(new(big.Int).Lsh(big.NewInt(1), 256), big.NewInt(1))
+
+	testCases := []struct {
+		msg        string
+		nftID      string
+		expTokenID *big.Int
+		expectPass bool
+	}{
+		{msg: "missing prefix", nftID: "42", expectPass: false},
+		{msg: "missing token id", nftID: "id:", expectPass: false},
+		{msg: "non decimal token id", nftID: "id:0x2a", expectPass: false},
+		{msg: "negative token id", nftID: "id:-1", expectPass: false},
+		{msg: "token id overflows uint256", nftID: CreateNFTID(new(big.Int).Add(maxUint256, big.NewInt(1))), expectPass: false},
+		{msg: "pass - zero", nftID: "id:0", expTokenID: big.NewInt(0), expectPass: true},
+		{msg: "pass - max uint256", nftID: CreateNFTID(maxUint256), expTokenID: maxUint256, expectPass: true},
+	}
+
+	for i, tc := range testCases {
+		tokenID, err := ParseNFTID(tc.nftID)
+
+		if tc.expectPass {
+			suite.Require().NoError(err, "valid test %d failed: %s, %v", i, tc.msg)
+			suite.Require().Equal(0, tc.expTokenID.Cmp(tokenID), tc.msg)
+			suite.Require().Equal(tc.nftID, CreateNFTID(tokenID), tc.msg)
+		} else {
+			suite.Require().Error(err, "invalid test %d passed: %s, %v", i, tc.msg)
+		}
+	}
+}
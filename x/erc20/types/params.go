@@ -3,13 +3,22 @@ package types
 import (
 	fmt "fmt"
 
+	"cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
 	paramtypes "github.com/cosmos/cosmos-sdk/x/params/types"
 )
 
+// DefaultRegistrationDeposit is 100 EVMOS
+var DefaultRegistrationDeposit = sdk.NewCoins(sdk.NewCoin("aevmos", math.NewIntWithDecimal(100, 18)))
+
 // Parameter store key
 var (
 	ParamStoreKeyEnableErc20   = []byte("EnableErc20")
 	ParamStoreKeyEnableEVMHook = []byte("EnableEVMHook")
+
+	ParamStoreKeyEnablePermissionlessRegistration = []byte("EnablePermissionlessRegistration")
+	ParamStoreKeyRegistrationDeposit              = []byte("RegistrationDeposit")
+	ParamStoreKeyEnableIBCReceiveSweep            = []byte("EnableIBCReceiveSweep")
 )
 
 var _ paramtypes.ParamSet = &Params{}
@@ -23,17 +32,26 @@ func ParamKeyTable() paramtypes.KeyTable {
 func NewParams(
 	enableErc20 bool,
 	enableEVMHook bool,
+	enablePermissionlessRegistration bool,
+	registrationDeposit sdk.Coins,
+	enableIBCReceiveSweep bool,
 ) Params {
 	return Params{
-		EnableErc20:   enableErc20,
-		EnableEVMHook: enableEVMHook,
+		EnableErc20:                      enableErc20,
+		EnableEVMHook:                    enableEVMHook,
+		EnablePermissionlessRegistration: enablePermissionlessRegistration,
+		RegistrationDeposit:              registrationDeposit,
+		EnableIBCReceiveSweep:            enableIBCReceiveSweep,
 	}
 }
 
 func DefaultParams() Params {
 	return Params{
-		EnableErc20:   true,
-		EnableEVMHook: true,
+		EnableErc20:                      true,
+		EnableEVMHook:                    true,
+		EnablePermissionlessRegistration: false,
+		RegistrationDeposit:              DefaultRegistrationDeposit,
+		EnableIBCReceiveSweep:            false,
 	}
 }
 
@@ -46,12 +64,26 @@ func validateBool(i interface{}) error {
 	return nil
 }
 
+func validateCoins(i interface{}) error {
+	coins, ok := i.(sdk.Coins)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+
+	return coins.Validate()
+}
+
 // ParamSetPairs returns the parameter set pairs.
 func (p *Params) ParamSetPairs() paramtypes.ParamSetPairs {
 	return paramtypes.ParamSetPairs{
 		paramtypes.NewParamSetPair(ParamStoreKeyEnableErc20, &p.EnableErc20, validateBool),
 		paramtypes.NewParamSetPair(ParamStoreKeyEnableEVMHook, &p.EnableEVMHook, validateBool),
+		paramtypes.NewParamSetPair(ParamStoreKeyEnablePermissionlessRegistration, &p.EnablePermissionlessRegistration, validateBool),
+		paramtypes.NewParamSetPair(ParamStoreKeyRegistrationDeposit, &p.RegistrationDeposit, validateCoins),
+		paramtypes.NewParamSetPair(ParamStoreKeyEnableIBCReceiveSweep, &p.EnableIBCReceiveSweep, validateBool),
 	}
 }
 
-func (p Params) Validate() error { return nil }
+func (p Params) Validate() error {
+	return validateCoins(p.RegistrationDeposit)
+}
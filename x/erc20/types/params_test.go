@@ -3,6 +3,7 @@ package types
 import (
 	"testing"
 
+	sdk "github.com/cosmos/cosmos-sdk/types"
 	paramtypes "github.com/cosmos/cosmos-sdk/x/params/types"
 	"github.com/stretchr/testify/suite"
 )
@@ -28,9 +29,24 @@ func (suite *ParamsTestSuite) TestParamsValidate() {
 		{"default", DefaultParams(), false},
 		{
 			"valid",
-			NewParams(true, true),
+			NewParams(true, true, true, DefaultRegistrationDeposit, false),
 			false,
 		},
+		{
+			"valid - ibc receive sweep",
+			NewParams(true, true, true, DefaultRegistrationDeposit, true),
+			false,
+		},
+		{
+			"valid - no registration deposit",
+			NewParams(true, true, true, sdk.Coins{}, false),
+			false,
+		},
+		{
+			"invalid - registration deposit with zero amount",
+			NewParams(true, true, true, sdk.Coins{sdk.Coin{Denom: "aevmos", Amount: sdk.ZeroInt()}}, false),
+			true,
+		},
 		{
 			"empty",
 			Params{},
@@ -52,4 +68,6 @@ func (suite *ParamsTestSuite) TestParamsValidate() {
 func (suite *ParamsTestSuite) TestParamsValidatePriv() {
 	suite.Require().Error(validateBool(1))
 	suite.Require().NoError(validateBool(true))
+	suite.Require().Error(validateCoins(true))
+	suite.Require().NoError(validateCoins(DefaultRegistrationDeposit))
 }
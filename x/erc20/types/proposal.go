@@ -5,34 +5,66 @@ import (
 	"strings"
 
 	errorsmod "cosmossdk.io/errors"
+	"cosmossdk.io/math"
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	errortypes "github.com/cosmos/cosmos-sdk/types/errors"
 	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
 	v1beta1 "github.com/cosmos/cosmos-sdk/x/gov/types/v1beta1"
 	ibctransfertypes "github.com/cosmos/ibc-go/v5/modules/apps/transfer/types"
 	ethermint "github.com/evmos/ethermint/types"
+
+	epochstypes "github.com/evmos/evmos/v10/x/epochs/types"
 )
 
 // constants
 const (
-	ProposalTypeRegisterCoin          string = "RegisterCoin"
-	ProposalTypeRegisterERC20         string = "RegisterERC20"
-	ProposalTypeToggleTokenConversion string = "ToggleTokenConversion" // #nosec
+	ProposalTypeRegisterCoin             string = "RegisterCoin"
+	ProposalTypeRegisterERC20            string = "RegisterERC20"
+	ProposalTypeToggleTokenConversion    string = "ToggleTokenConversion" // #nosec
+	ProposalTypeSlashRegistrationDeposit string = "SlashRegistrationDeposit"
+	ProposalTypeDeregisterTokenPair      string = "DeregisterTokenPair"
+	ProposalTypeSetTokenPairRateLimit    string = "SetTokenPairRateLimit"
+	ProposalTypeRegisterERC20Template    string = "RegisterERC20Template"
+	ProposalTypeRemoveERC20Template      string = "RemoveERC20Template"
+	ProposalTypeRegisterNFTPair          string = "RegisterNFTPair"
 )
 
+// MaxDeregistrationHolders is the maximum number of Cosmos coin holders of a
+// native ERC20 token pair that are unwound by a single deregistration, which
+// bounds the work performed when the proposal is executed.
+const MaxDeregistrationHolders = 100
+
 // Implements Proposal Interface
 var (
 	_ v1beta1.Content = &RegisterCoinProposal{}
 	_ v1beta1.Content = &RegisterERC20Proposal{}
 	_ v1beta1.Content = &ToggleTokenConversionProposal{}
+	_ v1beta1.Content = &SlashRegistrationDepositProposal{}
+	_ v1beta1.Content = &DeregisterTokenPairProposal{}
+	_ v1beta1.Content = &SetTokenPairRateLimitProposal{}
+	_ v1beta1.Content = &RegisterERC20TemplateProposal{}
+	_ v1beta1.Content = &RemoveERC20TemplateProposal{}
+	_ v1beta1.Content = &RegisterNFTPairProposal{}
 )
 
 func init() {
 	v1beta1.RegisterProposalType(ProposalTypeRegisterCoin)
 	v1beta1.RegisterProposalType(ProposalTypeRegisterERC20)
 	v1beta1.RegisterProposalType(ProposalTypeToggleTokenConversion)
+	v1beta1.RegisterProposalType(ProposalTypeSlashRegistrationDeposit)
+	v1beta1.RegisterProposalType(ProposalTypeDeregisterTokenPair)
+	v1beta1.RegisterProposalType(ProposalTypeSetTokenPairRateLimit)
+	v1beta1.RegisterProposalType(ProposalTypeRegisterERC20Template)
+	v1beta1.RegisterProposalType(ProposalTypeRemoveERC20Template)
+	v1beta1.RegisterProposalType(ProposalTypeRegisterNFTPair)
 	v1beta1.ModuleCdc.Amino.RegisterConcrete(&RegisterCoinProposal{}, "erc20/RegisterCoinProposal", nil)
 	v1beta1.ModuleCdc.Amino.RegisterConcrete(&RegisterERC20Proposal{}, "erc20/RegisterERC20Proposal", nil)
 	v1beta1.ModuleCdc.Amino.RegisterConcrete(&ToggleTokenConversionProposal{}, "erc20/ToggleTokenConversionProposal", nil)
+	v1beta1.ModuleCdc.Amino.RegisterConcrete(&SlashRegistrationDepositProposal{}, "erc20/SlashRegistrationDepositProposal", nil)
+	v1beta1.ModuleCdc.Amino.RegisterConcrete(&DeregisterTokenPairProposal{}, "erc20/DeregisterTokenPairProposal", nil)
+	v1beta1.ModuleCdc.Amino.RegisterConcrete(&SetTokenPairRateLimitProposal{}, "erc20/SetTokenPairRateLimitProposal", nil)
+	v1beta1.ModuleCdc.Amino.RegisterConcrete(&RegisterERC20TemplateProposal{}, "erc20/RegisterERC20TemplateProposal", nil)
+	v1beta1.ModuleCdc.Amino.RegisterConcrete(&RemoveERC20TemplateProposal{}, "erc20/RemoveERC20TemplateProposal", nil)
 }
 
 // CreateDenomDescription generates a string with the coin description
@@ -45,12 +77,14 @@ func CreateDenom(address string) string {
 	return fmt.Sprintf("%s/%s", ModuleName, address)
 }
 
-// NewRegisterCoinProposal returns new instance of RegisterCoinProposal
-func NewRegisterCoinProposal(title, description string, coinMetadata ...banktypes.Metadata) v1beta1.Content {
+// NewRegisterCoinProposal returns new instance of RegisterCoinProposal. The
+// default ERC20 template is deployed if the template ID is empty.
+func NewRegisterCoinProposal(title, description, templateID string, coinMetadata ...banktypes.Metadata) v1beta1.Content {
 	return &RegisterCoinProposal{
 		Title:       title,
 		Description: description,
 		Metadata:    coinMetadata,
+		TemplateID:  templateID,
 	}
 }
 
@@ -85,6 +119,12 @@ func (rtbp *RegisterCoinProposal) ValidateBasic() error {
 		}
 	}
 
+	if rtbp.TemplateID != "" {
+		if err := ValidateERC20TemplateID(rtbp.TemplateID); err != nil {
+			return err
+		}
+	}
+
 	return v1beta1.ValidateAbstract(rtbp)
 }
 
@@ -176,3 +216,207 @@ func (ttcp *ToggleTokenConversionProposal) ValidateBasic() error {
 
 	return v1beta1.ValidateAbstract(ttcp)
 }
+
+// NewSlashRegistrationDepositProposal returns new instance of SlashRegistrationDepositProposal
+func NewSlashRegistrationDepositProposal(title, description string, erc20Addreses ...string) v1beta1.Content {
+	return &SlashRegistrationDepositProposal{
+		Title:          title,
+		Description:    description,
+		Erc20Addresses: erc20Addreses,
+	}
+}
+
+// ProposalRoute returns router key for this proposal
+func (*SlashRegistrationDepositProposal) ProposalRoute() string { return RouterKey }
+
+// ProposalType returns proposal type for this proposal
+func (*SlashRegistrationDepositProposal) ProposalType() string {
+	return ProposalTypeSlashRegistrationDeposit
+}
+
+// ValidateBasic performs a stateless check of the proposal fields
+func (srdp *SlashRegistrationDepositProposal) ValidateBasic() error {
+	if len(srdp.Erc20Addresses) == 0 {
+		return errorsmod.Wrap(errortypes.ErrInvalidRequest, "ERC20 addresses cannot be empty")
+	}
+
+	for _, address := range srdp.Erc20Addresses {
+		if err := ethermint.ValidateAddress(address); err != nil {
+			return errorsmod.Wrap(err, "ERC20 address")
+		}
+	}
+
+	return v1beta1.ValidateAbstract(srdp)
+}
+
+// NewDeregisterTokenPairProposal returns new instance of DeregisterTokenPairProposal
+func NewDeregisterTokenPairProposal(title, description string, token string) v1beta1.Content {
+	return &DeregisterTokenPairProposal{
+		Title:       title,
+		Description: description,
+		Token:       token,
+	}
+}
+
+// ProposalRoute returns router key for this proposal
+func (*DeregisterTokenPairProposal) ProposalRoute() string { return RouterKey }
+
+// ProposalType returns proposal type for this proposal
+func (*DeregisterTokenPairProposal) ProposalType() string {
+	return ProposalTypeDeregisterTokenPair
+}
+
+// ValidateBasic performs a stateless check of the proposal fields
+func (dtpp *DeregisterTokenPairProposal) ValidateBasic() error {
+	// check if the token is a hex address, if not, check if it is a valid SDK
+	// denom
+	if err := ethermint.ValidateAddress(dtpp.Token); err != nil {
+		if err := sdk.ValidateDenom(dtpp.Token); err != nil {
+			return err
+		}
+	}
+
+	return v1beta1.ValidateAbstract(dtpp)
+}
+
+// NewSetTokenPairRateLimitProposal returns new instance of SetTokenPairRateLimitProposal
+func NewSetTokenPairRateLimitProposal(
+	title, description, token, epochIdentifier string,
+	coinToERC20Limit, erc20ToCoinLimit math.Int,
+) v1beta1.Content {
+	return &SetTokenPairRateLimitProposal{
+		Title:            title,
+		Description:      description,
+		Token:            token,
+		EpochIdentifier:  epochIdentifier,
+		CoinToERC20Limit: coinToERC20Limit,
+		ERC20ToCoinLimit: erc20ToCoinLimit,
+	}
+}
+
+// ProposalRoute returns router key for this proposal
+func (*SetTokenPairRateLimitProposal) ProposalRoute() string { return RouterKey }
+
+// ProposalType returns proposal type for this proposal
+func (*SetTokenPairRateLimitProposal) ProposalType() string {
+	return ProposalTypeSetTokenPairRateLimit
+}
+
+// ValidateBasic performs a stateless check of the proposal fields
+func (strlp *SetTokenPairRateLimitProposal) ValidateBasic() error {
+	// check if the token is a hex address, if not, check if it is a valid SDK
+	// denom
+	if err := ethermint.ValidateAddress(strlp.Token); err != nil {
+		if err := sdk.ValidateDenom(strlp.Token); err != nil {
+			return err
+		}
+	}
+
+	for _, limit := range []math.Int{strlp.CoinToERC20Limit, strlp.ERC20ToCoinLimit} {
+		if limit.IsNil() || limit.IsNegative() {
+			return errorsmod.Wrapf(errortypes.ErrInvalidRequest, "rate limit cannot be nil or negative: %s", limit)
+		}
+	}
+
+	// the epoch identifier is only required when setting a limit
+	if strlp.CoinToERC20Limit.IsPositive() || strlp.ERC20ToCoinLimit.IsPositive() {
+		if err := epochstypes.ValidateEpochIdentifierString(strlp.EpochIdentifier); err != nil {
+			return err
+		}
+	}
+
+	return v1beta1.ValidateAbstract(strlp)
+}
+
+// NewRegisterERC20TemplateProposal returns new instance of RegisterERC20TemplateProposal
+func NewRegisterERC20TemplateProposal(title, description string, template ERC20Template) v1beta1.Content {
+	return &RegisterERC20TemplateProposal{
+		Title:       title,
+		Description: description,
+		Template:    template,
+	}
+}
+
+// ProposalRoute returns router key for this proposal
+func (*RegisterERC20TemplateProposal) ProposalRoute() string { return RouterKey }
+
+// ProposalType returns proposal type for this proposal
+func (*RegisterERC20TemplateProposal) ProposalType() string {
+	return ProposalTypeRegisterERC20Template
+}
+
+// ValidateBasic performs a stateless check of the proposal fields
+func (retp *RegisterERC20TemplateProposal) ValidateBasic() error {
+	if err := retp.Template.Validate(); err != nil {
+		return err
+	}
+
+	return v1beta1.ValidateAbstract(retp)
+}
+
+// NewRemoveERC20TemplateProposal returns new instance of RemoveERC20TemplateProposal
+func NewRemoveERC20TemplateProposal(title, description, templateID string) v1beta1.Content {
+	return &RemoveERC20TemplateProposal{
+		Title:       title,
+		Description: description,
+		TemplateID:  templateID,
+	}
+}
+
+// ProposalRoute returns router key for this proposal
+func (*RemoveERC20TemplateProposal) ProposalRoute() string { return RouterKey }
+
+// ProposalType returns proposal type for this proposal
+func (*RemoveERC20TemplateProposal) ProposalType() string {
+	return ProposalTypeRemoveERC20Template
+}
+
+// ValidateBasic performs a stateless check of the proposal fields
+func (retp *RemoveERC20TemplateProposal) ValidateBasic() error {
+	if err := ValidateERC20TemplateID(retp.TemplateID); err != nil {
+		return err
+	}
+
+	if retp.TemplateID == DefaultERC20TemplateID {
+		return errorsmod.Wrap(ErrInvalidERC20Template, "the default template cannot be removed")
+	}
+
+	return v1beta1.ValidateAbstract(retp)
+}
+
+// NewRegisterNFTPairProposal returns new instance of RegisterNFTPairProposal
+func NewRegisterNFTPairProposal(
+	title, description, contract string,
+	standard NFTStandard,
+	classURI, classData string,
+) v1beta1.Content {
+	return &RegisterNFTPairProposal{
+		Title:           title,
+		Description:     description,
+		ContractAddress: contract,
+		Standard:        standard,
+		ClassURI:        classURI,
+		ClassData:       classData,
+	}
+}
+
+// ProposalRoute returns router key for this proposal
+func (*RegisterNFTPairProposal) ProposalRoute() string { return RouterKey }
+
+// ProposalType returns proposal type for this proposal
+func (*RegisterNFTPairProposal) ProposalType() string {
+	return ProposalTypeRegisterNFTPair
+}
+
+// ValidateBasic performs a stateless check of the proposal fields
+func (rnpp *RegisterNFTPairProposal) ValidateBasic() error {
+	if err := ethermint.ValidateAddress(rnpp.ContractAddress); err != nil {
+		return errorsmod.Wrap(err, "NFT contract address")
+	}
+
+	if err := ValidateNFTStandard(rnpp.Standard); err != nil {
+		return err
+	}
+
+	return v1beta1.ValidateAbstract(rnpp)
+}
@@ -4,6 +4,7 @@ import (
 	"strings"
 	"testing"
 
+	"cosmossdk.io/math"
 	"github.com/stretchr/testify/suite"
 
 	"github.com/evmos/ethermint/tests"
@@ -27,6 +28,18 @@ func (suite *ProposalTestSuite) TestKeysTypes() {
 	suite.Require().Equal("RegisterERC20", (&RegisterERC20Proposal{}).ProposalType())
 	suite.Require().Equal("erc20", (&ToggleTokenConversionProposal{}).ProposalRoute())
 	suite.Require().Equal("ToggleTokenConversion", (&ToggleTokenConversionProposal{}).ProposalType())
+	suite.Require().Equal("erc20", (&SlashRegistrationDepositProposal{}).ProposalRoute())
+	suite.Require().Equal("SlashRegistrationDeposit", (&SlashRegistrationDepositProposal{}).ProposalType())
+	suite.Require().Equal("erc20", (&DeregisterTokenPairProposal{}).ProposalRoute())
+	suite.Require().Equal("DeregisterTokenPair", (&DeregisterTokenPairProposal{}).ProposalType())
+	suite.Require().Equal("erc20", (&SetTokenPairRateLimitProposal{}).ProposalRoute())
+	suite.Require().Equal("SetTokenPairRateLimit", (&SetTokenPairRateLimitProposal{}).ProposalType())
+	suite.Require().Equal("erc20", (&RegisterERC20TemplateProposal{}).ProposalRoute())
+	suite.Require().Equal("RegisterERC20Template", (&RegisterERC20TemplateProposal{}).ProposalType())
+	suite.Require().Equal("erc20", (&RemoveERC20TemplateProposal{}).ProposalRoute())
+	suite.Require().Equal("RemoveERC20Template", (&RemoveERC20TemplateProposal{}).ProposalType())
+	suite.Require().Equal("erc20", (&RegisterNFTPairProposal{}).ProposalRoute())
+	suite.Require().Equal("RegisterNFTPair", (&RegisterNFTPairProposal{}).ProposalType())
 }
 
 func (suite *ProposalTestSuite) TestCreateDenomDescription() {
@@ -127,16 +140,16 @@ func (suite *ProposalTestSuite) TestRegisterERC20Proposal() {
 		expectPass  bool
 	}{
 		// Valid tests
-		{msg: "Register token pair - valid pair enabled", title: "test", description: "test desc", pair: TokenPair{tests.GenerateAddress().String(), "test", true, OWNER_MODULE}, expectPass: true},
-		{msg: "Register token pair - valid pair dissabled", title: "test", description: "test desc", pair: TokenPair{tests.GenerateAddress().String(), "test", false, OWNER_MODULE}, expectPass: true},
+		{msg: "Register token pair - valid pair enabled", title: "test", description: "test desc", pair: TokenPair{tests.GenerateAddress().String(), "test", true, OWNER_MODULE, ""}, expectPass: true},
+		{msg: "Register token pair - valid pair dissabled", title: "test", description: "test desc", pair: TokenPair{tests.GenerateAddress().String(), "test", false, OWNER_MODULE, ""}, expectPass: true},
 		// Missing params valid
-		{msg: "Register token pair - invalid missing title ", title: "", description: "test desc", pair: TokenPair{tests.GenerateAddress().String(), "test", false, OWNER_MODULE}, expectPass: false},
-		{msg: "Register token pair - invalid missing description ", title: "test", description: "", pair: TokenPair{tests.GenerateAddress().String(), "test", false, OWNER_MODULE}, expectPass: false},
+		{msg: "Register token pair - invalid missing title ", title: "", description: "test desc", pair: TokenPair{tests.GenerateAddress().String(), "test", false, OWNER_MODULE, ""}, expectPass: false},
+		{msg: "Register token pair - invalid missing description ", title: "test", description: "", pair: TokenPair{tests.GenerateAddress().String(), "test", false, OWNER_MODULE, ""}, expectPass: false},
 		// Invalid address
-		{msg: "Register token pair - invalid address (no hex)", title: "test", description: "test desc", pair: TokenPair{"0x5dCA2483280D9727c80b5518faC4556617fb19ZZ", "test", true, OWNER_MODULE}, expectPass: false},
-		{msg: "Register token pair - invalid address (invalid length 1)", title: "test", description: "test desc", pair: TokenPair{"0x5dCA2483280D9727c80b5518faC4556617fb19", "test", true, OWNER_MODULE}, expectPass: false},
-		{msg: "Register token pair - invalid address (invalid length 2)", title: "test", description: "test desc", pair: TokenPair{"0x5dCA2483280D9727c80b5518faC4556617fb194FFF", "test", true, OWNER_MODULE}, expectPass: false},
-		{msg: "Register token pair - invalid address (invalid prefix)", title: "test", description: "test desc", pair: TokenPair{"1x5dCA2483280D9727c80b5518faC4556617fb19F", "test", true, OWNER_MODULE}, expectPass: false},
+		{msg: "Register token pair - invalid address (no hex)", title: "test", description: "test desc", pair: TokenPair{"0x5dCA2483280D9727c80b5518faC4556617fb19ZZ", "test", true, OWNER_MODULE, ""}, expectPass: false},
+		{msg: "Register token pair - invalid address (invalid length 1)", title: "test", description: "test desc", pair: TokenPair{"0x5dCA2483280D9727c80b5518faC4556617fb19", "test", true, OWNER_MODULE, ""}, expectPass: false},
+		{msg: "Register token pair - invalid address (invalid length 2)", title: "test", description: "test desc", pair: TokenPair{"0x5dCA2483280D9727c80b5518faC4556617fb194FFF", "test", true, OWNER_MODULE, ""}, expectPass: false},
+		{msg: "Register token pair - invalid address (invalid prefix)", title: "test", description: "test desc", pair: TokenPair{"1x5dCA2483280D9727c80b5518faC4556617fb19F", "test", true, OWNER_MODULE, ""}, expectPass: false},
 	}
 
 	for i, tc := range testCases {
@@ -229,7 +242,7 @@ func (suite *ProposalTestSuite) TestRegisterCoinProposal() {
 	}
 
 	for i, tc := range testCases {
-		tx := NewRegisterCoinProposal(tc.title, tc.description, tc.metadata)
+		tx := NewRegisterCoinProposal(tc.title, tc.description, "", tc.metadata)
 		err := tx.ValidateBasic()
 
 		if tc.expectPass {
@@ -278,3 +291,201 @@ func (suite *ProposalTestSuite) TestToggleTokenConversionProposal() {
 		}
 	}
 }
+
+func (suite *ProposalTestSuite) TestSlashRegistrationDepositProposal() {
+	testCases := []struct {
+		msg         string
+		title       string
+		description string
+		addresses   []string
+		expectPass  bool
+	}{
+		{msg: "Slash deposit - valid", title: "test", description: "test desc", addresses: []string{tests.GenerateAddress().String()}, expectPass: true},
+		{msg: "Slash deposit - valid multiple", title: "test", description: "test desc", addresses: []string{tests.GenerateAddress().String(), tests.GenerateAddress().String()}, expectPass: true},
+		{msg: "Slash deposit - invalid missing title", title: "", description: "test desc", addresses: []string{tests.GenerateAddress().String()}, expectPass: false},
+		{msg: "Slash deposit - invalid missing description", title: "test", description: "", addresses: []string{tests.GenerateAddress().String()}, expectPass: false},
+		{msg: "Slash deposit - invalid empty addresses", title: "test", description: "test desc", addresses: []string{}, expectPass: false},
+		{msg: "Slash deposit - invalid address", title: "test", description: "test desc", addresses: []string{"0x5dCA2483280D9727c80b5518faC4556617fb19ZZ"}, expectPass: false},
+	}
+
+	for i, tc := range testCases {
+		tx := NewSlashRegistrationDepositProposal(tc.title, tc.description, tc.addresses...)
+		err := tx.ValidateBasic()
+
+		if tc.expectPass {
+			suite.Require().NoError(err, "valid test %d failed: %s, %v", i, tc.msg)
+		} else {
+			suite.Require().Error(err, "invalid test %d passed: %s, %v", i, tc.msg)
+		}
+	}
+}
+
+func (suite *ProposalTestSuite) TestDeregisterTokenPairProposal() {
+	testCases := []struct {
+		msg         string
+		title       string
+		description string
+		token       string
+		expectPass  bool
+	}{
+		{msg: "Deregister token pair proposal - valid denom", title: "test", description: "test desc", token: "test", expectPass: true},
+		{msg: "Deregister token pair proposal - valid address", title: "test", description: "test desc", token: "0x5dCA2483280D9727c80b5518faC4556617fb194F", expectPass: true},
+		{msg: "Deregister token pair proposal - invalid address", title: "test", description: "test desc", token: "0x123", expectPass: false},
+
+		// Invalid missing params
+		{msg: "Deregister token pair proposal - missing title", title: "", description: "test desc", token: "test", expectPass: false},
+		{msg: "Deregister token pair proposal - missing description", title: "test", description: "", token: "test", expectPass: false},
+		{msg: "Deregister token pair proposal - missing token", title: "test", description: "test desc", token: "", expectPass: false},
+
+		// Invalid regex
+		{msg: "Deregister token pair proposal - invalid denom", title: "test", description: "test desc", token: "^test", expectPass: false},
+	}
+
+	for i, tc := range testCases {
+		tx := NewDeregisterTokenPairProposal(tc.title, tc.description, tc.token)
+		err := tx.ValidateBasic()
+
+		if tc.expectPass {
+			suite.Require().NoError(err, "valid test %d failed: %s, %v", i, tc.msg)
+		} else {
+			suite.Require().Error(err, "invalid test %d passed: %s, %v", i, tc.msg)
+		}
+	}
+}
+
+func (suite *ProposalTestSuite) TestSetTokenPairRateLimitProposal() {
+	testCases := []struct {
+		msg              string
+		title            string
+		description      string
+		token            string
+		epochIdentifier  string
+		coinToERC20Limit math.Int
+		erc20ToCoinLimit math.Int
+		expectPass       bool
+	}{
+		{msg: "Set rate limit proposal - valid denom", title: "test", description: "test desc", token: "test", epochIdentifier: "day", coinToERC20Limit: math.NewInt(100), erc20ToCoinLimit: math.ZeroInt(), expectPass: true},
+		{msg: "Set rate limit proposal - valid address", title: "test", description: "test desc", token: "0x5dCA2483280D9727c80b5518faC4556617fb194F", epochIdentifier: "day", coinToERC20Limit: math.ZeroInt(), erc20ToCoinLimit: math.NewInt(100), expectPass: true},
+		{msg: "Set rate limit proposal - remove limit without epoch", title: "test", description: "test desc", token: "test", epochIdentifier: "", coinToERC20Limit: math.ZeroInt(), erc20ToCoinLimit: math.ZeroInt(), expectPass: true},
+		{msg: "Set rate limit proposal - invalid address", title: "test", description: "test desc", token: "0x123", epochIdentifier: "day", coinToERC20Limit: math.NewInt(100), erc20ToCoinLimit: math.NewInt(100), expectPass: false},
+
+		// Invalid limits
+		{msg: "Set rate limit proposal - missing epoch identifier", title: "test", description: "test desc", token: "test", epochIdentifier: "", coinToERC20Limit: math.NewInt(100), erc20ToCoinLimit: math.ZeroInt(), expectPass: false},
+		{msg: "Set rate limit proposal - negative limit", title: "test", description: "test desc", token: "test", epochIdentifier: "day", coinToERC20Limit: math.NewInt(-1), erc20ToCoinLimit: math.NewInt(100), expectPass: false},
+		{msg: "Set rate limit proposal - nil limit", title: "test", description: "test desc", token: "test", epochIdentifier: "day", coinToERC20Limit: math.NewInt(100), erc20ToCoinLimit: math.Int{}, expectPass: false},
+
+		// Invalid missing params
+		{msg: "Set rate limit proposal - missing title", title: "", description: "test desc", token: "test", epochIdentifier: "day", coinToERC20Limit: math.NewInt(100), erc20ToCoinLimit: math.NewInt(100), expectPass: false},
+		{msg: "Set rate limit proposal - missing description", title: "test", description: "", token: "test", epochIdentifier: "day", coinToERC20Limit: math.NewInt(100), erc20ToCoinLimit: math.NewInt(100), expectPass: false},
+		{msg: "Set rate limit proposal - missing token", title: "test", description: "test desc", token: "", epochIdentifier: "day", coinToERC20Limit: math.NewInt(100), erc20ToCoinLimit: math.NewInt(100), expectPass: false},
+	}
+
+	for i, tc := range testCases {
+		tx := NewSetTokenPairRateLimitProposal(tc.title, tc.description, tc.token, tc.epochIdentifier, tc.coinToERC20Limit, tc.erc20ToCoinLimit)
+		err := tx.ValidateBasic()
+
+		if tc.expectPass {
+			suite.Require().NoError(err, "valid test %d failed: %s, %v", i, tc.msg)
+		} else {
+			suite.Require().Error(err, "invalid test %d passed: %s, %v", i, tc.msg)
+		}
+	}
+}
+
+func (suite *ProposalTestSuite) TestRegisterERC20TemplateProposal() {
+	bytecode := []byte{0x60, 0x80}
+
+	testCases := []struct {
+		msg         string
+		title       string
+		description string
+		template    ERC20Template
+		expectPass  bool
+	}{
+		{msg: "Register template proposal - valid", title: "test", description: "test desc", template: NewERC20Template("erc20-permit", "permit", bytecode), expectPass: true},
+		{msg: "Register template proposal - valid without template description", title: "test", description: "test desc", template: NewERC20Template("erc20_pauser", "", bytecode), expectPass: true},
+
+		// Invalid template
+		{msg: "Register template proposal - default template id", title: "test", description: "test desc", template: NewERC20Template(DefaultERC20TemplateID, "", bytecode), expectPass: false},
+		{msg: "Register template proposal - empty id", title: "test", description: "test desc", template: NewERC20Template("", "", bytecode), expectPass: false},
+		{msg: "Register template proposal - uppercase id", title: "test", description: "test desc", template: NewERC20Template("ERC20-permit", "", bytecode), expectPass: false},
+		{msg: "Register template proposal - id with spaces", title: "test", description: "test desc", template: NewERC20Template("erc20 permit", "", bytecode), expectPass: false},
+		{msg: "Register template proposal - empty bytecode", title: "test", description: "test desc", template: NewERC20Template("erc20-permit", "", nil), expectPass: false},
+
+		// Invalid missing params
+		{msg: "Register template proposal - missing title", title: "", description: "test desc", template: NewERC20Template("erc20-permit", "", bytecode), expectPass: false},
+		{msg: "Register template proposal - missing description", title: "test", description: "", template: NewERC20Template("erc20-permit", "", bytecode), expectPass: false},
+	}
+
+	for i, tc := range testCases {
+		tx := NewRegisterERC20TemplateProposal(tc.title, tc.description, tc.template)
+		err := tx.ValidateBasic()
+
+		if tc.expectPass {
+			suite.Require().NoError(err, "valid test %d failed: %s, %v", i, tc.msg)
+		} else {
+			suite.Require().Error(err, "invalid test %d passed: %s, %v", i, tc.msg)
+		}
+	}
+}
+
+func (suite *ProposalTestSuite) TestRemoveERC20TemplateProposal() {
+	testCases := []struct {
+		msg         string
+		title       string
+		description string
+		templateID  string
+		expectPass  bool
+	}{
+		{msg: "Remove template proposal - valid", title: "test", description: "test desc", templateID: "erc20-permit", expectPass: true},
+		{msg: "Remove template proposal - default template id", title: "test", description: "test desc", templateID: DefaultERC20TemplateID, expectPass: false},
+		{msg: "Remove template proposal - invalid id", title: "test", description: "test desc", templateID: "erc20/permit", expectPass: false},
+
+		// Invalid missing params
+		{msg: "Remove template proposal - missing title", title: "", description: "test desc", templateID: "erc20-permit", expectPass: false},
+		{msg: "Remove template proposal - missing description", title: "test", description: "", templateID: "erc20-permit", expectPass: false},
+		{msg: "Remove template proposal - missing template id", title: "test", description: "test desc", templateID: "", expectPass: false},
+	}
+
+	for i, tc := range testCases {
+		tx := NewRemoveERC20TemplateProposal(tc.title, tc.description, tc.templateID)
+		err := tx.ValidateBasic()
+
+		if tc.expectPass {
+			suite.Require().NoError(err, "valid test %d failed: %s, %v", i, tc.msg)
+		} else {
+			suite.Require().Error(err, "invalid test %d passed: %s, %v", i, tc.msg)
+		}
+	}
+}
+
+func (suite *ProposalTestSuite) TestRegisterNFTPairProposal() {
+	testCases := []struct {
+		msg         string
+		title       string
+		description string
+		contract    string
+		standard    NFTStandard
+		expectPass  bool
+	}{
+		{msg: "Register NFT pair proposal - valid erc721", title: "test", description: "test desc", contract: tests.GenerateAddress().String(), standard: NFT_STANDARD_ERC721, expectPass: true},
+		{msg: "Register NFT pair proposal - valid erc1155", title: "test", description: "test desc", contract: tests.GenerateAddress().String(), standard: NFT_STANDARD_ERC1155, expectPass: true},
+		{msg: "Register NFT pair proposal - invalid address", title: "test", description: "test desc", contract: "0x5dCA2483280D9727c80b5518faC4556617fb19ZZ", standard: NFT_STANDARD_ERC721, expectPass: false},
+		{msg: "Register NFT pair proposal - unspecified standard", title: "test", description: "test desc", contract: tests.GenerateAddress().String(), standard: NFT_STANDARD_UNSPECIFIED, expectPass: false},
+
+		// Invalid missing params
+		{msg: "Register NFT pair proposal - missing title", title: "", description: "test desc", contract: tests.GenerateAddress().String(), standard: NFT_STANDARD_ERC721, expectPass: false},
+		{msg: "Register NFT pair proposal - missing description", title: "test", description: "", contract: tests.GenerateAddress().String(), standard: NFT_STANDARD_ERC721, expectPass: false},
+	}
+
+	for i, tc := range testCases {
+		tx := NewRegisterNFTPairProposal(tc.title, tc.description, tc.contract, tc.standard, "", "")
+		err := tx.ValidateBasic()
+
+		if tc.expectPass {
+			suite.Require().NoError(err, "valid test %d failed: %s, %v", i, tc.msg)
+		} else {
+			suite.Require().Error(err, "invalid test %d passed: %s, %v", i, tc.msg)
+		}
+	}
+}
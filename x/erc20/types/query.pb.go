@@ -6,6 +6,7 @@ package types
 import (
 	context "context"
 	fmt "fmt"
+	github_com_cosmos_cosmos_sdk_types "github.com/cosmos/cosmos-sdk/types"
 	query "github.com/cosmos/cosmos-sdk/types/query"
 	_ "github.com/cosmos/gogoproto/gogoproto"
 	grpc1 "github.com/gogo/protobuf/grpc"
@@ -30,11 +31,51 @@ var _ = math.Inf
 // proto package needs to be updated.
 const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
 
+// TokenPairStatus enumerates the conversion status filters of the
+// Query/TokenPairs RPC method.
+type TokenPairStatus int32
+
+const (
+	// TOKEN_PAIR_STATUS_UNSPECIFIED defines no status filter.
+	TOKEN_PAIR_STATUS_UNSPECIFIED TokenPairStatus = 0
+	// TOKEN_PAIR_STATUS_ENABLED selects the token pairs with conversions enabled.
+	TOKEN_PAIR_STATUS_ENABLED TokenPairStatus = 1
+	// TOKEN_PAIR_STATUS_DISABLED selects the token pairs with conversions
+	// disabled.
+	TOKEN_PAIR_STATUS_DISABLED TokenPairStatus = 2
+)
+
+var TokenPairStatus_name = map[int32]string{
+	0: "TOKEN_PAIR_STATUS_UNSPECIFIED",
+	1: "TOKEN_PAIR_STATUS_ENABLED",
+	2: "TOKEN_PAIR_STATUS_DISABLED",
+}
+
+var TokenPairStatus_value = map[string]int32{
+	"TOKEN_PAIR_STATUS_UNSPECIFIED": 0,
+	"TOKEN_PAIR_STATUS_ENABLED":     1,
+	"TOKEN_PAIR_STATUS_DISABLED":    2,
+}
+
+func (x TokenPairStatus) String() string {
+	return proto.EnumName(TokenPairStatus_name, int32(x))
+}
+
+func (TokenPairStatus) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_fba814bce17cabdf, []int{0}
+}
+
 // QueryTokenPairsRequest is the request type for the Query/TokenPairs RPC
 // method.
 type QueryTokenPairsRequest struct {
 	// pagination defines an optional pagination for the request.
 	Pagination *query.PageRequest `protobuf:"bytes,1,opt,name=pagination,proto3" json:"pagination,omitempty"`
+	// contract_owner filters the token pairs by owner. All owners are returned
+	// if unspecified.
+	ContractOwner Owner `protobuf:"varint,2,opt,name=contract_owner,json=contractOwner,proto3,enum=evmos.erc20.v1.Owner" json:"contract_owner,omitempty"`
+	// status filters the token pairs by conversion status. All token pairs are
+	// returned if unspecified.
+	Status TokenPairStatus `protobuf:"varint,3,opt,name=status,proto3,enum=evmos.erc20.v1.TokenPairStatus" json:"status,omitempty"`
 }
 
 func (m *QueryTokenPairsRequest) Reset()         { *m = QueryTokenPairsRequest{} }
@@ -77,6 +118,20 @@ func (m *QueryTokenPairsRequest) GetPagination() *query.PageRequest {
 	return nil
 }
 
+func (m *QueryTokenPairsRequest) GetContractOwner() Owner {
+	if m != nil {
+		return m.ContractOwner
+	}
+	return OWNER_UNSPECIFIED
+}
+
+func (m *QueryTokenPairsRequest) GetStatus() TokenPairStatus {
+	if m != nil {
+		return m.Status
+	}
+	return TOKEN_PAIR_STATUS_UNSPECIFIED
+}
+
 // QueryTokenPairsResponse is the response type for the Query/TokenPairs RPC
 // method.
 type QueryTokenPairsResponse struct {
@@ -227,22 +282,26 @@ func (m *QueryTokenPairResponse) GetTokenPair() TokenPair {
 	return TokenPair{}
 }
 
-// QueryParamsRequest is the request type for the Query/Params RPC method.
-type QueryParamsRequest struct {
+// QueryTokenPairRateLimitRequest is the request type for the
+// Query/TokenPairRateLimit RPC method.
+type QueryTokenPairRateLimitRequest struct {
+	// token identifier can be either the hex contract address of the ERC20 or the
+	// Cosmos base denomination
+	Token string `protobuf:"bytes,1,opt,name=token,proto3" json:"token,omitempty"`
 }
 
-func (m *QueryParamsRequest) Reset()         { *m = QueryParamsRequest{} }
-func (m *QueryParamsRequest) String() string { return proto.CompactTextString(m) }
-func (*QueryParamsRequest) ProtoMessage()    {}
-func (*QueryParamsRequest) Descriptor() ([]byte, []int) {
+func (m *QueryTokenPairRateLimitRequest) Reset()         { *m = QueryTokenPairRateLimitRequest{} }
+func (m *QueryTokenPairRateLimitRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryTokenPairRateLimitRequest) ProtoMessage()    {}
+func (*QueryTokenPairRateLimitRequest) Descriptor() ([]byte, []int) {
 	return fileDescriptor_fba814bce17cabdf, []int{4}
 }
-func (m *QueryParamsRequest) XXX_Unmarshal(b []byte) error {
+func (m *QueryTokenPairRateLimitRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *QueryParamsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *QueryTokenPairRateLimitRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_QueryParamsRequest.Marshal(b, m, deterministic)
+		return xxx_messageInfo_QueryTokenPairRateLimitRequest.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -252,37 +311,44 @@ func (m *QueryParamsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte,
 		return b[:n], nil
 	}
 }
-func (m *QueryParamsRequest) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_QueryParamsRequest.Merge(m, src)
+func (m *QueryTokenPairRateLimitRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryTokenPairRateLimitRequest.Merge(m, src)
 }
-func (m *QueryParamsRequest) XXX_Size() int {
+func (m *QueryTokenPairRateLimitRequest) XXX_Size() int {
 	return m.Size()
 }
-func (m *QueryParamsRequest) XXX_DiscardUnknown() {
-	xxx_messageInfo_QueryParamsRequest.DiscardUnknown(m)
+func (m *QueryTokenPairRateLimitRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryTokenPairRateLimitRequest.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_QueryParamsRequest proto.InternalMessageInfo
+var xxx_messageInfo_QueryTokenPairRateLimitRequest proto.InternalMessageInfo
 
-// QueryParamsResponse is the response type for the Query/Params RPC
-// method.
-type QueryParamsResponse struct {
-	// params are the erc20 module parameters
-	Params Params `protobuf:"bytes,1,opt,name=params,proto3" json:"params"`
+func (m *QueryTokenPairRateLimitRequest) GetToken() string {
+	if m != nil {
+		return m.Token
+	}
+	return ""
 }
 
-func (m *QueryParamsResponse) Reset()         { *m = QueryParamsResponse{} }
-func (m *QueryParamsResponse) String() string { return proto.CompactTextString(m) }
-func (*QueryParamsResponse) ProtoMessage()    {}
-func (*QueryParamsResponse) Descriptor() ([]byte, []int) {
+// QueryTokenPairRateLimitResponse is the response type for the
+// Query/TokenPairRateLimit RPC method.
+type QueryTokenPairRateLimitResponse struct {
+	// rate_limit is the conversion rate limit of the token pair
+	RateLimit TokenPairRateLimit `protobuf:"bytes,1,opt,name=rate_limit,json=rateLimit,proto3" json:"rate_limit"`
+}
+
+func (m *QueryTokenPairRateLimitResponse) Reset()         { *m = QueryTokenPairRateLimitResponse{} }
+func (m *QueryTokenPairRateLimitResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryTokenPairRateLimitResponse) ProtoMessage()    {}
+func (*QueryTokenPairRateLimitResponse) Descriptor() ([]byte, []int) {
 	return fileDescriptor_fba814bce17cabdf, []int{5}
 }
-func (m *QueryParamsResponse) XXX_Unmarshal(b []byte) error {
+func (m *QueryTokenPairRateLimitResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *QueryParamsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *QueryTokenPairRateLimitResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_QueryParamsResponse.Marshal(b, m, deterministic)
+		return xxx_messageInfo_QueryTokenPairRateLimitResponse.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -292,527 +358,3186 @@ func (m *QueryParamsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte,
 		return b[:n], nil
 	}
 }
-func (m *QueryParamsResponse) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_QueryParamsResponse.Merge(m, src)
+func (m *QueryTokenPairRateLimitResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryTokenPairRateLimitResponse.Merge(m, src)
 }
-func (m *QueryParamsResponse) XXX_Size() int {
+func (m *QueryTokenPairRateLimitResponse) XXX_Size() int {
 	return m.Size()
 }
-func (m *QueryParamsResponse) XXX_DiscardUnknown() {
-	xxx_messageInfo_QueryParamsResponse.DiscardUnknown(m)
+func (m *QueryTokenPairRateLimitResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryTokenPairRateLimitResponse.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_QueryParamsResponse proto.InternalMessageInfo
+var xxx_messageInfo_QueryTokenPairRateLimitResponse proto.InternalMessageInfo
 
-func (m *QueryParamsResponse) GetParams() Params {
+func (m *QueryTokenPairRateLimitResponse) GetRateLimit() TokenPairRateLimit {
 	if m != nil {
-		return m.Params
+		return m.RateLimit
 	}
-	return Params{}
+	return TokenPairRateLimit{}
 }
 
-func init() {
-	proto.RegisterType((*QueryTokenPairsRequest)(nil), "evmos.erc20.v1.QueryTokenPairsRequest")
-	proto.RegisterType((*QueryTokenPairsResponse)(nil), "evmos.erc20.v1.QueryTokenPairsResponse")
-	proto.RegisterType((*QueryTokenPairRequest)(nil), "evmos.erc20.v1.QueryTokenPairRequest")
-	proto.RegisterType((*QueryTokenPairResponse)(nil), "evmos.erc20.v1.QueryTokenPairResponse")
-	proto.RegisterType((*QueryParamsRequest)(nil), "evmos.erc20.v1.QueryParamsRequest")
-	proto.RegisterType((*QueryParamsResponse)(nil), "evmos.erc20.v1.QueryParamsResponse")
+// QueryTokenPairStatsRequest is the request type for the Query/TokenPairStats
+// RPC method.
+type QueryTokenPairStatsRequest struct {
+	// token identifier can be either the hex contract address of the ERC20 or the
+	// Cosmos base denomination
+	Token string `protobuf:"bytes,1,opt,name=token,proto3" json:"token,omitempty"`
 }
 
-func init() { proto.RegisterFile("evmos/erc20/v1/query.proto", fileDescriptor_fba814bce17cabdf) }
-
-var fileDescriptor_fba814bce17cabdf = []byte{
-	// 501 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x84, 0x53, 0x4f, 0x8b, 0xd3, 0x40,
-	0x14, 0xef, 0xec, 0xba, 0x85, 0xbe, 0x82, 0x87, 0xb1, 0xd6, 0x1a, 0x35, 0x2e, 0x29, 0xdb, 0x2d,
-	0x8a, 0x33, 0x9b, 0xea, 0x59, 0xa4, 0x07, 0x3d, 0x78, 0xa9, 0xc5, 0x83, 0x78, 0xd1, 0x69, 0x19,
-	0x62, 0xd0, 0x66, 0xd2, 0xcc, 0x34, 0xb8, 0x88, 0x97, 0xbd, 0x88, 0x37, 0xc1, 0xaf, 0xe0, 0x87,
-	0xd9, 0xe3, 0x82, 0x17, 0x4f, 0x22, 0xad, 0x1f, 0x44, 0x32, 0x33, 0x49, 0x37, 0xb1, 0xb4, 0x97,
-	0x92, 0xbc, 0xf7, 0x7e, 0xff, 0xde, 0x4b, 0xc1, 0xe1, 0xe9, 0x4c, 0x48, 0xca, 0x93, 0xe9, 0xe0,
-	0x84, 0xa6, 0x3e, 0x9d, 0x2f, 0x78, 0x72, 0x4a, 0xe2, 0x44, 0x28, 0x81, 0xaf, 0xea, 0x1e, 0xd1,
-	0x3d, 0x92, 0xfa, 0xce, 0xbd, 0xa9, 0x90, 0xd9, 0xf0, 0x84, 0x49, 0x6e, 0x06, 0x69, 0xea, 0x4f,
-	0xb8, 0x62, 0x3e, 0x8d, 0x59, 0x10, 0x46, 0x4c, 0x85, 0x22, 0x32, 0x58, 0xa7, 0xca, 0x6b, 0x48,
-	0x4c, 0xef, 0x76, 0xa5, 0x17, 0xf0, 0x88, 0xcb, 0x50, 0xda, 0x6e, 0x2b, 0x10, 0x81, 0xd0, 0x8f,
-	0x34, 0x7b, 0xca, 0x31, 0x81, 0x10, 0xc1, 0x07, 0x4e, 0x59, 0x1c, 0x52, 0x16, 0x45, 0x42, 0x69,
-	0x31, 0x8b, 0xf1, 0xde, 0x42, 0xfb, 0x45, 0xe6, 0xe7, 0xa5, 0x78, 0xcf, 0xa3, 0x11, 0x0b, 0x13,
-	0x39, 0xe6, 0xf3, 0x05, 0x97, 0x0a, 0x3f, 0x05, 0x58, 0x7b, 0xeb, 0xa0, 0x43, 0xd4, 0x6f, 0x0e,
-	0x7a, 0xc4, 0x04, 0x21, 0x59, 0x10, 0x62, 0x12, 0xdb, 0x20, 0x64, 0xc4, 0x02, 0x6e, 0xb1, 0xe3,
-	0x4b, 0x48, 0xef, 0x07, 0x82, 0x1b, 0xff, 0x49, 0xc8, 0x58, 0x44, 0x92, 0xe3, 0x27, 0xd0, 0x54,
-	0x59, 0xf5, 0x4d, 0x9c, 0x95, 0x3b, 0xe8, 0x70, 0xbf, 0xdf, 0x1c, 0xdc, 0x24, 0xe5, 0xed, 0x91,
-	0x02, 0x38, 0xbc, 0x72, 0xfe, 0xfb, 0x6e, 0x6d, 0x0c, 0xaa, 0x60, 0xc2, 0xcf, 0x4a, 0x2e, 0xf7,
-	0xb4, 0xcb, 0xe3, 0x9d, 0x2e, 0x8d, 0x7c, 0xc9, 0xe6, 0x03, 0xb8, 0x5e, 0x76, 0x99, 0xef, 0xa1,
-	0x05, 0x07, 0x5a, 0x4f, 0xaf, 0xa0, 0x31, 0x36, 0x2f, 0xde, 0xab, 0xea, 0xde, 0x8a, 0x4c, 0x8f,
-	0x01, 0xd6, 0x99, 0xec, 0xde, 0x76, 0x46, 0x6a, 0x14, 0x91, 0xbc, 0x16, 0x60, 0xcd, 0x3c, 0x62,
-	0x09, 0x9b, 0xe5, 0xd7, 0xf0, 0x9e, 0xc3, 0xb5, 0x52, 0xd5, 0x8a, 0x3d, 0x82, 0x7a, 0xac, 0x2b,
-	0x56, 0xa8, 0x5d, 0x15, 0x32, 0xf3, 0x56, 0xc5, 0xce, 0x0e, 0xbe, 0xee, 0xc3, 0x81, 0x66, 0xc3,
-	0x67, 0x08, 0x60, 0x7d, 0x17, 0xdc, 0xab, 0xc2, 0x37, 0x7f, 0x1b, 0xce, 0xf1, 0xce, 0x39, 0xe3,
-	0xcf, 0xeb, 0x9e, 0xfd, 0xfc, 0xfb, 0x7d, 0xef, 0x0e, 0xbe, 0x45, 0x2b, 0x5f, 0xee, 0xa5, 0xb3,
-	0xe3, 0x2f, 0x08, 0x1a, 0x05, 0x16, 0x1f, 0x6d, 0xe7, 0xce, 0x2d, 0xf4, 0x76, 0x8d, 0x59, 0x07,
-	0xf7, 0xb5, 0x83, 0x23, 0xdc, 0xdd, 0xe2, 0x80, 0x7e, 0xd2, 0x2f, 0x9f, 0xf1, 0x1c, 0xea, 0x66,
-	0x61, 0xd8, 0xdb, 0x48, 0x5f, 0xba, 0x89, 0xd3, 0xdd, 0x3a, 0x63, 0xf5, 0x5d, 0xad, 0xdf, 0xc1,
-	0xed, 0xaa, 0xbe, 0xb9, 0xc5, 0x70, 0x78, 0xbe, 0x74, 0xd1, 0xc5, 0xd2, 0x45, 0x7f, 0x96, 0x2e,
-	0xfa, 0xb6, 0x72, 0x6b, 0x17, 0x2b, 0xb7, 0xf6, 0x6b, 0xe5, 0xd6, 0x5e, 0xf7, 0x83, 0x50, 0xbd,
-	0x5b, 0x4c, 0xc8, 0x54, 0xcc, 0x72, 0xac, 0xfe, 0x4d, 0xfd, 0x13, 0xfa, 0xd1, 0xf2, 0xa8, 0xd3,
-	0x98, 0xcb, 0x49, 0x5d, 0xff, 0x97, 0x1f, 0xfe, 0x0b, 0x00, 0x00, 0xff, 0xff, 0x00, 0x85, 0xb6,
-	0x5b, 0x93, 0x04, 0x00, 0x00,
+func (m *QueryTokenPairStatsRequest) Reset()         { *m = QueryTokenPairStatsRequest{} }
+func (m *QueryTokenPairStatsRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryTokenPairStatsRequest) ProtoMessage()    {}
+func (*QueryTokenPairStatsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_fba814bce17cabdf, []int{6}
+}
+func (m *QueryTokenPairStatsRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryTokenPairStatsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryTokenPairStatsRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryTokenPairStatsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryTokenPairStatsRequest.Merge(m, src)
+}
+func (m *QueryTokenPairStatsRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryTokenPairStatsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryTokenPairStatsRequest.DiscardUnknown(m)
 }
 
-// Reference imports to suppress errors if they are not otherwise used.
-var _ context.Context
-var _ grpc.ClientConn
-
-// This is a compile-time assertion to ensure that this generated file
-// is compatible with the grpc package it is being compiled against.
-const _ = grpc.SupportPackageIsVersion4
+var xxx_messageInfo_QueryTokenPairStatsRequest proto.InternalMessageInfo
 
-// QueryClient is the client API for Query service.
-//
-// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
-type QueryClient interface {
-	// TokenPairs retrieves registered token pairs
-	TokenPairs(ctx context.Context, in *QueryTokenPairsRequest, opts ...grpc.CallOption) (*QueryTokenPairsResponse, error)
-	// TokenPair retrieves a registered token pair
-	TokenPair(ctx context.Context, in *QueryTokenPairRequest, opts ...grpc.CallOption) (*QueryTokenPairResponse, error)
-	// Params retrieves the erc20 module params
-	Params(ctx context.Context, in *QueryParamsRequest, opts ...grpc.CallOption) (*QueryParamsResponse, error)
+func (m *QueryTokenPairStatsRequest) GetToken() string {
+	if m != nil {
+		return m.Token
+	}
+	return ""
 }
 
-type queryClient struct {
-	cc grpc1.ClientConn
+// QueryTokenPairStatsResponse is the response type for the
+// Query/TokenPairStats RPC method.
+type QueryTokenPairStatsResponse struct {
+	// token_pair is the registered token pair
+	TokenPair TokenPair `protobuf:"bytes,1,opt,name=token_pair,json=tokenPair,proto3" json:"token_pair"`
+	// coin_to_erc20_volume is the total amount of Cosmos coins converted to
+	// ERC20 tokens
+	CoinToERC20Volume github_com_cosmos_cosmos_sdk_types.Int `protobuf:"bytes,2,opt,name=coin_to_erc20_volume,json=coinToErc20Volume,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Int" json:"coin_to_erc20_volume"`
+	// erc20_to_coin_volume is the total amount of ERC20 tokens converted to
+	// Cosmos coins
+	ERC20ToCoinVolume github_com_cosmos_cosmos_sdk_types.Int `protobuf:"bytes,3,opt,name=erc20_to_coin_volume,json=erc20ToCoinVolume,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Int" json:"erc20_to_coin_volume"`
+	// escrow_balance is the balance escrowed by the module for the token pair:
+	// Cosmos coins, excluding the registration deposits, for native coins and
+	// ERC20 tokens for native ERC20s
+	EscrowBalance github_com_cosmos_cosmos_sdk_types.Int `protobuf:"bytes,4,opt,name=escrow_balance,json=escrowBalance,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Int" json:"escrow_balance"`
+	// erc20_total_supply is the total supply of the ERC20 token contract
+	ERC20TotalSupply github_com_cosmos_cosmos_sdk_types.Int `protobuf:"bytes,5,opt,name=erc20_total_supply,json=erc20TotalSupply,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Int" json:"erc20_total_supply"`
 }
 
-func NewQueryClient(cc grpc1.ClientConn) QueryClient {
-	return &queryClient{cc}
+func (m *QueryTokenPairStatsResponse) Reset()         { *m = QueryTokenPairStatsResponse{} }
+func (m *QueryTokenPairStatsResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryTokenPairStatsResponse) ProtoMessage()    {}
+func (*QueryTokenPairStatsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_fba814bce17cabdf, []int{7}
 }
-
-func (c *queryClient) TokenPairs(ctx context.Context, in *QueryTokenPairsRequest, opts ...grpc.CallOption) (*QueryTokenPairsResponse, error) {
-	out := new(QueryTokenPairsResponse)
-	err := c.cc.Invoke(ctx, "/evmos.erc20.v1.Query/TokenPairs", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *QueryTokenPairStatsResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryTokenPairStatsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryTokenPairStatsResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return out, nil
+}
+func (m *QueryTokenPairStatsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryTokenPairStatsResponse.Merge(m, src)
+}
+func (m *QueryTokenPairStatsResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryTokenPairStatsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryTokenPairStatsResponse.DiscardUnknown(m)
 }
 
-func (c *queryClient) TokenPair(ctx context.Context, in *QueryTokenPairRequest, opts ...grpc.CallOption) (*QueryTokenPairResponse, error) {
-	out := new(QueryTokenPairResponse)
-	err := c.cc.Invoke(ctx, "/evmos.erc20.v1.Query/TokenPair", in, out, opts...)
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_QueryTokenPairStatsResponse proto.InternalMessageInfo
+
+func (m *QueryTokenPairStatsResponse) GetTokenPair() TokenPair {
+	if m != nil {
+		return m.TokenPair
 	}
-	return out, nil
+	return TokenPair{}
 }
 
-func (c *queryClient) Params(ctx context.Context, in *QueryParamsRequest, opts ...grpc.CallOption) (*QueryParamsResponse, error) {
-	out := new(QueryParamsResponse)
-	err := c.cc.Invoke(ctx, "/evmos.erc20.v1.Query/Params", in, out, opts...)
-	if err != nil {
-		return nil, err
+// QueryERC20TemplatesRequest is the request type for the Query/ERC20Templates
+// RPC method.
+type QueryERC20TemplatesRequest struct {
+	// pagination defines an optional pagination for the request.
+	Pagination *query.PageRequest `protobuf:"bytes,1,opt,name=pagination,proto3" json:"pagination,omitempty"`
+}
+
+func (m *QueryERC20TemplatesRequest) Reset()         { *m = QueryERC20TemplatesRequest{} }
+func (m *QueryERC20TemplatesRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryERC20TemplatesRequest) ProtoMessage()    {}
+func (*QueryERC20TemplatesRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_fba814bce17cabdf, []int{8}
+}
+func (m *QueryERC20TemplatesRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryERC20TemplatesRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryERC20TemplatesRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return out, nil
+}
+func (m *QueryERC20TemplatesRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryERC20TemplatesRequest.Merge(m, src)
+}
+func (m *QueryERC20TemplatesRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryERC20TemplatesRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryERC20TemplatesRequest.DiscardUnknown(m)
 }
 
-// QueryServer is the server API for Query service.
-type QueryServer interface {
-	// TokenPairs retrieves registered token pairs
-	TokenPairs(context.Context, *QueryTokenPairsRequest) (*QueryTokenPairsResponse, error)
-	// TokenPair retrieves a registered token pair
-	TokenPair(context.Context, *QueryTokenPairRequest) (*QueryTokenPairResponse, error)
-	// Params retrieves the erc20 module params
-	Params(context.Context, *QueryParamsRequest) (*QueryParamsResponse, error)
+var xxx_messageInfo_QueryERC20TemplatesRequest proto.InternalMessageInfo
+
+func (m *QueryERC20TemplatesRequest) GetPagination() *query.PageRequest {
+	if m != nil {
+		return m.Pagination
+	}
+	return nil
 }
 
-// UnimplementedQueryServer can be embedded to have forward compatible implementations.
-type UnimplementedQueryServer struct {
+// QueryERC20TemplatesResponse is the response type for the Query/ERC20Templates
+// RPC method.
+type QueryERC20TemplatesResponse struct {
+	// templates is a slice of the registered ERC20 templates. The default
+	// template is built into the module and not included.
+	Templates []ERC20Template `protobuf:"bytes,1,rep,name=templates,proto3" json:"templates"`
+	// pagination defines the pagination in the response.
+	Pagination *query.PageResponse `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
 }
 
-func (*UnimplementedQueryServer) TokenPairs(ctx context.Context, req *QueryTokenPairsRequest) (*QueryTokenPairsResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method TokenPairs not implemented")
+func (m *QueryERC20TemplatesResponse) Reset()         { *m = QueryERC20TemplatesResponse{} }
+func (m *QueryERC20TemplatesResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryERC20TemplatesResponse) ProtoMessage()    {}
+func (*QueryERC20TemplatesResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_fba814bce17cabdf, []int{9}
 }
-func (*UnimplementedQueryServer) TokenPair(ctx context.Context, req *QueryTokenPairRequest) (*QueryTokenPairResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method TokenPair not implemented")
+func (m *QueryERC20TemplatesResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
 }
-func (*UnimplementedQueryServer) Params(ctx context.Context, req *QueryParamsRequest) (*QueryParamsResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method Params not implemented")
+func (m *QueryERC20TemplatesResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryERC20TemplatesResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
 }
-
-func RegisterQueryServer(s grpc1.Server, srv QueryServer) {
-	s.RegisterService(&_Query_serviceDesc, srv)
+func (m *QueryERC20TemplatesResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryERC20TemplatesResponse.Merge(m, src)
+}
+func (m *QueryERC20TemplatesResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryERC20TemplatesResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryERC20TemplatesResponse.DiscardUnknown(m)
 }
 
-func _Query_TokenPairs_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryTokenPairsRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(QueryServer).TokenPairs(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/evmos.erc20.v1.Query/TokenPairs",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).TokenPairs(ctx, req.(*QueryTokenPairsRequest))
+var xxx_messageInfo_QueryERC20TemplatesResponse proto.InternalMessageInfo
+
+func (m *QueryERC20TemplatesResponse) GetTemplates() []ERC20Template {
+	if m != nil {
+		return m.Templates
 	}
-	return interceptor(ctx, in, info, handler)
+	return nil
 }
 
-func _Query_TokenPair_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryTokenPairRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(QueryServer).TokenPair(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/evmos.erc20.v1.Query/TokenPair",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).TokenPair(ctx, req.(*QueryTokenPairRequest))
+func (m *QueryERC20TemplatesResponse) GetPagination() *query.PageResponse {
+	if m != nil {
+		return m.Pagination
 	}
-	return interceptor(ctx, in, info, handler)
+	return nil
 }
 
-func _Query_Params_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryParamsRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(QueryServer).Params(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/evmos.erc20.v1.Query/Params",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).Params(ctx, req.(*QueryParamsRequest))
-	}
-	return interceptor(ctx, in, info, handler)
-}
-
-var _Query_serviceDesc = grpc.ServiceDesc{
-	ServiceName: "evmos.erc20.v1.Query",
-	HandlerType: (*QueryServer)(nil),
-	Methods: []grpc.MethodDesc{
-		{
-			MethodName: "TokenPairs",
-			Handler:    _Query_TokenPairs_Handler,
-		},
-		{
-			MethodName: "TokenPair",
-			Handler:    _Query_TokenPair_Handler,
-		},
-		{
-			MethodName: "Params",
-			Handler:    _Query_Params_Handler,
-		},
-	},
-	Streams:  []grpc.StreamDesc{},
-	Metadata: "evmos/erc20/v1/query.proto",
+// QueryNFTPairsRequest is the request type for the Query/NFTPairs RPC method.
+type QueryNFTPairsRequest struct {
+	// pagination defines an optional pagination for the request.
+	Pagination *query.PageRequest `protobuf:"bytes,1,opt,name=pagination,proto3" json:"pagination,omitempty"`
 }
 
-func (m *QueryTokenPairsRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
-	}
-	return dAtA[:n], nil
+func (m *QueryNFTPairsRequest) Reset()         { *m = QueryNFTPairsRequest{} }
+func (m *QueryNFTPairsRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryNFTPairsRequest) ProtoMessage()    {}
+func (*QueryNFTPairsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_fba814bce17cabdf, []int{10}
 }
-
-func (m *QueryTokenPairsRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *QueryNFTPairsRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
 }
-
-func (m *QueryTokenPairsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.Pagination != nil {
-		{
-			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintQuery(dAtA, i, uint64(size))
+func (m *QueryNFTPairsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryNFTPairsRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
-		i--
-		dAtA[i] = 0xa
+		return b[:n], nil
 	}
-	return len(dAtA) - i, nil
+}
+func (m *QueryNFTPairsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryNFTPairsRequest.Merge(m, src)
+}
+func (m *QueryNFTPairsRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryNFTPairsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryNFTPairsRequest.DiscardUnknown(m)
 }
 
-func (m *QueryTokenPairsResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_QueryNFTPairsRequest proto.InternalMessageInfo
+
+func (m *QueryNFTPairsRequest) GetPagination() *query.PageRequest {
+	if m != nil {
+		return m.Pagination
 	}
-	return dAtA[:n], nil
+	return nil
 }
 
-func (m *QueryTokenPairsResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+// QueryNFTPairsResponse is the response type for the Query/NFTPairs RPC
+// method.
+type QueryNFTPairsResponse struct {
+	// nft_pairs is a slice of the registered NFT pairs
+	NFTPairs []NFTPair `protobuf:"bytes,1,rep,name=nft_pairs,json=nftPairs,proto3" json:"nft_pairs"`
+	// pagination defines the pagination in the response.
+	Pagination *query.PageResponse `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
 }
 
-func (m *QueryTokenPairsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.Pagination != nil {
-		{
-			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintQuery(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0x12
-	}
-	if len(m.TokenPairs) > 0 {
-		for iNdEx := len(m.TokenPairs) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.TokenPairs[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintQuery(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0xa
+func (m *QueryNFTPairsResponse) Reset()         { *m = QueryNFTPairsResponse{} }
+func (m *QueryNFTPairsResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryNFTPairsResponse) ProtoMessage()    {}
+func (*QueryNFTPairsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_fba814bce17cabdf, []int{11}
+}
+func (m *QueryNFTPairsResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryNFTPairsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryNFTPairsResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
+		return b[:n], nil
 	}
-	return len(dAtA) - i, nil
 }
-
-func (m *QueryTokenPairRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
-	}
-	return dAtA[:n], nil
+func (m *QueryNFTPairsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryNFTPairsResponse.Merge(m, src)
 }
-
-func (m *QueryTokenPairRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *QueryNFTPairsResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryNFTPairsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryNFTPairsResponse.DiscardUnknown(m)
 }
 
-func (m *QueryTokenPairRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.Token) > 0 {
-		i -= len(m.Token)
-		copy(dAtA[i:], m.Token)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.Token)))
-		i--
-		dAtA[i] = 0xa
+var xxx_messageInfo_QueryNFTPairsResponse proto.InternalMessageInfo
+
+func (m *QueryNFTPairsResponse) GetNFTPairs() []NFTPair {
+	if m != nil {
+		return m.NFTPairs
 	}
-	return len(dAtA) - i, nil
+	return nil
 }
 
-func (m *QueryTokenPairResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *QueryNFTPairsResponse) GetPagination() *query.PageResponse {
+	if m != nil {
+		return m.Pagination
 	}
-	return dAtA[:n], nil
+	return nil
 }
 
-func (m *QueryTokenPairResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+// QueryNFTPairRequest is the request type for the Query/NFTPair RPC method.
+type QueryNFTPairRequest struct {
+	// token identifier can be either the hex address of the ERC721 or ERC1155
+	// contract or the x/nft class identifier
+	Token string `protobuf:"bytes,1,opt,name=token,proto3" json:"token,omitempty"`
 }
 
-func (m *QueryTokenPairResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	{
-		size, err := m.TokenPair.MarshalToSizedBuffer(dAtA[:i])
+func (m *QueryNFTPairRequest) Reset()         { *m = QueryNFTPairRequest{} }
+func (m *QueryNFTPairRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryNFTPairRequest) ProtoMessage()    {}
+func (*QueryNFTPairRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_fba814bce17cabdf, []int{12}
+}
+func (m *QueryNFTPairRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryNFTPairRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryNFTPairRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
 		if err != nil {
-			return 0, err
+			return nil, err
 		}
-		i -= size
-		i = encodeVarintQuery(dAtA, i, uint64(size))
+		return b[:n], nil
 	}
-	i--
-	dAtA[i] = 0xa
-	return len(dAtA) - i, nil
 }
-
-func (m *QueryParamsRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
-	}
-	return dAtA[:n], nil
+func (m *QueryNFTPairRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryNFTPairRequest.Merge(m, src)
 }
-
-func (m *QueryParamsRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *QueryNFTPairRequest) XXX_Size() int {
+	return m.Size()
 }
-
-func (m *QueryParamsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	return len(dAtA) - i, nil
+func (m *QueryNFTPairRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryNFTPairRequest.DiscardUnknown(m)
 }
 
-func (m *QueryParamsResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_QueryNFTPairRequest proto.InternalMessageInfo
+
+func (m *QueryNFTPairRequest) GetToken() string {
+	if m != nil {
+		return m.Token
 	}
-	return dAtA[:n], nil
+	return ""
 }
 
-func (m *QueryParamsResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+// QueryNFTPairResponse is the response type for the Query/NFTPair RPC method.
+type QueryNFTPairResponse struct {
+	// nft_pair is the registered NFT pair
+	NFTPair NFTPair `protobuf:"bytes,1,opt,name=nft_pair,json=nftPair,proto3" json:"nft_pair"`
 }
 
-func (m *QueryParamsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	{
-		size, err := m.Params.MarshalToSizedBuffer(dAtA[:i])
+func (m *QueryNFTPairResponse) Reset()         { *m = QueryNFTPairResponse{} }
+func (m *QueryNFTPairResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryNFTPairResponse) ProtoMessage()    {}
+func (*QueryNFTPairResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_fba814bce17cabdf, []int{13}
+}
+func (m *QueryNFTPairResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryNFTPairResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryNFTPairResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
 		if err != nil {
-			return 0, err
+			return nil, err
 		}
-		i -= size
-		i = encodeVarintQuery(dAtA, i, uint64(size))
+		return b[:n], nil
 	}
-	i--
-	dAtA[i] = 0xa
-	return len(dAtA) - i, nil
 }
-
-func encodeVarintQuery(dAtA []byte, offset int, v uint64) int {
-	offset -= sovQuery(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
+func (m *QueryNFTPairResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryNFTPairResponse.Merge(m, src)
+}
+func (m *QueryNFTPairResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryNFTPairResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryNFTPairResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryNFTPairResponse proto.InternalMessageInfo
+
+func (m *QueryNFTPairResponse) GetNFTPair() NFTPair {
+	if m != nil {
+		return m.NFTPair
 	}
-	dAtA[offset] = uint8(v)
-	return base
+	return NFTPair{}
 }
-func (m *QueryTokenPairsRequest) Size() (n int) {
-	if m == nil {
-		return 0
+
+// QueryFailedConversionsRequest is the request type for the
+// Query/FailedConversions RPC method.
+type QueryFailedConversionsRequest struct {
+	// pagination defines an optional pagination for the request.
+	Pagination *query.PageRequest `protobuf:"bytes,1,opt,name=pagination,proto3" json:"pagination,omitempty"`
+	// sender is an optional hex or bech32 address filter of the account that
+	// transferred the tokens to the module address
+	Sender string `protobuf:"bytes,2,opt,name=sender,proto3" json:"sender,omitempty"`
+}
+
+func (m *QueryFailedConversionsRequest) Reset()         { *m = QueryFailedConversionsRequest{} }
+func (m *QueryFailedConversionsRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryFailedConversionsRequest) ProtoMessage()    {}
+func (*QueryFailedConversionsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_fba814bce17cabdf, []int{14}
+}
+func (m *QueryFailedConversionsRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryFailedConversionsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryFailedConversionsRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	var l int
-	_ = l
-	if m.Pagination != nil {
-		l = m.Pagination.Size()
-		n += 1 + l + sovQuery(uint64(l))
+}
+func (m *QueryFailedConversionsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryFailedConversionsRequest.Merge(m, src)
+}
+func (m *QueryFailedConversionsRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryFailedConversionsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryFailedConversionsRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryFailedConversionsRequest proto.InternalMessageInfo
+
+func (m *QueryFailedConversionsRequest) GetPagination() *query.PageRequest {
+	if m != nil {
+		return m.Pagination
 	}
-	return n
+	return nil
 }
 
-func (m *QueryTokenPairsResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *QueryFailedConversionsRequest) GetSender() string {
+	if m != nil {
+		return m.Sender
 	}
-	var l int
-	_ = l
-	if len(m.TokenPairs) > 0 {
-		for _, e := range m.TokenPairs {
-			l = e.Size()
-			n += 1 + l + sovQuery(uint64(l))
+	return ""
+}
+
+// QueryFailedConversionsResponse is the response type for the
+// Query/FailedConversions RPC method.
+type QueryFailedConversionsResponse struct {
+	// failed_conversions is a slice of the failed EVM hook conversions
+	FailedConversions []FailedConversion `protobuf:"bytes,1,rep,name=failed_conversions,json=failedConversions,proto3" json:"failed_conversions"`
+	// pagination defines the pagination in the response.
+	Pagination *query.PageResponse `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
+}
+
+func (m *QueryFailedConversionsResponse) Reset()         { *m = QueryFailedConversionsResponse{} }
+func (m *QueryFailedConversionsResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryFailedConversionsResponse) ProtoMessage()    {}
+func (*QueryFailedConversionsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_fba814bce17cabdf, []int{15}
+}
+func (m *QueryFailedConversionsResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryFailedConversionsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryFailedConversionsResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
+		return b[:n], nil
 	}
-	if m.Pagination != nil {
-		l = m.Pagination.Size()
-		n += 1 + l + sovQuery(uint64(l))
+}
+func (m *QueryFailedConversionsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryFailedConversionsResponse.Merge(m, src)
+}
+func (m *QueryFailedConversionsResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryFailedConversionsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryFailedConversionsResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryFailedConversionsResponse proto.InternalMessageInfo
+
+func (m *QueryFailedConversionsResponse) GetFailedConversions() []FailedConversion {
+	if m != nil {
+		return m.FailedConversions
 	}
-	return n
+	return nil
 }
 
-func (m *QueryTokenPairRequest) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *QueryFailedConversionsResponse) GetPagination() *query.PageResponse {
+	if m != nil {
+		return m.Pagination
 	}
-	var l int
-	_ = l
-	l = len(m.Token)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
+	return nil
+}
+
+// QueryParamsRequest is the request type for the Query/Params RPC method.
+type QueryParamsRequest struct {
+}
+
+func (m *QueryParamsRequest) Reset()         { *m = QueryParamsRequest{} }
+func (m *QueryParamsRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryParamsRequest) ProtoMessage()    {}
+func (*QueryParamsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_fba814bce17cabdf, []int{16}
+}
+func (m *QueryParamsRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryParamsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryParamsRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return n
+}
+func (m *QueryParamsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryParamsRequest.Merge(m, src)
+}
+func (m *QueryParamsRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryParamsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryParamsRequest.DiscardUnknown(m)
 }
 
-func (m *QueryTokenPairResponse) Size() (n int) {
-	if m == nil {
-		return 0
+var xxx_messageInfo_QueryParamsRequest proto.InternalMessageInfo
+
+// QueryParamsResponse is the response type for the Query/Params RPC
+// method.
+type QueryParamsResponse struct {
+	// params are the erc20 module parameters
+	Params Params `protobuf:"bytes,1,opt,name=params,proto3" json:"params"`
+}
+
+func (m *QueryParamsResponse) Reset()         { *m = QueryParamsResponse{} }
+func (m *QueryParamsResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryParamsResponse) ProtoMessage()    {}
+func (*QueryParamsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_fba814bce17cabdf, []int{17}
+}
+func (m *QueryParamsResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryParamsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryParamsResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	var l int
-	_ = l
-	l = m.TokenPair.Size()
-	n += 1 + l + sovQuery(uint64(l))
-	return n
+}
+func (m *QueryParamsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryParamsResponse.Merge(m, src)
+}
+func (m *QueryParamsResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryParamsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryParamsResponse.DiscardUnknown(m)
 }
 
-func (m *QueryParamsRequest) Size() (n int) {
-	if m == nil {
-		return 0
+var xxx_messageInfo_QueryParamsResponse proto.InternalMessageInfo
+
+func (m *QueryParamsResponse) GetParams() Params {
+	if m != nil {
+		return m.Params
 	}
-	var l int
-	_ = l
-	return n
+	return Params{}
 }
 
-func (m *QueryParamsResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func init() {
+	proto.RegisterEnum("evmos.erc20.v1.TokenPairStatus", TokenPairStatus_name, TokenPairStatus_value)
+	proto.RegisterType((*QueryTokenPairsRequest)(nil), "evmos.erc20.v1.QueryTokenPairsRequest")
+	proto.RegisterType((*QueryTokenPairsResponse)(nil), "evmos.erc20.v1.QueryTokenPairsResponse")
+	proto.RegisterType((*QueryTokenPairRequest)(nil), "evmos.erc20.v1.QueryTokenPairRequest")
+	proto.RegisterType((*QueryTokenPairResponse)(nil), "evmos.erc20.v1.QueryTokenPairResponse")
+	proto.RegisterType((*QueryTokenPairRateLimitRequest)(nil), "evmos.erc20.v1.QueryTokenPairRateLimitRequest")
+	proto.RegisterType((*QueryTokenPairRateLimitResponse)(nil), "evmos.erc20.v1.QueryTokenPairRateLimitResponse")
+	proto.RegisterType((*QueryTokenPairStatsRequest)(nil), "evmos.erc20.v1.QueryTokenPairStatsRequest")
+	proto.RegisterType((*QueryTokenPairStatsResponse)(nil), "evmos.erc20.v1.QueryTokenPairStatsResponse")
+	proto.RegisterType((*QueryERC20TemplatesRequest)(nil), "evmos.erc20.v1.QueryERC20TemplatesRequest")
+	proto.RegisterType((*QueryERC20TemplatesResponse)(nil), "evmos.erc20.v1.QueryERC20TemplatesResponse")
+	proto.RegisterType((*QueryNFTPairsRequest)(nil), "evmos.erc20.v1.QueryNFTPairsRequest")
+	proto.RegisterType((*QueryNFTPairsResponse)(nil), "evmos.erc20.v1.QueryNFTPairsResponse")
+	proto.RegisterType((*QueryNFTPairRequest)(nil), "evmos.erc20.v1.QueryNFTPairRequest")
+	proto.RegisterType((*QueryNFTPairResponse)(nil), "evmos.erc20.v1.QueryNFTPairResponse")
+	proto.RegisterType((*QueryFailedConversionsRequest)(nil), "evmos.erc20.v1.QueryFailedConversionsRequest")
+	proto.RegisterType((*QueryFailedConversionsResponse)(nil), "evmos.erc20.v1.QueryFailedConversionsResponse")
+	proto.RegisterType((*QueryParamsRequest)(nil), "evmos.erc20.v1.QueryParamsRequest")
+	proto.RegisterType((*QueryParamsResponse)(nil), "evmos.erc20.v1.QueryParamsResponse")
+}
+
+func init() { proto.RegisterFile("evmos/erc20/v1/query.proto", fileDescriptor_fba814bce17cabdf) }
+
+var fileDescriptor_fba814bce17cabdf = []byte{
+	// 1211 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xb4, 0x57, 0x4d, 0x6f, 0x1b, 0x55,
+	0x17, 0xf6, 0xe4, 0xab, 0xf1, 0x89, 0xea, 0x26, 0xb7, 0x49, 0xea, 0x4c, 0xde, 0xd8, 0xc9, 0xe4,
+	0xa3, 0x69, 0xa2, 0xcc, 0xc4, 0x7e, 0x51, 0xd9, 0x20, 0x44, 0xec, 0x38, 0x55, 0xd4, 0x2a, 0x0d,
+	0x63, 0x07, 0x21, 0x90, 0x18, 0x4d, 0x9c, 0x1b, 0x33, 0xd4, 0x9e, 0x3b, 0x99, 0xb9, 0x76, 0x09,
+	0x08, 0x55, 0xaa, 0x84, 0x60, 0xc1, 0x02, 0xc1, 0x16, 0xb1, 0x81, 0x05, 0x2c, 0xf8, 0x0f, 0x2c,
+	0xbb, 0xac, 0xc4, 0x02, 0xc4, 0x22, 0x42, 0x09, 0x3f, 0x04, 0xcd, 0x9d, 0x3b, 0x13, 0xcf, 0xf8,
+	0xb3, 0xc8, 0x6c, 0x5a, 0xcf, 0xb9, 0xe7, 0x9c, 0xe7, 0x79, 0xce, 0xbd, 0x3a, 0xe7, 0x04, 0x44,
+	0xdc, 0xa8, 0x11, 0x47, 0xc1, 0x76, 0x39, 0xbb, 0xad, 0x34, 0x32, 0xca, 0x59, 0x1d, 0xdb, 0xe7,
+	0xb2, 0x65, 0x13, 0x4a, 0x50, 0x82, 0x9d, 0xc9, 0xec, 0x4c, 0x6e, 0x64, 0xc4, 0x8d, 0x32, 0x71,
+	0x5c, 0xe7, 0x63, 0xdd, 0xc1, 0x9e, 0xa3, 0xd2, 0xc8, 0x1c, 0x63, 0xaa, 0x67, 0x14, 0x4b, 0xaf,
+	0x18, 0xa6, 0x4e, 0x0d, 0x62, 0x7a, 0xb1, 0x62, 0x34, 0xaf, 0x97, 0xc4, 0x3b, 0xfb, 0x5f, 0xe4,
+	0xac, 0x82, 0x4d, 0xec, 0x18, 0x0e, 0x3f, 0x9d, 0xae, 0x90, 0x0a, 0x61, 0x3f, 0x15, 0xf7, 0x97,
+	0x1f, 0x53, 0x21, 0xa4, 0x52, 0xc5, 0x8a, 0x6e, 0x19, 0x8a, 0x6e, 0x9a, 0x84, 0x32, 0x30, 0x1e,
+	0x23, 0xfd, 0x2e, 0xc0, 0xec, 0xdb, 0x2e, 0xa1, 0x12, 0x79, 0x82, 0xcd, 0x43, 0xdd, 0xb0, 0x1d,
+	0x15, 0x9f, 0xd5, 0xb1, 0x43, 0xd1, 0x1e, 0xc0, 0x35, 0xb9, 0xa4, 0xb0, 0x28, 0xac, 0x4f, 0x64,
+	0xd7, 0x64, 0x4f, 0x89, 0xec, 0x2a, 0x91, 0x3d, 0xc9, 0x5c, 0x89, 0x7c, 0xa8, 0x57, 0x30, 0x8f,
+	0x55, 0x9b, 0x22, 0xd1, 0x1b, 0x90, 0x28, 0x13, 0x93, 0xda, 0x7a, 0x99, 0x6a, 0xe4, 0xa9, 0x89,
+	0xed, 0xe4, 0xd0, 0xa2, 0xb0, 0x9e, 0xc8, 0xce, 0xc8, 0xe1, 0x2a, 0xc9, 0x8f, 0xdd, 0x43, 0xf5,
+	0xa6, 0xef, 0xcc, 0x3e, 0xd1, 0xeb, 0x30, 0xe6, 0x50, 0x9d, 0xd6, 0x9d, 0xe4, 0x30, 0x8b, 0x4a,
+	0x47, 0xa3, 0x02, 0xe2, 0x45, 0xe6, 0xa6, 0x72, 0x77, 0xe9, 0x47, 0x01, 0xee, 0xb4, 0x28, 0x73,
+	0x2c, 0x62, 0x3a, 0x18, 0xbd, 0x05, 0x13, 0xd4, 0xb5, 0x6a, 0x96, 0x6b, 0x4e, 0x0a, 0x8b, 0xc3,
+	0xeb, 0x13, 0xd9, 0xb9, 0x8e, 0x99, 0x73, 0x23, 0x2f, 0x2e, 0xd2, 0x31, 0x15, 0x68, 0x90, 0x09,
+	0x3d, 0x08, 0x15, 0x67, 0x88, 0x15, 0xe7, 0x6e, 0xcf, 0xe2, 0x78, 0xf0, 0xcd, 0xd5, 0x91, 0xb6,
+	0x60, 0x26, 0xcc, 0xd2, 0x2f, 0xff, 0x34, 0x8c, 0x32, 0x3c, 0x56, 0xf9, 0xb8, 0xea, 0x7d, 0x48,
+	0xef, 0x46, 0xaf, 0x2b, 0xd0, 0xf4, 0x26, 0xc0, 0xb5, 0x26, 0x7e, 0x5d, 0x3d, 0x25, 0xc5, 0x03,
+	0x49, 0xd2, 0x7d, 0x48, 0x45, 0x32, 0xeb, 0x14, 0x3f, 0x32, 0x6a, 0x06, 0xed, 0xce, 0xe8, 0x23,
+	0x48, 0x77, 0x8c, 0xe3, 0xd4, 0x1e, 0x00, 0xd8, 0x3a, 0xc5, 0x5a, 0xd5, 0xb5, 0x72, 0x6a, 0x52,
+	0x47, 0x6a, 0x41, 0xbc, 0xcf, 0xd1, 0xf6, 0x0d, 0x52, 0x16, 0xc4, 0x30, 0x96, 0x7b, 0xe7, 0x4e,
+	0x77, 0x7e, 0xdf, 0x8c, 0xc0, 0x7c, 0xdb, 0xa0, 0xc1, 0xd4, 0x0d, 0x51, 0x98, 0x2e, 0x13, 0xc3,
+	0xd4, 0x28, 0xd1, 0x98, 0xbb, 0xd6, 0x20, 0xd5, 0x7a, 0x0d, 0xb3, 0x37, 0x11, 0xcf, 0xe5, 0x5d,
+	0xf7, 0x3f, 0x2f, 0xd2, 0x6b, 0x15, 0x83, 0x7e, 0x58, 0x3f, 0x96, 0xcb, 0xa4, 0xa6, 0xf0, 0x66,
+	0xe0, 0xfd, 0xb7, 0xe5, 0x9c, 0x3c, 0x51, 0xe8, 0xb9, 0x85, 0x1d, 0x79, 0xdf, 0xa4, 0x97, 0x17,
+	0xe9, 0xa9, 0x3c, 0x31, 0xcc, 0x12, 0x29, 0xa8, 0xf9, 0xec, 0xf6, 0x3b, 0x2c, 0x95, 0x3a, 0x55,
+	0xf6, 0x4c, 0x76, 0xd9, 0x37, 0xb9, 0xa8, 0x1e, 0x1a, 0x25, 0x1a, 0x83, 0xe7, 0xa8, 0xc3, 0xff,
+	0x16, 0x95, 0xe1, 0x95, 0x88, 0x0b, 0xee, 0xa3, 0x32, 0x80, 0x66, 0x13, 0x3a, 0x82, 0x04, 0x76,
+	0xca, 0x36, 0x79, 0xaa, 0x1d, 0xeb, 0x55, 0xdd, 0x2c, 0xe3, 0xe4, 0x08, 0xc3, 0x93, 0x5f, 0x0d,
+	0x4f, 0xbd, 0xe9, 0x65, 0xc9, 0x79, 0x49, 0x90, 0x05, 0xc8, 0x17, 0x43, 0xf5, 0xaa, 0xe6, 0xd4,
+	0x2d, 0xab, 0x7a, 0x9e, 0x1c, 0x65, 0xa9, 0x73, 0xaf, 0x2c, 0x65, 0x92, 0x4b, 0xa1, 0x7a, 0xb5,
+	0xc8, 0x32, 0xa9, 0x93, 0x5c, 0x49, 0x60, 0x91, 0x4e, 0xf8, 0x43, 0xf2, 0x5c, 0x71, 0xcd, 0xaa,
+	0xea, 0x14, 0x0f, 0xba, 0xf3, 0x49, 0x3f, 0x0b, 0x30, 0xdf, 0x16, 0x86, 0x3f, 0xbd, 0x1d, 0x88,
+	0x53, 0xdf, 0xc8, 0x9b, 0xd0, 0x42, 0xf4, 0xe5, 0x85, 0x42, 0x83, 0xd7, 0xe7, 0x47, 0x0d, 0xae,
+	0x0f, 0x7d, 0x00, 0xd3, 0x8c, 0xea, 0xc1, 0x5e, 0xe9, 0xbf, 0x98, 0x02, 0xd2, 0x4f, 0x02, 0xcc,
+	0x44, 0x00, 0x78, 0x15, 0xf6, 0x20, 0x6e, 0x9e, 0xd2, 0x50, 0x2b, 0xbe, 0x13, 0xad, 0x02, 0x0f,
+	0xca, 0x4d, 0xba, 0xfa, 0x2f, 0x2f, 0xd2, 0xe3, 0x41, 0x96, 0x71, 0xf3, 0x94, 0x0e, 0xb8, 0x25,
+	0x6f, 0xc2, 0xed, 0x66, 0xa6, 0xdd, 0xdb, 0xcb, 0xfb, 0xe1, 0xba, 0x05, 0xaa, 0xf2, 0x30, 0xee,
+	0xab, 0xe2, 0x55, 0xeb, 0x28, 0xea, 0x16, 0x17, 0x75, 0xc3, 0xcf, 0x71, 0x83, 0x6b, 0x92, 0x9e,
+	0xc1, 0x02, 0x4b, 0xbe, 0xa7, 0x1b, 0x55, 0x7c, 0x92, 0x27, 0x66, 0x03, 0xdb, 0x8e, 0x3b, 0xbd,
+	0x07, 0x3d, 0xa3, 0x67, 0x61, 0xcc, 0xc1, 0xe6, 0x09, 0x9f, 0xcd, 0x71, 0x95, 0x7f, 0x49, 0xbf,
+	0x0a, 0x90, 0xea, 0xc4, 0x80, 0x0b, 0x3d, 0x02, 0x74, 0xca, 0x0e, 0xb5, 0xf2, 0xf5, 0x29, 0xbf,
+	0xc7, 0xc5, 0xa8, 0xe4, 0x68, 0x1a, 0xfe, 0xa0, 0xa7, 0x4e, 0xa3, 0xe9, 0x07, 0x77, 0x9b, 0xd3,
+	0x80, 0x98, 0x82, 0x43, 0xdd, 0xd6, 0x6b, 0x7e, 0xe1, 0xa4, 0x87, 0x70, 0x3b, 0x64, 0xe5, 0x62,
+	0x5e, 0x83, 0x31, 0x8b, 0x59, 0x78, 0x2d, 0x67, 0xa3, 0x02, 0x3c, 0x7f, 0x4e, 0x9b, 0xfb, 0x6e,
+	0x9c, 0xc3, 0xad, 0xc8, 0x16, 0x82, 0x96, 0x60, 0xa1, 0xf4, 0xf8, 0x61, 0xe1, 0x40, 0x3b, 0xdc,
+	0xd9, 0x57, 0xb5, 0x62, 0x69, 0xa7, 0x74, 0x54, 0xd4, 0x8e, 0x0e, 0x8a, 0x87, 0x85, 0xfc, 0xfe,
+	0xde, 0x7e, 0x61, 0x77, 0x32, 0x86, 0x16, 0x60, 0xae, 0xd5, 0xa5, 0x70, 0xb0, 0x93, 0x7b, 0x54,
+	0xd8, 0x9d, 0x14, 0x50, 0x0a, 0xc4, 0xd6, 0xe3, 0xdd, 0xfd, 0xa2, 0x77, 0x3e, 0x24, 0x8e, 0x7c,
+	0xf9, 0x43, 0x2a, 0x96, 0xfd, 0x1c, 0x60, 0x94, 0x09, 0x41, 0xcf, 0x05, 0x80, 0xd2, 0xf5, 0x82,
+	0xb2, 0x16, 0x65, 0xde, 0x7e, 0xcb, 0x13, 0xef, 0xf6, 0xf4, 0xf3, 0x4a, 0x23, 0x2d, 0x3f, 0xff,
+	0xed, 0xef, 0x6f, 0x87, 0x16, 0xd0, 0xbc, 0x12, 0x59, 0x42, 0x9b, 0x36, 0x29, 0xf4, 0x85, 0x00,
+	0xf1, 0x20, 0x16, 0xad, 0x76, 0xcf, 0xed, 0x53, 0x58, 0xeb, 0xe5, 0xc6, 0x19, 0x6c, 0x32, 0x06,
+	0xab, 0x68, 0xb9, 0x0b, 0x03, 0xe5, 0x53, 0xf6, 0xf1, 0x19, 0xfa, 0x45, 0x00, 0xd4, 0xba, 0x52,
+	0x20, 0xb9, 0x07, 0x56, 0x64, 0xe7, 0x11, 0x95, 0xbe, 0xfd, 0x39, 0xc9, 0xfb, 0x8c, 0xe4, 0x36,
+	0x92, 0xfb, 0x20, 0xa9, 0x5c, 0x6f, 0x45, 0xe8, 0x3b, 0x01, 0x12, 0xe1, 0x0d, 0x05, 0x6d, 0x74,
+	0xc7, 0x6e, 0xde, 0x7d, 0xc4, 0xcd, 0xbe, 0x7c, 0x39, 0xc7, 0x0c, 0xe3, 0xb8, 0x89, 0xee, 0xf5,
+	0xc3, 0xd1, 0x61, 0x5c, 0xbe, 0x12, 0x20, 0x11, 0x9e, 0x62, 0x1d, 0xe8, 0xb5, 0x9d, 0xa8, 0xe2,
+	0x66, 0x5f, 0xbe, 0x9c, 0xde, 0x12, 0xa3, 0x37, 0x8f, 0xe6, 0x5a, 0xe8, 0x05, 0xd8, 0x9f, 0x40,
+	0x30, 0x01, 0xd0, 0x4a, 0xdb, 0xdc, 0x91, 0x39, 0x26, 0xae, 0xf6, 0xf0, 0xea, 0x85, 0x1d, 0x8c,
+	0x28, 0xf4, 0x0c, 0xfc, 0x46, 0x8d, 0x96, 0xbb, 0x25, 0xf5, 0x91, 0x57, 0xba, 0x3b, 0x71, 0xe0,
+	0x7b, 0x0c, 0x78, 0x19, 0x2d, 0x75, 0x04, 0x0e, 0x9e, 0xf6, 0xf7, 0x02, 0x4c, 0xb5, 0xf4, 0x63,
+	0xb4, 0xd5, 0x16, 0xa6, 0xd3, 0xe4, 0x10, 0xe5, 0x7e, 0xdd, 0x39, 0xbf, 0x0d, 0xc6, 0x6f, 0x05,
+	0x49, 0x51, 0x7e, 0xad, 0xcd, 0x1f, 0x9d, 0xc1, 0x98, 0xd7, 0x27, 0x91, 0xd4, 0x16, 0x25, 0xd4,
+	0x8a, 0xc5, 0xe5, 0xae, 0x3e, 0x1c, 0x3e, 0xc5, 0xe0, 0x93, 0x68, 0x36, 0x0a, 0xef, 0xb5, 0xe0,
+	0x5c, 0xee, 0xc5, 0x65, 0x4a, 0x78, 0x79, 0x99, 0x12, 0xfe, 0xba, 0x4c, 0x09, 0x5f, 0x5f, 0xa5,
+	0x62, 0x2f, 0xaf, 0x52, 0xb1, 0x3f, 0xae, 0x52, 0xb1, 0xf7, 0xd6, 0x9b, 0x16, 0x47, 0x1e, 0xcb,
+	0xfe, 0x6d, 0x64, 0xb6, 0x95, 0x8f, 0x79, 0x1e, 0xb6, 0x3e, 0x1e, 0x8f, 0xb1, 0x3f, 0x89, 0xff,
+	0xff, 0xcf, 0x00, 0xdc, 0xa9, 0xa5, 0xad, 0xda, 0x0f, 0x00, 0x00,
+}
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ context.Context
+var _ grpc.ClientConn
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+const _ = grpc.SupportPackageIsVersion4
+
+// QueryClient is the client API for Query service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
+type QueryClient interface {
+	// TokenPairs retrieves registered token pairs
+	TokenPairs(ctx context.Context, in *QueryTokenPairsRequest, opts ...grpc.CallOption) (*QueryTokenPairsResponse, error)
+	// TokenPair retrieves a registered token pair
+	TokenPair(ctx context.Context, in *QueryTokenPairRequest, opts ...grpc.CallOption) (*QueryTokenPairResponse, error)
+	// TokenPairRateLimit retrieves the conversion rate limit of a registered
+	// token pair
+	TokenPairRateLimit(ctx context.Context, in *QueryTokenPairRateLimitRequest, opts ...grpc.CallOption) (*QueryTokenPairRateLimitResponse, error)
+	// TokenPairStats retrieves the cumulative conversion volumes, escrow
+	// balance and ERC20 total supply of a registered token pair
+	TokenPairStats(ctx context.Context, in *QueryTokenPairStatsRequest, opts ...grpc.CallOption) (*QueryTokenPairStatsResponse, error)
+	// ERC20Templates retrieves the registered ERC20 templates
+	ERC20Templates(ctx context.Context, in *QueryERC20TemplatesRequest, opts ...grpc.CallOption) (*QueryERC20TemplatesResponse, error)
+	// NFTPairs retrieves the registered NFT pairs
+	NFTPairs(ctx context.Context, in *QueryNFTPairsRequest, opts ...grpc.CallOption) (*QueryNFTPairsResponse, error)
+	// NFTPair retrieves a registered NFT pair
+	NFTPair(ctx context.Context, in *QueryNFTPairRequest, opts ...grpc.CallOption) (*QueryNFTPairResponse, error)
+	// FailedConversions retrieves the failed EVM hook conversions that have not
+	// been refunded, optionally filtered by sender
+	FailedConversions(ctx context.Context, in *QueryFailedConversionsRequest, opts ...grpc.CallOption) (*QueryFailedConversionsResponse, error)
+	// Params retrieves the erc20 module params
+	Params(ctx context.Context, in *QueryParamsRequest, opts ...grpc.CallOption) (*QueryParamsResponse, error)
+}
+
+type queryClient struct {
+	cc grpc1.ClientConn
+}
+
+func NewQueryClient(cc grpc1.ClientConn) QueryClient {
+	return &queryClient{cc}
+}
+
+func (c *queryClient) TokenPairs(ctx context.Context, in *QueryTokenPairsRequest, opts ...grpc.CallOption) (*QueryTokenPairsResponse, error) {
+	out := new(QueryTokenPairsResponse)
+	err := c.cc.Invoke(ctx, "/evmos.erc20.v1.Query/TokenPairs", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	l = m.Params.Size()
-	n += 1 + l + sovQuery(uint64(l))
-	return n
+	return out, nil
 }
 
-func sovQuery(x uint64) (n int) {
-	return (math_bits.Len64(x|1) + 6) / 7
-}
-func sozQuery(x uint64) (n int) {
-	return sovQuery(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+func (c *queryClient) TokenPair(ctx context.Context, in *QueryTokenPairRequest, opts ...grpc.CallOption) (*QueryTokenPairResponse, error) {
+	out := new(QueryTokenPairResponse)
+	err := c.cc.Invoke(ctx, "/evmos.erc20.v1.Query/TokenPair", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) TokenPairRateLimit(ctx context.Context, in *QueryTokenPairRateLimitRequest, opts ...grpc.CallOption) (*QueryTokenPairRateLimitResponse, error) {
+	out := new(QueryTokenPairRateLimitResponse)
+	err := c.cc.Invoke(ctx, "/evmos.erc20.v1.Query/TokenPairRateLimit", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) TokenPairStats(ctx context.Context, in *QueryTokenPairStatsRequest, opts ...grpc.CallOption) (*QueryTokenPairStatsResponse, error) {
+	out := new(QueryTokenPairStatsResponse)
+	err := c.cc.Invoke(ctx, "/evmos.erc20.v1.Query/TokenPairStats", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) ERC20Templates(ctx context.Context, in *QueryERC20TemplatesRequest, opts ...grpc.CallOption) (*QueryERC20TemplatesResponse, error) {
+	out := new(QueryERC20TemplatesResponse)
+	err := c.cc.Invoke(ctx, "/evmos.erc20.v1.Query/ERC20Templates", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) NFTPairs(ctx context.Context, in *QueryNFTPairsRequest, opts ...grpc.CallOption) (*QueryNFTPairsResponse, error) {
+	out := new(QueryNFTPairsResponse)
+	err := c.cc.Invoke(ctx, "/evmos.erc20.v1.Query/NFTPairs", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) NFTPair(ctx context.Context, in *QueryNFTPairRequest, opts ...grpc.CallOption) (*QueryNFTPairResponse, error) {
+	out := new(QueryNFTPairResponse)
+	err := c.cc.Invoke(ctx, "/evmos.erc20.v1.Query/NFTPair", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) FailedConversions(ctx context.Context, in *QueryFailedConversionsRequest, opts ...grpc.CallOption) (*QueryFailedConversionsResponse, error) {
+	out := new(QueryFailedConversionsResponse)
+	err := c.cc.Invoke(ctx, "/evmos.erc20.v1.Query/FailedConversions", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) Params(ctx context.Context, in *QueryParamsRequest, opts ...grpc.CallOption) (*QueryParamsResponse, error) {
+	out := new(QueryParamsResponse)
+	err := c.cc.Invoke(ctx, "/evmos.erc20.v1.Query/Params", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// QueryServer is the server API for Query service.
+type QueryServer interface {
+	// TokenPairs retrieves registered token pairs
+	TokenPairs(context.Context, *QueryTokenPairsRequest) (*QueryTokenPairsResponse, error)
+	// TokenPair retrieves a registered token pair
+	TokenPair(context.Context, *QueryTokenPairRequest) (*QueryTokenPairResponse, error)
+	// TokenPairRateLimit retrieves the conversion rate limit of a registered
+	// token pair
+	TokenPairRateLimit(context.Context, *QueryTokenPairRateLimitRequest) (*QueryTokenPairRateLimitResponse, error)
+	// TokenPairStats retrieves the cumulative conversion volumes, escrow
+	// balance and ERC20 total supply of a registered token pair
+	TokenPairStats(context.Context, *QueryTokenPairStatsRequest) (*QueryTokenPairStatsResponse, error)
+	// ERC20Templates retrieves the registered ERC20 templates
+	ERC20Templates(context.Context, *QueryERC20TemplatesRequest) (*QueryERC20TemplatesResponse, error)
+	// NFTPairs retrieves the registered NFT pairs
+	NFTPairs(context.Context, *QueryNFTPairsRequest) (*QueryNFTPairsResponse, error)
+	// NFTPair retrieves a registered NFT pair
+	NFTPair(context.Context, *QueryNFTPairRequest) (*QueryNFTPairResponse, error)
+	// FailedConversions retrieves the failed EVM hook conversions that have not
+	// been refunded, optionally filtered by sender
+	FailedConversions(context.Context, *QueryFailedConversionsRequest) (*QueryFailedConversionsResponse, error)
+	// Params retrieves the erc20 module params
+	Params(context.Context, *QueryParamsRequest) (*QueryParamsResponse, error)
+}
+
+// UnimplementedQueryServer can be embedded to have forward compatible implementations.
+type UnimplementedQueryServer struct {
+}
+
+func (*UnimplementedQueryServer) TokenPairs(ctx context.Context, req *QueryTokenPairsRequest) (*QueryTokenPairsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method TokenPairs not implemented")
+}
+func (*UnimplementedQueryServer) TokenPair(ctx context.Context, req *QueryTokenPairRequest) (*QueryTokenPairResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method TokenPair not implemented")
+}
+func (*UnimplementedQueryServer) TokenPairRateLimit(ctx context.Context, req *QueryTokenPairRateLimitRequest) (*QueryTokenPairRateLimitResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method TokenPairRateLimit not implemented")
+}
+func (*UnimplementedQueryServer) TokenPairStats(ctx context.Context, req *QueryTokenPairStatsRequest) (*QueryTokenPairStatsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method TokenPairStats not implemented")
+}
+func (*UnimplementedQueryServer) ERC20Templates(ctx context.Context, req *QueryERC20TemplatesRequest) (*QueryERC20TemplatesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ERC20Templates not implemented")
+}
+func (*UnimplementedQueryServer) NFTPairs(ctx context.Context, req *QueryNFTPairsRequest) (*QueryNFTPairsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method NFTPairs not implemented")
+}
+func (*UnimplementedQueryServer) NFTPair(ctx context.Context, req *QueryNFTPairRequest) (*QueryNFTPairResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method NFTPair not implemented")
+}
+func (*UnimplementedQueryServer) FailedConversions(ctx context.Context, req *QueryFailedConversionsRequest) (*QueryFailedConversionsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method FailedConversions not implemented")
+}
+func (*UnimplementedQueryServer) Params(ctx context.Context, req *QueryParamsRequest) (*QueryParamsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Params not implemented")
+}
+
+func RegisterQueryServer(s grpc1.Server, srv QueryServer) {
+	s.RegisterService(&_Query_serviceDesc, srv)
+}
+
+func _Query_TokenPairs_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryTokenPairsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).TokenPairs(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/evmos.erc20.v1.Query/TokenPairs",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).TokenPairs(ctx, req.(*QueryTokenPairsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_TokenPair_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryTokenPairRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).TokenPair(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/evmos.erc20.v1.Query/TokenPair",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).TokenPair(ctx, req.(*QueryTokenPairRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_TokenPairRateLimit_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryTokenPairRateLimitRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).TokenPairRateLimit(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/evmos.erc20.v1.Query/TokenPairRateLimit",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).TokenPairRateLimit(ctx, req.(*QueryTokenPairRateLimitRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_TokenPairStats_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryTokenPairStatsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).TokenPairStats(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/evmos.erc20.v1.Query/TokenPairStats",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).TokenPairStats(ctx, req.(*QueryTokenPairStatsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_ERC20Templates_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryERC20TemplatesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).ERC20Templates(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/evmos.erc20.v1.Query/ERC20Templates",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).ERC20Templates(ctx, req.(*QueryERC20TemplatesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_NFTPairs_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryNFTPairsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).NFTPairs(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/evmos.erc20.v1.Query/NFTPairs",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).NFTPairs(ctx, req.(*QueryNFTPairsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_NFTPair_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryNFTPairRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).NFTPair(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/evmos.erc20.v1.Query/NFTPair",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).NFTPair(ctx, req.(*QueryNFTPairRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_FailedConversions_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryFailedConversionsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).FailedConversions(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/evmos.erc20.v1.Query/FailedConversions",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).FailedConversions(ctx, req.(*QueryFailedConversionsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_Params_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryParamsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).Params(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/evmos.erc20.v1.Query/Params",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).Params(ctx, req.(*QueryParamsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+var _Query_serviceDesc = grpc.ServiceDesc{
+	ServiceName: "evmos.erc20.v1.Query",
+	HandlerType: (*QueryServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "TokenPairs",
+			Handler:    _Query_TokenPairs_Handler,
+		},
+		{
+			MethodName: "TokenPair",
+			Handler:    _Query_TokenPair_Handler,
+		},
+		{
+			MethodName: "TokenPairRateLimit",
+			Handler:    _Query_TokenPairRateLimit_Handler,
+		},
+		{
+			MethodName: "TokenPairStats",
+			Handler:    _Query_TokenPairStats_Handler,
+		},
+		{
+			MethodName: "ERC20Templates",
+			Handler:    _Query_ERC20Templates_Handler,
+		},
+		{
+			MethodName: "NFTPairs",
+			Handler:    _Query_NFTPairs_Handler,
+		},
+		{
+			MethodName: "NFTPair",
+			Handler:    _Query_NFTPair_Handler,
+		},
+		{
+			MethodName: "FailedConversions",
+			Handler:    _Query_FailedConversions_Handler,
+		},
+		{
+			MethodName: "Params",
+			Handler:    _Query_Params_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "evmos/erc20/v1/query.proto",
+}
+
+func (m *QueryTokenPairsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryTokenPairsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryTokenPairsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Status != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.Status))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.ContractOwner != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.ContractOwner))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryTokenPairsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryTokenPairsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryTokenPairsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.TokenPairs) > 0 {
+		for iNdEx := len(m.TokenPairs) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.TokenPairs[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryTokenPairRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryTokenPairRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryTokenPairRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Token) > 0 {
+		i -= len(m.Token)
+		copy(dAtA[i:], m.Token)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Token)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryTokenPairResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryTokenPairResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryTokenPairResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.TokenPair.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryTokenPairRateLimitRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryTokenPairRateLimitRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryTokenPairRateLimitRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Token) > 0 {
+		i -= len(m.Token)
+		copy(dAtA[i:], m.Token)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Token)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryTokenPairRateLimitResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryTokenPairRateLimitResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryTokenPairRateLimitResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.RateLimit.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryTokenPairStatsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryTokenPairStatsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryTokenPairStatsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Token) > 0 {
+		i -= len(m.Token)
+		copy(dAtA[i:], m.Token)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Token)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryTokenPairStatsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryTokenPairStatsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryTokenPairStatsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size := m.ERC20TotalSupply.Size()
+		i -= size
+		if _, err := m.ERC20TotalSupply.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x2a
+	{
+		size := m.EscrowBalance.Size()
+		i -= size
+		if _, err := m.EscrowBalance.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x22
+	{
+		size := m.ERC20ToCoinVolume.Size()
+		i -= size
+		if _, err := m.ERC20ToCoinVolume.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	{
+		size := m.CoinToERC20Volume.Size()
+		i -= size
+		if _, err := m.CoinToERC20Volume.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	{
+		size, err := m.TokenPair.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryERC20TemplatesRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryERC20TemplatesRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryERC20TemplatesRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryERC20TemplatesResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryERC20TemplatesResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryERC20TemplatesResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Templates) > 0 {
+		for iNdEx := len(m.Templates) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Templates[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryNFTPairsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryNFTPairsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryNFTPairsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryNFTPairsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryNFTPairsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryNFTPairsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.NFTPairs) > 0 {
+		for iNdEx := len(m.NFTPairs) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.NFTPairs[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryNFTPairRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryNFTPairRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryNFTPairRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Token) > 0 {
+		i -= len(m.Token)
+		copy(dAtA[i:], m.Token)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Token)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryNFTPairResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryNFTPairResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryNFTPairResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.NFTPair.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryFailedConversionsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryFailedConversionsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryFailedConversionsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Sender) > 0 {
+		i -= len(m.Sender)
+		copy(dAtA[i:], m.Sender)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Sender)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryFailedConversionsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryFailedConversionsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryFailedConversionsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.FailedConversions) > 0 {
+		for iNdEx := len(m.FailedConversions) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.FailedConversions[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryParamsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryParamsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryParamsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryParamsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryParamsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryParamsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.Params.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintQuery(dAtA []byte, offset int, v uint64) int {
+	offset -= sovQuery(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *QueryTokenPairsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.ContractOwner != 0 {
+		n += 1 + sovQuery(uint64(m.ContractOwner))
+	}
+	if m.Status != 0 {
+		n += 1 + sovQuery(uint64(m.Status))
+	}
+	return n
+}
+
+func (m *QueryTokenPairsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.TokenPairs) > 0 {
+		for _, e := range m.TokenPairs {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryTokenPairRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Token)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryTokenPairResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.TokenPair.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *QueryTokenPairRateLimitRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Token)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryTokenPairRateLimitResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.RateLimit.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *QueryTokenPairStatsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Token)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryTokenPairStatsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.TokenPair.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	l = m.CoinToERC20Volume.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	l = m.ERC20ToCoinVolume.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	l = m.EscrowBalance.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	l = m.ERC20TotalSupply.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *QueryERC20TemplatesRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryERC20TemplatesResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Templates) > 0 {
+		for _, e := range m.Templates {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryNFTPairsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryNFTPairsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.NFTPairs) > 0 {
+		for _, e := range m.NFTPairs {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryNFTPairRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Token)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryNFTPairResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.NFTPair.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *QueryFailedConversionsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.Sender)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryFailedConversionsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.FailedConversions) > 0 {
+		for _, e := range m.FailedConversions {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryParamsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *QueryParamsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Params.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func sovQuery(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozQuery(x uint64) (n int) {
+	return sovQuery(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *QueryTokenPairsRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryTokenPairsRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryTokenPairsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageRequest{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ContractOwner", wireType)
+			}
+			m.ContractOwner = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ContractOwner |= Owner(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Status", wireType)
+			}
+			m.Status = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Status |= TokenPairStatus(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryTokenPairsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryTokenPairsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryTokenPairsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TokenPairs", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.TokenPairs = append(m.TokenPairs, TokenPair{})
+			if err := m.TokenPairs[len(m.TokenPairs)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageResponse{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryTokenPairRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryTokenPairRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryTokenPairRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Token", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Token = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryTokenPairResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryTokenPairResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryTokenPairResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TokenPair", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.TokenPair.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryTokenPairRateLimitRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryTokenPairRateLimitRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryTokenPairRateLimitRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Token", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Token = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryTokenPairRateLimitResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryTokenPairRateLimitResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryTokenPairRateLimitResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RateLimit", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.RateLimit.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryTokenPairStatsRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryTokenPairStatsRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryTokenPairStatsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Token", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Token = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryTokenPairStatsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryTokenPairStatsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryTokenPairStatsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TokenPair", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.TokenPair.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CoinToERC20Volume", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.CoinToERC20Volume.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ERC20ToCoinVolume", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.ERC20ToCoinVolume.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EscrowBalance", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.EscrowBalance.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ERC20TotalSupply", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.ERC20TotalSupply.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryERC20TemplatesRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryERC20TemplatesRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryERC20TemplatesRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageRequest{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryERC20TemplatesResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryERC20TemplatesResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryERC20TemplatesResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Templates", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Templates = append(m.Templates, ERC20Template{})
+			if err := m.Templates[len(m.Templates)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageResponse{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
 }
-func (m *QueryTokenPairsRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryNFTPairsRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -835,10 +3560,10 @@ func (m *QueryTokenPairsRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryTokenPairsRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryNFTPairsRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryTokenPairsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryNFTPairsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -898,7 +3623,7 @@ func (m *QueryTokenPairsRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryTokenPairsResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryNFTPairsResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -921,15 +3646,15 @@ func (m *QueryTokenPairsResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryTokenPairsResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryNFTPairsResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryTokenPairsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryNFTPairsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field TokenPairs", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field NFTPairs", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -956,8 +3681,8 @@ func (m *QueryTokenPairsResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.TokenPairs = append(m.TokenPairs, TokenPair{})
-			if err := m.TokenPairs[len(m.TokenPairs)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.NFTPairs = append(m.NFTPairs, NFTPair{})
+			if err := m.NFTPairs[len(m.NFTPairs)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -1018,7 +3743,7 @@ func (m *QueryTokenPairsResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryTokenPairRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryNFTPairRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1041,10 +3766,10 @@ func (m *QueryTokenPairRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryTokenPairRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryNFTPairRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryTokenPairRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryNFTPairRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -1100,7 +3825,7 @@ func (m *QueryTokenPairRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryTokenPairResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryNFTPairResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1123,15 +3848,15 @@ func (m *QueryTokenPairResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryTokenPairResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryNFTPairResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryTokenPairResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryNFTPairResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field TokenPair", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field NFTPair", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -1158,7 +3883,245 @@ func (m *QueryTokenPairResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.TokenPair.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.NFTPair.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryFailedConversionsRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryFailedConversionsRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryFailedConversionsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageRequest{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Sender", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Sender = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryFailedConversionsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryFailedConversionsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryFailedConversionsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FailedConversions", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.FailedConversions = append(m.FailedConversions, FailedConversion{})
+			if err := m.FailedConversions[len(m.FailedConversions)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageResponse{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
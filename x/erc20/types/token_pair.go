@@ -38,6 +38,10 @@ func (tp TokenPair) Validate() error {
 		return err
 	}
 
+	if tp.TemplateID != "" {
+		return ValidateERC20TemplateID(tp.TemplateID)
+	}
+
 	return nil
 }
 
@@ -0,0 +1,35 @@
+package types
+
+import (
+	errorsmod "cosmossdk.io/errors"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/ethereum/go-ethereum/common"
+	ethermint "github.com/evmos/ethermint/types"
+)
+
+// NewTokenPairDeposit returns an instance of TokenPairDeposit
+func NewTokenPairDeposit(erc20Address common.Address, depositor sdk.AccAddress, amount sdk.Coins) TokenPairDeposit {
+	return TokenPairDeposit{
+		Erc20Address: erc20Address.String(),
+		Depositor:    depositor.String(),
+		Amount:       amount,
+	}
+}
+
+// GetERC20Contract casts the hex string address of the ERC20 to common.Address
+func (d TokenPairDeposit) GetERC20Contract() common.Address {
+	return common.HexToAddress(d.Erc20Address)
+}
+
+// Validate performs a stateless validation of a TokenPairDeposit
+func (d TokenPairDeposit) Validate() error {
+	if err := ethermint.ValidateAddress(d.Erc20Address); err != nil {
+		return err
+	}
+
+	if _, err := sdk.AccAddressFromBech32(d.Depositor); err != nil {
+		return errorsmod.Wrap(err, "invalid depositor address")
+	}
+
+	return d.Amount.Validate()
+}
@@ -0,0 +1,114 @@
+package types
+
+import (
+	"fmt"
+
+	"cosmossdk.io/math"
+	"github.com/ethereum/go-ethereum/common"
+	ethermint "github.com/evmos/ethermint/types"
+
+	epochstypes "github.com/evmos/evmos/v10/x/epochs/types"
+)
+
+// ConversionDirection defines the direction of a token pair conversion
+type ConversionDirection int
+
+const (
+	// CoinToERC20 is the conversion of Cosmos coins to ERC20 tokens
+	CoinToERC20 ConversionDirection = iota
+	// ERC20ToCoin is the conversion of ERC20 tokens to Cosmos coins
+	ERC20ToCoin
+)
+
+// String implements the Stringer interface
+func (d ConversionDirection) String() string {
+	switch d {
+	case CoinToERC20:
+		return "coin_to_erc20"
+	case ERC20ToCoin:
+		return "erc20_to_coin"
+	default:
+		return "unspecified"
+	}
+}
+
+// NewTokenPairRateLimit returns an instance of TokenPairRateLimit with zero
+// converted volumes
+func NewTokenPairRateLimit(
+	erc20Address common.Address,
+	epochIdentifier string,
+	coinToERC20Limit, erc20ToCoinLimit math.Int,
+) TokenPairRateLimit {
+	return TokenPairRateLimit{
+		Erc20Address:      erc20Address.String(),
+		EpochIdentifier:   epochIdentifier,
+		CoinToERC20Limit:  coinToERC20Limit,
+		ERC20ToCoinLimit:  erc20ToCoinLimit,
+		CoinToERC20Volume: math.ZeroInt(),
+		ERC20ToCoinVolume: math.ZeroInt(),
+	}
+}
+
+// GetERC20Contract casts the hex string address of the ERC20 to common.Address
+func (rl TokenPairRateLimit) GetERC20Contract() common.Address {
+	return common.HexToAddress(rl.Erc20Address)
+}
+
+// Limit returns the maximum volume per epoch for the given direction
+func (rl TokenPairRateLimit) Limit(direction ConversionDirection) math.Int {
+	if direction == CoinToERC20 {
+		return rl.CoinToERC20Limit
+	}
+	return rl.ERC20ToCoinLimit
+}
+
+// Volume returns the volume converted during the current epoch for the given
+// direction
+func (rl TokenPairRateLimit) Volume(direction ConversionDirection) math.Int {
+	if direction == CoinToERC20 {
+		return rl.CoinToERC20Volume
+	}
+	return rl.ERC20ToCoinVolume
+}
+
+// AddVolume returns a copy of the rate limit with the amount added to the
+// converted volume of the given direction
+func (rl TokenPairRateLimit) AddVolume(direction ConversionDirection, amount math.Int) TokenPairRateLimit {
+	if direction == CoinToERC20 {
+		rl.CoinToERC20Volume = rl.CoinToERC20Volume.Add(amount)
+	} else {
+		rl.ERC20ToCoinVolume = rl.ERC20ToCoinVolume.Add(amount)
+	}
+	return rl
+}
+
+// IsLimited returns true if the volume of the given direction is limited
+func (rl TokenPairRateLimit) IsLimited(direction ConversionDirection) bool {
+	return rl.Limit(direction).IsPositive()
+}
+
+// Validate performs a stateless validation of a TokenPairRateLimit
+func (rl TokenPairRateLimit) Validate() error {
+	if err := ethermint.ValidateAddress(rl.Erc20Address); err != nil {
+		return err
+	}
+
+	if err := epochstypes.ValidateEpochIdentifierString(rl.EpochIdentifier); err != nil {
+		return err
+	}
+
+	for _, amount := range []math.Int{
+		rl.CoinToERC20Limit, rl.ERC20ToCoinLimit,
+		rl.CoinToERC20Volume, rl.ERC20ToCoinVolume,
+	} {
+		if amount.IsNil() || amount.IsNegative() {
+			return fmt.Errorf("rate limit amounts cannot be nil or negative: %s", amount)
+		}
+	}
+
+	if !rl.IsLimited(CoinToERC20) && !rl.IsLimited(ERC20ToCoin) {
+		return fmt.Errorf("rate limit for %s must define at least one positive limit", rl.Erc20Address)
+	}
+
+	return nil
+}
@@ -0,0 +1,50 @@
+package types
+
+import (
+	"fmt"
+
+	"cosmossdk.io/math"
+	"github.com/ethereum/go-ethereum/common"
+	ethermint "github.com/evmos/ethermint/types"
+)
+
+// NewTokenPairStats returns an instance of TokenPairStats with zero converted
+// volumes
+func NewTokenPairStats(erc20Address common.Address) TokenPairStats {
+	return TokenPairStats{
+		Erc20Address:      erc20Address.String(),
+		CoinToERC20Volume: math.ZeroInt(),
+		ERC20ToCoinVolume: math.ZeroInt(),
+	}
+}
+
+// GetERC20Contract casts the hex string address of the ERC20 to common.Address
+func (s TokenPairStats) GetERC20Contract() common.Address {
+	return common.HexToAddress(s.Erc20Address)
+}
+
+// AddVolume returns a copy of the stats with the amount added to the converted
+// volume of the given direction
+func (s TokenPairStats) AddVolume(direction ConversionDirection, amount math.Int) TokenPairStats {
+	if direction == CoinToERC20 {
+		s.CoinToERC20Volume = s.CoinToERC20Volume.Add(amount)
+	} else {
+		s.ERC20ToCoinVolume = s.ERC20ToCoinVolume.Add(amount)
+	}
+	return s
+}
+
+// Validate performs a stateless validation of a TokenPairStats
+func (s TokenPairStats) Validate() error {
+	if err := ethermint.ValidateAddress(s.Erc20Address); err != nil {
+		return err
+	}
+
+	for _, amount := range []math.Int{s.CoinToERC20Volume, s.ERC20ToCoinVolume} {
+		if amount.IsNil() || amount.IsNegative() {
+			return fmt.Errorf("token pair stats volumes cannot be nil or negative: %s", amount)
+		}
+	}
+
+	return nil
+}
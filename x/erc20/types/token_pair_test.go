@@ -58,10 +58,10 @@ func (suite *TokenPairTestSuite) TestTokenPair() {
 		pair       TokenPair
 		expectPass bool
 	}{
-		{msg: "Register token pair - invalid address (no hex)", pair: TokenPair{"0x5dCA2483280D9727c80b5518faC4556617fb19ZZ", "test", true, OWNER_MODULE}, expectPass: false},
-		{msg: "Register token pair - invalid address (invalid length 1)", pair: TokenPair{"0x5dCA2483280D9727c80b5518faC4556617fb19", "test", true, OWNER_MODULE}, expectPass: false},
-		{msg: "Register token pair - invalid address (invalid length 2)", pair: TokenPair{"0x5dCA2483280D9727c80b5518faC4556617fb194FFF", "test", true, OWNER_MODULE}, expectPass: false},
-		{msg: "pass", pair: TokenPair{tests.GenerateAddress().String(), "test", true, OWNER_MODULE}, expectPass: true},
+		{msg: "Register token pair - invalid address (no hex)", pair: TokenPair{"0x5dCA2483280D9727c80b5518faC4556617fb19ZZ", "test", true, OWNER_MODULE, ""}, expectPass: false},
+		{msg: "Register token pair - invalid address (invalid length 1)", pair: TokenPair{"0x5dCA2483280D9727c80b5518faC4556617fb19", "test", true, OWNER_MODULE, ""}, expectPass: false},
+		{msg: "Register token pair - invalid address (invalid length 2)", pair: TokenPair{"0x5dCA2483280D9727c80b5518faC4556617fb194FFF", "test", true, OWNER_MODULE, ""}, expectPass: false},
+		{msg: "pass", pair: TokenPair{tests.GenerateAddress().String(), "test", true, OWNER_MODULE, ""}, expectPass: true},
 	}
 
 	for i, tc := range testCases {
@@ -100,17 +100,17 @@ func (suite *TokenPairTestSuite) TestIsNativeCoin() {
 	}{
 		{
 			"no owner",
-			TokenPair{tests.GenerateAddress().String(), "test", true, OWNER_UNSPECIFIED},
+			TokenPair{tests.GenerateAddress().String(), "test", true, OWNER_UNSPECIFIED, ""},
 			false,
 		},
 		{
 			"external ERC20 owner",
-			TokenPair{tests.GenerateAddress().String(), "test", true, OWNER_EXTERNAL},
+			TokenPair{tests.GenerateAddress().String(), "test", true, OWNER_EXTERNAL, ""},
 			false,
 		},
 		{
 			"pass",
-			TokenPair{tests.GenerateAddress().String(), "test", true, OWNER_MODULE},
+			TokenPair{tests.GenerateAddress().String(), "test", true, OWNER_MODULE, ""},
 			true,
 		},
 	}
@@ -133,17 +133,17 @@ func (suite *TokenPairTestSuite) TestIsNativeERC20() {
 	}{
 		{
 			"no owner",
-			TokenPair{tests.GenerateAddress().String(), "test", true, OWNER_UNSPECIFIED},
+			TokenPair{tests.GenerateAddress().String(), "test", true, OWNER_UNSPECIFIED, ""},
 			false,
 		},
 		{
 			"module owner",
-			TokenPair{tests.GenerateAddress().String(), "test", true, OWNER_MODULE},
+			TokenPair{tests.GenerateAddress().String(), "test", true, OWNER_MODULE, ""},
 			false,
 		},
 		{
 			"pass",
-			TokenPair{tests.GenerateAddress().String(), "test", true, OWNER_EXTERNAL},
+			TokenPair{tests.GenerateAddress().String(), "test", true, OWNER_EXTERNAL, ""},
 			true,
 		},
 	}
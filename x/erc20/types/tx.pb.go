@@ -8,6 +8,7 @@ import (
 	fmt "fmt"
 	github_com_cosmos_cosmos_sdk_types "github.com/cosmos/cosmos-sdk/types"
 	types "github.com/cosmos/cosmos-sdk/types"
+	types1 "github.com/cosmos/cosmos-sdk/x/bank/types"
 	_ "github.com/cosmos/gogoproto/gogoproto"
 	grpc1 "github.com/gogo/protobuf/grpc"
 	proto "github.com/gogo/protobuf/proto"
@@ -237,397 +238,4446 @@ func (m *MsgConvertERC20Response) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_MsgConvertERC20Response proto.InternalMessageInfo
 
-func init() {
-	proto.RegisterType((*MsgConvertCoin)(nil), "evmos.erc20.v1.MsgConvertCoin")
-	proto.RegisterType((*MsgConvertCoinResponse)(nil), "evmos.erc20.v1.MsgConvertCoinResponse")
-	proto.RegisterType((*MsgConvertERC20)(nil), "evmos.erc20.v1.MsgConvertERC20")
-	proto.RegisterType((*MsgConvertERC20Response)(nil), "evmos.erc20.v1.MsgConvertERC20Response")
+// MsgRegisterERC20 defines a Msg to register a token pair for an ERC20 token
+// contract by escrowing the registration deposit defined in the module params
+type MsgRegisterERC20 struct {
+	// contract_address of the ERC20 token contract to register
+	ContractAddress string `protobuf:"bytes,1,opt,name=contract_address,json=contractAddress,proto3" json:"contract_address,omitempty"`
+	// sender is the cosmos bech32 address of the account that pays the registration deposit
+	Sender string `protobuf:"bytes,2,opt,name=sender,proto3" json:"sender,omitempty"`
 }
 
-func init() { proto.RegisterFile("evmos/erc20/v1/tx.proto", fileDescriptor_f8926fc6cb676914) }
-
-var fileDescriptor_f8926fc6cb676914 = []byte{
-	// 453 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x84, 0x52, 0x31, 0x6f, 0x13, 0x31,
-	0x14, 0x8e, 0x93, 0x28, 0xa2, 0x2e, 0x6a, 0x91, 0x85, 0xda, 0xf4, 0x84, 0x9c, 0x90, 0xa1, 0x0d,
-	0x03, 0x76, 0x2e, 0xfd, 0x05, 0x24, 0x02, 0x89, 0xa1, 0xcb, 0x8d, 0x2c, 0x95, 0xcf, 0x67, 0x1d,
-	0x27, 0x88, 0xdf, 0xc9, 0x76, 0x4f, 0xed, 0xc2, 0xd0, 0x91, 0x09, 0x89, 0x3f, 0xc3, 0x4f, 0xe8,
-	0x58, 0x89, 0x05, 0x31, 0x54, 0x28, 0xe1, 0x87, 0xa0, 0xf3, 0x5d, 0x43, 0x0f, 0xd4, 0x74, 0x49,
-	0xec, 0xf7, 0x7d, 0xf7, 0xbe, 0xef, 0x7d, 0xcf, 0x78, 0x5f, 0x15, 0x0b, 0xb0, 0x5c, 0x19, 0x39,
-	0x9d, 0xf0, 0x22, 0xe4, 0xee, 0x9c, 0xe5, 0x06, 0x1c, 0x90, 0x1d, 0x0f, 0x30, 0x0f, 0xb0, 0x22,
-	0x0c, 0xa8, 0x04, 0x5b, 0x32, 0x63, 0x61, 0x15, 0x2f, 0xc2, 0x58, 0x39, 0x11, 0x72, 0x09, 0x99,
-	0xae, 0xf8, 0xc1, 0xd3, 0x14, 0x52, 0xf0, 0x47, 0x5e, 0x9e, 0xea, 0xea, 0xb3, 0x14, 0x20, 0xfd,
-	0xa8, 0xb8, 0xc8, 0x33, 0x2e, 0xb4, 0x06, 0x27, 0x5c, 0x06, 0xda, 0x56, 0xe8, 0xe8, 0x02, 0xef,
-	0x9c, 0xd8, 0x74, 0x0e, 0xba, 0x50, 0xc6, 0xcd, 0x21, 0xd3, 0xe4, 0x18, 0x77, 0xcb, 0x9e, 0x7d,
-	0x34, 0x44, 0xe3, 0xed, 0xe9, 0x01, 0xab, 0x44, 0x59, 0x29, 0xca, 0x6a, 0x51, 0x56, 0x12, 0x67,
-	0xdd, 0xab, 0x9b, 0x41, 0x2b, 0xf2, 0x64, 0x12, 0xe0, 0x47, 0x46, 0x49, 0x95, 0x15, 0xca, 0xf4,
-	0xdb, 0x43, 0x34, 0xde, 0x8a, 0xd6, 0x77, 0xb2, 0x87, 0x7b, 0x56, 0xe9, 0x44, 0x99, 0x7e, 0xc7,
-	0x23, 0xf5, 0x6d, 0xd4, 0xc7, 0x7b, 0x4d, 0xe9, 0x48, 0xd9, 0x1c, 0xb4, 0x55, 0xa3, 0x6f, 0x08,
-	0xef, 0xfe, 0x85, 0x5e, 0x47, 0xf3, 0xe9, 0x84, 0xbc, 0xc0, 0x4f, 0x24, 0x68, 0x67, 0x84, 0x74,
-	0xa7, 0x22, 0x49, 0x8c, 0xb2, 0xd6, 0x5b, 0xdc, 0x8a, 0x76, 0x6f, 0xeb, 0xaf, 0xaa, 0x32, 0x79,
-	0x83, 0x7b, 0x62, 0x01, 0x67, 0xda, 0x55, 0x56, 0x66, 0xac, 0x34, 0xfa, 0xf3, 0x66, 0x70, 0x98,
-	0x66, 0xee, 0xfd, 0x59, 0xcc, 0x24, 0x2c, 0x78, 0x1d, 0x65, 0xf5, 0xf7, 0xd2, 0x26, 0x1f, 0xb8,
-	0xbb, 0xc8, 0x95, 0x65, 0x6f, 0xb5, 0x8b, 0xea, 0xaf, 0x1b, 0x43, 0x75, 0xee, 0x1d, 0xaa, 0xdb,
-	0x18, 0xea, 0x00, 0xef, 0xff, 0xe3, 0xfc, 0x76, 0xaa, 0xe9, 0xe7, 0x36, 0xee, 0x9c, 0xd8, 0x94,
-	0x7c, 0xc2, 0xdb, 0x77, 0xf3, 0xa6, 0xac, 0xb9, 0x66, 0xd6, 0x0c, 0x25, 0x38, 0xdc, 0x8c, 0xaf,
-	0x43, 0x3b, 0xba, 0xfc, 0xfe, 0xfb, 0x6b, 0xfb, 0x39, 0x19, 0xf0, 0xff, 0xde, 0x13, 0x97, 0x15,
-	0xff, 0xd4, 0xef, 0xea, 0x12, 0xe1, 0xc7, 0x8d, 0x68, 0x07, 0xf7, 0x2b, 0x78, 0x42, 0x70, 0xf4,
-	0x00, 0x61, 0xed, 0x61, 0xec, 0x3d, 0x8c, 0xc8, 0x70, 0x83, 0x07, 0x5f, 0x9b, 0xcd, 0xae, 0x96,
-	0x14, 0x5d, 0x2f, 0x29, 0xfa, 0xb5, 0xa4, 0xe8, 0xcb, 0x8a, 0xb6, 0xae, 0x57, 0xb4, 0xf5, 0x63,
-	0x45, 0x5b, 0xef, 0xc6, 0x77, 0xb6, 0x54, 0x77, 0xf1, 0xbf, 0x45, 0x38, 0xe1, 0xe7, 0x75, 0x47,
-	0xbf, 0xab, 0xb8, 0xe7, 0x9f, 0xf0, 0xf1, 0x9f, 0x00, 0x00, 0x00, 0xff, 0xff, 0x0a, 0x52, 0x11,
-	0x3e, 0x41, 0x03, 0x00, 0x00,
+func (m *MsgRegisterERC20) Reset()         { *m = MsgRegisterERC20{} }
+func (m *MsgRegisterERC20) String() string { return proto.CompactTextString(m) }
+func (*MsgRegisterERC20) ProtoMessage()    {}
+func (*MsgRegisterERC20) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f8926fc6cb676914, []int{4}
 }
-
-// Reference imports to suppress errors if they are not otherwise used.
-var _ context.Context
-var _ grpc.ClientConn
-
-// This is a compile-time assertion to ensure that this generated file
-// is compatible with the grpc package it is being compiled against.
-const _ = grpc.SupportPackageIsVersion4
-
-// MsgClient is the client API for Msg service.
-//
-// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
-type MsgClient interface {
-	// ConvertCoin mints a ERC20 representation of the native Cosmos coin denom
-	// that is registered on the token mapping.
-	ConvertCoin(ctx context.Context, in *MsgConvertCoin, opts ...grpc.CallOption) (*MsgConvertCoinResponse, error)
-	// ConvertERC20 mints a native Cosmos coin representation of the ERC20 token
-	// contract that is registered on the token mapping.
-	ConvertERC20(ctx context.Context, in *MsgConvertERC20, opts ...grpc.CallOption) (*MsgConvertERC20Response, error)
+func (m *MsgRegisterERC20) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
 }
-
-type msgClient struct {
-	cc grpc1.ClientConn
+func (m *MsgRegisterERC20) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgRegisterERC20.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
 }
-
-func NewMsgClient(cc grpc1.ClientConn) MsgClient {
-	return &msgClient{cc}
+func (m *MsgRegisterERC20) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgRegisterERC20.Merge(m, src)
+}
+func (m *MsgRegisterERC20) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgRegisterERC20) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgRegisterERC20.DiscardUnknown(m)
 }
 
-func (c *msgClient) ConvertCoin(ctx context.Context, in *MsgConvertCoin, opts ...grpc.CallOption) (*MsgConvertCoinResponse, error) {
-	out := new(MsgConvertCoinResponse)
-	err := c.cc.Invoke(ctx, "/evmos.erc20.v1.Msg/ConvertCoin", in, out, opts...)
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_MsgRegisterERC20 proto.InternalMessageInfo
+
+func (m *MsgRegisterERC20) GetContractAddress() string {
+	if m != nil {
+		return m.ContractAddress
 	}
-	return out, nil
+	return ""
 }
 
-func (c *msgClient) ConvertERC20(ctx context.Context, in *MsgConvertERC20, opts ...grpc.CallOption) (*MsgConvertERC20Response, error) {
-	out := new(MsgConvertERC20Response)
-	err := c.cc.Invoke(ctx, "/evmos.erc20.v1.Msg/ConvertERC20", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *MsgRegisterERC20) GetSender() string {
+	if m != nil {
+		return m.Sender
 	}
-	return out, nil
+	return ""
 }
 
-// MsgServer is the server API for Msg service.
-type MsgServer interface {
-	// ConvertCoin mints a ERC20 representation of the native Cosmos coin denom
-	// that is registered on the token mapping.
-	ConvertCoin(context.Context, *MsgConvertCoin) (*MsgConvertCoinResponse, error)
-	// ConvertERC20 mints a native Cosmos coin representation of the ERC20 token
-	// contract that is registered on the token mapping.
-	ConvertERC20(context.Context, *MsgConvertERC20) (*MsgConvertERC20Response, error)
+// MsgRegisterERC20Response returns no fields
+type MsgRegisterERC20Response struct {
 }
 
-// UnimplementedMsgServer can be embedded to have forward compatible implementations.
-type UnimplementedMsgServer struct {
+func (m *MsgRegisterERC20Response) Reset()         { *m = MsgRegisterERC20Response{} }
+func (m *MsgRegisterERC20Response) String() string { return proto.CompactTextString(m) }
+func (*MsgRegisterERC20Response) ProtoMessage()    {}
+func (*MsgRegisterERC20Response) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f8926fc6cb676914, []int{5}
 }
-
-func (*UnimplementedMsgServer) ConvertCoin(ctx context.Context, req *MsgConvertCoin) (*MsgConvertCoinResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method ConvertCoin not implemented")
+func (m *MsgRegisterERC20Response) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
 }
-func (*UnimplementedMsgServer) ConvertERC20(ctx context.Context, req *MsgConvertERC20) (*MsgConvertERC20Response, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method ConvertERC20 not implemented")
+func (m *MsgRegisterERC20Response) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgRegisterERC20Response.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgRegisterERC20Response) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgRegisterERC20Response.Merge(m, src)
+}
+func (m *MsgRegisterERC20Response) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgRegisterERC20Response) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgRegisterERC20Response.DiscardUnknown(m)
 }
 
-func RegisterMsgServer(s grpc1.Server, srv MsgServer) {
-	s.RegisterService(&_Msg_serviceDesc, srv)
+var xxx_messageInfo_MsgRegisterERC20Response proto.InternalMessageInfo
+
+// MsgDeregisterERC20 defines a Msg to deregister a token pair registered with
+// MsgRegisterERC20 and reclaim the registration deposit
+type MsgDeregisterERC20 struct {
+	// contract_address of the registered ERC20 token contract
+	ContractAddress string `protobuf:"bytes,1,opt,name=contract_address,json=contractAddress,proto3" json:"contract_address,omitempty"`
+	// sender is the cosmos bech32 address of the account that paid the registration deposit
+	Sender string `protobuf:"bytes,2,opt,name=sender,proto3" json:"sender,omitempty"`
 }
 
-func _Msg_ConvertCoin_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(MsgConvertCoin)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(MsgServer).ConvertCoin(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/evmos.erc20.v1.Msg/ConvertCoin",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(MsgServer).ConvertCoin(ctx, req.(*MsgConvertCoin))
+func (m *MsgDeregisterERC20) Reset()         { *m = MsgDeregisterERC20{} }
+func (m *MsgDeregisterERC20) String() string { return proto.CompactTextString(m) }
+func (*MsgDeregisterERC20) ProtoMessage()    {}
+func (*MsgDeregisterERC20) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f8926fc6cb676914, []int{6}
+}
+func (m *MsgDeregisterERC20) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgDeregisterERC20) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgDeregisterERC20.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return interceptor(ctx, in, info, handler)
+}
+func (m *MsgDeregisterERC20) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgDeregisterERC20.Merge(m, src)
+}
+func (m *MsgDeregisterERC20) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgDeregisterERC20) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgDeregisterERC20.DiscardUnknown(m)
 }
 
-func _Msg_ConvertERC20_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(MsgConvertERC20)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(MsgServer).ConvertERC20(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/evmos.erc20.v1.Msg/ConvertERC20",
+var xxx_messageInfo_MsgDeregisterERC20 proto.InternalMessageInfo
+
+func (m *MsgDeregisterERC20) GetContractAddress() string {
+	if m != nil {
+		return m.ContractAddress
 	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(MsgServer).ConvertERC20(ctx, req.(*MsgConvertERC20))
+	return ""
+}
+
+func (m *MsgDeregisterERC20) GetSender() string {
+	if m != nil {
+		return m.Sender
 	}
-	return interceptor(ctx, in, info, handler)
+	return ""
 }
 
-var _Msg_serviceDesc = grpc.ServiceDesc{
-	ServiceName: "evmos.erc20.v1.Msg",
-	HandlerType: (*MsgServer)(nil),
-	Methods: []grpc.MethodDesc{
-		{
-			MethodName: "ConvertCoin",
-			Handler:    _Msg_ConvertCoin_Handler,
-		},
-		{
-			MethodName: "ConvertERC20",
-			Handler:    _Msg_ConvertERC20_Handler,
-		},
-	},
-	Streams:  []grpc.StreamDesc{},
-	Metadata: "evmos/erc20/v1/tx.proto",
+// MsgDeregisterERC20Response returns no fields
+type MsgDeregisterERC20Response struct {
 }
 
-func (m *MsgConvertCoin) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *MsgDeregisterERC20Response) Reset()         { *m = MsgDeregisterERC20Response{} }
+func (m *MsgDeregisterERC20Response) String() string { return proto.CompactTextString(m) }
+func (*MsgDeregisterERC20Response) ProtoMessage()    {}
+func (*MsgDeregisterERC20Response) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f8926fc6cb676914, []int{7}
+}
+func (m *MsgDeregisterERC20Response) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgDeregisterERC20Response) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgDeregisterERC20Response.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return dAtA[:n], nil
+}
+func (m *MsgDeregisterERC20Response) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgDeregisterERC20Response.Merge(m, src)
+}
+func (m *MsgDeregisterERC20Response) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgDeregisterERC20Response) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgDeregisterERC20Response.DiscardUnknown(m)
 }
 
-func (m *MsgConvertCoin) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+var xxx_messageInfo_MsgDeregisterERC20Response proto.InternalMessageInfo
+
+// MsgConvertCoins defines a Msg to convert multiple native Cosmos coins to
+// ERC20 tokens
+type MsgConvertCoins struct {
+	// coins are Cosmos coins whose denominations are registered in a token pair.
+	// The coin amounts define the amount of coins to convert.
+	Coins github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,1,rep,name=coins,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"coins"`
+	// receiver is the hex address to receive ERC20 tokens
+	Receiver string `protobuf:"bytes,2,opt,name=receiver,proto3" json:"receiver,omitempty"`
+	// sender is the cosmos bech32 address from the owner of the given Cosmos coins
+	Sender string `protobuf:"bytes,3,opt,name=sender,proto3" json:"sender,omitempty"`
 }
 
-func (m *MsgConvertCoin) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.Sender) > 0 {
-		i -= len(m.Sender)
-		copy(dAtA[i:], m.Sender)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.Sender)))
-		i--
-		dAtA[i] = 0x1a
-	}
-	if len(m.Receiver) > 0 {
-		i -= len(m.Receiver)
-		copy(dAtA[i:], m.Receiver)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.Receiver)))
-		i--
-		dAtA[i] = 0x12
-	}
-	{
-		size, err := m.Coin.MarshalToSizedBuffer(dAtA[:i])
+func (m *MsgConvertCoins) Reset()         { *m = MsgConvertCoins{} }
+func (m *MsgConvertCoins) String() string { return proto.CompactTextString(m) }
+func (*MsgConvertCoins) ProtoMessage()    {}
+func (*MsgConvertCoins) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f8926fc6cb676914, []int{8}
+}
+func (m *MsgConvertCoins) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgConvertCoins) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgConvertCoins.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
 		if err != nil {
-			return 0, err
+			return nil, err
 		}
-		i -= size
-		i = encodeVarintTx(dAtA, i, uint64(size))
+		return b[:n], nil
 	}
-	i--
-	dAtA[i] = 0xa
-	return len(dAtA) - i, nil
+}
+func (m *MsgConvertCoins) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgConvertCoins.Merge(m, src)
+}
+func (m *MsgConvertCoins) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgConvertCoins) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgConvertCoins.DiscardUnknown(m)
 }
 
-func (m *MsgConvertCoinResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_MsgConvertCoins proto.InternalMessageInfo
+
+func (m *MsgConvertCoins) GetCoins() github_com_cosmos_cosmos_sdk_types.Coins {
+	if m != nil {
+		return m.Coins
 	}
-	return dAtA[:n], nil
+	return nil
 }
 
-func (m *MsgConvertCoinResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *MsgConvertCoins) GetReceiver() string {
+	if m != nil {
+		return m.Receiver
+	}
+	return ""
 }
 
-func (m *MsgConvertCoinResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	return len(dAtA) - i, nil
+func (m *MsgConvertCoins) GetSender() string {
+	if m != nil {
+		return m.Sender
+	}
+	return ""
 }
 
-func (m *MsgConvertERC20) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+// MsgConvertCoinsResponse returns the balances of the converted token pairs
+type MsgConvertCoinsResponse struct {
+	// balances of the sender coins and receiver tokens after the conversion
+	Balances []TokenPairBalance `protobuf:"bytes,1,rep,name=balances,proto3" json:"balances"`
+}
+
+func (m *MsgConvertCoinsResponse) Reset()         { *m = MsgConvertCoinsResponse{} }
+func (m *MsgConvertCoinsResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgConvertCoinsResponse) ProtoMessage()    {}
+func (*MsgConvertCoinsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f8926fc6cb676914, []int{9}
+}
+func (m *MsgConvertCoinsResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgConvertCoinsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgConvertCoinsResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return dAtA[:n], nil
+}
+func (m *MsgConvertCoinsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgConvertCoinsResponse.Merge(m, src)
+}
+func (m *MsgConvertCoinsResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgConvertCoinsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgConvertCoinsResponse.DiscardUnknown(m)
 }
 
-func (m *MsgConvertERC20) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+var xxx_messageInfo_MsgConvertCoinsResponse proto.InternalMessageInfo
+
+func (m *MsgConvertCoinsResponse) GetBalances() []TokenPairBalance {
+	if m != nil {
+		return m.Balances
+	}
+	return nil
 }
 
-func (m *MsgConvertERC20) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.Sender) > 0 {
-		i -= len(m.Sender)
-		copy(dAtA[i:], m.Sender)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.Sender)))
-		i--
-		dAtA[i] = 0x22
+// ERC20Amount defines an amount of tokens of an ERC20 token contract
+type ERC20Amount struct {
+	// contract_address of an ERC20 token contract, that is registered in a token pair
+	ContractAddress string `protobuf:"bytes,1,opt,name=contract_address,json=contractAddress,proto3" json:"contract_address,omitempty"`
+	// amount of ERC20 tokens
+	Amount github_com_cosmos_cosmos_sdk_types.Int `protobuf:"bytes,2,opt,name=amount,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Int" json:"amount"`
+}
+
+func (m *ERC20Amount) Reset()         { *m = ERC20Amount{} }
+func (m *ERC20Amount) String() string { return proto.CompactTextString(m) }
+func (*ERC20Amount) ProtoMessage()    {}
+func (*ERC20Amount) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f8926fc6cb676914, []int{10}
+}
+func (m *ERC20Amount) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ERC20Amount) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ERC20Amount.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	if len(m.Receiver) > 0 {
-		i -= len(m.Receiver)
-		copy(dAtA[i:], m.Receiver)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.Receiver)))
-		i--
-		dAtA[i] = 0x1a
+}
+func (m *ERC20Amount) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ERC20Amount.Merge(m, src)
+}
+func (m *ERC20Amount) XXX_Size() int {
+	return m.Size()
+}
+func (m *ERC20Amount) XXX_DiscardUnknown() {
+	xxx_messageInfo_ERC20Amount.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ERC20Amount proto.InternalMessageInfo
+
+func (m *ERC20Amount) GetContractAddress() string {
+	if m != nil {
+		return m.ContractAddress
 	}
-	{
-		size := m.Amount.Size()
-		i -= size
-		if _, err := m.Amount.MarshalTo(dAtA[i:]); err != nil {
-			return 0, err
+	return ""
+}
+
+// MsgConvertERC20S defines a Msg to convert multiple ERC20 tokens to native
+// Cosmos coins
+type MsgConvertERC20S struct {
+	// tokens are the ERC20 token contracts and amounts to convert
+	Tokens []ERC20Amount `protobuf:"bytes,1,rep,name=tokens,proto3" json:"tokens"`
+	// receiver is the bech32 address to receive native Cosmos coins
+	Receiver string `protobuf:"bytes,2,opt,name=receiver,proto3" json:"receiver,omitempty"`
+	// sender is the hex address from the owner of the given ERC20 tokens
+	Sender string `protobuf:"bytes,3,opt,name=sender,proto3" json:"sender,omitempty"`
+}
+
+func (m *MsgConvertERC20S) Reset()         { *m = MsgConvertERC20S{} }
+func (m *MsgConvertERC20S) String() string { return proto.CompactTextString(m) }
+func (*MsgConvertERC20S) ProtoMessage()    {}
+func (*MsgConvertERC20S) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f8926fc6cb676914, []int{11}
+}
+func (m *MsgConvertERC20S) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgConvertERC20S) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgConvertERC20S.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
-		i = encodeVarintTx(dAtA, i, uint64(size))
+		return b[:n], nil
 	}
-	i--
-	dAtA[i] = 0x12
-	if len(m.ContractAddress) > 0 {
-		i -= len(m.ContractAddress)
-		copy(dAtA[i:], m.ContractAddress)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.ContractAddress)))
-		i--
-		dAtA[i] = 0xa
+}
+func (m *MsgConvertERC20S) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgConvertERC20S.Merge(m, src)
+}
+func (m *MsgConvertERC20S) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgConvertERC20S) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgConvertERC20S.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgConvertERC20S proto.InternalMessageInfo
+
+func (m *MsgConvertERC20S) GetTokens() []ERC20Amount {
+	if m != nil {
+		return m.Tokens
 	}
-	return len(dAtA) - i, nil
+	return nil
 }
 
-func (m *MsgConvertERC20Response) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *MsgConvertERC20S) GetReceiver() string {
+	if m != nil {
+		return m.Receiver
 	}
-	return dAtA[:n], nil
+	return ""
 }
 
-func (m *MsgConvertERC20Response) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *MsgConvertERC20S) GetSender() string {
+	if m != nil {
+		return m.Sender
+	}
+	return ""
 }
 
-func (m *MsgConvertERC20Response) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	return len(dAtA) - i, nil
+// MsgConvertERC20SResponse returns the balances of the converted token pairs
+type MsgConvertERC20SResponse struct {
+	// balances of the sender tokens and receiver coins after the conversion
+	Balances []TokenPairBalance `protobuf:"bytes,1,rep,name=balances,proto3" json:"balances"`
 }
 
-func encodeVarintTx(dAtA []byte, offset int, v uint64) int {
-	offset -= sovTx(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
+func (m *MsgConvertERC20SResponse) Reset()         { *m = MsgConvertERC20SResponse{} }
+func (m *MsgConvertERC20SResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgConvertERC20SResponse) ProtoMessage()    {}
+func (*MsgConvertERC20SResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f8926fc6cb676914, []int{12}
+}
+func (m *MsgConvertERC20SResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgConvertERC20SResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgConvertERC20SResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	dAtA[offset] = uint8(v)
-	return base
 }
-func (m *MsgConvertCoin) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *MsgConvertERC20SResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgConvertERC20SResponse.Merge(m, src)
+}
+func (m *MsgConvertERC20SResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgConvertERC20SResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgConvertERC20SResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgConvertERC20SResponse proto.InternalMessageInfo
+
+func (m *MsgConvertERC20SResponse) GetBalances() []TokenPairBalance {
+	if m != nil {
+		return m.Balances
 	}
-	var l int
-	_ = l
-	l = m.Coin.Size()
-	n += 1 + l + sovTx(uint64(l))
-	l = len(m.Receiver)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	return nil
+}
+
+// TokenPairBalance defines the balances of a token pair after a conversion
+type TokenPairBalance struct {
+	// denom is the Cosmos coin denomination of the token pair
+	Denom string `protobuf:"bytes,1,opt,name=denom,proto3" json:"denom,omitempty"`
+	// erc20_address is the hex address of the ERC20 token contract of the pair
+	Erc20Address string `protobuf:"bytes,2,opt,name=erc20_address,json=erc20Address,proto3" json:"erc20_address,omitempty"`
+	// cosmos_balance is the Cosmos coin balance of the bech32 account
+	CosmosBalance github_com_cosmos_cosmos_sdk_types.Int `protobuf:"bytes,3,opt,name=cosmos_balance,json=cosmosBalance,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Int" json:"cosmos_balance"`
+	// erc20_balance is the ERC20 token balance of the hex account
+	Erc20Balance github_com_cosmos_cosmos_sdk_types.Int `protobuf:"bytes,4,opt,name=erc20_balance,json=erc20Balance,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Int" json:"erc20_balance"`
+}
+
+func (m *TokenPairBalance) Reset()         { *m = TokenPairBalance{} }
+func (m *TokenPairBalance) String() string { return proto.CompactTextString(m) }
+func (*TokenPairBalance) ProtoMessage()    {}
+func (*TokenPairBalance) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f8926fc6cb676914, []int{13}
+}
+func (m *TokenPairBalance) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *TokenPairBalance) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_TokenPairBalance.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	l = len(m.Sender)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+}
+func (m *TokenPairBalance) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_TokenPairBalance.Merge(m, src)
+}
+func (m *TokenPairBalance) XXX_Size() int {
+	return m.Size()
+}
+func (m *TokenPairBalance) XXX_DiscardUnknown() {
+	xxx_messageInfo_TokenPairBalance.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_TokenPairBalance proto.InternalMessageInfo
+
+func (m *TokenPairBalance) GetDenom() string {
+	if m != nil {
+		return m.Denom
 	}
-	return n
+	return ""
 }
 
-func (m *MsgConvertCoinResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *TokenPairBalance) GetErc20Address() string {
+	if m != nil {
+		return m.Erc20Address
 	}
-	var l int
-	_ = l
-	return n
+	return ""
 }
 
-func (m *MsgConvertERC20) Size() (n int) {
-	if m == nil {
-		return 0
+// MsgRefreshTokenPairMetadata defines a Msg to update the bank metadata of an
+// ERC20 token pair with the current details of its contract
+type MsgRefreshTokenPairMetadata struct {
+	// contract_address of the registered ERC20 token contract
+	ContractAddress string `protobuf:"bytes,1,opt,name=contract_address,json=contractAddress,proto3" json:"contract_address,omitempty"`
+	// sender is the cosmos bech32 address of the account that refreshes the metadata
+	Sender string `protobuf:"bytes,2,opt,name=sender,proto3" json:"sender,omitempty"`
+}
+
+func (m *MsgRefreshTokenPairMetadata) Reset()         { *m = MsgRefreshTokenPairMetadata{} }
+func (m *MsgRefreshTokenPairMetadata) String() string { return proto.CompactTextString(m) }
+func (*MsgRefreshTokenPairMetadata) ProtoMessage()    {}
+func (*MsgRefreshTokenPairMetadata) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f8926fc6cb676914, []int{14}
+}
+func (m *MsgRefreshTokenPairMetadata) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgRefreshTokenPairMetadata) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgRefreshTokenPairMetadata.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	var l int
-	_ = l
-	l = len(m.ContractAddress)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+}
+func (m *MsgRefreshTokenPairMetadata) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgRefreshTokenPairMetadata.Merge(m, src)
+}
+func (m *MsgRefreshTokenPairMetadata) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgRefreshTokenPairMetadata) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgRefreshTokenPairMetadata.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgRefreshTokenPairMetadata proto.InternalMessageInfo
+
+func (m *MsgRefreshTokenPairMetadata) GetContractAddress() string {
+	if m != nil {
+		return m.ContractAddress
 	}
-	l = m.Amount.Size()
-	n += 1 + l + sovTx(uint64(l))
-	l = len(m.Receiver)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	return ""
+}
+
+func (m *MsgRefreshTokenPairMetadata) GetSender() string {
+	if m != nil {
+		return m.Sender
+	}
+	return ""
+}
+
+// MsgRefreshTokenPairMetadataResponse returns the refreshed metadata
+type MsgRefreshTokenPairMetadataResponse struct {
+	// metadata is the bank metadata of the token pair after the refresh
+	Metadata types1.Metadata `protobuf:"bytes,1,opt,name=metadata,proto3" json:"metadata"`
+	// updated is true if the metadata changed
+	Updated bool `protobuf:"varint,2,opt,name=updated,proto3" json:"updated,omitempty"`
+}
+
+func (m *MsgRefreshTokenPairMetadataResponse) Reset()         { *m = MsgRefreshTokenPairMetadataResponse{} }
+func (m *MsgRefreshTokenPairMetadataResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgRefreshTokenPairMetadataResponse) ProtoMessage()    {}
+func (*MsgRefreshTokenPairMetadataResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f8926fc6cb676914, []int{15}
+}
+func (m *MsgRefreshTokenPairMetadataResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgRefreshTokenPairMetadataResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgRefreshTokenPairMetadataResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgRefreshTokenPairMetadataResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgRefreshTokenPairMetadataResponse.Merge(m, src)
+}
+func (m *MsgRefreshTokenPairMetadataResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgRefreshTokenPairMetadataResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgRefreshTokenPairMetadataResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgRefreshTokenPairMetadataResponse proto.InternalMessageInfo
+
+func (m *MsgRefreshTokenPairMetadataResponse) GetMetadata() types1.Metadata {
+	if m != nil {
+		return m.Metadata
+	}
+	return types1.Metadata{}
+}
+
+func (m *MsgRefreshTokenPairMetadataResponse) GetUpdated() bool {
+	if m != nil {
+		return m.Updated
+	}
+	return false
+}
+
+// MsgConvertNFT defines a Msg to convert a native x/nft token to an ERC721 or
+// ERC1155 token
+type MsgConvertNFT struct {
+	// class_id of the x/nft class that is registered in an NFT pair
+	ClassID string `protobuf:"bytes,1,opt,name=class_id,json=classId,proto3" json:"class_id,omitempty"`
+	// nft_id is the identifier of the x/nft token to convert
+	NFTID string `protobuf:"bytes,2,opt,name=nft_id,json=nftId,proto3" json:"nft_id,omitempty"`
+	// receiver is the hex address to receive the contract token
+	Receiver string `protobuf:"bytes,3,opt,name=receiver,proto3" json:"receiver,omitempty"`
+	// sender is the cosmos bech32 address from the owner of the given x/nft token
+	Sender string `protobuf:"bytes,4,opt,name=sender,proto3" json:"sender,omitempty"`
+}
+
+func (m *MsgConvertNFT) Reset()         { *m = MsgConvertNFT{} }
+func (m *MsgConvertNFT) String() string { return proto.CompactTextString(m) }
+func (*MsgConvertNFT) ProtoMessage()    {}
+func (*MsgConvertNFT) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f8926fc6cb676914, []int{16}
+}
+func (m *MsgConvertNFT) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgConvertNFT) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgConvertNFT.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgConvertNFT) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgConvertNFT.Merge(m, src)
+}
+func (m *MsgConvertNFT) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgConvertNFT) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgConvertNFT.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgConvertNFT proto.InternalMessageInfo
+
+func (m *MsgConvertNFT) GetClassID() string {
+	if m != nil {
+		return m.ClassID
+	}
+	return ""
+}
+
+func (m *MsgConvertNFT) GetNFTID() string {
+	if m != nil {
+		return m.NFTID
+	}
+	return ""
+}
+
+func (m *MsgConvertNFT) GetReceiver() string {
+	if m != nil {
+		return m.Receiver
+	}
+	return ""
+}
+
+func (m *MsgConvertNFT) GetSender() string {
+	if m != nil {
+		return m.Sender
+	}
+	return ""
+}
+
+// MsgConvertNFTResponse returns no fields
+type MsgConvertNFTResponse struct {
+}
+
+func (m *MsgConvertNFTResponse) Reset()         { *m = MsgConvertNFTResponse{} }
+func (m *MsgConvertNFTResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgConvertNFTResponse) ProtoMessage()    {}
+func (*MsgConvertNFTResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f8926fc6cb676914, []int{17}
+}
+func (m *MsgConvertNFTResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgConvertNFTResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgConvertNFTResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgConvertNFTResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgConvertNFTResponse.Merge(m, src)
+}
+func (m *MsgConvertNFTResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgConvertNFTResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgConvertNFTResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgConvertNFTResponse proto.InternalMessageInfo
+
+// MsgConvertContractNFT defines a Msg to convert an ERC721 or ERC1155 token to
+// a native x/nft token
+type MsgConvertContractNFT struct {
+	// contract_address of an ERC721 or ERC1155 contract, that is registered in an
+	// NFT pair
+	ContractAddress string `protobuf:"bytes,1,opt,name=contract_address,json=contractAddress,proto3" json:"contract_address,omitempty"`
+	// token_id is the identifier of the contract token to convert
+	TokenID github_com_cosmos_cosmos_sdk_types.Int `protobuf:"bytes,2,opt,name=token_id,json=tokenId,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Int" json:"token_id"`
+	// receiver is the bech32 address to receive the x/nft token
+	Receiver string `protobuf:"bytes,3,opt,name=receiver,proto3" json:"receiver,omitempty"`
+	// sender is the hex address from the owner of the given contract token
+	Sender string `protobuf:"bytes,4,opt,name=sender,proto3" json:"sender,omitempty"`
+}
+
+func (m *MsgConvertContractNFT) Reset()         { *m = MsgConvertContractNFT{} }
+func (m *MsgConvertContractNFT) String() string { return proto.CompactTextString(m) }
+func (*MsgConvertContractNFT) ProtoMessage()    {}
+func (*MsgConvertContractNFT) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f8926fc6cb676914, []int{18}
+}
+func (m *MsgConvertContractNFT) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgConvertContractNFT) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgConvertContractNFT.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgConvertContractNFT) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgConvertContractNFT.Merge(m, src)
+}
+func (m *MsgConvertContractNFT) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgConvertContractNFT) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgConvertContractNFT.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgConvertContractNFT proto.InternalMessageInfo
+
+func (m *MsgConvertContractNFT) GetContractAddress() string {
+	if m != nil {
+		return m.ContractAddress
+	}
+	return ""
+}
+
+func (m *MsgConvertContractNFT) GetReceiver() string {
+	if m != nil {
+		return m.Receiver
+	}
+	return ""
+}
+
+func (m *MsgConvertContractNFT) GetSender() string {
+	if m != nil {
+		return m.Sender
+	}
+	return ""
+}
+
+// MsgConvertContractNFTResponse returns no fields
+type MsgConvertContractNFTResponse struct {
+}
+
+func (m *MsgConvertContractNFTResponse) Reset()         { *m = MsgConvertContractNFTResponse{} }
+func (m *MsgConvertContractNFTResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgConvertContractNFTResponse) ProtoMessage()    {}
+func (*MsgConvertContractNFTResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f8926fc6cb676914, []int{19}
+}
+func (m *MsgConvertContractNFTResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgConvertContractNFTResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgConvertContractNFTResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgConvertContractNFTResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgConvertContractNFTResponse.Merge(m, src)
+}
+func (m *MsgConvertContractNFTResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgConvertContractNFTResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgConvertContractNFTResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgConvertContractNFTResponse proto.InternalMessageInfo
+
+// MsgRefundFailedConversion defines a Msg to reclaim the ERC20 tokens of a
+// failed EVM hook conversion
+type MsgRefundFailedConversion struct {
+	// tx_hash is the hex hash of the Ethereum tx of the failed conversion
+	TxHash string `protobuf:"bytes,1,opt,name=tx_hash,json=txHash,proto3" json:"tx_hash,omitempty"`
+	// log_index is the index of the transfer log in the tx receipt
+	LogIndex uint64 `protobuf:"varint,2,opt,name=log_index,json=logIndex,proto3" json:"log_index,omitempty"`
+	// sender is the cosmos bech32 address of the account that transferred the
+	// tokens to the module address
+	Sender string `protobuf:"bytes,3,opt,name=sender,proto3" json:"sender,omitempty"`
+}
+
+func (m *MsgRefundFailedConversion) Reset()         { *m = MsgRefundFailedConversion{} }
+func (m *MsgRefundFailedConversion) String() string { return proto.CompactTextString(m) }
+func (*MsgRefundFailedConversion) ProtoMessage()    {}
+func (*MsgRefundFailedConversion) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f8926fc6cb676914, []int{20}
+}
+func (m *MsgRefundFailedConversion) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgRefundFailedConversion) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgRefundFailedConversion.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgRefundFailedConversion) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgRefundFailedConversion.Merge(m, src)
+}
+func (m *MsgRefundFailedConversion) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgRefundFailedConversion) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgRefundFailedConversion.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgRefundFailedConversion proto.InternalMessageInfo
+
+func (m *MsgRefundFailedConversion) GetTxHash() string {
+	if m != nil {
+		return m.TxHash
+	}
+	return ""
+}
+
+func (m *MsgRefundFailedConversion) GetLogIndex() uint64 {
+	if m != nil {
+		return m.LogIndex
+	}
+	return 0
+}
+
+func (m *MsgRefundFailedConversion) GetSender() string {
+	if m != nil {
+		return m.Sender
+	}
+	return ""
+}
+
+// MsgRefundFailedConversionResponse returns no fields
+type MsgRefundFailedConversionResponse struct {
+}
+
+func (m *MsgRefundFailedConversionResponse) Reset()         { *m = MsgRefundFailedConversionResponse{} }
+func (m *MsgRefundFailedConversionResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgRefundFailedConversionResponse) ProtoMessage()    {}
+func (*MsgRefundFailedConversionResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f8926fc6cb676914, []int{21}
+}
+func (m *MsgRefundFailedConversionResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgRefundFailedConversionResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgRefundFailedConversionResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgRefundFailedConversionResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgRefundFailedConversionResponse.Merge(m, src)
+}
+func (m *MsgRefundFailedConversionResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgRefundFailedConversionResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgRefundFailedConversionResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgRefundFailedConversionResponse proto.InternalMessageInfo
+
+func init() {
+	proto.RegisterType((*MsgConvertCoin)(nil), "evmos.erc20.v1.MsgConvertCoin")
+	proto.RegisterType((*MsgConvertCoinResponse)(nil), "evmos.erc20.v1.MsgConvertCoinResponse")
+	proto.RegisterType((*MsgConvertERC20)(nil), "evmos.erc20.v1.MsgConvertERC20")
+	proto.RegisterType((*MsgConvertERC20Response)(nil), "evmos.erc20.v1.MsgConvertERC20Response")
+	proto.RegisterType((*MsgRegisterERC20)(nil), "evmos.erc20.v1.MsgRegisterERC20")
+	proto.RegisterType((*MsgRegisterERC20Response)(nil), "evmos.erc20.v1.MsgRegisterERC20Response")
+	proto.RegisterType((*MsgDeregisterERC20)(nil), "evmos.erc20.v1.MsgDeregisterERC20")
+	proto.RegisterType((*MsgDeregisterERC20Response)(nil), "evmos.erc20.v1.MsgDeregisterERC20Response")
+	proto.RegisterType((*MsgConvertCoins)(nil), "evmos.erc20.v1.MsgConvertCoins")
+	proto.RegisterType((*MsgConvertCoinsResponse)(nil), "evmos.erc20.v1.MsgConvertCoinsResponse")
+	proto.RegisterType((*ERC20Amount)(nil), "evmos.erc20.v1.ERC20Amount")
+	proto.RegisterType((*MsgConvertERC20S)(nil), "evmos.erc20.v1.MsgConvertERC20S")
+	proto.RegisterType((*MsgConvertERC20SResponse)(nil), "evmos.erc20.v1.MsgConvertERC20SResponse")
+	proto.RegisterType((*TokenPairBalance)(nil), "evmos.erc20.v1.TokenPairBalance")
+	proto.RegisterType((*MsgRefreshTokenPairMetadata)(nil), "evmos.erc20.v1.MsgRefreshTokenPairMetadata")
+	proto.RegisterType((*MsgRefreshTokenPairMetadataResponse)(nil), "evmos.erc20.v1.MsgRefreshTokenPairMetadataResponse")
+	proto.RegisterType((*MsgConvertNFT)(nil), "evmos.erc20.v1.MsgConvertNFT")
+	proto.RegisterType((*MsgConvertNFTResponse)(nil), "evmos.erc20.v1.MsgConvertNFTResponse")
+	proto.RegisterType((*MsgConvertContractNFT)(nil), "evmos.erc20.v1.MsgConvertContractNFT")
+	proto.RegisterType((*MsgConvertContractNFTResponse)(nil), "evmos.erc20.v1.MsgConvertContractNFTResponse")
+	proto.RegisterType((*MsgRefundFailedConversion)(nil), "evmos.erc20.v1.MsgRefundFailedConversion")
+	proto.RegisterType((*MsgRefundFailedConversionResponse)(nil), "evmos.erc20.v1.MsgRefundFailedConversionResponse")
+}
+
+func init() { proto.RegisterFile("evmos/erc20/v1/tx.proto", fileDescriptor_f8926fc6cb676914) }
+
+var fileDescriptor_f8926fc6cb676914 = []byte{
+	// 1186 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xbc, 0x57, 0xcf, 0x4f, 0xe3, 0xc6,
+	0x17, 0xc7, 0x0b, 0x84, 0xf0, 0x58, 0x7e, 0x68, 0xb4, 0x5f, 0x08, 0x66, 0x49, 0x82, 0x11, 0x10,
+	0xbe, 0x08, 0x9b, 0x80, 0x54, 0x69, 0x4f, 0xd5, 0x06, 0x8a, 0x9a, 0x03, 0xa8, 0x32, 0xac, 0x56,
+	0xaa, 0xd4, 0xa6, 0x26, 0x1e, 0x1c, 0x8b, 0xe0, 0x89, 0x3c, 0x43, 0xc4, 0xf6, 0x50, 0x6d, 0xf7,
+	0xd0, 0x5b, 0xab, 0x56, 0x3d, 0xf6, 0x1f, 0xa8, 0xda, 0x6b, 0x0f, 0x3d, 0xf4, 0x0f, 0xd8, 0x43,
+	0x0f, 0x2b, 0xf5, 0x52, 0xf5, 0x40, 0x2b, 0xe8, 0x3f, 0xd1, 0x5b, 0xe5, 0x19, 0x7b, 0x12, 0x67,
+	0x9d, 0x04, 0x22, 0xd4, 0x0b, 0x64, 0xe6, 0x7d, 0xe6, 0xbd, 0xcf, 0xe7, 0xbd, 0x37, 0x3f, 0x0c,
+	0x73, 0xb8, 0x79, 0x4e, 0xa8, 0x81, 0xfd, 0xea, 0xf6, 0x96, 0xd1, 0x2c, 0x1a, 0xec, 0x52, 0x6f,
+	0xf8, 0x84, 0x11, 0x34, 0xc5, 0x0d, 0x3a, 0x37, 0xe8, 0xcd, 0xa2, 0x9a, 0xad, 0x12, 0x1a, 0x20,
+	0x4f, 0x2c, 0xef, 0xcc, 0x68, 0x16, 0x4f, 0x30, 0xb3, 0x8a, 0x7c, 0x20, 0xf0, 0x6d, 0x76, 0x8a,
+	0xa5, 0xbd, 0x4a, 0x5c, 0x2f, 0xb4, 0x3f, 0x72, 0x88, 0x43, 0xf8, 0x4f, 0x23, 0xf8, 0x15, 0xce,
+	0x3e, 0x76, 0x08, 0x71, 0xea, 0xd8, 0xb0, 0x1a, 0xae, 0x61, 0x79, 0x1e, 0x61, 0x16, 0x73, 0x89,
+	0x47, 0x85, 0x55, 0x7b, 0x01, 0x53, 0x07, 0xd4, 0xd9, 0x25, 0x5e, 0x13, 0xfb, 0x6c, 0x97, 0xb8,
+	0x1e, 0xda, 0x81, 0x91, 0xc0, 0x67, 0x46, 0xc9, 0x2b, 0x85, 0x89, 0xed, 0x79, 0x5d, 0x04, 0xd5,
+	0x83, 0xa0, 0x7a, 0x18, 0x54, 0x0f, 0x80, 0xa5, 0x91, 0xd7, 0x57, 0xb9, 0x21, 0x93, 0x83, 0x91,
+	0x0a, 0x69, 0x1f, 0x57, 0xb1, 0xdb, 0xc4, 0x7e, 0xe6, 0x41, 0x5e, 0x29, 0x8c, 0x9b, 0x72, 0x8c,
+	0x66, 0x21, 0x45, 0xb1, 0x67, 0x63, 0x3f, 0x33, 0xcc, 0x2d, 0xe1, 0x48, 0xcb, 0xc0, 0x6c, 0x3c,
+	0xb4, 0x89, 0x69, 0x83, 0x78, 0x14, 0x6b, 0x3f, 0x2b, 0x30, 0xdd, 0x32, 0xbd, 0x67, 0xee, 0x6e,
+	0x6f, 0xa1, 0x75, 0x98, 0xa9, 0x12, 0x8f, 0xf9, 0x56, 0x95, 0x55, 0x2c, 0xdb, 0xf6, 0x31, 0xa5,
+	0x9c, 0xe2, 0xb8, 0x39, 0x1d, 0xcd, 0x3f, 0x15, 0xd3, 0x68, 0x1f, 0x52, 0xd6, 0x39, 0xb9, 0xf0,
+	0x98, 0xa0, 0x52, 0xd2, 0x03, 0xa2, 0x7f, 0x5c, 0xe5, 0x56, 0x1d, 0x97, 0xd5, 0x2e, 0x4e, 0xf4,
+	0x2a, 0x39, 0x37, 0xc2, 0x54, 0x8a, 0x7f, 0x9b, 0xd4, 0x3e, 0x33, 0xd8, 0x8b, 0x06, 0xa6, 0x7a,
+	0xd9, 0x63, 0x66, 0xb8, 0x3a, 0x26, 0x6a, 0xb8, 0xab, 0xa8, 0x91, 0x98, 0xa8, 0x79, 0x98, 0xeb,
+	0x60, 0x2e, 0x55, 0x3d, 0x83, 0x99, 0x03, 0xea, 0x98, 0xd8, 0x71, 0x29, 0xc3, 0xfe, 0x9d, 0x55,
+	0xb5, 0x22, 0x3e, 0x88, 0x45, 0x54, 0x21, 0xd3, 0xe9, 0x56, 0x86, 0x7c, 0x0e, 0xe8, 0x80, 0x3a,
+	0x7b, 0xd8, 0xbf, 0xef, 0xa0, 0x8f, 0x41, 0x7d, 0xdb, 0xb1, 0x0c, 0xfb, 0x7d, 0xac, 0x7e, 0x41,
+	0x69, 0x29, 0xb2, 0x60, 0x34, 0xe8, 0x94, 0x20, 0xd2, 0x70, 0xef, 0xbe, 0xda, 0x0a, 0xca, 0xf5,
+	0xc3, 0x9f, 0xb9, 0xc2, 0x2d, 0xca, 0xc5, 0x7d, 0x9b, 0xc2, 0xf3, 0x40, 0x4d, 0xf8, 0x51, 0x7b,
+	0xbd, 0x84, 0xb7, 0x50, 0x05, 0x2a, 0x41, 0xfa, 0xc4, 0xaa, 0x5b, 0x5e, 0x15, 0x47, 0xa4, 0xf3,
+	0x7a, 0x7c, 0xc7, 0xea, 0xc7, 0xe4, 0x0c, 0x7b, 0x1f, 0x58, 0xae, 0x5f, 0x12, 0xc0, 0x70, 0x4f,
+	0xc8, 0x75, 0xda, 0x4b, 0x05, 0x26, 0x78, 0x6e, 0x9e, 0x8a, 0x96, 0xfa, 0xef, 0xbb, 0x58, 0xfb,
+	0x5c, 0x81, 0x99, 0x96, 0x44, 0x4e, 0xe6, 0x08, 0x3d, 0x81, 0x14, 0x0b, 0xb8, 0x47, 0xca, 0x16,
+	0x3a, 0x95, 0xb5, 0x91, 0x0e, 0x45, 0x85, 0x0b, 0x06, 0xca, 0xf2, 0xc7, 0x90, 0xe9, 0xa4, 0x70,
+	0xaf, 0x69, 0xfe, 0x47, 0x81, 0x99, 0x4e, 0x10, 0x7a, 0x04, 0xa3, 0x36, 0xf6, 0xc8, 0x79, 0x98,
+	0x60, 0x31, 0x40, 0xcb, 0x30, 0xc9, 0xfd, 0xca, 0xf4, 0x0b, 0x0d, 0x0f, 0xf9, 0x64, 0x94, 0xfb,
+	0x67, 0x30, 0x25, 0x72, 0x5a, 0x09, 0x43, 0x64, 0x86, 0x07, 0xaa, 0xc1, 0xa4, 0x98, 0x8d, 0x18,
+	0x1d, 0x45, 0xb1, 0x23, 0xaf, 0x23, 0x03, 0x79, 0x15, 0x5c, 0x43, 0xa7, 0xda, 0x27, 0xb0, 0xc0,
+	0xf7, 0xff, 0xa9, 0x8f, 0x69, 0x4d, 0x26, 0xe1, 0x00, 0x33, 0xcb, 0xb6, 0x98, 0x75, 0x1f, 0x9b,
+	0xfd, 0xa5, 0x02, 0xcb, 0x3d, 0x42, 0xc8, 0x4a, 0xbe, 0x0b, 0xe9, 0xf3, 0x70, 0x2e, 0xbc, 0x3d,
+	0x16, 0x5b, 0xbb, 0xdc, 0x3b, 0x93, 0xbb, 0x3c, 0x5a, 0x18, 0x95, 0x31, 0x5a, 0x84, 0x32, 0x30,
+	0x76, 0xd1, 0xb0, 0x2d, 0x86, 0x6d, 0xce, 0x20, 0x6d, 0x46, 0x43, 0xed, 0x4b, 0x05, 0x26, 0x5b,
+	0x1d, 0x74, 0xb8, 0x7f, 0x8c, 0x56, 0x21, 0x5d, 0xad, 0x5b, 0x94, 0x56, 0x5c, 0x5b, 0xe8, 0x29,
+	0x4d, 0x5c, 0x5f, 0xe5, 0xc6, 0x76, 0x83, 0xb9, 0xf2, 0x9e, 0x39, 0xc6, 0x8d, 0x65, 0x1b, 0xe5,
+	0x21, 0xe5, 0x9d, 0xb2, 0x8a, 0x2b, 0x5c, 0x8e, 0x97, 0xc6, 0xaf, 0xaf, 0x72, 0xa3, 0x87, 0xfb,
+	0xc7, 0xe5, 0x3d, 0x73, 0xd4, 0x3b, 0x65, 0x65, 0x7b, 0xa0, 0x63, 0x7e, 0x0e, 0xfe, 0x17, 0xa3,
+	0x23, 0x8f, 0xbe, 0x5f, 0x95, 0x76, 0xcb, 0x6e, 0x98, 0xe1, 0x80, 0xf0, 0x1d, 0x0a, 0x71, 0x0c,
+	0x69, 0xbe, 0xd9, 0x5a, 0xac, 0x9f, 0xdc, 0xad, 0x45, 0x82, 0x4c, 0xf0, 0x3a, 0x05, 0x99, 0xe0,
+	0xae, 0x06, 0xd4, 0x99, 0x83, 0xc5, 0x44, 0x35, 0x52, 0xaf, 0x0b, 0xf3, 0xa2, 0x35, 0x2e, 0x3c,
+	0x7b, 0xdf, 0x72, 0xeb, 0xd8, 0x16, 0x60, 0xea, 0x12, 0x0f, 0xcd, 0xc1, 0x18, 0xbb, 0xac, 0xd4,
+	0x2c, 0x5a, 0x0b, 0x95, 0xa6, 0xd8, 0xe5, 0xfb, 0x16, 0xad, 0xa1, 0x05, 0x18, 0xaf, 0x13, 0xa7,
+	0xe2, 0x7a, 0x36, 0xbe, 0xe4, 0x0a, 0x47, 0xcc, 0x74, 0x9d, 0x38, 0xe5, 0x60, 0xdc, 0xf5, 0x10,
+	0x59, 0x86, 0xa5, 0xae, 0xa1, 0x22, 0x3e, 0xdb, 0xbf, 0x4c, 0xc0, 0xf0, 0x01, 0x75, 0xd0, 0x67,
+	0x30, 0xd1, 0xfe, 0xa8, 0xc9, 0x76, 0x1e, 0x29, 0xf1, 0x43, 0x5f, 0x5d, 0xed, 0x6d, 0x97, 0x72,
+	0xd7, 0x5e, 0xfd, 0xf6, 0xf7, 0xb7, 0x0f, 0x96, 0x50, 0xce, 0x78, 0xeb, 0x51, 0x67, 0x54, 0x05,
+	0xbe, 0xc2, 0x1f, 0x44, 0xaf, 0x14, 0x78, 0x18, 0x7b, 0xbf, 0xe4, 0xba, 0x47, 0xe0, 0x00, 0x75,
+	0xad, 0x0f, 0x40, 0x72, 0x28, 0x70, 0x0e, 0x1a, 0xca, 0xf7, 0xe0, 0xc0, 0xe7, 0xd0, 0x37, 0x0a,
+	0x64, 0x62, 0x0f, 0x83, 0xe7, 0x2e, 0xab, 0xed, 0xe1, 0x06, 0xa1, 0x2e, 0x43, 0xf9, 0x84, 0x78,
+	0x31, 0xb0, 0x5a, 0xe8, 0x87, 0x90, 0x94, 0xd6, 0x39, 0xa5, 0x65, 0x6d, 0x29, 0x81, 0x52, 0xf4,
+	0x44, 0x08, 0x39, 0x7d, 0xa5, 0xc0, 0x74, 0xe7, 0x83, 0x44, 0x4b, 0x08, 0xd4, 0x81, 0x51, 0xff,
+	0xdf, 0x1f, 0x23, 0xe9, 0x6c, 0x70, 0x3a, 0x2b, 0xda, 0x72, 0x02, 0x1d, 0x1b, 0x77, 0x10, 0x6a,
+	0xab, 0x94, 0x78, 0xa9, 0xe4, 0x7a, 0xf7, 0x02, 0x55, 0xd7, 0xfa, 0x00, 0xee, 0x54, 0x29, 0xf1,
+	0x74, 0xf9, 0x42, 0x81, 0xc9, 0xf8, 0x0d, 0x9d, 0xef, 0xd3, 0x0e, 0x47, 0x6a, 0xa1, 0x1f, 0xa2,
+	0xb3, 0x3c, 0x68, 0xa9, 0x5f, 0xc7, 0x50, 0xf4, 0x13, 0x6f, 0x99, 0x2e, 0x77, 0xc9, 0x46, 0x62,
+	0x43, 0x24, 0x83, 0xd5, 0x9d, 0x3b, 0x80, 0x25, 0xd3, 0x77, 0x38, 0xd3, 0x2d, 0x4d, 0x4f, 0x6c,
+	0x24, 0xbe, 0xb8, 0x22, 0x8e, 0xc6, 0x86, 0xe5, 0xfa, 0x15, 0x79, 0x73, 0x7c, 0x0a, 0xd0, 0x76,
+	0x37, 0x2c, 0x76, 0xcf, 0xcc, 0xe1, 0xfe, 0xb1, 0xba, 0xd2, 0xd3, 0x2c, 0xb9, 0xac, 0x72, 0x2e,
+	0x79, 0x94, 0xed, 0x91, 0x35, 0xef, 0x94, 0xa1, 0xef, 0x14, 0x40, 0x09, 0xe7, 0xfd, 0x4a, 0xaf,
+	0x2e, 0x91, 0x30, 0x75, 0xf3, 0x56, 0x30, 0x49, 0xca, 0xe0, 0xa4, 0xd6, 0xd1, 0x5a, 0xcf, 0x96,
+	0x0a, 0xaf, 0x99, 0x80, 0xdd, 0x8f, 0x0a, 0xcc, 0x76, 0x39, 0x9e, 0xd7, 0x93, 0x2b, 0x94, 0x00,
+	0x55, 0x8b, 0xb7, 0x86, 0x4a, 0xa6, 0x3b, 0x9c, 0xe9, 0xa6, 0xb6, 0x91, 0x5c, 0xca, 0x0b, 0xcf,
+	0xae, 0x9c, 0xf2, 0xb5, 0x95, 0xaa, 0x5c, 0x5c, 0x2a, 0xbd, 0xbe, 0xce, 0x2a, 0x6f, 0xae, 0xb3,
+	0xca, 0x5f, 0xd7, 0x59, 0xe5, 0xeb, 0x9b, 0xec, 0xd0, 0x9b, 0x9b, 0xec, 0xd0, 0xef, 0x37, 0xd9,
+	0xa1, 0x0f, 0xdb, 0xbf, 0x06, 0x42, 0x87, 0xfc, 0x6f, 0xb3, 0xb8, 0x65, 0x5c, 0x86, 0xce, 0xf9,
+	0xfd, 0x77, 0x92, 0xe2, 0x5f, 0xb6, 0x3b, 0xff, 0x0e, 0x00, 0x11, 0x9b, 0x3f, 0x39, 0x78, 0x0f,
+	0x00, 0x00,
+}
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ context.Context
+var _ grpc.ClientConn
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+const _ = grpc.SupportPackageIsVersion4
+
+// MsgClient is the client API for Msg service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
+type MsgClient interface {
+	// ConvertCoin mints a ERC20 representation of the native Cosmos coin denom
+	// that is registered on the token mapping.
+	ConvertCoin(ctx context.Context, in *MsgConvertCoin, opts ...grpc.CallOption) (*MsgConvertCoinResponse, error)
+	// ConvertERC20 mints a native Cosmos coin representation of the ERC20 token
+	// contract that is registered on the token mapping.
+	ConvertERC20(ctx context.Context, in *MsgConvertERC20, opts ...grpc.CallOption) (*MsgConvertERC20Response, error)
+	// RegisterERC20WithDeposit registers a token pair for an ERC20 token contract
+	// without a governance proposal by escrowing a refundable deposit.
+	RegisterERC20WithDeposit(ctx context.Context, in *MsgRegisterERC20, opts ...grpc.CallOption) (*MsgRegisterERC20Response, error)
+	// DeregisterERC20 removes a token pair registered with a deposit from the
+	// token mapping and refunds the deposit to the depositor. The escrowed
+	// balances of the pair are unwound.
+	DeregisterERC20(ctx context.Context, in *MsgDeregisterERC20, opts ...grpc.CallOption) (*MsgDeregisterERC20Response, error)
+	// ConvertCoins converts multiple native Cosmos coins to their ERC20
+	// representations. The conversion is reverted if any of the coins fails to
+	// convert.
+	ConvertCoins(ctx context.Context, in *MsgConvertCoins, opts ...grpc.CallOption) (*MsgConvertCoinsResponse, error)
+	// ConvertERC20S converts multiple ERC20 tokens to their native Cosmos coin
+	// representations. The conversion is reverted if any of the tokens fails to
+	// convert.
+	ConvertERC20S(ctx context.Context, in *MsgConvertERC20S, opts ...grpc.CallOption) (*MsgConvertERC20SResponse, error)
+	// RefreshTokenPairMetadata updates the bank metadata of an externally owned
+	// ERC20 token pair with the current name and symbol of its contract.
+	RefreshTokenPairMetadata(ctx context.Context, in *MsgRefreshTokenPairMetadata, opts ...grpc.CallOption) (*MsgRefreshTokenPairMetadataResponse, error)
+	// ConvertNFT converts a native x/nft token to the ERC721 or ERC1155 token of
+	// the contract that is registered on the NFT mapping.
+	ConvertNFT(ctx context.Context, in *MsgConvertNFT, opts ...grpc.CallOption) (*MsgConvertNFTResponse, error)
+	// ConvertContractNFT converts an ERC721 or ERC1155 token of a contract that is
+	// registered on the NFT mapping to a native x/nft token.
+	ConvertContractNFT(ctx context.Context, in *MsgConvertContractNFT, opts ...grpc.CallOption) (*MsgConvertContractNFTResponse, error)
+	// RefundFailedConversion transfers the ERC20 tokens of a failed EVM hook
+	// conversion from the module address back to their sender.
+	RefundFailedConversion(ctx context.Context, in *MsgRefundFailedConversion, opts ...grpc.CallOption) (*MsgRefundFailedConversionResponse, error)
+}
+
+type msgClient struct {
+	cc grpc1.ClientConn
+}
+
+func NewMsgClient(cc grpc1.ClientConn) MsgClient {
+	return &msgClient{cc}
+}
+
+func (c *msgClient) ConvertCoin(ctx context.Context, in *MsgConvertCoin, opts ...grpc.CallOption) (*MsgConvertCoinResponse, error) {
+	out := new(MsgConvertCoinResponse)
+	err := c.cc.Invoke(ctx, "/evmos.erc20.v1.Msg/ConvertCoin", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) ConvertERC20(ctx context.Context, in *MsgConvertERC20, opts ...grpc.CallOption) (*MsgConvertERC20Response, error) {
+	out := new(MsgConvertERC20Response)
+	err := c.cc.Invoke(ctx, "/evmos.erc20.v1.Msg/ConvertERC20", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) RegisterERC20WithDeposit(ctx context.Context, in *MsgRegisterERC20, opts ...grpc.CallOption) (*MsgRegisterERC20Response, error) {
+	out := new(MsgRegisterERC20Response)
+	err := c.cc.Invoke(ctx, "/evmos.erc20.v1.Msg/RegisterERC20WithDeposit", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) DeregisterERC20(ctx context.Context, in *MsgDeregisterERC20, opts ...grpc.CallOption) (*MsgDeregisterERC20Response, error) {
+	out := new(MsgDeregisterERC20Response)
+	err := c.cc.Invoke(ctx, "/evmos.erc20.v1.Msg/DeregisterERC20", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) ConvertCoins(ctx context.Context, in *MsgConvertCoins, opts ...grpc.CallOption) (*MsgConvertCoinsResponse, error) {
+	out := new(MsgConvertCoinsResponse)
+	err := c.cc.Invoke(ctx, "/evmos.erc20.v1.Msg/ConvertCoins", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) ConvertERC20S(ctx context.Context, in *MsgConvertERC20S, opts ...grpc.CallOption) (*MsgConvertERC20SResponse, error) {
+	out := new(MsgConvertERC20SResponse)
+	err := c.cc.Invoke(ctx, "/evmos.erc20.v1.Msg/ConvertERC20S", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) RefreshTokenPairMetadata(ctx context.Context, in *MsgRefreshTokenPairMetadata, opts ...grpc.CallOption) (*MsgRefreshTokenPairMetadataResponse, error) {
+	out := new(MsgRefreshTokenPairMetadataResponse)
+	err := c.cc.Invoke(ctx, "/evmos.erc20.v1.Msg/RefreshTokenPairMetadata", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) ConvertNFT(ctx context.Context, in *MsgConvertNFT, opts ...grpc.CallOption) (*MsgConvertNFTResponse, error) {
+	out := new(MsgConvertNFTResponse)
+	err := c.cc.Invoke(ctx, "/evmos.erc20.v1.Msg/ConvertNFT", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) ConvertContractNFT(ctx context.Context, in *MsgConvertContractNFT, opts ...grpc.CallOption) (*MsgConvertContractNFTResponse, error) {
+	out := new(MsgConvertContractNFTResponse)
+	err := c.cc.Invoke(ctx, "/evmos.erc20.v1.Msg/ConvertContractNFT", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) RefundFailedConversion(ctx context.Context, in *MsgRefundFailedConversion, opts ...grpc.CallOption) (*MsgRefundFailedConversionResponse, error) {
+	out := new(MsgRefundFailedConversionResponse)
+	err := c.cc.Invoke(ctx, "/evmos.erc20.v1.Msg/RefundFailedConversion", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// MsgServer is the server API for Msg service.
+type MsgServer interface {
+	// ConvertCoin mints a ERC20 representation of the native Cosmos coin denom
+	// that is registered on the token mapping.
+	ConvertCoin(context.Context, *MsgConvertCoin) (*MsgConvertCoinResponse, error)
+	// ConvertERC20 mints a native Cosmos coin representation of the ERC20 token
+	// contract that is registered on the token mapping.
+	ConvertERC20(context.Context, *MsgConvertERC20) (*MsgConvertERC20Response, error)
+	// RegisterERC20WithDeposit registers a token pair for an ERC20 token contract
+	// without a governance proposal by escrowing a refundable deposit.
+	RegisterERC20WithDeposit(context.Context, *MsgRegisterERC20) (*MsgRegisterERC20Response, error)
+	// DeregisterERC20 removes a token pair registered with a deposit from the
+	// token mapping and refunds the deposit to the depositor. The escrowed
+	// balances of the pair are unwound.
+	DeregisterERC20(context.Context, *MsgDeregisterERC20) (*MsgDeregisterERC20Response, error)
+	// ConvertCoins converts multiple native Cosmos coins to their ERC20
+	// representations. The conversion is reverted if any of the coins fails to
+	// convert.
+	ConvertCoins(context.Context, *MsgConvertCoins) (*MsgConvertCoinsResponse, error)
+	// ConvertERC20S converts multiple ERC20 tokens to their native Cosmos coin
+	// representations. The conversion is reverted if any of the tokens fails to
+	// convert.
+	ConvertERC20S(context.Context, *MsgConvertERC20S) (*MsgConvertERC20SResponse, error)
+	// RefreshTokenPairMetadata updates the bank metadata of an externally owned
+	// ERC20 token pair with the current name and symbol of its contract.
+	RefreshTokenPairMetadata(context.Context, *MsgRefreshTokenPairMetadata) (*MsgRefreshTokenPairMetadataResponse, error)
+	// ConvertNFT converts a native x/nft token to the ERC721 or ERC1155 token of
+	// the contract that is registered on the NFT mapping.
+	ConvertNFT(context.Context, *MsgConvertNFT) (*MsgConvertNFTResponse, error)
+	// ConvertContractNFT converts an ERC721 or ERC1155 token of a contract that is
+	// registered on the NFT mapping to a native x/nft token.
+	ConvertContractNFT(context.Context, *MsgConvertContractNFT) (*MsgConvertContractNFTResponse, error)
+	// RefundFailedConversion transfers the ERC20 tokens of a failed EVM hook
+	// conversion from the module address back to their sender.
+	RefundFailedConversion(context.Context, *MsgRefundFailedConversion) (*MsgRefundFailedConversionResponse, error)
+}
+
+// UnimplementedMsgServer can be embedded to have forward compatible implementations.
+type UnimplementedMsgServer struct {
+}
+
+func (*UnimplementedMsgServer) ConvertCoin(ctx context.Context, req *MsgConvertCoin) (*MsgConvertCoinResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ConvertCoin not implemented")
+}
+func (*UnimplementedMsgServer) ConvertERC20(ctx context.Context, req *MsgConvertERC20) (*MsgConvertERC20Response, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ConvertERC20 not implemented")
+}
+func (*UnimplementedMsgServer) RegisterERC20WithDeposit(ctx context.Context, req *MsgRegisterERC20) (*MsgRegisterERC20Response, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RegisterERC20WithDeposit not implemented")
+}
+func (*UnimplementedMsgServer) DeregisterERC20(ctx context.Context, req *MsgDeregisterERC20) (*MsgDeregisterERC20Response, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeregisterERC20 not implemented")
+}
+func (*UnimplementedMsgServer) ConvertCoins(ctx context.Context, req *MsgConvertCoins) (*MsgConvertCoinsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ConvertCoins not implemented")
+}
+func (*UnimplementedMsgServer) ConvertERC20S(ctx context.Context, req *MsgConvertERC20S) (*MsgConvertERC20SResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ConvertERC20S not implemented")
+}
+func (*UnimplementedMsgServer) RefreshTokenPairMetadata(ctx context.Context, req *MsgRefreshTokenPairMetadata) (*MsgRefreshTokenPairMetadataResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RefreshTokenPairMetadata not implemented")
+}
+func (*UnimplementedMsgServer) ConvertNFT(ctx context.Context, req *MsgConvertNFT) (*MsgConvertNFTResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ConvertNFT not implemented")
+}
+func (*UnimplementedMsgServer) ConvertContractNFT(ctx context.Context, req *MsgConvertContractNFT) (*MsgConvertContractNFTResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ConvertContractNFT not implemented")
+}
+func (*UnimplementedMsgServer) RefundFailedConversion(ctx context.Context, req *MsgRefundFailedConversion) (*MsgRefundFailedConversionResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RefundFailedConversion not implemented")
+}
+
+func RegisterMsgServer(s grpc1.Server, srv MsgServer) {
+	s.RegisterService(&_Msg_serviceDesc, srv)
+}
+
+func _Msg_ConvertCoin_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgConvertCoin)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).ConvertCoin(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/evmos.erc20.v1.Msg/ConvertCoin",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).ConvertCoin(ctx, req.(*MsgConvertCoin))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_ConvertERC20_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgConvertERC20)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).ConvertERC20(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/evmos.erc20.v1.Msg/ConvertERC20",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).ConvertERC20(ctx, req.(*MsgConvertERC20))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_RegisterERC20WithDeposit_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgRegisterERC20)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).RegisterERC20WithDeposit(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/evmos.erc20.v1.Msg/RegisterERC20WithDeposit",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).RegisterERC20WithDeposit(ctx, req.(*MsgRegisterERC20))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_DeregisterERC20_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgDeregisterERC20)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).DeregisterERC20(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/evmos.erc20.v1.Msg/DeregisterERC20",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).DeregisterERC20(ctx, req.(*MsgDeregisterERC20))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_ConvertCoins_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgConvertCoins)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).ConvertCoins(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/evmos.erc20.v1.Msg/ConvertCoins",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).ConvertCoins(ctx, req.(*MsgConvertCoins))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_ConvertERC20S_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgConvertERC20S)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).ConvertERC20S(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/evmos.erc20.v1.Msg/ConvertERC20S",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).ConvertERC20S(ctx, req.(*MsgConvertERC20S))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_RefreshTokenPairMetadata_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgRefreshTokenPairMetadata)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).RefreshTokenPairMetadata(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/evmos.erc20.v1.Msg/RefreshTokenPairMetadata",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).RefreshTokenPairMetadata(ctx, req.(*MsgRefreshTokenPairMetadata))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_ConvertNFT_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgConvertNFT)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).ConvertNFT(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/evmos.erc20.v1.Msg/ConvertNFT",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).ConvertNFT(ctx, req.(*MsgConvertNFT))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_ConvertContractNFT_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgConvertContractNFT)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).ConvertContractNFT(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/evmos.erc20.v1.Msg/ConvertContractNFT",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).ConvertContractNFT(ctx, req.(*MsgConvertContractNFT))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_RefundFailedConversion_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgRefundFailedConversion)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).RefundFailedConversion(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/evmos.erc20.v1.Msg/RefundFailedConversion",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).RefundFailedConversion(ctx, req.(*MsgRefundFailedConversion))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+var _Msg_serviceDesc = grpc.ServiceDesc{
+	ServiceName: "evmos.erc20.v1.Msg",
+	HandlerType: (*MsgServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "ConvertCoin",
+			Handler:    _Msg_ConvertCoin_Handler,
+		},
+		{
+			MethodName: "ConvertERC20",
+			Handler:    _Msg_ConvertERC20_Handler,
+		},
+		{
+			MethodName: "RegisterERC20WithDeposit",
+			Handler:    _Msg_RegisterERC20WithDeposit_Handler,
+		},
+		{
+			MethodName: "DeregisterERC20",
+			Handler:    _Msg_DeregisterERC20_Handler,
+		},
+		{
+			MethodName: "ConvertCoins",
+			Handler:    _Msg_ConvertCoins_Handler,
+		},
+		{
+			MethodName: "ConvertERC20S",
+			Handler:    _Msg_ConvertERC20S_Handler,
+		},
+		{
+			MethodName: "RefreshTokenPairMetadata",
+			Handler:    _Msg_RefreshTokenPairMetadata_Handler,
+		},
+		{
+			MethodName: "ConvertNFT",
+			Handler:    _Msg_ConvertNFT_Handler,
+		},
+		{
+			MethodName: "ConvertContractNFT",
+			Handler:    _Msg_ConvertContractNFT_Handler,
+		},
+		{
+			MethodName: "RefundFailedConversion",
+			Handler:    _Msg_RefundFailedConversion_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "evmos/erc20/v1/tx.proto",
+}
+
+func (m *MsgConvertCoin) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgConvertCoin) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgConvertCoin) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Sender) > 0 {
+		i -= len(m.Sender)
+		copy(dAtA[i:], m.Sender)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Sender)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Receiver) > 0 {
+		i -= len(m.Receiver)
+		copy(dAtA[i:], m.Receiver)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Receiver)))
+		i--
+		dAtA[i] = 0x12
+	}
+	{
+		size, err := m.Coin.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgConvertCoinResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgConvertCoinResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgConvertCoinResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgConvertERC20) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgConvertERC20) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgConvertERC20) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Sender) > 0 {
+		i -= len(m.Sender)
+		copy(dAtA[i:], m.Sender)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Sender)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.Receiver) > 0 {
+		i -= len(m.Receiver)
+		copy(dAtA[i:], m.Receiver)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Receiver)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	{
+		size := m.Amount.Size()
+		i -= size
+		if _, err := m.Amount.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if len(m.ContractAddress) > 0 {
+		i -= len(m.ContractAddress)
+		copy(dAtA[i:], m.ContractAddress)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.ContractAddress)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgConvertERC20Response) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgConvertERC20Response) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgConvertERC20Response) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgRegisterERC20) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgRegisterERC20) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgRegisterERC20) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Sender) > 0 {
+		i -= len(m.Sender)
+		copy(dAtA[i:], m.Sender)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Sender)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.ContractAddress) > 0 {
+		i -= len(m.ContractAddress)
+		copy(dAtA[i:], m.ContractAddress)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.ContractAddress)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgRegisterERC20Response) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgRegisterERC20Response) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgRegisterERC20Response) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgDeregisterERC20) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgDeregisterERC20) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgDeregisterERC20) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Sender) > 0 {
+		i -= len(m.Sender)
+		copy(dAtA[i:], m.Sender)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Sender)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.ContractAddress) > 0 {
+		i -= len(m.ContractAddress)
+		copy(dAtA[i:], m.ContractAddress)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.ContractAddress)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgDeregisterERC20Response) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgDeregisterERC20Response) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgDeregisterERC20Response) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgConvertCoins) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgConvertCoins) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgConvertCoins) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Sender) > 0 {
+		i -= len(m.Sender)
+		copy(dAtA[i:], m.Sender)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Sender)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Receiver) > 0 {
+		i -= len(m.Receiver)
+		copy(dAtA[i:], m.Receiver)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Receiver)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Coins) > 0 {
+		for iNdEx := len(m.Coins) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Coins[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintTx(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgConvertCoinsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgConvertCoinsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgConvertCoinsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Balances) > 0 {
+		for iNdEx := len(m.Balances) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Balances[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintTx(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *ERC20Amount) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ERC20Amount) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ERC20Amount) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size := m.Amount.Size()
+		i -= size
+		if _, err := m.Amount.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if len(m.ContractAddress) > 0 {
+		i -= len(m.ContractAddress)
+		copy(dAtA[i:], m.ContractAddress)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.ContractAddress)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgConvertERC20S) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgConvertERC20S) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgConvertERC20S) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Sender) > 0 {
+		i -= len(m.Sender)
+		copy(dAtA[i:], m.Sender)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Sender)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Receiver) > 0 {
+		i -= len(m.Receiver)
+		copy(dAtA[i:], m.Receiver)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Receiver)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Tokens) > 0 {
+		for iNdEx := len(m.Tokens) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Tokens[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintTx(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgConvertERC20SResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgConvertERC20SResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgConvertERC20SResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Balances) > 0 {
+		for iNdEx := len(m.Balances) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Balances[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintTx(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *TokenPairBalance) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *TokenPairBalance) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *TokenPairBalance) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size := m.Erc20Balance.Size()
+		i -= size
+		if _, err := m.Erc20Balance.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x22
+	{
+		size := m.CosmosBalance.Size()
+		i -= size
+		if _, err := m.CosmosBalance.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	if len(m.Erc20Address) > 0 {
+		i -= len(m.Erc20Address)
+		copy(dAtA[i:], m.Erc20Address)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Erc20Address)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Denom) > 0 {
+		i -= len(m.Denom)
+		copy(dAtA[i:], m.Denom)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Denom)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgRefreshTokenPairMetadata) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgRefreshTokenPairMetadata) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgRefreshTokenPairMetadata) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Sender) > 0 {
+		i -= len(m.Sender)
+		copy(dAtA[i:], m.Sender)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Sender)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.ContractAddress) > 0 {
+		i -= len(m.ContractAddress)
+		copy(dAtA[i:], m.ContractAddress)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.ContractAddress)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgRefreshTokenPairMetadataResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgRefreshTokenPairMetadataResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgRefreshTokenPairMetadataResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Updated {
+		i--
+		if m.Updated {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x10
+	}
+	{
+		size, err := m.Metadata.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgConvertNFT) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgConvertNFT) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgConvertNFT) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Sender) > 0 {
+		i -= len(m.Sender)
+		copy(dAtA[i:], m.Sender)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Sender)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.Receiver) > 0 {
+		i -= len(m.Receiver)
+		copy(dAtA[i:], m.Receiver)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Receiver)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.NFTID) > 0 {
+		i -= len(m.NFTID)
+		copy(dAtA[i:], m.NFTID)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.NFTID)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.ClassID) > 0 {
+		i -= len(m.ClassID)
+		copy(dAtA[i:], m.ClassID)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.ClassID)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgConvertNFTResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgConvertNFTResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgConvertNFTResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgConvertContractNFT) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgConvertContractNFT) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgConvertContractNFT) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Sender) > 0 {
+		i -= len(m.Sender)
+		copy(dAtA[i:], m.Sender)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Sender)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.Receiver) > 0 {
+		i -= len(m.Receiver)
+		copy(dAtA[i:], m.Receiver)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Receiver)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	{
+		size := m.TokenID.Size()
+		i -= size
+		if _, err := m.TokenID.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if len(m.ContractAddress) > 0 {
+		i -= len(m.ContractAddress)
+		copy(dAtA[i:], m.ContractAddress)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.ContractAddress)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgConvertContractNFTResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgConvertContractNFTResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgConvertContractNFTResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgRefundFailedConversion) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgRefundFailedConversion) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgRefundFailedConversion) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Sender) > 0 {
+		i -= len(m.Sender)
+		copy(dAtA[i:], m.Sender)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Sender)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if m.LogIndex != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.LogIndex))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.TxHash) > 0 {
+		i -= len(m.TxHash)
+		copy(dAtA[i:], m.TxHash)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.TxHash)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgRefundFailedConversionResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgRefundFailedConversionResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgRefundFailedConversionResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintTx(dAtA []byte, offset int, v uint64) int {
+	offset -= sovTx(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *MsgConvertCoin) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Coin.Size()
+	n += 1 + l + sovTx(uint64(l))
+	l = len(m.Receiver)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.Sender)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgConvertCoinResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgConvertERC20) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ContractAddress)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = m.Amount.Size()
+	n += 1 + l + sovTx(uint64(l))
+	l = len(m.Receiver)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.Sender)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgConvertERC20Response) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgRegisterERC20) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ContractAddress)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.Sender)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgRegisterERC20Response) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgDeregisterERC20) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ContractAddress)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.Sender)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgDeregisterERC20Response) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgConvertCoins) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Coins) > 0 {
+		for _, e := range m.Coins {
+			l = e.Size()
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
+	l = len(m.Receiver)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.Sender)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgConvertCoinsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Balances) > 0 {
+		for _, e := range m.Balances {
+			l = e.Size()
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *ERC20Amount) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ContractAddress)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = m.Amount.Size()
+	n += 1 + l + sovTx(uint64(l))
+	return n
+}
+
+func (m *MsgConvertERC20S) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Tokens) > 0 {
+		for _, e := range m.Tokens {
+			l = e.Size()
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
+	l = len(m.Receiver)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.Sender)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgConvertERC20SResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Balances) > 0 {
+		for _, e := range m.Balances {
+			l = e.Size()
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *TokenPairBalance) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Denom)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.Erc20Address)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = m.CosmosBalance.Size()
+	n += 1 + l + sovTx(uint64(l))
+	l = m.Erc20Balance.Size()
+	n += 1 + l + sovTx(uint64(l))
+	return n
+}
+
+func (m *MsgRefreshTokenPairMetadata) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ContractAddress)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.Sender)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgRefreshTokenPairMetadataResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Metadata.Size()
+	n += 1 + l + sovTx(uint64(l))
+	if m.Updated {
+		n += 2
+	}
+	return n
+}
+
+func (m *MsgConvertNFT) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ClassID)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.NFTID)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.Receiver)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.Sender)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgConvertNFTResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgConvertContractNFT) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ContractAddress)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = m.TokenID.Size()
+	n += 1 + l + sovTx(uint64(l))
+	l = len(m.Receiver)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.Sender)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgConvertContractNFTResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgRefundFailedConversion) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.TxHash)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.LogIndex != 0 {
+		n += 1 + sovTx(uint64(m.LogIndex))
+	}
+	l = len(m.Sender)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgRefundFailedConversionResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func sovTx(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozTx(x uint64) (n int) {
+	return sovTx(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *MsgConvertCoin) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgConvertCoin: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgConvertCoin: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Coin", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Coin.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Receiver", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Receiver = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Sender", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Sender = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgConvertCoinResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgConvertCoinResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgConvertCoinResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgConvertERC20) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgConvertERC20: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgConvertERC20: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ContractAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ContractAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Amount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Receiver", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Receiver = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Sender", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Sender = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgConvertERC20Response) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgConvertERC20Response: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgConvertERC20Response: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgRegisterERC20) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgRegisterERC20: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgRegisterERC20: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ContractAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ContractAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Sender", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Sender = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgRegisterERC20Response) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgRegisterERC20Response: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgRegisterERC20Response: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgDeregisterERC20) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgDeregisterERC20: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgDeregisterERC20: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ContractAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ContractAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Sender", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Sender = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgDeregisterERC20Response) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgDeregisterERC20Response: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgDeregisterERC20Response: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgConvertCoins) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgConvertCoins: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgConvertCoins: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Coins", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Coins = append(m.Coins, types.Coin{})
+			if err := m.Coins[len(m.Coins)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Receiver", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Receiver = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Sender", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Sender = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgConvertCoinsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgConvertCoinsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgConvertCoinsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Balances", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Balances = append(m.Balances, TokenPairBalance{})
+			if err := m.Balances[len(m.Balances)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ERC20Amount) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ERC20Amount: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ERC20Amount: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ContractAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ContractAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Amount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgConvertERC20S) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgConvertERC20S: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgConvertERC20S: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Tokens", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Tokens = append(m.Tokens, ERC20Amount{})
+			if err := m.Tokens[len(m.Tokens)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Receiver", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Receiver = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Sender", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Sender = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgConvertERC20SResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgConvertERC20SResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgConvertERC20SResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Balances", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Balances = append(m.Balances, TokenPairBalance{})
+			if err := m.Balances[len(m.Balances)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *TokenPairBalance) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: TokenPairBalance: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: TokenPairBalance: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Denom", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Denom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Erc20Address", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Erc20Address = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CosmosBalance", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.CosmosBalance.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Erc20Balance", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Erc20Balance.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
 	}
-	l = len(m.Sender)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgRefreshTokenPairMetadata) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgRefreshTokenPairMetadata: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgRefreshTokenPairMetadata: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ContractAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ContractAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Sender", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Sender = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgRefreshTokenPairMetadataResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgRefreshTokenPairMetadataResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgRefreshTokenPairMetadataResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Metadata", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Metadata.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Updated", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Updated = bool(v != 0)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
 	}
-	return n
-}
 
-func (m *MsgConvertERC20Response) Size() (n int) {
-	if m == nil {
-		return 0
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
 	}
-	var l int
-	_ = l
-	return n
-}
-
-func sovTx(x uint64) (n int) {
-	return (math_bits.Len64(x|1) + 6) / 7
-}
-func sozTx(x uint64) (n int) {
-	return sovTx(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+	return nil
 }
-func (m *MsgConvertCoin) Unmarshal(dAtA []byte) error {
+func (m *MsgConvertNFT) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -650,17 +4700,17 @@ func (m *MsgConvertCoin) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgConvertCoin: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgConvertNFT: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgConvertCoin: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgConvertNFT: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Coin", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ClassID", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -670,26 +4720,57 @@ func (m *MsgConvertCoin) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthTx
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthTx
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Coin.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.ClassID = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NFTID", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.NFTID = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
 			if wireType != 2 {
 				return fmt.Errorf("proto: wrong wireType = %d for field Receiver", wireType)
 			}
@@ -721,7 +4802,7 @@ func (m *MsgConvertCoin) Unmarshal(dAtA []byte) error {
 			}
 			m.Receiver = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 3:
+		case 4:
 			if wireType != 2 {
 				return fmt.Errorf("proto: wrong wireType = %d for field Sender", wireType)
 			}
@@ -774,7 +4855,7 @@ func (m *MsgConvertCoin) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgConvertCoinResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgConvertNFTResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -797,10 +4878,10 @@ func (m *MsgConvertCoinResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgConvertCoinResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgConvertNFTResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgConvertCoinResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgConvertNFTResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		default:
@@ -824,7 +4905,7 @@ func (m *MsgConvertCoinResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgConvertERC20) Unmarshal(dAtA []byte) error {
+func (m *MsgConvertContractNFT) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -847,10 +4928,10 @@ func (m *MsgConvertERC20) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgConvertERC20: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgConvertContractNFT: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgConvertERC20: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgConvertContractNFT: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -887,7 +4968,7 @@ func (m *MsgConvertERC20) Unmarshal(dAtA []byte) error {
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field TokenID", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -915,7 +4996,7 @@ func (m *MsgConvertERC20) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Amount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.TokenID.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -1004,7 +5085,7 @@ func (m *MsgConvertERC20) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgConvertERC20Response) Unmarshal(dAtA []byte) error {
+func (m *MsgConvertContractNFTResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1027,10 +5108,193 @@ func (m *MsgConvertERC20Response) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgConvertERC20Response: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgConvertContractNFTResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgConvertERC20Response: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgConvertContractNFTResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgRefundFailedConversion) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgRefundFailedConversion: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgRefundFailedConversion: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TxHash", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.TxHash = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LogIndex", wireType)
+			}
+			m.LogIndex = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.LogIndex |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Sender", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Sender = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgRefundFailedConversionResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgRefundFailedConversionResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgRefundFailedConversionResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		default:
@@ -68,6 +68,18 @@ func EqualMetadata(a, b banktypes.Metadata) error {
 	return fmt.Errorf("metadata provided is different from stored")
 }
 
+// GetMetadataDecimals returns the exponent of the display denom unit of the
+// coin metadata, or zero if the display unit is not found.
+func GetMetadataDecimals(metadata banktypes.Metadata) uint32 {
+	for _, unit := range metadata.DenomUnits {
+		if unit.Denom == metadata.Display {
+			return unit.Exponent
+		}
+	}
+
+	return 0
+}
+
 // EqualStringSlice checks if two string slices are equal.
 func EqualStringSlice(aliasesA, aliasesB []string) bool {
 	if len(aliasesA) != len(aliasesB) {
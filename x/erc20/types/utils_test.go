@@ -247,3 +247,46 @@ func TestEqualAliases(t *testing.T) {
 		require.Equal(t, tc.expEqual, EqualStringSlice(tc.aliasesA, tc.aliasesB), tc.name)
 	}
 }
+
+func TestGetMetadataDecimals(t *testing.T) {
+	testCases := []struct {
+		name        string
+		metadata    banktypes.Metadata
+		expDecimals uint32
+	}{
+		{
+			"display is base denom",
+			banktypes.Metadata{
+				Base:       "erc20/0xdac17f958d2ee523a2206206994597c13d831ec7",
+				Display:    "erc20/0xdac17f958d2ee523a2206206994597c13d831ec7",
+				DenomUnits: []*banktypes.DenomUnit{{Denom: "erc20/0xdac17f958d2ee523a2206206994597c13d831ec7", Exponent: 0}},
+			},
+			0,
+		},
+		{
+			"display unit with decimals",
+			banktypes.Metadata{
+				Base:    "erc20/0xdac17f958d2ee523a2206206994597c13d831ec7",
+				Display: "usdt",
+				DenomUnits: []*banktypes.DenomUnit{
+					{Denom: "erc20/0xdac17f958d2ee523a2206206994597c13d831ec7", Exponent: 0},
+					{Denom: "usdt", Exponent: 6},
+				},
+			},
+			6,
+		},
+		{
+			"display unit not found",
+			banktypes.Metadata{
+				Base:       "erc20/0xdac17f958d2ee523a2206206994597c13d831ec7",
+				Display:    "usdt",
+				DenomUnits: []*banktypes.DenomUnit{{Denom: "erc20/0xdac17f958d2ee523a2206206994597c13d831ec7", Exponent: 0}},
+			},
+			0,
+		},
+	}
+
+	for _, tc := range testCases {
+		require.Equal(t, tc.expDecimals, GetMetadataDecimals(tc.metadata), tc.name)
+	}
+}
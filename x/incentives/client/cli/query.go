@@ -29,6 +29,9 @@ func GetQueryCmd() *cobra.Command {
 		GetGasMeterCmd(),
 		GetAllocationMetersCmd(),
 		GetAllocationMeterCmd(),
+		GetPendingRewardsCmd(),
+		GetIncentivePayoutsCmd(),
+		GetParticipantPayoutsCmd(),
 		GetParamsCmd(),
 	)
 	return cmd
@@ -190,6 +193,42 @@ func GetGasMeterCmd() *cobra.Command {
 	return cmd
 }
 
+// GetPendingRewardsCmd queries the incentive rewards a participant can claim
+func GetPendingRewardsCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "pending-rewards PARTICIPANT_ADDRESS",
+		Short: "Gets the incentive rewards a participant can claim",
+		Long:  "Gets the incentive rewards a participant can claim from all finalized reward periods",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			if !common.IsHexAddress(args[0]) {
+				return fmt.Errorf("invalid participant address: %s", args[0])
+			}
+
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+
+			req := &types.QueryPendingRewardsRequest{
+				Participant: args[0],
+			}
+
+			res, err := queryClient.PendingRewards(context.Background(), req)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+	return cmd
+}
+
 // GetAllocationMetersCmd queries the list of allocation meters
 func GetAllocationMetersCmd() *cobra.Command {
 	cmd := &cobra.Command{
@@ -259,6 +298,101 @@ func GetAllocationMeterCmd() *cobra.Command {
 	return cmd
 }
 
+// GetIncentivePayoutsCmd queries the payout history of an incentive
+func GetIncentivePayoutsCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "incentive-payouts CONTRACT_ADDRESS",
+		Short: "Gets the payout history of an incentive",
+		Long:  "Gets the payout summaries of the retained reward periods of an incentive",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			if !common.IsHexAddress(args[0]) {
+				return fmt.Errorf("invalid contract address: %s", args[0])
+			}
+
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+
+			pageReq, err := client.ReadPageRequest(cmd.Flags())
+			if err != nil {
+				return err
+			}
+
+			req := &types.QueryIncentivePayoutsRequest{
+				Contract:   args[0],
+				Pagination: pageReq,
+			}
+
+			res, err := queryClient.IncentivePayouts(context.Background(), req)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+	flags.AddPaginationFlagsToCmd(cmd, "incentive payouts")
+	return cmd
+}
+
+// GetParticipantPayoutsCmd queries the payout history of a participant
+func GetParticipantPayoutsCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "participant-payouts PARTICIPANT_ADDRESS [CONTRACT_ADDRESS]",
+		Short: "Gets the payout history of a participant",
+		Long:  "Gets the payouts of a participant for the retained reward periods of all incentives or of the given incentive",
+		Args:  cobra.RangeArgs(1, 2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			if !common.IsHexAddress(args[0]) {
+				return fmt.Errorf("invalid participant address: %s", args[0])
+			}
+
+			contract := ""
+			if len(args) == 2 {
+				if !common.IsHexAddress(args[1]) {
+					return fmt.Errorf("invalid contract address: %s", args[1])
+				}
+				contract = args[1]
+			}
+
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+
+			pageReq, err := client.ReadPageRequest(cmd.Flags())
+			if err != nil {
+				return err
+			}
+
+			req := &types.QueryParticipantPayoutsRequest{
+				Participant: args[0],
+				Contract:    contract,
+				Pagination:  pageReq,
+			}
+
+			res, err := queryClient.ParticipantPayouts(context.Background(), req)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+	flags.AddPaginationFlagsToCmd(cmd, "participant payouts")
+	return cmd
+}
+
 // GetParamsCmd queries the module parameters
 func GetParamsCmd() *cobra.Command {
 	cmd := &cobra.Command{
@@ -3,11 +3,13 @@ package cli
 import (
 	"fmt"
 	"strconv"
+	"strings"
 
 	"github.com/ethereum/go-ethereum/common"
 	"github.com/spf13/cobra"
 
 	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/flags"
 	"github.com/cosmos/cosmos-sdk/client/tx"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/cosmos/cosmos-sdk/version"
@@ -17,6 +19,100 @@ import (
 	"github.com/evmos/evmos/v10/x/incentives/types"
 )
 
+// weighting and eligibility flags of the register incentive proposal
+const (
+	FlagWeighting           = "weighting"
+	FlagGasCap              = "gas-cap"
+	FlagMinStake            = "min-stake"
+	FlagMinParticipationAge = "min-participation-age"
+	FlagMinBalance          = "min-balance"
+)
+
+// NewTxCmd returns a root CLI command handler for incentives transaction commands
+func NewTxCmd() *cobra.Command {
+	txCmd := &cobra.Command{
+		Use:                        types.ModuleName,
+		Short:                      "incentives subcommands",
+		DisableFlagParsing:         true,
+		SuggestionsMinimumDistance: 2,
+		RunE:                       client.ValidateCmd,
+	}
+
+	txCmd.AddCommand(
+		NewFundIncentiveCmd(),
+		NewClaimIncentiveRewardsCmd(),
+	)
+	return txCmd
+}
+
+// NewFundIncentiveCmd returns a CLI command handler for escrowing coins for an
+// incentive
+func NewFundIncentiveCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "fund-incentive CONTRACT_ADDRESS AMOUNT",
+		Short:   "Escrow coins to reward the participants of a registered incentive during its remaining epochs",
+		Long:    "Escrow coins to reward the participants of a registered incentive during its remaining epochs. The unspent coins are refunded when the incentive ends or is cancelled.",
+		Example: fmt.Sprintf("$ %s tx incentives fund-incentive <contract> 1000000000aevmos --from=<key_or_address>", version.AppName),
+		Args:    cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cliCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			amount, err := sdk.ParseCoinsNormalized(args[1])
+			if err != nil {
+				return err
+			}
+
+			msg := &types.MsgFundIncentive{
+				Contract: args[0],
+				Amount:   amount,
+				Funder:   cliCtx.GetFromAddress().String(),
+			}
+
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(cliCtx, cmd.Flags(), msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+	return cmd
+}
+
+// NewClaimIncentiveRewardsCmd returns a CLI command handler for claiming the
+// accrued incentive rewards of the sender
+func NewClaimIncentiveRewardsCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "claim-rewards",
+		Short:   "Claim the incentive rewards of the sender from all finalized reward periods",
+		Example: fmt.Sprintf("$ %s tx incentives claim-rewards --from=<key_or_address>", version.AppName),
+		Args:    cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, _ []string) error {
+			cliCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			msg := &types.MsgClaimIncentiveRewards{
+				Sender: cliCtx.GetFromAddress().String(),
+			}
+
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(cliCtx, cmd.Flags(), msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+	return cmd
+}
+
 // NewRegisterIncentiveProposalCmd implements the command to submit a register
 //
 //	incentive proposal
@@ -27,8 +123,8 @@ func NewRegisterIncentiveProposalCmd() *cobra.Command {
 		Use:     "register-incentive CONTRACT_ADDRESS ALLOCATION EPOCHS",
 		Args:    cobra.ExactArgs(3),
 		Short:   "Submit a proposal to register a contract incentive",
-		Long:    "Submit a proposal to register a contract incentive.",
-		Example: fmt.Sprintf("$ %s tx gov submit-proposal register-incentive <contract> --from=<key_or_address>", version.AppName),
+		Long:    "Submit a proposal to register a contract incentive. The rewards are split among the participants by the weighting strategy: gas, unique-participants, capped-gas (requires --gas-cap) or sqrt-gas.",
+		Example: fmt.Sprintf("$ %s tx gov submit-proposal register-incentive <contract> 0.005aevmos 10 --weighting=capped-gas --gas-cap=1000000 --from=<key_or_address>", version.AppName),
 		RunE: func(cmd *cobra.Command, args []string) error {
 			clientCtx, err := client.GetClientTxContext(cmd)
 			if err != nil {
@@ -71,8 +167,50 @@ func NewRegisterIncentiveProposalCmd() *cobra.Command {
 
 			contract := args[0]
 
+			strategyStr, err := cmd.Flags().GetString(FlagWeighting)
+			if err != nil {
+				return err
+			}
+
+			strategy, err := parseWeightingStrategy(strategyStr)
+			if err != nil {
+				return err
+			}
+
+			gasCap, err := cmd.Flags().GetUint64(FlagGasCap)
+			if err != nil {
+				return err
+			}
+
+			minStakeStr, err := cmd.Flags().GetString(FlagMinStake)
+			if err != nil {
+				return err
+			}
+
+			minStake, ok := sdk.NewIntFromString(minStakeStr)
+			if !ok {
+				return fmt.Errorf("invalid minimum stake: %s", minStakeStr)
+			}
+
+			minParticipationAge, err := cmd.Flags().GetUint64(FlagMinParticipationAge)
+			if err != nil {
+				return err
+			}
+
+			minBalanceStr, err := cmd.Flags().GetString(FlagMinBalance)
+			if err != nil {
+				return err
+			}
+
+			minBalance, err := sdk.ParseCoinsNormalized(minBalanceStr)
+			if err != nil {
+				return err
+			}
+
 			from := clientCtx.GetFromAddress()
-			content := types.NewRegisterIncentiveProposal(title, description, contract, allocation, uint32(epochs))
+			weighting := types.NewWeighting(strategy, gasCap)
+			eligibility := types.NewEligibility(minStake, minParticipationAge, minBalance)
+			content := types.NewRegisterIncentiveProposal(title, description, contract, allocation, uint32(epochs), weighting, eligibility)
 
 			msg, err := govv1beta1.NewMsgSubmitProposal(content, deposit, from)
 			if err != nil {
@@ -90,6 +228,11 @@ func NewRegisterIncentiveProposalCmd() *cobra.Command {
 	cmd.Flags().String(cli.FlagTitle, "", "title of proposal")
 	cmd.Flags().String(cli.FlagDescription, "", "description of proposal")
 	cmd.Flags().String(cli.FlagDeposit, "1aevmos", "deposit of proposal")
+	cmd.Flags().String(FlagWeighting, "gas", "strategy used to weight the participants (gas|unique-participants|capped-gas|sqrt-gas)")
+	cmd.Flags().Uint64(FlagGasCap, 0, "maximum gas of a participant taken into account by the capped-gas strategy")
+	cmd.Flags().String(FlagMinStake, "0", "minimum amount of the staking denomination bonded by eligible participants")
+	cmd.Flags().Uint64(FlagMinParticipationAge, 0, "minimum number of blocks since the first interaction of eligible participants with an incentivized contract")
+	cmd.Flags().String(FlagMinBalance, "", "minimum balance held by eligible participants")
 	if err := cmd.MarkFlagRequired(cli.FlagTitle); err != nil {
 		panic(err)
 	}
@@ -176,3 +319,103 @@ func NewCancelIncentiveProposalCmd() *cobra.Command {
 	}
 	return cmd
 }
+
+// NewUpdateIncentiveProposalCmd implements the command to submit an update
+// incentive proposal
+func NewUpdateIncentiveProposalCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "update-incentive CONTRACT_ADDRESS ALLOCATION EPOCHS",
+		Args:    cobra.ExactArgs(3),
+		Short:   "Submit a proposal to update the allocations and remaining epochs of a contract incentive",
+		Long:    "Submit a proposal to update the allocations and remaining epochs of a contract incentive. The allocations replace the current ones and the gas meters of the current epoch are kept.",
+		Example: fmt.Sprintf("$ %s tx gov submit-proposal update-incentive <contract> 0.005aevmos 20 --from=<key_or_address>", version.AppName),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			title, err := cmd.Flags().GetString(cli.FlagTitle)
+			if err != nil {
+				return err
+			}
+
+			description, err := cmd.Flags().GetString(cli.FlagDescription)
+			if err != nil {
+				return err
+			}
+
+			depositStr, err := cmd.Flags().GetString(cli.FlagDeposit)
+			if err != nil {
+				return err
+			}
+
+			deposit, err := sdk.ParseCoinsNormalized(depositStr)
+			if err != nil {
+				return err
+			}
+
+			if !common.IsHexAddress(args[0]) {
+				return fmt.Errorf("invalid contract address: %s", args[0])
+			}
+
+			allocation, err := sdk.ParseDecCoins(args[1])
+			if err != nil {
+				return err
+			}
+
+			epochs, err := strconv.ParseUint(args[2], 10, 32)
+			if err != nil {
+				return err
+			}
+
+			contract := args[0]
+
+			from := clientCtx.GetFromAddress()
+			content := types.NewUpdateIncentiveProposal(title, description, contract, allocation, uint32(epochs))
+
+			msg, err := govv1beta1.NewMsgSubmitProposal(content, deposit, from)
+			if err != nil {
+				return err
+			}
+
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	cmd.Flags().String(cli.FlagTitle, "", "title of proposal")
+	cmd.Flags().String(cli.FlagDescription, "", "description of proposal")
+	cmd.Flags().String(cli.FlagDeposit, "1aevmos", "deposit of proposal")
+	if err := cmd.MarkFlagRequired(cli.FlagTitle); err != nil {
+		panic(err)
+	}
+	if err := cmd.MarkFlagRequired(cli.FlagDescription); err != nil {
+		panic(err)
+	}
+	if err := cmd.MarkFlagRequired(cli.FlagDeposit); err != nil {
+		panic(err)
+	}
+	return cmd
+}
+
+// parseWeightingStrategy parses the weighting strategy of an incentive
+func parseWeightingStrategy(strategy string) (types.WeightingStrategy, error) {
+	switch strings.ToLower(strategy) {
+	case "gas":
+		return types.WEIGHTING_STRATEGY_GAS, nil
+	case "unique-participants":
+		return types.WEIGHTING_STRATEGY_UNIQUE_PARTICIPANTS, nil
+	case "capped-gas":
+		return types.WEIGHTING_STRATEGY_CAPPED_GAS, nil
+	case "sqrt-gas":
+		return types.WEIGHTING_STRATEGY_SQRT_GAS, nil
+	default:
+		return types.WEIGHTING_STRATEGY_UNSPECIFIED, fmt.Errorf(
+			"invalid weighting strategy '%s', expected gas, unique-participants, capped-gas or sqrt-gas", strategy,
+		)
+	}
+}
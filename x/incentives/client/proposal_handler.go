@@ -9,4 +9,5 @@ import (
 var (
 	RegisterIncentiveProposalHandler = govclient.NewProposalHandler(cli.NewRegisterIncentiveProposalCmd)
 	CancelIncentiveProposalHandler   = govclient.NewProposalHandler(cli.NewCancelIncentiveProposalCmd)
+	UpdateIncentiveProposalHandler   = govclient.NewProposalHandler(cli.NewUpdateIncentiveProposalCmd)
 )
@@ -55,13 +55,53 @@ func InitGenesis(
 	for _, gasMeter := range data.GasMeters {
 		k.SetGasMeter(ctx, gasMeter)
 	}
+
+	// Set participants
+	for _, participant := range data.Participants {
+		k.SetParticipant(ctx, participant)
+	}
+
+	// Set funds
+	for _, fund := range data.Funds {
+		k.SetFund(ctx, fund)
+	}
+
+	// Set reward periods and accrued rewards, which are both reserved from the
+	// module account balance
+	outstanding := sdk.Coins{}
+	for _, rp := range data.RewardPeriods {
+		k.SetRewardPeriod(ctx, rp)
+		outstanding = outstanding.Add(rp.Remaining...)
+	}
+
+	for _, ar := range data.AccruedRewards {
+		k.SetAccruedRewards(ctx, ar)
+		outstanding = outstanding.Add(ar.Rewards...)
+	}
+
+	k.SetOutstandingRewards(ctx, outstanding)
+
+	// Set payout history
+	for _, ip := range data.IncentivePayouts {
+		k.SetIncentivePayout(ctx, ip)
+	}
+
+	for _, pp := range data.ParticipantPayouts {
+		k.SetParticipantPayout(ctx, pp)
+	}
 }
 
 // ExportGenesis export module status
 func ExportGenesis(ctx sdk.Context, k keeper.Keeper) *types.GenesisState {
 	return &types.GenesisState{
-		Params:     k.GetParams(ctx),
-		Incentives: k.GetAllIncentives(ctx),
-		GasMeters:  k.GetIncentivesGasMeters(ctx),
+		Params:             k.GetParams(ctx),
+		Incentives:         k.GetAllIncentives(ctx),
+		GasMeters:          k.GetIncentivesGasMeters(ctx),
+		Participants:       k.GetAllParticipants(ctx),
+		Funds:              k.GetAllFunds(ctx),
+		RewardPeriods:      k.GetAllRewardPeriods(ctx),
+		AccruedRewards:     k.GetAllAccruedRewards(ctx),
+		IncentivePayouts:   k.GetAllIncentivePayouts(ctx),
+		ParticipantPayouts: k.GetAllParticipantPayouts(ctx),
 	}
 }
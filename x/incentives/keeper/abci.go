@@ -0,0 +1,12 @@
+package keeper
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// EndBlocker prunes a bounded number of inactive participants and of payouts
+// that are no longer retained at the end of each block
+func (k Keeper) EndBlocker(ctx sdk.Context) {
+	k.pruneParticipants(ctx)
+	k.prunePayouts(ctx)
+}
@@ -1,7 +1,6 @@
 package keeper
 
 import (
-	"math/big"
 	"strconv"
 
 	errorsmod "cosmossdk.io/errors"
@@ -15,24 +14,40 @@ import (
 	"github.com/evmos/evmos/v10/x/incentives/types"
 )
 
-// DistributeRewards transfers the allocated rewards to the participants of a given
-// incentive.
+// DistributeRewards finalizes the current reward period of each incentive. The
+// rewards are not transferred to the participants, who claim them through
+// MsgClaimIncentiveRewards instead, so that the cost of ending an epoch doesn't
+// depend on the number of participants.
+//   - releases the unsettled rewards of the reward periods that expired
 //   - allocates the amount to be distributed from the inflation pool
-//   - distributes the rewards to all participants
-//   - deletes all gas meters
+//   - reserves the allocation and the epoch amount of the funds of each incentive for the participants of the period
+//   - records the payout summary of the period
+//   - deducts the reserved rewards from the funds of the incentive
+//   - starts a new reward period for each incentive
 //   - updates the remaining epochs of each incentive
 //   - sets the cumulative totalGas to zero
+//   - refunds the unspent funds of the incentives without remaining epochs
 func (k Keeper) DistributeRewards(ctx sdk.Context) error {
 	logger := k.Logger(ctx)
 
+	k.expireRewardPeriods(ctx)
+
 	rewardAllocations, totalRewards, err := k.rewardAllocations(ctx)
 	if err != nil {
 		return err
 	}
 
 	k.IterateIncentives(ctx, func(incentive types.Incentive) (stop bool) {
-		_, _ = k.rewardParticipants(ctx, incentive, rewardAllocations)
+		contract := common.HexToAddress(incentive.Contract)
+		reserved := k.finalizeRewardPeriod(ctx, incentive, rewardAllocations[contract])
+		if reserved.IsZero() {
+			logger.Debug(
+				"no weighted participants of incentive during epoch",
+				"contract", incentive.Contract,
+			)
+		}
 
+		incentive.Period++
 		incentive.Epochs--
 
 		// Update Incentive and reset its total gas count. Remove incentive if it
@@ -42,6 +57,7 @@ func (k Keeper) DistributeRewards(ctx sdk.Context) error {
 			k.SetIncentiveTotalGas(ctx, incentive, 0)
 		} else {
 			k.DeleteIncentiveAndUpdateAllocationMeters(ctx, incentive)
+			k.refundFunds(ctx, contract)
 			logger.Info(
 				"incentive finalized",
 				"contract", incentive.Contract,
@@ -80,22 +96,33 @@ func (k Keeper) DistributeRewards(ctx sdk.Context) error {
 //   - Iterate over all the registered and active incentives
 //   - create an allocation (module account) from escrow balance to be distributed to the contract address
 //   - check that escrow balance is sufficient
+//
+// The coins escrowed through MsgFundIncentive are excluded from the escrow
+// balance, as they are only distributed to the participants of the funded
+// incentive. So are the outstanding rewards reserved for the participants of
+// previous reward periods.
 func (k Keeper) rewardAllocations(
 	ctx sdk.Context,
 ) (map[common.Address]sdk.Coins, sdk.Coins, error) {
 	// Get balances on incentive module account
 	denomBalances := make(map[string]math.Int)
 	moduleAddr := k.accountKeeper.GetModuleAddress(types.ModuleName)
+	reserved := k.GetTotalFunds(ctx).Add(k.GetOutstandingRewards(ctx)...)
 
 	escrow := sdk.Coins{}
 
 	// iterate over the module account balance insert elements to the denom -> amount
 	// lookup map
 	k.bankKeeper.IterateAccountBalances(ctx, moduleAddr, func(coin sdk.Coin) bool {
-		denomBalances[coin.Denom] = coin.Amount
+		amount := coin.Amount.Sub(reserved.AmountOf(coin.Denom))
+		if !amount.IsPositive() {
+			return false
+		}
+
+		denomBalances[coin.Denom] = amount
 		// NOTE: all coins have different denomination so we can safely append instead
 		// of using Add
-		escrow = append(escrow, coin)
+		escrow = append(escrow, sdk.Coin{Denom: coin.Denom, Amount: amount})
 		return false
 	})
 
@@ -149,104 +176,3 @@ func (k Keeper) rewardAllocations(
 
 	return rewardAllocations, rewards, nil
 }
-
-// rewardParticipants reward participants of a given Incentive, delete their gas
-// meters and returns a count of all gas meters
-//   - Check if participants spent gas on interacting with incentive
-//   - Iterate over the incentive participants' gas meters
-//   - Allocate rewards according to participants gasRatio and cap them at 100% of their gas spent on interaction with incentive
-//   - Send rewards to participants
-//   - Delete gas meter
-func (k Keeper) rewardParticipants(
-	ctx sdk.Context,
-	incentive types.Incentive,
-	coinsAllocated map[common.Address]sdk.Coins,
-) (rewards sdk.Coins, count uint64) {
-	logger := k.Logger(ctx)
-
-	// Check if coin allocation was successful
-	contract := common.HexToAddress(incentive.Contract)
-	contractAllocation, ok := coinsAllocated[contract]
-	if !ok {
-		logger.Debug(
-			"contract allocation coins not found",
-			"contract", incentive.Contract,
-		)
-		return sdk.Coins{}, 0
-	}
-
-	// Check if participants spent gas on interacting with incentive
-	totalGas := incentive.TotalGas
-	if totalGas == 0 {
-		logger.Debug(
-			"no gas spent on incentive during epoch",
-			"contract", incentive.Contract,
-		)
-		return sdk.Coins{}, 0
-	}
-
-	totalGasDec := sdk.NewDecFromBigInt(new(big.Int).SetUint64(totalGas))
-	mintDenom := k.evmKeeper.GetParams(ctx).EvmDenom
-	rewardScaler := k.GetParams(ctx).RewardScaler
-
-	// Iterate over the incentive's gas meters and distribute rewards
-	k.IterateIncentiveGasMeters(
-		ctx,
-		contract,
-		func(gm types.GasMeter) (stop bool) {
-			// Get participant's ratio of `gas spent / total gas spent`
-			cumulativeGas := sdk.NewDecFromBigInt(new(big.Int).SetUint64(gm.CumulativeGas))
-			gasRatio := cumulativeGas.Quo(totalGasDec)
-			coins := sdk.Coins{}
-
-			// Allocate rewards according to gasRatio
-			for _, allocation := range incentive.Allocations {
-				coinAllocated := contractAllocation.AmountOf(allocation.Denom)
-				reward := gasRatio.MulInt(coinAllocated)
-				if !reward.IsPositive() {
-					continue
-				}
-
-				// Cap rewards in mint denom (i.e. aevmos) to receive only up to 100% of
-				// the participant's gas spent and prevent gaming
-				if mintDenom == allocation.Denom {
-					rewardCap := cumulativeGas.Mul(rewardScaler)
-					reward = sdk.MinDec(reward, rewardCap)
-				}
-
-				// NOTE: ignore denom validation
-				coin := sdk.Coin{Denom: allocation.Denom, Amount: reward.TruncateInt()}
-				coins = coins.Add(coin)
-			}
-
-			rewards = rewards.Add(coins...)
-
-			// Send rewards to participant
-			participant := common.HexToAddress(gm.Participant)
-			err := k.bankKeeper.SendCoinsFromModuleToAccount(
-				ctx,
-				types.ModuleName,
-				sdk.AccAddress(participant.Bytes()),
-				coins,
-			)
-			if err != nil {
-				logger.Debug(
-					"failed to distribute incentive",
-					"address", gm.Participant,
-					"allocation", coins.String(),
-					"incentive", gm.Contract,
-					"error", err.Error(),
-				)
-				return true // break iteration
-			}
-
-			// Remove gas meter once the rewards are distributed
-			k.DeleteGasMeter(ctx, gm)
-			count++
-
-			return false
-		},
-	)
-
-	return rewards, count
-}
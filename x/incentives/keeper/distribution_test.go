@@ -4,6 +4,7 @@ import (
 	"fmt"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/ethereum/go-ethereum/common"
 
 	"github.com/evmos/evmos/v10/x/incentives/types"
 )
@@ -77,6 +78,8 @@ func (suite *KeeperTestSuite) TestDistributeIncentives() {
 				contract,
 				tc.allocations,
 				tc.epochs,
+				types.DefaultWeighting(),
+				types.DefaultEligibility(),
 			)
 			suite.Require().NoError(err)
 
@@ -87,16 +90,9 @@ func (suite *KeeperTestSuite) TestDistributeIncentives() {
 			balance := suite.app.BankKeeper.GetBalance(suite.ctx, moduleAddr, tc.denom)
 			suite.Require().True(balance.IsPositive())
 
-			// create Gas Meter
-			gm := types.NewGasMeter(contract, participant, gasUsed)
-			suite.app.IncentivesKeeper.SetGasMeter(suite.ctx, gm)
-
-			// Set total gas meter
-			suite.app.IncentivesKeeper.SetIncentiveTotalGas(
-				suite.ctx,
-				regIn,
-				totalGasUsed,
-			)
+			// meter the gas of both participants
+			suite.addGasUsed(contract, participant, gasUsed)
+			suite.addGasUsed(contract, participant2, totalGasUsed-gasUsed)
 			suite.Commit()
 
 			err = suite.app.IncentivesKeeper.DistributeRewards(suite.ctx)
@@ -104,19 +100,34 @@ func (suite *KeeperTestSuite) TestDistributeIncentives() {
 			if tc.expPass {
 				suite.Require().NoError(err, tc.name)
 
-				// distributes the rewards to all participants
-				sdkParticipant := sdk.AccAddress(participant.Bytes())
-				balance := suite.app.BankKeeper.GetBalance(suite.ctx, sdkParticipant, tc.denom)
-
 				gasRatio := sdk.NewDec(int64(gasUsed)).QuoInt64(int64(totalGasUsed))
 				coinAllocated := sdk.NewDec(tc.mintAmount).MulInt64(allocationRate).QuoInt64(100)
 				expBalance := coinAllocated.Mul(gasRatio)
 				params := suite.app.IncentivesKeeper.GetParams(suite.ctx)
 				expBalance = sdk.MinDec(expBalance, params.RewardScaler.MulInt64(int64(gasUsed)))
+				expReward := sdk.NewCoin(tc.denom, expBalance.TruncateInt())
 
-				suite.Require().Equal(expBalance.TruncateInt(), balance.Amount, tc.name)
+				// the rewards are reserved but not transferred to the participants
+				sdkParticipant := sdk.AccAddress(participant.Bytes())
+				balance := suite.app.BankKeeper.GetBalance(suite.ctx, sdkParticipant, tc.denom)
+				suite.Require().True(balance.IsZero(), tc.name)
 
-				// deletes all gas meters
+				res, err := suite.app.IncentivesKeeper.PendingRewards(
+					sdk.WrapSDKContext(suite.ctx),
+					&types.QueryPendingRewardsRequest{Participant: participant.Hex()},
+				)
+				suite.Require().NoError(err)
+				suite.Require().Equal(expReward.Amount, res.Rewards.AmountOf(tc.denom), tc.name)
+
+				// the participant claims its rewards
+				rewards, err := suite.app.IncentivesKeeper.ClaimRewards(suite.ctx, participant)
+				suite.Require().NoError(err)
+				suite.Require().Equal(expReward.Amount, rewards.AmountOf(tc.denom), tc.name)
+
+				balance = suite.app.BankKeeper.GetBalance(suite.ctx, sdkParticipant, tc.denom)
+				suite.Require().Equal(expReward.Amount, balance.Amount, tc.name)
+
+				// deletes the settled gas meter
 				_, found := suite.app.IncentivesKeeper.GetGasMeter(suite.ctx, contract, participant)
 				suite.Require().False(found)
 
@@ -139,3 +150,97 @@ func (suite *KeeperTestSuite) TestDistributeIncentives() {
 		})
 	}
 }
+
+func (suite *KeeperTestSuite) TestDistributeIncentivesWeighting() {
+	const (
+		mintAmount int64  = 1000000
+		gasUsed    uint64 = 100
+		gasUsed2   uint64 = 900
+	)
+
+	testCases := []struct {
+		name      string
+		weighting types.Weighting
+		expShare  sdk.Dec
+		expShare2 sdk.Dec
+	}{
+		{
+			"gas",
+			types.DefaultWeighting(),
+			sdk.NewDecWithPrec(1, 1),
+			sdk.NewDecWithPrec(9, 1),
+		},
+		{
+			"unspecified strategy defaults to gas",
+			types.Weighting{},
+			sdk.NewDecWithPrec(1, 1),
+			sdk.NewDecWithPrec(9, 1),
+		},
+		{
+			"unique participants",
+			types.NewWeighting(types.WEIGHTING_STRATEGY_UNIQUE_PARTICIPANTS, 0),
+			sdk.NewDecWithPrec(5, 1),
+			sdk.NewDecWithPrec(5, 1),
+		},
+		{
+			"capped gas - both participants above the cap",
+			types.NewWeighting(types.WEIGHTING_STRATEGY_CAPPED_GAS, 100),
+			sdk.NewDecWithPrec(5, 1),
+			sdk.NewDecWithPrec(5, 1),
+		},
+		{
+			"capped gas - one participant above the cap",
+			types.NewWeighting(types.WEIGHTING_STRATEGY_CAPPED_GAS, 400),
+			sdk.NewDecWithPrec(2, 1),
+			sdk.NewDecWithPrec(8, 1),
+		},
+		{
+			"sqrt gas",
+			types.NewWeighting(types.WEIGHTING_STRATEGY_SQRT_GAS, 0),
+			sdk.NewDecWithPrec(25, 2),
+			sdk.NewDecWithPrec(75, 2),
+		},
+	}
+	for _, tc := range testCases {
+		suite.Run(fmt.Sprintf("Case %s", tc.name), func() {
+			suite.SetupTest() // reset
+
+			err := suite.app.BankKeeper.MintCoins(
+				suite.ctx,
+				types.ModuleName,
+				sdk.Coins{sdk.NewInt64Coin(denomCoin, mintAmount)},
+			)
+			suite.Require().NoError(err)
+
+			_, err = suite.app.IncentivesKeeper.RegisterIncentive(
+				suite.ctx,
+				contract,
+				sdk.DecCoins{sdk.NewDecCoinFromDec(denomCoin, sdk.NewDecWithPrec(allocationRate, 2))},
+				epochs,
+				tc.weighting,
+				types.DefaultEligibility(),
+			)
+			suite.Require().NoError(err)
+
+			suite.addGasUsed(contract, participant, gasUsed)
+			suite.addGasUsed(contract, participant2, gasUsed2)
+			suite.Commit()
+
+			err = suite.app.IncentivesKeeper.DistributeRewards(suite.ctx)
+			suite.Require().NoError(err)
+
+			for _, p := range []common.Address{participant, participant2} {
+				_, err = suite.app.IncentivesKeeper.ClaimRewards(suite.ctx, p)
+				suite.Require().NoError(err)
+			}
+
+			coinAllocated := sdk.NewDec(mintAmount).MulInt64(allocationRate).QuoInt64(100)
+
+			balance := suite.app.BankKeeper.GetBalance(suite.ctx, sdk.AccAddress(participant.Bytes()), denomCoin)
+			suite.Require().Equal(coinAllocated.Mul(tc.expShare).TruncateInt(), balance.Amount)
+
+			balance = suite.app.BankKeeper.GetBalance(suite.ctx, sdk.AccAddress(participant2.Bytes()), denomCoin)
+			suite.Require().Equal(coinAllocated.Mul(tc.expShare2).TruncateInt(), balance.Amount)
+		})
+	}
+}
@@ -24,7 +24,18 @@ func (h Hooks) PostTxProcessing(ctx sdk.Context, msg core.Message, receipt *etht
 
 // PostTxProcessing implements EvmHooks.PostTxProcessing. After each successful
 // interaction with an incentivized contract, the participants's GasUsed is
-// added to its gasMeter.
+// added to its gasMeter and to the current reward period of the incentive. The
+// gas of participants that don't meet the eligibility rules of the incentive is
+// not added to the gasMeter nor to the incentive's totalGas.
+//
+// If the internal gas attribution is enabled, the GasUsed is split evenly
+// among all incentivized contracts that are either the recipient of the tx or
+// emitted logs during its execution, so that participants reaching a contract
+// through a router or a smart-contract wallet are also rewarded. As the split
+// isn't weighted by the gas used in each contract, it dilutes the gas metered
+// for the recipient, e.g. when its execution emits logs of incentivized
+// tokens. The share of an incentive for which the participant is not eligible
+// is not redistributed to the other contracts.
 func (k Keeper) PostTxProcessing(ctx sdk.Context, msg core.Message, receipt *ethtypes.Receipt) error {
 	// check if the Incentives are globally enabled
 	params := k.GetParams(ctx)
@@ -32,11 +43,11 @@ func (k Keeper) PostTxProcessing(ctx sdk.Context, msg core.Message, receipt *eth
 		return nil
 	}
 
-	contract := msg.To()
 	participant := msg.From()
 
-	// If theres no incentive registered for the contract, do nothing
-	if contract == nil || !k.IsIncentiveRegistered(ctx, *contract) {
+	// If theres no incentive registered for the contracts, do nothing
+	contracts := k.incentivizedContracts(ctx, params, msg, receipt)
+	if len(contracts) == 0 {
 		return nil
 	}
 
@@ -51,8 +62,22 @@ func (k Keeper) PostTxProcessing(ctx sdk.Context, msg core.Message, receipt *eth
 		return nil
 	}
 
-	k.addGasToIncentive(ctx, *contract, receipt.GasUsed)
-	k.addGasToParticipant(ctx, *contract, participant, receipt.GasUsed)
+	k.recordParticipant(ctx, participant)
+
+	for i, contract := range contracts {
+		// NOTE: existence of contract incentive is already checked
+		incentive, _ := k.GetIncentive(ctx, contract)
+		if !k.IsEligible(ctx, incentive, participant) {
+			k.Logger(ctx).Debug(
+				"participant not eligible for incentive",
+				"contract", contract.String(),
+				"participant", participant.String(),
+			)
+			continue
+		}
+
+		k.AddGasUsed(ctx, incentive, participant, gasShare(receipt.GasUsed, len(contracts), i))
+	}
 
 	defer func() {
 		telemetry.IncrCounter(
@@ -71,30 +96,51 @@ func (k Keeper) PostTxProcessing(ctx sdk.Context, msg core.Message, receipt *eth
 	return nil
 }
 
-// addGasToIncentive adds gasUsed to an incentive's cumulated totalGas
-func (k Keeper) addGasToIncentive(
+// incentivizedContracts returns the incentivized contracts that the gas of a
+// tx is attributed to, without duplicates. These are the recipient of the tx
+// and, if the internal gas attribution is enabled, the contracts that emitted
+// logs in the receipt. Contracts that are called internally without emitting
+// any log can't be identified from the receipt and are not credited.
+func (k Keeper) incentivizedContracts(
 	ctx sdk.Context,
-	contract common.Address,
-	gasUsed uint64,
-) {
-	// NOTE: existence of contract incentive is already checked
-	incentive, _ := k.GetIncentive(ctx, contract)
-	incentive.TotalGas += gasUsed
-	k.SetIncentive(ctx, incentive)
-}
+	params types.Params,
+	msg core.Message,
+	receipt *ethtypes.Receipt,
+) []common.Address {
+	candidates := []common.Address{}
+	if msg.To() != nil {
+		candidates = append(candidates, *msg.To())
+	}
 
-// addGasToParticipant adds gasUsed to a participant's gas meter's cumulative
-// gas used
-func (k Keeper) addGasToParticipant(
-	ctx sdk.Context,
-	contract, participant common.Address,
-	gasUsed uint64,
-) {
-	previousGas, found := k.GetGasMeter(ctx, contract, participant)
-	if found {
-		gasUsed += previousGas
+	if params.EnableInternalGasAttribution {
+		for _, log := range receipt.Logs {
+			candidates = append(candidates, log.Address)
+		}
 	}
 
-	gm := types.NewGasMeter(contract, participant, gasUsed)
-	k.SetGasMeter(ctx, gm)
+	contracts := []common.Address{}
+	seen := make(map[common.Address]bool)
+	for _, contract := range candidates {
+		if seen[contract] {
+			continue
+		}
+		seen[contract] = true
+
+		if k.IsIncentiveRegistered(ctx, contract) {
+			contracts = append(contracts, contract)
+		}
+	}
+
+	return contracts
+}
+
+// gasShare returns the share of the gas used by a tx that is attributed to the
+// i-th of n contracts. The remainder of the division is attributed to the
+// first contract, so that the shares add up to the gas used.
+func gasShare(gasUsed uint64, n, i int) uint64 {
+	share := gasUsed / uint64(n)
+	if i == 0 {
+		share += gasUsed % uint64(n)
+	}
+	return share
 }
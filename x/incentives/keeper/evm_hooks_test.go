@@ -6,6 +6,7 @@ import (
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/ethereum/go-ethereum/common"
+	ethtypes "github.com/ethereum/go-ethereum/core/types"
 	"github.com/ethereum/go-ethereum/crypto"
 
 	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
@@ -142,6 +143,8 @@ func (suite *KeeperTestSuite) TestEvmHooksStoreTxGasUsed() {
 				contractAddr,
 				mintAllocations,
 				epochs,
+				types.DefaultWeighting(),
+				types.DefaultEligibility(),
 			)
 			suite.Require().NoError(err)
 
@@ -177,3 +180,198 @@ func (suite *KeeperTestSuite) TestEvmHooksStoreTxGasUsed() {
 	}
 	suite.mintFeeCollector = false
 }
+
+func (suite *KeeperTestSuite) TestEvmHooksEligibility() {
+	testCases := []struct {
+		name        string
+		eligibility types.Eligibility
+		expPass     bool
+	}{
+		{
+			"eligible participant",
+			types.DefaultEligibility(),
+			true,
+		},
+		{
+			"ineligible participant - insufficient balance",
+			types.NewEligibility(sdk.ZeroInt(), 0, sdk.NewCoins(sdk.NewInt64Coin(denomCoin, 1))),
+			false,
+		},
+		{
+			"ineligible participant - first interaction",
+			types.NewEligibility(sdk.ZeroInt(), 1, nil),
+			false,
+		},
+	}
+	for _, tc := range testCases {
+		suite.Run(fmt.Sprintf("Case %s", tc.name), func() {
+			suite.mintFeeCollector = true
+			suite.SetupTest()
+			suite.ensureHooksSet()
+
+			contractAddr, err := suite.DeployContract(denomCoin, "COIN", erc20Decimals)
+			suite.Require().NoError(err)
+			suite.Commit()
+
+			_, err = suite.app.IncentivesKeeper.RegisterIncentive(
+				suite.ctx,
+				contractAddr,
+				mintAllocations,
+				epochs,
+				types.DefaultWeighting(),
+				tc.eligibility,
+			)
+			suite.Require().NoError(err)
+
+			coins := sdk.NewCoins(sdk.NewCoin(evm.DefaultEVMDenom, sdk.NewInt(30000000)))
+			err = testutil.FundAccount(suite.ctx, suite.app.BankKeeper, sdk.AccAddress(suite.address.Bytes()), coins)
+			suite.Require().NoError(err)
+
+			res := suite.MintERC20Token(contractAddr, suite.address, suite.address, big.NewInt(1000))
+			expGasUsed := res.AsTransaction().Gas()
+
+			// the first interaction is recorded regardless of the eligibility, but
+			// only if an incentive sets a minimum participation age
+			_, found := suite.app.IncentivesKeeper.GetParticipantFirstHeight(suite.ctx, suite.address)
+			suite.Require().Equal(tc.eligibility.MinParticipationAge > 0, found)
+
+			incentive, _ := suite.app.IncentivesKeeper.GetIncentive(suite.ctx, contractAddr)
+			gm, _ := suite.app.IncentivesKeeper.GetGasMeter(suite.ctx, contractAddr, suite.address)
+
+			if tc.expPass {
+				suite.Require().Equal(expGasUsed, gm)
+				suite.Require().Equal(expGasUsed, incentive.TotalGas)
+			} else {
+				suite.Require().Zero(gm)
+				suite.Require().Zero(incentive.TotalGas)
+			}
+		})
+	}
+	suite.mintFeeCollector = false
+}
+
+func (suite *KeeperTestSuite) TestEvmHooksInternalGasAttribution() {
+	const gasUsed = uint64(1001)
+
+	router := tests.GenerateAddress()
+	other := tests.GenerateAddress()
+
+	testCases := []struct {
+		name          string
+		enabled       bool
+		to            func() common.Address
+		logs          func() []common.Address
+		eligibleOther bool
+		expGas        uint64
+		expGasOther   uint64
+	}{
+		{
+			"disabled - logs of incentivized contracts are ignored",
+			false,
+			func() common.Address { return router },
+			func() []common.Address { return []common.Address{contract, contract2} },
+			true,
+			0,
+			0,
+		},
+		{
+			"disabled - recipient is credited",
+			false,
+			func() common.Address { return contract },
+			func() []common.Address { return []common.Address{contract2} },
+			true,
+			gasUsed,
+			0,
+		},
+		{
+			"enabled - gas split among contracts reached through a router",
+			true,
+			func() common.Address { return router },
+			func() []common.Address { return []common.Address{contract, contract2, contract} },
+			true,
+			501,
+			500,
+		},
+		{
+			"enabled - gas split among recipient and log emitter",
+			true,
+			func() common.Address { return contract2 },
+			func() []common.Address { return []common.Address{other, contract} },
+			true,
+			500,
+			501,
+		},
+		{
+			"enabled - non-incentivized log emitters are ignored",
+			true,
+			func() common.Address { return contract },
+			func() []common.Address { return []common.Address{router, other} },
+			true,
+			gasUsed,
+			0,
+		},
+		{
+			"enabled - recipient diluted by incentivized log emitters",
+			true,
+			func() common.Address { return contract },
+			func() []common.Address { return []common.Address{contract2, contract2} },
+			true,
+			501,
+			500,
+		},
+		{
+			"enabled - share of an ineligible incentive is not redistributed",
+			true,
+			func() common.Address { return contract },
+			func() []common.Address { return []common.Address{contract2} },
+			false,
+			501,
+			0,
+		},
+	}
+	for _, tc := range testCases {
+		suite.Run(fmt.Sprintf("Case %s", tc.name), func() {
+			suite.SetupTest() // reset
+
+			params := suite.app.IncentivesKeeper.GetParams(suite.ctx)
+			params.EnableInternalGasAttribution = tc.enabled
+			suite.app.IncentivesKeeper.SetParams(suite.ctx, params)
+
+			for _, c := range []common.Address{contract, contract2} {
+				eligibility := types.DefaultEligibility()
+				if c == contract2 && !tc.eligibleOther {
+					// the participant interacts for the first time
+					eligibility = types.NewEligibility(sdk.ZeroInt(), 1, nil)
+				}
+
+				_, err := suite.app.IncentivesKeeper.RegisterIncentive(
+					suite.ctx,
+					c,
+					mintAllocations,
+					epochs,
+					types.DefaultWeighting(),
+					eligibility,
+				)
+				suite.Require().NoError(err)
+			}
+
+			acc := authtypes.NewBaseAccount(sdk.AccAddress(participant.Bytes()), nil, 0, 0)
+			suite.app.AccountKeeper.SetAccount(suite.ctx, acc)
+
+			to := tc.to()
+			msg := ethtypes.NewMessage(participant, &to, 0, nil, gasUsed, nil, nil, nil, nil, nil, true)
+			receipt := &ethtypes.Receipt{GasUsed: gasUsed}
+			for _, addr := range tc.logs() {
+				receipt.Logs = append(receipt.Logs, &ethtypes.Log{Address: addr})
+			}
+
+			err := suite.app.IncentivesKeeper.PostTxProcessing(suite.ctx, msg, receipt)
+			suite.Require().NoError(err)
+
+			gas, _ := suite.app.IncentivesKeeper.GetGasMeter(suite.ctx, contract, participant)
+			suite.Require().Equal(tc.expGas, gas)
+			gas, _ = suite.app.IncentivesKeeper.GetGasMeter(suite.ctx, contract2, participant)
+			suite.Require().Equal(tc.expGasOther, gas)
+		})
+	}
+}
@@ -0,0 +1,276 @@
+package keeper
+
+import (
+	errorsmod "cosmossdk.io/errors"
+	"github.com/cosmos/cosmos-sdk/store/prefix"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/ethereum/go-ethereum/common"
+
+	"github.com/evmos/evmos/v10/x/incentives/types"
+)
+
+// GetAllFunds returns the funds escrowed for all incentives
+func (k Keeper) GetAllFunds(ctx sdk.Context) []types.Fund {
+	funds := []types.Fund{}
+
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefixFund)
+	iterator := store.Iterator(nil, nil)
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		var fund types.Fund
+		k.cdc.MustUnmarshal(iterator.Value(), &fund)
+		funds = append(funds, fund)
+	}
+
+	return funds
+}
+
+// GetIncentiveFunds returns the funds escrowed for a given incentive
+func (k Keeper) GetIncentiveFunds(ctx sdk.Context, contract common.Address) []types.Fund {
+	funds := []types.Fund{}
+
+	k.IterateIncentiveFunds(ctx, contract, func(fund types.Fund) (stop bool) {
+		funds = append(funds, fund)
+		return false
+	})
+
+	return funds
+}
+
+// IterateIncentiveFunds iterates over the funds escrowed for a given incentive
+// and performs a callback
+func (k Keeper) IterateIncentiveFunds(
+	ctx sdk.Context,
+	contract common.Address,
+	handlerFn func(fund types.Fund) (stop bool),
+) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefixFund)
+	iterator := sdk.KVStorePrefixIterator(store, contract.Bytes())
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		var fund types.Fund
+		k.cdc.MustUnmarshal(iterator.Value(), &fund)
+
+		if handlerFn(fund) {
+			break
+		}
+	}
+}
+
+// GetFund returns the coins escrowed by a funder for a given incentive
+func (k Keeper) GetFund(
+	ctx sdk.Context,
+	contract common.Address,
+	funder sdk.AccAddress,
+) (types.Fund, bool) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefixFund)
+	bz := store.Get(append(contract.Bytes(), funder.Bytes()...))
+	if len(bz) == 0 {
+		return types.Fund{}, false
+	}
+
+	var fund types.Fund
+	k.cdc.MustUnmarshal(bz, &fund)
+	return fund, true
+}
+
+// SetFund stores the coins escrowed by a funder for an incentive
+func (k Keeper) SetFund(ctx sdk.Context, fund types.Fund) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefixFund)
+	store.Set(fundKey(fund), k.cdc.MustMarshal(&fund))
+}
+
+// DeleteFund removes the coins escrowed by a funder for an incentive
+func (k Keeper) DeleteFund(ctx sdk.Context, fund types.Fund) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefixFund)
+	store.Delete(fundKey(fund))
+}
+
+// fundKey returns the `<contract_address>|<funder_address>` key of a fund
+func fundKey(fund types.Fund) []byte {
+	contract := common.HexToAddress(fund.Contract)
+	funder := sdk.MustAccAddressFromBech32(fund.Funder)
+	return append(contract.Bytes(), funder.Bytes()...)
+}
+
+// fundIncentive escrows coins of the funder on the module account to reward
+// the participants of a registered incentive during its remaining epochs. Each
+// coin of a funding must be at least the minimum fund of its denomination, if
+// any. New funders are rejected once the incentive has the maximum number of
+// funders, as the funds of an incentive are iterated at the end of each epoch.
+func (k Keeper) fundIncentive(
+	ctx sdk.Context,
+	contract common.Address,
+	funder sdk.AccAddress,
+	amount sdk.Coins,
+) error {
+	// Check if the Incentives are globally enabled
+	params := k.GetParams(ctx)
+	if !params.EnableIncentives {
+		return errorsmod.Wrap(
+			types.ErrInternalIncentive,
+			"incentives are currently disabled by governance",
+		)
+	}
+
+	if !k.IsIncentiveRegistered(ctx, contract) {
+		return errorsmod.Wrapf(
+			types.ErrInternalIncentive,
+			"incentive not registered: %s", contract,
+		)
+	}
+
+	for _, coin := range amount {
+		if minAmount := params.MinFund.AmountOf(coin.Denom); coin.Amount.LT(minAmount) {
+			return errorsmod.Wrapf(
+				types.ErrInsufficientFund,
+				"fund amount %s is lower than the minimum fund %s%s", coin, minAmount, coin.Denom,
+			)
+		}
+	}
+
+	fund, found := k.GetFund(ctx, contract, funder)
+	if !found {
+		if funders := k.countIncentiveFunders(ctx, contract); funders >= params.MaxFunders {
+			return errorsmod.Wrapf(
+				types.ErrMaxFundersReached,
+				"incentive %s already has %d funders", contract, funders,
+			)
+		}
+		fund = types.NewFund(contract, funder, sdk.Coins{})
+	}
+
+	if err := k.bankKeeper.SendCoinsFromAccountToModule(ctx, funder, types.ModuleName, amount); err != nil {
+		return err
+	}
+
+	fund.Amount = fund.Amount.Add(amount...)
+	k.SetFund(ctx, fund)
+
+	return nil
+}
+
+// countIncentiveFunders returns the number of funders of an incentive
+func (k Keeper) countIncentiveFunders(ctx sdk.Context, contract common.Address) uint32 {
+	funders := uint32(0)
+	k.IterateIncentiveFunds(ctx, contract, func(_ types.Fund) (stop bool) {
+		funders++
+		return false
+	})
+
+	return funders
+}
+
+// GetTotalFunds returns the sum of the coins escrowed for all incentives. These
+// coins are held by the module account but are not part of the inflation pool.
+func (k Keeper) GetTotalFunds(ctx sdk.Context) sdk.Coins {
+	total := sdk.Coins{}
+	for _, fund := range k.GetAllFunds(ctx) {
+		total = total.Add(fund.Amount...)
+	}
+
+	return total
+}
+
+// fundAllocation returns the escrowed coins of an incentive to be distributed
+// during the current epoch
+func (k Keeper) fundAllocation(ctx sdk.Context, incentive types.Incentive) sdk.Coins {
+	allocation := sdk.Coins{}
+
+	k.IterateIncentiveFunds(
+		ctx,
+		common.HexToAddress(incentive.Contract),
+		func(fund types.Fund) (stop bool) {
+			allocation = allocation.Add(fund.EpochAmount(incentive.Epochs)...)
+			return false
+		},
+	)
+
+	return allocation
+}
+
+// spendFunds deducts the escrowed coins distributed during the current epoch
+// from the funds of an incentive. Each fund is charged up to its epoch amount,
+// so that funds that weren't distributed remain escrowed for the next epochs.
+// It returns the coins charged to each funder.
+func (k Keeper) spendFunds(ctx sdk.Context, incentive types.Incentive, spent sdk.Coins) []types.Fund {
+	charges := []types.Fund{}
+
+	for _, fund := range k.GetIncentiveFunds(ctx, common.HexToAddress(incentive.Contract)) {
+		if spent.IsZero() {
+			break
+		}
+
+		charged := spent.Min(fund.EpochAmount(incentive.Epochs))
+		if charged.IsZero() {
+			continue
+		}
+
+		spent = spent.Sub(charged...)
+		fund.Amount = fund.Amount.Sub(charged...)
+		charges = append(charges, types.Fund{Contract: fund.Contract, Funder: fund.Funder, Amount: charged})
+
+		if fund.Amount.IsZero() {
+			k.DeleteFund(ctx, fund)
+		} else {
+			k.SetFund(ctx, fund)
+		}
+	}
+
+	return charges
+}
+
+// refundFundRewards transfers the funded rewards of a reward period that were
+// not settled back to the funders of the period. A refund that fails remains
+// on the module account as part of the inflation pool.
+func (k Keeper) refundFundRewards(ctx sdk.Context, rp types.RewardPeriod) {
+	for _, refund := range rp.FundRefunds() {
+		funder := sdk.MustAccAddressFromBech32(refund.Funder)
+		if err := k.bankKeeper.SendCoinsFromModuleToAccount(ctx, types.ModuleName, funder, refund.Amount); err != nil {
+			k.Logger(ctx).Error(
+				"failed to refund unsettled funded rewards",
+				"contract", rp.Contract, "period", rp.Period, "funder", refund.Funder, "error", err.Error(),
+			)
+			continue
+		}
+
+		ctx.EventManager().EmitEvent(
+			sdk.NewEvent(
+				types.EventTypeRefundIncentiveFund,
+				sdk.NewAttribute(types.AttributeKeyContract, refund.Contract),
+				sdk.NewAttribute(types.AttributeKeyFunder, refund.Funder),
+				sdk.NewAttribute(types.AttributeKeyAmount, refund.Amount.String()),
+			),
+		)
+	}
+}
+
+// refundFunds transfers the remaining escrowed coins of an incentive back to
+// their funders and removes the funds. A refund that fails, e.g. because the
+// funder became a blocked address, is skipped and its coins remain on the
+// module account as part of the inflation pool.
+func (k Keeper) refundFunds(ctx sdk.Context, contract common.Address) {
+	for _, fund := range k.GetIncentiveFunds(ctx, contract) {
+		k.DeleteFund(ctx, fund)
+
+		funder := sdk.MustAccAddressFromBech32(fund.Funder)
+		if err := k.bankKeeper.SendCoinsFromModuleToAccount(ctx, types.ModuleName, funder, fund.Amount); err != nil {
+			k.Logger(ctx).Error(
+				"failed to refund incentive fund",
+				"contract", fund.Contract, "funder", fund.Funder, "error", err.Error(),
+			)
+			continue
+		}
+
+		ctx.EventManager().EmitEvent(
+			sdk.NewEvent(
+				types.EventTypeRefundIncentiveFund,
+				sdk.NewAttribute(types.AttributeKeyContract, fund.Contract),
+				sdk.NewAttribute(types.AttributeKeyFunder, fund.Funder),
+				sdk.NewAttribute(types.AttributeKeyAmount, fund.Amount.String()),
+			),
+		)
+	}
+}
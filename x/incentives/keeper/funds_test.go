@@ -0,0 +1,354 @@
+package keeper_test
+
+import (
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	"github.com/ethereum/go-ethereum/common"
+
+	"github.com/evmos/ethermint/tests"
+
+	"github.com/evmos/evmos/v10/testutil"
+	"github.com/evmos/evmos/v10/x/incentives/types"
+)
+
+func (suite *KeeperTestSuite) TestFundIncentive() {
+	funder := sdk.AccAddress(participant2.Bytes())
+	amount := sdk.NewCoins(sdk.NewInt64Coin(denomCoin, 1000))
+
+	testCases := []struct {
+		name      string
+		malleate  func()
+		expAmount sdk.Coins
+		expPass   bool
+	}{
+		{
+			"fail - incentives are disabled globally",
+			func() {
+				params := types.DefaultParams()
+				params.EnableIncentives = false
+				suite.app.IncentivesKeeper.SetParams(suite.ctx, params)
+			},
+			nil,
+			false,
+		},
+		{
+			"fail - incentive not registered",
+			func() {
+				err := suite.app.IncentivesKeeper.CancelIncentive(suite.ctx, contract)
+				suite.Require().NoError(err)
+			},
+			nil,
+			false,
+		},
+		{
+			"fail - insufficient funds",
+			func() {
+				err := suite.app.BankKeeper.SendCoins(
+					suite.ctx, funder, sdk.AccAddress(participant.Bytes()), amount,
+				)
+				suite.Require().NoError(err)
+			},
+			nil,
+			false,
+		},
+		{
+			"fail - fund lower than the minimum fund",
+			func() {
+				params := types.DefaultParams()
+				params.MinFund = sdk.NewCoins(sdk.NewInt64Coin(denomCoin, 1001))
+				suite.app.IncentivesKeeper.SetParams(suite.ctx, params)
+			},
+			nil,
+			false,
+		},
+		{
+			"fail - maximum number of funders reached",
+			func() {
+				params := types.DefaultParams()
+				params.MaxFunders = 1
+				suite.app.IncentivesKeeper.SetParams(suite.ctx, params)
+
+				other := sdk.AccAddress(tests.GenerateAddress().Bytes())
+				err := testutil.FundAccount(suite.ctx, suite.app.BankKeeper, other, amount)
+				suite.Require().NoError(err)
+				_, err = suite.app.IncentivesKeeper.FundIncentive(
+					sdk.WrapSDKContext(suite.ctx),
+					types.NewMsgFundIncentive(contract, amount, other),
+				)
+				suite.Require().NoError(err)
+			},
+			nil,
+			false,
+		},
+		{
+			"pass - first fund",
+			func() {},
+			amount,
+			true,
+		},
+		{
+			"pass - fund added to the existing fund",
+			func() {
+				_, err := suite.app.IncentivesKeeper.FundIncentive(
+					sdk.WrapSDKContext(suite.ctx),
+					types.NewMsgFundIncentive(contract, sdk.NewCoins(sdk.NewInt64Coin(denomCoin, 500)), funder),
+				)
+				suite.Require().NoError(err)
+			},
+			sdk.NewCoins(sdk.NewInt64Coin(denomCoin, 1500)),
+			true,
+		},
+		{
+			"pass - existing funder once the maximum number of funders is reached",
+			func() {
+				params := types.DefaultParams()
+				params.MaxFunders = 1
+				suite.app.IncentivesKeeper.SetParams(suite.ctx, params)
+
+				_, err := suite.app.IncentivesKeeper.FundIncentive(
+					sdk.WrapSDKContext(suite.ctx),
+					types.NewMsgFundIncentive(contract, sdk.NewCoins(sdk.NewInt64Coin(denomCoin, 500)), funder),
+				)
+				suite.Require().NoError(err)
+			},
+			sdk.NewCoins(sdk.NewInt64Coin(denomCoin, 1500)),
+			true,
+		},
+	}
+	for _, tc := range testCases {
+		suite.Run(fmt.Sprintf("Case %s", tc.name), func() {
+			suite.SetupTest() // reset
+
+			err := suite.app.BankKeeper.MintCoins(suite.ctx, types.ModuleName, amount)
+			suite.Require().NoError(err)
+
+			_, err = suite.app.IncentivesKeeper.RegisterIncentive(
+				suite.ctx,
+				contract,
+				allocations,
+				epochs,
+				types.DefaultWeighting(),
+				types.DefaultEligibility(),
+			)
+			suite.Require().NoError(err)
+
+			err = testutil.FundAccount(
+				suite.ctx, suite.app.BankKeeper, funder,
+				amount.Add(sdk.NewInt64Coin(denomCoin, 500)),
+			)
+			suite.Require().NoError(err)
+
+			tc.malleate()
+
+			_, err = suite.app.IncentivesKeeper.FundIncentive(
+				sdk.WrapSDKContext(suite.ctx),
+				types.NewMsgFundIncentive(contract, amount, funder),
+			)
+			fund, found := suite.app.IncentivesKeeper.GetFund(suite.ctx, contract, funder)
+
+			if tc.expPass {
+				suite.Require().NoError(err)
+				suite.Require().True(found)
+				suite.Require().Equal(tc.expAmount, fund.Amount)
+				suite.Require().Equal(tc.expAmount, suite.app.IncentivesKeeper.GetTotalFunds(suite.ctx))
+			} else {
+				suite.Require().Error(err)
+				suite.Require().False(found)
+			}
+		})
+	}
+}
+
+func (suite *KeeperTestSuite) TestDistributeIncentivesFunds() {
+	const (
+		mintAmount int64  = 1000
+		fundAmount int64  = 1000
+		gasUsed    uint64 = 100
+	)
+
+	suite.SetupTest()
+
+	funder := sdk.AccAddress(participant2.Bytes())
+	sdkParticipant := sdk.AccAddress(participant.Bytes())
+
+	// inflation pool
+	err := suite.app.BankKeeper.MintCoins(
+		suite.ctx,
+		types.ModuleName,
+		sdk.NewCoins(sdk.NewInt64Coin(denomCoin, mintAmount)),
+	)
+	suite.Require().NoError(err)
+
+	_, err = suite.app.IncentivesKeeper.RegisterIncentive(
+		suite.ctx,
+		contract,
+		allocations,
+		2,
+		types.DefaultWeighting(),
+		types.DefaultEligibility(),
+	)
+	suite.Require().NoError(err)
+
+	fundCoins := sdk.NewCoins(sdk.NewInt64Coin(denomCoin, fundAmount))
+	err = testutil.FundAccount(suite.ctx, suite.app.BankKeeper, funder, fundCoins)
+	suite.Require().NoError(err)
+
+	_, err = suite.app.IncentivesKeeper.FundIncentive(
+		sdk.WrapSDKContext(suite.ctx),
+		types.NewMsgFundIncentive(contract, fundCoins, funder),
+	)
+	suite.Require().NoError(err)
+
+	// first epoch - the participant receives the inflation allocation and half
+	// of the funds
+	suite.addGasUsed(contract, participant, gasUsed)
+
+	err = suite.app.IncentivesKeeper.DistributeRewards(suite.ctx)
+	suite.Require().NoError(err)
+
+	_, err = suite.app.IncentivesKeeper.ClaimRewards(suite.ctx, participant)
+	suite.Require().NoError(err)
+
+	// the funds are not part of the inflation pool
+	expInflationReward := mintAmount * allocationRate / 100
+	expReward := expInflationReward + fundAmount/2
+	balance := suite.app.BankKeeper.GetBalance(suite.ctx, sdkParticipant, denomCoin)
+	suite.Require().Equal(expReward, balance.Amount.Int64())
+
+	fund, found := suite.app.IncentivesKeeper.GetFund(suite.ctx, contract, funder)
+	suite.Require().True(found)
+	suite.Require().Equal(fundAmount/2, fund.Amount.AmountOf(denomCoin).Int64())
+
+	// last epoch - no gas spent, so the remaining funds are refunded once the
+	// incentive ends
+	err = suite.app.IncentivesKeeper.DistributeRewards(suite.ctx)
+	suite.Require().NoError(err)
+
+	suite.Require().False(suite.app.IncentivesKeeper.IsIncentiveRegistered(suite.ctx, contract))
+	_, found = suite.app.IncentivesKeeper.GetFund(suite.ctx, contract, funder)
+	suite.Require().False(found)
+
+	balance = suite.app.BankKeeper.GetBalance(suite.ctx, funder, denomCoin)
+	suite.Require().Equal(fundAmount/2, balance.Amount.Int64())
+}
+
+func (suite *KeeperTestSuite) TestDistributeIncentivesFundsOnly() {
+	const (
+		fundAmount int64  = 1000
+		gasUsed    uint64 = 100
+		gasUsed2   uint64 = 300
+	)
+
+	suite.SetupTest()
+
+	funder := sdk.AccAddress(suite.address.Bytes())
+
+	// incentive without inflation allocations
+	_, err := suite.app.IncentivesKeeper.RegisterIncentive(
+		suite.ctx,
+		contract,
+		sdk.DecCoins{},
+		1,
+		types.DefaultWeighting(),
+		types.DefaultEligibility(),
+	)
+	suite.Require().NoError(err)
+
+	fundCoins := sdk.NewCoins(sdk.NewInt64Coin(denomCoin, fundAmount))
+	err = testutil.FundAccount(suite.ctx, suite.app.BankKeeper, funder, fundCoins)
+	suite.Require().NoError(err)
+
+	_, err = suite.app.IncentivesKeeper.FundIncentive(
+		sdk.WrapSDKContext(suite.ctx),
+		types.NewMsgFundIncentive(contract, fundCoins, funder),
+	)
+	suite.Require().NoError(err)
+
+	suite.addGasUsed(contract, participant, gasUsed)
+	suite.addGasUsed(contract, participant2, gasUsed2)
+
+	err = suite.app.IncentivesKeeper.DistributeRewards(suite.ctx)
+	suite.Require().NoError(err)
+
+	for _, p := range []common.Address{participant, participant2} {
+		_, err = suite.app.IncentivesKeeper.ClaimRewards(suite.ctx, p)
+		suite.Require().NoError(err)
+	}
+
+	balance := suite.app.BankKeeper.GetBalance(suite.ctx, sdk.AccAddress(participant.Bytes()), denomCoin)
+	suite.Require().Equal(int64(250), balance.Amount.Int64())
+	balance = suite.app.BankKeeper.GetBalance(suite.ctx, sdk.AccAddress(participant2.Bytes()), denomCoin)
+	suite.Require().Equal(int64(750), balance.Amount.Int64())
+
+	// the whole fund was distributed
+	suite.Require().Empty(suite.app.IncentivesKeeper.GetAllFunds(suite.ctx))
+	balance = suite.app.BankKeeper.GetBalance(suite.ctx, funder, denomCoin)
+	suite.Require().True(balance.IsZero())
+}
+
+func (suite *KeeperTestSuite) TestCancelIncentiveRefundsFunds() {
+	suite.SetupTest()
+
+	funder := sdk.AccAddress(participant2.Bytes())
+	fundCoins := sdk.NewCoins(sdk.NewInt64Coin(denomCoin, 1000))
+
+	_, err := suite.app.IncentivesKeeper.RegisterIncentive(
+		suite.ctx,
+		contract,
+		sdk.DecCoins{},
+		epochs,
+		types.DefaultWeighting(),
+		types.DefaultEligibility(),
+	)
+	suite.Require().NoError(err)
+
+	err = testutil.FundAccount(suite.ctx, suite.app.BankKeeper, funder, fundCoins)
+	suite.Require().NoError(err)
+
+	_, err = suite.app.IncentivesKeeper.FundIncentive(
+		sdk.WrapSDKContext(suite.ctx),
+		types.NewMsgFundIncentive(contract, fundCoins, funder),
+	)
+	suite.Require().NoError(err)
+	suite.Require().True(suite.app.BankKeeper.GetBalance(suite.ctx, funder, denomCoin).IsZero())
+
+	err = suite.app.IncentivesKeeper.CancelIncentive(suite.ctx, contract)
+	suite.Require().NoError(err)
+
+	suite.Require().Empty(suite.app.IncentivesKeeper.GetAllFunds(suite.ctx))
+	suite.Require().Equal(fundCoins[0], suite.app.BankKeeper.GetBalance(suite.ctx, funder, denomCoin))
+}
+
+func (suite *KeeperTestSuite) TestCancelIncentiveSkipsFailingRefunds() {
+	suite.SetupTest()
+
+	funder := sdk.AccAddress(participant2.Bytes())
+	blocked := authtypes.NewModuleAddress(authtypes.FeeCollectorName)
+	fundCoins := sdk.NewCoins(sdk.NewInt64Coin(denomCoin, 1000))
+
+	_, err := suite.app.IncentivesKeeper.RegisterIncentive(
+		suite.ctx,
+		contract,
+		sdk.DecCoins{},
+		epochs,
+		types.DefaultWeighting(),
+		types.DefaultEligibility(),
+	)
+	suite.Require().NoError(err)
+
+	// escrow the funds of a blocked address, which cannot receive the refund
+	err = testutil.FundModuleAccount(suite.ctx, suite.app.BankKeeper, types.ModuleName, fundCoins.Add(fundCoins...))
+	suite.Require().NoError(err)
+	suite.app.IncentivesKeeper.SetFund(suite.ctx, types.NewFund(contract, blocked, fundCoins))
+	suite.app.IncentivesKeeper.SetFund(suite.ctx, types.NewFund(contract, funder, fundCoins))
+	blockedBalance := suite.app.BankKeeper.GetBalance(suite.ctx, blocked, denomCoin)
+
+	err = suite.app.IncentivesKeeper.CancelIncentive(suite.ctx, contract)
+	suite.Require().NoError(err)
+
+	suite.Require().Empty(suite.app.IncentivesKeeper.GetAllFunds(suite.ctx))
+	suite.Require().Equal(fundCoins[0], suite.app.BankKeeper.GetBalance(suite.ctx, funder, denomCoin))
+	suite.Require().Equal(blockedBalance, suite.app.BankKeeper.GetBalance(suite.ctx, blocked, denomCoin))
+}
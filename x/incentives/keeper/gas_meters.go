@@ -19,14 +19,7 @@ func (k Keeper) GetIncentivesGasMeters(ctx sdk.Context) []types.GasMeter {
 
 	for ; iterator.Valid(); iterator.Next() {
 		contract, userAddress := types.SplitGasMeterKey(iterator.Key())
-		gas := sdk.BigEndianToUint64(iterator.Value())
-
-		gm := types.GasMeter{
-			Contract:      contract.String(),
-			Participant:   userAddress.String(),
-			CumulativeGas: gas,
-		}
-
+		gm := unmarshalGasMeter(contract, userAddress, iterator.Value())
 		gms = append(gms, gm)
 	}
 
@@ -65,12 +58,7 @@ func (k Keeper) IterateIncentiveGasMeters(
 
 	for ; iterator.Valid(); iterator.Next() {
 		contract, userAddress := types.SplitGasMeterKey(iterator.Key())
-		gas := sdk.BigEndianToUint64(iterator.Value())
-		gm := types.GasMeter{
-			Contract:      contract.String(),
-			Participant:   userAddress.String(),
-			CumulativeGas: gas,
-		}
+		gm := unmarshalGasMeter(contract, userAddress, iterator.Value())
 
 		if handlerFn(gm) {
 			break
@@ -83,31 +71,89 @@ func (k Keeper) GetGasMeter(
 	ctx sdk.Context,
 	contract, participant common.Address,
 ) (uint64, bool) {
+	gm, found := k.getGasMeter(ctx, contract, participant)
+	return gm.CumulativeGas, found
+}
+
+// getGasMeter returns the gas meter of a participant, including the reward
+// period during which the gas was spent
+func (k Keeper) getGasMeter(
+	ctx sdk.Context,
+	contract, participant common.Address,
+) (types.GasMeter, bool) {
 	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefixGasMeter)
 	key := append(contract.Bytes(), participant.Bytes()...)
 
 	bz := store.Get(key)
 	if len(bz) == 0 {
-		return 0, false
+		return types.GasMeter{}, false
 	}
 
-	return sdk.BigEndianToUint64(bz), true
+	return unmarshalGasMeter(contract, participant, bz), true
 }
 
-// SetGasMeter stores a gasMeter
+// IterateParticipantGasMeters iterates over all the gas meters of a
+// participant and performs a callback.
+func (k Keeper) IterateParticipantGasMeters(
+	ctx sdk.Context,
+	participant common.Address,
+	handlerFn func(gm types.GasMeter) (stop bool),
+) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefixParticipantGasMeter)
+
+	iterator := sdk.KVStorePrefixIterator(store, participant.Bytes())
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		contract := common.BytesToAddress(iterator.Key()[common.AddressLength:])
+		gm, found := k.getGasMeter(ctx, contract, participant)
+		if !found {
+			continue
+		}
+
+		if handlerFn(gm) {
+			break
+		}
+	}
+}
+
+// SetGasMeter stores a gasMeter and indexes it by participant
 func (k Keeper) SetGasMeter(ctx sdk.Context, gm types.GasMeter) {
 	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefixGasMeter)
 	contract := common.HexToAddress(gm.Contract)
 	participant := common.HexToAddress(gm.Participant)
 	key := append(contract.Bytes(), participant.Bytes()...)
-	store.Set(key, sdk.Uint64ToBigEndian(gm.CumulativeGas))
+	store.Set(key, marshalGasMeter(gm))
+
+	indexStore := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefixParticipantGasMeter)
+	indexStore.Set(append(participant.Bytes(), contract.Bytes()...), []byte{1})
 }
 
-// DeleteGasMeter removes a gasMeter.
+// DeleteGasMeter removes a gasMeter and its participant index.
 func (k Keeper) DeleteGasMeter(ctx sdk.Context, gm types.GasMeter) {
 	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefixGasMeter)
 	contract := common.HexToAddress(gm.Contract)
 	participant := common.HexToAddress(gm.Participant)
 	key := append(contract.Bytes(), participant.Bytes()...)
 	store.Delete(key)
+
+	indexStore := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefixParticipantGasMeter)
+	indexStore.Delete(append(participant.Bytes(), contract.Bytes()...))
+}
+
+// marshalGasMeter encodes the cumulative gas and the reward period of a gas
+// meter as `<cumulative_gas>|<period>`
+func marshalGasMeter(gm types.GasMeter) []byte {
+	return append(sdk.Uint64ToBigEndian(gm.CumulativeGas), sdk.Uint64ToBigEndian(gm.Period)...)
+}
+
+// unmarshalGasMeter decodes a gas meter stored as `<cumulative_gas>|<period>`.
+// Values that only contain the cumulative gas belong to the first period.
+func unmarshalGasMeter(contract, participant common.Address, bz []byte) types.GasMeter {
+	gm := types.NewGasMeter(contract, participant, sdk.BigEndianToUint64(bz[:8]))
+	if len(bz) > 8 {
+		gm.Period = sdk.BigEndianToUint64(bz[8:])
+	}
+
+	return gm
 }
@@ -128,13 +128,8 @@ func (k Keeper) GasMeters(
 		req.Pagination,
 		func(key, value []byte) error {
 			participant := common.BytesToAddress(key)
-			cumulativeGas := sdk.BigEndianToUint64(value)
-
-			gm := types.GasMeter{
-				Contract:      req.Contract,
-				Participant:   participant.Hex(),
-				CumulativeGas: cumulativeGas,
-			}
+			gm := unmarshalGasMeter(contract, participant, value)
+			gm.Contract = req.Contract
 
 			gms = append(gms, gm)
 			return nil
@@ -270,6 +265,158 @@ func (k Keeper) AllocationMeter(
 	return &types.QueryAllocationMeterResponse{AllocationMeter: allocationMeter}, nil
 }
 
+// PendingRewards returns the incentive rewards a participant can claim
+func (k Keeper) PendingRewards(
+	c context.Context,
+	req *types.QueryPendingRewardsRequest,
+) (*types.QueryPendingRewardsResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	if strings.TrimSpace(req.Participant) == "" {
+		return nil, status.Error(
+			codes.InvalidArgument,
+			"participant address is empty",
+		)
+	}
+
+	// check if the participant is a hex address
+	if err := ethermint.ValidateAddress(req.Participant); err != nil {
+		return nil, status.Errorf(
+			codes.InvalidArgument,
+			errorsmod.Wrapf(errortypes.ErrInvalidAddress, "invalid participant address %s", req.Participant).Error(),
+		)
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+	rewards := k.pendingRewards(ctx, common.HexToAddress(req.Participant))
+
+	return &types.QueryPendingRewardsResponse{Rewards: rewards}, nil
+}
+
+// IncentivePayouts returns the retained payout summaries of an incentive
+func (k Keeper) IncentivePayouts(
+	c context.Context,
+	req *types.QueryIncentivePayoutsRequest,
+) (*types.QueryIncentivePayoutsResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	if strings.TrimSpace(req.Contract) == "" {
+		return nil, status.Error(
+			codes.InvalidArgument,
+			"contract address is empty",
+		)
+	}
+
+	// check if the contract is a hex address
+	if err := ethermint.ValidateAddress(req.Contract); err != nil {
+		return nil, status.Errorf(
+			codes.InvalidArgument,
+			errorsmod.Wrapf(errortypes.ErrInvalidAddress, "invalid contract address %s", req.Contract).Error(),
+		)
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+	contract := common.HexToAddress(req.Contract)
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), append(types.KeyPrefixIncentivePayout, contract.Bytes()...))
+
+	ips := []types.IncentivePayout{}
+
+	pageRes, err := query.Paginate(
+		store,
+		req.Pagination,
+		func(_, value []byte) error {
+			var ip types.IncentivePayout
+			if err := k.cdc.Unmarshal(value, &ip); err != nil {
+				return err
+			}
+			ips = append(ips, ip)
+			return nil
+		},
+	)
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &types.QueryIncentivePayoutsResponse{
+		Payouts:    ips,
+		Pagination: pageRes,
+	}, nil
+}
+
+// ParticipantPayouts returns the retained payouts of a participant, optionally
+// for a single incentive, and its payouts that are pending settlement
+func (k Keeper) ParticipantPayouts(
+	c context.Context,
+	req *types.QueryParticipantPayoutsRequest,
+) (*types.QueryParticipantPayoutsResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	if strings.TrimSpace(req.Participant) == "" {
+		return nil, status.Error(
+			codes.InvalidArgument,
+			"participant address is empty",
+		)
+	}
+
+	// check if the participant is a hex address
+	if err := ethermint.ValidateAddress(req.Participant); err != nil {
+		return nil, status.Errorf(
+			codes.InvalidArgument,
+			errorsmod.Wrapf(errortypes.ErrInvalidAddress, "invalid participant address %s", req.Participant).Error(),
+		)
+	}
+
+	participant := common.HexToAddress(req.Participant)
+	keyPrefix := append(types.KeyPrefixParticipantPayout, participant.Bytes()...)
+
+	// filter by contract if provided
+	var contract *common.Address
+	if strings.TrimSpace(req.Contract) != "" {
+		if err := ethermint.ValidateAddress(req.Contract); err != nil {
+			return nil, status.Errorf(
+				codes.InvalidArgument,
+				errorsmod.Wrapf(errortypes.ErrInvalidAddress, "invalid contract address %s", req.Contract).Error(),
+			)
+		}
+		addr := common.HexToAddress(req.Contract)
+		contract = &addr
+		keyPrefix = append(keyPrefix, contract.Bytes()...)
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), keyPrefix)
+
+	pps := []types.ParticipantPayout{}
+
+	pageRes, err := query.Paginate(
+		store,
+		req.Pagination,
+		func(_, value []byte) error {
+			var pp types.ParticipantPayout
+			if err := k.cdc.Unmarshal(value, &pp); err != nil {
+				return err
+			}
+			pps = append(pps, pp)
+			return nil
+		},
+	)
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &types.QueryParticipantPayoutsResponse{
+		Payouts:    pps,
+		Pagination: pageRes,
+		Pending:    k.pendingPayouts(ctx, participant, contract),
+	}, nil
+}
+
 // Params return hub contract param
 func (k Keeper) Params(
 	c context.Context,
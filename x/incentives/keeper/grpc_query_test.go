@@ -1,7 +1,9 @@
 package keeper_test
 
 import (
+	"bytes"
 	"fmt"
+	"time"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/cosmos/cosmos-sdk/types/query"
@@ -34,7 +36,7 @@ func (suite *KeeperTestSuite) TestIncentives() {
 				req = &types.QueryIncentivesRequest{
 					Pagination: &query.PageRequest{Limit: 10, CountTotal: true},
 				}
-				in := types.NewIncentive(contract, allocations, epochs)
+				in := types.NewIncentive(contract, allocations, epochs, types.DefaultWeighting(), types.DefaultEligibility())
 				suite.app.IncentivesKeeper.SetIncentive(suite.ctx, in)
 				suite.Commit()
 
@@ -49,8 +51,8 @@ func (suite *KeeperTestSuite) TestIncentives() {
 			"2 incentives registered wo/pagination",
 			func() {
 				req = &types.QueryIncentivesRequest{}
-				in := types.NewIncentive(contract, allocations, epochs)
-				in2 := types.NewIncentive(contract2, allocations, epochs)
+				in := types.NewIncentive(contract, allocations, epochs, types.DefaultWeighting(), types.DefaultEligibility())
+				in2 := types.NewIncentive(contract2, allocations, epochs, types.DefaultWeighting(), types.DefaultEligibility())
 				suite.app.IncentivesKeeper.SetIncentive(suite.ctx, in)
 				suite.app.IncentivesKeeper.SetIncentive(suite.ctx, in2)
 				suite.Commit()
@@ -124,7 +126,7 @@ func (suite *KeeperTestSuite) TestIncentive() {
 		{
 			"incentive found",
 			func() {
-				in := types.NewIncentive(contract, allocations, epochs)
+				in := types.NewIncentive(contract, allocations, epochs, types.DefaultWeighting(), types.DefaultEligibility())
 				suite.app.IncentivesKeeper.SetIncentive(suite.ctx, in)
 				suite.Commit()
 
@@ -462,6 +464,85 @@ func (suite *KeeperTestSuite) TestAllocationMeter() {
 	}
 }
 
+func (suite *KeeperTestSuite) TestPendingRewards() {
+	var (
+		req    *types.QueryPendingRewardsRequest
+		expRes *types.QueryPendingRewardsResponse
+	)
+
+	testCases := []struct {
+		name     string
+		malleate func()
+		expPass  bool
+	}{
+		{
+			"blank participant address",
+			func() {
+				req = &types.QueryPendingRewardsRequest{Participant: "  "}
+				expRes = &types.QueryPendingRewardsResponse{}
+			},
+			false,
+		},
+		{
+			"invalid participant hex address",
+			func() {
+				req = &types.QueryPendingRewardsRequest{Participant: "1234"}
+				expRes = &types.QueryPendingRewardsResponse{}
+			},
+			false,
+		},
+		{
+			"no pending rewards",
+			func() {
+				req = &types.QueryPendingRewardsRequest{Participant: participant.String()}
+				expRes = &types.QueryPendingRewardsResponse{}
+			},
+			true,
+		},
+		{
+			"accrued and unsettled rewards",
+			func() {
+				rewards := sdk.NewCoins(sdk.NewInt64Coin(denomCoin, 10))
+				suite.app.IncentivesKeeper.SetAccruedRewards(
+					suite.ctx, types.NewAccruedRewards(participant, rewards),
+				)
+
+				// finalized reward period of a removed incentive
+				rp := types.NewRewardPeriod(contract, 0, types.DefaultWeighting())
+				rp.TotalWeight = sdk.NewDec(100)
+				rp.GasMeters = 1
+				rp.RewardPerWeight = sdk.NewDecCoins(sdk.NewInt64DecCoin(denomCoin, 1))
+				rp.Remaining = sdk.NewCoins(sdk.NewInt64Coin(denomCoin, 100))
+				suite.app.IncentivesKeeper.SetRewardPeriod(suite.ctx, rp)
+				suite.app.IncentivesKeeper.SetGasMeter(suite.ctx, types.NewGasMeter(contract, participant, 100))
+				suite.Commit()
+
+				req = &types.QueryPendingRewardsRequest{Participant: participant.String()}
+				expRes = &types.QueryPendingRewardsResponse{
+					Rewards: sdk.NewCoins(sdk.NewInt64Coin(denomCoin, 110)),
+				}
+			},
+			true,
+		},
+	}
+	for _, tc := range testCases {
+		suite.Run(fmt.Sprintf("Case %s", tc.name), func() {
+			suite.SetupTest() // reset
+
+			ctx := sdk.WrapSDKContext(suite.ctx)
+			tc.malleate()
+
+			res, err := suite.queryClient.PendingRewards(ctx, req)
+			if tc.expPass {
+				suite.Require().NoError(err)
+				suite.Require().Equal(expRes, res)
+			} else {
+				suite.Require().Error(err)
+			}
+		})
+	}
+}
+
 func (suite *KeeperTestSuite) TestQueryParams() {
 	ctx := sdk.WrapSDKContext(suite.ctx)
 	expParams := types.DefaultParams()
@@ -470,3 +551,177 @@ func (suite *KeeperTestSuite) TestQueryParams() {
 	suite.Require().NoError(err)
 	suite.Require().Equal(expParams, res.Params)
 }
+
+func (suite *KeeperTestSuite) TestIncentivePayouts() {
+	var (
+		req    *types.QueryIncentivePayoutsRequest
+		expRes *types.QueryIncentivePayoutsResponse
+	)
+
+	testCases := []struct {
+		name     string
+		malleate func()
+		expPass  bool
+	}{
+		{
+			"blank contract address",
+			func() {
+				req = &types.QueryIncentivePayoutsRequest{}
+				expRes = &types.QueryIncentivePayoutsResponse{Pagination: &query.PageResponse{}}
+			},
+			false,
+		},
+		{
+			"invalid contract address",
+			func() {
+				req = &types.QueryIncentivePayoutsRequest{Contract: "123"}
+				expRes = &types.QueryIncentivePayoutsResponse{Pagination: &query.PageResponse{}}
+			},
+			false,
+		},
+		{
+			"2 payouts of one contract w/pagination",
+			func() {
+				req = &types.QueryIncentivePayoutsRequest{
+					Pagination: &query.PageRequest{Limit: 10, CountTotal: true},
+					Contract:   contract.Hex(),
+				}
+				endTime := time.Unix(1000, 0).UTC()
+				ip := types.IncentivePayout{Contract: contract.Hex(), Period: 0, EndTime: endTime}
+				ip2 := types.IncentivePayout{Contract: contract.Hex(), Period: 1, EndTime: endTime}
+				ip3 := types.IncentivePayout{Contract: contract2.Hex(), Period: 0, EndTime: endTime}
+				suite.app.IncentivesKeeper.SetIncentivePayout(suite.ctx, ip2)
+				suite.app.IncentivesKeeper.SetIncentivePayout(suite.ctx, ip)
+				suite.app.IncentivesKeeper.SetIncentivePayout(suite.ctx, ip3)
+				suite.Commit()
+
+				expRes = &types.QueryIncentivePayoutsResponse{
+					Pagination: &query.PageResponse{Total: 2},
+					Payouts:    []types.IncentivePayout{ip, ip2},
+				}
+			},
+			true,
+		},
+	}
+	for _, tc := range testCases {
+		suite.Run(fmt.Sprintf("Case %s", tc.name), func() {
+			suite.SetupTest() // reset
+
+			ctx := sdk.WrapSDKContext(suite.ctx)
+			tc.malleate()
+
+			res, err := suite.queryClient.IncentivePayouts(ctx, req)
+			if tc.expPass {
+				suite.Require().NoError(err)
+				suite.Require().Equal(expRes.Pagination, res.Pagination)
+				suite.Require().Equal(expRes.Payouts, res.Payouts)
+			} else {
+				suite.Require().Error(err)
+			}
+		})
+	}
+}
+
+func (suite *KeeperTestSuite) TestParticipantPayouts() {
+	var (
+		req    *types.QueryParticipantPayoutsRequest
+		expRes *types.QueryParticipantPayoutsResponse
+	)
+
+	rewards := sdk.NewCoins(sdk.NewInt64Coin(denomCoin, 10))
+
+	testCases := []struct {
+		name     string
+		malleate func()
+		expPass  bool
+	}{
+		{
+			"blank participant address",
+			func() {
+				req = &types.QueryParticipantPayoutsRequest{Participant: "  "}
+				expRes = &types.QueryParticipantPayoutsResponse{Pagination: &query.PageResponse{}}
+			},
+			false,
+		},
+		{
+			"invalid participant address",
+			func() {
+				req = &types.QueryParticipantPayoutsRequest{Participant: "1234"}
+				expRes = &types.QueryParticipantPayoutsResponse{Pagination: &query.PageResponse{}}
+			},
+			false,
+		},
+		{
+			"invalid contract address",
+			func() {
+				req = &types.QueryParticipantPayoutsRequest{Participant: participant.Hex(), Contract: "1234"}
+				expRes = &types.QueryParticipantPayoutsResponse{Pagination: &query.PageResponse{}}
+			},
+			false,
+		},
+		{
+			"payouts of all contracts w/pagination",
+			func() {
+				req = &types.QueryParticipantPayoutsRequest{
+					Pagination:  &query.PageRequest{Limit: 10, CountTotal: true},
+					Participant: participant.Hex(),
+				}
+				pp := types.NewParticipantPayout(types.NewGasMeter(contract, participant, 100), rewards)
+				pp2 := types.NewParticipantPayout(types.NewGasMeter(contract2, participant, 200), rewards)
+				pp3 := types.NewParticipantPayout(types.NewGasMeter(contract, participant2, 300), rewards)
+				suite.app.IncentivesKeeper.SetParticipantPayout(suite.ctx, pp)
+				suite.app.IncentivesKeeper.SetParticipantPayout(suite.ctx, pp2)
+				suite.app.IncentivesKeeper.SetParticipantPayout(suite.ctx, pp3)
+				suite.Commit()
+
+				expPayouts := []types.ParticipantPayout{pp, pp2}
+				if bytes.Compare(contract2.Bytes(), contract.Bytes()) < 0 {
+					expPayouts = []types.ParticipantPayout{pp2, pp}
+				}
+				expRes = &types.QueryParticipantPayoutsResponse{
+					Pagination: &query.PageResponse{Total: 2},
+					Payouts:    expPayouts,
+				}
+			},
+			true,
+		},
+		{
+			"payouts of one contract",
+			func() {
+				req = &types.QueryParticipantPayoutsRequest{
+					Pagination:  &query.PageRequest{Limit: 10, CountTotal: true},
+					Participant: participant.Hex(),
+					Contract:    contract.Hex(),
+				}
+				pp := types.NewParticipantPayout(types.NewGasMeter(contract, participant, 100), rewards)
+				pp2 := types.NewParticipantPayout(types.NewGasMeter(contract2, participant, 200), rewards)
+				suite.app.IncentivesKeeper.SetParticipantPayout(suite.ctx, pp)
+				suite.app.IncentivesKeeper.SetParticipantPayout(suite.ctx, pp2)
+				suite.Commit()
+
+				expRes = &types.QueryParticipantPayoutsResponse{
+					Pagination: &query.PageResponse{Total: 1},
+					Payouts:    []types.ParticipantPayout{pp},
+				}
+			},
+			true,
+		},
+	}
+	for _, tc := range testCases {
+		suite.Run(fmt.Sprintf("Case %s", tc.name), func() {
+			suite.SetupTest() // reset
+
+			ctx := sdk.WrapSDKContext(suite.ctx)
+			tc.malleate()
+
+			res, err := suite.queryClient.ParticipantPayouts(ctx, req)
+			if tc.expPass {
+				suite.Require().NoError(err)
+				suite.Require().Equal(expRes.Pagination, res.Pagination)
+				suite.Require().Equal(expRes.Payouts, res.Payouts)
+			} else {
+				suite.Require().Error(err)
+			}
+		})
+	}
+}
@@ -68,6 +68,13 @@ func (k Keeper) SetIncentive(ctx sdk.Context, incentive types.Incentive) {
 	key := common.HexToAddress(incentive.Contract)
 	bz := k.cdc.MustMarshal(&incentive)
 	store.Set(key.Bytes(), bz)
+
+	ageStore := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefixParticipationAgeIncentive)
+	if age := incentive.Eligibility.MinParticipationAge; age > 0 {
+		ageStore.Set(key.Bytes(), sdk.Uint64ToBigEndian(age))
+	} else {
+		ageStore.Delete(key.Bytes())
+	}
 }
 
 // DeleteIncentiveAndUpdateAllocationMeters removes an incentive and updates the
@@ -77,6 +84,9 @@ func (k Keeper) DeleteIncentiveAndUpdateAllocationMeters(ctx sdk.Context, incent
 	key := common.HexToAddress(incentive.Contract)
 	store.Delete(key.Bytes())
 
+	ageStore := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefixParticipationAgeIncentive)
+	ageStore.Delete(key.Bytes())
+
 	// Subtract allocations from allocation meters
 	for _, al := range incentive.Allocations {
 		// NOTE: existence of incentive is already checked
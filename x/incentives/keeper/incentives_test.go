@@ -22,7 +22,7 @@ func (suite *KeeperTestSuite) TestGetAllIncentives() {
 		{
 			"1 pair registered",
 			func() {
-				in := types.NewIncentive(contract, allocations, epochs)
+				in := types.NewIncentive(contract, allocations, epochs, types.DefaultWeighting(), types.DefaultEligibility())
 				suite.app.IncentivesKeeper.SetIncentive(suite.ctx, in)
 				suite.Commit()
 
@@ -32,8 +32,8 @@ func (suite *KeeperTestSuite) TestGetAllIncentives() {
 		{
 			"2 pairs registered",
 			func() {
-				in := types.NewIncentive(contract, allocations, epochs)
-				in2 := types.NewIncentive(contract2, allocations, epochs)
+				in := types.NewIncentive(contract, allocations, epochs, types.DefaultWeighting(), types.DefaultEligibility())
+				in2 := types.NewIncentive(contract2, allocations, epochs, types.DefaultWeighting(), types.DefaultEligibility())
 				suite.app.IncentivesKeeper.SetIncentive(suite.ctx, in)
 				suite.app.IncentivesKeeper.SetIncentive(suite.ctx, in2)
 				suite.Commit()
@@ -55,7 +55,7 @@ func (suite *KeeperTestSuite) TestGetAllIncentives() {
 }
 
 func (suite *KeeperTestSuite) TestGetIncetive() {
-	expIn := types.NewIncentive(contract, allocations, epochs)
+	expIn := types.NewIncentive(contract, allocations, epochs, types.DefaultWeighting(), types.DefaultEligibility())
 	suite.app.IncentivesKeeper.SetIncentive(suite.ctx, expIn)
 	suite.Commit()
 
@@ -85,6 +85,8 @@ func (suite *KeeperTestSuite) TestDeleteIncentiveAndUpdateAllocationMeters() {
 		contract,
 		mintAllocations,
 		epochs,
+		types.DefaultWeighting(),
+		types.DefaultEligibility(),
 	)
 	suite.Require().NoError(err)
 
@@ -121,7 +123,7 @@ func (suite *KeeperTestSuite) TestDeleteIncentiveAndUpdateAllocationMeters() {
 }
 
 func (suite *KeeperTestSuite) TestIsIncentiveRegistered() {
-	regIn := types.NewIncentive(contract, allocations, epochs)
+	regIn := types.NewIncentive(contract, allocations, epochs, types.DefaultWeighting(), types.DefaultEligibility())
 	suite.app.IncentivesKeeper.SetIncentive(suite.ctx, regIn)
 	suite.Commit()
 
@@ -88,6 +88,8 @@ var _ = Describe("Distribution", Ordered, func() {
 			contractAddr,
 			mintAllocations,
 			epochs,
+			types.DefaultWeighting(),
+			types.DefaultEligibility(),
 		)
 		s.Require().NoError(err)
 
@@ -134,13 +136,30 @@ var _ = Describe("Distribution", Ordered, func() {
 				balance := s.app.BankKeeper.GetBalance(s.ctx, moduleAcc, denomMint)
 				Expect(balance.IsZero()).ToNot(BeTrue())
 			})
-			It("should reset the participant gas meter", func() {
+			It("should keep the participant gas meter until the rewards are claimed", func() {
 				gm, _ := s.app.IncentivesKeeper.GetGasMeter(s.ctx, contractAddr, s.address)
-				Expect(gm).To(BeZero())
+				Expect(gm).ToNot(BeZero())
 			})
-			It("should distribute usage incentives to the participant", func() {
+			It("should make the usage incentives claimable by the participant", func() {
+				res, err := s.app.IncentivesKeeper.PendingRewards(
+					sdk.WrapSDKContext(s.ctx),
+					&types.QueryPendingRewardsRequest{Participant: s.address.Hex()},
+				)
+				Expect(err).To(BeNil())
+				Expect(res.Rewards.AmountOf(denomMint).IsPositive()).To(BeTrue())
+			})
+			It("should distribute usage incentives to the participant once claimed", func() {
+				_, err := s.app.IncentivesKeeper.ClaimIncentiveRewards(
+					sdk.WrapSDKContext(s.ctx),
+					types.NewMsgClaimIncentiveRewards(participantAcc),
+				)
+				Expect(err).To(BeNil())
+
 				actual := s.app.BankKeeper.GetBalance(s.ctx, participantAcc, denomMint)
 				Expect(actual).ToNot(Equal(balanceBefore))
+
+				gm, _ := s.app.IncentivesKeeper.GetGasMeter(s.ctx, contractAddr, s.address)
+				Expect(gm).To(BeZero())
 			})
 		})
 	})
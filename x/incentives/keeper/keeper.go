@@ -21,12 +21,8 @@ type Keeper struct {
 	accountKeeper   types.AccountKeeper
 	bankKeeper      types.BankKeeper
 	inflationKeeper types.InflationKeeper
-
-	// Currently not used, but added to prevent breaking change s in case we want
-	// to allocate incentives to staking instead of transferring the deferred
-	// rewards to the user's wallet
-	stakeKeeper types.StakeKeeper
-	evmKeeper   types.EVMKeeper
+	stakeKeeper     types.StakeKeeper
+	evmKeeper       types.EVMKeeper
 }
 
 // NewKeeper creates new instances of the incentives Keeper
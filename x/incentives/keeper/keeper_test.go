@@ -209,6 +209,13 @@ func (suite *KeeperTestSuite) CommitAfter(t time.Duration) {
 	suite.queryClientEvm = evm.NewQueryClient(queryHelper)
 }
 
+// addGasUsed meters the gas spent by a participant on a registered incentive
+func (suite *KeeperTestSuite) addGasUsed(contract, participant common.Address, gasUsed uint64) {
+	incentive, found := suite.app.IncentivesKeeper.GetIncentive(suite.ctx, contract)
+	suite.Require().True(found)
+	suite.app.IncentivesKeeper.AddGasUsed(suite.ctx, incentive, participant, gasUsed)
+}
+
 // MintFeeCollector mints coins with the bank modules and sends them to the fee
 // collector.
 func (suite *KeeperTestSuite) MintFeeCollector(coins sdk.Coins) {
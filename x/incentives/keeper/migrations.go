@@ -1,5 +1,14 @@
 package keeper
 
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/module"
+
+	v2 "github.com/evmos/evmos/v10/x/incentives/migrations/v2"
+)
+
+var _ module.MigrationHandler = Migrator{}.Migrate1to2
+
 // Migrator is a struct for handling in-place store migrations.
 type Migrator struct {
 	keeper Keeper
@@ -11,3 +20,12 @@ func NewMigrator(keeper Keeper) Migrator {
 		keeper: keeper,
 	}
 }
+
+// Migrate1to2 migrates from consensus version 1 to 2.
+func (m Migrator) Migrate1to2(ctx sdk.Context) error {
+	if err := v2.UpdateParams(ctx, &m.keeper.paramstore); err != nil {
+		return err
+	}
+
+	return v2.MigrateRewardPeriods(ctx, m.keeper.storeKey, m.keeper.cdc)
+}
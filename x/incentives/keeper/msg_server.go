@@ -0,0 +1,74 @@
+package keeper
+
+import (
+	"context"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/ethereum/go-ethereum/common"
+
+	"github.com/evmos/evmos/v10/x/incentives/types"
+)
+
+var _ types.MsgServer = &Keeper{}
+
+// FundIncentive escrows the coins of the funder for a registered incentive.
+// The coins are distributed to the participants during the remaining epochs of
+// the incentive and any unspent amount is refunded once the incentive ends or
+// is cancelled.
+func (k Keeper) FundIncentive(
+	goCtx context.Context,
+	msg *types.MsgFundIncentive,
+) (*types.MsgFundIncentiveResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	// Error checked during msg validation
+	funder := sdk.MustAccAddressFromBech32(msg.Funder)
+	contract := common.HexToAddress(msg.Contract)
+
+	if err := k.fundIncentive(ctx, contract, funder, msg.Amount); err != nil {
+		return nil, err
+	}
+
+	ctx.EventManager().EmitEvents(
+		sdk.Events{
+			sdk.NewEvent(
+				types.EventTypeFundIncentive,
+				sdk.NewAttribute(types.AttributeKeyContract, msg.Contract),
+				sdk.NewAttribute(types.AttributeKeyFunder, msg.Funder),
+				sdk.NewAttribute(types.AttributeKeyAmount, msg.Amount.String()),
+			),
+		},
+	)
+
+	return &types.MsgFundIncentiveResponse{}, nil
+}
+
+// ClaimIncentiveRewards transfers the incentive rewards of the sender from all
+// finalized reward periods to its account
+func (k Keeper) ClaimIncentiveRewards(
+	goCtx context.Context,
+	msg *types.MsgClaimIncentiveRewards,
+) (*types.MsgClaimIncentiveRewardsResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	// Error checked during msg validation
+	sender := sdk.MustAccAddressFromBech32(msg.Sender)
+	participant := common.BytesToAddress(sender.Bytes())
+
+	rewards, err := k.ClaimRewards(ctx, participant)
+	if err != nil {
+		return nil, err
+	}
+
+	ctx.EventManager().EmitEvents(
+		sdk.Events{
+			sdk.NewEvent(
+				types.EventTypeClaimIncentiveRewards,
+				sdk.NewAttribute(types.AttributeKeyParticipant, participant.Hex()),
+				sdk.NewAttribute(types.AttributeKeyAmount, rewards.String()),
+			),
+		},
+	)
+
+	return &types.MsgClaimIncentiveRewardsResponse{Rewards: rewards}, nil
+}
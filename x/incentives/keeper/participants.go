@@ -0,0 +1,209 @@
+package keeper
+
+import (
+	"github.com/cosmos/cosmos-sdk/store/prefix"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/ethereum/go-ethereum/common"
+
+	"github.com/evmos/evmos/v10/x/incentives/types"
+)
+
+// maxPrunedParticipantsPerBlock bounds the number of inactive participants
+// removed at the end of each block, so that the cost of pruning doesn't grow
+// with the number of participants
+const maxPrunedParticipantsPerBlock = 100
+
+// GetAllParticipants returns the first interactions of all the participants
+// with incentivized contracts
+func (k Keeper) GetAllParticipants(ctx sdk.Context) []types.Participant {
+	participants := []types.Participant{}
+
+	k.IterateParticipants(ctx, func(participant types.Participant) (stop bool) {
+		participants = append(participants, participant)
+		return false
+	})
+
+	return participants
+}
+
+// IterateParticipants iterates over the interactions of all the participants
+// and performs a callback
+func (k Keeper) IterateParticipants(
+	ctx sdk.Context,
+	handlerFn func(participant types.Participant) (stop bool),
+) {
+	store := ctx.KVStore(k.storeKey)
+	iterator := sdk.KVStorePrefixIterator(store, types.KeyPrefixParticipant)
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		var participant types.Participant
+		k.cdc.MustUnmarshal(iterator.Value(), &participant)
+
+		if handlerFn(participant) {
+			break
+		}
+	}
+}
+
+// GetParticipant returns the first and the last interaction of the participant
+// with an incentivized contract
+func (k Keeper) GetParticipant(
+	ctx sdk.Context,
+	participant common.Address,
+) (types.Participant, bool) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefixParticipant)
+	bz := store.Get(participant.Bytes())
+	if len(bz) == 0 {
+		return types.Participant{}, false
+	}
+
+	var p types.Participant
+	k.cdc.MustUnmarshal(bz, &p)
+	return p, true
+}
+
+// GetParticipantFirstHeight returns the block height of the first interaction
+// of the participant with an incentivized contract
+func (k Keeper) GetParticipantFirstHeight(
+	ctx sdk.Context,
+	participant common.Address,
+) (int64, bool) {
+	p, found := k.GetParticipant(ctx, participant)
+	return p.FirstHeight, found
+}
+
+// SetParticipant stores the interactions of a participant and indexes it by
+// the height of its last interaction for pruning
+func (k Keeper) SetParticipant(ctx sdk.Context, participant types.Participant) {
+	address := common.HexToAddress(participant.Address)
+	indexStore := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefixParticipantActivity)
+
+	if p, found := k.GetParticipant(ctx, address); found {
+		indexStore.Delete(participantActivityKey(address, p.LastHeight))
+	}
+
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefixParticipant)
+	store.Set(address.Bytes(), k.cdc.MustMarshal(&participant))
+	indexStore.Set(participantActivityKey(address, participant.LastHeight), []byte{1})
+}
+
+// participantActivityKey returns the `<last_height>|<participant_address>` key
+// of a participant in the activity index
+func participantActivityKey(address common.Address, lastHeight int64) []byte {
+	return append(sdk.Uint64ToBigEndian(uint64(lastHeight)), address.Bytes()...)
+}
+
+// hasParticipationAgeRule returns true if at least one registered incentive
+// sets a minimum participation age
+func (k Keeper) hasParticipationAgeRule(ctx sdk.Context) bool {
+	iterator := sdk.KVStorePrefixIterator(ctx.KVStore(k.storeKey), types.KeyPrefixParticipationAgeIncentive)
+	defer iterator.Close()
+	return iterator.Valid()
+}
+
+// maxParticipationAge returns the largest minimum participation age of the
+// registered incentives, or zero if none of them sets one
+func (k Keeper) maxParticipationAge(ctx sdk.Context) uint64 {
+	iterator := sdk.KVStorePrefixIterator(ctx.KVStore(k.storeKey), types.KeyPrefixParticipationAgeIncentive)
+	defer iterator.Close()
+
+	maxAge := uint64(0)
+	for ; iterator.Valid(); iterator.Next() {
+		if age := sdk.BigEndianToUint64(iterator.Value()); age > maxAge {
+			maxAge = age
+		}
+	}
+
+	return maxAge
+}
+
+// recordParticipant stores the current block height as the last interaction
+// of the participant, and as its first interaction if it has not interacted
+// with an incentivized contract before. Participants are only recorded while
+// a registered incentive sets a minimum participation age.
+func (k Keeper) recordParticipant(ctx sdk.Context, participant common.Address) {
+	if !k.hasParticipationAgeRule(ctx) {
+		return
+	}
+
+	p, found := k.GetParticipant(ctx, participant)
+	switch {
+	case !found:
+		p = types.NewParticipant(participant, ctx.BlockHeight())
+	case p.LastHeight == ctx.BlockHeight():
+		return
+	default:
+		p.LastHeight = ctx.BlockHeight()
+	}
+
+	k.SetParticipant(ctx, p)
+}
+
+// pruneParticipants removes the participants that haven't interacted with an
+// incentivized contract for more blocks than the largest minimum participation
+// age of the registered incentives, so that the participants don't accumulate
+// in the store. At most maxPrunedParticipantsPerBlock participants are removed
+// per call, the least recently active first, and the rest are removed in the
+// next blocks. If a pruned participant interacts again, its participation age
+// starts over from that interaction. Nothing is pruned while no incentive sets
+// a minimum participation age, as the participants are not recorded either.
+func (k Keeper) pruneParticipants(ctx sdk.Context) {
+	maxAge := k.maxParticipationAge(ctx)
+	if maxAge == 0 || uint64(ctx.BlockHeight()) <= maxAge {
+		return
+	}
+
+	// participants whose last interaction is below the cutoff are pruned
+	cutoff := uint64(ctx.BlockHeight()) - maxAge
+
+	indexStore := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefixParticipantActivity)
+	iterator := indexStore.Iterator(nil, sdk.Uint64ToBigEndian(cutoff))
+	var keys [][]byte
+	for ; iterator.Valid() && len(keys) < maxPrunedParticipantsPerBlock; iterator.Next() {
+		keys = append(keys, iterator.Key())
+	}
+	iterator.Close()
+
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefixParticipant)
+	for _, key := range keys {
+		store.Delete(key[8:])
+		indexStore.Delete(key)
+	}
+}
+
+// IsEligible checks if the participant meets the eligibility rules of the
+// incentive:
+//   - the amount of the staking denomination bonded is at least the minimum stake
+//   - the number of blocks since its first interaction with an incentivized
+//     contract is at least the minimum participation age. The creation height
+//     of the account isn't recorded, so this is not the age of the account.
+//   - the balance of each denomination is at least the minimum balance
+func (k Keeper) IsEligible(
+	ctx sdk.Context,
+	incentive types.Incentive,
+	participant common.Address,
+) bool {
+	eligibility := incentive.Eligibility
+	address := sdk.AccAddress(participant.Bytes())
+
+	if eligibility.HasMinStake() &&
+		k.stakeKeeper.GetDelegatorBonded(ctx, address).LT(eligibility.MinStake) {
+		return false
+	}
+
+	if eligibility.MinParticipationAge > 0 {
+		firstHeight, found := k.GetParticipantFirstHeight(ctx, participant)
+		if !found || uint64(ctx.BlockHeight()-firstHeight) < eligibility.MinParticipationAge {
+			return false
+		}
+	}
+
+	for _, minBalance := range eligibility.MinBalance {
+		if k.bankKeeper.GetBalance(ctx, address, minBalance.Denom).IsLT(minBalance) {
+			return false
+		}
+	}
+
+	return true
+}
@@ -0,0 +1,250 @@
+package keeper_test
+
+import (
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/evmos/ethermint/tests"
+
+	"github.com/evmos/evmos/v10/testutil"
+	"github.com/evmos/evmos/v10/x/incentives/types"
+)
+
+func (suite *KeeperTestSuite) TestGetAllParticipants() {
+	var expRes []types.Participant
+
+	testCases := []struct {
+		name     string
+		malleate func()
+	}{
+		{
+			"no participant registered",
+			func() { expRes = []types.Participant{} },
+		},
+		{
+			"2 participants registered",
+			func() {
+				p := types.NewParticipant(participant, 1)
+				p2 := types.NewParticipant(participant2, 2)
+				suite.app.IncentivesKeeper.SetParticipant(suite.ctx, p)
+				suite.app.IncentivesKeeper.SetParticipant(suite.ctx, p2)
+				suite.Commit()
+
+				expRes = []types.Participant{p, p2}
+			},
+		},
+	}
+	for _, tc := range testCases {
+		suite.Run(fmt.Sprintf("Case %s", tc.name), func() {
+			suite.SetupTest() // reset
+
+			tc.malleate()
+			res := suite.app.IncentivesKeeper.GetAllParticipants(suite.ctx)
+			suite.Require().ElementsMatch(expRes, res, tc.name)
+		})
+	}
+}
+
+func (suite *KeeperTestSuite) TestGetParticipantFirstHeight() {
+	suite.SetupTest()
+
+	_, found := suite.app.IncentivesKeeper.GetParticipantFirstHeight(suite.ctx, participant)
+	suite.Require().False(found)
+
+	suite.app.IncentivesKeeper.SetParticipant(suite.ctx, types.NewParticipant(participant, 5))
+
+	height, found := suite.app.IncentivesKeeper.GetParticipantFirstHeight(suite.ctx, participant)
+	suite.Require().True(found)
+	suite.Require().Equal(int64(5), height)
+}
+
+func (suite *KeeperTestSuite) TestIsEligible() {
+	minBalance := sdk.NewCoins(sdk.NewInt64Coin(denomCoin, 100))
+
+	testCases := []struct {
+		name        string
+		eligibility types.Eligibility
+		malleate    func(common.Address)
+		expEligible bool
+	}{
+		{
+			"no rules",
+			types.DefaultEligibility(),
+			func(common.Address) {},
+			true,
+		},
+		{
+			"min stake - no delegation",
+			types.NewEligibility(sdk.NewInt(100), 0, nil),
+			func(common.Address) {},
+			false,
+		},
+		{
+			"min stake - enough bonded",
+			types.NewEligibility(sdk.NewInt(100), 0, nil),
+			func(addr common.Address) {
+				delAddr := sdk.AccAddress(addr.Bytes())
+				bondDenom := suite.app.StakingKeeper.BondDenom(suite.ctx)
+				err := testutil.FundAccount(
+					suite.ctx,
+					suite.app.BankKeeper,
+					delAddr,
+					sdk.NewCoins(sdk.NewInt64Coin(bondDenom, 100)),
+				)
+				suite.Require().NoError(err)
+
+				validator := suite.app.StakingKeeper.GetAllValidators(suite.ctx)[0]
+				_, err = suite.app.StakingKeeper.Delegate(
+					suite.ctx, delAddr, sdk.NewInt(100), stakingtypes.Unbonded, validator, true,
+				)
+				suite.Require().NoError(err)
+			},
+			true,
+		},
+		{
+			"min participation age - no interaction",
+			types.NewEligibility(sdk.ZeroInt(), 10, nil),
+			func(common.Address) {},
+			false,
+		},
+		{
+			"min participation age - interaction too recent",
+			types.NewEligibility(sdk.ZeroInt(), 10, nil),
+			func(addr common.Address) {
+				suite.app.IncentivesKeeper.SetParticipant(
+					suite.ctx,
+					types.NewParticipant(addr, suite.ctx.BlockHeight()-9),
+				)
+			},
+			false,
+		},
+		{
+			"min participation age - old enough",
+			types.NewEligibility(sdk.ZeroInt(), 10, nil),
+			func(addr common.Address) {
+				suite.app.IncentivesKeeper.SetParticipant(
+					suite.ctx,
+					types.NewParticipant(addr, suite.ctx.BlockHeight()-10),
+				)
+			},
+			true,
+		},
+		{
+			"min balance - insufficient balance",
+			types.NewEligibility(sdk.ZeroInt(), 0, minBalance),
+			func(addr common.Address) {
+				err := testutil.FundAccount(
+					suite.ctx,
+					suite.app.BankKeeper,
+					sdk.AccAddress(addr.Bytes()),
+					sdk.NewCoins(sdk.NewInt64Coin(denomCoin, 99)),
+				)
+				suite.Require().NoError(err)
+			},
+			false,
+		},
+		{
+			"min balance - enough balance",
+			types.NewEligibility(sdk.ZeroInt(), 0, minBalance),
+			func(addr common.Address) {
+				err := testutil.FundAccount(
+					suite.ctx,
+					suite.app.BankKeeper,
+					sdk.AccAddress(addr.Bytes()),
+					minBalance,
+				)
+				suite.Require().NoError(err)
+			},
+			true,
+		},
+		{
+			"min balance - insufficient balance of one denomination",
+			types.NewEligibility(sdk.ZeroInt(), 0, minBalance.Add(sdk.NewInt64Coin("aother", 1))),
+			func(addr common.Address) {
+				err := testutil.FundAccount(
+					suite.ctx,
+					suite.app.BankKeeper,
+					sdk.AccAddress(addr.Bytes()),
+					minBalance,
+				)
+				suite.Require().NoError(err)
+			},
+			false,
+		},
+	}
+	for _, tc := range testCases {
+		suite.Run(fmt.Sprintf("Case %s", tc.name), func() {
+			suite.SetupTest() // reset
+
+			// advance the chain so that the participation age can be checked
+			for i := 0; i < 10; i++ {
+				suite.Commit()
+			}
+
+			incentive := types.NewIncentive(contract, allocations, epochs, types.DefaultWeighting(), tc.eligibility)
+			tc.malleate(participant)
+
+			eligible := suite.app.IncentivesKeeper.IsEligible(suite.ctx, incentive, participant)
+			suite.Require().Equal(tc.expEligible, eligible)
+		})
+	}
+}
+
+func (suite *KeeperTestSuite) TestPruneParticipants() {
+	suite.SetupTest()
+
+	_, err := suite.app.IncentivesKeeper.RegisterIncentive(
+		suite.ctx,
+		contract,
+		sdk.DecCoins{},
+		epochs,
+		types.DefaultWeighting(),
+		types.NewEligibility(sdk.ZeroInt(), 10, nil),
+	)
+	suite.Require().NoError(err)
+
+	height := suite.ctx.BlockHeight()
+	suite.app.IncentivesKeeper.SetParticipant(suite.ctx, types.NewParticipant(participant, height))
+
+	// the last interaction of an active participant is updated
+	p2 := types.NewParticipant(participant2, height)
+	suite.app.IncentivesKeeper.SetParticipant(suite.ctx, p2)
+	p2.LastHeight = height + 5
+	suite.app.IncentivesKeeper.SetParticipant(suite.ctx, p2)
+
+	// participants are retained for the largest minimum participation age
+	suite.ctx = suite.ctx.WithBlockHeight(height + 10)
+	suite.app.IncentivesKeeper.EndBlocker(suite.ctx)
+	suite.Require().Len(suite.app.IncentivesKeeper.GetAllParticipants(suite.ctx), 2)
+
+	// inactive participants are pruned afterwards
+	suite.ctx = suite.ctx.WithBlockHeight(height + 11)
+	suite.app.IncentivesKeeper.EndBlocker(suite.ctx)
+	suite.Require().Equal([]types.Participant{p2}, suite.app.IncentivesKeeper.GetAllParticipants(suite.ctx))
+
+	suite.ctx = suite.ctx.WithBlockHeight(height + 16)
+	suite.app.IncentivesKeeper.EndBlocker(suite.ctx)
+	suite.Require().Empty(suite.app.IncentivesKeeper.GetAllParticipants(suite.ctx))
+
+	// at most 100 inactive participants are pruned per block
+	for i := 0; i < 150; i++ {
+		suite.app.IncentivesKeeper.SetParticipant(suite.ctx, types.NewParticipant(tests.GenerateAddress(), height))
+	}
+	suite.app.IncentivesKeeper.EndBlocker(suite.ctx)
+	suite.Require().Len(suite.app.IncentivesKeeper.GetAllParticipants(suite.ctx), 50)
+	suite.app.IncentivesKeeper.EndBlocker(suite.ctx)
+	suite.Require().Empty(suite.app.IncentivesKeeper.GetAllParticipants(suite.ctx))
+
+	// participants are neither recorded nor pruned once no incentive sets a
+	// minimum participation age
+	incentive, _ := suite.app.IncentivesKeeper.GetIncentive(suite.ctx, contract)
+	incentive.Eligibility = types.DefaultEligibility()
+	suite.app.IncentivesKeeper.SetIncentive(suite.ctx, incentive)
+
+	suite.app.IncentivesKeeper.SetParticipant(suite.ctx, p2)
+	suite.ctx = suite.ctx.WithBlockHeight(height + 100)
+	suite.app.IncentivesKeeper.EndBlocker(suite.ctx)
+	suite.Require().Equal([]types.Participant{p2}, suite.app.IncentivesKeeper.GetAllParticipants(suite.ctx))
+}
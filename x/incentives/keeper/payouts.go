@@ -0,0 +1,228 @@
+package keeper
+
+import (
+	"github.com/cosmos/cosmos-sdk/store/prefix"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/ethereum/go-ethereum/common"
+
+	"github.com/evmos/evmos/v10/x/incentives/types"
+)
+
+// maxPrunedPayoutsPerBlock bounds the number of payout records removed at the
+// end of each block
+const maxPrunedPayoutsPerBlock = 100
+
+// GetAllIncentivePayouts returns the retained payout summaries of all
+// incentives
+func (k Keeper) GetAllIncentivePayouts(ctx sdk.Context) []types.IncentivePayout {
+	ips := []types.IncentivePayout{}
+
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefixIncentivePayout)
+	iterator := store.Iterator(nil, nil)
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		var ip types.IncentivePayout
+		k.cdc.MustUnmarshal(iterator.Value(), &ip)
+		ips = append(ips, ip)
+	}
+
+	return ips
+}
+
+// GetIncentivePayout returns the payout summary of a reward period of an
+// incentive
+func (k Keeper) GetIncentivePayout(
+	ctx sdk.Context,
+	contract common.Address,
+	period uint64,
+) (types.IncentivePayout, bool) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefixIncentivePayout)
+	bz := store.Get(append(contract.Bytes(), sdk.Uint64ToBigEndian(period)...))
+	if len(bz) == 0 {
+		return types.IncentivePayout{}, false
+	}
+
+	var ip types.IncentivePayout
+	k.cdc.MustUnmarshal(bz, &ip)
+	return ip, true
+}
+
+// SetIncentivePayout stores the payout summary of a reward period of an
+// incentive
+func (k Keeper) SetIncentivePayout(ctx sdk.Context, ip types.IncentivePayout) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefixIncentivePayout)
+	contract := common.HexToAddress(ip.Contract)
+	store.Set(append(contract.Bytes(), sdk.Uint64ToBigEndian(ip.Period)...), k.cdc.MustMarshal(&ip))
+}
+
+// GetAllParticipantPayouts returns the retained payouts of all participants
+func (k Keeper) GetAllParticipantPayouts(ctx sdk.Context) []types.ParticipantPayout {
+	pps := []types.ParticipantPayout{}
+
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefixParticipantPayout)
+	iterator := store.Iterator(nil, nil)
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		var pp types.ParticipantPayout
+		k.cdc.MustUnmarshal(iterator.Value(), &pp)
+		pps = append(pps, pp)
+	}
+
+	return pps
+}
+
+// GetParticipantPayout returns the payout of a participant for a reward period
+// of an incentive
+func (k Keeper) GetParticipantPayout(
+	ctx sdk.Context,
+	contract common.Address,
+	participant common.Address,
+	period uint64,
+) (types.ParticipantPayout, bool) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefixParticipantPayout)
+	bz := store.Get(types.ParticipantPayoutKey(contract, participant, period))
+	if len(bz) == 0 {
+		return types.ParticipantPayout{}, false
+	}
+
+	var pp types.ParticipantPayout
+	k.cdc.MustUnmarshal(bz, &pp)
+	return pp, true
+}
+
+// SetParticipantPayout stores the payout of a participant for a reward period
+// of an incentive and indexes it for pruning
+func (k Keeper) SetParticipantPayout(ctx sdk.Context, pp types.ParticipantPayout) {
+	contract := common.HexToAddress(pp.Contract)
+	participant := common.HexToAddress(pp.Participant)
+
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefixParticipantPayout)
+	store.Set(types.ParticipantPayoutKey(contract, participant, pp.Period), k.cdc.MustMarshal(&pp))
+
+	indexStore := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefixPayoutIndex)
+	indexStore.Set(types.PayoutIndexKey(contract, participant, pp.Period), []byte{1})
+}
+
+// recordIncentivePayout stores the payout summary of a finalized reward period
+// if the payout history is enabled
+func (k Keeper) recordIncentivePayout(ctx sdk.Context, rp types.RewardPeriod, totalGas uint64) {
+	if k.GetParams(ctx).PayoutHistoryPeriods == 0 {
+		return
+	}
+
+	k.SetIncentivePayout(ctx, types.NewIncentivePayout(rp, ctx.BlockTime(), totalGas))
+}
+
+// recordParticipantPayout stores the payout of a settled gas meter. The payout
+// is only recorded if the summary of its reward period is retained, so that
+// late settlements don't outlive the pruning of their period.
+func (k Keeper) recordParticipantPayout(ctx sdk.Context, gm types.GasMeter, rewards sdk.Coins) {
+	contract := common.HexToAddress(gm.Contract)
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefixIncentivePayout)
+	if !store.Has(append(contract.Bytes(), sdk.Uint64ToBigEndian(gm.Period)...)) {
+		return
+	}
+
+	k.SetParticipantPayout(ctx, types.NewParticipantPayout(gm, rewards))
+}
+
+// pendingPayouts returns the payouts of a participant for the finalized reward
+// periods whose gas meters have not been settled yet, optionally for a single
+// incentive. The rewards are computed from the retained reward periods, as the
+// payouts are only recorded once the gas meters are settled.
+func (k Keeper) pendingPayouts(
+	ctx sdk.Context,
+	participant common.Address,
+	contract *common.Address,
+) []types.ParticipantPayout {
+	pps := []types.ParticipantPayout{}
+
+	k.IterateParticipantGasMeters(ctx, participant, func(gm types.GasMeter) (stop bool) {
+		gmContract := common.HexToAddress(gm.Contract)
+		if contract != nil && gmContract != *contract {
+			return false
+		}
+
+		if !k.isSettleable(ctx, gm) {
+			return false
+		}
+
+		rp, found := k.GetRewardPeriod(ctx, gmContract, gm.Period)
+		if found {
+			pps = append(pps, types.NewParticipantPayout(gm, rp.Rewards(gm.CumulativeGas)))
+		}
+		return false
+	})
+
+	return pps
+}
+
+// prunePayouts removes the payouts of the reward periods of the registered
+// incentives that are older than the retained number of periods, i.e. all
+// finalized periods if the payout history is disabled. At most
+// maxPrunedPayoutsPerBlock records are removed per call, so that the cost of
+// pruning doesn't depend on the number of participants, and the rest are
+// removed in the next blocks. The payouts of an incentive that ended or was
+// cancelled are retained.
+func (k Keeper) prunePayouts(ctx sdk.Context) {
+	retained := k.GetParams(ctx).PayoutHistoryPeriods
+	budget := maxPrunedPayoutsPerBlock
+
+	k.IterateIncentives(ctx, func(incentive types.Incentive) (stop bool) {
+		// the finalized periods precede the ongoing period and the ones below
+		// the cutoff are pruned
+		if incentive.Period <= retained {
+			return false
+		}
+		cutoff := incentive.Period - retained
+
+		budget -= k.deletePayouts(ctx, common.HexToAddress(incentive.Contract), cutoff, budget)
+		return budget == 0
+	})
+}
+
+// deletePayouts removes up to limit payouts of the reward periods of a
+// contract below the cutoff and returns the number of removed records. The
+// payouts of the participants are removed before the payout summaries, so
+// that no participant payout outlives the summary of its period.
+func (k Keeper) deletePayouts(ctx sdk.Context, contract common.Address, cutoff uint64, limit int) int {
+	end := append(contract.Bytes(), sdk.Uint64ToBigEndian(cutoff)...)
+	deleted := 0
+
+	payoutStore := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefixParticipantPayout)
+	indexStore := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefixPayoutIndex)
+	iterator := indexStore.Iterator(contract.Bytes(), end)
+	var keys [][]byte
+	for ; iterator.Valid() && len(keys) < limit; iterator.Next() {
+		keys = append(keys, iterator.Key())
+	}
+	iterator.Close()
+
+	for _, key := range keys {
+		period := sdk.BigEndianToUint64(key[common.AddressLength : common.AddressLength+8])
+		participant := common.BytesToAddress(key[common.AddressLength+8:])
+		payoutStore.Delete(types.ParticipantPayoutKey(contract, participant, period))
+		indexStore.Delete(key)
+	}
+	deleted += len(keys)
+
+	if deleted == limit {
+		return deleted
+	}
+
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefixIncentivePayout)
+	iterator = store.Iterator(contract.Bytes(), end)
+	keys = [][]byte{}
+	for ; iterator.Valid() && deleted+len(keys) < limit; iterator.Next() {
+		keys = append(keys, iterator.Key())
+	}
+	iterator.Close()
+
+	for _, key := range keys {
+		store.Delete(key)
+	}
+
+	return deleted + len(keys)
+}
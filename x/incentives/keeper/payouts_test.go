@@ -0,0 +1,229 @@
+package keeper_test
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/evmos/ethermint/tests"
+
+	"github.com/evmos/evmos/v10/x/incentives/types"
+)
+
+func (suite *KeeperTestSuite) TestPayoutHistory() {
+	const mintAmount int64 = 1000
+
+	suite.SetupTest()
+
+	err := suite.app.BankKeeper.MintCoins(
+		suite.ctx,
+		types.ModuleName,
+		sdk.NewCoins(sdk.NewInt64Coin(denomCoin, mintAmount)),
+	)
+	suite.Require().NoError(err)
+
+	_, err = suite.app.IncentivesKeeper.RegisterIncentive(
+		suite.ctx,
+		contract,
+		allocations,
+		epochs,
+		types.DefaultWeighting(),
+		types.DefaultEligibility(),
+	)
+	suite.Require().NoError(err)
+
+	suite.addGasUsed(contract, participant, 100)
+	suite.addGasUsed(contract, participant2, 300)
+
+	err = suite.app.IncentivesKeeper.DistributeRewards(suite.ctx)
+	suite.Require().NoError(err)
+
+	// the summary of the period is recorded once it is finalized
+	ip, found := suite.app.IncentivesKeeper.GetIncentivePayout(suite.ctx, contract, 0)
+	suite.Require().True(found)
+	suite.Require().Equal(uint64(2), ip.Participants)
+	suite.Require().Equal(uint64(400), ip.TotalGas)
+	suite.Require().Equal(suite.ctx.BlockTime(), ip.EndTime)
+	suite.Require().Equal(
+		sdk.NewCoins(sdk.NewInt64Coin(denomCoin, mintAmount*allocationRate/100)),
+		ip.Rewards,
+	)
+
+	// the payout of a participant is recorded once it is settled, until then
+	// it is computed from the reward period
+	_, found = suite.app.IncentivesKeeper.GetParticipantPayout(suite.ctx, contract, participant, 0)
+	suite.Require().False(found)
+
+	req := &types.QueryParticipantPayoutsRequest{Participant: participant.Hex()}
+	res, err := suite.queryClient.ParticipantPayouts(sdk.WrapSDKContext(suite.ctx), req)
+	suite.Require().NoError(err)
+	suite.Require().Empty(res.Payouts)
+	suite.Require().Len(res.Pending, 1)
+	suite.Require().Equal(uint64(100), res.Pending[0].CumulativeGas)
+
+	rewards, err := suite.app.IncentivesKeeper.ClaimRewards(suite.ctx, participant)
+	suite.Require().NoError(err)
+	suite.Require().Equal(rewards, res.Pending[0].Rewards)
+
+	pp, found := suite.app.IncentivesKeeper.GetParticipantPayout(suite.ctx, contract, participant, 0)
+	suite.Require().True(found)
+	suite.Require().Equal(uint64(100), pp.CumulativeGas)
+	suite.Require().Equal(rewards, pp.Rewards)
+
+	res, err = suite.queryClient.ParticipantPayouts(sdk.WrapSDKContext(suite.ctx), req)
+	suite.Require().NoError(err)
+	suite.Require().Equal([]types.ParticipantPayout{pp}, res.Payouts)
+	suite.Require().Empty(res.Pending)
+
+	// spending gas in the next period settles the previous gas meter
+	suite.addGasUsed(contract, participant2, 50)
+
+	pp, found = suite.app.IncentivesKeeper.GetParticipantPayout(suite.ctx, contract, participant2, 0)
+	suite.Require().True(found)
+	suite.Require().Equal(uint64(300), pp.CumulativeGas)
+	suite.Require().Equal(sdk.NewCoins(sdk.NewInt64Coin(denomCoin, 37)), pp.Rewards)
+}
+
+func (suite *KeeperTestSuite) TestPrunePayouts() {
+	const mintAmount int64 = 1000
+
+	testCases := []struct {
+		name       string
+		retained   uint64
+		expPeriods []uint64
+	}{
+		{"history disabled", 0, []uint64{}},
+		{"last period retained", 1, []uint64{2}},
+		{"last two periods retained", 2, []uint64{1, 2}},
+		{"all periods retained", 52, []uint64{0, 1, 2}},
+	}
+	for _, tc := range testCases {
+		suite.Run(tc.name, func() {
+			suite.SetupTest() // reset
+
+			params := suite.app.IncentivesKeeper.GetParams(suite.ctx)
+			params.PayoutHistoryPeriods = tc.retained
+			suite.app.IncentivesKeeper.SetParams(suite.ctx, params)
+
+			err := suite.app.BankKeeper.MintCoins(
+				suite.ctx,
+				types.ModuleName,
+				sdk.NewCoins(sdk.NewInt64Coin(denomCoin, mintAmount)),
+			)
+			suite.Require().NoError(err)
+
+			_, err = suite.app.IncentivesKeeper.RegisterIncentive(
+				suite.ctx,
+				contract,
+				allocations,
+				epochs,
+				types.DefaultWeighting(),
+				types.DefaultEligibility(),
+			)
+			suite.Require().NoError(err)
+
+			// the participant settles the previous period in each new period
+			for i := 0; i < 3; i++ {
+				suite.addGasUsed(contract, participant, 100)
+				err = suite.app.IncentivesKeeper.DistributeRewards(suite.ctx)
+				suite.Require().NoError(err)
+			}
+			_, err = suite.app.IncentivesKeeper.ClaimRewards(suite.ctx, participant)
+			suite.Require().NoError(err)
+			suite.app.IncentivesKeeper.EndBlocker(suite.ctx)
+
+			ips := suite.app.IncentivesKeeper.GetAllIncentivePayouts(suite.ctx)
+			pps := suite.app.IncentivesKeeper.GetAllParticipantPayouts(suite.ctx)
+			suite.Require().Len(ips, len(tc.expPeriods))
+			suite.Require().Len(pps, len(tc.expPeriods))
+			for i, period := range tc.expPeriods {
+				suite.Require().Equal(period, ips[i].Period)
+				suite.Require().Equal(period, pps[i].Period)
+			}
+		})
+	}
+}
+
+func (suite *KeeperTestSuite) TestPayoutHistoryDeletedIncentive() {
+	suite.SetupTest()
+
+	err := suite.app.BankKeeper.MintCoins(
+		suite.ctx,
+		types.ModuleName,
+		sdk.NewCoins(sdk.NewInt64Coin(denomCoin, 1000)),
+	)
+	suite.Require().NoError(err)
+
+	_, err = suite.app.IncentivesKeeper.RegisterIncentive(
+		suite.ctx,
+		contract,
+		allocations,
+		epochs,
+		types.DefaultWeighting(),
+		types.DefaultEligibility(),
+	)
+	suite.Require().NoError(err)
+
+	suite.addGasUsed(contract, participant, 100)
+	err = suite.app.IncentivesKeeper.DistributeRewards(suite.ctx)
+	suite.Require().NoError(err)
+	_, err = suite.app.IncentivesKeeper.ClaimRewards(suite.ctx, participant)
+	suite.Require().NoError(err)
+
+	err = suite.app.IncentivesKeeper.CancelIncentive(suite.ctx, contract)
+	suite.Require().NoError(err)
+
+	// the payouts are retained after the incentive is cancelled
+	suite.app.IncentivesKeeper.EndBlocker(suite.ctx)
+	suite.Require().Len(suite.app.IncentivesKeeper.GetAllIncentivePayouts(suite.ctx), 1)
+	suite.Require().Len(suite.app.IncentivesKeeper.GetAllParticipantPayouts(suite.ctx), 1)
+
+	// the periods continue after the retained payouts once all reward periods
+	// are settled
+	incentive, err := suite.app.IncentivesKeeper.RegisterIncentive(
+		suite.ctx,
+		contract,
+		allocations,
+		epochs,
+		types.DefaultWeighting(),
+		types.DefaultEligibility(),
+	)
+	suite.Require().NoError(err)
+	suite.Require().Equal(uint64(1), incentive.Period)
+}
+
+func (suite *KeeperTestSuite) TestPrunePayoutsBounded() {
+	suite.SetupTest()
+
+	params := suite.app.IncentivesKeeper.GetParams(suite.ctx)
+	params.PayoutHistoryPeriods = 1
+	suite.app.IncentivesKeeper.SetParams(suite.ctx, params)
+
+	incentive, err := suite.app.IncentivesKeeper.RegisterIncentive(
+		suite.ctx,
+		contract,
+		sdk.DecCoins{},
+		epochs,
+		types.DefaultWeighting(),
+		types.DefaultEligibility(),
+	)
+	suite.Require().NoError(err)
+
+	// the first period is no longer retained once the incentive moved on
+	incentive.Period = 2
+	suite.app.IncentivesKeeper.SetIncentive(suite.ctx, *incentive)
+	suite.app.IncentivesKeeper.SetIncentivePayout(suite.ctx, types.IncentivePayout{Contract: contract.String(), Period: 0})
+	suite.app.IncentivesKeeper.SetIncentivePayout(suite.ctx, types.IncentivePayout{Contract: contract.String(), Period: 1})
+	for i := 0; i < 150; i++ {
+		gm := types.NewGasMeter(contract, tests.GenerateAddress(), 100)
+		suite.app.IncentivesKeeper.SetParticipantPayout(suite.ctx, types.NewParticipantPayout(gm, sdk.Coins{}))
+	}
+
+	// at most 100 payouts are pruned per block, the participant payouts first
+	suite.app.IncentivesKeeper.EndBlocker(suite.ctx)
+	suite.Require().Len(suite.app.IncentivesKeeper.GetAllParticipantPayouts(suite.ctx), 50)
+	suite.Require().Len(suite.app.IncentivesKeeper.GetAllIncentivePayouts(suite.ctx), 2)
+
+	suite.app.IncentivesKeeper.EndBlocker(suite.ctx)
+	suite.Require().Empty(suite.app.IncentivesKeeper.GetAllParticipantPayouts(suite.ctx))
+	ips := suite.app.IncentivesKeeper.GetAllIncentivePayouts(suite.ctx)
+	suite.Require().Len(ips, 1)
+	suite.Require().Equal(uint64(1), ips[0].Period)
+}
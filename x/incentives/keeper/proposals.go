@@ -15,6 +15,8 @@ func (k Keeper) RegisterIncentive(
 	contract common.Address,
 	allocations sdk.DecCoins,
 	epochs uint32,
+	weighting types.Weighting,
+	eligibility types.Eligibility,
 ) (*types.Incentive, error) {
 	// Check if the Incentives are globally enabled
 	params := k.GetParams(ctx)
@@ -42,51 +44,15 @@ func (k Keeper) RegisterIncentive(
 		)
 	}
 
-	// Check if the balance is > 0 for coins other than the mint denomination
-	mintDenom := k.evmKeeper.GetParams(ctx).EvmDenom
-	moduleAddr := k.accountKeeper.GetModuleAddress(types.ModuleName)
-	for _, al := range allocations {
-		if al.Denom != mintDenom && k.bankKeeper.GetBalance(ctx, moduleAddr, al.Denom).IsZero() {
-			return nil, errorsmod.Wrapf(
-				errortypes.ErrInvalidCoins,
-				"base denomination '%s' cannot have a supply of 0", al.Denom,
-			)
-		}
-
-		// Check if each allocation is below the allocation limit
-		if al.Amount.GT(params.AllocationLimit) {
-			return nil, errorsmod.Wrapf(
-				types.ErrInternalIncentive,
-				"allocation for denom '%s' (%s) cannot be above allocation limit (%s)", al.Denom, al.Amount, params.AllocationLimit,
-			)
-		}
-	}
-
-	// Iterate over allocations to update allocation meters
-	allocationMeters := []sdk.DecCoin{}
-	for _, al := range allocations {
-		allocationMeter, _ := k.GetAllocationMeter(ctx, al.Denom)
-		// Check if the sum of all allocations (current + proposed) exceeds 100%
-		allocationSum := allocationMeter.Amount.Add(al.Amount)
-		if allocationSum.GT(sdk.OneDec()) {
-			return nil, errorsmod.Wrapf(
-				types.ErrInternalIncentive,
-				"allocation for denom %s is larger than 100 percent: %v",
-				al.Denom, allocationSum,
-			)
-		}
-
-		// build new allocation meter
-		newAllocationMeter := sdk.DecCoin{
-			Denom:  al.Denom,
-			Amount: allocationSum,
-		}
-		allocationMeters = append(allocationMeters, newAllocationMeter)
+	allocationMeters, err := k.updatedAllocationMeters(ctx, params, allocations, sdk.DecCoins{})
+	if err != nil {
+		return nil, err
 	}
 
 	// create incentive and set to store
-	incentive := types.NewIncentive(contract, allocations, epochs)
+	incentive := types.NewIncentive(contract, allocations, epochs, weighting, eligibility)
 	incentive.StartTime = ctx.BlockTime()
+	incentive.Period = k.nextRewardPeriod(ctx, contract)
 	k.SetIncentive(ctx, incentive)
 
 	// Update allocation meters
@@ -121,11 +87,129 @@ func (k Keeper) CancelIncentive(
 
 	k.DeleteIncentiveAndUpdateAllocationMeters(ctx, incentive)
 
-	// Delete incentive's gas meters
-	gms := k.GetIncentiveGasMeters(ctx, contract)
-	for _, gm := range gms {
-		k.DeleteGasMeter(ctx, gm)
-	}
+	// Delete incentive's gas meters of the ongoing reward period. The gas meters
+	// of finalized reward periods remain claimable and their payouts are
+	// retained.
+	k.removeRewardPeriod(ctx, incentive)
 
+	// Refund the unspent funds of the incentive
+	k.refundFunds(ctx, contract)
 	return nil
 }
+
+// UpdateIncentive replaces the allocations and the number of remaining epochs
+// of a registered incentive. The gas meters and reward period of the current
+// epoch are kept, so the new allocations already apply to the current epoch.
+func (k Keeper) UpdateIncentive(
+	ctx sdk.Context,
+	contract common.Address,
+	allocations sdk.DecCoins,
+	epochs uint32,
+) (*types.Incentive, error) {
+	// Check if the Incentives are globally enabled
+	params := k.GetParams(ctx)
+	if !params.EnableIncentives {
+		return nil, errorsmod.Wrap(
+			types.ErrInternalIncentive,
+			"incentives are currently disabled by governance",
+		)
+	}
+
+	incentive, found := k.GetIncentive(ctx, contract)
+	if !found {
+		return nil, errorsmod.Wrapf(
+			errortypes.ErrInvalidAddress,
+			"unmatching contract '%s' ", contract,
+		)
+	}
+
+	allocationMeters, err := k.updatedAllocationMeters(ctx, params, allocations, incentive.Allocations)
+	if err != nil {
+		return nil, err
+	}
+
+	incentive.Allocations = allocations
+	incentive.Epochs = epochs
+	k.SetIncentive(ctx, incentive)
+
+	// Update allocation meters
+	for _, am := range allocationMeters {
+		k.SetAllocationMeter(ctx, am)
+	}
+
+	return &incentive, nil
+}
+
+// updatedAllocationMeters checks the proposed allocations of an incentive and
+// returns the allocation meters that result from replacing its current
+// allocations with the proposed ones. It fails if a non-mint denomination has
+// no supply, if an allocation is above the allocation limit or if the total
+// allocation of a denomination exceeds 100%.
+func (k Keeper) updatedAllocationMeters(
+	ctx sdk.Context,
+	params types.Params,
+	allocations sdk.DecCoins,
+	current sdk.DecCoins,
+) ([]sdk.DecCoin, error) {
+	// Check if the balance is > 0 for coins other than the mint denomination
+	mintDenom := k.evmKeeper.GetParams(ctx).EvmDenom
+	moduleAddr := k.accountKeeper.GetModuleAddress(types.ModuleName)
+	for _, al := range allocations {
+		if al.Denom != mintDenom && k.bankKeeper.GetBalance(ctx, moduleAddr, al.Denom).IsZero() {
+			return nil, errorsmod.Wrapf(
+				errortypes.ErrInvalidCoins,
+				"base denomination '%s' cannot have a supply of 0", al.Denom,
+			)
+		}
+
+		// Check if each allocation is below the allocation limit
+		if al.Amount.GT(params.AllocationLimit) {
+			return nil, errorsmod.Wrapf(
+				types.ErrInternalIncentive,
+				"allocation for denom '%s' (%s) cannot be above allocation limit (%s)", al.Denom, al.Amount, params.AllocationLimit,
+			)
+		}
+	}
+
+	// Release the current allocations of the incentive
+	denoms := []string{}
+	amounts := make(map[string]sdk.Dec)
+	for _, al := range current {
+		// NOTE: existence of incentive is already checked
+		allocationMeter, _ := k.GetAllocationMeter(ctx, al.Denom)
+		amounts[al.Denom] = allocationMeter.Amount.Sub(al.Amount)
+		denoms = append(denoms, al.Denom)
+	}
+
+	// Iterate over allocations to update allocation meters
+	for _, al := range allocations {
+		amount, ok := amounts[al.Denom]
+		if !ok {
+			allocationMeter, _ := k.GetAllocationMeter(ctx, al.Denom)
+			amount = allocationMeter.Amount
+			denoms = append(denoms, al.Denom)
+		}
+
+		// Check if the sum of all allocations (current + proposed) exceeds 100%
+		allocationSum := amount.Add(al.Amount)
+		if allocationSum.GT(sdk.OneDec()) {
+			return nil, errorsmod.Wrapf(
+				types.ErrInternalIncentive,
+				"allocation for denom %s is larger than 100 percent: %v",
+				al.Denom, allocationSum,
+			)
+		}
+		amounts[al.Denom] = allocationSum
+	}
+
+	// build new allocation meters
+	allocationMeters := make([]sdk.DecCoin, 0, len(denoms))
+	for _, denom := range denoms {
+		allocationMeters = append(allocationMeters, sdk.DecCoin{
+			Denom:  denom,
+			Amount: amounts[denom],
+		})
+	}
+
+	return allocationMeters, nil
+}
@@ -37,7 +37,7 @@ func (suite KeeperTestSuite) TestRegisterIncentive() {
 		{
 			"inventive already registered",
 			func() {
-				regIn := types.NewIncentive(contract, allocations, epochs)
+				regIn := types.NewIncentive(contract, allocations, epochs, types.DefaultWeighting(), types.DefaultEligibility())
 				suite.app.IncentivesKeeper.SetIncentive(suite.ctx, regIn)
 				suite.Commit()
 			},
@@ -94,6 +94,8 @@ func (suite KeeperTestSuite) TestRegisterIncentive() {
 						sdk.NewDecCoinFromDec(denomCoin, sdk.NewDecWithPrec(100, 2)),
 					},
 					epochs,
+					types.DefaultWeighting(),
+					types.DefaultEligibility(),
 				)
 				suite.Require().NoError(err)
 				suite.Commit()
@@ -127,6 +129,8 @@ func (suite KeeperTestSuite) TestRegisterIncentive() {
 				contract,
 				allocations,
 				epochs,
+				types.DefaultWeighting(),
+				types.DefaultEligibility(),
 			)
 			suite.Commit()
 
@@ -135,6 +139,8 @@ func (suite KeeperTestSuite) TestRegisterIncentive() {
 				Allocations: allocations,
 				Epochs:      epochs,
 				StartTime:   suite.ctx.BlockTime(),
+				Weighting:   types.DefaultWeighting(),
+				Eligibility: types.DefaultEligibility(),
 			}
 
 			allocationMeters := suite.app.IncentivesKeeper.GetAllAllocationMeters(suite.ctx)
@@ -182,6 +188,8 @@ func (suite KeeperTestSuite) TestCancelIncentive() {
 					contract,
 					mintAllocations,
 					epochs,
+					types.DefaultWeighting(),
+					types.DefaultEligibility(),
 				)
 				suite.Require().NoError(err)
 				suite.Commit()
@@ -219,3 +227,143 @@ func (suite KeeperTestSuite) TestCancelIncentive() {
 		})
 	}
 }
+
+func (suite *KeeperTestSuite) TestUpdateIncentive() {
+	updatedAllocations := sdk.DecCoins{
+		sdk.NewDecCoinFromDec(denomMint, sdk.NewDecWithPrec(10, 2)),
+	}
+
+	testCases := []struct {
+		name                string
+		malleate            func()
+		allocations         sdk.DecCoins
+		expAllocationMeters []sdk.DecCoin
+		expPass             bool
+	}{
+		{
+			"incentives are disabled globally",
+			func() {
+				params := types.DefaultParams()
+				params.EnableIncentives = false
+				suite.app.IncentivesKeeper.SetParams(suite.ctx, params)
+			},
+			updatedAllocations,
+			[]sdk.DecCoin{allocations[1], allocations[0]},
+			false,
+		},
+		{
+			"inventive not registered",
+			func() {
+				contract = tests.GenerateAddress()
+			},
+			updatedAllocations,
+			[]sdk.DecCoin{allocations[1], allocations[0]},
+			false,
+		},
+		{
+			"allocation above allocation limit",
+			func() {
+				params := types.DefaultParams()
+				params.AllocationLimit = sdk.NewDecWithPrec(6, 2)
+				suite.app.IncentivesKeeper.SetParams(suite.ctx, params)
+			},
+			updatedAllocations,
+			[]sdk.DecCoin{allocations[1], allocations[0]},
+			false,
+		},
+		{
+			"Total allocation for at least one denom (current + proposed) > 100%",
+			func() {
+				params := types.DefaultParams()
+				params.AllocationLimit = sdk.NewDecWithPrec(100, 2)
+				suite.app.IncentivesKeeper.SetParams(suite.ctx, params)
+
+				_, err := suite.app.IncentivesKeeper.RegisterIncentive(
+					suite.ctx,
+					contract2,
+					sdk.DecCoins{sdk.NewDecCoinFromDec(denomMint, sdk.NewDecWithPrec(95, 2))},
+					epochs,
+					types.DefaultWeighting(),
+					types.DefaultEligibility(),
+				)
+				suite.Require().NoError(err)
+			},
+			updatedAllocations,
+			[]sdk.DecCoin{allocations[1], sdk.NewDecCoinFromDec(denomMint, sdk.NewDecWithPrec(100, 2))},
+			false,
+		},
+		{
+			"ok - current allocations are replaced",
+			func() {
+				params := types.DefaultParams()
+				params.AllocationLimit = sdk.NewDecWithPrec(100, 2)
+				suite.app.IncentivesKeeper.SetParams(suite.ctx, params)
+
+				_, err := suite.app.IncentivesKeeper.RegisterIncentive(
+					suite.ctx,
+					contract2,
+					sdk.DecCoins{sdk.NewDecCoinFromDec(denomMint, sdk.NewDecWithPrec(90, 2))},
+					epochs,
+					types.DefaultWeighting(),
+					types.DefaultEligibility(),
+				)
+				suite.Require().NoError(err)
+			},
+			updatedAllocations,
+			[]sdk.DecCoin{sdk.NewDecCoinFromDec(denomMint, sdk.NewDecWithPrec(100, 2))},
+			true,
+		},
+		{
+			"ok - allocations removed",
+			func() {},
+			sdk.DecCoins{},
+			[]sdk.DecCoin{},
+			true,
+		},
+	}
+	for _, tc := range testCases {
+		suite.Run(fmt.Sprintf("Case %s", tc.name), func() {
+			suite.SetupTest() // reset
+
+			// Make sure the non-mint coin has supply
+			err := suite.app.BankKeeper.MintCoins(
+				suite.ctx,
+				types.ModuleName,
+				sdk.Coins{sdk.NewInt64Coin(denomCoin, 1)},
+			)
+			suite.Require().NoError(err)
+
+			_, err = suite.app.IncentivesKeeper.RegisterIncentive(
+				suite.ctx,
+				contract,
+				allocations,
+				epochs,
+				types.DefaultWeighting(),
+				types.DefaultEligibility(),
+			)
+			suite.Require().NoError(err)
+			suite.addGasUsed(contract, participant, 100)
+
+			tc.malleate()
+
+			in, err := suite.app.IncentivesKeeper.UpdateIncentive(suite.ctx, contract, tc.allocations, epochs+10)
+			suite.Commit()
+
+			allocationMeters := suite.app.IncentivesKeeper.GetAllAllocationMeters(suite.ctx)
+			suite.Require().Equal(tc.expAllocationMeters, allocationMeters)
+
+			if tc.expPass {
+				suite.Require().NoError(err, tc.name)
+				suite.Require().Equal(tc.allocations, in.Allocations)
+				suite.Require().Equal(epochs+10, in.Epochs)
+
+				// the gas meters of the current epoch are kept
+				gas, found := suite.app.IncentivesKeeper.GetGasMeter(suite.ctx, contract, participant)
+				suite.Require().True(found)
+				suite.Require().Equal(uint64(100), gas)
+			} else {
+				suite.Require().Error(err, tc.name)
+			}
+		})
+	}
+}
@@ -0,0 +1,418 @@
+package keeper
+
+import (
+	"strconv"
+
+	errorsmod "cosmossdk.io/errors"
+	"github.com/cosmos/cosmos-sdk/store/prefix"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/ethereum/go-ethereum/common"
+
+	"github.com/evmos/evmos/v10/x/incentives/types"
+)
+
+// GetAllRewardPeriods returns the reward periods of all incentives that have
+// not been fully settled
+func (k Keeper) GetAllRewardPeriods(ctx sdk.Context) []types.RewardPeriod {
+	rps := []types.RewardPeriod{}
+
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefixRewardPeriod)
+	iterator := store.Iterator(nil, nil)
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		var rp types.RewardPeriod
+		k.cdc.MustUnmarshal(iterator.Value(), &rp)
+		rps = append(rps, rp)
+	}
+
+	return rps
+}
+
+// GetRewardPeriod returns the reward period of an incentive
+func (k Keeper) GetRewardPeriod(
+	ctx sdk.Context,
+	contract common.Address,
+	period uint64,
+) (types.RewardPeriod, bool) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefixRewardPeriod)
+	bz := store.Get(append(contract.Bytes(), sdk.Uint64ToBigEndian(period)...))
+	if len(bz) == 0 {
+		return types.RewardPeriod{}, false
+	}
+
+	var rp types.RewardPeriod
+	k.cdc.MustUnmarshal(bz, &rp)
+	return rp, true
+}
+
+// SetRewardPeriod stores the reward period of an incentive
+func (k Keeper) SetRewardPeriod(ctx sdk.Context, rp types.RewardPeriod) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefixRewardPeriod)
+	store.Set(rewardPeriodKey(rp), k.cdc.MustMarshal(&rp))
+}
+
+// DeleteRewardPeriod removes the reward period of an incentive
+func (k Keeper) DeleteRewardPeriod(ctx sdk.Context, rp types.RewardPeriod) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefixRewardPeriod)
+	store.Delete(rewardPeriodKey(rp))
+}
+
+// rewardPeriodKey returns the `<contract_address>|<period>` key of a reward
+// period
+func rewardPeriodKey(rp types.RewardPeriod) []byte {
+	contract := common.HexToAddress(rp.Contract)
+	return append(contract.Bytes(), sdk.Uint64ToBigEndian(rp.Period)...)
+}
+
+// nextRewardPeriod returns the first reward period of a contract that is
+// neither used by a reward period nor by a retained payout, so that the gas
+// meters and the payout history of a previous incentive for the same contract
+// remain valid when it is registered again
+func (k Keeper) nextRewardPeriod(ctx sdk.Context, contract common.Address) uint64 {
+	next := uint64(0)
+	for _, prefixKey := range [][]byte{types.KeyPrefixRewardPeriod, types.KeyPrefixIncentivePayout} {
+		store := prefix.NewStore(ctx.KVStore(k.storeKey), prefixKey)
+		iterator := sdk.KVStoreReversePrefixIterator(store, contract.Bytes())
+		if iterator.Valid() {
+			if period := sdk.BigEndianToUint64(iterator.Key()[common.AddressLength:]) + 1; period > next {
+				next = period
+			}
+		}
+		iterator.Close()
+	}
+
+	return next
+}
+
+// GetAllAccruedRewards returns the settled rewards of all participants that
+// have not been claimed yet
+func (k Keeper) GetAllAccruedRewards(ctx sdk.Context) []types.AccruedRewards {
+	ars := []types.AccruedRewards{}
+
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefixAccruedRewards)
+	iterator := store.Iterator(nil, nil)
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		var ar types.AccruedRewards
+		k.cdc.MustUnmarshal(iterator.Value(), &ar)
+		ars = append(ars, ar)
+	}
+
+	return ars
+}
+
+// GetAccruedRewards returns the settled rewards of a participant that have not
+// been claimed yet
+func (k Keeper) GetAccruedRewards(ctx sdk.Context, participant common.Address) sdk.Coins {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefixAccruedRewards)
+	bz := store.Get(participant.Bytes())
+	if len(bz) == 0 {
+		return sdk.Coins{}
+	}
+
+	var ar types.AccruedRewards
+	k.cdc.MustUnmarshal(bz, &ar)
+	return ar.Rewards
+}
+
+// SetAccruedRewards stores the settled rewards of a participant
+func (k Keeper) SetAccruedRewards(ctx sdk.Context, ar types.AccruedRewards) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefixAccruedRewards)
+	participant := common.HexToAddress(ar.Participant)
+	store.Set(participant.Bytes(), k.cdc.MustMarshal(&ar))
+}
+
+// DeleteAccruedRewards removes the settled rewards of a participant
+func (k Keeper) DeleteAccruedRewards(ctx sdk.Context, participant common.Address) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefixAccruedRewards)
+	store.Delete(participant.Bytes())
+}
+
+// GetOutstandingRewards returns the rewards reserved for the participants of
+// finalized reward periods that have not been claimed yet. These coins are
+// held by the module account but are not part of the inflation pool. They are
+// stored as the accrued rewards of the module account.
+func (k Keeper) GetOutstandingRewards(ctx sdk.Context) sdk.Coins {
+	store := ctx.KVStore(k.storeKey)
+	bz := store.Get(types.KeyOutstandingRewards)
+	if len(bz) == 0 {
+		return sdk.Coins{}
+	}
+
+	var ar types.AccruedRewards
+	k.cdc.MustUnmarshal(bz, &ar)
+	return ar.Rewards
+}
+
+// SetOutstandingRewards stores the rewards reserved for the participants of
+// finalized reward periods
+func (k Keeper) SetOutstandingRewards(ctx sdk.Context, outstanding sdk.Coins) {
+	store := ctx.KVStore(k.storeKey)
+	if outstanding.IsZero() {
+		store.Delete(types.KeyOutstandingRewards)
+		return
+	}
+
+	ar := types.AccruedRewards{Participant: types.ModuleAddress.String(), Rewards: outstanding}
+	store.Set(types.KeyOutstandingRewards, k.cdc.MustMarshal(&ar))
+}
+
+// releaseOutstandingRewards deducts paid or unused rewards from the
+// outstanding rewards
+func (k Keeper) releaseOutstandingRewards(ctx sdk.Context, coins sdk.Coins) {
+	outstanding := k.GetOutstandingRewards(ctx)
+	k.SetOutstandingRewards(ctx, outstanding.Sub(outstanding.Min(coins)...))
+}
+
+// AddGasUsed adds the gas spent by a participant to its gas meter and to the
+// total gas and total weight of the current reward period of the incentive. A
+// gas meter of a previous period is settled before the participant starts
+// accumulating gas in the current period.
+func (k Keeper) AddGasUsed(
+	ctx sdk.Context,
+	incentive types.Incentive,
+	participant common.Address,
+	gasUsed uint64,
+) {
+	contract := common.HexToAddress(incentive.Contract)
+
+	incentive.TotalGas += gasUsed
+	k.SetIncentive(ctx, incentive)
+
+	rp, found := k.GetRewardPeriod(ctx, contract, incentive.Period)
+	if !found {
+		rp = types.NewRewardPeriod(contract, incentive.Period, incentive.Weighting)
+	}
+
+	previousGas := uint64(0)
+	gm, found := k.getGasMeter(ctx, contract, participant)
+	switch {
+	case found && gm.Period == incentive.Period:
+		previousGas = gm.CumulativeGas
+	case found:
+		k.settleGasMeter(ctx, gm)
+		rp.GasMeters++
+	default:
+		rp.GasMeters++
+	}
+
+	cumulativeGas := previousGas + gasUsed
+	rp.TotalWeight = rp.TotalWeight.
+		Sub(rp.Weighting.Weight(previousGas)).
+		Add(rp.Weighting.Weight(cumulativeGas))
+	k.SetRewardPeriod(ctx, rp)
+
+	gm = types.NewGasMeter(contract, participant, cumulativeGas)
+	gm.Period = incentive.Period
+	k.SetGasMeter(ctx, gm)
+}
+
+// finalizeRewardPeriod ends the current reward period of an incentive. The
+// allocated inflation rewards and the epoch amount of the incentive funds are
+// reserved for the participants of the period and recorded as rewards per
+// unit of weight, which are settled lazily for each participant. The coins
+// reserved from each funder are recorded, so that the funded rewards that are
+// not settled can be refunded. Nothing is reserved if no participant has a
+// positive weight. It returns the reserved coins.
+func (k Keeper) finalizeRewardPeriod(
+	ctx sdk.Context,
+	incentive types.Incentive,
+	allocation sdk.Coins,
+) sdk.Coins {
+	contract := common.HexToAddress(incentive.Contract)
+
+	rp, found := k.GetRewardPeriod(ctx, contract, incentive.Period)
+	if !found || !rp.TotalWeight.IsPositive() {
+		return sdk.Coins{}
+	}
+
+	funded := k.fundAllocation(ctx, incentive)
+
+	rp.RewardPerWeight = sdk.NewDecCoinsFromCoins(allocation...).QuoDecTruncate(rp.TotalWeight)
+	rp.FundRewardPerWeight = sdk.NewDecCoinsFromCoins(funded...).QuoDecTruncate(rp.TotalWeight)
+	rp.CappedDenom = k.evmKeeper.GetParams(ctx).EvmDenom
+	rp.RewardScaler = k.GetParams(ctx).RewardScaler
+	rp.Remaining = allocation.Add(funded...)
+	rp.FundRemaining = funded
+	rp.Funds = k.spendFunds(ctx, incentive, funded)
+	rp.EndTime = ctx.BlockTime()
+	k.SetRewardPeriod(ctx, rp)
+
+	k.SetOutstandingRewards(ctx, k.GetOutstandingRewards(ctx).Add(rp.Remaining...))
+	k.recordIncentivePayout(ctx, rp, incentive.TotalGas)
+
+	return rp.Remaining
+}
+
+// settleGasMeter credits the rewards of a gas meter from a finalized reward
+// period to the accrued rewards of its participant and removes the gas meter.
+// Once all gas meters of the period are settled, the reward period is
+// released.
+func (k Keeper) settleGasMeter(ctx sdk.Context, gm types.GasMeter) {
+	k.DeleteGasMeter(ctx, gm)
+
+	contract := common.HexToAddress(gm.Contract)
+	rp, found := k.GetRewardPeriod(ctx, contract, gm.Period)
+	if !found {
+		return
+	}
+
+	inflationRewards, fundRewards := rp.SplitRewards(gm.CumulativeGas)
+	rewards := inflationRewards.Add(fundRewards...)
+	rp.Remaining = rp.Remaining.Sub(rewards...)
+	rp.FundRemaining = rp.FundRemaining.Sub(fundRewards...)
+	if rp.GasMeters > 0 {
+		rp.GasMeters--
+	}
+
+	if rp.GasMeters == 0 {
+		k.releaseRewardPeriod(ctx, rp)
+	} else {
+		k.SetRewardPeriod(ctx, rp)
+	}
+
+	k.recordParticipantPayout(ctx, gm, rewards)
+
+	if rewards.IsZero() {
+		return
+	}
+
+	participant := common.HexToAddress(gm.Participant)
+	accrued := k.GetAccruedRewards(ctx, participant).Add(rewards...)
+	k.SetAccruedRewards(ctx, types.NewAccruedRewards(participant, accrued))
+}
+
+// releaseRewardPeriod removes a finalized reward period whose gas meters were
+// all settled or expired. The reserved rewards that were not settled, i.e. the
+// rounding dust, the inflation rewards withheld by the gas cap and the rewards
+// of expired gas meters, are no longer outstanding: the inflation rewards are
+// released to the inflation pool and the funded rewards are refunded to the
+// funders of the period.
+func (k Keeper) releaseRewardPeriod(ctx sdk.Context, rp types.RewardPeriod) {
+	k.DeleteRewardPeriod(ctx, rp)
+	k.releaseOutstandingRewards(ctx, rp.Remaining)
+	k.refundFundRewards(ctx, rp)
+}
+
+// expireRewardPeriods releases the unsettled rewards of the finalized reward
+// periods that ended at least the reward expiry duration ago, and the rewards
+// of the participants that didn't settle them in the meantime are forfeited.
+// The gas meters of an expired period are not removed here, as that would
+// depend on the number of participants. The period is kept without remaining
+// rewards instead, and its gas meters are settled without rewards when their
+// participants claim or spend gas again, which removes the period once its
+// last gas meter is settled.
+func (k Keeper) expireRewardPeriods(ctx sdk.Context) {
+	expiry := k.GetParams(ctx).RewardExpiry
+	if expiry == 0 {
+		return
+	}
+
+	expired := []types.RewardPeriod{}
+	for _, rp := range k.GetAllRewardPeriods(ctx) {
+		// ongoing reward periods have no end time and expired ones have no
+		// remaining rewards
+		if !rp.EndTime.IsZero() && !rp.Remaining.IsZero() && !ctx.BlockTime().Before(rp.EndTime.Add(expiry)) {
+			expired = append(expired, rp)
+		}
+	}
+
+	for _, rp := range expired {
+		k.releaseOutstandingRewards(ctx, rp.Remaining)
+		k.refundFundRewards(ctx, rp)
+
+		ctx.EventManager().EmitEvent(
+			sdk.NewEvent(
+				types.EventTypeExpireRewardPeriod,
+				sdk.NewAttribute(types.AttributeKeyContract, rp.Contract),
+				sdk.NewAttribute(types.AttributeKeyPeriod, strconv.FormatUint(rp.Period, 10)),
+				sdk.NewAttribute(types.AttributeKeyAmount, rp.Remaining.String()),
+			),
+		)
+
+		rp.Remaining = sdk.Coins{}
+		rp.FundRemaining = sdk.Coins{}
+		rp.Funds = nil
+		k.SetRewardPeriod(ctx, rp)
+	}
+}
+
+// isSettleable returns true if the reward period of a gas meter has been
+// finalized, i.e. the incentive moved on to a later period or was removed
+func (k Keeper) isSettleable(ctx sdk.Context, gm types.GasMeter) bool {
+	incentive, found := k.GetIncentive(ctx, common.HexToAddress(gm.Contract))
+	return !found || gm.Period < incentive.Period
+}
+
+// ClaimRewards settles the gas meters of a participant from finalized reward
+// periods and transfers all its accrued rewards to its account
+func (k Keeper) ClaimRewards(ctx sdk.Context, participant common.Address) (sdk.Coins, error) {
+	gms := []types.GasMeter{}
+	k.IterateParticipantGasMeters(ctx, participant, func(gm types.GasMeter) (stop bool) {
+		if k.isSettleable(ctx, gm) {
+			gms = append(gms, gm)
+		}
+		return false
+	})
+
+	for _, gm := range gms {
+		k.settleGasMeter(ctx, gm)
+	}
+
+	rewards := k.GetAccruedRewards(ctx, participant)
+	if rewards.IsZero() {
+		return nil, errorsmod.Wrapf(
+			types.ErrNoIncentiveRewards,
+			"participant %s", participant,
+		)
+	}
+
+	if err := k.bankKeeper.SendCoinsFromModuleToAccount(
+		ctx, types.ModuleName, sdk.AccAddress(participant.Bytes()), rewards,
+	); err != nil {
+		return nil, err
+	}
+
+	k.DeleteAccruedRewards(ctx, participant)
+	k.releaseOutstandingRewards(ctx, rewards)
+
+	return rewards, nil
+}
+
+// pendingRewards returns the rewards a participant can claim, i.e. its accrued
+// rewards and the rewards of its gas meters from finalized reward periods
+func (k Keeper) pendingRewards(ctx sdk.Context, participant common.Address) sdk.Coins {
+	rewards := k.GetAccruedRewards(ctx, participant)
+
+	k.IterateParticipantGasMeters(ctx, participant, func(gm types.GasMeter) (stop bool) {
+		if !k.isSettleable(ctx, gm) {
+			return false
+		}
+
+		rp, found := k.GetRewardPeriod(ctx, common.HexToAddress(gm.Contract), gm.Period)
+		if found {
+			rewards = rewards.Add(rp.Rewards(gm.CumulativeGas)...)
+		}
+		return false
+	})
+
+	return rewards
+}
+
+// removeRewardPeriod discards the ongoing reward period of an incentive and
+// the gas meters recorded during it
+func (k Keeper) removeRewardPeriod(ctx sdk.Context, incentive types.Incentive) {
+	contract := common.HexToAddress(incentive.Contract)
+
+	for _, gm := range k.GetIncentiveGasMeters(ctx, contract) {
+		if gm.Period == incentive.Period {
+			k.DeleteGasMeter(ctx, gm)
+		}
+	}
+
+	if rp, found := k.GetRewardPeriod(ctx, contract, incentive.Period); found {
+		k.DeleteRewardPeriod(ctx, rp)
+	}
+}
@@ -0,0 +1,306 @@
+package keeper_test
+
+import (
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/evmos/evmos/v10/testutil"
+	"github.com/evmos/evmos/v10/x/incentives/types"
+)
+
+func (suite *KeeperTestSuite) TestAddGasUsed() {
+	suite.SetupTest()
+
+	err := suite.app.BankKeeper.MintCoins(
+		suite.ctx,
+		types.ModuleName,
+		sdk.NewCoins(sdk.NewInt64Coin(denomCoin, 1000)),
+	)
+	suite.Require().NoError(err)
+
+	_, err = suite.app.IncentivesKeeper.RegisterIncentive(
+		suite.ctx,
+		contract,
+		allocations,
+		epochs,
+		types.DefaultWeighting(),
+		types.DefaultEligibility(),
+	)
+	suite.Require().NoError(err)
+
+	suite.addGasUsed(contract, participant, 100)
+	suite.addGasUsed(contract, participant, 300)
+	suite.addGasUsed(contract, participant2, 100)
+
+	// the reward period accumulates the weights of its participants
+	rp, found := suite.app.IncentivesKeeper.GetRewardPeriod(suite.ctx, contract, 0)
+	suite.Require().True(found)
+	suite.Require().Equal(uint64(2), rp.GasMeters)
+	suite.Require().Equal(sdk.NewDec(500), rp.TotalWeight)
+
+	incentive, _ := suite.app.IncentivesKeeper.GetIncentive(suite.ctx, contract)
+	suite.Require().Equal(uint64(500), incentive.TotalGas)
+
+	err = suite.app.IncentivesKeeper.DistributeRewards(suite.ctx)
+	suite.Require().NoError(err)
+
+	incentive, _ = suite.app.IncentivesKeeper.GetIncentive(suite.ctx, contract)
+	suite.Require().Equal(uint64(1), incentive.Period)
+
+	// spending gas in the next period settles the gas meter of the previous one
+	suite.addGasUsed(contract, participant, 50)
+
+	gas, found := suite.app.IncentivesKeeper.GetGasMeter(suite.ctx, contract, participant)
+	suite.Require().True(found)
+	suite.Require().Equal(uint64(50), gas)
+
+	rp, found = suite.app.IncentivesKeeper.GetRewardPeriod(suite.ctx, contract, 0)
+	suite.Require().True(found)
+	suite.Require().Equal(uint64(1), rp.GasMeters)
+
+	rp, found = suite.app.IncentivesKeeper.GetRewardPeriod(suite.ctx, contract, 1)
+	suite.Require().True(found)
+	suite.Require().Equal(uint64(1), rp.GasMeters)
+	suite.Require().Equal(sdk.NewDec(50), rp.TotalWeight)
+}
+
+func (suite *KeeperTestSuite) TestClaimRewards() {
+	const mintAmount int64 = 1000
+
+	suite.SetupTest()
+
+	_, err := suite.app.IncentivesKeeper.ClaimRewards(suite.ctx, participant)
+	suite.Require().ErrorIs(err, types.ErrNoIncentiveRewards)
+
+	err = suite.app.BankKeeper.MintCoins(
+		suite.ctx,
+		types.ModuleName,
+		sdk.NewCoins(sdk.NewInt64Coin(denomCoin, mintAmount)),
+	)
+	suite.Require().NoError(err)
+
+	_, err = suite.app.IncentivesKeeper.RegisterIncentive(
+		suite.ctx,
+		contract,
+		allocations,
+		epochs,
+		types.DefaultWeighting(),
+		types.DefaultEligibility(),
+	)
+	suite.Require().NoError(err)
+
+	suite.addGasUsed(contract, participant, 100)
+	suite.addGasUsed(contract, participant2, 300)
+
+	err = suite.app.IncentivesKeeper.DistributeRewards(suite.ctx)
+	suite.Require().NoError(err)
+
+	// the allocation is reserved for the participants of the period
+	allocation := sdk.NewCoins(sdk.NewInt64Coin(denomCoin, mintAmount*allocationRate/100))
+	suite.Require().Equal(allocation, suite.app.IncentivesKeeper.GetOutstandingRewards(suite.ctx))
+
+	rewards, err := suite.app.IncentivesKeeper.ClaimRewards(suite.ctx, participant)
+	suite.Require().NoError(err)
+	suite.Require().Equal(sdk.NewCoins(sdk.NewInt64Coin(denomCoin, 12)), rewards)
+
+	balance := suite.app.BankKeeper.GetBalance(suite.ctx, sdk.AccAddress(participant.Bytes()), denomCoin)
+	suite.Require().Equal(int64(12), balance.Amount.Int64())
+
+	// rewards can't be claimed twice
+	_, err = suite.app.IncentivesKeeper.ClaimRewards(suite.ctx, participant)
+	suite.Require().ErrorIs(err, types.ErrNoIncentiveRewards)
+
+	rewards, err = suite.app.IncentivesKeeper.ClaimRewards(suite.ctx, participant2)
+	suite.Require().NoError(err)
+	suite.Require().Equal(sdk.NewCoins(sdk.NewInt64Coin(denomCoin, 37)), rewards)
+
+	// once all gas meters are settled, the period is removed and the truncated
+	// remainder is released to the inflation pool
+	_, found := suite.app.IncentivesKeeper.GetRewardPeriod(suite.ctx, contract, 0)
+	suite.Require().False(found)
+	suite.Require().True(suite.app.IncentivesKeeper.GetOutstandingRewards(suite.ctx).IsZero())
+	suite.Require().Empty(suite.app.IncentivesKeeper.GetAllAccruedRewards(suite.ctx))
+}
+
+func (suite *KeeperTestSuite) TestClaimRewardsOutstandingExcludedFromPool() {
+	const mintAmount int64 = 1000
+
+	suite.SetupTest()
+
+	err := suite.app.BankKeeper.MintCoins(
+		suite.ctx,
+		types.ModuleName,
+		sdk.NewCoins(sdk.NewInt64Coin(denomCoin, mintAmount)),
+	)
+	suite.Require().NoError(err)
+
+	_, err = suite.app.IncentivesKeeper.RegisterIncentive(
+		suite.ctx,
+		contract,
+		allocations,
+		epochs,
+		types.DefaultWeighting(),
+		types.DefaultEligibility(),
+	)
+	suite.Require().NoError(err)
+
+	suite.addGasUsed(contract, participant, 100)
+	err = suite.app.IncentivesKeeper.DistributeRewards(suite.ctx)
+	suite.Require().NoError(err)
+
+	suite.addGasUsed(contract, participant2, 100)
+	err = suite.app.IncentivesKeeper.DistributeRewards(suite.ctx)
+	suite.Require().NoError(err)
+
+	// the second allocation is computed without the unclaimed rewards of the
+	// first period
+	first := mintAmount * allocationRate / 100
+	second := (mintAmount - first) * allocationRate / 100
+
+	rewards, err := suite.app.IncentivesKeeper.ClaimRewards(suite.ctx, participant)
+	suite.Require().NoError(err)
+	suite.Require().Equal(first, rewards.AmountOf(denomCoin).Int64())
+
+	rewards, err = suite.app.IncentivesKeeper.ClaimRewards(suite.ctx, participant2)
+	suite.Require().NoError(err)
+	suite.Require().Equal(second, rewards.AmountOf(denomCoin).Int64())
+}
+
+func (suite *KeeperTestSuite) TestCancelIncentiveRewardPeriods() {
+	const mintAmount int64 = 1000
+
+	suite.SetupTest()
+
+	err := suite.app.BankKeeper.MintCoins(
+		suite.ctx,
+		types.ModuleName,
+		sdk.NewCoins(sdk.NewInt64Coin(denomCoin, mintAmount)),
+	)
+	suite.Require().NoError(err)
+
+	_, err = suite.app.IncentivesKeeper.RegisterIncentive(
+		suite.ctx,
+		contract,
+		allocations,
+		epochs,
+		types.DefaultWeighting(),
+		types.DefaultEligibility(),
+	)
+	suite.Require().NoError(err)
+
+	suite.addGasUsed(contract, participant, 100)
+	err = suite.app.IncentivesKeeper.DistributeRewards(suite.ctx)
+	suite.Require().NoError(err)
+
+	suite.addGasUsed(contract, participant2, 100)
+	err = suite.app.IncentivesKeeper.CancelIncentive(suite.ctx, contract)
+	suite.Require().NoError(err)
+
+	// the ongoing period is discarded
+	_, found := suite.app.IncentivesKeeper.GetGasMeter(suite.ctx, contract, participant2)
+	suite.Require().False(found)
+	_, found = suite.app.IncentivesKeeper.GetRewardPeriod(suite.ctx, contract, 1)
+	suite.Require().False(found)
+
+	// registering the contract again doesn't reuse the finalized period
+	incentive, err := suite.app.IncentivesKeeper.RegisterIncentive(
+		suite.ctx,
+		contract,
+		allocations,
+		epochs,
+		types.DefaultWeighting(),
+		types.DefaultEligibility(),
+	)
+	suite.Require().NoError(err)
+	suite.Require().Equal(uint64(1), incentive.Period)
+
+	// the rewards of the finalized period remain claimable
+	rewards, err := suite.app.IncentivesKeeper.ClaimRewards(suite.ctx, participant)
+	suite.Require().NoError(err)
+	suite.Require().Equal(mintAmount*allocationRate/100, rewards.AmountOf(denomCoin).Int64())
+}
+
+func (suite *KeeperTestSuite) TestExpireRewardPeriods() {
+	const fundAmount int64 = 1000
+
+	suite.SetupTest()
+
+	// rewards don't expire by default
+	expiry := 24 * time.Hour
+	params := suite.app.IncentivesKeeper.GetParams(suite.ctx)
+	params.RewardExpiry = expiry
+	suite.app.IncentivesKeeper.SetParams(suite.ctx, params)
+
+	funder := sdk.AccAddress(participant2.Bytes())
+	fundCoins := sdk.NewCoins(sdk.NewInt64Coin(denomCoin, fundAmount))
+
+	_, err := suite.app.IncentivesKeeper.RegisterIncentive(
+		suite.ctx,
+		contract,
+		sdk.DecCoins{},
+		1,
+		types.DefaultWeighting(),
+		types.DefaultEligibility(),
+	)
+	suite.Require().NoError(err)
+
+	err = testutil.FundAccount(suite.ctx, suite.app.BankKeeper, funder, fundCoins)
+	suite.Require().NoError(err)
+
+	_, err = suite.app.IncentivesKeeper.FundIncentive(
+		sdk.WrapSDKContext(suite.ctx),
+		types.NewMsgFundIncentive(contract, fundCoins, funder),
+	)
+	suite.Require().NoError(err)
+
+	suite.addGasUsed(contract, participant, 100)
+
+	err = suite.app.IncentivesKeeper.DistributeRewards(suite.ctx)
+	suite.Require().NoError(err)
+
+	// the funds are reserved for the participant of the finalized period
+	rp, found := suite.app.IncentivesKeeper.GetRewardPeriod(suite.ctx, contract, 0)
+	suite.Require().True(found)
+	suite.Require().Equal(fundCoins, rp.FundRemaining)
+	suite.Require().Equal(suite.ctx.BlockTime(), rp.EndTime)
+
+	// the period is retained until the reward expiry passed
+	suite.ctx = suite.ctx.WithBlockTime(rp.EndTime.Add(expiry - 1))
+	err = suite.app.IncentivesKeeper.DistributeRewards(suite.ctx)
+	suite.Require().NoError(err)
+	_, found = suite.app.IncentivesKeeper.GetRewardPeriod(suite.ctx, contract, 0)
+	suite.Require().True(found)
+
+	// the unclaimed funded rewards are refunded to the funder once the period
+	// expired
+	suite.ctx = suite.ctx.WithBlockTime(rp.EndTime.Add(expiry))
+	err = suite.app.IncentivesKeeper.DistributeRewards(suite.ctx)
+	suite.Require().NoError(err)
+
+	suite.Require().True(suite.app.IncentivesKeeper.GetOutstandingRewards(suite.ctx).IsZero())
+	suite.Require().Equal(fundCoins[0], suite.app.BankKeeper.GetBalance(suite.ctx, funder, denomCoin))
+
+	// the gas meter of the expired period is kept until it is settled
+	rp, found = suite.app.IncentivesKeeper.GetRewardPeriod(suite.ctx, contract, 0)
+	suite.Require().True(found)
+	suite.Require().True(rp.Remaining.IsZero())
+	_, found = suite.app.IncentivesKeeper.GetGasMeter(suite.ctx, contract, participant)
+	suite.Require().True(found)
+
+	// the expired period is not released again
+	suite.ctx = suite.ctx.WithBlockTime(rp.EndTime.Add(2 * expiry))
+	err = suite.app.IncentivesKeeper.DistributeRewards(suite.ctx)
+	suite.Require().NoError(err)
+	suite.Require().Equal(fundCoins[0], suite.app.BankKeeper.GetBalance(suite.ctx, funder, denomCoin))
+
+	// the gas meter is settled without rewards when claiming, which removes
+	// the expired period
+	_, err = suite.app.IncentivesKeeper.ClaimRewards(suite.ctx, participant)
+	suite.Require().ErrorIs(err, types.ErrNoIncentiveRewards)
+
+	_, found = suite.app.IncentivesKeeper.GetRewardPeriod(suite.ctx, contract, 0)
+	suite.Require().False(found)
+	_, found = suite.app.IncentivesKeeper.GetGasMeter(suite.ctx, contract, participant)
+	suite.Require().False(found)
+}
@@ -0,0 +1,83 @@
+package v2
+
+import (
+	"github.com/cosmos/cosmos-sdk/codec"
+	"github.com/cosmos/cosmos-sdk/store/prefix"
+	storetypes "github.com/cosmos/cosmos-sdk/store/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	paramtypes "github.com/cosmos/cosmos-sdk/x/params/types"
+	"github.com/ethereum/go-ethereum/common"
+
+	"github.com/evmos/evmos/v10/x/incentives/types"
+)
+
+// UpdateParams sets the default values of the module parameters introduced
+// in consensus version 2.
+func UpdateParams(ctx sdk.Context, paramstore *paramtypes.Subspace) error {
+	if !paramstore.HasKeyTable() {
+		ps := paramstore.WithKeyTable(types.ParamKeyTable())
+		paramstore = &ps
+	}
+
+	params := types.DefaultParams()
+	paramstore.Set(ctx, types.ParamStoreKeyPayoutHistoryPeriods, params.PayoutHistoryPeriods)
+	paramstore.Set(ctx, types.ParamStoreKeyEnableInternalGasAttribution, params.EnableInternalGasAttribution)
+	paramstore.Set(ctx, types.ParamStoreKeyRewardExpiry, params.RewardExpiry)
+	paramstore.Set(ctx, types.ParamStoreKeyMinFund, params.MinFund)
+	paramstore.Set(ctx, types.ParamStoreKeyMaxFunders, params.MaxFunders)
+	return nil
+}
+
+// MigrateRewardPeriods creates the reward period of the ongoing epoch for each
+// registered incentive from its gas meters, so that they are rewarded through
+// the reward index introduced in consensus version 2. It also indexes all gas
+// meters by participant. The gas meters stored before the migration belong to
+// the first reward period.
+func MigrateRewardPeriods(ctx sdk.Context, storeKey storetypes.StoreKey, cdc codec.BinaryCodec) error {
+	store := ctx.KVStore(storeKey)
+	incentiveStore := prefix.NewStore(store, types.KeyPrefixIncentive)
+	gasMeterStore := prefix.NewStore(store, types.KeyPrefixGasMeter)
+	rewardPeriodStore := prefix.NewStore(store, types.KeyPrefixRewardPeriod)
+	indexStore := prefix.NewStore(store, types.KeyPrefixParticipantGasMeter)
+
+	iterator := incentiveStore.Iterator(nil, nil)
+	defer iterator.Close()
+
+	var incentives []types.Incentive
+	for ; iterator.Valid(); iterator.Next() {
+		var incentive types.Incentive
+		if err := cdc.Unmarshal(iterator.Value(), &incentive); err != nil {
+			return err
+		}
+		incentives = append(incentives, incentive)
+	}
+
+	for _, incentive := range incentives {
+		contract := common.HexToAddress(incentive.Contract)
+		rp := types.NewRewardPeriod(contract, incentive.Period, incentive.Weighting)
+
+		gmIterator := sdk.KVStorePrefixIterator(gasMeterStore, contract.Bytes())
+		for ; gmIterator.Valid(); gmIterator.Next() {
+			_, participant := types.SplitGasMeterKey(gmIterator.Key())
+			cumulativeGas := sdk.BigEndianToUint64(gmIterator.Value())
+
+			rp.TotalWeight = rp.TotalWeight.Add(rp.Weighting.Weight(cumulativeGas))
+			rp.GasMeters++
+
+			indexStore.Set(append(participant.Bytes(), contract.Bytes()...), []byte{1})
+		}
+		gmIterator.Close()
+
+		if rp.GasMeters == 0 {
+			continue
+		}
+
+		bz, err := cdc.Marshal(&rp)
+		if err != nil {
+			return err
+		}
+		rewardPeriodStore.Set(append(contract.Bytes(), sdk.Uint64ToBigEndian(rp.Period)...), bz)
+	}
+
+	return nil
+}
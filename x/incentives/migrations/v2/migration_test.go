@@ -0,0 +1,117 @@
+package v2_test
+
+import (
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/cosmos/cosmos-sdk/store/prefix"
+	"github.com/cosmos/cosmos-sdk/testutil"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	paramtypes "github.com/cosmos/cosmos-sdk/x/params/types"
+	"github.com/ethereum/go-ethereum/common"
+
+	"github.com/evmos/ethermint/encoding"
+	"github.com/evmos/ethermint/tests"
+
+	"github.com/evmos/evmos/v10/app"
+	v2 "github.com/evmos/evmos/v10/x/incentives/migrations/v2"
+	"github.com/evmos/evmos/v10/x/incentives/types"
+)
+
+func TestMigrateRewardPeriods(t *testing.T) {
+	encCfg := encoding.MakeConfig(app.ModuleBasics)
+	incentivesKey := sdk.NewKVStoreKey(types.StoreKey)
+	tIncentivesKey := sdk.NewTransientStoreKey(fmt.Sprintf("%s_test", types.StoreKey))
+	ctx := testutil.DefaultContext(incentivesKey, tIncentivesKey)
+	store := ctx.KVStore(incentivesKey)
+
+	contract := tests.GenerateAddress()
+	emptyContract := tests.GenerateAddress()
+	participant := tests.GenerateAddress()
+	participant2 := tests.GenerateAddress()
+
+	weighting := types.NewWeighting(types.WEIGHTING_STRATEGY_SQRT_GAS, 0)
+	for _, c := range []common.Address{contract, emptyContract} {
+		incentive := types.Incentive{Contract: c.String(), Epochs: 10, Weighting: weighting}
+		store.Set(append(types.KeyPrefixIncentive, c.Bytes()...), encCfg.Codec.MustMarshal(&incentive))
+	}
+
+	// gas meters stored before the migration only contain the cumulative gas
+	gasMeterStore := prefix.NewStore(store, types.KeyPrefixGasMeter)
+	gasMeterStore.Set(append(contract.Bytes(), participant.Bytes()...), sdk.Uint64ToBigEndian(16))
+	gasMeterStore.Set(append(contract.Bytes(), participant2.Bytes()...), sdk.Uint64ToBigEndian(81))
+
+	err := v2.MigrateRewardPeriods(ctx, incentivesKey, encCfg.Codec)
+	require.NoError(t, err)
+
+	rewardPeriodStore := prefix.NewStore(store, types.KeyPrefixRewardPeriod)
+	bz := rewardPeriodStore.Get(append(contract.Bytes(), sdk.Uint64ToBigEndian(0)...))
+	require.NotNil(t, bz)
+
+	var rp types.RewardPeriod
+	encCfg.Codec.MustUnmarshal(bz, &rp)
+	require.Equal(t, uint64(2), rp.GasMeters)
+	require.Equal(t, weighting, rp.Weighting)
+	require.Equal(t, sdk.NewDec(13), rp.TotalWeight)
+
+	// no reward period is created for incentives without gas meters
+	require.False(t, rewardPeriodStore.Has(append(emptyContract.Bytes(), sdk.Uint64ToBigEndian(0)...)))
+
+	// the gas meters are indexed by participant
+	indexStore := prefix.NewStore(store, types.KeyPrefixParticipantGasMeter)
+	require.True(t, indexStore.Has(append(participant.Bytes(), contract.Bytes()...)))
+	require.True(t, indexStore.Has(append(participant2.Bytes(), contract.Bytes()...)))
+}
+
+func TestUpdateParams(t *testing.T) {
+	encCfg := encoding.MakeConfig(app.ModuleBasics)
+	incentivesKey := sdk.NewKVStoreKey(types.StoreKey)
+	tIncentivesKey := sdk.NewTransientStoreKey(fmt.Sprintf("%s_test", types.StoreKey))
+	ctx := testutil.DefaultContext(incentivesKey, tIncentivesKey)
+	paramstore := paramtypes.NewSubspace(
+		encCfg.Codec, encCfg.Amino, incentivesKey, tIncentivesKey, "incentives",
+	)
+	paramstore = paramstore.WithKeyTable(types.ParamKeyTable())
+	require.True(t, paramstore.HasKeyTable())
+
+	// check no params
+	require.False(t, paramstore.Has(ctx, types.ParamStoreKeyPayoutHistoryPeriods))
+	require.False(t, paramstore.Has(ctx, types.ParamStoreKeyEnableInternalGasAttribution))
+	require.False(t, paramstore.Has(ctx, types.ParamStoreKeyRewardExpiry))
+	require.False(t, paramstore.Has(ctx, types.ParamStoreKeyMinFund))
+	require.False(t, paramstore.Has(ctx, types.ParamStoreKeyMaxFunders))
+
+	// Run migrations
+	err := v2.UpdateParams(ctx, &paramstore)
+	require.NoError(t, err)
+
+	// Make sure the params are set
+	require.True(t, paramstore.Has(ctx, types.ParamStoreKeyPayoutHistoryPeriods))
+	require.True(t, paramstore.Has(ctx, types.ParamStoreKeyEnableInternalGasAttribution))
+	require.True(t, paramstore.Has(ctx, types.ParamStoreKeyRewardExpiry))
+	require.True(t, paramstore.Has(ctx, types.ParamStoreKeyMinFund))
+	require.True(t, paramstore.Has(ctx, types.ParamStoreKeyMaxFunders))
+
+	var (
+		payoutHistoryPeriods         uint64
+		enableInternalGasAttribution bool
+		rewardExpiry                 time.Duration
+		minFund                      sdk.Coins
+		maxFunders                   uint32
+	)
+	require.NotPanics(t, func() {
+		paramstore.Get(ctx, types.ParamStoreKeyPayoutHistoryPeriods, &payoutHistoryPeriods)
+		paramstore.Get(ctx, types.ParamStoreKeyEnableInternalGasAttribution, &enableInternalGasAttribution)
+		paramstore.Get(ctx, types.ParamStoreKeyRewardExpiry, &rewardExpiry)
+		paramstore.Get(ctx, types.ParamStoreKeyMinFund, &minFund)
+		paramstore.Get(ctx, types.ParamStoreKeyMaxFunders, &maxFunders)
+	})
+	require.Equal(t, types.DefaultParams().PayoutHistoryPeriods, payoutHistoryPeriods)
+	require.False(t, enableInternalGasAttribution)
+	require.Equal(t, types.DefaultParams().RewardExpiry, rewardExpiry)
+	require.Equal(t, types.DefaultParams().MinFund, minFund)
+	require.Equal(t, types.DefaultParams().MaxFunders, maxFunders)
+}
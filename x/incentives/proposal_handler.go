@@ -23,6 +23,8 @@ func NewIncentivesProposalHandler(k *keeper.Keeper) govv1beta1.Handler {
 			return handleRegisterIncentiveProposal(ctx, k, c)
 		case *types.CancelIncentiveProposal:
 			return handleCancelIncentiveProposal(ctx, k, c)
+		case *types.UpdateIncentiveProposal:
+			return handleUpdateIncentiveProposal(ctx, k, c)
 		default:
 			return errorsmod.Wrapf(
 				errortypes.ErrUnknownRequest,
@@ -33,7 +35,7 @@ func NewIncentivesProposalHandler(k *keeper.Keeper) govv1beta1.Handler {
 }
 
 func handleRegisterIncentiveProposal(ctx sdk.Context, k *keeper.Keeper, p *types.RegisterIncentiveProposal) error {
-	in, err := k.RegisterIncentive(ctx, common.HexToAddress(p.Contract), p.Allocations, p.Epochs)
+	in, err := k.RegisterIncentive(ctx, common.HexToAddress(p.Contract), p.Allocations, p.Epochs, p.Weighting, p.Eligibility)
 	if err != nil {
 		return err
 	}
@@ -45,6 +47,7 @@ func handleRegisterIncentiveProposal(ctx sdk.Context, k *keeper.Keeper, p *types
 				types.AttributeKeyEpochs,
 				strconv.FormatUint(uint64(in.Epochs), 10),
 			),
+			sdk.NewAttribute(types.AttributeKeyWeightingStrategy, in.Weighting.Strategy.String()),
 		),
 	)
 	return nil
@@ -63,3 +66,21 @@ func handleCancelIncentiveProposal(ctx sdk.Context, k *keeper.Keeper, p *types.C
 	)
 	return nil
 }
+
+func handleUpdateIncentiveProposal(ctx sdk.Context, k *keeper.Keeper, p *types.UpdateIncentiveProposal) error {
+	in, err := k.UpdateIncentive(ctx, common.HexToAddress(p.Contract), p.Allocations, p.Epochs)
+	if err != nil {
+		return err
+	}
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeUpdateIncentive,
+			sdk.NewAttribute(types.AttributeKeyContract, in.Contract),
+			sdk.NewAttribute(
+				types.AttributeKeyEpochs,
+				strconv.FormatUint(uint64(in.Epochs), 10),
+			),
+		),
+	)
+	return nil
+}
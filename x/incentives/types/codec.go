@@ -3,21 +3,58 @@ package types
 import (
 	"github.com/cosmos/cosmos-sdk/codec"
 	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/msgservice"
 	govv1beta1 "github.com/cosmos/cosmos-sdk/x/gov/types/v1beta1"
 )
 
-// ModuleCdc references the global incentives module codec. Note, the codec
-// should ONLY be used in certain instances of tests and for JSON encoding.
-//
-// The actual codec used for serialization should be provided to
-// modules/incentives and defined at the application level.
-var ModuleCdc = codec.NewProtoCodec(codectypes.NewInterfaceRegistry())
+var (
+	amino = codec.NewLegacyAmino()
+
+	// ModuleCdc references the global incentives module codec. Note, the codec
+	// should ONLY be used in certain instances of tests and for JSON encoding.
+	//
+	// The actual codec used for serialization should be provided to
+	// modules/incentives and defined at the application level.
+	ModuleCdc = codec.NewProtoCodec(codectypes.NewInterfaceRegistry())
+
+	// AminoCdc is a amino codec created to support amino JSON compatible msgs.
+	AminoCdc = codec.NewAminoCodec(amino)
+)
+
+const (
+	// Amino names
+	fundIncentiveName         = "evmos/MsgFundIncentive"
+	claimIncentiveRewardsName = "evmos/MsgClaimIncentiveRewards"
+)
+
+// NOTE: This is required for the GetSignBytes function
+func init() {
+	RegisterLegacyAminoCodec(amino)
+	amino.Seal()
+}
 
 // RegisterInterfaces register implementations
 func RegisterInterfaces(registry codectypes.InterfaceRegistry) {
+	registry.RegisterImplementations(
+		(*sdk.Msg)(nil),
+		&MsgFundIncentive{},
+		&MsgClaimIncentiveRewards{},
+	)
 	registry.RegisterImplementations(
 		(*govv1beta1.Content)(nil),
 		&RegisterIncentiveProposal{},
 		&CancelIncentiveProposal{},
+		&UpdateIncentiveProposal{},
 	)
+
+	msgservice.RegisterMsgServiceDesc(registry, &_Msg_serviceDesc)
+}
+
+// RegisterLegacyAminoCodec registers the necessary x/incentives interfaces and
+// concrete types on the provided LegacyAmino codec. These types are used for
+// Amino JSON serialization and EIP-712 compatibility.
+func RegisterLegacyAminoCodec(cdc *codec.LegacyAmino) {
+	cdc.RegisterConcrete(&MsgFundIncentive{}, fundIncentiveName, nil)
+	cdc.RegisterConcrete(&MsgClaimIncentiveRewards{}, claimIncentiveRewardsName, nil)
 }
@@ -0,0 +1,40 @@
+package types
+
+import (
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// NewEligibility returns an instance of Eligibility
+func NewEligibility(
+	minStake sdk.Int,
+	minParticipationAge uint64,
+	minBalance sdk.Coins,
+) Eligibility {
+	return Eligibility{
+		MinStake:            minStake,
+		MinParticipationAge: minParticipationAge,
+		MinBalance:          minBalance,
+	}
+}
+
+// DefaultEligibility returns an Eligibility without any rule, so that every
+// participant is eligible
+func DefaultEligibility() Eligibility {
+	return NewEligibility(sdk.ZeroInt(), 0, nil)
+}
+
+// Validate performs a stateless validation of an Eligibility
+func (e Eligibility) Validate() error {
+	if !e.MinStake.IsNil() && e.MinStake.IsNegative() {
+		return fmt.Errorf("minimum stake cannot be negative: %s", e.MinStake)
+	}
+
+	return e.MinBalance.Validate()
+}
+
+// HasMinStake returns true if the participants must have a minimum stake
+func (e Eligibility) HasMinStake() bool {
+	return !e.MinStake.IsNil() && e.MinStake.IsPositive()
+}
@@ -0,0 +1,85 @@
+package types
+
+import (
+	"testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/suite"
+
+	"github.com/evmos/ethermint/tests"
+)
+
+type EligibilityTestSuite struct {
+	suite.Suite
+}
+
+func TestEligibilitySuite(t *testing.T) {
+	suite.Run(t, new(EligibilityTestSuite))
+}
+
+func (suite *EligibilityTestSuite) TestEligibilityValidate() {
+	testCases := []struct {
+		name        string
+		eligibility Eligibility
+		expectPass  bool
+	}{
+		{"empty", Eligibility{}, true},
+		{"default", DefaultEligibility(), true},
+		{
+			"all rules",
+			NewEligibility(sdk.NewInt(100), 1000, sdk.NewCoins(sdk.NewInt64Coin("aevmos", 1))),
+			true,
+		},
+		{"negative minimum stake", NewEligibility(sdk.NewInt(-1), 0, nil), false},
+		{
+			"invalid minimum balance denom",
+			NewEligibility(sdk.ZeroInt(), 0, sdk.Coins{{Denom: "1", Amount: sdk.OneInt()}}),
+			false,
+		},
+		{
+			"zero minimum balance",
+			NewEligibility(sdk.ZeroInt(), 0, sdk.Coins{{Denom: "aevmos", Amount: sdk.ZeroInt()}}),
+			false,
+		},
+	}
+
+	for _, tc := range testCases {
+		err := tc.eligibility.Validate()
+
+		if tc.expectPass {
+			suite.Require().NoError(err, tc.name)
+		} else {
+			suite.Require().Error(err, tc.name)
+		}
+	}
+}
+
+func (suite *EligibilityTestSuite) TestEligibilityHasMinStake() {
+	suite.Require().False(Eligibility{}.HasMinStake())
+	suite.Require().False(DefaultEligibility().HasMinStake())
+	suite.Require().True(NewEligibility(sdk.OneInt(), 0, nil).HasMinStake())
+}
+
+func (suite *EligibilityTestSuite) TestParticipantValidate() {
+	testCases := []struct {
+		name        string
+		participant Participant
+		expectPass  bool
+	}{
+		{"valid", NewParticipant(tests.GenerateAddress(), 10), true},
+		{"valid - genesis height", NewParticipant(tests.GenerateAddress(), 0), true},
+		{"invalid address", Participant{"0x5dCA2483280D9727c80b5518faC4556617fb19", 10, 10}, false},
+		{"negative height", NewParticipant(tests.GenerateAddress(), -1), false},
+		{"last height lower than first height", Participant{Address: tests.GenerateAddress().String(), FirstHeight: 10, LastHeight: 9}, false},
+	}
+
+	for _, tc := range testCases {
+		err := tc.participant.Validate()
+
+		if tc.expectPass {
+			suite.Require().NoError(err, tc.name)
+		} else {
+			suite.Require().Error(err, tc.name)
+		}
+	}
+}
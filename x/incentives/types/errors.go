@@ -6,5 +6,8 @@ import (
 
 // errors
 var (
-	ErrInternalIncentive = errorsmod.Register(ModuleName, 2, "internal incentives error")
+	ErrInternalIncentive  = errorsmod.Register(ModuleName, 2, "internal incentives error")
+	ErrNoIncentiveRewards = errorsmod.Register(ModuleName, 3, "no incentive rewards to claim")
+	ErrInsufficientFund   = errorsmod.Register(ModuleName, 4, "fund amount is lower than the minimum fund")
+	ErrMaxFundersReached  = errorsmod.Register(ModuleName, 5, "maximum number of incentive funders reached")
 )
@@ -2,10 +2,20 @@ package types
 
 // incentives events
 const (
-	EventTypeRegisterIncentive    = "register_incentive"
-	EventTypeCancelIncentive      = "cancel_incentive"
-	EventTypeDistributeIncentives = "distribute_incentives"
+	EventTypeRegisterIncentive     = "register_incentive"
+	EventTypeCancelIncentive       = "cancel_incentive"
+	EventTypeUpdateIncentive       = "update_incentive"
+	EventTypeDistributeIncentives  = "distribute_incentives"
+	EventTypeFundIncentive         = "fund_incentive"
+	EventTypeRefundIncentiveFund   = "refund_incentive_fund"
+	EventTypeClaimIncentiveRewards = "claim_incentive_rewards"
+	EventTypeExpireRewardPeriod    = "expire_reward_period"
 
-	AttributeKeyContract = "contract"
-	AttributeKeyEpochs   = "epochs"
+	AttributeKeyContract          = "contract"
+	AttributeKeyEpochs            = "epochs"
+	AttributeKeyWeightingStrategy = "weighting_strategy"
+	AttributeKeyFunder            = "funder"
+	AttributeKeyAmount            = "amount"
+	AttributeKeyParticipant       = "participant"
+	AttributeKeyPeriod            = "period"
 )
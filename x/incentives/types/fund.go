@@ -0,0 +1,56 @@
+package types
+
+import (
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/ethereum/go-ethereum/common"
+	ethermint "github.com/evmos/ethermint/types"
+)
+
+// NewFund returns an instance of Fund
+func NewFund(
+	contract common.Address,
+	funder sdk.AccAddress,
+	amount sdk.Coins,
+) Fund {
+	return Fund{
+		Contract: contract.String(),
+		Funder:   funder.String(),
+		Amount:   amount,
+	}
+}
+
+// Validate performs a stateless validation of a Fund
+func (f Fund) Validate() error {
+	if err := ethermint.ValidateAddress(f.Contract); err != nil {
+		return err
+	}
+
+	if _, err := sdk.AccAddressFromBech32(f.Funder); err != nil {
+		return fmt.Errorf("invalid funder address %s: %w", f.Funder, err)
+	}
+
+	if f.Amount.Empty() {
+		return fmt.Errorf("fund amount cannot be empty")
+	}
+
+	return f.Amount.Validate()
+}
+
+// EpochAmount returns the amount of the fund to be distributed during the
+// current epoch, given the remaining epochs of the incentive. The amount is
+// split evenly among the remaining epochs and the last epoch distributes the
+// whole remaining amount.
+func (f Fund) EpochAmount(epochs uint32) sdk.Coins {
+	if epochs <= 1 {
+		return f.Amount
+	}
+
+	amount := sdk.Coins{}
+	for _, coin := range f.Amount {
+		amount = amount.Add(sdk.NewCoin(coin.Denom, coin.Amount.QuoRaw(int64(epochs))))
+	}
+
+	return amount
+}
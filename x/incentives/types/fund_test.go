@@ -0,0 +1,72 @@
+package types
+
+import (
+	"testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/suite"
+
+	"github.com/evmos/ethermint/tests"
+)
+
+type FundTestSuite struct {
+	suite.Suite
+}
+
+func TestFundSuite(t *testing.T) {
+	suite.Run(t, new(FundTestSuite))
+}
+
+func (suite *FundTestSuite) TestFundValidate() {
+	funder := sdk.AccAddress(tests.GenerateAddress().Bytes())
+	amount := sdk.NewCoins(sdk.NewInt64Coin("aevmos", 100))
+
+	testCases := []struct {
+		name       string
+		fund       Fund
+		expectPass bool
+	}{
+		{"valid", NewFund(tests.GenerateAddress(), funder, amount), true},
+		{"invalid contract", Fund{"0xinvalidaddress", funder.String(), amount}, false},
+		{"invalid funder", Fund{tests.GenerateAddress().String(), "evmos1invalid", amount}, false},
+		{"empty amount", NewFund(tests.GenerateAddress(), funder, sdk.Coins{}), false},
+		{
+			"invalid amount",
+			NewFund(tests.GenerateAddress(), funder, sdk.Coins{{Denom: "aevmos", Amount: sdk.ZeroInt()}}),
+			false,
+		},
+	}
+
+	for _, tc := range testCases {
+		err := tc.fund.Validate()
+
+		if tc.expectPass {
+			suite.Require().NoError(err, tc.name)
+		} else {
+			suite.Require().Error(err, tc.name)
+		}
+	}
+}
+
+func (suite *FundTestSuite) TestFundEpochAmount() {
+	fund := NewFund(
+		tests.GenerateAddress(),
+		sdk.AccAddress(tests.GenerateAddress().Bytes()),
+		sdk.NewCoins(sdk.NewInt64Coin("aevmos", 100), sdk.NewInt64Coin("acoin", 5)),
+	)
+
+	testCases := []struct {
+		name      string
+		epochs    uint32
+		expAmount sdk.Coins
+	}{
+		{"last epoch", 1, fund.Amount},
+		{"no remaining epochs", 0, fund.Amount},
+		{"split evenly", 4, sdk.NewCoins(sdk.NewInt64Coin("aevmos", 25), sdk.NewInt64Coin("acoin", 1))},
+		{"rounded down", 6, sdk.NewCoins(sdk.NewInt64Coin("aevmos", 16))},
+	}
+
+	for _, tc := range testCases {
+		suite.Require().Equal(tc.expAmount, fund.EpochAmount(tc.epochs), tc.name)
+	}
+}
@@ -65,6 +65,7 @@ func (suite *GasMeterTestSuite) TestGasMeter() {
 				"0x5dCA2483280D9727c80b5518faC4556617fb19ZZ",
 				tests.GenerateAddress().String(),
 				10,
+				0,
 			},
 			false,
 		},
@@ -74,6 +75,7 @@ func (suite *GasMeterTestSuite) TestGasMeter() {
 				tests.GenerateAddress().String(),
 				"0x5dCA2483280D9727c80b5518faC4556617fb19ZZ",
 				10,
+				0,
 			},
 			false,
 		},
@@ -83,6 +85,7 @@ func (suite *GasMeterTestSuite) TestGasMeter() {
 				"0x5dCA2483280D9727c80b5518faC4556617fb19",
 				tests.GenerateAddress().String(),
 				10,
+				0,
 			},
 			false,
 		},
@@ -92,6 +95,7 @@ func (suite *GasMeterTestSuite) TestGasMeter() {
 				"0x5dCA2483280D9727c80b5518faC4556617fb194FFF",
 				tests.GenerateAddress().String(),
 				10,
+				0,
 			},
 			false,
 		},
@@ -101,6 +105,7 @@ func (suite *GasMeterTestSuite) TestGasMeter() {
 				tests.GenerateAddress().String(),
 				tests.GenerateAddress().String(),
 				10,
+				0,
 			},
 			true,
 		},
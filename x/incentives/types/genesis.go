@@ -1,17 +1,33 @@
 package types
 
-import "fmt"
+import (
+	"fmt"
+
+	"github.com/ethereum/go-ethereum/common"
+)
 
 // NewGenesisState creates a new genesis state.
 func NewGenesisState(
 	params Params,
 	incentives []Incentive,
 	gasMeters []GasMeter,
+	participants []Participant,
+	funds []Fund,
+	rewardPeriods []RewardPeriod,
+	accruedRewards []AccruedRewards,
+	incentivePayouts []IncentivePayout,
+	participantPayouts []ParticipantPayout,
 ) GenesisState {
 	return GenesisState{
-		Params:     params,
-		Incentives: incentives,
-		GasMeters:  gasMeters,
+		Params:             params,
+		Incentives:         incentives,
+		GasMeters:          gasMeters,
+		Participants:       participants,
+		Funds:              funds,
+		RewardPeriods:      rewardPeriods,
+		AccruedRewards:     accruedRewards,
+		IncentivePayouts:   incentivePayouts,
+		ParticipantPayouts: participantPayouts,
 	}
 }
 
@@ -57,5 +73,133 @@ func (gs GenesisState) Validate() error {
 		seenGasMeters[gm.Contract+gm.Participant] = true
 	}
 
+	seenParticipants := make(map[string]bool)
+	for _, p := range gs.Participants {
+		// only one record per participant
+		if seenParticipants[p.Address] {
+			return fmt.Errorf("participant duplicated on genesis '%s'", p.Address)
+		}
+
+		if err := p.Validate(); err != nil {
+			return err
+		}
+
+		seenParticipants[p.Address] = true
+	}
+
+	seenFunds := make(map[string]bool)
+	for _, f := range gs.Funds {
+		// only one fund per contract+funder combination
+		if seenFunds[f.Contract+f.Funder] {
+			return fmt.Errorf(
+				"fund duplicated on genesis contract: '%s', funder: '%s'",
+				f.Contract, f.Funder,
+			)
+		}
+
+		if err := f.Validate(); err != nil {
+			return err
+		}
+
+		// funds can only be escrowed for active incentives
+		if !seenContractIn[f.Contract] {
+			return fmt.Errorf("fund for unregistered incentive on genesis '%s'", f.Contract)
+		}
+
+		seenFunds[f.Contract+f.Funder] = true
+	}
+
+	seenRewardPeriods := make(map[string]bool)
+	for _, rp := range gs.RewardPeriods {
+		// only one reward period per contract+period combination
+		key := rewardPeriodID(rp.Contract, rp.Period)
+		if seenRewardPeriods[key] {
+			return fmt.Errorf(
+				"reward period duplicated on genesis contract: '%s', period: %d",
+				rp.Contract, rp.Period,
+			)
+		}
+
+		if err := rp.Validate(); err != nil {
+			return err
+		}
+
+		seenRewardPeriods[key] = true
+	}
+
+	// every gas meter is settled against the reward period it was recorded in
+	for _, gm := range gs.GasMeters {
+		if !seenRewardPeriods[rewardPeriodID(gm.Contract, gm.Period)] {
+			return fmt.Errorf(
+				"gas meter without reward period on genesis contract: '%s', period: %d",
+				gm.Contract, gm.Period,
+			)
+		}
+	}
+
+	seenAccruedRewards := make(map[string]bool)
+	for _, ar := range gs.AccruedRewards {
+		// only one record per participant
+		if seenAccruedRewards[ar.Participant] {
+			return fmt.Errorf("accrued rewards duplicated on genesis '%s'", ar.Participant)
+		}
+
+		if err := ar.Validate(); err != nil {
+			return err
+		}
+
+		seenAccruedRewards[ar.Participant] = true
+	}
+
+	seenIncentivePayouts := make(map[string]bool)
+	for _, ip := range gs.IncentivePayouts {
+		// only one payout summary per contract+period combination
+		key := rewardPeriodID(ip.Contract, ip.Period)
+		if seenIncentivePayouts[key] {
+			return fmt.Errorf(
+				"incentive payout duplicated on genesis contract: '%s', period: %d",
+				ip.Contract, ip.Period,
+			)
+		}
+
+		if err := ip.Validate(); err != nil {
+			return err
+		}
+
+		seenIncentivePayouts[key] = true
+	}
+
+	seenParticipantPayouts := make(map[string]bool)
+	for _, pp := range gs.ParticipantPayouts {
+		// only one payout per contract+participant+period combination
+		key := rewardPeriodID(pp.Contract, pp.Period) + "/" + common.HexToAddress(pp.Participant).String()
+		if seenParticipantPayouts[key] {
+			return fmt.Errorf(
+				"participant payout duplicated on genesis contract: '%s', participant: '%s', period: %d",
+				pp.Contract, pp.Participant, pp.Period,
+			)
+		}
+
+		if err := pp.Validate(); err != nil {
+			return err
+		}
+
+		// participant payouts are pruned along with the summary of their period
+		if !seenIncentivePayouts[rewardPeriodID(pp.Contract, pp.Period)] {
+			return fmt.Errorf(
+				"participant payout without incentive payout on genesis contract: '%s', period: %d",
+				pp.Contract, pp.Period,
+			)
+		}
+
+		seenParticipantPayouts[key] = true
+	}
+
 	return gs.Params.Validate()
 }
+
+// rewardPeriodID returns a unique identifier of the reward period of a contract
+// that doesn't depend on the case of the hex address
+func rewardPeriodID(contract string, period uint64) string {
+	return fmt.Sprintf("%s/%d", common.HexToAddress(contract), period)
+}
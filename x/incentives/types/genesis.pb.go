@@ -6,17 +6,22 @@ package types
 import (
 	fmt "fmt"
 	github_com_cosmos_cosmos_sdk_types "github.com/cosmos/cosmos-sdk/types"
+	types "github.com/cosmos/cosmos-sdk/types"
 	_ "github.com/cosmos/gogoproto/gogoproto"
 	proto "github.com/gogo/protobuf/proto"
+	github_com_gogo_protobuf_types "github.com/gogo/protobuf/types"
+	_ "google.golang.org/protobuf/types/known/durationpb"
 	io "io"
 	math "math"
 	math_bits "math/bits"
+	time "time"
 )
 
 // Reference imports to suppress errors if they are not otherwise used.
 var _ = proto.Marshal
 var _ = fmt.Errorf
 var _ = math.Inf
+var _ = time.Kitchen
 
 // This is a compile-time assertion to ensure that this generated file
 // is compatible with the proto package it is being compiled against.
@@ -32,6 +37,18 @@ type GenesisState struct {
 	Incentives []Incentive `protobuf:"bytes,2,rep,name=incentives,proto3" json:"incentives"`
 	// gas_meters is a slice of active Gasmeters
 	GasMeters []GasMeter `protobuf:"bytes,3,rep,name=gas_meters,json=gasMeters,proto3" json:"gas_meters"`
+	// participants is a slice of the first interactions of addresses with incentivized contracts
+	Participants []Participant `protobuf:"bytes,4,rep,name=participants,proto3" json:"participants"`
+	// funds is a slice of the coins escrowed by third parties for active incentives
+	Funds []Fund `protobuf:"bytes,5,rep,name=funds,proto3" json:"funds"`
+	// reward_periods is a slice of the reward periods that have not been fully settled
+	RewardPeriods []RewardPeriod `protobuf:"bytes,6,rep,name=reward_periods,json=rewardPeriods,proto3" json:"reward_periods"`
+	// accrued_rewards is a slice of the settled rewards that have not been claimed
+	AccruedRewards []AccruedRewards `protobuf:"bytes,7,rep,name=accrued_rewards,json=accruedRewards,proto3" json:"accrued_rewards"`
+	// incentive_payouts is a slice of the retained payout summaries of the incentives
+	IncentivePayouts []IncentivePayout `protobuf:"bytes,8,rep,name=incentive_payouts,json=incentivePayouts,proto3" json:"incentive_payouts"`
+	// participant_payouts is a slice of the retained payouts of the participants
+	ParticipantPayouts []ParticipantPayout `protobuf:"bytes,9,rep,name=participant_payouts,json=participantPayouts,proto3" json:"participant_payouts"`
 }
 
 func (m *GenesisState) Reset()         { *m = GenesisState{} }
@@ -88,6 +105,48 @@ func (m *GenesisState) GetGasMeters() []GasMeter {
 	return nil
 }
 
+func (m *GenesisState) GetParticipants() []Participant {
+	if m != nil {
+		return m.Participants
+	}
+	return nil
+}
+
+func (m *GenesisState) GetFunds() []Fund {
+	if m != nil {
+		return m.Funds
+	}
+	return nil
+}
+
+func (m *GenesisState) GetRewardPeriods() []RewardPeriod {
+	if m != nil {
+		return m.RewardPeriods
+	}
+	return nil
+}
+
+func (m *GenesisState) GetAccruedRewards() []AccruedRewards {
+	if m != nil {
+		return m.AccruedRewards
+	}
+	return nil
+}
+
+func (m *GenesisState) GetIncentivePayouts() []IncentivePayout {
+	if m != nil {
+		return m.IncentivePayouts
+	}
+	return nil
+}
+
+func (m *GenesisState) GetParticipantPayouts() []ParticipantPayout {
+	if m != nil {
+		return m.ParticipantPayouts
+	}
+	return nil
+}
+
 // Params defines the incentives module params
 type Params struct {
 	// enable_incentives is the parameter to enable incentives
@@ -98,6 +157,22 @@ type Params struct {
 	IncentivesEpochIdentifier string `protobuf:"bytes,3,opt,name=incentives_epoch_identifier,json=incentivesEpochIdentifier,proto3" json:"incentives_epoch_identifier,omitempty"`
 	// reward_scaler is the scaling factor for capping rewards
 	RewardScaler github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,4,opt,name=reward_scaler,json=rewardScaler,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"reward_scaler"`
+	// payout_history_periods is the number of most recent reward periods of each
+	// incentive whose payouts are retained. The payout history is disabled if zero.
+	PayoutHistoryPeriods uint64 `protobuf:"varint,5,opt,name=payout_history_periods,json=payoutHistoryPeriods,proto3" json:"payout_history_periods,omitempty"`
+	// enable_internal_gas_attribution is the parameter to attribute the gas of a
+	// transaction to the incentivized contracts that emitted logs during its
+	// execution in addition to its recipient
+	EnableInternalGasAttribution bool `protobuf:"varint,6,opt,name=enable_internal_gas_attribution,json=enableInternalGasAttribution,proto3" json:"enable_internal_gas_attribution,omitempty"`
+	// reward_expiry is the duration after the end of a reward period after which
+	// the rewards of its participants that have not been settled expire. Rewards
+	// don't expire if zero.
+	RewardExpiry time.Duration `protobuf:"bytes,7,opt,name=reward_expiry,json=rewardExpiry,proto3,stdduration" json:"reward_expiry"`
+	// min_fund is the minimum amount per denomination of each coin escrowed by a
+	// MsgFundIncentive
+	MinFund github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,8,rep,name=min_fund,json=minFund,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"min_fund"`
+	// max_funders is the maximum number of funders of an incentive
+	MaxFunders uint32 `protobuf:"varint,9,opt,name=max_funders,json=maxFunders,proto3" json:"max_funders,omitempty"`
 }
 
 func (m *Params) Reset()         { *m = Params{} }
@@ -147,6 +222,41 @@ func (m *Params) GetIncentivesEpochIdentifier() string {
 	return ""
 }
 
+func (m *Params) GetPayoutHistoryPeriods() uint64 {
+	if m != nil {
+		return m.PayoutHistoryPeriods
+	}
+	return 0
+}
+
+func (m *Params) GetEnableInternalGasAttribution() bool {
+	if m != nil {
+		return m.EnableInternalGasAttribution
+	}
+	return false
+}
+
+func (m *Params) GetRewardExpiry() time.Duration {
+	if m != nil {
+		return m.RewardExpiry
+	}
+	return 0
+}
+
+func (m *Params) GetMinFund() github_com_cosmos_cosmos_sdk_types.Coins {
+	if m != nil {
+		return m.MinFund
+	}
+	return nil
+}
+
+func (m *Params) GetMaxFunders() uint32 {
+	if m != nil {
+		return m.MaxFunders
+	}
+	return 0
+}
+
 func init() {
 	proto.RegisterType((*GenesisState)(nil), "evmos.incentives.v1.GenesisState")
 	proto.RegisterType((*Params)(nil), "evmos.incentives.v1.Params")
@@ -155,33 +265,55 @@ func init() {
 func init() { proto.RegisterFile("evmos/incentives/v1/genesis.proto", fileDescriptor_7bb1f7c7e8ad160b) }
 
 var fileDescriptor_7bb1f7c7e8ad160b = []byte{
-	// 403 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x9c, 0x92, 0xc1, 0xee, 0xd2, 0x40,
-	0x10, 0x87, 0x5b, 0xf8, 0x87, 0xc8, 0x82, 0x11, 0xab, 0x87, 0x0a, 0xb1, 0x20, 0x31, 0x86, 0xc4,
-	0xb0, 0x15, 0x3c, 0x79, 0xf1, 0xd0, 0x60, 0x08, 0x89, 0x26, 0xa6, 0x9c, 0xf4, 0xd2, 0x2c, 0x65,
-	0x2c, 0x1b, 0xdb, 0x6e, 0xd3, 0x5d, 0xab, 0xbe, 0x85, 0x2f, 0xe1, 0xbb, 0x70, 0x24, 0xf1, 0x62,
-	0x3c, 0x10, 0x03, 0x2f, 0x62, 0x76, 0xb7, 0xda, 0x1e, 0x7a, 0xf2, 0xd2, 0xee, 0xb4, 0xdf, 0x7c,
-	0xbf, 0x69, 0x33, 0xe8, 0x11, 0x14, 0x09, 0xe3, 0x2e, 0x4d, 0x43, 0x48, 0x05, 0x2d, 0x80, 0xbb,
-	0xc5, 0xc2, 0x8d, 0x20, 0x05, 0x4e, 0x39, 0xce, 0x72, 0x26, 0x98, 0x75, 0x4f, 0x21, 0xb8, 0x42,
-	0x70, 0xb1, 0x18, 0x3e, 0x6e, 0xea, 0xab, 0x21, 0xaa, 0x75, 0x78, 0x3f, 0x62, 0x11, 0x53, 0x47,
-	0x57, 0x9e, 0xf4, 0xd3, 0xe9, 0x0f, 0x13, 0xf5, 0xd7, 0x3a, 0x62, 0x2b, 0x88, 0x00, 0xeb, 0x05,
-	0xea, 0x64, 0x24, 0x27, 0x09, 0xb7, 0xcd, 0x89, 0x39, 0xeb, 0x2d, 0x47, 0xb8, 0x21, 0x12, 0xbf,
-	0x55, 0x88, 0x77, 0x73, 0x3c, 0x8f, 0x0d, 0xbf, 0x6c, 0xb0, 0x56, 0x08, 0x55, 0x94, 0xdd, 0x9a,
-	0xb4, 0x67, 0xbd, 0xa5, 0xd3, 0xd8, 0xbe, 0xf9, 0x5b, 0x95, 0x86, 0x5a, 0x9f, 0xe5, 0x21, 0x14,
-	0x11, 0x1e, 0x24, 0x20, 0x20, 0xe7, 0x76, 0x5b, 0x59, 0x1e, 0x36, 0x5a, 0xd6, 0x84, 0xbf, 0x91,
-	0x54, 0x29, 0xe9, 0x46, 0x65, 0xcd, 0xa7, 0xdf, 0x5b, 0xa8, 0xa3, 0x47, 0xb4, 0x9e, 0xa2, 0xbb,
-	0x90, 0x92, 0x5d, 0x0c, 0x41, 0x6d, 0x36, 0xf9, 0x69, 0xb7, 0xfc, 0x81, 0x7e, 0xb1, 0xa9, 0xb2,
-	0xdf, 0xa1, 0x01, 0x89, 0x63, 0x16, 0x12, 0x41, 0x59, 0x1a, 0xc4, 0x34, 0xa1, 0xc2, 0x6e, 0x4d,
-	0xcc, 0x59, 0xd7, 0xc3, 0x32, 0xe2, 0xd7, 0x79, 0xfc, 0x24, 0xa2, 0xe2, 0xf0, 0x69, 0x87, 0x43,
-	0x96, 0xb8, 0x21, 0xe3, 0xf2, 0xbf, 0xeb, 0xdb, 0x9c, 0xef, 0x3f, 0xba, 0xe2, 0x6b, 0x06, 0x1c,
-	0xaf, 0x20, 0xf4, 0xef, 0x54, 0x9e, 0xd7, 0x52, 0x63, 0xbd, 0x44, 0xa3, 0x6a, 0x80, 0x00, 0x32,
-	0x16, 0x1e, 0x02, 0xba, 0x97, 0xf5, 0x07, 0x0a, 0xb9, 0xdd, 0x96, 0x29, 0xfe, 0x83, 0x0a, 0x79,
-	0x25, 0x89, 0xcd, 0x3f, 0xc0, 0xda, 0xa2, 0xdb, 0x39, 0x7c, 0x26, 0xf9, 0x3e, 0xe0, 0x21, 0x89,
-	0x21, 0xb7, 0x6f, 0xfe, 0x6b, 0xae, 0xbe, 0x96, 0x6c, 0x95, 0xc3, 0x5b, 0x1f, 0x2f, 0x8e, 0x79,
-	0xba, 0x38, 0xe6, 0xef, 0x8b, 0x63, 0x7e, 0xbb, 0x3a, 0xc6, 0xe9, 0xea, 0x18, 0x3f, 0xaf, 0x8e,
-	0xf1, 0x7e, 0x5e, 0xf3, 0xe9, 0xf5, 0xd2, 0xd7, 0x62, 0xf1, 0xcc, 0xfd, 0x52, 0x5f, 0x35, 0xa5,
-	0xde, 0x75, 0xd4, 0x36, 0x3d, 0xff, 0x13, 0x00, 0x00, 0xff, 0xff, 0x6e, 0xce, 0xce, 0x10, 0xc3,
-	0x02, 0x00, 0x00,
+	// 757 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x9c, 0x94, 0xcb, 0x6e, 0xe3, 0x36,
+	0x14, 0x86, 0xad, 0xc4, 0x76, 0x6c, 0xe6, 0xce, 0x04, 0x85, 0x9c, 0xb4, 0xb2, 0x93, 0x06, 0x81,
+	0x81, 0x22, 0x52, 0x9c, 0xb6, 0x8b, 0x6e, 0x0a, 0xc4, 0x4d, 0xe2, 0xa4, 0x68, 0x8b, 0x40, 0x59,
+	0x14, 0x2d, 0x50, 0x08, 0xb4, 0x44, 0x2b, 0x44, 0x2d, 0x51, 0x20, 0x29, 0xd7, 0x7e, 0x8b, 0x2e,
+	0xfb, 0x0c, 0x5d, 0xce, 0x53, 0x64, 0x99, 0xe5, 0x60, 0x16, 0xc9, 0x20, 0x79, 0x86, 0xd9, 0x0f,
+	0x48, 0x4a, 0xbe, 0x0c, 0x3c, 0x17, 0xcc, 0xc6, 0x16, 0xc9, 0xff, 0xff, 0xc8, 0x73, 0x78, 0x78,
+	0xc0, 0x1e, 0x1e, 0x44, 0x94, 0x3b, 0x24, 0xf6, 0x71, 0x2c, 0xc8, 0x00, 0x73, 0x67, 0xd0, 0x72,
+	0x42, 0x1c, 0x63, 0x4e, 0xb8, 0x9d, 0x30, 0x2a, 0x28, 0xdc, 0x52, 0x12, 0x7b, 0x22, 0xb1, 0x07,
+	0xad, 0x9d, 0x83, 0x79, 0xbe, 0x29, 0x89, 0xb2, 0xee, 0x58, 0x3e, 0xe5, 0x52, 0xd6, 0x45, 0x1c,
+	0x3b, 0x83, 0x56, 0x17, 0x0b, 0xd4, 0x72, 0x7c, 0x4a, 0xe2, 0x6c, 0x7d, 0x3b, 0xa4, 0x21, 0x55,
+	0x9f, 0x8e, 0xfc, 0xca, 0x5d, 0x21, 0xa5, 0x61, 0x1f, 0x3b, 0x6a, 0xd4, 0x4d, 0x7b, 0x4e, 0x90,
+	0x32, 0x24, 0x08, 0xcd, 0x5c, 0xfb, 0x2f, 0x4a, 0x60, 0xa5, 0xa3, 0x8f, 0x78, 0x23, 0x90, 0xc0,
+	0xf0, 0x07, 0x50, 0x4e, 0x10, 0x43, 0x11, 0x37, 0x8d, 0x86, 0xd1, 0x5c, 0x3e, 0xd9, 0xb5, 0xe7,
+	0x1c, 0xd9, 0xbe, 0x56, 0x92, 0x76, 0xf1, 0xee, 0xa1, 0x5e, 0x70, 0x33, 0x03, 0x3c, 0x03, 0x60,
+	0xa2, 0x32, 0x17, 0x1a, 0x8b, 0xcd, 0xe5, 0x13, 0x6b, 0xae, 0xfd, 0x2a, 0x1f, 0x65, 0x84, 0x29,
+	0x1f, 0x6c, 0x03, 0x10, 0x22, 0xee, 0x45, 0x58, 0x60, 0xc6, 0xcd, 0x45, 0x45, 0xf9, 0x6a, 0x2e,
+	0xa5, 0x83, 0xf8, 0xaf, 0x52, 0x95, 0x41, 0xaa, 0x61, 0x36, 0xe6, 0xf0, 0x67, 0xb0, 0x92, 0x20,
+	0x26, 0x88, 0x4f, 0x12, 0x14, 0x0b, 0x6e, 0x16, 0x15, 0xa5, 0xf1, 0xbe, 0x50, 0x72, 0x61, 0x06,
+	0x9a, 0xf1, 0xc2, 0xef, 0x41, 0xa9, 0x97, 0xc6, 0x01, 0x37, 0x4b, 0x0a, 0x52, 0x9b, 0x0b, 0xb9,
+	0x48, 0xe3, 0x20, 0x73, 0x6b, 0x35, 0xfc, 0x0d, 0xac, 0x31, 0xfc, 0x0f, 0x62, 0x81, 0x97, 0x60,
+	0x46, 0x68, 0xc0, 0xcd, 0xb2, 0xf2, 0xef, 0xcd, 0xf5, 0xbb, 0x4a, 0x7a, 0xad, 0x94, 0x19, 0x67,
+	0x95, 0x4d, 0xcd, 0x71, 0xe8, 0x82, 0x75, 0xe4, 0xfb, 0x2c, 0xc5, 0x81, 0xa7, 0x17, 0xb8, 0xb9,
+	0xa4, 0x80, 0x5f, 0xcf, 0x05, 0x9e, 0x6a, 0xad, 0xe6, 0xe6, 0x17, 0xb5, 0x86, 0x66, 0x66, 0xe1,
+	0xef, 0x60, 0x73, 0xec, 0xf2, 0x12, 0x34, 0xa2, 0xa9, 0xe0, 0x66, 0x45, 0x51, 0x0f, 0x3e, 0x7c,
+	0x6f, 0xd7, 0x4a, 0x9c, 0x61, 0x37, 0xc8, 0xec, 0x34, 0x87, 0x7f, 0x81, 0xad, 0xa9, 0x1c, 0x8e,
+	0xd1, 0x55, 0x85, 0x3e, 0xfc, 0xd8, 0x35, 0xcc, 0xc0, 0x61, 0xf2, 0xee, 0x02, 0xdf, 0x7f, 0x53,
+	0x04, 0x65, 0x5d, 0x81, 0xf0, 0x1b, 0xb0, 0x89, 0x63, 0xd4, 0xed, 0x63, 0x6f, 0xaa, 0xf4, 0x64,
+	0xe5, 0x56, 0xdc, 0x0d, 0xbd, 0x70, 0x35, 0x29, 0xad, 0x3f, 0xc0, 0x06, 0xea, 0xf7, 0xa9, 0xaf,
+	0x1e, 0x80, 0xd7, 0x27, 0x11, 0x11, 0xe6, 0x42, 0xc3, 0x68, 0x56, 0xdb, 0xb6, 0xdc, 0xeb, 0xd5,
+	0x43, 0xfd, 0x30, 0x24, 0xe2, 0x36, 0xed, 0xda, 0x3e, 0x8d, 0x9c, 0xec, 0xbd, 0xe9, 0xbf, 0x23,
+	0x1e, 0xfc, 0xed, 0x88, 0x51, 0x82, 0xb9, 0x7d, 0x86, 0x7d, 0x77, 0x7d, 0xc2, 0xf9, 0x45, 0x62,
+	0xe0, 0x8f, 0x60, 0x77, 0x72, 0x00, 0x0f, 0x27, 0xd4, 0xbf, 0xf5, 0x48, 0x20, 0xc7, 0x3d, 0x82,
+	0x99, 0xb9, 0x28, 0x77, 0x71, 0x6b, 0x13, 0xc9, 0xb9, 0x54, 0x5c, 0x8d, 0x05, 0xf0, 0x06, 0x64,
+	0xf7, 0xed, 0x71, 0x1f, 0xf5, 0x31, 0x33, 0x8b, 0x9f, 0x75, 0xae, 0x15, 0x0d, 0xb9, 0x51, 0x0c,
+	0xf8, 0x1d, 0xf8, 0x42, 0xa7, 0xde, 0xbb, 0x25, 0x5c, 0x50, 0x36, 0x1a, 0xd7, 0x62, 0xa9, 0x61,
+	0x34, 0x8b, 0xee, 0xb6, 0x5e, 0xbd, 0xd4, 0x8b, 0x79, 0xa5, 0x9d, 0x83, 0xfa, 0x38, 0xa5, 0x02,
+	0xb3, 0x18, 0xf5, 0x3d, 0xf9, 0x20, 0x91, 0x10, 0x8c, 0x74, 0x53, 0x19, 0xb2, 0x59, 0x56, 0x09,
+	0xfe, 0x32, 0x4f, 0xb0, 0x56, 0x75, 0x10, 0x3f, 0x9d, 0x68, 0xe0, 0xe5, 0x38, 0x22, 0x3c, 0x4c,
+	0x08, 0x1b, 0x99, 0x4b, 0xaa, 0x9f, 0xd4, 0x6c, 0xdd, 0x91, 0xec, 0xbc, 0x23, 0xd9, 0x67, 0x59,
+	0x47, 0x6a, 0x57, 0x64, 0xb0, 0xff, 0x3d, 0xd6, 0x8d, 0x3c, 0x8c, 0x73, 0x65, 0x84, 0x3d, 0x50,
+	0x89, 0x48, 0xec, 0xc9, 0x77, 0x95, 0x55, 0x67, 0xcd, 0xd6, 0xd1, 0xdb, 0xb2, 0x19, 0xda, 0x59,
+	0x33, 0xb4, 0x7f, 0xa2, 0x24, 0x6e, 0x1f, 0x4b, 0xc8, 0xff, 0x8f, 0xf5, 0xe6, 0x27, 0x64, 0x4c,
+	0x1a, 0xb8, 0xbb, 0x14, 0x91, 0x58, 0xbe, 0x5f, 0x58, 0x07, 0xcb, 0x11, 0x1a, 0xaa, 0x7d, 0x64,
+	0xeb, 0xa9, 0x36, 0x8c, 0xe6, 0xaa, 0x0b, 0x22, 0x34, 0xbc, 0xd0, 0x33, 0xed, 0xce, 0xdd, 0x93,
+	0x65, 0xdc, 0x3f, 0x59, 0xc6, 0xeb, 0x27, 0xcb, 0xf8, 0xf7, 0xd9, 0x2a, 0xdc, 0x3f, 0x5b, 0x85,
+	0x97, 0xcf, 0x56, 0xe1, 0xcf, 0xa3, 0xa9, 0xdd, 0x74, 0x37, 0xd7, 0xbf, 0x83, 0xd6, 0xb1, 0x33,
+	0x9c, 0xee, 0xec, 0x6a, 0xe3, 0x6e, 0x59, 0x05, 0xff, 0xed, 0xdb, 0x01, 0x00, 0x0d, 0xde, 0x86,
+	0x04, 0x32, 0x06, 0x00, 0x00,
 }
 
 func (m *GenesisState) Marshal() (dAtA []byte, err error) {
@@ -204,6 +336,90 @@ func (m *GenesisState) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.ParticipantPayouts) > 0 {
+		for iNdEx := len(m.ParticipantPayouts) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.ParticipantPayouts[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x4a
+		}
+	}
+	if len(m.IncentivePayouts) > 0 {
+		for iNdEx := len(m.IncentivePayouts) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.IncentivePayouts[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x42
+		}
+	}
+	if len(m.AccruedRewards) > 0 {
+		for iNdEx := len(m.AccruedRewards) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.AccruedRewards[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x3a
+		}
+	}
+	if len(m.RewardPeriods) > 0 {
+		for iNdEx := len(m.RewardPeriods) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.RewardPeriods[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x32
+		}
+	}
+	if len(m.Funds) > 0 {
+		for iNdEx := len(m.Funds) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Funds[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x2a
+		}
+	}
+	if len(m.Participants) > 0 {
+		for iNdEx := len(m.Participants) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Participants[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x22
+		}
+	}
 	if len(m.GasMeters) > 0 {
 		for iNdEx := len(m.GasMeters) - 1; iNdEx >= 0; iNdEx-- {
 			{
@@ -265,6 +481,48 @@ func (m *Params) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.MaxFunders != 0 {
+		i = encodeVarintGenesis(dAtA, i, uint64(m.MaxFunders))
+		i--
+		dAtA[i] = 0x48
+	}
+	if len(m.MinFund) > 0 {
+		for iNdEx := len(m.MinFund) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.MinFund[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x42
+		}
+	}
+	n2, err2 := github_com_gogo_protobuf_types.StdDurationMarshalTo(m.RewardExpiry, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdDuration(m.RewardExpiry):])
+	if err2 != nil {
+		return 0, err2
+	}
+	i -= n2
+	i = encodeVarintGenesis(dAtA, i, uint64(n2))
+	i--
+	dAtA[i] = 0x3a
+	if m.EnableInternalGasAttribution {
+		i--
+		if m.EnableInternalGasAttribution {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x30
+	}
+	if m.PayoutHistoryPeriods != 0 {
+		i = encodeVarintGenesis(dAtA, i, uint64(m.PayoutHistoryPeriods))
+		i--
+		dAtA[i] = 0x28
+	}
 	{
 		size := m.RewardScaler.Size()
 		i -= size
@@ -336,6 +594,42 @@ func (m *GenesisState) Size() (n int) {
 			n += 1 + l + sovGenesis(uint64(l))
 		}
 	}
+	if len(m.Participants) > 0 {
+		for _, e := range m.Participants {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.Funds) > 0 {
+		for _, e := range m.Funds {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.RewardPeriods) > 0 {
+		for _, e := range m.RewardPeriods {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.AccruedRewards) > 0 {
+		for _, e := range m.AccruedRewards {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.IncentivePayouts) > 0 {
+		for _, e := range m.IncentivePayouts {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.ParticipantPayouts) > 0 {
+		for _, e := range m.ParticipantPayouts {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
 	return n
 }
 
@@ -356,6 +650,23 @@ func (m *Params) Size() (n int) {
 	}
 	l = m.RewardScaler.Size()
 	n += 1 + l + sovGenesis(uint64(l))
+	if m.PayoutHistoryPeriods != 0 {
+		n += 1 + sovGenesis(uint64(m.PayoutHistoryPeriods))
+	}
+	if m.EnableInternalGasAttribution {
+		n += 2
+	}
+	l = github_com_gogo_protobuf_types.SizeOfStdDuration(m.RewardExpiry)
+	n += 1 + l + sovGenesis(uint64(l))
+	if len(m.MinFund) > 0 {
+		for _, e := range m.MinFund {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if m.MaxFunders != 0 {
+		n += 1 + sovGenesis(uint64(m.MaxFunders))
+	}
 	return n
 }
 
@@ -495,6 +806,210 @@ func (m *GenesisState) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Participants", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Participants = append(m.Participants, Participant{})
+			if err := m.Participants[len(m.Participants)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Funds", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Funds = append(m.Funds, Fund{})
+			if err := m.Funds[len(m.Funds)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RewardPeriods", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.RewardPeriods = append(m.RewardPeriods, RewardPeriod{})
+			if err := m.RewardPeriods[len(m.RewardPeriods)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AccruedRewards", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.AccruedRewards = append(m.AccruedRewards, AccruedRewards{})
+			if err := m.AccruedRewards[len(m.AccruedRewards)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field IncentivePayouts", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.IncentivePayouts = append(m.IncentivePayouts, IncentivePayout{})
+			if err := m.IncentivePayouts[len(m.IncentivePayouts)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 9:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ParticipantPayouts", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ParticipantPayouts = append(m.ParticipantPayouts, ParticipantPayout{})
+			if err := m.ParticipantPayouts[len(m.ParticipantPayouts)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenesis(dAtA[iNdEx:])
@@ -665,6 +1180,131 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PayoutHistoryPeriods", wireType)
+			}
+			m.PayoutHistoryPeriods = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.PayoutHistoryPeriods |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EnableInternalGasAttribution", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.EnableInternalGasAttribution = bool(v != 0)
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RewardExpiry", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_gogo_protobuf_types.StdDurationUnmarshal(&m.RewardExpiry, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MinFund", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.MinFund = append(m.MinFund, types.Coin{})
+			if err := m.MinFund[len(m.MinFund)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 9:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxFunders", wireType)
+			}
+			m.MaxFunders = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MaxFunders |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenesis(dAtA[iNdEx:])
@@ -5,7 +5,10 @@ import (
 	"time"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/ethereum/go-ethereum/common"
 	"github.com/stretchr/testify/suite"
+
+	"github.com/evmos/ethermint/tests"
 )
 
 type GenesisTestSuite struct {
@@ -17,7 +20,14 @@ func TestGenesisTestSuite(t *testing.T) {
 }
 
 func (suite *GenesisTestSuite) TestValidateGenesis() {
-	newGen := NewGenesisState(DefaultParams(), []Incentive{}, []GasMeter{})
+	newGen := NewGenesisState(
+		DefaultParams(), []Incentive{}, []GasMeter{}, []Participant{}, []Fund{}, []RewardPeriod{}, []AccruedRewards{},
+		[]IncentivePayout{}, []ParticipantPayout{},
+	)
+	funder := sdk.AccAddress(tests.GenerateAddress().Bytes()).String()
+	rewardPeriod := NewRewardPeriod(
+		common.HexToAddress("0xdac17f958d2ee523a2206206994597c13d831ec7"), 0, DefaultWeighting(),
+	)
 
 	testCases := []struct {
 		name     string
@@ -113,9 +123,26 @@ func (suite *GenesisTestSuite) TestValidateGenesis() {
 						CumulativeGas: 10,
 					},
 				},
+				RewardPeriods: []RewardPeriod{rewardPeriod},
 			},
 			true,
 		},
+		{
+			"invalid genesis - gasmeter without reward period",
+			&GenesisState{
+				Params: DefaultParams(),
+				GasMeters: []GasMeter{
+					{
+						Contract:      "0xdac17f958d2ee523a2206206994597c13d831ec7",
+						Participant:   "0xdac17f958d2ee523a2206206994597c13d831ec7",
+						CumulativeGas: 10,
+						Period:        1,
+					},
+				},
+				RewardPeriods: []RewardPeriod{rewardPeriod},
+			},
+			false,
+		},
 		{
 			"invalid genesis - duplicated gasmeter",
 			&GenesisState{
@@ -149,6 +176,247 @@ func (suite *GenesisTestSuite) TestValidateGenesis() {
 			},
 			false,
 		},
+		{
+			"valid genesis - with participants",
+			&GenesisState{
+				Params: DefaultParams(),
+				Participants: []Participant{
+					{
+						Address:     "0xdac17f958d2ee523a2206206994597c13d831ec7",
+						FirstHeight: 10,
+						LastHeight:  15,
+					},
+				},
+			},
+			true,
+		},
+		{
+			"invalid genesis - duplicated participant",
+			&GenesisState{
+				Params: DefaultParams(),
+				Participants: []Participant{
+					{
+						Address:     "0xdac17f958d2ee523a2206206994597c13d831ec7",
+						FirstHeight: 10,
+					},
+					{
+						Address:     "0xdac17f958d2ee523a2206206994597c13d831ec7",
+						FirstHeight: 20,
+					},
+				},
+			},
+			false,
+		},
+		{
+			"invalid genesis - invalid participant",
+			&GenesisState{
+				Params: DefaultParams(),
+				Participants: []Participant{
+					{
+						Address:     "0xinvalidaddress",
+						FirstHeight: 10,
+					},
+				},
+			},
+			false,
+		},
+		{
+			"valid genesis - with funds",
+			&GenesisState{
+				Params: DefaultParams(),
+				Incentives: []Incentive{
+					{
+						Contract:  "0xdac17f958d2ee523a2206206994597c13d831ec7",
+						Epochs:    10,
+						StartTime: time.Now(),
+					},
+				},
+				Funds: []Fund{
+					{
+						Contract: "0xdac17f958d2ee523a2206206994597c13d831ec7",
+						Funder:   funder,
+						Amount:   sdk.NewCoins(sdk.NewInt64Coin("aevmos", 100)),
+					},
+				},
+			},
+			true,
+		},
+		{
+			"invalid genesis - duplicated fund",
+			&GenesisState{
+				Params: DefaultParams(),
+				Incentives: []Incentive{
+					{
+						Contract:  "0xdac17f958d2ee523a2206206994597c13d831ec7",
+						Epochs:    10,
+						StartTime: time.Now(),
+					},
+				},
+				Funds: []Fund{
+					{
+						Contract: "0xdac17f958d2ee523a2206206994597c13d831ec7",
+						Funder:   funder,
+						Amount:   sdk.NewCoins(sdk.NewInt64Coin("aevmos", 100)),
+					},
+					{
+						Contract: "0xdac17f958d2ee523a2206206994597c13d831ec7",
+						Funder:   funder,
+						Amount:   sdk.NewCoins(sdk.NewInt64Coin("aevmos", 100)),
+					},
+				},
+			},
+			false,
+		},
+		{
+			"invalid genesis - fund for unregistered incentive",
+			&GenesisState{
+				Params: DefaultParams(),
+				Funds: []Fund{
+					{
+						Contract: "0xdac17f958d2ee523a2206206994597c13d831ec7",
+						Funder:   funder,
+						Amount:   sdk.NewCoins(sdk.NewInt64Coin("aevmos", 100)),
+					},
+				},
+			},
+			false,
+		},
+		{
+			"invalid genesis - duplicated reward period",
+			&GenesisState{
+				Params:        DefaultParams(),
+				RewardPeriods: []RewardPeriod{rewardPeriod, rewardPeriod},
+			},
+			false,
+		},
+		{
+			"invalid genesis - invalid reward period",
+			&GenesisState{
+				Params: DefaultParams(),
+				RewardPeriods: []RewardPeriod{
+					{
+						Contract:     "0xinvalidaddress",
+						TotalWeight:  sdk.ZeroDec(),
+						RewardScaler: sdk.ZeroDec(),
+					},
+				},
+			},
+			false,
+		},
+		{
+			"valid genesis - with accrued rewards",
+			&GenesisState{
+				Params: DefaultParams(),
+				AccruedRewards: []AccruedRewards{
+					{
+						Participant: "0xdac17f958d2ee523a2206206994597c13d831ec7",
+						Rewards:     sdk.NewCoins(sdk.NewInt64Coin("aevmos", 100)),
+					},
+				},
+			},
+			true,
+		},
+		{
+			"invalid genesis - duplicated accrued rewards",
+			&GenesisState{
+				Params: DefaultParams(),
+				AccruedRewards: []AccruedRewards{
+					{
+						Participant: "0xdac17f958d2ee523a2206206994597c13d831ec7",
+						Rewards:     sdk.NewCoins(sdk.NewInt64Coin("aevmos", 100)),
+					},
+					{
+						Participant: "0xdac17f958d2ee523a2206206994597c13d831ec7",
+						Rewards:     sdk.NewCoins(sdk.NewInt64Coin("aevmos", 100)),
+					},
+				},
+			},
+			false,
+		},
+		{
+			"invalid genesis - empty accrued rewards",
+			&GenesisState{
+				Params: DefaultParams(),
+				AccruedRewards: []AccruedRewards{
+					{
+						Participant: "0xdac17f958d2ee523a2206206994597c13d831ec7",
+					},
+				},
+			},
+			false,
+		},
+		{
+			"valid genesis - with payouts",
+			&GenesisState{
+				Params: DefaultParams(),
+				IncentivePayouts: []IncentivePayout{
+					{
+						Contract: "0xdac17f958d2ee523a2206206994597c13d831ec7",
+						Period:   1,
+						Rewards:  sdk.NewCoins(sdk.NewInt64Coin("aevmos", 100)),
+					},
+				},
+				ParticipantPayouts: []ParticipantPayout{
+					{
+						Contract:    "0xdAC17F958D2ee523a2206206994597C13D831ec7",
+						Participant: "0xdac17f958d2ee523a2206206994597c13d831ec8",
+						Period:      1,
+						Rewards:     sdk.NewCoins(sdk.NewInt64Coin("aevmos", 100)),
+					},
+				},
+			},
+			true,
+		},
+		{
+			"invalid genesis - duplicated incentive payout",
+			&GenesisState{
+				Params: DefaultParams(),
+				IncentivePayouts: []IncentivePayout{
+					{Contract: "0xdac17f958d2ee523a2206206994597c13d831ec7", Period: 1},
+					{Contract: "0xdac17f958d2ee523a2206206994597c13d831ec7", Period: 1},
+				},
+			},
+			false,
+		},
+		{
+			"invalid genesis - duplicated participant payout",
+			&GenesisState{
+				Params: DefaultParams(),
+				IncentivePayouts: []IncentivePayout{
+					{Contract: "0xdac17f958d2ee523a2206206994597c13d831ec7", Period: 1},
+				},
+				ParticipantPayouts: []ParticipantPayout{
+					{
+						Contract:    "0xdac17f958d2ee523a2206206994597c13d831ec7",
+						Participant: "0xdac17f958d2ee523a2206206994597c13d831ec8",
+						Period:      1,
+					},
+					{
+						Contract:    "0xdac17f958d2ee523a2206206994597c13d831ec7",
+						Participant: "0xdac17f958d2ee523a2206206994597c13d831ec8",
+						Period:      1,
+					},
+				},
+			},
+			false,
+		},
+		{
+			"invalid genesis - participant payout without incentive payout",
+			&GenesisState{
+				Params: DefaultParams(),
+				IncentivePayouts: []IncentivePayout{
+					{Contract: "0xdac17f958d2ee523a2206206994597c13d831ec7", Period: 1},
+				},
+				ParticipantPayouts: []ParticipantPayout{
+					{
+						Contract:    "0xdac17f958d2ee523a2206206994597c13d831ec7",
+						Participant: "0xdac17f958d2ee523a2206206994597c13d831ec8",
+						Period:      0,
+					},
+				},
+			},
+			false,
+		},
 		{
 			"empty genesis",
 			&GenesisState{},
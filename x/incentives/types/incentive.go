@@ -13,12 +13,16 @@ func NewIncentive(
 	contract common.Address,
 	allocations sdk.DecCoins,
 	epochs uint32,
+	weighting Weighting,
+	eligibility Eligibility,
 ) Incentive {
 	return Incentive{
 		Contract:    contract.String(),
 		Allocations: allocations,
 		Epochs:      epochs,
 		TotalGas:    0,
+		Weighting:   weighting,
+		Eligibility: eligibility,
 	}
 }
 
@@ -28,10 +32,6 @@ func (i Incentive) Validate() error {
 		return err
 	}
 
-	if i.Allocations.IsZero() {
-		return fmt.Errorf("allocations cannot be empty: %s", i.Allocations)
-	}
-
 	for _, al := range i.Allocations {
 		if err := sdk.ValidateDenom(al.Denom); err != nil {
 			return err
@@ -44,7 +44,12 @@ func (i Incentive) Validate() error {
 	if i.Epochs == 0 {
 		return fmt.Errorf("epoch cannot be 0")
 	}
-	return nil
+
+	if err := i.Weighting.Validate(); err != nil {
+		return err
+	}
+
+	return i.Eligibility.Validate()
 }
 
 // IsActive returns true if the Incentive has remaining Epochs
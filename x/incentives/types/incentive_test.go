@@ -39,7 +39,7 @@ func (suite *IncentiveTestSuite) TestIncentiveNew() {
 			tests.GenerateAddress(),
 			sdk.DecCoins{},
 			10,
-			false,
+			true,
 		},
 		{
 			"Register incentive - invalid allocation denom",
@@ -72,7 +72,7 @@ func (suite *IncentiveTestSuite) TestIncentiveNew() {
 	}
 
 	for _, tc := range testCases {
-		i := NewIncentive(tc.contract, tc.allocations, tc.epochs)
+		i := NewIncentive(tc.contract, tc.allocations, tc.epochs, DefaultWeighting(), DefaultEligibility())
 		err := i.Validate()
 
 		if tc.expectPass {
@@ -97,6 +97,9 @@ func (suite *IncentiveTestSuite) TestIncentive() {
 				10,
 				time.Now(),
 				0,
+				DefaultWeighting(),
+				DefaultEligibility(),
+				0,
 			},
 			false,
 		},
@@ -108,6 +111,9 @@ func (suite *IncentiveTestSuite) TestIncentive() {
 				10,
 				time.Now(),
 				0,
+				DefaultWeighting(),
+				DefaultEligibility(),
+				0,
 			},
 			false,
 		},
@@ -119,6 +125,9 @@ func (suite *IncentiveTestSuite) TestIncentive() {
 				10,
 				time.Now(),
 				0,
+				DefaultWeighting(),
+				DefaultEligibility(),
+				0,
 			},
 			false,
 		},
@@ -130,6 +139,9 @@ func (suite *IncentiveTestSuite) TestIncentive() {
 				10,
 				time.Now(),
 				0,
+				DefaultWeighting(),
+				DefaultEligibility(),
+				0,
 			},
 			true,
 		},
@@ -160,6 +172,9 @@ func (suite *IncentiveTestSuite) TestIsActive() {
 				10,
 				time.Now(),
 				0,
+				DefaultWeighting(),
+				DefaultEligibility(),
+				0,
 			},
 			true,
 		},
@@ -171,6 +186,9 @@ func (suite *IncentiveTestSuite) TestIsActive() {
 				0,
 				time.Now(),
 				0,
+				DefaultWeighting(),
+				DefaultEligibility(),
+				0,
 			},
 			false,
 		},
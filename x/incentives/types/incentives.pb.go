@@ -29,6 +29,49 @@ var _ = time.Kitchen
 // proto package needs to be updated.
 const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
 
+// WeightingStrategy enumerates the strategies used to weight the participants of an incentive
+// when distributing its rewards.
+type WeightingStrategy int32
+
+const (
+	// WEIGHTING_STRATEGY_UNSPECIFIED defaults to the gas weighting strategy.
+	WEIGHTING_STRATEGY_UNSPECIFIED WeightingStrategy = 0
+	// WEIGHTING_STRATEGY_GAS weights each participant by the gas spent during the epoch.
+	WEIGHTING_STRATEGY_GAS WeightingStrategy = 1
+	// WEIGHTING_STRATEGY_UNIQUE_PARTICIPANTS weights all participants equally.
+	WEIGHTING_STRATEGY_UNIQUE_PARTICIPANTS WeightingStrategy = 2
+	// WEIGHTING_STRATEGY_CAPPED_GAS weights each participant by the gas spent during the epoch, up to
+	// the gas cap.
+	WEIGHTING_STRATEGY_CAPPED_GAS WeightingStrategy = 3
+	// WEIGHTING_STRATEGY_SQRT_GAS weights each participant by the square root of the gas spent during
+	// the epoch.
+	WEIGHTING_STRATEGY_SQRT_GAS WeightingStrategy = 4
+)
+
+var WeightingStrategy_name = map[int32]string{
+	0: "WEIGHTING_STRATEGY_UNSPECIFIED",
+	1: "WEIGHTING_STRATEGY_GAS",
+	2: "WEIGHTING_STRATEGY_UNIQUE_PARTICIPANTS",
+	3: "WEIGHTING_STRATEGY_CAPPED_GAS",
+	4: "WEIGHTING_STRATEGY_SQRT_GAS",
+}
+
+var WeightingStrategy_value = map[string]int32{
+	"WEIGHTING_STRATEGY_UNSPECIFIED":         0,
+	"WEIGHTING_STRATEGY_GAS":                 1,
+	"WEIGHTING_STRATEGY_UNIQUE_PARTICIPANTS": 2,
+	"WEIGHTING_STRATEGY_CAPPED_GAS":          3,
+	"WEIGHTING_STRATEGY_SQRT_GAS":            4,
+}
+
+func (x WeightingStrategy) String() string {
+	return proto.EnumName(WeightingStrategy_name, int32(x))
+}
+
+func (WeightingStrategy) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_95b81e40854aec77, []int{0}
+}
+
 // Incentive defines an instance that organizes distribution conditions for a
 // given smart contract
 type Incentive struct {
@@ -42,6 +85,13 @@ type Incentive struct {
 	StartTime time.Time `protobuf:"bytes,4,opt,name=start_time,json=startTime,proto3,stdtime" json:"start_time"`
 	// total_gas is the cumulative gas spent by all gas meters of the incentive during the epoch
 	TotalGas uint64 `protobuf:"varint,5,opt,name=total_gas,json=totalGas,proto3" json:"total_gas,omitempty"`
+	// weighting defines how the rewards of the incentive are split among its participants
+	Weighting Weighting `protobuf:"bytes,6,opt,name=weighting,proto3" json:"weighting"`
+	// eligibility defines the rules a participant must meet for its gas to be counted by the incentive
+	Eligibility Eligibility `protobuf:"bytes,7,opt,name=eligibility,proto3" json:"eligibility"`
+	// period is the index of the current reward period of the incentive. It is
+	// incremented at the end of each epoch.
+	Period uint64 `protobuf:"varint,8,opt,name=period,proto3" json:"period,omitempty"`
 }
 
 func (m *Incentive) Reset()         { *m = Incentive{} }
@@ -112,6 +162,209 @@ func (m *Incentive) GetTotalGas() uint64 {
 	return 0
 }
 
+func (m *Incentive) GetWeighting() Weighting {
+	if m != nil {
+		return m.Weighting
+	}
+	return Weighting{}
+}
+
+func (m *Incentive) GetEligibility() Eligibility {
+	if m != nil {
+		return m.Eligibility
+	}
+	return Eligibility{}
+}
+
+func (m *Incentive) GetPeriod() uint64 {
+	if m != nil {
+		return m.Period
+	}
+	return 0
+}
+
+// Weighting defines the strategy used to weight the participants of an incentive
+type Weighting struct {
+	// strategy used to weight the participants
+	Strategy WeightingStrategy `protobuf:"varint,1,opt,name=strategy,proto3,enum=evmos.incentives.v1.WeightingStrategy" json:"strategy,omitempty"`
+	// gas_cap is the maximum gas of a participant taken into account by the capped gas strategy
+	GasCap uint64 `protobuf:"varint,2,opt,name=gas_cap,json=gasCap,proto3" json:"gas_cap,omitempty"`
+}
+
+func (m *Weighting) Reset()         { *m = Weighting{} }
+func (m *Weighting) String() string { return proto.CompactTextString(m) }
+func (*Weighting) ProtoMessage()    {}
+func (*Weighting) Descriptor() ([]byte, []int) {
+	return fileDescriptor_95b81e40854aec77, []int{1}
+}
+func (m *Weighting) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *Weighting) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_Weighting.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *Weighting) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Weighting.Merge(m, src)
+}
+func (m *Weighting) XXX_Size() int {
+	return m.Size()
+}
+func (m *Weighting) XXX_DiscardUnknown() {
+	xxx_messageInfo_Weighting.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_Weighting proto.InternalMessageInfo
+
+func (m *Weighting) GetStrategy() WeightingStrategy {
+	if m != nil {
+		return m.Strategy
+	}
+	return WEIGHTING_STRATEGY_UNSPECIFIED
+}
+
+func (m *Weighting) GetGasCap() uint64 {
+	if m != nil {
+		return m.GasCap
+	}
+	return 0
+}
+
+// Eligibility defines the rules a participant must meet for its gas to be counted by an incentive.
+// Zero values disable the corresponding rule.
+type Eligibility struct {
+	// min_stake is the minimum amount of the staking denomination bonded by the participant
+	MinStake github_com_cosmos_cosmos_sdk_types.Int `protobuf:"bytes,1,opt,name=min_stake,json=minStake,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Int" json:"min_stake"`
+	// min_participation_age is the minimum number of blocks since the first interaction of the
+	// participant with an incentivized contract. It is not the age of the account, which is not
+	// recorded on chain.
+	MinParticipationAge uint64 `protobuf:"varint,2,opt,name=min_participation_age,json=minParticipationAge,proto3" json:"min_participation_age,omitempty"`
+	// min_balance is the minimum balance the participant must hold
+	MinBalance github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,3,rep,name=min_balance,json=minBalance,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"min_balance"`
+}
+
+func (m *Eligibility) Reset()         { *m = Eligibility{} }
+func (m *Eligibility) String() string { return proto.CompactTextString(m) }
+func (*Eligibility) ProtoMessage()    {}
+func (*Eligibility) Descriptor() ([]byte, []int) {
+	return fileDescriptor_95b81e40854aec77, []int{2}
+}
+func (m *Eligibility) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *Eligibility) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_Eligibility.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *Eligibility) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Eligibility.Merge(m, src)
+}
+func (m *Eligibility) XXX_Size() int {
+	return m.Size()
+}
+func (m *Eligibility) XXX_DiscardUnknown() {
+	xxx_messageInfo_Eligibility.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_Eligibility proto.InternalMessageInfo
+
+func (m *Eligibility) GetMinParticipationAge() uint64 {
+	if m != nil {
+		return m.MinParticipationAge
+	}
+	return 0
+}
+
+func (m *Eligibility) GetMinBalance() github_com_cosmos_cosmos_sdk_types.Coins {
+	if m != nil {
+		return m.MinBalance
+	}
+	return nil
+}
+
+// Participant records the first and the last interaction of an address with an incentivized
+// contract
+type Participant struct {
+	// address is the hex address of the participant
+	Address string `protobuf:"bytes,1,opt,name=address,proto3" json:"address,omitempty"`
+	// first_height is the block height of the first interaction of the participant with an
+	// incentivized contract
+	FirstHeight int64 `protobuf:"varint,2,opt,name=first_height,json=firstHeight,proto3" json:"first_height,omitempty"`
+	// last_height is the block height of the last interaction of the participant with an
+	// incentivized contract
+	LastHeight int64 `protobuf:"varint,3,opt,name=last_height,json=lastHeight,proto3" json:"last_height,omitempty"`
+}
+
+func (m *Participant) Reset()         { *m = Participant{} }
+func (m *Participant) String() string { return proto.CompactTextString(m) }
+func (*Participant) ProtoMessage()    {}
+func (*Participant) Descriptor() ([]byte, []int) {
+	return fileDescriptor_95b81e40854aec77, []int{3}
+}
+func (m *Participant) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *Participant) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_Participant.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *Participant) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Participant.Merge(m, src)
+}
+func (m *Participant) XXX_Size() int {
+	return m.Size()
+}
+func (m *Participant) XXX_DiscardUnknown() {
+	xxx_messageInfo_Participant.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_Participant proto.InternalMessageInfo
+
+func (m *Participant) GetAddress() string {
+	if m != nil {
+		return m.Address
+	}
+	return ""
+}
+
+func (m *Participant) GetFirstHeight() int64 {
+	if m != nil {
+		return m.FirstHeight
+	}
+	return 0
+}
+
+func (m *Participant) GetLastHeight() int64 {
+	if m != nil {
+		return m.LastHeight
+	}
+	return 0
+}
+
 // GasMeter tracks the cumulative gas spent per participant in one epoch
 type GasMeter struct {
 	// contract is the hex address of the incentivized smart contract
@@ -120,13 +373,15 @@ type GasMeter struct {
 	Participant string `protobuf:"bytes,2,opt,name=participant,proto3" json:"participant,omitempty"`
 	// cumulative_gas spent during the epoch
 	CumulativeGas uint64 `protobuf:"varint,3,opt,name=cumulative_gas,json=cumulativeGas,proto3" json:"cumulative_gas,omitempty"`
+	// period is the reward period of the incentive during which the gas was spent
+	Period uint64 `protobuf:"varint,4,opt,name=period,proto3" json:"period,omitempty"`
 }
 
 func (m *GasMeter) Reset()         { *m = GasMeter{} }
 func (m *GasMeter) String() string { return proto.CompactTextString(m) }
 func (*GasMeter) ProtoMessage()    {}
 func (*GasMeter) Descriptor() ([]byte, []int) {
-	return fileDescriptor_95b81e40854aec77, []int{1}
+	return fileDescriptor_95b81e40854aec77, []int{4}
 }
 func (m *GasMeter) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -176,6 +431,13 @@ func (m *GasMeter) GetCumulativeGas() uint64 {
 	return 0
 }
 
+func (m *GasMeter) GetPeriod() uint64 {
+	if m != nil {
+		return m.Period
+	}
+	return 0
+}
+
 // RegisterIncentiveProposal is a gov Content type to register an incentive
 type RegisterIncentiveProposal struct {
 	// title of the proposal
@@ -188,13 +450,17 @@ type RegisterIncentiveProposal struct {
 	Allocations github_com_cosmos_cosmos_sdk_types.DecCoins `protobuf:"bytes,4,rep,name=allocations,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.DecCoins" json:"allocations"`
 	// epochs is the number of remaining epochs for the incentive
 	Epochs uint32 `protobuf:"varint,5,opt,name=epochs,proto3" json:"epochs,omitempty"`
+	// weighting defines how the rewards of the incentive are split among its participants
+	Weighting Weighting `protobuf:"bytes,6,opt,name=weighting,proto3" json:"weighting"`
+	// eligibility defines the rules a participant must meet for its gas to be counted by the incentive
+	Eligibility Eligibility `protobuf:"bytes,7,opt,name=eligibility,proto3" json:"eligibility"`
 }
 
 func (m *RegisterIncentiveProposal) Reset()         { *m = RegisterIncentiveProposal{} }
 func (m *RegisterIncentiveProposal) String() string { return proto.CompactTextString(m) }
 func (*RegisterIncentiveProposal) ProtoMessage()    {}
 func (*RegisterIncentiveProposal) Descriptor() ([]byte, []int) {
-	return fileDescriptor_95b81e40854aec77, []int{2}
+	return fileDescriptor_95b81e40854aec77, []int{5}
 }
 func (m *RegisterIncentiveProposal) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -258,6 +524,20 @@ func (m *RegisterIncentiveProposal) GetEpochs() uint32 {
 	return 0
 }
 
+func (m *RegisterIncentiveProposal) GetWeighting() Weighting {
+	if m != nil {
+		return m.Weighting
+	}
+	return Weighting{}
+}
+
+func (m *RegisterIncentiveProposal) GetEligibility() Eligibility {
+	if m != nil {
+		return m.Eligibility
+	}
+	return Eligibility{}
+}
+
 // CancelIncentiveProposal is a gov Content type to cancel an incentive
 type CancelIncentiveProposal struct {
 	// title of the proposal
@@ -272,7 +552,7 @@ func (m *CancelIncentiveProposal) Reset()         { *m = CancelIncentiveProposal
 func (m *CancelIncentiveProposal) String() string { return proto.CompactTextString(m) }
 func (*CancelIncentiveProposal) ProtoMessage()    {}
 func (*CancelIncentiveProposal) Descriptor() ([]byte, []int) {
-	return fileDescriptor_95b81e40854aec77, []int{3}
+	return fileDescriptor_95b81e40854aec77, []int{6}
 }
 func (m *CancelIncentiveProposal) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -322,379 +602,3363 @@ func (m *CancelIncentiveProposal) GetContract() string {
 	return ""
 }
 
-func init() {
-	proto.RegisterType((*Incentive)(nil), "evmos.incentives.v1.Incentive")
-	proto.RegisterType((*GasMeter)(nil), "evmos.incentives.v1.GasMeter")
-	proto.RegisterType((*RegisterIncentiveProposal)(nil), "evmos.incentives.v1.RegisterIncentiveProposal")
-	proto.RegisterType((*CancelIncentiveProposal)(nil), "evmos.incentives.v1.CancelIncentiveProposal")
+// UpdateIncentiveProposal is a gov Content type to update the allocations and
+// remaining epochs of a registered incentive
+type UpdateIncentiveProposal struct {
+	// title of the proposal
+	Title string `protobuf:"bytes,1,opt,name=title,proto3" json:"title,omitempty"`
+	// description of the proposal
+	Description string `protobuf:"bytes,2,opt,name=description,proto3" json:"description,omitempty"`
+	// contract address of the incentivized smart contract
+	Contract string `protobuf:"bytes,3,opt,name=contract,proto3" json:"contract,omitempty"`
+	// allocations replaces the denoms and percentage of rewards allocated to the incentive
+	Allocations github_com_cosmos_cosmos_sdk_types.DecCoins `protobuf:"bytes,4,rep,name=allocations,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.DecCoins" json:"allocations"`
+	// epochs is the new number of remaining epochs for the incentive
+	Epochs uint32 `protobuf:"varint,5,opt,name=epochs,proto3" json:"epochs,omitempty"`
 }
 
-func init() {
-	proto.RegisterFile("evmos/incentives/v1/incentives.proto", fileDescriptor_95b81e40854aec77)
+func (m *UpdateIncentiveProposal) Reset()         { *m = UpdateIncentiveProposal{} }
+func (m *UpdateIncentiveProposal) String() string { return proto.CompactTextString(m) }
+func (*UpdateIncentiveProposal) ProtoMessage()    {}
+func (*UpdateIncentiveProposal) Descriptor() ([]byte, []int) {
+	return fileDescriptor_95b81e40854aec77, []int{7}
 }
-
-var fileDescriptor_95b81e40854aec77 = []byte{
-	// 488 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xbc, 0x53, 0xbf, 0x6f, 0xd4, 0x30,
-	0x18, 0x4d, 0xee, 0x47, 0x75, 0xe7, 0x53, 0x19, 0x42, 0x05, 0xe1, 0x40, 0x49, 0x74, 0x02, 0x29,
-	0x12, 0xaa, 0x4d, 0xda, 0x8d, 0xf1, 0x0e, 0xe9, 0xc4, 0x80, 0x84, 0x22, 0x26, 0x96, 0xca, 0x71,
-	0x4d, 0x6a, 0x91, 0xc4, 0x51, 0xfc, 0x5d, 0x04, 0x2b, 0x7f, 0x41, 0x27, 0x66, 0x66, 0xfe, 0x92,
-	0x8e, 0x1d, 0x99, 0x28, 0xba, 0x5b, 0xf8, 0x33, 0x90, 0x9d, 0xa4, 0xa4, 0x4b, 0xc7, 0x5b, 0x12,
-	0xbf, 0x67, 0x7f, 0x7a, 0xef, 0x7d, 0x9f, 0x8d, 0x9e, 0xf3, 0x3a, 0x97, 0x8a, 0x88, 0x82, 0xf1,
-	0x02, 0x44, 0xcd, 0x15, 0xa9, 0xa3, 0x1e, 0xc2, 0x65, 0x25, 0x41, 0x3a, 0x0f, 0xcd, 0x29, 0xdc,
-	0xe3, 0xeb, 0x68, 0xee, 0x31, 0xa9, 0x74, 0x6d, 0x42, 0x15, 0x27, 0x75, 0x94, 0x70, 0xa0, 0x11,
-	0x61, 0x52, 0x14, 0x4d, 0xd1, 0xfc, 0x28, 0x95, 0xa9, 0x34, 0x4b, 0xa2, 0x57, 0x2d, 0xeb, 0xa7,
-	0x52, 0xa6, 0x19, 0x27, 0x06, 0x25, 0x9b, 0x4f, 0x04, 0x44, 0xce, 0x15, 0xd0, 0xbc, 0x6c, 0x0e,
-	0x2c, 0xbe, 0x0f, 0xd0, 0xf4, 0x6d, 0x27, 0xe4, 0xcc, 0xd1, 0x84, 0xc9, 0x02, 0x2a, 0xca, 0xc0,
-	0xb5, 0x03, 0x3b, 0x9c, 0xc6, 0xb7, 0xd8, 0x51, 0x68, 0x46, 0xb3, 0x4c, 0x32, 0x0a, 0x42, 0x16,
-	0xca, 0x1d, 0x04, 0xc3, 0x70, 0x76, 0xf2, 0x0c, 0x37, 0xb6, 0xb0, 0xb6, 0x85, 0x5b, 0x5b, 0xf8,
-	0x0d, 0x67, 0x2b, 0x29, 0x8a, 0xe5, 0xe9, 0xd5, 0x6f, 0xdf, 0xfa, 0x79, 0xe3, 0xbf, 0x4c, 0x05,
-	0x5c, 0x6c, 0x12, 0xcc, 0x64, 0x4e, 0xda, 0x18, 0xcd, 0xef, 0x58, 0x9d, 0x7f, 0x26, 0xf0, 0xb5,
-	0xe4, 0xaa, 0xab, 0x51, 0x71, 0x5f, 0xc5, 0x79, 0x84, 0x0e, 0x78, 0x29, 0xd9, 0x85, 0x72, 0x87,
-	0x81, 0x1d, 0x1e, 0xc6, 0x2d, 0x72, 0x56, 0x08, 0x29, 0xa0, 0x15, 0x9c, 0xe9, 0x3c, 0xee, 0x28,
-	0xb0, 0xc3, 0xd9, 0xc9, 0x1c, 0x37, 0x61, 0x71, 0x17, 0x16, 0x7f, 0xe8, 0xc2, 0x2e, 0x27, 0xda,
-	0xc9, 0xe5, 0x8d, 0x6f, 0xc7, 0x53, 0x53, 0xa7, 0x77, 0x9c, 0xa7, 0x68, 0x0a, 0x12, 0x68, 0x76,
-	0x96, 0x52, 0xe5, 0x8e, 0x03, 0x3b, 0x1c, 0xc5, 0x13, 0x43, 0xac, 0xa9, 0x5a, 0x48, 0x34, 0x59,
-	0x53, 0xf5, 0x8e, 0x03, 0xaf, 0xee, 0x6d, 0x4b, 0x80, 0x66, 0x25, 0xad, 0x40, 0x30, 0x51, 0xd2,
-	0x02, 0xdc, 0x81, 0xd9, 0xee, 0x53, 0xce, 0x0b, 0xf4, 0x80, 0x6d, 0xf2, 0x4d, 0x46, 0x75, 0x8b,
-	0x8d, 0xd6, 0xd0, 0x68, 0x1d, 0xfe, 0x67, 0xb5, 0xe0, 0xb7, 0x01, 0x7a, 0x12, 0xf3, 0x54, 0x28,
-	0xe0, 0xd5, 0xed, 0x44, 0xde, 0x57, 0xb2, 0x94, 0x8a, 0x66, 0xce, 0x11, 0x1a, 0x83, 0x80, 0x8c,
-	0xb7, 0xfa, 0x0d, 0xd0, 0xe2, 0xe7, 0x5c, 0xb1, 0x4a, 0x94, 0xba, 0x5d, 0x9d, 0x78, 0x8f, 0xba,
-	0x63, 0x7d, 0x78, 0xff, 0x44, 0x47, 0x7b, 0x9e, 0xe8, 0xb8, 0x3f, 0xd1, 0xd7, 0xa3, 0xbf, 0x3f,
-	0x7c, 0x6b, 0xa1, 0xd0, 0xe3, 0x15, 0x2d, 0x18, 0xcf, 0xf6, 0xd2, 0x81, 0x46, 0x74, 0xb9, 0xbe,
-	0xda, 0x7a, 0xf6, 0xf5, 0xd6, 0xb3, 0xff, 0x6c, 0x3d, 0xfb, 0x72, 0xe7, 0x59, 0xd7, 0x3b, 0xcf,
-	0xfa, 0xb5, 0xf3, 0xac, 0x8f, 0xc7, 0xbd, 0x98, 0xcd, 0xd3, 0x6d, 0xbe, 0x75, 0xf4, 0x8a, 0x7c,
-	0xe9, 0x3f, 0x63, 0x93, 0x38, 0x39, 0x30, 0x37, 0xef, 0xf4, 0x5f, 0x00, 0x00, 0x00, 0xff, 0xff,
-	0x1f, 0xe9, 0x28, 0xf9, 0xe7, 0x03, 0x00, 0x00,
+func (m *UpdateIncentiveProposal) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
 }
-
-func (m *Incentive) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *UpdateIncentiveProposal) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_UpdateIncentiveProposal.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return dAtA[:n], nil
 }
-
-func (m *Incentive) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *UpdateIncentiveProposal) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_UpdateIncentiveProposal.Merge(m, src)
+}
+func (m *UpdateIncentiveProposal) XXX_Size() int {
+	return m.Size()
+}
+func (m *UpdateIncentiveProposal) XXX_DiscardUnknown() {
+	xxx_messageInfo_UpdateIncentiveProposal.DiscardUnknown(m)
 }
 
-func (m *Incentive) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.TotalGas != 0 {
-		i = encodeVarintIncentives(dAtA, i, uint64(m.TotalGas))
-		i--
-		dAtA[i] = 0x28
-	}
-	n1, err1 := github_com_gogo_protobuf_types.StdTimeMarshalTo(m.StartTime, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(m.StartTime):])
-	if err1 != nil {
-		return 0, err1
+var xxx_messageInfo_UpdateIncentiveProposal proto.InternalMessageInfo
+
+func (m *UpdateIncentiveProposal) GetTitle() string {
+	if m != nil {
+		return m.Title
 	}
-	i -= n1
-	i = encodeVarintIncentives(dAtA, i, uint64(n1))
-	i--
-	dAtA[i] = 0x22
-	if m.Epochs != 0 {
-		i = encodeVarintIncentives(dAtA, i, uint64(m.Epochs))
-		i--
-		dAtA[i] = 0x18
+	return ""
+}
+
+func (m *UpdateIncentiveProposal) GetDescription() string {
+	if m != nil {
+		return m.Description
 	}
-	if len(m.Allocations) > 0 {
-		for iNdEx := len(m.Allocations) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.Allocations[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintIncentives(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0x12
-		}
+	return ""
+}
+
+func (m *UpdateIncentiveProposal) GetContract() string {
+	if m != nil {
+		return m.Contract
 	}
-	if len(m.Contract) > 0 {
-		i -= len(m.Contract)
-		copy(dAtA[i:], m.Contract)
-		i = encodeVarintIncentives(dAtA, i, uint64(len(m.Contract)))
-		i--
-		dAtA[i] = 0xa
+	return ""
+}
+
+func (m *UpdateIncentiveProposal) GetAllocations() github_com_cosmos_cosmos_sdk_types.DecCoins {
+	if m != nil {
+		return m.Allocations
 	}
-	return len(dAtA) - i, nil
+	return nil
 }
 
-func (m *GasMeter) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *UpdateIncentiveProposal) GetEpochs() uint32 {
+	if m != nil {
+		return m.Epochs
 	}
-	return dAtA[:n], nil
+	return 0
 }
 
-func (m *GasMeter) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+// Fund defines the coins escrowed by a third party to reward the participants
+// of an incentive in addition to its inflation allocations
+type Fund struct {
+	// contract address of the incentivized smart contract
+	Contract string `protobuf:"bytes,1,opt,name=contract,proto3" json:"contract,omitempty"`
+	// funder is the cosmos bech32 address of the account that escrowed the coins
+	Funder string `protobuf:"bytes,2,opt,name=funder,proto3" json:"funder,omitempty"`
+	// amount is the remaining amount of escrowed coins that has not been distributed yet
+	Amount github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,3,rep,name=amount,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"amount"`
 }
 
-func (m *GasMeter) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.CumulativeGas != 0 {
-		i = encodeVarintIncentives(dAtA, i, uint64(m.CumulativeGas))
-		i--
-		dAtA[i] = 0x18
+func (m *Fund) Reset()         { *m = Fund{} }
+func (m *Fund) String() string { return proto.CompactTextString(m) }
+func (*Fund) ProtoMessage()    {}
+func (*Fund) Descriptor() ([]byte, []int) {
+	return fileDescriptor_95b81e40854aec77, []int{8}
+}
+func (m *Fund) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *Fund) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_Fund.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	if len(m.Participant) > 0 {
-		i -= len(m.Participant)
-		copy(dAtA[i:], m.Participant)
-		i = encodeVarintIncentives(dAtA, i, uint64(len(m.Participant)))
-		i--
-		dAtA[i] = 0x12
+}
+func (m *Fund) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Fund.Merge(m, src)
+}
+func (m *Fund) XXX_Size() int {
+	return m.Size()
+}
+func (m *Fund) XXX_DiscardUnknown() {
+	xxx_messageInfo_Fund.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_Fund proto.InternalMessageInfo
+
+func (m *Fund) GetContract() string {
+	if m != nil {
+		return m.Contract
 	}
-	if len(m.Contract) > 0 {
-		i -= len(m.Contract)
-		copy(dAtA[i:], m.Contract)
-		i = encodeVarintIncentives(dAtA, i, uint64(len(m.Contract)))
-		i--
-		dAtA[i] = 0xa
+	return ""
+}
+
+func (m *Fund) GetFunder() string {
+	if m != nil {
+		return m.Funder
 	}
-	return len(dAtA) - i, nil
+	return ""
 }
 
-func (m *RegisterIncentiveProposal) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *Fund) GetAmount() github_com_cosmos_cosmos_sdk_types.Coins {
+	if m != nil {
+		return m.Amount
 	}
-	return dAtA[:n], nil
+	return nil
 }
 
-func (m *RegisterIncentiveProposal) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+// RewardPeriod tracks the rewards of an incentive for one epoch. While the
+// period is ongoing, it accumulates the total weight of its participants. Once
+// the epoch ends, it records the rewards per unit of weight, which are settled
+// lazily for each gas meter of the period.
+type RewardPeriod struct {
+	// contract address of the incentivized smart contract
+	Contract string `protobuf:"bytes,1,opt,name=contract,proto3" json:"contract,omitempty"`
+	// period is the index of the reward period
+	Period uint64 `protobuf:"varint,2,opt,name=period,proto3" json:"period,omitempty"`
+	// weighting used to weight the participants during the period
+	Weighting Weighting `protobuf:"bytes,3,opt,name=weighting,proto3" json:"weighting"`
+	// total_weight is the sum of the weights of all participants of the period
+	TotalWeight github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,4,opt,name=total_weight,json=totalWeight,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"total_weight"`
+	// gas_meters is the number of gas meters of the period that have not been settled yet
+	GasMeters uint64 `protobuf:"varint,5,opt,name=gas_meters,json=gasMeters,proto3" json:"gas_meters,omitempty"`
+	// reward_per_weight is the inflation allocation distributed per unit of weight
+	RewardPerWeight github_com_cosmos_cosmos_sdk_types.DecCoins `protobuf:"bytes,6,rep,name=reward_per_weight,json=rewardPerWeight,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.DecCoins" json:"reward_per_weight"`
+	// fund_reward_per_weight is the funded allocation distributed per unit of weight
+	FundRewardPerWeight github_com_cosmos_cosmos_sdk_types.DecCoins `protobuf:"bytes,7,rep,name=fund_reward_per_weight,json=fundRewardPerWeight,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.DecCoins" json:"fund_reward_per_weight"`
+	// capped_denom is the denomination of the inflation rewards capped by the gas spent
+	CappedDenom string `protobuf:"bytes,8,opt,name=capped_denom,json=cappedDenom,proto3" json:"capped_denom,omitempty"`
+	// reward_scaler is the scaling factor used to cap the rewards
+	RewardScaler github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,9,opt,name=reward_scaler,json=rewardScaler,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"reward_scaler"`
+	// remaining is the amount of reserved rewards that has not been settled yet
+	Remaining github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,10,rep,name=remaining,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"remaining"`
+	// fund_remaining is the part of the remaining rewards that was reserved from
+	// the incentive funds
+	FundRemaining github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,11,rep,name=fund_remaining,json=fundRemaining,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"fund_remaining"`
+	// funds are the coins reserved from each funder of the incentive for the
+	// period, used to refund the funded rewards that are not settled
+	Funds []Fund `protobuf:"bytes,12,rep,name=funds,proto3" json:"funds"`
+	// end_time is the block time at which the reward period was finalized
+	EndTime time.Time `protobuf:"bytes,13,opt,name=end_time,json=endTime,proto3,stdtime" json:"end_time"`
 }
 
-func (m *RegisterIncentiveProposal) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.Epochs != 0 {
-		i = encodeVarintIncentives(dAtA, i, uint64(m.Epochs))
-		i--
-		dAtA[i] = 0x28
-	}
-	if len(m.Allocations) > 0 {
-		for iNdEx := len(m.Allocations) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.Allocations[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintIncentives(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0x22
+func (m *RewardPeriod) Reset()         { *m = RewardPeriod{} }
+func (m *RewardPeriod) String() string { return proto.CompactTextString(m) }
+func (*RewardPeriod) ProtoMessage()    {}
+func (*RewardPeriod) Descriptor() ([]byte, []int) {
+	return fileDescriptor_95b81e40854aec77, []int{9}
+}
+func (m *RewardPeriod) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *RewardPeriod) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_RewardPeriod.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
+		return b[:n], nil
 	}
-	if len(m.Contract) > 0 {
-		i -= len(m.Contract)
-		copy(dAtA[i:], m.Contract)
-		i = encodeVarintIncentives(dAtA, i, uint64(len(m.Contract)))
-		i--
-		dAtA[i] = 0x1a
+}
+func (m *RewardPeriod) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_RewardPeriod.Merge(m, src)
+}
+func (m *RewardPeriod) XXX_Size() int {
+	return m.Size()
+}
+func (m *RewardPeriod) XXX_DiscardUnknown() {
+	xxx_messageInfo_RewardPeriod.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_RewardPeriod proto.InternalMessageInfo
+
+func (m *RewardPeriod) GetContract() string {
+	if m != nil {
+		return m.Contract
 	}
-	if len(m.Description) > 0 {
-		i -= len(m.Description)
-		copy(dAtA[i:], m.Description)
-		i = encodeVarintIncentives(dAtA, i, uint64(len(m.Description)))
-		i--
-		dAtA[i] = 0x12
+	return ""
+}
+
+func (m *RewardPeriod) GetPeriod() uint64 {
+	if m != nil {
+		return m.Period
 	}
-	if len(m.Title) > 0 {
-		i -= len(m.Title)
-		copy(dAtA[i:], m.Title)
-		i = encodeVarintIncentives(dAtA, i, uint64(len(m.Title)))
-		i--
-		dAtA[i] = 0xa
+	return 0
+}
+
+func (m *RewardPeriod) GetWeighting() Weighting {
+	if m != nil {
+		return m.Weighting
 	}
-	return len(dAtA) - i, nil
+	return Weighting{}
 }
 
-func (m *CancelIncentiveProposal) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *RewardPeriod) GetGasMeters() uint64 {
+	if m != nil {
+		return m.GasMeters
 	}
-	return dAtA[:n], nil
+	return 0
 }
 
-func (m *CancelIncentiveProposal) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *RewardPeriod) GetRewardPerWeight() github_com_cosmos_cosmos_sdk_types.DecCoins {
+	if m != nil {
+		return m.RewardPerWeight
+	}
+	return nil
 }
 
-func (m *CancelIncentiveProposal) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.Contract) > 0 {
-		i -= len(m.Contract)
-		copy(dAtA[i:], m.Contract)
-		i = encodeVarintIncentives(dAtA, i, uint64(len(m.Contract)))
-		i--
-		dAtA[i] = 0x1a
+func (m *RewardPeriod) GetFundRewardPerWeight() github_com_cosmos_cosmos_sdk_types.DecCoins {
+	if m != nil {
+		return m.FundRewardPerWeight
 	}
-	if len(m.Description) > 0 {
-		i -= len(m.Description)
-		copy(dAtA[i:], m.Description)
-		i = encodeVarintIncentives(dAtA, i, uint64(len(m.Description)))
-		i--
-		dAtA[i] = 0x12
+	return nil
+}
+
+func (m *RewardPeriod) GetCappedDenom() string {
+	if m != nil {
+		return m.CappedDenom
 	}
-	if len(m.Title) > 0 {
-		i -= len(m.Title)
-		copy(dAtA[i:], m.Title)
-		i = encodeVarintIncentives(dAtA, i, uint64(len(m.Title)))
-		i--
-		dAtA[i] = 0xa
+	return ""
+}
+
+func (m *RewardPeriod) GetRemaining() github_com_cosmos_cosmos_sdk_types.Coins {
+	if m != nil {
+		return m.Remaining
 	}
-	return len(dAtA) - i, nil
+	return nil
 }
 
-func encodeVarintIncentives(dAtA []byte, offset int, v uint64) int {
-	offset -= sovIncentives(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
+func (m *RewardPeriod) GetFundRemaining() github_com_cosmos_cosmos_sdk_types.Coins {
+	if m != nil {
+		return m.FundRemaining
 	}
-	dAtA[offset] = uint8(v)
-	return base
+	return nil
 }
-func (m *Incentive) Size() (n int) {
-	if m == nil {
-		return 0
+
+func (m *RewardPeriod) GetFunds() []Fund {
+	if m != nil {
+		return m.Funds
 	}
-	var l int
-	_ = l
-	l = len(m.Contract)
-	if l > 0 {
-		n += 1 + l + sovIncentives(uint64(l))
+	return nil
+}
+
+func (m *RewardPeriod) GetEndTime() time.Time {
+	if m != nil {
+		return m.EndTime
 	}
-	if len(m.Allocations) > 0 {
-		for _, e := range m.Allocations {
-			l = e.Size()
-			n += 1 + l + sovIncentives(uint64(l))
+	return time.Time{}
+}
+
+// AccruedRewards defines the settled rewards of a participant that have not
+// been claimed yet
+type AccruedRewards struct {
+	// participant is the hex address of the participant
+	Participant string `protobuf:"bytes,1,opt,name=participant,proto3" json:"participant,omitempty"`
+	// rewards is the amount of claimable rewards
+	Rewards github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,2,rep,name=rewards,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"rewards"`
+}
+
+func (m *AccruedRewards) Reset()         { *m = AccruedRewards{} }
+func (m *AccruedRewards) String() string { return proto.CompactTextString(m) }
+func (*AccruedRewards) ProtoMessage()    {}
+func (*AccruedRewards) Descriptor() ([]byte, []int) {
+	return fileDescriptor_95b81e40854aec77, []int{10}
+}
+func (m *AccruedRewards) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *AccruedRewards) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_AccruedRewards.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
+		return b[:n], nil
 	}
-	if m.Epochs != 0 {
-		n += 1 + sovIncentives(uint64(m.Epochs))
-	}
-	l = github_com_gogo_protobuf_types.SizeOfStdTime(m.StartTime)
-	n += 1 + l + sovIncentives(uint64(l))
-	if m.TotalGas != 0 {
-		n += 1 + sovIncentives(uint64(m.TotalGas))
-	}
-	return n
+}
+func (m *AccruedRewards) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_AccruedRewards.Merge(m, src)
+}
+func (m *AccruedRewards) XXX_Size() int {
+	return m.Size()
+}
+func (m *AccruedRewards) XXX_DiscardUnknown() {
+	xxx_messageInfo_AccruedRewards.DiscardUnknown(m)
 }
 
-func (m *GasMeter) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.Contract)
-	if l > 0 {
-		n += 1 + l + sovIncentives(uint64(l))
-	}
-	l = len(m.Participant)
-	if l > 0 {
-		n += 1 + l + sovIncentives(uint64(l))
-	}
-	if m.CumulativeGas != 0 {
-		n += 1 + sovIncentives(uint64(m.CumulativeGas))
+var xxx_messageInfo_AccruedRewards proto.InternalMessageInfo
+
+func (m *AccruedRewards) GetParticipant() string {
+	if m != nil {
+		return m.Participant
 	}
-	return n
+	return ""
 }
 
-func (m *RegisterIncentiveProposal) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *AccruedRewards) GetRewards() github_com_cosmos_cosmos_sdk_types.Coins {
+	if m != nil {
+		return m.Rewards
 	}
+	return nil
+}
+
+// IncentivePayout defines the summary of the rewards reserved for the
+// participants of an incentive at the end of a reward period
+type IncentivePayout struct {
+	// contract address of the incentivized smart contract
+	Contract string `protobuf:"bytes,1,opt,name=contract,proto3" json:"contract,omitempty"`
+	// period is the index of the reward period of the incentive
+	Period uint64 `protobuf:"varint,2,opt,name=period,proto3" json:"period,omitempty"`
+	// end_time is the block time at which the reward period was finalized
+	EndTime time.Time `protobuf:"bytes,3,opt,name=end_time,json=endTime,proto3,stdtime" json:"end_time"`
+	// participants is the number of participants of the period
+	Participants uint64 `protobuf:"varint,4,opt,name=participants,proto3" json:"participants,omitempty"`
+	// total_gas is the gas spent by all participants during the period
+	TotalGas uint64 `protobuf:"varint,5,opt,name=total_gas,json=totalGas,proto3" json:"total_gas,omitempty"`
+	// rewards is the amount of rewards reserved for the participants of the period
+	Rewards github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,6,rep,name=rewards,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"rewards"`
+}
+
+func (m *IncentivePayout) Reset()         { *m = IncentivePayout{} }
+func (m *IncentivePayout) String() string { return proto.CompactTextString(m) }
+func (*IncentivePayout) ProtoMessage()    {}
+func (*IncentivePayout) Descriptor() ([]byte, []int) {
+	return fileDescriptor_95b81e40854aec77, []int{11}
+}
+func (m *IncentivePayout) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *IncentivePayout) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_IncentivePayout.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *IncentivePayout) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_IncentivePayout.Merge(m, src)
+}
+func (m *IncentivePayout) XXX_Size() int {
+	return m.Size()
+}
+func (m *IncentivePayout) XXX_DiscardUnknown() {
+	xxx_messageInfo_IncentivePayout.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_IncentivePayout proto.InternalMessageInfo
+
+func (m *IncentivePayout) GetContract() string {
+	if m != nil {
+		return m.Contract
+	}
+	return ""
+}
+
+func (m *IncentivePayout) GetPeriod() uint64 {
+	if m != nil {
+		return m.Period
+	}
+	return 0
+}
+
+func (m *IncentivePayout) GetEndTime() time.Time {
+	if m != nil {
+		return m.EndTime
+	}
+	return time.Time{}
+}
+
+func (m *IncentivePayout) GetParticipants() uint64 {
+	if m != nil {
+		return m.Participants
+	}
+	return 0
+}
+
+func (m *IncentivePayout) GetTotalGas() uint64 {
+	if m != nil {
+		return m.TotalGas
+	}
+	return 0
+}
+
+func (m *IncentivePayout) GetRewards() github_com_cosmos_cosmos_sdk_types.Coins {
+	if m != nil {
+		return m.Rewards
+	}
+	return nil
+}
+
+// ParticipantPayout defines the rewards earned by a participant of an
+// incentive during a reward period
+type ParticipantPayout struct {
+	// contract address of the incentivized smart contract
+	Contract string `protobuf:"bytes,1,opt,name=contract,proto3" json:"contract,omitempty"`
+	// participant is the hex address of the participant
+	Participant string `protobuf:"bytes,2,opt,name=participant,proto3" json:"participant,omitempty"`
+	// period is the index of the reward period of the incentive
+	Period uint64 `protobuf:"varint,3,opt,name=period,proto3" json:"period,omitempty"`
+	// cumulative_gas spent by the participant during the period
+	CumulativeGas uint64 `protobuf:"varint,4,opt,name=cumulative_gas,json=cumulativeGas,proto3" json:"cumulative_gas,omitempty"`
+	// rewards is the amount of rewards earned by the participant
+	Rewards github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,5,rep,name=rewards,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"rewards"`
+}
+
+func (m *ParticipantPayout) Reset()         { *m = ParticipantPayout{} }
+func (m *ParticipantPayout) String() string { return proto.CompactTextString(m) }
+func (*ParticipantPayout) ProtoMessage()    {}
+func (*ParticipantPayout) Descriptor() ([]byte, []int) {
+	return fileDescriptor_95b81e40854aec77, []int{12}
+}
+func (m *ParticipantPayout) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ParticipantPayout) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ParticipantPayout.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *ParticipantPayout) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ParticipantPayout.Merge(m, src)
+}
+func (m *ParticipantPayout) XXX_Size() int {
+	return m.Size()
+}
+func (m *ParticipantPayout) XXX_DiscardUnknown() {
+	xxx_messageInfo_ParticipantPayout.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ParticipantPayout proto.InternalMessageInfo
+
+func (m *ParticipantPayout) GetContract() string {
+	if m != nil {
+		return m.Contract
+	}
+	return ""
+}
+
+func (m *ParticipantPayout) GetParticipant() string {
+	if m != nil {
+		return m.Participant
+	}
+	return ""
+}
+
+func (m *ParticipantPayout) GetPeriod() uint64 {
+	if m != nil {
+		return m.Period
+	}
+	return 0
+}
+
+func (m *ParticipantPayout) GetCumulativeGas() uint64 {
+	if m != nil {
+		return m.CumulativeGas
+	}
+	return 0
+}
+
+func (m *ParticipantPayout) GetRewards() github_com_cosmos_cosmos_sdk_types.Coins {
+	if m != nil {
+		return m.Rewards
+	}
+	return nil
+}
+
+func init() {
+	proto.RegisterEnum("evmos.incentives.v1.WeightingStrategy", WeightingStrategy_name, WeightingStrategy_value)
+	proto.RegisterType((*Incentive)(nil), "evmos.incentives.v1.Incentive")
+	proto.RegisterType((*Weighting)(nil), "evmos.incentives.v1.Weighting")
+	proto.RegisterType((*Eligibility)(nil), "evmos.incentives.v1.Eligibility")
+	proto.RegisterType((*Participant)(nil), "evmos.incentives.v1.Participant")
+	proto.RegisterType((*GasMeter)(nil), "evmos.incentives.v1.GasMeter")
+	proto.RegisterType((*RegisterIncentiveProposal)(nil), "evmos.incentives.v1.RegisterIncentiveProposal")
+	proto.RegisterType((*CancelIncentiveProposal)(nil), "evmos.incentives.v1.CancelIncentiveProposal")
+	proto.RegisterType((*UpdateIncentiveProposal)(nil), "evmos.incentives.v1.UpdateIncentiveProposal")
+	proto.RegisterType((*Fund)(nil), "evmos.incentives.v1.Fund")
+	proto.RegisterType((*RewardPeriod)(nil), "evmos.incentives.v1.RewardPeriod")
+	proto.RegisterType((*AccruedRewards)(nil), "evmos.incentives.v1.AccruedRewards")
+	proto.RegisterType((*IncentivePayout)(nil), "evmos.incentives.v1.IncentivePayout")
+	proto.RegisterType((*ParticipantPayout)(nil), "evmos.incentives.v1.ParticipantPayout")
+}
+
+func init() {
+	proto.RegisterFile("evmos/incentives/v1/incentives.proto", fileDescriptor_95b81e40854aec77)
+}
+
+var fileDescriptor_95b81e40854aec77 = []byte{
+	// 1241 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xe4, 0x57, 0x4f, 0x6f, 0x1b, 0x45,
+	0x14, 0xf7, 0x66, 0x1d, 0xff, 0x79, 0xeb, 0xa4, 0xed, 0xb4, 0xa4, 0xdb, 0x94, 0xda, 0xae, 0x05,
+	0x55, 0x54, 0xd4, 0x75, 0x93, 0x8a, 0x0b, 0x17, 0x64, 0x3b, 0xae, 0x6b, 0x21, 0x2a, 0x77, 0xed,
+	0xaa, 0x82, 0x8b, 0x35, 0xde, 0x9d, 0x6e, 0x46, 0xdd, 0x7f, 0xda, 0x19, 0xa7, 0xe4, 0x80, 0xc4,
+	0x09, 0x38, 0xf6, 0xca, 0x09, 0x24, 0x6e, 0x1c, 0xf8, 0x04, 0x7c, 0x80, 0x72, 0xa2, 0x47, 0xc4,
+	0xa1, 0x45, 0xed, 0x85, 0x6f, 0xc0, 0x85, 0x03, 0x9a, 0xd9, 0xdd, 0x78, 0xdd, 0x58, 0x69, 0x1b,
+	0x25, 0x15, 0x12, 0x97, 0xc4, 0xf3, 0xfc, 0xfe, 0xfc, 0xde, 0x7b, 0xbf, 0x79, 0xf3, 0x0c, 0xef,
+	0x91, 0x5d, 0x2f, 0x60, 0x4d, 0xea, 0x5b, 0xc4, 0xe7, 0x74, 0x97, 0xb0, 0xe6, 0xee, 0x66, 0xe6,
+	0x64, 0x84, 0x51, 0xc0, 0x03, 0x74, 0x56, 0x6a, 0x19, 0x19, 0xf9, 0xee, 0xe6, 0x7a, 0xd5, 0x0a,
+	0x98, 0xb0, 0x9d, 0x60, 0x46, 0x9a, 0xbb, 0x9b, 0x13, 0xc2, 0xf1, 0x66, 0xd3, 0x0a, 0xa8, 0x1f,
+	0x1b, 0xad, 0x9f, 0x73, 0x02, 0x27, 0x90, 0x1f, 0x9b, 0xe2, 0x53, 0x22, 0xad, 0x39, 0x41, 0xe0,
+	0xb8, 0xa4, 0x29, 0x4f, 0x93, 0xe9, 0xfd, 0x26, 0xa7, 0x1e, 0x61, 0x1c, 0x7b, 0x61, 0xac, 0xd0,
+	0xf8, 0x45, 0x85, 0x72, 0x3f, 0x0d, 0x84, 0xd6, 0xa1, 0x64, 0x05, 0x3e, 0x8f, 0xb0, 0xc5, 0x75,
+	0xa5, 0xae, 0x6c, 0x94, 0xcd, 0xfd, 0x33, 0x62, 0xa0, 0x61, 0xd7, 0x0d, 0x2c, 0xcc, 0x69, 0xe0,
+	0x33, 0x7d, 0xa9, 0xae, 0x6e, 0x68, 0x5b, 0xef, 0x1a, 0x31, 0x2c, 0x43, 0xc0, 0x32, 0x12, 0x58,
+	0xc6, 0x36, 0xb1, 0x3a, 0x01, 0xf5, 0xdb, 0x37, 0x1e, 0x3f, 0xad, 0xe5, 0x7e, 0x7a, 0x56, 0xfb,
+	0xc0, 0xa1, 0x7c, 0x67, 0x3a, 0x31, 0xac, 0xc0, 0x6b, 0x26, 0x69, 0xc4, 0xff, 0xae, 0x31, 0xfb,
+	0x41, 0x93, 0xef, 0x85, 0x84, 0xa5, 0x36, 0xcc, 0xcc, 0x46, 0x41, 0x6b, 0x50, 0x20, 0x61, 0x60,
+	0xed, 0x30, 0x5d, 0xad, 0x2b, 0x1b, 0x2b, 0x66, 0x72, 0x42, 0x1d, 0x00, 0xc6, 0x71, 0xc4, 0xc7,
+	0x22, 0x1f, 0x3d, 0x5f, 0x57, 0x36, 0xb4, 0xad, 0x75, 0x23, 0x4e, 0xd6, 0x48, 0x93, 0x35, 0x46,
+	0x69, 0xb2, 0xed, 0x92, 0x40, 0xf2, 0xe8, 0x59, 0x4d, 0x31, 0xcb, 0xd2, 0x4e, 0x7c, 0x83, 0x2e,
+	0x42, 0x99, 0x07, 0x1c, 0xbb, 0x63, 0x07, 0x33, 0x7d, 0xb9, 0xae, 0x6c, 0xe4, 0xcd, 0x92, 0x14,
+	0xf4, 0x30, 0x43, 0x6d, 0x28, 0x3f, 0x24, 0xd4, 0xd9, 0xe1, 0xd4, 0x77, 0xf4, 0x82, 0x0c, 0x50,
+	0x35, 0x16, 0x34, 0xc6, 0xb8, 0x97, 0x6a, 0xb5, 0xf3, 0x22, 0x88, 0x39, 0x33, 0x43, 0xb7, 0x40,
+	0x23, 0x2e, 0x75, 0xe8, 0x84, 0xba, 0x94, 0xef, 0xe9, 0x45, 0xe9, 0xa5, 0xbe, 0xd0, 0x4b, 0x77,
+	0xa6, 0x97, 0xf8, 0xc9, 0x9a, 0x8a, 0x3a, 0x84, 0x24, 0xa2, 0x81, 0xad, 0x97, 0x24, 0xce, 0xe4,
+	0xd4, 0xd8, 0x81, 0xf2, 0x7e, 0x7c, 0xd4, 0x86, 0x12, 0xe3, 0x11, 0xe6, 0xc4, 0xd9, 0x93, 0xdd,
+	0x5b, 0xdd, 0xba, 0x72, 0x38, 0xe2, 0x61, 0xa2, 0x6d, 0xee, 0xdb, 0xa1, 0xf3, 0x50, 0x74, 0x30,
+	0x1b, 0x5b, 0x38, 0xd4, 0x97, 0xe2, 0x48, 0x0e, 0x66, 0x1d, 0x1c, 0x36, 0xfe, 0x51, 0x40, 0xcb,
+	0x80, 0x44, 0x9f, 0x40, 0xd9, 0xa3, 0xfe, 0x98, 0x71, 0xfc, 0x80, 0xc4, 0x5c, 0x69, 0x1b, 0x02,
+	0xf7, 0x1f, 0x4f, 0x6b, 0x57, 0x5e, 0xa3, 0xdd, 0x7d, 0x9f, 0x9b, 0x25, 0x8f, 0xfa, 0x43, 0x61,
+	0x8f, 0xb6, 0xe0, 0x1d, 0xe1, 0x2c, 0xc4, 0x11, 0xa7, 0x16, 0x0d, 0x65, 0xf3, 0xc7, 0xd8, 0x21,
+	0x09, 0x86, 0xb3, 0x1e, 0xf5, 0x07, 0xd9, 0xef, 0x5a, 0x0e, 0x41, 0x2e, 0x68, 0xc2, 0x66, 0x82,
+	0x5d, 0xec, 0x5b, 0x44, 0x57, 0x25, 0x1f, 0x2f, 0x2c, 0xe4, 0xa3, 0x24, 0xe3, 0xf5, 0x84, 0x8c,
+	0x1b, 0xaf, 0x81, 0x2e, 0x66, 0x22, 0x78, 0xd4, 0x6f, 0xc7, 0xee, 0x1b, 0x0f, 0x40, 0xdb, 0x47,
+	0xe0, 0x73, 0xa4, 0x43, 0x11, 0xdb, 0x76, 0x44, 0x18, 0x4b, 0xee, 0x49, 0x7a, 0x44, 0x97, 0xa1,
+	0x72, 0x9f, 0x46, 0x8c, 0x8f, 0x77, 0x64, 0x95, 0x65, 0x06, 0xaa, 0xa9, 0x49, 0xd9, 0x2d, 0x29,
+	0x42, 0x35, 0xd0, 0x5c, 0x3c, 0xd3, 0x50, 0xa5, 0x06, 0xb8, 0x38, 0x55, 0x68, 0x7c, 0xa3, 0x40,
+	0xa9, 0x87, 0xd9, 0xa7, 0x84, 0x93, 0xe8, 0xd0, 0x3b, 0x59, 0x07, 0x2d, 0x9c, 0xa1, 0x92, 0xb1,
+	0xca, 0x66, 0x56, 0x84, 0xde, 0x87, 0x55, 0x6b, 0xea, 0x4d, 0x5d, 0x2c, 0xba, 0x2f, 0x89, 0xae,
+	0xca, 0x92, 0xae, 0xcc, 0xa4, 0x82, 0xed, 0x33, 0x7e, 0xe5, 0xe7, 0xf8, 0xf5, 0x48, 0x85, 0x0b,
+	0x26, 0x71, 0x28, 0xe3, 0x24, 0xda, 0x1f, 0x13, 0x83, 0x28, 0x08, 0x03, 0x86, 0x5d, 0x74, 0x0e,
+	0x96, 0x39, 0xe5, 0x6e, 0xd2, 0x7f, 0x33, 0x3e, 0x08, 0x50, 0x36, 0x61, 0x56, 0x44, 0x43, 0xd1,
+	0xaa, 0x14, 0x54, 0x46, 0x34, 0x97, 0x92, 0x7a, 0xf8, 0x98, 0xc9, 0xbf, 0xe5, 0x31, 0xb3, 0x3c,
+	0x37, 0x66, 0xfe, 0x53, 0x43, 0xe0, 0xa3, 0xfc, 0x5f, 0x3f, 0xd4, 0x72, 0x0d, 0x06, 0xe7, 0x3b,
+	0x82, 0x92, 0xee, 0x5b, 0xe9, 0x47, 0x12, 0xf4, 0xab, 0x25, 0x38, 0x7f, 0x37, 0xb4, 0x31, 0x27,
+	0xff, 0x3f, 0x16, 0x24, 0x25, 0xf8, 0x5e, 0x81, 0xfc, 0xcd, 0xa9, 0x6f, 0x1f, 0x7a, 0x21, 0xd7,
+	0xa0, 0x70, 0x7f, 0xea, 0xdb, 0x24, 0x4a, 0x12, 0x4e, 0x4e, 0xc8, 0x82, 0x02, 0xf6, 0x82, 0xa9,
+	0xcf, 0x4f, 0x62, 0x4e, 0x25, 0xae, 0x1b, 0xbf, 0x15, 0xa1, 0x62, 0x92, 0x87, 0x38, 0xb2, 0x07,
+	0xf2, 0xf6, 0xbe, 0x0a, 0x69, 0x72, 0xe3, 0x97, 0xb2, 0x37, 0x7e, 0x9e, 0xf2, 0xea, 0xd1, 0x28,
+	0x7f, 0x07, 0x2a, 0xf1, 0xc3, 0x1a, 0x8b, 0xf4, 0xfc, 0x1b, 0x3f, 0x0f, 0xdb, 0xc4, 0x32, 0x35,
+	0xe9, 0x23, 0x0e, 0x83, 0x2e, 0x01, 0x88, 0x77, 0xc9, 0x13, 0x23, 0x31, 0x7d, 0xac, 0xcb, 0x4e,
+	0x32, 0x23, 0x19, 0xfa, 0x12, 0xce, 0x44, 0x32, 0xf3, 0x71, 0x48, 0xa2, 0x34, 0x6c, 0xe1, 0xa4,
+	0x58, 0x73, 0x2a, 0x4a, 0xab, 0x9c, 0xa0, 0xfb, 0x5a, 0x81, 0x35, 0xd1, 0xe9, 0xf1, 0x41, 0x10,
+	0xc5, 0x93, 0x02, 0x71, 0x56, 0x04, 0x34, 0x5f, 0x02, 0x72, 0x19, 0x2a, 0x16, 0x0e, 0x43, 0x62,
+	0x8f, 0x6d, 0xe2, 0x07, 0x9e, 0xdc, 0x16, 0xca, 0xa6, 0x16, 0xcb, 0xb6, 0x85, 0x08, 0x0d, 0x61,
+	0x25, 0x41, 0xc9, 0x2c, 0xec, 0x92, 0x48, 0x2f, 0x1f, 0xa9, 0x3b, 0x95, 0xd8, 0xc9, 0x50, 0xfa,
+	0x40, 0x14, 0xca, 0x11, 0xf1, 0x30, 0xf5, 0x05, 0x6b, 0xe0, 0xf8, 0x29, 0x3e, 0xf3, 0x8e, 0x22,
+	0x58, 0x4d, 0x4a, 0x9d, 0xc6, 0xd3, 0x8e, 0x3f, 0xde, 0x4a, 0x5c, 0xdc, 0x34, 0xe6, 0x87, 0xb0,
+	0x2c, 0x04, 0x4c, 0xaf, 0x24, 0xa1, 0x16, 0x5d, 0x08, 0x31, 0x1c, 0x92, 0xbb, 0x10, 0x6b, 0xa3,
+	0x8f, 0xa1, 0x44, 0x7c, 0x3b, 0xde, 0x51, 0x57, 0xde, 0x60, 0x47, 0x2d, 0x12, 0xdf, 0x16, 0xf2,
+	0xc6, 0x77, 0x0a, 0xac, 0xb6, 0x2c, 0x2b, 0x9a, 0x92, 0xa4, 0xd3, 0xec, 0xe5, 0x27, 0x5f, 0x39,
+	0xf8, 0xe4, 0x13, 0x28, 0xc6, 0xbd, 0x49, 0x97, 0xf4, 0x63, 0xad, 0x4c, 0xea, 0xbb, 0xf1, 0xf3,
+	0x12, 0x9c, 0x9a, 0x3d, 0x06, 0x78, 0x2f, 0x98, 0xf2, 0x23, 0x0d, 0x9c, 0x6c, 0x91, 0xd4, 0x23,
+	0x14, 0x09, 0x35, 0xa0, 0x92, 0x49, 0x9f, 0x25, 0x1b, 0xcc, 0x9c, 0xec, 0xf0, 0x55, 0x3f, 0x53,
+	0xb0, 0xc2, 0x09, 0x16, 0xec, 0x6f, 0x05, 0xce, 0x64, 0x76, 0xc8, 0xd7, 0x28, 0xd9, 0xab, 0xd7,
+	0xbb, 0x59, 0x51, 0xd5, 0xb9, 0xa2, 0x1e, 0x5c, 0xfb, 0xf2, 0x8b, 0xd6, 0xbe, 0x4c, 0xe6, 0xcb,
+	0x27, 0x97, 0xf9, 0xd5, 0x5f, 0x15, 0x38, 0x73, 0xe0, 0x47, 0x07, 0x6a, 0x40, 0xf5, 0x5e, 0xb7,
+	0xdf, 0xbb, 0x35, 0xea, 0xdf, 0xee, 0x8d, 0x87, 0x23, 0xb3, 0x35, 0xea, 0xf6, 0x3e, 0x1b, 0xdf,
+	0xbd, 0x3d, 0x1c, 0x74, 0x3b, 0xfd, 0x9b, 0xfd, 0xee, 0xf6, 0xe9, 0x1c, 0x5a, 0x87, 0xb5, 0x05,
+	0x3a, 0xbd, 0xd6, 0xf0, 0xb4, 0x82, 0xae, 0xc2, 0x95, 0x85, 0xf6, 0xfd, 0x3b, 0x77, 0xbb, 0xe3,
+	0x41, 0xcb, 0x1c, 0xf5, 0x3b, 0xfd, 0x41, 0xeb, 0xf6, 0x68, 0x78, 0x7a, 0x09, 0x5d, 0x86, 0x4b,
+	0x0b, 0x74, 0x3b, 0xad, 0xc1, 0xa0, 0xbb, 0x2d, 0xdd, 0xa9, 0xa8, 0x06, 0x17, 0x17, 0xa8, 0x0c,
+	0xef, 0x98, 0x23, 0xa9, 0x90, 0x5f, 0xcf, 0x7f, 0xfb, 0x63, 0x35, 0xd7, 0xee, 0x3d, 0x7e, 0x5e,
+	0x55, 0x9e, 0x3c, 0xaf, 0x2a, 0x7f, 0x3e, 0xaf, 0x2a, 0x8f, 0x5e, 0x54, 0x73, 0x4f, 0x5e, 0x54,
+	0x73, 0xbf, 0xbf, 0xa8, 0xe6, 0x3e, 0xbf, 0x96, 0x29, 0x4c, 0xfc, 0x3b, 0x3f, 0xfe, 0xbb, 0xbb,
+	0x79, 0xbd, 0xf9, 0x45, 0xf6, 0x37, 0xbf, 0xac, 0xd1, 0xa4, 0x20, 0xd9, 0x7d, 0xe3, 0xdf, 0x01,
+	0x00, 0x81, 0xe7, 0xcf, 0x85, 0x14, 0x10, 0x00, 0x00,
+}
+
+func (m *Incentive) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *Incentive) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *Incentive) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.Title)
-	if l > 0 {
-		n += 1 + l + sovIncentives(uint64(l))
+	if m.Period != 0 {
+		i = encodeVarintIncentives(dAtA, i, uint64(m.Period))
+		i--
+		dAtA[i] = 0x40
 	}
-	l = len(m.Description)
-	if l > 0 {
-		n += 1 + l + sovIncentives(uint64(l))
+	{
+		size, err := m.Eligibility.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintIncentives(dAtA, i, uint64(size))
 	}
-	l = len(m.Contract)
-	if l > 0 {
-		n += 1 + l + sovIncentives(uint64(l))
+	i--
+	dAtA[i] = 0x3a
+	{
+		size, err := m.Weighting.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintIncentives(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x32
+	if m.TotalGas != 0 {
+		i = encodeVarintIncentives(dAtA, i, uint64(m.TotalGas))
+		i--
+		dAtA[i] = 0x28
+	}
+	n3, err3 := github_com_gogo_protobuf_types.StdTimeMarshalTo(m.StartTime, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(m.StartTime):])
+	if err3 != nil {
+		return 0, err3
+	}
+	i -= n3
+	i = encodeVarintIncentives(dAtA, i, uint64(n3))
+	i--
+	dAtA[i] = 0x22
+	if m.Epochs != 0 {
+		i = encodeVarintIncentives(dAtA, i, uint64(m.Epochs))
+		i--
+		dAtA[i] = 0x18
 	}
 	if len(m.Allocations) > 0 {
-		for _, e := range m.Allocations {
-			l = e.Size()
-			n += 1 + l + sovIncentives(uint64(l))
+		for iNdEx := len(m.Allocations) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Allocations[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintIncentives(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if len(m.Contract) > 0 {
+		i -= len(m.Contract)
+		copy(dAtA[i:], m.Contract)
+		i = encodeVarintIncentives(dAtA, i, uint64(len(m.Contract)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *Weighting) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *Weighting) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *Weighting) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.GasCap != 0 {
+		i = encodeVarintIncentives(dAtA, i, uint64(m.GasCap))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.Strategy != 0 {
+		i = encodeVarintIncentives(dAtA, i, uint64(m.Strategy))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *Eligibility) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *Eligibility) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *Eligibility) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.MinBalance) > 0 {
+		for iNdEx := len(m.MinBalance) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.MinBalance[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintIncentives(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	if m.MinParticipationAge != 0 {
+		i = encodeVarintIncentives(dAtA, i, uint64(m.MinParticipationAge))
+		i--
+		dAtA[i] = 0x10
+	}
+	{
+		size := m.MinStake.Size()
+		i -= size
+		if _, err := m.MinStake.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintIncentives(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *Participant) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *Participant) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *Participant) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.LastHeight != 0 {
+		i = encodeVarintIncentives(dAtA, i, uint64(m.LastHeight))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.FirstHeight != 0 {
+		i = encodeVarintIncentives(dAtA, i, uint64(m.FirstHeight))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Address) > 0 {
+		i -= len(m.Address)
+		copy(dAtA[i:], m.Address)
+		i = encodeVarintIncentives(dAtA, i, uint64(len(m.Address)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *GasMeter) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *GasMeter) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *GasMeter) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Period != 0 {
+		i = encodeVarintIncentives(dAtA, i, uint64(m.Period))
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.CumulativeGas != 0 {
+		i = encodeVarintIncentives(dAtA, i, uint64(m.CumulativeGas))
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.Participant) > 0 {
+		i -= len(m.Participant)
+		copy(dAtA[i:], m.Participant)
+		i = encodeVarintIncentives(dAtA, i, uint64(len(m.Participant)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Contract) > 0 {
+		i -= len(m.Contract)
+		copy(dAtA[i:], m.Contract)
+		i = encodeVarintIncentives(dAtA, i, uint64(len(m.Contract)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *RegisterIncentiveProposal) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *RegisterIncentiveProposal) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *RegisterIncentiveProposal) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.Eligibility.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintIncentives(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x3a
+	{
+		size, err := m.Weighting.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintIncentives(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x32
+	if m.Epochs != 0 {
+		i = encodeVarintIncentives(dAtA, i, uint64(m.Epochs))
+		i--
+		dAtA[i] = 0x28
+	}
+	if len(m.Allocations) > 0 {
+		for iNdEx := len(m.Allocations) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Allocations[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintIncentives(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x22
+		}
+	}
+	if len(m.Contract) > 0 {
+		i -= len(m.Contract)
+		copy(dAtA[i:], m.Contract)
+		i = encodeVarintIncentives(dAtA, i, uint64(len(m.Contract)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Description) > 0 {
+		i -= len(m.Description)
+		copy(dAtA[i:], m.Description)
+		i = encodeVarintIncentives(dAtA, i, uint64(len(m.Description)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Title) > 0 {
+		i -= len(m.Title)
+		copy(dAtA[i:], m.Title)
+		i = encodeVarintIncentives(dAtA, i, uint64(len(m.Title)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *CancelIncentiveProposal) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *CancelIncentiveProposal) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *CancelIncentiveProposal) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Contract) > 0 {
+		i -= len(m.Contract)
+		copy(dAtA[i:], m.Contract)
+		i = encodeVarintIncentives(dAtA, i, uint64(len(m.Contract)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Description) > 0 {
+		i -= len(m.Description)
+		copy(dAtA[i:], m.Description)
+		i = encodeVarintIncentives(dAtA, i, uint64(len(m.Description)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Title) > 0 {
+		i -= len(m.Title)
+		copy(dAtA[i:], m.Title)
+		i = encodeVarintIncentives(dAtA, i, uint64(len(m.Title)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *UpdateIncentiveProposal) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *UpdateIncentiveProposal) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *UpdateIncentiveProposal) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Epochs != 0 {
+		i = encodeVarintIncentives(dAtA, i, uint64(m.Epochs))
+		i--
+		dAtA[i] = 0x28
+	}
+	if len(m.Allocations) > 0 {
+		for iNdEx := len(m.Allocations) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Allocations[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintIncentives(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x22
+		}
+	}
+	if len(m.Contract) > 0 {
+		i -= len(m.Contract)
+		copy(dAtA[i:], m.Contract)
+		i = encodeVarintIncentives(dAtA, i, uint64(len(m.Contract)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Description) > 0 {
+		i -= len(m.Description)
+		copy(dAtA[i:], m.Description)
+		i = encodeVarintIncentives(dAtA, i, uint64(len(m.Description)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Title) > 0 {
+		i -= len(m.Title)
+		copy(dAtA[i:], m.Title)
+		i = encodeVarintIncentives(dAtA, i, uint64(len(m.Title)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *Fund) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *Fund) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *Fund) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Amount) > 0 {
+		for iNdEx := len(m.Amount) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Amount[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintIncentives(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	if len(m.Funder) > 0 {
+		i -= len(m.Funder)
+		copy(dAtA[i:], m.Funder)
+		i = encodeVarintIncentives(dAtA, i, uint64(len(m.Funder)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Contract) > 0 {
+		i -= len(m.Contract)
+		copy(dAtA[i:], m.Contract)
+		i = encodeVarintIncentives(dAtA, i, uint64(len(m.Contract)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *RewardPeriod) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *RewardPeriod) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *RewardPeriod) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	n6, err6 := github_com_gogo_protobuf_types.StdTimeMarshalTo(m.EndTime, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(m.EndTime):])
+	if err6 != nil {
+		return 0, err6
+	}
+	i -= n6
+	i = encodeVarintIncentives(dAtA, i, uint64(n6))
+	i--
+	dAtA[i] = 0x6a
+	if len(m.Funds) > 0 {
+		for iNdEx := len(m.Funds) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Funds[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintIncentives(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x62
+		}
+	}
+	if len(m.FundRemaining) > 0 {
+		for iNdEx := len(m.FundRemaining) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.FundRemaining[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintIncentives(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x5a
+		}
+	}
+	if len(m.Remaining) > 0 {
+		for iNdEx := len(m.Remaining) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Remaining[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintIncentives(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x52
+		}
+	}
+	{
+		size := m.RewardScaler.Size()
+		i -= size
+		if _, err := m.RewardScaler.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintIncentives(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x4a
+	if len(m.CappedDenom) > 0 {
+		i -= len(m.CappedDenom)
+		copy(dAtA[i:], m.CappedDenom)
+		i = encodeVarintIncentives(dAtA, i, uint64(len(m.CappedDenom)))
+		i--
+		dAtA[i] = 0x42
+	}
+	if len(m.FundRewardPerWeight) > 0 {
+		for iNdEx := len(m.FundRewardPerWeight) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.FundRewardPerWeight[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintIncentives(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x3a
+		}
+	}
+	if len(m.RewardPerWeight) > 0 {
+		for iNdEx := len(m.RewardPerWeight) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.RewardPerWeight[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintIncentives(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x32
+		}
+	}
+	if m.GasMeters != 0 {
+		i = encodeVarintIncentives(dAtA, i, uint64(m.GasMeters))
+		i--
+		dAtA[i] = 0x28
+	}
+	{
+		size := m.TotalWeight.Size()
+		i -= size
+		if _, err := m.TotalWeight.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintIncentives(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x22
+	{
+		size, err := m.Weighting.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintIncentives(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	if m.Period != 0 {
+		i = encodeVarintIncentives(dAtA, i, uint64(m.Period))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Contract) > 0 {
+		i -= len(m.Contract)
+		copy(dAtA[i:], m.Contract)
+		i = encodeVarintIncentives(dAtA, i, uint64(len(m.Contract)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *AccruedRewards) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *AccruedRewards) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *AccruedRewards) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Rewards) > 0 {
+		for iNdEx := len(m.Rewards) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Rewards[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintIncentives(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if len(m.Participant) > 0 {
+		i -= len(m.Participant)
+		copy(dAtA[i:], m.Participant)
+		i = encodeVarintIncentives(dAtA, i, uint64(len(m.Participant)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *IncentivePayout) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *IncentivePayout) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *IncentivePayout) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Rewards) > 0 {
+		for iNdEx := len(m.Rewards) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Rewards[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintIncentives(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x32
+		}
+	}
+	if m.TotalGas != 0 {
+		i = encodeVarintIncentives(dAtA, i, uint64(m.TotalGas))
+		i--
+		dAtA[i] = 0x28
+	}
+	if m.Participants != 0 {
+		i = encodeVarintIncentives(dAtA, i, uint64(m.Participants))
+		i--
+		dAtA[i] = 0x20
+	}
+	n8, err8 := github_com_gogo_protobuf_types.StdTimeMarshalTo(m.EndTime, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(m.EndTime):])
+	if err8 != nil {
+		return 0, err8
+	}
+	i -= n8
+	i = encodeVarintIncentives(dAtA, i, uint64(n8))
+	i--
+	dAtA[i] = 0x1a
+	if m.Period != 0 {
+		i = encodeVarintIncentives(dAtA, i, uint64(m.Period))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Contract) > 0 {
+		i -= len(m.Contract)
+		copy(dAtA[i:], m.Contract)
+		i = encodeVarintIncentives(dAtA, i, uint64(len(m.Contract)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *ParticipantPayout) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ParticipantPayout) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ParticipantPayout) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Rewards) > 0 {
+		for iNdEx := len(m.Rewards) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Rewards[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintIncentives(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x2a
+		}
+	}
+	if m.CumulativeGas != 0 {
+		i = encodeVarintIncentives(dAtA, i, uint64(m.CumulativeGas))
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.Period != 0 {
+		i = encodeVarintIncentives(dAtA, i, uint64(m.Period))
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.Participant) > 0 {
+		i -= len(m.Participant)
+		copy(dAtA[i:], m.Participant)
+		i = encodeVarintIncentives(dAtA, i, uint64(len(m.Participant)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Contract) > 0 {
+		i -= len(m.Contract)
+		copy(dAtA[i:], m.Contract)
+		i = encodeVarintIncentives(dAtA, i, uint64(len(m.Contract)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintIncentives(dAtA []byte, offset int, v uint64) int {
+	offset -= sovIncentives(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *Incentive) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Contract)
+	if l > 0 {
+		n += 1 + l + sovIncentives(uint64(l))
+	}
+	if len(m.Allocations) > 0 {
+		for _, e := range m.Allocations {
+			l = e.Size()
+			n += 1 + l + sovIncentives(uint64(l))
+		}
+	}
+	if m.Epochs != 0 {
+		n += 1 + sovIncentives(uint64(m.Epochs))
+	}
+	l = github_com_gogo_protobuf_types.SizeOfStdTime(m.StartTime)
+	n += 1 + l + sovIncentives(uint64(l))
+	if m.TotalGas != 0 {
+		n += 1 + sovIncentives(uint64(m.TotalGas))
+	}
+	l = m.Weighting.Size()
+	n += 1 + l + sovIncentives(uint64(l))
+	l = m.Eligibility.Size()
+	n += 1 + l + sovIncentives(uint64(l))
+	if m.Period != 0 {
+		n += 1 + sovIncentives(uint64(m.Period))
+	}
+	return n
+}
+
+func (m *Weighting) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Strategy != 0 {
+		n += 1 + sovIncentives(uint64(m.Strategy))
+	}
+	if m.GasCap != 0 {
+		n += 1 + sovIncentives(uint64(m.GasCap))
+	}
+	return n
+}
+
+func (m *Eligibility) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.MinStake.Size()
+	n += 1 + l + sovIncentives(uint64(l))
+	if m.MinParticipationAge != 0 {
+		n += 1 + sovIncentives(uint64(m.MinParticipationAge))
+	}
+	if len(m.MinBalance) > 0 {
+		for _, e := range m.MinBalance {
+			l = e.Size()
+			n += 1 + l + sovIncentives(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *Participant) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Address)
+	if l > 0 {
+		n += 1 + l + sovIncentives(uint64(l))
+	}
+	if m.FirstHeight != 0 {
+		n += 1 + sovIncentives(uint64(m.FirstHeight))
+	}
+	if m.LastHeight != 0 {
+		n += 1 + sovIncentives(uint64(m.LastHeight))
+	}
+	return n
+}
+
+func (m *GasMeter) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Contract)
+	if l > 0 {
+		n += 1 + l + sovIncentives(uint64(l))
+	}
+	l = len(m.Participant)
+	if l > 0 {
+		n += 1 + l + sovIncentives(uint64(l))
+	}
+	if m.CumulativeGas != 0 {
+		n += 1 + sovIncentives(uint64(m.CumulativeGas))
+	}
+	if m.Period != 0 {
+		n += 1 + sovIncentives(uint64(m.Period))
+	}
+	return n
+}
+
+func (m *RegisterIncentiveProposal) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Title)
+	if l > 0 {
+		n += 1 + l + sovIncentives(uint64(l))
+	}
+	l = len(m.Description)
+	if l > 0 {
+		n += 1 + l + sovIncentives(uint64(l))
+	}
+	l = len(m.Contract)
+	if l > 0 {
+		n += 1 + l + sovIncentives(uint64(l))
+	}
+	if len(m.Allocations) > 0 {
+		for _, e := range m.Allocations {
+			l = e.Size()
+			n += 1 + l + sovIncentives(uint64(l))
+		}
+	}
+	if m.Epochs != 0 {
+		n += 1 + sovIncentives(uint64(m.Epochs))
+	}
+	l = m.Weighting.Size()
+	n += 1 + l + sovIncentives(uint64(l))
+	l = m.Eligibility.Size()
+	n += 1 + l + sovIncentives(uint64(l))
+	return n
+}
+
+func (m *CancelIncentiveProposal) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Title)
+	if l > 0 {
+		n += 1 + l + sovIncentives(uint64(l))
+	}
+	l = len(m.Description)
+	if l > 0 {
+		n += 1 + l + sovIncentives(uint64(l))
+	}
+	l = len(m.Contract)
+	if l > 0 {
+		n += 1 + l + sovIncentives(uint64(l))
+	}
+	return n
+}
+
+func (m *UpdateIncentiveProposal) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Title)
+	if l > 0 {
+		n += 1 + l + sovIncentives(uint64(l))
+	}
+	l = len(m.Description)
+	if l > 0 {
+		n += 1 + l + sovIncentives(uint64(l))
+	}
+	l = len(m.Contract)
+	if l > 0 {
+		n += 1 + l + sovIncentives(uint64(l))
+	}
+	if len(m.Allocations) > 0 {
+		for _, e := range m.Allocations {
+			l = e.Size()
+			n += 1 + l + sovIncentives(uint64(l))
+		}
+	}
+	if m.Epochs != 0 {
+		n += 1 + sovIncentives(uint64(m.Epochs))
+	}
+	return n
+}
+
+func (m *Fund) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Contract)
+	if l > 0 {
+		n += 1 + l + sovIncentives(uint64(l))
+	}
+	l = len(m.Funder)
+	if l > 0 {
+		n += 1 + l + sovIncentives(uint64(l))
+	}
+	if len(m.Amount) > 0 {
+		for _, e := range m.Amount {
+			l = e.Size()
+			n += 1 + l + sovIncentives(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *RewardPeriod) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Contract)
+	if l > 0 {
+		n += 1 + l + sovIncentives(uint64(l))
+	}
+	if m.Period != 0 {
+		n += 1 + sovIncentives(uint64(m.Period))
+	}
+	l = m.Weighting.Size()
+	n += 1 + l + sovIncentives(uint64(l))
+	l = m.TotalWeight.Size()
+	n += 1 + l + sovIncentives(uint64(l))
+	if m.GasMeters != 0 {
+		n += 1 + sovIncentives(uint64(m.GasMeters))
+	}
+	if len(m.RewardPerWeight) > 0 {
+		for _, e := range m.RewardPerWeight {
+			l = e.Size()
+			n += 1 + l + sovIncentives(uint64(l))
+		}
+	}
+	if len(m.FundRewardPerWeight) > 0 {
+		for _, e := range m.FundRewardPerWeight {
+			l = e.Size()
+			n += 1 + l + sovIncentives(uint64(l))
+		}
+	}
+	l = len(m.CappedDenom)
+	if l > 0 {
+		n += 1 + l + sovIncentives(uint64(l))
+	}
+	l = m.RewardScaler.Size()
+	n += 1 + l + sovIncentives(uint64(l))
+	if len(m.Remaining) > 0 {
+		for _, e := range m.Remaining {
+			l = e.Size()
+			n += 1 + l + sovIncentives(uint64(l))
+		}
+	}
+	if len(m.FundRemaining) > 0 {
+		for _, e := range m.FundRemaining {
+			l = e.Size()
+			n += 1 + l + sovIncentives(uint64(l))
+		}
+	}
+	if len(m.Funds) > 0 {
+		for _, e := range m.Funds {
+			l = e.Size()
+			n += 1 + l + sovIncentives(uint64(l))
+		}
+	}
+	l = github_com_gogo_protobuf_types.SizeOfStdTime(m.EndTime)
+	n += 1 + l + sovIncentives(uint64(l))
+	return n
+}
+
+func (m *AccruedRewards) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Participant)
+	if l > 0 {
+		n += 1 + l + sovIncentives(uint64(l))
+	}
+	if len(m.Rewards) > 0 {
+		for _, e := range m.Rewards {
+			l = e.Size()
+			n += 1 + l + sovIncentives(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *IncentivePayout) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Contract)
+	if l > 0 {
+		n += 1 + l + sovIncentives(uint64(l))
+	}
+	if m.Period != 0 {
+		n += 1 + sovIncentives(uint64(m.Period))
+	}
+	l = github_com_gogo_protobuf_types.SizeOfStdTime(m.EndTime)
+	n += 1 + l + sovIncentives(uint64(l))
+	if m.Participants != 0 {
+		n += 1 + sovIncentives(uint64(m.Participants))
+	}
+	if m.TotalGas != 0 {
+		n += 1 + sovIncentives(uint64(m.TotalGas))
+	}
+	if len(m.Rewards) > 0 {
+		for _, e := range m.Rewards {
+			l = e.Size()
+			n += 1 + l + sovIncentives(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *ParticipantPayout) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Contract)
+	if l > 0 {
+		n += 1 + l + sovIncentives(uint64(l))
+	}
+	l = len(m.Participant)
+	if l > 0 {
+		n += 1 + l + sovIncentives(uint64(l))
+	}
+	if m.Period != 0 {
+		n += 1 + sovIncentives(uint64(m.Period))
+	}
+	if m.CumulativeGas != 0 {
+		n += 1 + sovIncentives(uint64(m.CumulativeGas))
+	}
+	if len(m.Rewards) > 0 {
+		for _, e := range m.Rewards {
+			l = e.Size()
+			n += 1 + l + sovIncentives(uint64(l))
+		}
+	}
+	return n
+}
+
+func sovIncentives(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozIncentives(x uint64) (n int) {
+	return sovIncentives(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *Incentive) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowIncentives
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Incentive: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Incentive: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Contract", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowIncentives
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthIncentives
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthIncentives
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Contract = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Allocations", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowIncentives
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthIncentives
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthIncentives
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Allocations = append(m.Allocations, types.DecCoin{})
+			if err := m.Allocations[len(m.Allocations)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Epochs", wireType)
+			}
+			m.Epochs = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowIncentives
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Epochs |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StartTime", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowIncentives
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthIncentives
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthIncentives
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_gogo_protobuf_types.StdTimeUnmarshal(&m.StartTime, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TotalGas", wireType)
+			}
+			m.TotalGas = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowIncentives
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.TotalGas |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Weighting", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowIncentives
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthIncentives
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthIncentives
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Weighting.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Eligibility", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowIncentives
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthIncentives
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthIncentives
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Eligibility.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 8:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Period", wireType)
+			}
+			m.Period = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowIncentives
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Period |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipIncentives(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthIncentives
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *Weighting) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowIncentives
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Weighting: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Weighting: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Strategy", wireType)
+			}
+			m.Strategy = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowIncentives
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Strategy |= WeightingStrategy(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field GasCap", wireType)
+			}
+			m.GasCap = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowIncentives
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.GasCap |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipIncentives(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthIncentives
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *Eligibility) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowIncentives
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Eligibility: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Eligibility: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MinStake", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowIncentives
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthIncentives
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthIncentives
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.MinStake.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MinParticipationAge", wireType)
+			}
+			m.MinParticipationAge = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowIncentives
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MinParticipationAge |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MinBalance", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowIncentives
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthIncentives
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthIncentives
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.MinBalance = append(m.MinBalance, types.Coin{})
+			if err := m.MinBalance[len(m.MinBalance)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipIncentives(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthIncentives
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *Participant) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowIncentives
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Participant: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Participant: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowIncentives
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthIncentives
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthIncentives
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Address = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FirstHeight", wireType)
+			}
+			m.FirstHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowIncentives
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.FirstHeight |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LastHeight", wireType)
+			}
+			m.LastHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowIncentives
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.LastHeight |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipIncentives(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthIncentives
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *GasMeter) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowIncentives
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: GasMeter: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: GasMeter: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Contract", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowIncentives
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthIncentives
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthIncentives
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Contract = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Participant", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowIncentives
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthIncentives
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthIncentives
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Participant = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CumulativeGas", wireType)
+			}
+			m.CumulativeGas = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowIncentives
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.CumulativeGas |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Period", wireType)
+			}
+			m.Period = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowIncentives
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Period |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipIncentives(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthIncentives
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *RegisterIncentiveProposal) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowIncentives
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: RegisterIncentiveProposal: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: RegisterIncentiveProposal: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Title", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowIncentives
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthIncentives
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthIncentives
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Title = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Description", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowIncentives
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthIncentives
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthIncentives
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Description = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Contract", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowIncentives
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthIncentives
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthIncentives
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Contract = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Allocations", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowIncentives
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthIncentives
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthIncentives
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Allocations = append(m.Allocations, types.DecCoin{})
+			if err := m.Allocations[len(m.Allocations)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Epochs", wireType)
+			}
+			m.Epochs = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowIncentives
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Epochs |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Weighting", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowIncentives
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthIncentives
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthIncentives
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Weighting.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Eligibility", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowIncentives
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthIncentives
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthIncentives
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Eligibility.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipIncentives(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthIncentives
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *CancelIncentiveProposal) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowIncentives
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: CancelIncentiveProposal: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: CancelIncentiveProposal: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Title", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowIncentives
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthIncentives
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthIncentives
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Title = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Description", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowIncentives
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthIncentives
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthIncentives
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Description = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Contract", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowIncentives
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthIncentives
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthIncentives
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Contract = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipIncentives(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthIncentives
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *UpdateIncentiveProposal) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowIncentives
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: UpdateIncentiveProposal: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: UpdateIncentiveProposal: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Title", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowIncentives
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthIncentives
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthIncentives
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Title = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Description", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowIncentives
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthIncentives
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthIncentives
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Description = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Contract", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowIncentives
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthIncentives
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthIncentives
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Contract = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Allocations", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowIncentives
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthIncentives
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthIncentives
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Allocations = append(m.Allocations, types.DecCoin{})
+			if err := m.Allocations[len(m.Allocations)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Epochs", wireType)
+			}
+			m.Epochs = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowIncentives
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Epochs |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipIncentives(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthIncentives
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *Fund) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowIncentives
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Fund: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Fund: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Contract", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowIncentives
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthIncentives
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthIncentives
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Contract = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Funder", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowIncentives
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthIncentives
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthIncentives
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Funder = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowIncentives
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthIncentives
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthIncentives
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Amount = append(m.Amount, types.Coin{})
+			if err := m.Amount[len(m.Amount)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipIncentives(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthIncentives
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
 		}
 	}
-	if m.Epochs != 0 {
-		n += 1 + sovIncentives(uint64(m.Epochs))
-	}
-	return n
-}
 
-func (m *CancelIncentiveProposal) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.Title)
-	if l > 0 {
-		n += 1 + l + sovIncentives(uint64(l))
-	}
-	l = len(m.Description)
-	if l > 0 {
-		n += 1 + l + sovIncentives(uint64(l))
-	}
-	l = len(m.Contract)
-	if l > 0 {
-		n += 1 + l + sovIncentives(uint64(l))
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
 	}
-	return n
-}
-
-func sovIncentives(x uint64) (n int) {
-	return (math_bits.Len64(x|1) + 6) / 7
-}
-func sozIncentives(x uint64) (n int) {
-	return sovIncentives(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+	return nil
 }
-func (m *Incentive) Unmarshal(dAtA []byte) error {
+func (m *RewardPeriod) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -717,15 +3981,252 @@ func (m *Incentive) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: Incentive: wiretype end group for non-group")
+			return fmt.Errorf("proto: RewardPeriod: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: Incentive: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: RewardPeriod: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Contract", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Contract", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowIncentives
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthIncentives
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthIncentives
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Contract = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Period", wireType)
+			}
+			m.Period = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowIncentives
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Period |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Weighting", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowIncentives
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthIncentives
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthIncentives
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Weighting.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TotalWeight", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowIncentives
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthIncentives
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthIncentives
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.TotalWeight.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field GasMeters", wireType)
+			}
+			m.GasMeters = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowIncentives
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.GasMeters |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RewardPerWeight", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowIncentives
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthIncentives
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthIncentives
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.RewardPerWeight = append(m.RewardPerWeight, types.DecCoin{})
+			if err := m.RewardPerWeight[len(m.RewardPerWeight)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FundRewardPerWeight", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowIncentives
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthIncentives
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthIncentives
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.FundRewardPerWeight = append(m.FundRewardPerWeight, types.DecCoin{})
+			if err := m.FundRewardPerWeight[len(m.FundRewardPerWeight)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CappedDenom", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowIncentives
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthIncentives
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthIncentives
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.CappedDenom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 9:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RewardScaler", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -753,11 +4254,13 @@ func (m *Incentive) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Contract = string(dAtA[iNdEx:postIndex])
+			if err := m.RewardScaler.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
-		case 2:
+		case 10:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Allocations", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Remaining", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -784,16 +4287,16 @@ func (m *Incentive) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Allocations = append(m.Allocations, types.DecCoin{})
-			if err := m.Allocations[len(m.Allocations)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.Remaining = append(m.Remaining, types.Coin{})
+			if err := m.Remaining[len(m.Remaining)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 3:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Epochs", wireType)
+		case 11:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FundRemaining", wireType)
 			}
-			m.Epochs = 0
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowIncentives
@@ -803,14 +4306,29 @@ func (m *Incentive) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Epochs |= uint32(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 4:
+			if msglen < 0 {
+				return ErrInvalidLengthIncentives
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthIncentives
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.FundRemaining = append(m.FundRemaining, types.Coin{})
+			if err := m.FundRemaining[len(m.FundRemaining)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 12:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field StartTime", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Funds", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -837,15 +4355,16 @@ func (m *Incentive) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := github_com_gogo_protobuf_types.StdTimeUnmarshal(&m.StartTime, dAtA[iNdEx:postIndex]); err != nil {
+			m.Funds = append(m.Funds, Fund{})
+			if err := m.Funds[len(m.Funds)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 5:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field TotalGas", wireType)
+		case 13:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EndTime", wireType)
 			}
-			m.TotalGas = 0
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowIncentives
@@ -855,11 +4374,25 @@ func (m *Incentive) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.TotalGas |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
+			if msglen < 0 {
+				return ErrInvalidLengthIncentives
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthIncentives
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_gogo_protobuf_types.StdTimeUnmarshal(&m.EndTime, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipIncentives(dAtA[iNdEx:])
@@ -881,7 +4414,7 @@ func (m *Incentive) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *GasMeter) Unmarshal(dAtA []byte) error {
+func (m *AccruedRewards) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -904,15 +4437,15 @@ func (m *GasMeter) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: GasMeter: wiretype end group for non-group")
+			return fmt.Errorf("proto: AccruedRewards: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: GasMeter: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: AccruedRewards: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Contract", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Participant", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -940,13 +4473,13 @@ func (m *GasMeter) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Contract = string(dAtA[iNdEx:postIndex])
+			m.Participant = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Participant", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Rewards", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowIncentives
@@ -956,43 +4489,26 @@ func (m *GasMeter) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthIncentives
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthIncentives
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Participant = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 3:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field CumulativeGas", wireType)
-			}
-			m.CumulativeGas = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowIncentives
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.CumulativeGas |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
+			m.Rewards = append(m.Rewards, types.Coin{})
+			if err := m.Rewards[len(m.Rewards)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipIncentives(dAtA[iNdEx:])
@@ -1014,7 +4530,7 @@ func (m *GasMeter) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *RegisterIncentiveProposal) Unmarshal(dAtA []byte) error {
+func (m *IncentivePayout) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1037,15 +4553,15 @@ func (m *RegisterIncentiveProposal) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: RegisterIncentiveProposal: wiretype end group for non-group")
+			return fmt.Errorf("proto: IncentivePayout: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: RegisterIncentiveProposal: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: IncentivePayout: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Title", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Contract", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -1073,13 +4589,13 @@ func (m *RegisterIncentiveProposal) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Title = string(dAtA[iNdEx:postIndex])
+			m.Contract = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Description", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Period", wireType)
 			}
-			var stringLen uint64
+			m.Period = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowIncentives
@@ -1089,29 +4605,16 @@ func (m *RegisterIncentiveProposal) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				m.Period |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthIncentives
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthIncentives
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Description = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
 		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Contract", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field EndTime", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowIncentives
@@ -1121,29 +4624,30 @@ func (m *RegisterIncentiveProposal) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthIncentives
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthIncentives
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Contract = string(dAtA[iNdEx:postIndex])
+			if err := github_com_gogo_protobuf_types.StdTimeUnmarshal(&m.EndTime, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		case 4:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Allocations", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Participants", wireType)
 			}
-			var msglen int
+			m.Participants = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowIncentives
@@ -1153,31 +4657,16 @@ func (m *RegisterIncentiveProposal) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				m.Participants |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
-				return ErrInvalidLengthIncentives
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthIncentives
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Allocations = append(m.Allocations, types.DecCoin{})
-			if err := m.Allocations[len(m.Allocations)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
 		case 5:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Epochs", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field TotalGas", wireType)
 			}
-			m.Epochs = 0
+			m.TotalGas = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowIncentives
@@ -1187,11 +4676,45 @@ func (m *RegisterIncentiveProposal) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Epochs |= uint32(b&0x7F) << shift
+				m.TotalGas |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Rewards", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowIncentives
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
+			if msglen < 0 {
+				return ErrInvalidLengthIncentives
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthIncentives
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Rewards = append(m.Rewards, types.Coin{})
+			if err := m.Rewards[len(m.Rewards)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipIncentives(dAtA[iNdEx:])
@@ -1213,7 +4736,7 @@ func (m *RegisterIncentiveProposal) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *CancelIncentiveProposal) Unmarshal(dAtA []byte) error {
+func (m *ParticipantPayout) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1236,15 +4759,15 @@ func (m *CancelIncentiveProposal) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: CancelIncentiveProposal: wiretype end group for non-group")
+			return fmt.Errorf("proto: ParticipantPayout: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: CancelIncentiveProposal: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: ParticipantPayout: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Title", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Contract", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -1272,11 +4795,11 @@ func (m *CancelIncentiveProposal) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Title = string(dAtA[iNdEx:postIndex])
+			m.Contract = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Description", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Participant", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -1304,13 +4827,51 @@ func (m *CancelIncentiveProposal) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Description = string(dAtA[iNdEx:postIndex])
+			m.Participant = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Period", wireType)
+			}
+			m.Period = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowIncentives
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Period |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CumulativeGas", wireType)
+			}
+			m.CumulativeGas = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowIncentives
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.CumulativeGas |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Contract", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Rewards", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowIncentives
@@ -1320,23 +4881,25 @@ func (m *CancelIncentiveProposal) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthIncentives
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthIncentives
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Contract = string(dAtA[iNdEx:postIndex])
+			m.Rewards = append(m.Rewards, types.Coin{})
+			if err := m.Rewards[len(m.Rewards)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
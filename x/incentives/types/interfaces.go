@@ -7,6 +7,7 @@ import (
 
 	"github.com/tendermint/tendermint/libs/log"
 
+	"cosmossdk.io/math"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
 	govv1beta1 "github.com/cosmos/cosmos-sdk/x/gov/types/v1beta1"
@@ -59,4 +60,6 @@ type EVMKeeper interface {
 }
 
 // Stakekeeper defines the expected staking keeper interface used on incentives
-type StakeKeeper interface{}
+type StakeKeeper interface {
+	GetDelegatorBonded(ctx sdk.Context, delegator sdk.AccAddress) math.Int
+}
@@ -29,6 +29,17 @@ const (
 	prefixIncentive = iota + 1
 	prefixGasMeter
 	prefixAllocationMeter
+	prefixParticipant
+	prefixFund
+	prefixRewardPeriod
+	prefixAccruedRewards
+	prefixParticipantGasMeter
+	prefixOutstandingRewards
+	prefixIncentivePayout
+	prefixParticipantPayout
+	prefixPayoutIndex
+	prefixParticipantActivity
+	prefixParticipationAgeIncentive
 )
 
 // KVStore key prefixes
@@ -36,6 +47,31 @@ var (
 	KeyPrefixIncentive       = []byte{prefixIncentive}
 	KeyPrefixGasMeter        = []byte{prefixGasMeter}
 	KeyPrefixAllocationMeter = []byte{prefixAllocationMeter}
+	KeyPrefixParticipant     = []byte{prefixParticipant}
+	KeyPrefixFund            = []byte{prefixFund}
+	KeyPrefixRewardPeriod    = []byte{prefixRewardPeriod}
+	KeyPrefixAccruedRewards  = []byte{prefixAccruedRewards}
+	// KeyPrefixParticipantGasMeter indexes the gas meters by
+	// `<participant_address>|<contract_address>`
+	KeyPrefixParticipantGasMeter = []byte{prefixParticipantGasMeter}
+	// KeyOutstandingRewards stores the total reserved rewards that have not
+	// been claimed yet
+	KeyOutstandingRewards = []byte{prefixOutstandingRewards}
+	// KeyPrefixIncentivePayout stores the payout summaries by
+	// `<contract_address>|<period>`
+	KeyPrefixIncentivePayout = []byte{prefixIncentivePayout}
+	// KeyPrefixParticipantPayout stores the participant payouts by
+	// `<participant_address>|<contract_address>|<period>`
+	KeyPrefixParticipantPayout = []byte{prefixParticipantPayout}
+	// KeyPrefixPayoutIndex indexes the participant payouts by
+	// `<contract_address>|<period>|<participant_address>` for pruning
+	KeyPrefixPayoutIndex = []byte{prefixPayoutIndex}
+	// KeyPrefixParticipantActivity indexes the participants by
+	// `<last_height>|<participant_address>` for pruning
+	KeyPrefixParticipantActivity = []byte{prefixParticipantActivity}
+	// KeyPrefixParticipationAgeIncentive indexes the minimum participation age
+	// of the incentives that set one by `<contract_address>`
+	KeyPrefixParticipationAgeIncentive = []byte{prefixParticipationAgeIncentive}
 )
 
 // SplitGasMeterKey is a helper to split up KV-store keys in a
@@ -0,0 +1,91 @@
+package types
+
+import (
+	errorsmod "cosmossdk.io/errors"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	errortypes "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/ethereum/go-ethereum/common"
+	ethermint "github.com/evmos/ethermint/types"
+)
+
+var (
+	_ sdk.Msg = &MsgFundIncentive{}
+	_ sdk.Msg = &MsgClaimIncentiveRewards{}
+)
+
+const (
+	TypeMsgFundIncentive         = "fund_incentive"
+	TypeMsgClaimIncentiveRewards = "claim_incentive_rewards"
+)
+
+// NewMsgFundIncentive creates a new instance of MsgFundIncentive
+func NewMsgFundIncentive(contract common.Address, amount sdk.Coins, funder sdk.AccAddress) *MsgFundIncentive { // nolint: interfacer
+	return &MsgFundIncentive{
+		Contract: contract.String(),
+		Amount:   amount,
+		Funder:   funder.String(),
+	}
+}
+
+// Route should return the name of the module
+func (msg MsgFundIncentive) Route() string { return RouterKey }
+
+// Type should return the action
+func (msg MsgFundIncentive) Type() string { return TypeMsgFundIncentive }
+
+// ValidateBasic runs stateless checks on the message
+func (msg MsgFundIncentive) ValidateBasic() error {
+	if err := ethermint.ValidateAddress(msg.Contract); err != nil {
+		return errorsmod.Wrap(err, "invalid contract address")
+	}
+	if msg.Amount.Empty() || !msg.Amount.IsValid() {
+		return errorsmod.Wrapf(errortypes.ErrInvalidCoins, "invalid fund amount %s", msg.Amount)
+	}
+	if _, err := sdk.AccAddressFromBech32(msg.Funder); err != nil {
+		return errorsmod.Wrap(err, "invalid funder address")
+	}
+	return nil
+}
+
+// GetSignBytes encodes the message for signing
+func (msg MsgFundIncentive) GetSignBytes() []byte {
+	return sdk.MustSortJSON(AminoCdc.MustMarshalJSON(&msg))
+}
+
+// GetSigners defines whose signature is required
+func (msg MsgFundIncentive) GetSigners() []sdk.AccAddress {
+	addr := sdk.MustAccAddressFromBech32(msg.Funder)
+	return []sdk.AccAddress{addr}
+}
+
+// NewMsgClaimIncentiveRewards creates a new instance of MsgClaimIncentiveRewards
+func NewMsgClaimIncentiveRewards(sender sdk.AccAddress) *MsgClaimIncentiveRewards { // nolint: interfacer
+	return &MsgClaimIncentiveRewards{
+		Sender: sender.String(),
+	}
+}
+
+// Route should return the name of the module
+func (msg MsgClaimIncentiveRewards) Route() string { return RouterKey }
+
+// Type should return the action
+func (msg MsgClaimIncentiveRewards) Type() string { return TypeMsgClaimIncentiveRewards }
+
+// ValidateBasic runs stateless checks on the message
+func (msg MsgClaimIncentiveRewards) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(msg.Sender); err != nil {
+		return errorsmod.Wrap(err, "invalid sender address")
+	}
+	return nil
+}
+
+// GetSignBytes encodes the message for signing
+func (msg MsgClaimIncentiveRewards) GetSignBytes() []byte {
+	return sdk.MustSortJSON(AminoCdc.MustMarshalJSON(&msg))
+}
+
+// GetSigners defines whose signature is required
+func (msg MsgClaimIncentiveRewards) GetSigners() []sdk.AccAddress {
+	addr := sdk.MustAccAddressFromBech32(msg.Sender)
+	return []sdk.AccAddress{addr}
+}
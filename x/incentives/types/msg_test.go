@@ -0,0 +1,120 @@
+package types
+
+import (
+	"testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/suite"
+
+	"github.com/evmos/ethermint/tests"
+)
+
+type MsgsTestSuite struct {
+	suite.Suite
+}
+
+func TestMsgsTestSuite(t *testing.T) {
+	suite.Run(t, new(MsgsTestSuite))
+}
+
+func (suite *MsgsTestSuite) TestMsgFundIncentiveGetters() {
+	msgInvalid := MsgFundIncentive{}
+	msg := NewMsgFundIncentive(
+		tests.GenerateAddress(),
+		sdk.NewCoins(sdk.NewInt64Coin("aevmos", 100)),
+		sdk.AccAddress(tests.GenerateAddress().Bytes()),
+	)
+	suite.Require().Equal(RouterKey, msg.Route())
+	suite.Require().Equal(TypeMsgFundIncentive, msg.Type())
+	suite.Require().NotNil(msgInvalid.GetSignBytes())
+	suite.Require().NotNil(msg.GetSigners())
+}
+
+func (suite *MsgsTestSuite) TestMsgFundIncentive() {
+	testCases := []struct {
+		msg        string
+		contract   string
+		amount     sdk.Coins
+		funder     string
+		expectPass bool
+	}{
+		{
+			"invalid contract address",
+			"0x5dCA2483280D9727c80b5518faC4556617fb19",
+			sdk.NewCoins(sdk.NewInt64Coin("aevmos", 100)),
+			sdk.AccAddress(tests.GenerateAddress().Bytes()).String(),
+			false,
+		},
+		{
+			"empty amount",
+			tests.GenerateAddress().String(),
+			sdk.Coins{},
+			sdk.AccAddress(tests.GenerateAddress().Bytes()).String(),
+			false,
+		},
+		{
+			"zero amount",
+			tests.GenerateAddress().String(),
+			sdk.Coins{{Denom: "aevmos", Amount: sdk.ZeroInt()}},
+			sdk.AccAddress(tests.GenerateAddress().Bytes()).String(),
+			false,
+		},
+		{
+			"invalid funder address",
+			tests.GenerateAddress().String(),
+			sdk.NewCoins(sdk.NewInt64Coin("aevmos", 100)),
+			tests.GenerateAddress().String(),
+			false,
+		},
+		{
+			"msg fund incentive - pass",
+			tests.GenerateAddress().String(),
+			sdk.NewCoins(sdk.NewInt64Coin("aevmos", 100), sdk.NewInt64Coin("acoin", 5)),
+			sdk.AccAddress(tests.GenerateAddress().Bytes()).String(),
+			true,
+		},
+	}
+
+	for i, tc := range testCases {
+		tx := MsgFundIncentive{tc.contract, tc.amount, tc.funder}
+		err := tx.ValidateBasic()
+
+		if tc.expectPass {
+			suite.Require().NoError(err, "valid test %d failed: %s", i, tc.msg)
+		} else {
+			suite.Require().Error(err, "invalid test %d passed: %s", i, tc.msg)
+		}
+	}
+}
+
+func (suite *MsgsTestSuite) TestMsgClaimIncentiveRewardsGetters() {
+	msgInvalid := MsgClaimIncentiveRewards{}
+	msg := NewMsgClaimIncentiveRewards(sdk.AccAddress(tests.GenerateAddress().Bytes()))
+	suite.Require().Equal(RouterKey, msg.Route())
+	suite.Require().Equal(TypeMsgClaimIncentiveRewards, msg.Type())
+	suite.Require().NotNil(msgInvalid.GetSignBytes())
+	suite.Require().NotNil(msg.GetSigners())
+}
+
+func (suite *MsgsTestSuite) TestMsgClaimIncentiveRewards() {
+	testCases := []struct {
+		msg        string
+		sender     string
+		expectPass bool
+	}{
+		{"empty sender", "", false},
+		{"invalid sender address", tests.GenerateAddress().String(), false},
+		{"msg claim incentive rewards - pass", sdk.AccAddress(tests.GenerateAddress().Bytes()).String(), true},
+	}
+
+	for i, tc := range testCases {
+		tx := MsgClaimIncentiveRewards{tc.sender}
+		err := tx.ValidateBasic()
+
+		if tc.expectPass {
+			suite.Require().NoError(err, "valid test %d failed: %s", i, tc.msg)
+		} else {
+			suite.Require().Error(err, "invalid test %d passed: %s", i, tc.msg)
+		}
+	}
+}
@@ -5,6 +5,7 @@ import (
 	"fmt"
 	"time"
 
+	"cosmossdk.io/math"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	paramtypes "github.com/cosmos/cosmos-sdk/x/params/types"
 
@@ -17,8 +18,29 @@ var (
 	ParamStoreKeyAllocationLimit  = []byte("AllocationLimit")
 	ParamStoreKeyEpochIdentifier  = []byte("EpochIdentifier")
 	ParamStoreKeyRewardScaler     = []byte("RewardScaler")
+	// ParamStoreKeyPayoutHistoryPeriods is the store key of the number of
+	// reward periods whose payouts are retained
+	ParamStoreKeyPayoutHistoryPeriods = []byte("PayoutHistoryPeriods")
+	// ParamStoreKeyEnableInternalGasAttribution is the store key of the flag
+	// that attributes gas to the incentivized contracts that emitted logs
+	ParamStoreKeyEnableInternalGasAttribution = []byte("EnableInternalGasAttribution")
+	// ParamStoreKeyRewardExpiry is the store key of the duration after which
+	// the unsettled rewards of a reward period expire
+	ParamStoreKeyRewardExpiry = []byte("RewardExpiry")
+	// ParamStoreKeyMinFund is the store key of the minimum amount per
+	// denomination escrowed by each funding of an incentive
+	ParamStoreKeyMinFund = []byte("MinFund")
+	// ParamStoreKeyMaxFunders is the store key of the maximum number of funders
+	// of an incentive
+	ParamStoreKeyMaxFunders = []byte("MaxFunders")
 )
 
+// DefaultMinFund is 1 EVMOS
+var DefaultMinFund = sdk.NewCoins(sdk.NewCoin("aevmos", math.NewIntWithDecimal(1, 18)))
+
+// DefaultMaxFunders is the default maximum number of funders of an incentive
+const DefaultMaxFunders = 20
+
 // ParamKeyTable returns the parameter key table.
 func ParamKeyTable() paramtypes.KeyTable {
 	return paramtypes.NewKeyTable().RegisterParamSet(&Params{})
@@ -31,21 +53,36 @@ func NewParams(
 	allocationLimit sdk.Dec,
 	epochIdentifier string,
 	rewardScaler sdk.Dec,
+	payoutHistoryPeriods uint64,
+	enableInternalGasAttribution bool,
+	rewardExpiry time.Duration,
+	minFund sdk.Coins,
+	maxFunders uint32,
 ) Params {
 	return Params{
-		EnableIncentives:          enableIncentives,
-		AllocationLimit:           allocationLimit,
-		IncentivesEpochIdentifier: epochIdentifier,
-		RewardScaler:              rewardScaler,
+		EnableIncentives:             enableIncentives,
+		AllocationLimit:              allocationLimit,
+		IncentivesEpochIdentifier:    epochIdentifier,
+		RewardScaler:                 rewardScaler,
+		PayoutHistoryPeriods:         payoutHistoryPeriods,
+		EnableInternalGasAttribution: enableInternalGasAttribution,
+		RewardExpiry:                 rewardExpiry,
+		MinFund:                      minFund,
+		MaxFunders:                   maxFunders,
 	}
 }
 
 func DefaultParams() Params {
 	return Params{
-		EnableIncentives:          true,
-		AllocationLimit:           sdk.NewDecWithPrec(5, 2),
-		IncentivesEpochIdentifier: epochstypes.WeekEpochID,
-		RewardScaler:              sdk.NewDecWithPrec(12, 1),
+		EnableIncentives:             true,
+		AllocationLimit:              sdk.NewDecWithPrec(5, 2),
+		IncentivesEpochIdentifier:    epochstypes.WeekEpochID,
+		RewardScaler:                 sdk.NewDecWithPrec(12, 1),
+		PayoutHistoryPeriods:         52,
+		EnableInternalGasAttribution: false,
+		RewardExpiry:                 0,
+		MinFund:                      DefaultMinFund,
+		MaxFunders:                   DefaultMaxFunders,
 	}
 }
 
@@ -56,6 +93,11 @@ func (p *Params) ParamSetPairs() paramtypes.ParamSetPairs {
 		paramtypes.NewParamSetPair(ParamStoreKeyAllocationLimit, &p.AllocationLimit, validatePercentage),
 		paramtypes.NewParamSetPair(ParamStoreKeyEpochIdentifier, &p.IncentivesEpochIdentifier, epochstypes.ValidateEpochIdentifierInterface),
 		paramtypes.NewParamSetPair(ParamStoreKeyRewardScaler, &p.RewardScaler, validateUncappedPercentage),
+		paramtypes.NewParamSetPair(ParamStoreKeyPayoutHistoryPeriods, &p.PayoutHistoryPeriods, validateUint64),
+		paramtypes.NewParamSetPair(ParamStoreKeyEnableInternalGasAttribution, &p.EnableInternalGasAttribution, validateBool),
+		paramtypes.NewParamSetPair(ParamStoreKeyRewardExpiry, &p.RewardExpiry, validateDuration),
+		paramtypes.NewParamSetPair(ParamStoreKeyMinFund, &p.MinFund, validateCoins),
+		paramtypes.NewParamSetPair(ParamStoreKeyMaxFunders, &p.MaxFunders, validateMaxFunders),
 	}
 }
 
@@ -68,6 +110,48 @@ func validateBool(i interface{}) error {
 	return nil
 }
 
+func validateUint64(i interface{}) error {
+	_, ok := i.(uint64)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+
+	return nil
+}
+
+func validateCoins(i interface{}) error {
+	coins, ok := i.(sdk.Coins)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+
+	return coins.Validate()
+}
+
+func validateMaxFunders(i interface{}) error {
+	maxFunders, ok := i.(uint32)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+	if maxFunders == 0 {
+		return errors.New("maximum number of funders must be positive")
+	}
+
+	return nil
+}
+
+func validateDuration(i interface{}) error {
+	duration, ok := i.(time.Duration)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+	if duration < 0 {
+		return fmt.Errorf("duration must be non-negative: %s", duration)
+	}
+
+	return nil
+}
+
 func validatePercentage(i interface{}) error {
 	dec, ok := i.(sdk.Dec)
 	if !ok {
@@ -114,5 +198,17 @@ func (p Params) Validate() error {
 		return err
 	}
 
+	if err := validateDuration(p.RewardExpiry); err != nil {
+		return err
+	}
+
+	if err := validateCoins(p.MinFund); err != nil {
+		return err
+	}
+
+	if err := validateMaxFunders(p.MaxFunders); err != nil {
+		return err
+	}
+
 	return epochstypes.ValidateEpochIdentifierString(p.IncentivesEpochIdentifier)
 }
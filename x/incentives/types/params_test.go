@@ -2,6 +2,7 @@ package types
 
 import (
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/suite"
 
@@ -43,6 +44,11 @@ func (suite *ParamsTestSuite) TestParamsValidate() {
 				sdk.NewDecWithPrec(5, 2),
 				epochstypes.WeekEpochID,
 				sdk.NewDecWithPrec(15, 1),
+				52,
+				false,
+				time.Hour,
+				DefaultMinFund,
+				DefaultMaxFunders,
 			),
 			false,
 		},
@@ -54,6 +60,11 @@ func (suite *ParamsTestSuite) TestParamsValidate() {
 				sdk.NewDecWithPrec(100, 2),
 				epochstypes.WeekEpochID,
 				sdk.NewDecWithPrec(15, 1),
+				52,
+				false,
+				time.Hour,
+				DefaultMinFund,
+				DefaultMaxFunders,
 			),
 			false,
 		},
@@ -65,6 +76,11 @@ func (suite *ParamsTestSuite) TestParamsValidate() {
 				sdk.NewDecWithPrec(100, 2),
 				epochstypes.WeekEpochID,
 				sdk.NewDecWithPrec(10, 0),
+				0,
+				true,
+				0,
+				nil,
+				1,
 			),
 			false,
 		},
@@ -123,6 +139,40 @@ func (suite *ParamsTestSuite) TestParamsValidate() {
 			},
 			true,
 		},
+		{
+			"invalid - negative reward expiry",
+			Params{
+				EnableIncentives:          true,
+				AllocationLimit:           sdk.NewDecWithPrec(5, 2),
+				IncentivesEpochIdentifier: epochstypes.WeekEpochID,
+				RewardScaler:              sdk.NewDecWithPrec(15, 1),
+				RewardExpiry:              -time.Hour,
+			},
+			true,
+		},
+		{
+			"invalid - zero max funders",
+			Params{
+				EnableIncentives:          true,
+				AllocationLimit:           sdk.NewDecWithPrec(5, 2),
+				IncentivesEpochIdentifier: epochstypes.WeekEpochID,
+				RewardScaler:              sdk.NewDecWithPrec(15, 1),
+				MaxFunders:                0,
+			},
+			true,
+		},
+		{
+			"invalid - invalid min fund",
+			Params{
+				EnableIncentives:          true,
+				AllocationLimit:           sdk.NewDecWithPrec(5, 2),
+				IncentivesEpochIdentifier: epochstypes.WeekEpochID,
+				RewardScaler:              sdk.NewDecWithPrec(15, 1),
+				MinFund:                   sdk.Coins{{Denom: "aevmos", Amount: sdk.NewInt(-1)}},
+				MaxFunders:                DefaultMaxFunders,
+			},
+			true,
+		},
 		{
 			"invalid - empty epoch identifier",
 			Params{
@@ -149,4 +199,14 @@ func (suite *ParamsTestSuite) TestParamsValidate() {
 func (suite *ParamsTestSuite) TestParamsValidatePriv() {
 	suite.Require().Error(validateBool(1))
 	suite.Require().NoError(validateBool(true))
+	suite.Require().Error(validateUint64(int64(1)))
+	suite.Require().NoError(validateUint64(uint64(52)))
+	suite.Require().Error(validateDuration(uint64(52)))
+	suite.Require().Error(validateDuration(-time.Second))
+	suite.Require().NoError(validateDuration(time.Hour))
+	suite.Require().Error(validateCoins(uint64(52)))
+	suite.Require().NoError(validateCoins(sdk.Coins(nil)))
+	suite.Require().Error(validateMaxFunders(uint64(1)))
+	suite.Require().Error(validateMaxFunders(uint32(0)))
+	suite.Require().NoError(validateMaxFunders(uint32(1)))
 }
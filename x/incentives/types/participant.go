@@ -0,0 +1,35 @@
+package types
+
+import (
+	"fmt"
+
+	"github.com/ethereum/go-ethereum/common"
+	ethermint "github.com/evmos/ethermint/types"
+)
+
+// NewParticipant returns an instance of Participant whose first interaction
+// is also its last one
+func NewParticipant(address common.Address, firstHeight int64) Participant {
+	return Participant{
+		Address:     address.String(),
+		FirstHeight: firstHeight,
+		LastHeight:  firstHeight,
+	}
+}
+
+// Validate performs a stateless validation of a Participant
+func (p Participant) Validate() error {
+	if err := ethermint.ValidateAddress(p.Address); err != nil {
+		return err
+	}
+
+	if p.FirstHeight < 0 {
+		return fmt.Errorf("first height cannot be negative: %d", p.FirstHeight)
+	}
+
+	if p.LastHeight < p.FirstHeight {
+		return fmt.Errorf("last height %d cannot be lower than first height %d", p.LastHeight, p.FirstHeight)
+	}
+
+	return nil
+}
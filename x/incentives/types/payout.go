@@ -0,0 +1,73 @@
+package types
+
+import (
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/ethereum/go-ethereum/common"
+	ethermint "github.com/evmos/ethermint/types"
+)
+
+// NewIncentivePayout returns the payout summary of a finalized RewardPeriod
+func NewIncentivePayout(
+	rp RewardPeriod,
+	endTime time.Time,
+	totalGas uint64,
+) IncentivePayout {
+	return IncentivePayout{
+		Contract:     rp.Contract,
+		Period:       rp.Period,
+		EndTime:      endTime,
+		Participants: rp.GasMeters,
+		TotalGas:     totalGas,
+		Rewards:      rp.Remaining,
+	}
+}
+
+// Validate performs a stateless validation of an IncentivePayout
+func (ip IncentivePayout) Validate() error {
+	if err := ethermint.ValidateAddress(ip.Contract); err != nil {
+		return err
+	}
+
+	return ip.Rewards.Validate()
+}
+
+// NewParticipantPayout returns the payout of a participant for the reward
+// period of its gas meter
+func NewParticipantPayout(gm GasMeter, rewards sdk.Coins) ParticipantPayout {
+	return ParticipantPayout{
+		Contract:      gm.Contract,
+		Participant:   gm.Participant,
+		Period:        gm.Period,
+		CumulativeGas: gm.CumulativeGas,
+		Rewards:       rewards,
+	}
+}
+
+// Validate performs a stateless validation of a ParticipantPayout
+func (pp ParticipantPayout) Validate() error {
+	if err := ethermint.ValidateAddress(pp.Contract); err != nil {
+		return err
+	}
+
+	if err := ethermint.ValidateAddress(pp.Participant); err != nil {
+		return err
+	}
+
+	return pp.Rewards.Validate()
+}
+
+// ParticipantPayoutKey returns the key of a participant payout in a
+// `<participant_address>|<contract_address>|<period>` format
+func ParticipantPayoutKey(contract, participant common.Address, period uint64) []byte {
+	key := append(participant.Bytes(), contract.Bytes()...)
+	return append(key, sdk.Uint64ToBigEndian(period)...)
+}
+
+// PayoutIndexKey returns the key of a participant payout in the pruning index
+// in a `<contract_address>|<period>|<participant_address>` format
+func PayoutIndexKey(contract, participant common.Address, period uint64) []byte {
+	key := append(contract.Bytes(), sdk.Uint64ToBigEndian(period)...)
+	return append(key, participant.Bytes()...)
+}
@@ -0,0 +1,103 @@
+package types
+
+import (
+	"testing"
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/suite"
+
+	"github.com/evmos/ethermint/tests"
+)
+
+type PayoutTestSuite struct {
+	suite.Suite
+}
+
+func TestPayoutSuite(t *testing.T) {
+	suite.Run(t, new(PayoutTestSuite))
+}
+
+func (suite *PayoutTestSuite) TestNewIncentivePayout() {
+	rp := NewRewardPeriod(tests.GenerateAddress(), 3, DefaultWeighting())
+	rp.GasMeters = 2
+	rp.Remaining = sdk.NewCoins(sdk.NewInt64Coin("aevmos", 100))
+	endTime := time.Now().UTC()
+
+	ip := NewIncentivePayout(rp, endTime, 500)
+	suite.Require().NoError(ip.Validate())
+	suite.Require().Equal(rp.Contract, ip.Contract)
+	suite.Require().Equal(uint64(3), ip.Period)
+	suite.Require().Equal(endTime, ip.EndTime)
+	suite.Require().Equal(uint64(2), ip.Participants)
+	suite.Require().Equal(uint64(500), ip.TotalGas)
+	suite.Require().Equal(rp.Remaining, ip.Rewards)
+}
+
+func (suite *PayoutTestSuite) TestIncentivePayoutValidate() {
+	testCases := []struct {
+		name       string
+		ip         IncentivePayout
+		expectPass bool
+	}{
+		{"valid", IncentivePayout{Contract: tests.GenerateAddress().String()}, true},
+		{"invalid contract", IncentivePayout{Contract: "0xinvalidaddress"}, false},
+		{
+			"invalid rewards",
+			IncentivePayout{
+				Contract: tests.GenerateAddress().String(),
+				Rewards:  sdk.Coins{{Denom: "aevmos", Amount: sdk.ZeroInt()}},
+			},
+			false,
+		},
+	}
+
+	for _, tc := range testCases {
+		err := tc.ip.Validate()
+
+		if tc.expectPass {
+			suite.Require().NoError(err, tc.name)
+		} else {
+			suite.Require().Error(err, tc.name)
+		}
+	}
+}
+
+func (suite *PayoutTestSuite) TestParticipantPayoutValidate() {
+	gm := NewGasMeter(tests.GenerateAddress(), tests.GenerateAddress(), 100)
+	rewards := sdk.NewCoins(sdk.NewInt64Coin("aevmos", 10))
+
+	testCases := []struct {
+		name       string
+		pp         ParticipantPayout
+		expectPass bool
+	}{
+		{"valid", NewParticipantPayout(gm, rewards), true},
+		{"valid - no rewards", NewParticipantPayout(gm, sdk.Coins{}), true},
+		{
+			"invalid contract",
+			ParticipantPayout{Contract: "0xinvalidaddress", Participant: gm.Participant},
+			false,
+		},
+		{
+			"invalid participant",
+			ParticipantPayout{Contract: gm.Contract, Participant: "0xinvalidaddress"},
+			false,
+		},
+		{
+			"invalid rewards",
+			NewParticipantPayout(gm, sdk.Coins{{Denom: "aevmos", Amount: sdk.ZeroInt()}}),
+			false,
+		},
+	}
+
+	for _, tc := range testCases {
+		err := tc.pp.Validate()
+
+		if tc.expectPass {
+			suite.Require().NoError(err, tc.name)
+		} else {
+			suite.Require().Error(err, tc.name)
+		}
+	}
+}
@@ -1,7 +1,6 @@
 package types
 
 import (
-	"errors"
 	fmt "fmt"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
@@ -13,19 +12,23 @@ import (
 const (
 	ProposalTypeRegisterIncentive string = "RegisterIncentive"
 	ProposalTypeCancelIncentive   string = "CancelIncentive"
+	ProposalTypeUpdateIncentive   string = "UpdateIncentive"
 )
 
 // Implements Proposal Interface
 var (
 	_ govv1beta1.Content = &RegisterIncentiveProposal{}
 	_ govv1beta1.Content = &CancelIncentiveProposal{}
+	_ govv1beta1.Content = &UpdateIncentiveProposal{}
 )
 
 func init() {
 	govv1beta1.RegisterProposalType(ProposalTypeRegisterIncentive)
 	govv1beta1.RegisterProposalType(ProposalTypeCancelIncentive)
+	govv1beta1.RegisterProposalType(ProposalTypeUpdateIncentive)
 	govv1beta1.ModuleCdc.Amino.RegisterConcrete(&RegisterIncentiveProposal{}, "incentives/RegisterIncentiveProposal", nil)
 	govv1beta1.ModuleCdc.Amino.RegisterConcrete(&CancelIncentiveProposal{}, "incentives/CancelIncentiveProposal", nil)
+	govv1beta1.ModuleCdc.Amino.RegisterConcrete(&UpdateIncentiveProposal{}, "incentives/UpdateIncentiveProposal", nil)
 }
 
 // NewRegisterIncentiveProposal returns new instance of RegisterIncentiveProposal
@@ -33,6 +36,8 @@ func NewRegisterIncentiveProposal(
 	title, description, contract string,
 	allocations sdk.DecCoins,
 	epochs uint32,
+	weighting Weighting,
+	eligibility Eligibility,
 ) govv1beta1.Content {
 	return &RegisterIncentiveProposal{
 		Title:       title,
@@ -40,6 +45,8 @@ func NewRegisterIncentiveProposal(
 		Contract:    contract,
 		Allocations: allocations,
 		Epochs:      epochs,
+		Weighting:   weighting,
+		Eligibility: eligibility,
 	}
 }
 
@@ -65,17 +72,24 @@ func (rip *RegisterIncentiveProposal) ValidateBasic() error {
 		return err
 	}
 
+	if err := rip.Weighting.Validate(); err != nil {
+		return err
+	}
+
+	if err := rip.Eligibility.Validate(); err != nil {
+		return err
+	}
+
 	return govv1beta1.ValidateAbstract(rip)
 }
 
 // validateAllocations checks if each allocation has
 // - a valid denom
 // - a valid amount representing the percentage of allocation
+//
+// An incentive without allocations is only rewarded with the coins escrowed
+// through MsgFundIncentive.
 func validateAllocations(allocations sdk.DecCoins) error {
-	if allocations.Empty() {
-		return errors.New("incentive allocations cannot be empty")
-	}
-
 	for _, al := range allocations {
 		if err := validateAmount(al.Amount); err != nil {
 			return err
@@ -126,3 +140,43 @@ func (rip *CancelIncentiveProposal) ValidateBasic() error {
 
 	return govv1beta1.ValidateAbstract(rip)
 }
+
+// NewUpdateIncentiveProposal returns new instance of UpdateIncentiveProposal
+func NewUpdateIncentiveProposal(
+	title, description, contract string,
+	allocations sdk.DecCoins,
+	epochs uint32,
+) govv1beta1.Content {
+	return &UpdateIncentiveProposal{
+		Title:       title,
+		Description: description,
+		Contract:    contract,
+		Allocations: allocations,
+		Epochs:      epochs,
+	}
+}
+
+// ProposalRoute returns router key for this proposal
+func (*UpdateIncentiveProposal) ProposalRoute() string { return RouterKey }
+
+// ProposalType returns proposal type for this proposal
+func (*UpdateIncentiveProposal) ProposalType() string {
+	return ProposalTypeUpdateIncentive
+}
+
+// ValidateBasic performs a stateless check of the proposal fields
+func (uip *UpdateIncentiveProposal) ValidateBasic() error {
+	if err := ethermint.ValidateAddress(uip.Contract); err != nil {
+		return err
+	}
+
+	if err := validateAllocations(uip.Allocations); err != nil {
+		return err
+	}
+
+	if err := validateEpochs(uip.Epochs); err != nil {
+		return err
+	}
+
+	return govv1beta1.ValidateAbstract(uip)
+}
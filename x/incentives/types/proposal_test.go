@@ -23,6 +23,8 @@ func (suite *ProposalTestSuite) TestKeysTypes() {
 	suite.Require().Equal("RegisterIncentive", (&RegisterIncentiveProposal{}).ProposalType())
 	suite.Require().Equal("incentives", (&CancelIncentiveProposal{}).ProposalRoute())
 	suite.Require().Equal("CancelIncentive", (&CancelIncentiveProposal{}).ProposalType())
+	suite.Require().Equal("incentives", (&UpdateIncentiveProposal{}).ProposalRoute())
+	suite.Require().Equal("UpdateIncentive", (&UpdateIncentiveProposal{}).ProposalType())
 }
 
 func (suite *ProposalTestSuite) TestRegisterIncentiveProposal() {
@@ -43,6 +45,9 @@ func (suite *ProposalTestSuite) TestRegisterIncentiveProposal() {
 				10,
 				time.Now(),
 				0,
+				DefaultWeighting(),
+				DefaultEligibility(),
+				0,
 			},
 			true,
 		},
@@ -56,8 +61,11 @@ func (suite *ProposalTestSuite) TestRegisterIncentiveProposal() {
 				10,
 				time.Now(),
 				0,
+				DefaultWeighting(),
+				DefaultEligibility(),
+				0,
 			},
-			false,
+			true,
 		},
 		{
 			"Register incentive - invalid missing title ",
@@ -69,6 +77,9 @@ func (suite *ProposalTestSuite) TestRegisterIncentiveProposal() {
 				10,
 				time.Now(),
 				0,
+				DefaultWeighting(),
+				DefaultEligibility(),
+				0,
 			},
 			false,
 		},
@@ -82,6 +93,9 @@ func (suite *ProposalTestSuite) TestRegisterIncentiveProposal() {
 				10,
 				time.Now(),
 				0,
+				DefaultWeighting(),
+				DefaultEligibility(),
+				0,
 			},
 			false,
 		},
@@ -95,6 +109,9 @@ func (suite *ProposalTestSuite) TestRegisterIncentiveProposal() {
 				10,
 				time.Now(),
 				0,
+				DefaultWeighting(),
+				DefaultEligibility(),
+				0,
 			},
 			false,
 		},
@@ -108,6 +125,9 @@ func (suite *ProposalTestSuite) TestRegisterIncentiveProposal() {
 				10,
 				time.Now(),
 				0,
+				DefaultWeighting(),
+				DefaultEligibility(),
+				0,
 			},
 			false,
 		},
@@ -121,6 +141,9 @@ func (suite *ProposalTestSuite) TestRegisterIncentiveProposal() {
 				10,
 				time.Now(),
 				0,
+				DefaultWeighting(),
+				DefaultEligibility(),
+				0,
 			},
 			false,
 		},
@@ -134,6 +157,9 @@ func (suite *ProposalTestSuite) TestRegisterIncentiveProposal() {
 				10,
 				time.Now(),
 				0,
+				DefaultWeighting(),
+				DefaultEligibility(),
+				0,
 			},
 			false,
 		},
@@ -147,6 +173,9 @@ func (suite *ProposalTestSuite) TestRegisterIncentiveProposal() {
 				10,
 				time.Now(),
 				0,
+				DefaultWeighting(),
+				DefaultEligibility(),
+				0,
 			},
 			false,
 		},
@@ -160,9 +189,44 @@ func (suite *ProposalTestSuite) TestRegisterIncentiveProposal() {
 				0,
 				time.Now(),
 				0,
+				DefaultWeighting(),
+				DefaultEligibility(),
+				0,
 			},
 			false,
 		},
+		{
+			"Register incentive - capped gas weighting without cap",
+			"test",
+			"test desc",
+			Incentive{
+				tests.GenerateAddress().String(),
+				sdk.DecCoins{sdk.NewDecCoinFromDec("aevmos", sdk.NewDecWithPrec(5, 2))},
+				10,
+				time.Now(),
+				0,
+				NewWeighting(WEIGHTING_STRATEGY_CAPPED_GAS, 0),
+				DefaultEligibility(),
+				0,
+			},
+			false,
+		},
+		{
+			"Register incentive - sqrt gas weighting",
+			"test",
+			"test desc",
+			Incentive{
+				tests.GenerateAddress().String(),
+				sdk.DecCoins{sdk.NewDecCoinFromDec("aevmos", sdk.NewDecWithPrec(5, 2))},
+				10,
+				time.Now(),
+				0,
+				NewWeighting(WEIGHTING_STRATEGY_SQRT_GAS, 0),
+				DefaultEligibility(),
+				0,
+			},
+			true,
+		},
 		{
 			"Register incentive - invalid allocation amount 0%",
 			"test",
@@ -173,6 +237,9 @@ func (suite *ProposalTestSuite) TestRegisterIncentiveProposal() {
 				10,
 				time.Now(),
 				0,
+				DefaultWeighting(),
+				DefaultEligibility(),
+				0,
 			},
 			false,
 		},
@@ -184,6 +251,8 @@ func (suite *ProposalTestSuite) TestRegisterIncentiveProposal() {
 			tc.incentive.Contract,
 			tc.incentive.Allocations,
 			tc.incentive.Epochs,
+			tc.incentive.Weighting,
+			tc.incentive.Eligibility,
 		)
 		err := tx.ValidateBasic()
 
@@ -213,6 +282,9 @@ func (suite *ProposalTestSuite) TestCancelIncentiveProposal() {
 				5,
 				time.Now(),
 				0,
+				DefaultWeighting(),
+				DefaultEligibility(),
+				0,
 			},
 			true,
 		},
@@ -226,6 +298,9 @@ func (suite *ProposalTestSuite) TestCancelIncentiveProposal() {
 				10,
 				time.Now(),
 				0,
+				DefaultWeighting(),
+				DefaultEligibility(),
+				0,
 			},
 			false,
 		},
@@ -239,6 +314,9 @@ func (suite *ProposalTestSuite) TestCancelIncentiveProposal() {
 				10,
 				time.Now(),
 				0,
+				DefaultWeighting(),
+				DefaultEligibility(),
+				0,
 			},
 			false,
 		},
@@ -252,6 +330,9 @@ func (suite *ProposalTestSuite) TestCancelIncentiveProposal() {
 				10,
 				time.Now(),
 				0,
+				DefaultWeighting(),
+				DefaultEligibility(),
+				0,
 			},
 			false,
 		},
@@ -265,6 +346,9 @@ func (suite *ProposalTestSuite) TestCancelIncentiveProposal() {
 				10,
 				time.Now(),
 				0,
+				DefaultWeighting(),
+				DefaultEligibility(),
+				0,
 			},
 			false,
 		},
@@ -278,6 +362,9 @@ func (suite *ProposalTestSuite) TestCancelIncentiveProposal() {
 				10,
 				time.Now(),
 				0,
+				DefaultWeighting(),
+				DefaultEligibility(),
+				0,
 			},
 			false,
 		},
@@ -297,3 +384,44 @@ func (suite *ProposalTestSuite) TestCancelIncentiveProposal() {
 		}
 	}
 }
+
+func (suite *ProposalTestSuite) TestUpdateIncentiveProposal() {
+	allocations := sdk.DecCoins{sdk.NewDecCoinFromDec("aevmos", sdk.NewDecWithPrec(5, 2))}
+
+	testCases := []struct {
+		name        string
+		title       string
+		description string
+		contract    string
+		allocations sdk.DecCoins
+		epochs      uint32
+		expectPass  bool
+	}{
+		{"Update incentive - valid", "test", "test desc", tests.GenerateAddress().String(), allocations, 10, true},
+		{"Update incentive - empty allocations", "test", "test desc", tests.GenerateAddress().String(), sdk.DecCoins{}, 10, true},
+		{"Update incentive - invalid missing title", "", "test desc", tests.GenerateAddress().String(), allocations, 10, false},
+		{"Update incentive - invalid missing description", "test", "", tests.GenerateAddress().String(), allocations, 10, false},
+		{"Update incentive - invalid address", "test", "test desc", "0x5dCA2483280D9727c80b5518faC4556617fb19ZZ", allocations, 10, false},
+		{
+			"Update incentive - invalid allocation amount > 100%",
+			"test",
+			"test desc",
+			tests.GenerateAddress().String(),
+			sdk.DecCoins{sdk.NewDecCoinFromDec("aevmos", sdk.NewDecWithPrec(101, 2))},
+			10,
+			false,
+		},
+		{"Update incentive - zero epochs", "test", "test desc", tests.GenerateAddress().String(), allocations, 0, false},
+	}
+
+	for _, tc := range testCases {
+		tx := NewUpdateIncentiveProposal(tc.title, tc.description, tc.contract, tc.allocations, tc.epochs)
+		err := tx.ValidateBasic()
+
+		if tc.expectPass {
+			suite.Require().NoError(err, tc.name)
+		} else {
+			suite.Require().Error(err, tc.name)
+		}
+	}
+}
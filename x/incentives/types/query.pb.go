@@ -639,6 +639,344 @@ func (m *QueryAllocationMeterResponse) GetAllocationMeter() types.DecCoin {
 	return types.DecCoin{}
 }
 
+// QueryPendingRewardsRequest is the request type for the Query/PendingRewards
+// RPC method.
+type QueryPendingRewardsRequest struct {
+	// participant is the hex address of a user
+	Participant string `protobuf:"bytes,1,opt,name=participant,proto3" json:"participant,omitempty"`
+}
+
+func (m *QueryPendingRewardsRequest) Reset()         { *m = QueryPendingRewardsRequest{} }
+func (m *QueryPendingRewardsRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryPendingRewardsRequest) ProtoMessage()    {}
+func (*QueryPendingRewardsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_ee5d2766935e7631, []int{12}
+}
+func (m *QueryPendingRewardsRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryPendingRewardsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryPendingRewardsRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryPendingRewardsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryPendingRewardsRequest.Merge(m, src)
+}
+func (m *QueryPendingRewardsRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryPendingRewardsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryPendingRewardsRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryPendingRewardsRequest proto.InternalMessageInfo
+
+func (m *QueryPendingRewardsRequest) GetParticipant() string {
+	if m != nil {
+		return m.Participant
+	}
+	return ""
+}
+
+// QueryPendingRewardsResponse is the response type for the
+// Query/PendingRewards RPC method.
+type QueryPendingRewardsResponse struct {
+	// rewards is the amount of incentive rewards the participant can claim
+	Rewards github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,1,rep,name=rewards,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"rewards"`
+}
+
+func (m *QueryPendingRewardsResponse) Reset()         { *m = QueryPendingRewardsResponse{} }
+func (m *QueryPendingRewardsResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryPendingRewardsResponse) ProtoMessage()    {}
+func (*QueryPendingRewardsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_ee5d2766935e7631, []int{13}
+}
+func (m *QueryPendingRewardsResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryPendingRewardsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryPendingRewardsResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryPendingRewardsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryPendingRewardsResponse.Merge(m, src)
+}
+func (m *QueryPendingRewardsResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryPendingRewardsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryPendingRewardsResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryPendingRewardsResponse proto.InternalMessageInfo
+
+func (m *QueryPendingRewardsResponse) GetRewards() github_com_cosmos_cosmos_sdk_types.Coins {
+	if m != nil {
+		return m.Rewards
+	}
+	return nil
+}
+
+// QueryIncentivePayoutsRequest is the request type for the
+// Query/IncentivePayouts RPC method.
+type QueryIncentivePayoutsRequest struct {
+	// contract is the hex contract address of an incentivized smart contract
+	Contract string `protobuf:"bytes,1,opt,name=contract,proto3" json:"contract,omitempty"`
+	// pagination defines an optional pagination for the request.
+	Pagination *query.PageRequest `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
+}
+
+func (m *QueryIncentivePayoutsRequest) Reset()         { *m = QueryIncentivePayoutsRequest{} }
+func (m *QueryIncentivePayoutsRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryIncentivePayoutsRequest) ProtoMessage()    {}
+func (*QueryIncentivePayoutsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_ee5d2766935e7631, []int{14}
+}
+func (m *QueryIncentivePayoutsRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryIncentivePayoutsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryIncentivePayoutsRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryIncentivePayoutsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryIncentivePayoutsRequest.Merge(m, src)
+}
+func (m *QueryIncentivePayoutsRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryIncentivePayoutsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryIncentivePayoutsRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryIncentivePayoutsRequest proto.InternalMessageInfo
+
+func (m *QueryIncentivePayoutsRequest) GetContract() string {
+	if m != nil {
+		return m.Contract
+	}
+	return ""
+}
+
+func (m *QueryIncentivePayoutsRequest) GetPagination() *query.PageRequest {
+	if m != nil {
+		return m.Pagination
+	}
+	return nil
+}
+
+// QueryIncentivePayoutsResponse is the response type for the
+// Query/IncentivePayouts RPC method.
+type QueryIncentivePayoutsResponse struct {
+	// payouts is a slice of the payout summaries of the incentive ordered by period
+	Payouts []IncentivePayout `protobuf:"bytes,1,rep,name=payouts,proto3" json:"payouts"`
+	// pagination defines the pagination in the response.
+	Pagination *query.PageResponse `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
+}
+
+func (m *QueryIncentivePayoutsResponse) Reset()         { *m = QueryIncentivePayoutsResponse{} }
+func (m *QueryIncentivePayoutsResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryIncentivePayoutsResponse) ProtoMessage()    {}
+func (*QueryIncentivePayoutsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_ee5d2766935e7631, []int{15}
+}
+func (m *QueryIncentivePayoutsResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryIncentivePayoutsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryIncentivePayoutsResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryIncentivePayoutsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryIncentivePayoutsResponse.Merge(m, src)
+}
+func (m *QueryIncentivePayoutsResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryIncentivePayoutsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryIncentivePayoutsResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryIncentivePayoutsResponse proto.InternalMessageInfo
+
+func (m *QueryIncentivePayoutsResponse) GetPayouts() []IncentivePayout {
+	if m != nil {
+		return m.Payouts
+	}
+	return nil
+}
+
+func (m *QueryIncentivePayoutsResponse) GetPagination() *query.PageResponse {
+	if m != nil {
+		return m.Pagination
+	}
+	return nil
+}
+
+// QueryParticipantPayoutsRequest is the request type for the
+// Query/ParticipantPayouts RPC method.
+type QueryParticipantPayoutsRequest struct {
+	// participant is the hex address of a user
+	Participant string `protobuf:"bytes,1,opt,name=participant,proto3" json:"participant,omitempty"`
+	// contract is the optional hex contract address of an incentivized smart contract
+	Contract string `protobuf:"bytes,2,opt,name=contract,proto3" json:"contract,omitempty"`
+	// pagination defines an optional pagination for the request.
+	Pagination *query.PageRequest `protobuf:"bytes,3,opt,name=pagination,proto3" json:"pagination,omitempty"`
+}
+
+func (m *QueryParticipantPayoutsRequest) Reset()         { *m = QueryParticipantPayoutsRequest{} }
+func (m *QueryParticipantPayoutsRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryParticipantPayoutsRequest) ProtoMessage()    {}
+func (*QueryParticipantPayoutsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_ee5d2766935e7631, []int{16}
+}
+func (m *QueryParticipantPayoutsRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryParticipantPayoutsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryParticipantPayoutsRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryParticipantPayoutsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryParticipantPayoutsRequest.Merge(m, src)
+}
+func (m *QueryParticipantPayoutsRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryParticipantPayoutsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryParticipantPayoutsRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryParticipantPayoutsRequest proto.InternalMessageInfo
+
+func (m *QueryParticipantPayoutsRequest) GetParticipant() string {
+	if m != nil {
+		return m.Participant
+	}
+	return ""
+}
+
+func (m *QueryParticipantPayoutsRequest) GetContract() string {
+	if m != nil {
+		return m.Contract
+	}
+	return ""
+}
+
+func (m *QueryParticipantPayoutsRequest) GetPagination() *query.PageRequest {
+	if m != nil {
+		return m.Pagination
+	}
+	return nil
+}
+
+// QueryParticipantPayoutsResponse is the response type for the
+// Query/ParticipantPayouts RPC method.
+type QueryParticipantPayoutsResponse struct {
+	// payouts is a slice of the payouts of the participant ordered by contract and period
+	Payouts []ParticipantPayout `protobuf:"bytes,1,rep,name=payouts,proto3" json:"payouts"`
+	// pagination defines the pagination in the response.
+	Pagination *query.PageResponse `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
+	// pending is a slice of the payouts of the participant for finalized reward
+	// periods that have not been settled yet, computed from the retained reward
+	// periods. It is not paginated.
+	Pending []ParticipantPayout `protobuf:"bytes,3,rep,name=pending,proto3" json:"pending"`
+}
+
+func (m *QueryParticipantPayoutsResponse) Reset()         { *m = QueryParticipantPayoutsResponse{} }
+func (m *QueryParticipantPayoutsResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryParticipantPayoutsResponse) ProtoMessage()    {}
+func (*QueryParticipantPayoutsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_ee5d2766935e7631, []int{17}
+}
+func (m *QueryParticipantPayoutsResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryParticipantPayoutsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryParticipantPayoutsResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryParticipantPayoutsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryParticipantPayoutsResponse.Merge(m, src)
+}
+func (m *QueryParticipantPayoutsResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryParticipantPayoutsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryParticipantPayoutsResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryParticipantPayoutsResponse proto.InternalMessageInfo
+
+func (m *QueryParticipantPayoutsResponse) GetPayouts() []ParticipantPayout {
+	if m != nil {
+		return m.Payouts
+	}
+	return nil
+}
+
+func (m *QueryParticipantPayoutsResponse) GetPagination() *query.PageResponse {
+	if m != nil {
+		return m.Pagination
+	}
+	return nil
+}
+
+func (m *QueryParticipantPayoutsResponse) GetPending() []ParticipantPayout {
+	if m != nil {
+		return m.Pending
+	}
+	return nil
+}
+
 // QueryParamsRequest is the request type for the Query/Params RPC method.
 type QueryParamsRequest struct {
 }
@@ -647,7 +985,7 @@ func (m *QueryParamsRequest) Reset()         { *m = QueryParamsRequest{} }
 func (m *QueryParamsRequest) String() string { return proto.CompactTextString(m) }
 func (*QueryParamsRequest) ProtoMessage()    {}
 func (*QueryParamsRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ee5d2766935e7631, []int{12}
+	return fileDescriptor_ee5d2766935e7631, []int{18}
 }
 func (m *QueryParamsRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -687,7 +1025,7 @@ func (m *QueryParamsResponse) Reset()         { *m = QueryParamsResponse{} }
 func (m *QueryParamsResponse) String() string { return proto.CompactTextString(m) }
 func (*QueryParamsResponse) ProtoMessage()    {}
 func (*QueryParamsResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ee5d2766935e7631, []int{13}
+	return fileDescriptor_ee5d2766935e7631, []int{19}
 }
 func (m *QueryParamsResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -736,6 +1074,12 @@ func init() {
 	proto.RegisterType((*QueryAllocationMetersResponse)(nil), "evmos.incentives.v1.QueryAllocationMetersResponse")
 	proto.RegisterType((*QueryAllocationMeterRequest)(nil), "evmos.incentives.v1.QueryAllocationMeterRequest")
 	proto.RegisterType((*QueryAllocationMeterResponse)(nil), "evmos.incentives.v1.QueryAllocationMeterResponse")
+	proto.RegisterType((*QueryPendingRewardsRequest)(nil), "evmos.incentives.v1.QueryPendingRewardsRequest")
+	proto.RegisterType((*QueryPendingRewardsResponse)(nil), "evmos.incentives.v1.QueryPendingRewardsResponse")
+	proto.RegisterType((*QueryIncentivePayoutsRequest)(nil), "evmos.incentives.v1.QueryIncentivePayoutsRequest")
+	proto.RegisterType((*QueryIncentivePayoutsResponse)(nil), "evmos.incentives.v1.QueryIncentivePayoutsResponse")
+	proto.RegisterType((*QueryParticipantPayoutsRequest)(nil), "evmos.incentives.v1.QueryParticipantPayoutsRequest")
+	proto.RegisterType((*QueryParticipantPayoutsResponse)(nil), "evmos.incentives.v1.QueryParticipantPayoutsResponse")
 	proto.RegisterType((*QueryParamsRequest)(nil), "evmos.incentives.v1.QueryParamsRequest")
 	proto.RegisterType((*QueryParamsResponse)(nil), "evmos.incentives.v1.QueryParamsResponse")
 }
@@ -743,60 +1087,75 @@ func init() {
 func init() { proto.RegisterFile("evmos/incentives/v1/query.proto", fileDescriptor_ee5d2766935e7631) }
 
 var fileDescriptor_ee5d2766935e7631 = []byte{
-	// 836 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xb4, 0x96, 0x4f, 0x4f, 0x13, 0x4f,
-	0x18, 0xc7, 0x3b, 0xfc, 0x80, 0xb4, 0xc3, 0x01, 0x7e, 0x43, 0x55, 0xd2, 0xc2, 0x16, 0x56, 0x03,
-	0xb5, 0xc0, 0x2e, 0x2d, 0xc6, 0xa8, 0x27, 0xad, 0x44, 0xe2, 0xc1, 0x04, 0x1b, 0x4f, 0xc6, 0x44,
-	0xa7, 0xcb, 0xb8, 0x6e, 0xa4, 0x3b, 0x4b, 0x67, 0x69, 0x24, 0x15, 0x63, 0x7c, 0x05, 0x24, 0x5e,
-	0x3c, 0x78, 0x33, 0x26, 0xea, 0xc1, 0xd7, 0xc1, 0x91, 0xc4, 0xc4, 0x78, 0x52, 0x03, 0x1e, 0x7c,
-	0x19, 0xa6, 0xb3, 0x33, 0xdb, 0xdd, 0x65, 0x4b, 0x17, 0x83, 0x17, 0x68, 0x67, 0x9f, 0x3f, 0x9f,
-	0xef, 0xf3, 0x30, 0xdf, 0x05, 0x16, 0x48, 0xab, 0x41, 0x99, 0x6e, 0xd9, 0x06, 0xb1, 0x5d, 0xab,
-	0x45, 0x98, 0xde, 0x2a, 0xeb, 0x9b, 0x5b, 0xa4, 0xb9, 0xad, 0x39, 0x4d, 0xea, 0x52, 0x34, 0xce,
-	0x03, 0xb4, 0x6e, 0x80, 0xd6, 0x2a, 0xe7, 0x4a, 0x06, 0x65, 0x9d, 0xb4, 0x3a, 0x66, 0xc4, 0x8b,
-	0xd6, 0x5b, 0xe5, 0x3a, 0x71, 0x71, 0x59, 0x77, 0xb0, 0x69, 0xd9, 0xd8, 0xb5, 0xa8, 0xed, 0x15,
-	0xc8, 0x29, 0xc1, 0x58, 0x19, 0x65, 0x50, 0x4b, 0x3e, 0x9f, 0x89, 0x23, 0x30, 0x89, 0x4d, 0x98,
-	0xc5, 0x44, 0xc8, 0x85, 0xb8, 0x90, 0x00, 0x91, 0x17, 0x95, 0x35, 0xa9, 0x49, 0xf9, 0x47, 0xbd,
-	0xf3, 0x49, 0x9c, 0x4e, 0x9a, 0x94, 0x9a, 0x1b, 0x44, 0xc7, 0x8e, 0xa5, 0x63, 0xdb, 0xa6, 0x2e,
-	0x67, 0x13, 0x39, 0xea, 0x23, 0x78, 0xf6, 0x6e, 0x07, 0xff, 0xb6, 0x5f, 0xac, 0x46, 0x36, 0xb7,
-	0x08, 0x73, 0xd1, 0x2d, 0x08, 0xbb, 0x52, 0x26, 0xc0, 0x34, 0x28, 0x8e, 0x54, 0x66, 0x35, 0x4f,
-	0x8b, 0xd6, 0xd1, 0xa2, 0x79, 0x53, 0x12, 0x8a, 0xb4, 0x35, 0x6c, 0x12, 0x91, 0x5b, 0x0b, 0x64,
-	0xaa, 0x1f, 0x00, 0x3c, 0x77, 0xa4, 0x05, 0x73, 0xa8, 0xcd, 0x08, 0x5a, 0x81, 0xb0, 0xab, 0x62,
-	0x02, 0x4c, 0xff, 0x57, 0x1c, 0xa9, 0x28, 0x5a, 0xcc, 0xc0, 0x35, 0x3f, 0xb9, 0x3a, 0xb8, 0xf7,
-	0xbd, 0x90, 0xaa, 0x05, 0xf2, 0xd0, 0x6a, 0x88, 0x74, 0x80, 0x93, 0xce, 0xf5, 0x25, 0xf5, 0x10,
-	0x42, 0xa8, 0xcb, 0xf0, 0x4c, 0x98, 0x54, 0xce, 0x22, 0x07, 0xd3, 0x06, 0xb5, 0xdd, 0x26, 0x36,
-	0x5c, 0x3e, 0x89, 0x4c, 0xcd, 0xff, 0xae, 0x3e, 0x88, 0x4e, 0xd0, 0x57, 0x57, 0x85, 0x19, 0x9f,
-	0x52, 0x0c, 0x30, 0x99, 0xb8, 0x6e, 0x9a, 0xda, 0x16, 0x48, 0xab, 0x98, 0xdd, 0x21, 0x2e, 0x69,
-	0xb2, 0x04, 0x48, 0x91, 0xd5, 0x0d, 0xfc, 0xf5, 0xea, 0xde, 0x03, 0xa1, 0x2d, 0xd0, 0xdd, 0xd7,
-	0x06, 0x4d, 0xcc, 0x1e, 0x36, 0xf8, 0xa9, 0xd8, 0xdc, 0x54, 0xac, 0x38, 0x99, 0x2b, 0xb5, 0x99,
-	0xb2, 0xd6, 0xe9, 0xed, 0xed, 0x1e, 0xcc, 0x86, 0x30, 0x93, 0xcc, 0x68, 0x1a, 0x8e, 0x38, 0xb8,
-	0xe9, 0x5a, 0x86, 0xe5, 0x60, 0xdb, 0xe5, 0xdd, 0x33, 0xb5, 0xe0, 0x91, 0x7a, 0x29, 0x32, 0x7a,
-	0x5f, 0x7b, 0x1e, 0x66, 0x7c, 0xed, 0xbc, 0xee, 0x60, 0x2d, 0x2d, 0x55, 0xa9, 0x8f, 0xe1, 0x24,
-	0xcf, 0xba, 0xb1, 0xb1, 0x41, 0x0d, 0x8e, 0x17, 0xde, 0xdb, 0x69, 0x5d, 0xab, 0xdf, 0x00, 0x4e,
-	0xf5, 0x68, 0x24, 0x30, 0x5f, 0xc0, 0xff, 0xb1, 0xff, 0x2c, 0xbc, 0xa9, 0xc9, 0x50, 0x43, 0xd9,
-	0x6a, 0x85, 0x18, 0x37, 0xa9, 0x65, 0x57, 0x97, 0x3b, 0x8b, 0xfa, 0xf4, 0xa3, 0x30, 0x6f, 0x5a,
-	0xee, 0x93, 0xad, 0xba, 0x66, 0xd0, 0x86, 0x2e, 0x3c, 0xcc, 0xfb, 0xb5, 0xc8, 0xd6, 0x9f, 0xea,
-	0xee, 0xb6, 0x43, 0x98, 0xcc, 0x61, 0xb5, 0x31, 0x1c, 0xe1, 0x38, 0xcd, 0x6b, 0x99, 0x8f, 0x53,
-	0x2a, 0x27, 0x9a, 0x85, 0x43, 0xeb, 0xc4, 0xa6, 0x0d, 0xb1, 0x62, 0xef, 0x8b, 0xfa, 0x16, 0xc4,
-	0x2f, 0xc2, 0x1f, 0xcf, 0x73, 0x38, 0x16, 0x1d, 0x8f, 0x58, 0xc7, 0x3f, 0x98, 0xce, 0x68, 0x64,
-	0x3a, 0x6a, 0x16, 0x22, 0x4e, 0xb7, 0x86, 0x9b, 0xb8, 0x21, 0xff, 0x38, 0xd4, 0x35, 0x38, 0x1e,
-	0x3a, 0x15, 0xa8, 0x57, 0xe1, 0xb0, 0xc3, 0x4f, 0x04, 0x60, 0x3e, 0xf6, 0xa2, 0x79, 0x49, 0xe2,
-	0x9a, 0x89, 0x84, 0xca, 0xd7, 0x34, 0x1c, 0xe2, 0x25, 0xd1, 0x2e, 0x80, 0xb0, 0x6b, 0xc1, 0x68,
-	0x3e, 0xb6, 0x46, 0xfc, 0xbb, 0x20, 0xb7, 0x90, 0x2c, 0xd8, 0xc3, 0x55, 0xe7, 0x5e, 0x7d, 0xf9,
-	0xf5, 0x7a, 0x60, 0x06, 0x15, 0xf4, 0xe3, 0x5f, 0x5b, 0xe8, 0x0d, 0x80, 0x19, 0x3f, 0x1f, 0x95,
-	0x12, 0x34, 0x91, 0x40, 0xf3, 0x89, 0x62, 0x05, 0x4f, 0x85, 0xf3, 0x2c, 0xa0, 0x52, 0x1f, 0x1e,
-	0xbd, 0x2d, 0xdd, 0x61, 0x87, 0xa3, 0xf9, 0xae, 0x77, 0x1c, 0x5a, 0xd4, 0x98, 0x8f, 0x43, 0x3b,
-	0x62, 0xa3, 0x7d, 0xd0, 0xba, 0x0e, 0x1b, 0x44, 0x7b, 0x07, 0x60, 0x5a, 0x56, 0x42, 0x17, 0xfb,
-	0x77, 0x93, 0x60, 0xa5, 0x24, 0xa1, 0x82, 0xeb, 0x3a, 0xe7, 0xba, 0x86, 0xae, 0x24, 0xe7, 0xd2,
-	0xdb, 0x01, 0xf3, 0xdc, 0x41, 0x1f, 0x01, 0x1c, 0x8b, 0x5a, 0x13, 0x2a, 0xf7, 0x46, 0xe8, 0xe1,
-	0x97, 0xb9, 0xca, 0x49, 0x52, 0x04, 0xbd, 0xc6, 0xe9, 0x8b, 0x68, 0x36, 0x96, 0xfe, 0x88, 0x29,
-	0xa2, 0xcf, 0x00, 0x8e, 0x46, 0x8a, 0xa1, 0xa5, 0xc4, 0x7d, 0x25, 0x69, 0xf9, 0x04, 0x19, 0x02,
-	0xf4, 0x32, 0x07, 0x5d, 0x42, 0x5a, 0x32, 0x50, 0xbd, 0xcd, 0xbd, 0x6d, 0x07, 0xbd, 0x04, 0x70,
-	0xd8, 0xbb, 0xee, 0x68, 0xae, 0x77, 0xd7, 0x90, 0xb7, 0xe4, 0x8a, 0xfd, 0x03, 0x05, 0xd5, 0x79,
-	0x4e, 0x35, 0x85, 0xf2, 0xb1, 0x54, 0x9e, 0xb1, 0x54, 0x57, 0xf7, 0x0e, 0x14, 0xb0, 0x7f, 0xa0,
-	0x80, 0x9f, 0x07, 0x0a, 0xd8, 0x3d, 0x54, 0x52, 0xfb, 0x87, 0x4a, 0xea, 0xdb, 0xa1, 0x92, 0xba,
-	0xbf, 0x18, 0x30, 0x46, 0xaf, 0x80, 0xf7, 0xb3, 0x55, 0x5e, 0xd2, 0x9f, 0x05, 0x8b, 0x71, 0x8f,
-	0xac, 0x0f, 0xf3, 0x7f, 0x44, 0x97, 0xff, 0x04, 0x00, 0x00, 0xff, 0xff, 0xd6, 0xfc, 0x0d, 0x20,
-	0x89, 0x0b, 0x00, 0x00,
+	// 1086 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xbc, 0x98, 0x4b, 0x6f, 0x1b, 0x55,
+	0x14, 0xc7, 0x73, 0x93, 0x36, 0x8d, 0x4f, 0x04, 0x0d, 0xb7, 0xa1, 0x84, 0x71, 0x62, 0xa7, 0x43,
+	0x95, 0x98, 0xa4, 0x9d, 0xf1, 0x23, 0xaa, 0xda, 0x2e, 0x2a, 0x08, 0x55, 0x22, 0x16, 0x48, 0xc1,
+	0x62, 0x85, 0x90, 0xc2, 0xcd, 0xe4, 0x32, 0x8c, 0x88, 0xe7, 0x4e, 0x3d, 0x13, 0x43, 0x14, 0x82,
+	0xa0, 0xe2, 0x03, 0x54, 0x62, 0xc3, 0x82, 0x1d, 0x02, 0x01, 0x8b, 0xb2, 0x61, 0xcf, 0xb6, 0xcb,
+	0x4a, 0x6c, 0x58, 0x01, 0x4a, 0x58, 0xf0, 0x19, 0x58, 0x21, 0xdf, 0xc7, 0xbc, 0x3c, 0x63, 0x4f,
+	0xa2, 0x94, 0x4d, 0x6b, 0x5f, 0x9f, 0xc7, 0xef, 0xfc, 0xcf, 0x7d, 0x1c, 0x05, 0xaa, 0xb4, 0xd7,
+	0x61, 0xbe, 0xe9, 0xb8, 0x16, 0x75, 0x03, 0xa7, 0x47, 0x7d, 0xb3, 0xd7, 0x30, 0x1f, 0xec, 0xd3,
+	0xee, 0x81, 0xe1, 0x75, 0x59, 0xc0, 0xf0, 0x15, 0x6e, 0x60, 0x44, 0x06, 0x46, 0xaf, 0xa1, 0xad,
+	0x58, 0xcc, 0xef, 0xbb, 0xed, 0x10, 0x9f, 0x0a, 0x6b, 0xb3, 0xd7, 0xd8, 0xa1, 0x01, 0x69, 0x98,
+	0x1e, 0xb1, 0x1d, 0x97, 0x04, 0x0e, 0x73, 0x45, 0x00, 0xad, 0x12, 0xb7, 0x55, 0x56, 0x16, 0x73,
+	0xd4, 0xef, 0xd7, 0xb2, 0x08, 0x6c, 0xea, 0x52, 0xdf, 0xf1, 0xa5, 0xc9, 0xf5, 0x2c, 0x93, 0xe8,
+	0x9b, 0xb4, 0x9a, 0xb5, 0x99, 0xcd, 0xf8, 0x47, 0xb3, 0xff, 0x49, 0xae, 0xce, 0xdb, 0x8c, 0xd9,
+	0x7b, 0xd4, 0x24, 0x9e, 0x63, 0x12, 0xd7, 0x65, 0x01, 0x67, 0x93, 0x3e, 0xfa, 0xfb, 0x70, 0xf5,
+	0xed, 0x3e, 0xfe, 0x9b, 0x61, 0xb0, 0x36, 0x7d, 0xb0, 0x4f, 0xfd, 0x00, 0x6f, 0x00, 0x44, 0xa5,
+	0xcc, 0xa1, 0x45, 0x54, 0x9b, 0x6e, 0x2e, 0x19, 0xa2, 0x16, 0xa3, 0x5f, 0x8b, 0x21, 0x54, 0x92,
+	0x15, 0x19, 0x5b, 0xc4, 0xa6, 0xd2, 0xb7, 0x1d, 0xf3, 0xd4, 0x7f, 0x40, 0xf0, 0xd2, 0x40, 0x0a,
+	0xdf, 0x63, 0xae, 0x4f, 0xf1, 0x7d, 0x80, 0xa8, 0x8a, 0x39, 0xb4, 0x38, 0x51, 0x9b, 0x6e, 0x56,
+	0x8c, 0x0c, 0xc1, 0x8d, 0xd0, 0x79, 0xfd, 0xc2, 0x93, 0x3f, 0xaa, 0x63, 0xed, 0x98, 0x1f, 0xde,
+	0x4c, 0x90, 0x8e, 0x73, 0xd2, 0xe5, 0x91, 0xa4, 0x02, 0x21, 0x81, 0xda, 0x82, 0x17, 0x93, 0xa4,
+	0x4a, 0x0b, 0x0d, 0xa6, 0x2c, 0xe6, 0x06, 0x5d, 0x62, 0x05, 0x5c, 0x89, 0x52, 0x3b, 0xfc, 0xae,
+	0xbf, 0x97, 0x56, 0x30, 0xac, 0x6e, 0x1d, 0x4a, 0x21, 0xa5, 0x14, 0xb0, 0x58, 0x71, 0x91, 0x9b,
+	0x7e, 0x28, 0x91, 0x36, 0x89, 0xff, 0x16, 0x0d, 0x68, 0xd7, 0x2f, 0x80, 0x84, 0x37, 0x32, 0x04,
+	0x39, 0x4b, 0xeb, 0xbe, 0x43, 0x70, 0x35, 0x9d, 0x3d, 0xac, 0x0d, 0x6c, 0xe2, 0x6f, 0x77, 0xf8,
+	0xaa, 0xec, 0xdc, 0x42, 0x66, 0x71, 0xca, 0x57, 0xd5, 0x66, 0xab, 0x58, 0xe7, 0xd7, 0xb7, 0x77,
+	0x60, 0x36, 0x81, 0x59, 0x44, 0xa3, 0x45, 0x98, 0xf6, 0x48, 0x37, 0x70, 0x2c, 0xc7, 0x23, 0x6e,
+	0xc0, 0xb3, 0x97, 0xda, 0xf1, 0x25, 0x7d, 0x2d, 0x25, 0x7d, 0x58, 0x7b, 0x19, 0x4a, 0x61, 0xed,
+	0x3c, 0xee, 0x85, 0xf6, 0x94, 0xaa, 0x4a, 0xff, 0x00, 0xe6, 0xb9, 0xd7, 0xeb, 0x7b, 0x7b, 0xcc,
+	0xe2, 0x78, 0xc9, 0xbe, 0x9d, 0xd7, 0xb1, 0xfa, 0x07, 0xc1, 0x42, 0x4e, 0x22, 0x89, 0xf9, 0x19,
+	0xbc, 0x40, 0xc2, 0xdf, 0x92, 0x9d, 0x9a, 0x4f, 0x24, 0x54, 0xa9, 0xee, 0x53, 0xeb, 0x0d, 0xe6,
+	0xb8, 0xeb, 0xad, 0x7e, 0xa3, 0x7e, 0xfa, 0xb3, 0xba, 0x6a, 0x3b, 0xc1, 0x87, 0xfb, 0x3b, 0x86,
+	0xc5, 0x3a, 0xa6, 0xbc, 0xc3, 0xc4, 0x7f, 0x37, 0xfd, 0xdd, 0x8f, 0xcc, 0xe0, 0xc0, 0xa3, 0xbe,
+	0xf2, 0xf1, 0xdb, 0x33, 0x24, 0xc5, 0x71, 0x9e, 0xc7, 0xb2, 0x9c, 0x55, 0xa9, 0x52, 0x74, 0x16,
+	0x2e, 0xee, 0x52, 0x97, 0x75, 0x64, 0x8b, 0xc5, 0x17, 0xfd, 0x1b, 0x94, 0xdd, 0x88, 0x50, 0x9e,
+	0x4f, 0x61, 0x26, 0x2d, 0x8f, 0x6c, 0xc7, 0x33, 0x50, 0xe7, 0x72, 0x4a, 0x1d, 0xfd, 0x1e, 0x68,
+	0x9c, 0x6e, 0x8b, 0xba, 0xbb, 0x8e, 0x6b, 0xb7, 0xe9, 0xc7, 0xa4, 0xbb, 0x1b, 0x6e, 0x92, 0xd4,
+	0xe6, 0x44, 0x83, 0x9b, 0xf3, 0x4b, 0x04, 0xe5, 0xcc, 0x00, 0xb2, 0x3a, 0x0a, 0x97, 0xba, 0x62,
+	0x49, 0xb6, 0xfc, 0xe5, 0xcc, 0xa2, 0x78, 0x45, 0x75, 0x59, 0x51, 0xad, 0x40, 0x45, 0xa2, 0x1c,
+	0x15, 0x5b, 0x7f, 0xa8, 0x54, 0x0e, 0xaf, 0xb0, 0x2d, 0x72, 0xc0, 0xf6, 0x83, 0xff, 0xf5, 0x9a,
+	0x7a, 0xac, 0x8e, 0xc2, 0x20, 0x44, 0xf8, 0xce, 0x5c, 0xf2, 0xc4, 0x92, 0x54, 0xe3, 0xfa, 0xf0,
+	0x7b, 0x58, 0xf8, 0xcb, 0x1b, 0x4b, 0xb9, 0x9e, 0xdf, 0x86, 0xfe, 0x1e, 0x41, 0x45, 0x34, 0x2f,
+	0xea, 0x68, 0x4a, 0xb7, 0x91, 0x3b, 0x20, 0xa1, 0xec, 0xf8, 0x50, 0x65, 0x27, 0xce, 0xac, 0xec,
+	0xbf, 0x08, 0xaa, 0xb9, 0xa0, 0x52, 0xdb, 0x8d, 0xb4, 0xb6, 0x4b, 0x99, 0xda, 0x0e, 0x44, 0x78,
+	0x56, 0xea, 0x72, 0x20, 0x71, 0x28, 0xe6, 0x26, 0xce, 0x04, 0x24, 0x9c, 0xf5, 0x59, 0xc0, 0xaa,
+	0x76, 0xd2, 0x51, 0x8d, 0xd1, 0xb7, 0xe0, 0x4a, 0x62, 0x55, 0xaa, 0x70, 0x07, 0x26, 0x3d, 0xbe,
+	0x22, 0xef, 0x90, 0x72, 0x5e, 0x4e, 0xd2, 0xf1, 0x65, 0x22, 0xe9, 0xd0, 0xfc, 0xe2, 0x39, 0xb8,
+	0xc8, 0x43, 0xe2, 0x47, 0x08, 0x20, 0x9a, 0x92, 0xf0, 0x6a, 0x66, 0x8c, 0xec, 0x71, 0x4d, 0xbb,
+	0x51, 0xcc, 0x58, 0xe0, 0xea, 0xcb, 0x0f, 0x7f, 0xfb, 0xfb, 0xab, 0xf1, 0x6b, 0xb8, 0x6a, 0x0e,
+	0x9f, 0x2c, 0xf1, 0xd7, 0x08, 0x4a, 0xa1, 0x3f, 0x5e, 0x29, 0x90, 0x44, 0x01, 0xad, 0x16, 0xb2,
+	0x95, 0x3c, 0x4d, 0xce, 0x73, 0x03, 0xaf, 0x8c, 0xe0, 0x31, 0x0f, 0xd5, 0x1e, 0x3f, 0xe2, 0x68,
+	0xe1, 0x60, 0x32, 0x0c, 0x2d, 0x3d, 0x3b, 0x69, 0xab, 0x85, 0x6c, 0x0b, 0xa1, 0x45, 0x43, 0x50,
+	0x1c, 0xed, 0x5b, 0x04, 0x53, 0x2a, 0x12, 0x7e, 0x75, 0x74, 0x36, 0x05, 0xb6, 0x52, 0xc4, 0x54,
+	0x72, 0xbd, 0xc6, 0xb9, 0xee, 0xe2, 0xdb, 0xc5, 0xb9, 0xcc, 0xc3, 0xd8, 0x05, 0x72, 0x84, 0x7f,
+	0x44, 0x30, 0x93, 0x9e, 0x1e, 0x70, 0x23, 0x1f, 0x21, 0x67, 0xa4, 0xd1, 0x9a, 0xa7, 0x71, 0x91,
+	0xf4, 0x06, 0xa7, 0xaf, 0xe1, 0xa5, 0x4c, 0xfa, 0x81, 0xb9, 0x05, 0x3f, 0x46, 0x70, 0x39, 0x15,
+	0x0c, 0xd7, 0x0b, 0xe7, 0x55, 0xa4, 0x8d, 0x53, 0x78, 0x48, 0xd0, 0x5b, 0x1c, 0xb4, 0x8e, 0x8d,
+	0x62, 0xa0, 0xe6, 0x21, 0x1f, 0x3f, 0x8e, 0xf0, 0xcf, 0x08, 0x9e, 0x4f, 0xbe, 0xcd, 0xd8, 0xcc,
+	0xcf, 0x9e, 0x39, 0x06, 0x68, 0xf5, 0xe2, 0x0e, 0x92, 0xf6, 0x2e, 0xa7, 0x5d, 0xc3, 0xcd, 0x4c,
+	0x5a, 0x79, 0xb3, 0x6d, 0xcb, 0xd7, 0x3b, 0xb5, 0x1d, 0x7e, 0x41, 0x30, 0x93, 0x7e, 0x41, 0x87,
+	0x6d, 0x87, 0x9c, 0x27, 0x5f, 0x6b, 0x9e, 0xc6, 0x45, 0x72, 0xdf, 0xe1, 0xdc, 0x2d, 0xdc, 0x18,
+	0x7e, 0xfe, 0xb7, 0xe5, 0x63, 0x11, 0x3f, 0x6b, 0xbf, 0x22, 0xc0, 0x83, 0xcf, 0x13, 0x6e, 0x0d,
+	0xd1, 0x2e, 0xef, 0xd5, 0xd5, 0xd6, 0x4e, 0xe7, 0x24, 0xe1, 0xef, 0x71, 0xf8, 0xdb, 0xf8, 0x56,
+	0xb6, 0xe8, 0x91, 0x63, 0x84, 0x9f, 0x10, 0xfe, 0x73, 0x04, 0x93, 0xe2, 0x65, 0xc0, 0xcb, 0x43,
+	0x01, 0xa2, 0x67, 0x48, 0xab, 0x8d, 0x36, 0x94, 0x74, 0xaf, 0x70, 0xba, 0x05, 0x5c, 0xce, 0xa3,
+	0xeb, 0xbf, 0x48, 0x9b, 0x4f, 0x8e, 0x2b, 0xe8, 0xe9, 0x71, 0x05, 0xfd, 0x75, 0x5c, 0x41, 0x8f,
+	0x4e, 0x2a, 0x63, 0x4f, 0x4f, 0x2a, 0x63, 0xbf, 0x9f, 0x54, 0xc6, 0xde, 0xbd, 0x19, 0x1b, 0x0a,
+	0x45, 0x00, 0xf1, 0x6f, 0xaf, 0x51, 0x37, 0x3f, 0x89, 0x07, 0xe3, 0xf3, 0xe1, 0xce, 0x24, 0xff,
+	0xb3, 0x42, 0xeb, 0xbf, 0x01, 0x00, 0x2e, 0x21, 0xd8, 0x85, 0x57, 0x11, 0x00, 0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -824,6 +1183,13 @@ type QueryClient interface {
 	AllocationMeters(ctx context.Context, in *QueryAllocationMetersRequest, opts ...grpc.CallOption) (*QueryAllocationMetersResponse, error)
 	// AllocationMeter retrieves a active gas meter
 	AllocationMeter(ctx context.Context, in *QueryAllocationMeterRequest, opts ...grpc.CallOption) (*QueryAllocationMeterResponse, error)
+	// PendingRewards retrieves the incentive rewards a participant can claim
+	PendingRewards(ctx context.Context, in *QueryPendingRewardsRequest, opts ...grpc.CallOption) (*QueryPendingRewardsResponse, error)
+	// IncentivePayouts retrieves the payout history of an incentive
+	IncentivePayouts(ctx context.Context, in *QueryIncentivePayoutsRequest, opts ...grpc.CallOption) (*QueryIncentivePayoutsResponse, error)
+	// ParticipantPayouts retrieves the payout history of a participant,
+	// optionally for a single incentive
+	ParticipantPayouts(ctx context.Context, in *QueryParticipantPayoutsRequest, opts ...grpc.CallOption) (*QueryParticipantPayoutsResponse, error)
 	// Params retrieves the incentives module params
 	Params(ctx context.Context, in *QueryParamsRequest, opts ...grpc.CallOption) (*QueryParamsResponse, error)
 }
@@ -890,6 +1256,33 @@ func (c *queryClient) AllocationMeter(ctx context.Context, in *QueryAllocationMe
 	return out, nil
 }
 
+func (c *queryClient) PendingRewards(ctx context.Context, in *QueryPendingRewardsRequest, opts ...grpc.CallOption) (*QueryPendingRewardsResponse, error) {
+	out := new(QueryPendingRewardsResponse)
+	err := c.cc.Invoke(ctx, "/evmos.incentives.v1.Query/PendingRewards", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) IncentivePayouts(ctx context.Context, in *QueryIncentivePayoutsRequest, opts ...grpc.CallOption) (*QueryIncentivePayoutsResponse, error) {
+	out := new(QueryIncentivePayoutsResponse)
+	err := c.cc.Invoke(ctx, "/evmos.incentives.v1.Query/IncentivePayouts", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) ParticipantPayouts(ctx context.Context, in *QueryParticipantPayoutsRequest, opts ...grpc.CallOption) (*QueryParticipantPayoutsResponse, error) {
+	out := new(QueryParticipantPayoutsResponse)
+	err := c.cc.Invoke(ctx, "/evmos.incentives.v1.Query/ParticipantPayouts", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *queryClient) Params(ctx context.Context, in *QueryParamsRequest, opts ...grpc.CallOption) (*QueryParamsResponse, error) {
 	out := new(QueryParamsResponse)
 	err := c.cc.Invoke(ctx, "/evmos.incentives.v1.Query/Params", in, out, opts...)
@@ -914,6 +1307,13 @@ type QueryServer interface {
 	AllocationMeters(context.Context, *QueryAllocationMetersRequest) (*QueryAllocationMetersResponse, error)
 	// AllocationMeter retrieves a active gas meter
 	AllocationMeter(context.Context, *QueryAllocationMeterRequest) (*QueryAllocationMeterResponse, error)
+	// PendingRewards retrieves the incentive rewards a participant can claim
+	PendingRewards(context.Context, *QueryPendingRewardsRequest) (*QueryPendingRewardsResponse, error)
+	// IncentivePayouts retrieves the payout history of an incentive
+	IncentivePayouts(context.Context, *QueryIncentivePayoutsRequest) (*QueryIncentivePayoutsResponse, error)
+	// ParticipantPayouts retrieves the payout history of a participant,
+	// optionally for a single incentive
+	ParticipantPayouts(context.Context, *QueryParticipantPayoutsRequest) (*QueryParticipantPayoutsResponse, error)
 	// Params retrieves the incentives module params
 	Params(context.Context, *QueryParamsRequest) (*QueryParamsResponse, error)
 }
@@ -940,6 +1340,15 @@ func (*UnimplementedQueryServer) AllocationMeters(ctx context.Context, req *Quer
 func (*UnimplementedQueryServer) AllocationMeter(ctx context.Context, req *QueryAllocationMeterRequest) (*QueryAllocationMeterResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method AllocationMeter not implemented")
 }
+func (*UnimplementedQueryServer) PendingRewards(ctx context.Context, req *QueryPendingRewardsRequest) (*QueryPendingRewardsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method PendingRewards not implemented")
+}
+func (*UnimplementedQueryServer) IncentivePayouts(ctx context.Context, req *QueryIncentivePayoutsRequest) (*QueryIncentivePayoutsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method IncentivePayouts not implemented")
+}
+func (*UnimplementedQueryServer) ParticipantPayouts(ctx context.Context, req *QueryParticipantPayoutsRequest) (*QueryParticipantPayoutsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ParticipantPayouts not implemented")
+}
 func (*UnimplementedQueryServer) Params(ctx context.Context, req *QueryParamsRequest) (*QueryParamsResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method Params not implemented")
 }
@@ -1056,45 +1465,99 @@ func _Query_AllocationMeter_Handler(srv interface{}, ctx context.Context, dec fu
 	return interceptor(ctx, in, info, handler)
 }
 
-func _Query_Params_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryParamsRequest)
+func _Query_PendingRewards_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryPendingRewardsRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(QueryServer).Params(ctx, in)
+		return srv.(QueryServer).PendingRewards(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/evmos.incentives.v1.Query/Params",
+		FullMethod: "/evmos.incentives.v1.Query/PendingRewards",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).Params(ctx, req.(*QueryParamsRequest))
+		return srv.(QueryServer).PendingRewards(ctx, req.(*QueryPendingRewardsRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-var _Query_serviceDesc = grpc.ServiceDesc{
-	ServiceName: "evmos.incentives.v1.Query",
-	HandlerType: (*QueryServer)(nil),
-	Methods: []grpc.MethodDesc{
-		{
-			MethodName: "Incentives",
-			Handler:    _Query_Incentives_Handler,
-		},
-		{
-			MethodName: "Incentive",
-			Handler:    _Query_Incentive_Handler,
-		},
-		{
-			MethodName: "GasMeters",
-			Handler:    _Query_GasMeters_Handler,
-		},
-		{
-			MethodName: "GasMeter",
-			Handler:    _Query_GasMeter_Handler,
-		},
-		{
+func _Query_IncentivePayouts_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryIncentivePayoutsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).IncentivePayouts(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/evmos.incentives.v1.Query/IncentivePayouts",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).IncentivePayouts(ctx, req.(*QueryIncentivePayoutsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_ParticipantPayouts_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryParticipantPayoutsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).ParticipantPayouts(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/evmos.incentives.v1.Query/ParticipantPayouts",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).ParticipantPayouts(ctx, req.(*QueryParticipantPayoutsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_Params_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryParamsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).Params(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/evmos.incentives.v1.Query/Params",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).Params(ctx, req.(*QueryParamsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+var _Query_serviceDesc = grpc.ServiceDesc{
+	ServiceName: "evmos.incentives.v1.Query",
+	HandlerType: (*QueryServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "Incentives",
+			Handler:    _Query_Incentives_Handler,
+		},
+		{
+			MethodName: "Incentive",
+			Handler:    _Query_Incentive_Handler,
+		},
+		{
+			MethodName: "GasMeters",
+			Handler:    _Query_GasMeters_Handler,
+		},
+		{
+			MethodName: "GasMeter",
+			Handler:    _Query_GasMeter_Handler,
+		},
+		{
 			MethodName: "AllocationMeters",
 			Handler:    _Query_AllocationMeters_Handler,
 		},
@@ -1102,6 +1565,18 @@ var _Query_serviceDesc = grpc.ServiceDesc{
 			MethodName: "AllocationMeter",
 			Handler:    _Query_AllocationMeter_Handler,
 		},
+		{
+			MethodName: "PendingRewards",
+			Handler:    _Query_PendingRewards_Handler,
+		},
+		{
+			MethodName: "IncentivePayouts",
+			Handler:    _Query_IncentivePayouts_Handler,
+		},
+		{
+			MethodName: "ParticipantPayouts",
+			Handler:    _Query_ParticipantPayouts_Handler,
+		},
 		{
 			MethodName: "Params",
 			Handler:    _Query_Params_Handler,
@@ -1561,7 +2036,7 @@ func (m *QueryAllocationMeterResponse) MarshalToSizedBuffer(dAtA []byte) (int, e
 	return len(dAtA) - i, nil
 }
 
-func (m *QueryParamsRequest) Marshal() (dAtA []byte, err error) {
+func (m *QueryPendingRewardsRequest) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -1571,20 +2046,27 @@ func (m *QueryParamsRequest) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *QueryParamsRequest) MarshalTo(dAtA []byte) (int, error) {
+func (m *QueryPendingRewardsRequest) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *QueryParamsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *QueryPendingRewardsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
+	if len(m.Participant) > 0 {
+		i -= len(m.Participant)
+		copy(dAtA[i:], m.Participant)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Participant)))
+		i--
+		dAtA[i] = 0xa
+	}
 	return len(dAtA) - i, nil
 }
 
-func (m *QueryParamsResponse) Marshal() (dAtA []byte, err error) {
+func (m *QueryPendingRewardsResponse) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -1594,139 +2076,402 @@ func (m *QueryParamsResponse) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *QueryParamsResponse) MarshalTo(dAtA []byte) (int, error) {
+func (m *QueryPendingRewardsResponse) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *QueryParamsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *QueryPendingRewardsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	{
-		size, err := m.Params.MarshalToSizedBuffer(dAtA[:i])
-		if err != nil {
-			return 0, err
+	if len(m.Rewards) > 0 {
+		for iNdEx := len(m.Rewards) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Rewards[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
 		}
-		i -= size
-		i = encodeVarintQuery(dAtA, i, uint64(size))
 	}
-	i--
-	dAtA[i] = 0xa
 	return len(dAtA) - i, nil
 }
 
-func encodeVarintQuery(dAtA []byte, offset int, v uint64) int {
-	offset -= sovQuery(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
+func (m *QueryIncentivePayoutsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	dAtA[offset] = uint8(v)
-	return base
+	return dAtA[:n], nil
 }
-func (m *QueryIncentivesRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if m.Pagination != nil {
-		l = m.Pagination.Size()
-		n += 1 + l + sovQuery(uint64(l))
-	}
-	return n
+
+func (m *QueryIncentivePayoutsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *QueryIncentivesResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+func (m *QueryIncentivePayoutsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	if len(m.Incentives) > 0 {
-		for _, e := range m.Incentives {
-			l = e.Size()
-			n += 1 + l + sovQuery(uint64(l))
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
 		}
+		i--
+		dAtA[i] = 0x12
 	}
-	if m.Pagination != nil {
-		l = m.Pagination.Size()
-		n += 1 + l + sovQuery(uint64(l))
+	if len(m.Contract) > 0 {
+		i -= len(m.Contract)
+		copy(dAtA[i:], m.Contract)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Contract)))
+		i--
+		dAtA[i] = 0xa
 	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *QueryIncentiveRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.Contract)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
+func (m *QueryIncentivePayoutsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	return n
+	return dAtA[:n], nil
 }
 
-func (m *QueryIncentiveResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = m.Incentive.Size()
-	n += 1 + l + sovQuery(uint64(l))
-	return n
+func (m *QueryIncentivePayoutsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *QueryGasMetersRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+func (m *QueryIncentivePayoutsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.Contract)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
-	}
 	if m.Pagination != nil {
-		l = m.Pagination.Size()
-		n += 1 + l + sovQuery(uint64(l))
-	}
-	return n
-}
-
-func (m *QueryGasMetersResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if len(m.GasMeters) > 0 {
-		for _, e := range m.GasMeters {
-			l = e.Size()
-			n += 1 + l + sovQuery(uint64(l))
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
 		}
+		i--
+		dAtA[i] = 0x12
 	}
-	if m.Pagination != nil {
-		l = m.Pagination.Size()
-		n += 1 + l + sovQuery(uint64(l))
+	if len(m.Payouts) > 0 {
+		for iNdEx := len(m.Payouts) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Payouts[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
 	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *QueryGasMeterRequest) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *QueryParticipantPayoutsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryParticipantPayoutsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryParticipantPayoutsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.Contract)
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Contract) > 0 {
+		i -= len(m.Contract)
+		copy(dAtA[i:], m.Contract)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Contract)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Participant) > 0 {
+		i -= len(m.Participant)
+		copy(dAtA[i:], m.Participant)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Participant)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryParticipantPayoutsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryParticipantPayoutsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryParticipantPayoutsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Pending) > 0 {
+		for iNdEx := len(m.Pending) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Pending[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Payouts) > 0 {
+		for iNdEx := len(m.Payouts) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Payouts[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryParamsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryParamsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryParamsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryParamsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryParamsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryParamsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.Params.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintQuery(dAtA []byte, offset int, v uint64) int {
+	offset -= sovQuery(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *QueryIncentivesRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryIncentivesResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Incentives) > 0 {
+		for _, e := range m.Incentives {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryIncentiveRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Contract)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryIncentiveResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Incentive.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *QueryGasMetersRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Contract)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryGasMetersResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.GasMeters) > 0 {
+		for _, e := range m.GasMeters {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryGasMeterRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Contract)
 	if l > 0 {
 		n += 1 + l + sovQuery(uint64(l))
 	}
@@ -1805,24 +2550,134 @@ func (m *QueryAllocationMeterResponse) Size() (n int) {
 	return n
 }
 
-func (m *QueryParamsRequest) Size() (n int) {
+func (m *QueryPendingRewardsRequest) Size() (n int) {
 	if m == nil {
 		return 0
 	}
 	var l int
 	_ = l
+	l = len(m.Participant)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
 	return n
 }
 
-func (m *QueryParamsResponse) Size() (n int) {
+func (m *QueryPendingRewardsResponse) Size() (n int) {
 	if m == nil {
 		return 0
 	}
 	var l int
 	_ = l
-	l = m.Params.Size()
-	n += 1 + l + sovQuery(uint64(l))
-	return n
+	if len(m.Rewards) > 0 {
+		for _, e := range m.Rewards {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *QueryIncentivePayoutsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Contract)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryIncentivePayoutsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Payouts) > 0 {
+		for _, e := range m.Payouts {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryParticipantPayoutsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Participant)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.Contract)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryParticipantPayoutsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Payouts) > 0 {
+		for _, e := range m.Payouts {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if len(m.Pending) > 0 {
+		for _, e := range m.Pending {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *QueryParamsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *QueryParamsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Params.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
 }
 
 func sovQuery(x uint64) (n int) {
@@ -1841,26 +2696,429 @@ func (m *QueryIncentivesRequest) Unmarshal(dAtA []byte) error {
 			if shift >= 64 {
 				return ErrIntOverflowQuery
 			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryIncentivesRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryIncentivesRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageRequest{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryIncentivesResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryIncentivesResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryIncentivesResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Incentives", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Incentives = append(m.Incentives, Incentive{})
+			if err := m.Incentives[len(m.Incentives)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageResponse{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryIncentiveRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryIncentiveRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryIncentiveRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Contract", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Contract = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryIncentiveResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryIncentiveResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryIncentiveResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Incentive", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Incentive.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryGasMetersRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryGasMetersRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryGasMetersRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Contract", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
 			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
 			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: QueryIncentivesRequest: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryIncentivesRequest: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Contract = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
 			if wireType != 2 {
 				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
 			}
@@ -1917,7 +3175,7 @@ func (m *QueryIncentivesRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryIncentivesResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryGasMetersResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1940,15 +3198,15 @@ func (m *QueryIncentivesResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryIncentivesResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryGasMetersResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryIncentivesResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryGasMetersResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Incentives", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field GasMeters", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -1975,8 +3233,8 @@ func (m *QueryIncentivesResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Incentives = append(m.Incentives, Incentive{})
-			if err := m.Incentives[len(m.Incentives)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.GasMeters = append(m.GasMeters, GasMeter{})
+			if err := m.GasMeters[len(m.GasMeters)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -2037,7 +3295,7 @@ func (m *QueryIncentivesResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryIncentiveRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryGasMeterRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2060,10 +3318,10 @@ func (m *QueryIncentiveRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryIncentiveRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryGasMeterRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryIncentiveRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryGasMeterRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -2098,6 +3356,38 @@ func (m *QueryIncentiveRequest) Unmarshal(dAtA []byte) error {
 			}
 			m.Contract = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Participant", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Participant = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -2119,7 +3409,7 @@ func (m *QueryIncentiveRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryIncentiveResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryGasMeterResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2142,15 +3432,84 @@ func (m *QueryIncentiveResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryIncentiveResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryGasMeterResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryIncentiveResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryGasMeterResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field GasMeter", wireType)
+			}
+			m.GasMeter = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.GasMeter |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryAllocationMetersRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryAllocationMetersRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryAllocationMetersRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Incentive", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -2177,7 +3536,10 @@ func (m *QueryIncentiveResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Incentive.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if m.Pagination == nil {
+				m.Pagination = &query.PageRequest{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -2202,7 +3564,7 @@ func (m *QueryIncentiveResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryGasMetersRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryAllocationMetersResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2225,17 +3587,17 @@ func (m *QueryGasMetersRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryGasMetersRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryAllocationMetersResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryGasMetersRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryAllocationMetersResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Contract", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field AllocationMeters", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -2245,23 +3607,25 @@ func (m *QueryGasMetersRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthQuery
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Contract = string(dAtA[iNdEx:postIndex])
+			m.AllocationMeters = append(m.AllocationMeters, types.DecCoin{})
+			if err := m.AllocationMeters[len(m.AllocationMeters)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
@@ -2293,7 +3657,7 @@ func (m *QueryGasMetersRequest) Unmarshal(dAtA []byte) error {
 				return io.ErrUnexpectedEOF
 			}
 			if m.Pagination == nil {
-				m.Pagination = &query.PageRequest{}
+				m.Pagination = &query.PageResponse{}
 			}
 			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
@@ -2320,7 +3684,7 @@ func (m *QueryGasMetersRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryGasMetersResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryAllocationMeterRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2343,17 +3707,17 @@ func (m *QueryGasMetersResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryGasMetersResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryAllocationMeterRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryGasMetersResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryAllocationMeterRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field GasMeters", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Denom", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -2363,29 +3727,77 @@ func (m *QueryGasMetersResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthQuery
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.GasMeters = append(m.GasMeters, GasMeter{})
-			if err := m.GasMeters[len(m.GasMeters)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.Denom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
 				return err
 			}
-			iNdEx = postIndex
-		case 2:
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryAllocationMeterResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryAllocationMeterResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryAllocationMeterResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field AllocationMeter", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -2412,10 +3824,7 @@ func (m *QueryGasMetersResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Pagination == nil {
-				m.Pagination = &query.PageResponse{}
-			}
-			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.AllocationMeter.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -2440,7 +3849,7 @@ func (m *QueryGasMetersResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryGasMeterRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryPendingRewardsRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2463,45 +3872,13 @@ func (m *QueryGasMeterRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryGasMeterRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryPendingRewardsRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryGasMeterRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryPendingRewardsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Contract", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowQuery
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthQuery
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthQuery
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Contract = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 2:
 			if wireType != 2 {
 				return fmt.Errorf("proto: wrong wireType = %d for field Participant", wireType)
 			}
@@ -2554,7 +3931,7 @@ func (m *QueryGasMeterRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryGasMeterResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryPendingRewardsResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2577,17 +3954,17 @@ func (m *QueryGasMeterResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryGasMeterResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryPendingRewardsResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryGasMeterResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryPendingRewardsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field GasMeter", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Rewards", wireType)
 			}
-			m.GasMeter = 0
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -2597,11 +3974,26 @@ func (m *QueryGasMeterResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.GasMeter |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Rewards = append(m.Rewards, types.Coin{})
+			if err := m.Rewards[len(m.Rewards)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -2623,7 +4015,7 @@ func (m *QueryGasMeterResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryAllocationMetersRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryIncentivePayoutsRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2646,13 +4038,45 @@ func (m *QueryAllocationMetersRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryAllocationMetersRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryIncentivePayoutsRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryAllocationMetersRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryIncentivePayoutsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Contract", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Contract = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
 			if wireType != 2 {
 				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
 			}
@@ -2709,7 +4133,7 @@ func (m *QueryAllocationMetersRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryAllocationMetersResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryIncentivePayoutsResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2732,15 +4156,15 @@ func (m *QueryAllocationMetersResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryAllocationMetersResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryIncentivePayoutsResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryAllocationMetersResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryIncentivePayoutsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field AllocationMeters", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Payouts", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -2767,8 +4191,8 @@ func (m *QueryAllocationMetersResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.AllocationMeters = append(m.AllocationMeters, types.DecCoin{})
-			if err := m.AllocationMeters[len(m.AllocationMeters)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.Payouts = append(m.Payouts, IncentivePayout{})
+			if err := m.Payouts[len(m.Payouts)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -2829,7 +4253,7 @@ func (m *QueryAllocationMetersResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryAllocationMeterRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryParticipantPayoutsRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2852,15 +4276,15 @@ func (m *QueryAllocationMeterRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryAllocationMeterRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryParticipantPayoutsRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryAllocationMeterRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryParticipantPayoutsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Denom", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Participant", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -2888,7 +4312,75 @@ func (m *QueryAllocationMeterRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Denom = string(dAtA[iNdEx:postIndex])
+			m.Participant = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Contract", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Contract = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageRequest{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -2911,7 +4403,7 @@ func (m *QueryAllocationMeterRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryAllocationMeterResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryParticipantPayoutsResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2934,15 +4426,15 @@ func (m *QueryAllocationMeterResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryAllocationMeterResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryParticipantPayoutsResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryAllocationMeterResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryParticipantPayoutsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field AllocationMeter", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Payouts", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -2969,7 +4461,78 @@ func (m *QueryAllocationMeterResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.AllocationMeter.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.Payouts = append(m.Payouts, ParticipantPayout{})
+			if err := m.Payouts[len(m.Payouts)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageResponse{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pending", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Pending = append(m.Pending, ParticipantPayout{})
+			if err := m.Pending[len(m.Pending)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -0,0 +1,157 @@
+package types
+
+import (
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/ethereum/go-ethereum/common"
+	ethermint "github.com/evmos/ethermint/types"
+)
+
+// NewRewardPeriod returns an ongoing RewardPeriod of an incentive without any
+// participant
+func NewRewardPeriod(
+	contract common.Address,
+	period uint64,
+	weighting Weighting,
+) RewardPeriod {
+	return RewardPeriod{
+		Contract:     contract.String(),
+		Period:       period,
+		Weighting:    weighting,
+		TotalWeight:  sdk.ZeroDec(),
+		RewardScaler: sdk.ZeroDec(),
+	}
+}
+
+// Validate performs a stateless validation of a RewardPeriod
+func (rp RewardPeriod) Validate() error {
+	if err := ethermint.ValidateAddress(rp.Contract); err != nil {
+		return err
+	}
+
+	if err := rp.Weighting.Validate(); err != nil {
+		return err
+	}
+
+	if rp.TotalWeight.IsNil() || rp.TotalWeight.IsNegative() {
+		return fmt.Errorf("total weight must be non-negative: %s", rp.TotalWeight)
+	}
+
+	if rp.RewardScaler.IsNil() || rp.RewardScaler.IsNegative() {
+		return fmt.Errorf("reward scaler must be non-negative: %s", rp.RewardScaler)
+	}
+
+	if err := rp.RewardPerWeight.Validate(); err != nil {
+		return fmt.Errorf("invalid reward per weight: %w", err)
+	}
+
+	if err := rp.FundRewardPerWeight.Validate(); err != nil {
+		return fmt.Errorf("invalid fund reward per weight: %w", err)
+	}
+
+	if err := rp.FundRemaining.Validate(); err != nil {
+		return fmt.Errorf("invalid fund remaining: %w", err)
+	}
+
+	if !rp.Remaining.IsAllGTE(rp.FundRemaining) {
+		return fmt.Errorf("fund remaining %s exceeds remaining %s", rp.FundRemaining, rp.Remaining)
+	}
+
+	for _, fund := range rp.Funds {
+		if err := fund.Validate(); err != nil {
+			return fmt.Errorf("invalid fund: %w", err)
+		}
+	}
+
+	return rp.Remaining.Validate()
+}
+
+// Rewards returns the rewards of a participant that spent the given cumulative
+// gas during the period, i.e. the sum of its inflation and funded rewards
+func (rp RewardPeriod) Rewards(cumulativeGas uint64) sdk.Coins {
+	rewards, funded := rp.SplitRewards(cumulativeGas)
+	return rewards.Add(funded...)
+}
+
+// SplitRewards returns the inflation and the funded rewards of a participant
+// that spent the given cumulative gas during the period. The inflation rewards
+// of the capped denomination are limited by the gas spent and the rewards never
+// exceed the remaining reserved amount of the period.
+func (rp RewardPeriod) SplitRewards(cumulativeGas uint64) (rewards, funded sdk.Coins) {
+	weight := rp.Weighting.Weight(cumulativeGas)
+	rewards = sdk.Coins{}
+	funded = sdk.Coins{}
+
+	for _, reward := range rp.RewardPerWeight {
+		amount := reward.Amount.Mul(weight)
+
+		// cap the rewards by the gas spent to prevent participants from
+		// spending less on gas than they receive as rewards
+		if reward.Denom == rp.CappedDenom {
+			limit := sdk.NewDecFromInt(sdk.NewIntFromUint64(cumulativeGas)).Mul(rp.RewardScaler)
+			amount = sdk.MinDec(amount, limit)
+		}
+
+		rewards = rewards.Add(sdk.NewCoin(reward.Denom, amount.TruncateInt()))
+	}
+
+	for _, reward := range rp.FundRewardPerWeight {
+		funded = funded.Add(sdk.NewCoin(reward.Denom, reward.Amount.Mul(weight).TruncateInt()))
+	}
+
+	inflationRemaining, _ := rp.Remaining.SafeSub(rp.FundRemaining...)
+	return rewards.Min(inflationRemaining), funded.Min(rp.FundRemaining)
+}
+
+// FundRefunds splits the funded rewards of the period that were not settled
+// among its funders, in proportion to the coins reserved from each of them.
+// The amounts are truncated, so that the refunds never exceed the unsettled
+// funded rewards.
+func (rp RewardPeriod) FundRefunds() []Fund {
+	reserved := sdk.Coins{}
+	for _, fund := range rp.Funds {
+		reserved = reserved.Add(fund.Amount...)
+	}
+
+	refunds := []Fund{}
+	for _, fund := range rp.Funds {
+		refund := sdk.Coins{}
+		for _, coin := range rp.FundRemaining {
+			share := fund.Amount.AmountOf(coin.Denom)
+			if !share.IsPositive() {
+				continue
+			}
+
+			amount := coin.Amount.Mul(share).Quo(reserved.AmountOf(coin.Denom))
+			refund = refund.Add(sdk.NewCoin(coin.Denom, amount))
+		}
+
+		if !refund.IsZero() {
+			refunds = append(refunds, Fund{Contract: fund.Contract, Funder: fund.Funder, Amount: refund})
+		}
+	}
+
+	return refunds
+}
+
+// NewAccruedRewards returns an instance of AccruedRewards
+func NewAccruedRewards(participant common.Address, rewards sdk.Coins) AccruedRewards {
+	return AccruedRewards{
+		Participant: participant.String(),
+		Rewards:     rewards,
+	}
+}
+
+// Validate performs a stateless validation of AccruedRewards
+func (ar AccruedRewards) Validate() error {
+	if err := ethermint.ValidateAddress(ar.Participant); err != nil {
+		return err
+	}
+
+	if ar.Rewards.Empty() {
+		return fmt.Errorf("accrued rewards cannot be empty")
+	}
+
+	return ar.Rewards.Validate()
+}
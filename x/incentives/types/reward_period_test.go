@@ -0,0 +1,169 @@
+package types
+
+import (
+	"testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/suite"
+
+	"github.com/evmos/ethermint/tests"
+)
+
+type RewardPeriodTestSuite struct {
+	suite.Suite
+}
+
+func TestRewardPeriodSuite(t *testing.T) {
+	suite.Run(t, new(RewardPeriodTestSuite))
+}
+
+func (suite *RewardPeriodTestSuite) TestRewardPeriodValidate() {
+	invalidWeight := NewRewardPeriod(tests.GenerateAddress(), 0, DefaultWeighting())
+	invalidWeight.TotalWeight = sdk.NewDec(-1)
+
+	invalidRemaining := NewRewardPeriod(tests.GenerateAddress(), 0, DefaultWeighting())
+	invalidRemaining.Remaining = sdk.Coins{{Denom: "aevmos", Amount: sdk.NewInt(-1)}}
+
+	exceedingFundRemaining := NewRewardPeriod(tests.GenerateAddress(), 0, DefaultWeighting())
+	exceedingFundRemaining.Remaining = sdk.NewCoins(sdk.NewInt64Coin("afund", 10))
+	exceedingFundRemaining.FundRemaining = sdk.NewCoins(sdk.NewInt64Coin("afund", 11))
+
+	invalidFund := NewRewardPeriod(tests.GenerateAddress(), 0, DefaultWeighting())
+	invalidFund.Funds = []Fund{{Contract: invalidFund.Contract, Funder: "invalid"}}
+
+	testCases := []struct {
+		name         string
+		rewardPeriod RewardPeriod
+		expectPass   bool
+	}{
+		{"valid", NewRewardPeriod(tests.GenerateAddress(), 1, DefaultWeighting()), true},
+		{
+			"invalid contract",
+			RewardPeriod{Contract: "0xinvalidaddress", TotalWeight: sdk.ZeroDec(), RewardScaler: sdk.ZeroDec()},
+			false,
+		},
+		{
+			"invalid weighting",
+			NewRewardPeriod(tests.GenerateAddress(), 0, NewWeighting(WEIGHTING_STRATEGY_CAPPED_GAS, 0)),
+			false,
+		},
+		{"nil total weight", RewardPeriod{Contract: tests.GenerateAddress().String()}, false},
+		{"negative total weight", invalidWeight, false},
+		{"invalid remaining", invalidRemaining, false},
+		{"fund remaining exceeds remaining", exceedingFundRemaining, false},
+		{"invalid fund", invalidFund, false},
+	}
+
+	for _, tc := range testCases {
+		err := tc.rewardPeriod.Validate()
+
+		if tc.expectPass {
+			suite.Require().NoError(err, tc.name)
+		} else {
+			suite.Require().Error(err, tc.name)
+		}
+	}
+}
+
+func (suite *RewardPeriodTestSuite) TestRewardPeriodRewards() {
+	rp := NewRewardPeriod(tests.GenerateAddress(), 0, DefaultWeighting())
+	rp.TotalWeight = sdk.NewDec(400)
+	rp.RewardPerWeight = sdk.NewDecCoins(sdk.NewInt64DecCoin("aevmos", 2), sdk.NewInt64DecCoin("acoin", 1))
+	rp.FundRewardPerWeight = sdk.NewDecCoins(sdk.NewInt64DecCoin("afund", 3))
+	rp.CappedDenom = "aevmos"
+	rp.RewardScaler = sdk.NewDecWithPrec(15, 1)
+	rp.Remaining = sdk.NewCoins(
+		sdk.NewInt64Coin("aevmos", 800),
+		sdk.NewInt64Coin("acoin", 400),
+		sdk.NewInt64Coin("afund", 1000),
+	)
+	rp.FundRemaining = sdk.NewCoins(sdk.NewInt64Coin("afund", 1000))
+
+	testCases := []struct {
+		name       string
+		gas        uint64
+		expRewards sdk.Coins
+	}{
+		{"no gas", 0, sdk.Coins(nil)},
+		{
+			"capped denom limited by the gas spent",
+			100,
+			sdk.NewCoins(
+				sdk.NewInt64Coin("aevmos", 150),
+				sdk.NewInt64Coin("acoin", 100),
+				sdk.NewInt64Coin("afund", 300),
+			),
+		},
+		{
+			"limited by the remaining rewards",
+			400,
+			sdk.NewCoins(
+				sdk.NewInt64Coin("aevmos", 600),
+				sdk.NewInt64Coin("acoin", 400),
+				sdk.NewInt64Coin("afund", 1000),
+			),
+		},
+	}
+
+	for _, tc := range testCases {
+		suite.Require().Equal(tc.expRewards, rp.Rewards(tc.gas), tc.name)
+	}
+
+	// the funded rewards are split from the inflation rewards
+	rewards, funded := rp.SplitRewards(100)
+	suite.Require().Equal(sdk.NewCoins(sdk.NewInt64Coin("aevmos", 150), sdk.NewInt64Coin("acoin", 100)), rewards)
+	suite.Require().Equal(sdk.NewCoins(sdk.NewInt64Coin("afund", 300)), funded)
+}
+
+func (suite *RewardPeriodTestSuite) TestRewardPeriodFundRefunds() {
+	contract := tests.GenerateAddress()
+	funder := sdk.AccAddress(tests.GenerateAddress().Bytes())
+	funder2 := sdk.AccAddress(tests.GenerateAddress().Bytes())
+
+	rp := NewRewardPeriod(contract, 0, DefaultWeighting())
+	rp.Funds = []Fund{
+		NewFund(contract, funder, sdk.NewCoins(sdk.NewInt64Coin("afund", 300), sdk.NewInt64Coin("aother", 10))),
+		NewFund(contract, funder2, sdk.NewCoins(sdk.NewInt64Coin("afund", 100))),
+	}
+
+	// nothing is refunded once all the funded rewards are settled
+	suite.Require().Empty(rp.FundRefunds())
+
+	rp.FundRemaining = sdk.NewCoins(sdk.NewInt64Coin("afund", 10), sdk.NewInt64Coin("aother", 5))
+	suite.Require().Equal(
+		[]Fund{
+			NewFund(contract, funder, sdk.NewCoins(sdk.NewInt64Coin("afund", 7), sdk.NewInt64Coin("aother", 5))),
+			NewFund(contract, funder2, sdk.NewCoins(sdk.NewInt64Coin("afund", 2))),
+		},
+		rp.FundRefunds(),
+	)
+}
+
+func (suite *RewardPeriodTestSuite) TestAccruedRewardsValidate() {
+	rewards := sdk.NewCoins(sdk.NewInt64Coin("aevmos", 100))
+
+	testCases := []struct {
+		name       string
+		accrued    AccruedRewards
+		expectPass bool
+	}{
+		{"valid", NewAccruedRewards(tests.GenerateAddress(), rewards), true},
+		{"invalid participant", AccruedRewards{"0xinvalidaddress", rewards}, false},
+		{"empty rewards", NewAccruedRewards(tests.GenerateAddress(), sdk.Coins{}), false},
+		{
+			"invalid rewards",
+			NewAccruedRewards(tests.GenerateAddress(), sdk.Coins{{Denom: "aevmos", Amount: sdk.ZeroInt()}}),
+			false,
+		},
+	}
+
+	for _, tc := range testCases {
+		err := tc.accrued.Validate()
+
+		if tc.expectPass {
+			suite.Require().NoError(err, tc.name)
+		} else {
+			suite.Require().Error(err, tc.name)
+		}
+	}
+}
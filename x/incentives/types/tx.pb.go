@@ -0,0 +1,1058 @@
+// Code generated by protoc-gen-gogo. DO NOT EDIT.
+// source: evmos/incentives/v1/tx.proto
+
+package types
+
+import (
+	context "context"
+	fmt "fmt"
+	github_com_cosmos_cosmos_sdk_types "github.com/cosmos/cosmos-sdk/types"
+	types "github.com/cosmos/cosmos-sdk/types"
+	_ "github.com/cosmos/gogoproto/gogoproto"
+	grpc1 "github.com/gogo/protobuf/grpc"
+	proto "github.com/gogo/protobuf/proto"
+	_ "google.golang.org/genproto/googleapis/api/annotations"
+	grpc "google.golang.org/grpc"
+	codes "google.golang.org/grpc/codes"
+	status "google.golang.org/grpc/status"
+	io "io"
+	math "math"
+	math_bits "math/bits"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
+
+// MsgFundIncentive defines a Msg to escrow coins for an incentive
+type MsgFundIncentive struct {
+	// contract is the hex address of the incentivized smart contract
+	Contract string `protobuf:"bytes,1,opt,name=contract,proto3" json:"contract,omitempty"`
+	// amount of coins to escrow
+	Amount github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,2,rep,name=amount,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"amount"`
+	// funder is the cosmos bech32 address of the account that escrows the coins
+	Funder string `protobuf:"bytes,3,opt,name=funder,proto3" json:"funder,omitempty"`
+}
+
+func (m *MsgFundIncentive) Reset()         { *m = MsgFundIncentive{} }
+func (m *MsgFundIncentive) String() string { return proto.CompactTextString(m) }
+func (*MsgFundIncentive) ProtoMessage()    {}
+func (*MsgFundIncentive) Descriptor() ([]byte, []int) {
+	return fileDescriptor_8acb8c4fd5b75ea3, []int{0}
+}
+func (m *MsgFundIncentive) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgFundIncentive) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgFundIncentive.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgFundIncentive) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgFundIncentive.Merge(m, src)
+}
+func (m *MsgFundIncentive) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgFundIncentive) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgFundIncentive.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgFundIncentive proto.InternalMessageInfo
+
+func (m *MsgFundIncentive) GetContract() string {
+	if m != nil {
+		return m.Contract
+	}
+	return ""
+}
+
+func (m *MsgFundIncentive) GetAmount() github_com_cosmos_cosmos_sdk_types.Coins {
+	if m != nil {
+		return m.Amount
+	}
+	return nil
+}
+
+func (m *MsgFundIncentive) GetFunder() string {
+	if m != nil {
+		return m.Funder
+	}
+	return ""
+}
+
+// MsgFundIncentiveResponse returns no fields
+type MsgFundIncentiveResponse struct {
+}
+
+func (m *MsgFundIncentiveResponse) Reset()         { *m = MsgFundIncentiveResponse{} }
+func (m *MsgFundIncentiveResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgFundIncentiveResponse) ProtoMessage()    {}
+func (*MsgFundIncentiveResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_8acb8c4fd5b75ea3, []int{1}
+}
+func (m *MsgFundIncentiveResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgFundIncentiveResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgFundIncentiveResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgFundIncentiveResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgFundIncentiveResponse.Merge(m, src)
+}
+func (m *MsgFundIncentiveResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgFundIncentiveResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgFundIncentiveResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgFundIncentiveResponse proto.InternalMessageInfo
+
+// MsgClaimIncentiveRewards defines a Msg to claim the accrued incentive rewards
+// of a participant
+type MsgClaimIncentiveRewards struct {
+	// sender is the cosmos bech32 address of the participant
+	Sender string `protobuf:"bytes,1,opt,name=sender,proto3" json:"sender,omitempty"`
+}
+
+func (m *MsgClaimIncentiveRewards) Reset()         { *m = MsgClaimIncentiveRewards{} }
+func (m *MsgClaimIncentiveRewards) String() string { return proto.CompactTextString(m) }
+func (*MsgClaimIncentiveRewards) ProtoMessage()    {}
+func (*MsgClaimIncentiveRewards) Descriptor() ([]byte, []int) {
+	return fileDescriptor_8acb8c4fd5b75ea3, []int{2}
+}
+func (m *MsgClaimIncentiveRewards) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgClaimIncentiveRewards) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgClaimIncentiveRewards.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgClaimIncentiveRewards) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgClaimIncentiveRewards.Merge(m, src)
+}
+func (m *MsgClaimIncentiveRewards) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgClaimIncentiveRewards) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgClaimIncentiveRewards.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgClaimIncentiveRewards proto.InternalMessageInfo
+
+func (m *MsgClaimIncentiveRewards) GetSender() string {
+	if m != nil {
+		return m.Sender
+	}
+	return ""
+}
+
+// MsgClaimIncentiveRewardsResponse returns the claimed rewards
+type MsgClaimIncentiveRewardsResponse struct {
+	// rewards is the amount of claimed rewards
+	Rewards github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,1,rep,name=rewards,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"rewards"`
+}
+
+func (m *MsgClaimIncentiveRewardsResponse) Reset()         { *m = MsgClaimIncentiveRewardsResponse{} }
+func (m *MsgClaimIncentiveRewardsResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgClaimIncentiveRewardsResponse) ProtoMessage()    {}
+func (*MsgClaimIncentiveRewardsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_8acb8c4fd5b75ea3, []int{3}
+}
+func (m *MsgClaimIncentiveRewardsResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgClaimIncentiveRewardsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgClaimIncentiveRewardsResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgClaimIncentiveRewardsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgClaimIncentiveRewardsResponse.Merge(m, src)
+}
+func (m *MsgClaimIncentiveRewardsResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgClaimIncentiveRewardsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgClaimIncentiveRewardsResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgClaimIncentiveRewardsResponse proto.InternalMessageInfo
+
+func (m *MsgClaimIncentiveRewardsResponse) GetRewards() github_com_cosmos_cosmos_sdk_types.Coins {
+	if m != nil {
+		return m.Rewards
+	}
+	return nil
+}
+
+func init() {
+	proto.RegisterType((*MsgFundIncentive)(nil), "evmos.incentives.v1.MsgFundIncentive")
+	proto.RegisterType((*MsgFundIncentiveResponse)(nil), "evmos.incentives.v1.MsgFundIncentiveResponse")
+	proto.RegisterType((*MsgClaimIncentiveRewards)(nil), "evmos.incentives.v1.MsgClaimIncentiveRewards")
+	proto.RegisterType((*MsgClaimIncentiveRewardsResponse)(nil), "evmos.incentives.v1.MsgClaimIncentiveRewardsResponse")
+}
+
+func init() { proto.RegisterFile("evmos/incentives/v1/tx.proto", fileDescriptor_8acb8c4fd5b75ea3) }
+
+var fileDescriptor_8acb8c4fd5b75ea3 = []byte{
+	// 442 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xac, 0x53, 0x4f, 0x8b, 0xd4, 0x30,
+	0x14, 0x9f, 0xcc, 0xc0, 0xa8, 0x11, 0x41, 0xea, 0x1f, 0x6a, 0x59, 0xba, 0x43, 0x41, 0x99, 0xcb,
+	0x24, 0xdb, 0x11, 0xf1, 0xbe, 0x0b, 0x8a, 0x87, 0xbd, 0xcc, 0xd1, 0xcb, 0x92, 0xa6, 0xb1, 0x06,
+	0xb7, 0x79, 0xa5, 0x49, 0xeb, 0x7a, 0xf5, 0xe6, 0x4d, 0x10, 0xbf, 0x84, 0xde, 0xfd, 0x0c, 0x7b,
+	0x5c, 0x10, 0xc4, 0x93, 0xca, 0x8c, 0x1f, 0x44, 0x9a, 0x74, 0xba, 0xe3, 0xd2, 0x01, 0x85, 0xbd,
+	0xb4, 0x79, 0xff, 0x7e, 0xef, 0xf7, 0x7e, 0x2f, 0xc1, 0x3b, 0xa2, 0xce, 0x41, 0x53, 0xa9, 0xb8,
+	0x50, 0x46, 0xd6, 0x42, 0xd3, 0x3a, 0xa6, 0xe6, 0x84, 0x14, 0x25, 0x18, 0xf0, 0x6e, 0xd9, 0x28,
+	0x39, 0x8f, 0x92, 0x3a, 0x0e, 0x42, 0x0e, 0xba, 0xa9, 0x49, 0x98, 0x16, 0xb4, 0x8e, 0x13, 0x61,
+	0x58, 0x4c, 0x39, 0x48, 0xe5, 0x8a, 0x82, 0xdb, 0x19, 0x64, 0x60, 0x8f, 0xb4, 0x39, 0xb5, 0xde,
+	0x9d, 0x0c, 0x20, 0x3b, 0x16, 0x94, 0x15, 0x92, 0x32, 0xa5, 0xc0, 0x30, 0x23, 0x41, 0x69, 0x17,
+	0x8d, 0x3e, 0x23, 0x7c, 0xf3, 0x50, 0x67, 0x4f, 0x2a, 0x95, 0x3e, 0x5b, 0x37, 0xf3, 0x02, 0x7c,
+	0x95, 0x83, 0x32, 0x25, 0xe3, 0xc6, 0x47, 0x13, 0x34, 0xbd, 0xb6, 0xe8, 0x6c, 0x8f, 0xe3, 0x31,
+	0xcb, 0xa1, 0x52, 0xc6, 0x1f, 0x4e, 0x46, 0xd3, 0xeb, 0xf3, 0x7b, 0xc4, 0xb1, 0x22, 0x0d, 0x2b,
+	0xd2, 0xb2, 0x22, 0x07, 0x20, 0xd5, 0xfe, 0xde, 0xe9, 0x8f, 0xdd, 0xc1, 0xa7, 0x9f, 0xbb, 0xd3,
+	0x4c, 0x9a, 0x97, 0x55, 0x42, 0x38, 0xe4, 0xb4, 0x1d, 0xc1, 0xfd, 0x66, 0x3a, 0x7d, 0x45, 0xcd,
+	0x9b, 0x42, 0x68, 0x5b, 0xa0, 0x17, 0x2d, 0xb4, 0x77, 0x17, 0x8f, 0x5f, 0x54, 0x2a, 0x15, 0xa5,
+	0x3f, 0xb2, 0xed, 0x5b, 0x2b, 0x0a, 0xb0, 0x7f, 0x91, 0xec, 0x42, 0xe8, 0x02, 0x94, 0x16, 0xd1,
+	0xdc, 0xc6, 0x0e, 0x8e, 0x99, 0xcc, 0x37, 0x82, 0xaf, 0x59, 0x99, 0xea, 0x06, 0x4f, 0x0b, 0x8b,
+	0xe7, 0xc6, 0x69, 0xad, 0xe8, 0x1d, 0xc2, 0x93, 0x6d, 0x45, 0x6b, 0x60, 0x4f, 0xe0, 0x2b, 0xa5,
+	0x73, 0xf9, 0xe8, 0xf2, 0x47, 0x5e, 0x63, 0xcf, 0xbf, 0x0d, 0xf1, 0xe8, 0x50, 0x67, 0xde, 0x47,
+	0x84, 0x6f, 0xfc, 0xbd, 0x8e, 0xfb, 0xa4, 0xe7, 0x36, 0x90, 0x8b, 0x42, 0x04, 0xb3, 0x7f, 0x4a,
+	0xeb, 0xf4, 0x22, 0x6f, 0xbf, 0xfe, 0xfe, 0x30, 0x9c, 0x46, 0x0f, 0x68, 0xff, 0x4d, 0xa4, 0x8d,
+	0xe6, 0x47, 0x9d, 0xd7, 0xfb, 0x82, 0xf0, 0x9d, 0x7e, 0x75, 0xb7, 0x36, 0xee, 0x4d, 0x0f, 0x1e,
+	0xfd, 0x57, 0x7a, 0xc7, 0xf7, 0xb1, 0xe5, 0x1b, 0x47, 0x74, 0x1b, 0x5f, 0xde, 0x94, 0x9f, 0x13,
+	0x3e, 0x6a, 0x85, 0xdd, 0x7f, 0x7a, 0xba, 0x0c, 0xd1, 0xd9, 0x32, 0x44, 0xbf, 0x96, 0x21, 0x7a,
+	0xbf, 0x0a, 0x07, 0x67, 0xab, 0x70, 0xf0, 0x7d, 0x15, 0x0e, 0x9e, 0xcf, 0x36, 0xb6, 0xe4, 0x40,
+	0xdd, 0xb7, 0x8e, 0xf7, 0xe8, 0xc9, 0x66, 0x03, 0xbb, 0xb0, 0x64, 0x6c, 0x9f, 0xcc, 0xc3, 0x3f,
+	0x03, 0x00, 0xec, 0x34, 0x56, 0x90, 0xbb, 0x03, 0x00, 0x00,
+}
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ context.Context
+var _ grpc.ClientConn
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+const _ = grpc.SupportPackageIsVersion4
+
+// MsgClient is the client API for Msg service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
+type MsgClient interface {
+	// FundIncentive escrows coins of the sender to reward the participants of a
+	// registered incentive during its remaining epochs.
+	FundIncentive(ctx context.Context, in *MsgFundIncentive, opts ...grpc.CallOption) (*MsgFundIncentiveResponse, error)
+	// ClaimIncentiveRewards transfers the accrued incentive rewards of the sender
+	// to its account.
+	ClaimIncentiveRewards(ctx context.Context, in *MsgClaimIncentiveRewards, opts ...grpc.CallOption) (*MsgClaimIncentiveRewardsResponse, error)
+}
+
+type msgClient struct {
+	cc grpc1.ClientConn
+}
+
+func NewMsgClient(cc grpc1.ClientConn) MsgClient {
+	return &msgClient{cc}
+}
+
+func (c *msgClient) FundIncentive(ctx context.Context, in *MsgFundIncentive, opts ...grpc.CallOption) (*MsgFundIncentiveResponse, error) {
+	out := new(MsgFundIncentiveResponse)
+	err := c.cc.Invoke(ctx, "/evmos.incentives.v1.Msg/FundIncentive", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) ClaimIncentiveRewards(ctx context.Context, in *MsgClaimIncentiveRewards, opts ...grpc.CallOption) (*MsgClaimIncentiveRewardsResponse, error) {
+	out := new(MsgClaimIncentiveRewardsResponse)
+	err := c.cc.Invoke(ctx, "/evmos.incentives.v1.Msg/ClaimIncentiveRewards", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// MsgServer is the server API for Msg service.
+type MsgServer interface {
+	// FundIncentive escrows coins of the sender to reward the participants of a
+	// registered incentive during its remaining epochs.
+	FundIncentive(context.Context, *MsgFundIncentive) (*MsgFundIncentiveResponse, error)
+	// ClaimIncentiveRewards transfers the accrued incentive rewards of the sender
+	// to its account.
+	ClaimIncentiveRewards(context.Context, *MsgClaimIncentiveRewards) (*MsgClaimIncentiveRewardsResponse, error)
+}
+
+// UnimplementedMsgServer can be embedded to have forward compatible implementations.
+type UnimplementedMsgServer struct {
+}
+
+func (*UnimplementedMsgServer) FundIncentive(ctx context.Context, req *MsgFundIncentive) (*MsgFundIncentiveResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method FundIncentive not implemented")
+}
+func (*UnimplementedMsgServer) ClaimIncentiveRewards(ctx context.Context, req *MsgClaimIncentiveRewards) (*MsgClaimIncentiveRewardsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ClaimIncentiveRewards not implemented")
+}
+
+func RegisterMsgServer(s grpc1.Server, srv MsgServer) {
+	s.RegisterService(&_Msg_serviceDesc, srv)
+}
+
+func _Msg_FundIncentive_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgFundIncentive)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).FundIncentive(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/evmos.incentives.v1.Msg/FundIncentive",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).FundIncentive(ctx, req.(*MsgFundIncentive))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_ClaimIncentiveRewards_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgClaimIncentiveRewards)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).ClaimIncentiveRewards(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/evmos.incentives.v1.Msg/ClaimIncentiveRewards",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).ClaimIncentiveRewards(ctx, req.(*MsgClaimIncentiveRewards))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+var _Msg_serviceDesc = grpc.ServiceDesc{
+	ServiceName: "evmos.incentives.v1.Msg",
+	HandlerType: (*MsgServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "FundIncentive",
+			Handler:    _Msg_FundIncentive_Handler,
+		},
+		{
+			MethodName: "ClaimIncentiveRewards",
+			Handler:    _Msg_ClaimIncentiveRewards_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "evmos/incentives/v1/tx.proto",
+}
+
+func (m *MsgFundIncentive) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgFundIncentive) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgFundIncentive) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Funder) > 0 {
+		i -= len(m.Funder)
+		copy(dAtA[i:], m.Funder)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Funder)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Amount) > 0 {
+		for iNdEx := len(m.Amount) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Amount[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintTx(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if len(m.Contract) > 0 {
+		i -= len(m.Contract)
+		copy(dAtA[i:], m.Contract)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Contract)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgFundIncentiveResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgFundIncentiveResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgFundIncentiveResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgClaimIncentiveRewards) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgClaimIncentiveRewards) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgClaimIncentiveRewards) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Sender) > 0 {
+		i -= len(m.Sender)
+		copy(dAtA[i:], m.Sender)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Sender)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgClaimIncentiveRewardsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgClaimIncentiveRewardsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgClaimIncentiveRewardsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Rewards) > 0 {
+		for iNdEx := len(m.Rewards) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Rewards[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintTx(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintTx(dAtA []byte, offset int, v uint64) int {
+	offset -= sovTx(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *MsgFundIncentive) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Contract)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if len(m.Amount) > 0 {
+		for _, e := range m.Amount {
+			l = e.Size()
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
+	l = len(m.Funder)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgFundIncentiveResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgClaimIncentiveRewards) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Sender)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgClaimIncentiveRewardsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Rewards) > 0 {
+		for _, e := range m.Rewards {
+			l = e.Size()
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
+	return n
+}
+
+func sovTx(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozTx(x uint64) (n int) {
+	return sovTx(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *MsgFundIncentive) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgFundIncentive: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgFundIncentive: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Contract", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Contract = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Amount = append(m.Amount, types.Coin{})
+			if err := m.Amount[len(m.Amount)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Funder", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Funder = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgFundIncentiveResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgFundIncentiveResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgFundIncentiveResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgClaimIncentiveRewards) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgClaimIncentiveRewards: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgClaimIncentiveRewards: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Sender", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Sender = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgClaimIncentiveRewardsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgClaimIncentiveRewardsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgClaimIncentiveRewardsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Rewards", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Rewards = append(m.Rewards, types.Coin{})
+			if err := m.Rewards[len(m.Rewards)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func skipTx(dAtA []byte) (n int, err error) {
+	l := len(dAtA)
+	iNdEx := 0
+	depth := 0
+	for iNdEx < l {
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return 0, ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return 0, io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		wireType := int(wire & 0x7)
+		switch wireType {
+		case 0:
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				iNdEx++
+				if dAtA[iNdEx-1] < 0x80 {
+					break
+				}
+			}
+		case 1:
+			iNdEx += 8
+		case 2:
+			var length int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				length |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if length < 0 {
+				return 0, ErrInvalidLengthTx
+			}
+			iNdEx += length
+		case 3:
+			depth++
+		case 4:
+			if depth == 0 {
+				return 0, ErrUnexpectedEndOfGroupTx
+			}
+			depth--
+		case 5:
+			iNdEx += 4
+		default:
+			return 0, fmt.Errorf("proto: illegal wireType %d", wireType)
+		}
+		if iNdEx < 0 {
+			return 0, ErrInvalidLengthTx
+		}
+		if depth == 0 {
+			return iNdEx, nil
+		}
+	}
+	return 0, io.ErrUnexpectedEOF
+}
+
+var (
+	ErrInvalidLengthTx        = fmt.Errorf("proto: negative length found during unmarshaling")
+	ErrIntOverflowTx          = fmt.Errorf("proto: integer overflow")
+	ErrUnexpectedEndOfGroupTx = fmt.Errorf("proto: unexpected end of group")
+)
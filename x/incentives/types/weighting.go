@@ -0,0 +1,71 @@
+package types
+
+import (
+	"fmt"
+	"math/big"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// NewWeighting returns an instance of Weighting
+func NewWeighting(strategy WeightingStrategy, gasCap uint64) Weighting {
+	return Weighting{
+		Strategy: strategy,
+		GasCap:   gasCap,
+	}
+}
+
+// DefaultWeighting returns the gas weighting strategy
+func DefaultWeighting() Weighting {
+	return NewWeighting(WEIGHTING_STRATEGY_GAS, 0)
+}
+
+// Validate performs a stateless validation of a Weighting
+func (w Weighting) Validate() error {
+	if _, ok := WeightingStrategy_name[int32(w.Strategy)]; !ok {
+		return fmt.Errorf("invalid weighting strategy: %s", w.Strategy)
+	}
+
+	if w.Strategy == WEIGHTING_STRATEGY_CAPPED_GAS {
+		if w.GasCap == 0 {
+			return fmt.Errorf("gas cap cannot be 0 for the %s strategy", w.Strategy)
+		}
+		return nil
+	}
+
+	if w.GasCap != 0 {
+		return fmt.Errorf("gas cap can only be set for the %s strategy", WEIGHTING_STRATEGY_CAPPED_GAS)
+	}
+	return nil
+}
+
+// IsGas returns true if the participants are weighted by the gas spent, which
+// is the case of an unspecified strategy
+func (w Weighting) IsGas() bool {
+	return w.Strategy == WEIGHTING_STRATEGY_UNSPECIFIED || w.Strategy == WEIGHTING_STRATEGY_GAS
+}
+
+// Weight returns the weight of a participant that spent the given cumulative
+// gas on the incentivized contract during the epoch
+func (w Weighting) Weight(cumulativeGas uint64) sdk.Dec {
+	if cumulativeGas == 0 {
+		return sdk.ZeroDec()
+	}
+
+	gas := new(big.Int).SetUint64(cumulativeGas)
+
+	switch w.Strategy {
+	case WEIGHTING_STRATEGY_UNIQUE_PARTICIPANTS:
+		return sdk.OneDec()
+	case WEIGHTING_STRATEGY_CAPPED_GAS:
+		if cumulativeGas > w.GasCap {
+			gas.SetUint64(w.GasCap)
+		}
+		return sdk.NewDecFromBigInt(gas)
+	case WEIGHTING_STRATEGY_SQRT_GAS:
+		// use the integer square root to keep the weights deterministic
+		return sdk.NewDecFromBigInt(gas.Sqrt(gas))
+	default:
+		return sdk.NewDecFromBigInt(gas)
+	}
+}
@@ -0,0 +1,65 @@
+package types
+
+import (
+	"testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/suite"
+)
+
+type WeightingTestSuite struct {
+	suite.Suite
+}
+
+func TestWeightingSuite(t *testing.T) {
+	suite.Run(t, new(WeightingTestSuite))
+}
+
+func (suite *WeightingTestSuite) TestWeightingValidate() {
+	testCases := []struct {
+		name       string
+		weighting  Weighting
+		expectPass bool
+	}{
+		{"unspecified", Weighting{}, true},
+		{"gas", DefaultWeighting(), true},
+		{"unique participants", NewWeighting(WEIGHTING_STRATEGY_UNIQUE_PARTICIPANTS, 0), true},
+		{"capped gas", NewWeighting(WEIGHTING_STRATEGY_CAPPED_GAS, 1000), true},
+		{"sqrt gas", NewWeighting(WEIGHTING_STRATEGY_SQRT_GAS, 0), true},
+		{"invalid strategy", NewWeighting(WeightingStrategy(10), 0), false},
+		{"capped gas without cap", NewWeighting(WEIGHTING_STRATEGY_CAPPED_GAS, 0), false},
+		{"gas cap for another strategy", NewWeighting(WEIGHTING_STRATEGY_SQRT_GAS, 1000), false},
+	}
+
+	for _, tc := range testCases {
+		err := tc.weighting.Validate()
+
+		if tc.expectPass {
+			suite.Require().NoError(err, tc.name)
+		} else {
+			suite.Require().Error(err, tc.name)
+		}
+	}
+}
+
+func (suite *WeightingTestSuite) TestWeightingWeight() {
+	testCases := []struct {
+		name      string
+		weighting Weighting
+		gas       uint64
+		expWeight sdk.Dec
+	}{
+		{"zero gas", DefaultWeighting(), 0, sdk.ZeroDec()},
+		{"unspecified", Weighting{}, 900, sdk.NewDec(900)},
+		{"gas", DefaultWeighting(), 900, sdk.NewDec(900)},
+		{"unique participants", NewWeighting(WEIGHTING_STRATEGY_UNIQUE_PARTICIPANTS, 0), 900, sdk.OneDec()},
+		{"capped gas - below cap", NewWeighting(WEIGHTING_STRATEGY_CAPPED_GAS, 1000), 900, sdk.NewDec(900)},
+		{"capped gas - above cap", NewWeighting(WEIGHTING_STRATEGY_CAPPED_GAS, 500), 900, sdk.NewDec(500)},
+		{"sqrt gas", NewWeighting(WEIGHTING_STRATEGY_SQRT_GAS, 0), 900, sdk.NewDec(30)},
+		{"sqrt gas - rounded down", NewWeighting(WEIGHTING_STRATEGY_SQRT_GAS, 0), 99, sdk.NewDec(9)},
+	}
+
+	for _, tc := range testCases {
+		suite.Require().Equal(tc.expWeight, tc.weighting.Weight(tc.gas), tc.name)
+	}
+}
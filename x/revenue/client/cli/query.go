@@ -29,6 +29,9 @@ func GetQueryCmd() *cobra.Command {
 		GetCmdQueryParams(),
 		GetCmdQueryDeployerRevenues(),
 		GetCmdQueryWithdrawerRevenues(),
+		GetCmdQueryDerivedContracts(),
+		GetCmdQueryAccruedRevenues(),
+		GetCmdQueryWithdrawerAccruedRevenues(),
 	)
 
 	return feesQueryCmd
@@ -209,3 +212,117 @@ func GetCmdQueryWithdrawerRevenues() *cobra.Command {
 	flags.AddQueryFlagsToCmd(cmd)
 	return cmd
 }
+
+// GetCmdQueryDerivedContracts implements a command that returns all contracts
+// that inherit the revenue of a given factory
+func GetCmdQueryDerivedContracts() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "derived-contracts FACTORY_ADDRESS",
+		Args:    cobra.ExactArgs(1),
+		Short:   "Query all contracts derived from a factory registered for fee distribution",
+		Long:    "Query all contracts created directly or indirectly by a factory registered for fee distribution, which inherit its revenue",
+		Example: fmt.Sprintf("%s query revenue derived-contracts <factory-address>", version.AppName),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+
+			pageReq, err := client.ReadPageRequest(cmd.Flags())
+			if err != nil {
+				return err
+			}
+
+			// Query store
+			res, err := queryClient.DerivedContracts(context.Background(), &types.QueryDerivedContractsRequest{
+				FactoryAddress: args[0],
+				Pagination:     pageReq,
+			})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+	flags.AddQueryFlagsToCmd(cmd)
+	return cmd
+}
+
+// GetCmdQueryAccruedRevenues implements a command that returns the revenues
+// accrued from a given contract that have not been withdrawn yet
+func GetCmdQueryAccruedRevenues() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "accrued-revenues CONTRACT_ADDRESS",
+		Args:    cobra.ExactArgs(1),
+		Short:   "Query the revenues accrued from a contract that have not been withdrawn yet",
+		Long:    "Query the revenues accrued from a contract registered for fee distribution, per withdrawer, that have not been withdrawn yet",
+		Example: fmt.Sprintf("%s query revenue accrued-revenues <contract-address>", version.AppName),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+
+			pageReq, err := client.ReadPageRequest(cmd.Flags())
+			if err != nil {
+				return err
+			}
+
+			// Query store
+			res, err := queryClient.AccruedRevenues(context.Background(), &types.QueryAccruedRevenuesRequest{
+				ContractAddress: args[0],
+				Pagination:      pageReq,
+			})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+	flags.AddQueryFlagsToCmd(cmd)
+	return cmd
+}
+
+// GetCmdQueryWithdrawerAccruedRevenues implements a command that returns the
+// revenues accrued for a given withdrawer that have not been withdrawn yet
+func GetCmdQueryWithdrawerAccruedRevenues() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "withdrawer-accrued-revenues WITHDRAWER_ADDRESS",
+		Args:    cobra.ExactArgs(1),
+		Short:   "Query the revenues accrued for a withdrawer that have not been withdrawn yet",
+		Long:    "Query the revenues accrued for a withdrawer, per contract, that have not been withdrawn yet",
+		Example: fmt.Sprintf("%s query revenue withdrawer-accrued-revenues <withdrawer-address>", version.AppName),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+
+			pageReq, err := client.ReadPageRequest(cmd.Flags())
+			if err != nil {
+				return err
+			}
+
+			// Query store
+			res, err := queryClient.WithdrawerAccruedRevenues(context.Background(), &types.QueryWithdrawerAccruedRevenuesRequest{
+				WithdrawerAddress: args[0],
+				Pagination:        pageReq,
+			})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+	flags.AddQueryFlagsToCmd(cmd)
+	return cmd
+}
@@ -3,6 +3,9 @@ package cli
 import (
 	"encoding/json"
 	"fmt"
+	"strings"
+
+	"github.com/ethereum/go-ethereum/common"
 
 	"github.com/spf13/cobra"
 
@@ -16,6 +19,15 @@ import (
 	"github.com/evmos/evmos/v10/x/revenue/types"
 )
 
+// flags for the revenue transaction commands
+const (
+	// FlagFactory defines the flag to register a contract as a factory
+	FlagFactory = "factory"
+	// FlagWithdrawers defines the flag to set the weighted withdrawers of a
+	// contract
+	FlagWithdrawers = "withdrawers"
+)
+
 // NewTxCmd returns a root CLI command handler for certain modules/revenue
 // transaction commands.
 func NewTxCmd() *cobra.Command {
@@ -31,6 +43,8 @@ func NewTxCmd() *cobra.Command {
 		NewRegisterRevenue(),
 		NewCancelRevenue(),
 		NewUpdateRevenue(),
+		NewRegisterDerivedContract(),
+		NewWithdrawRevenue(),
 	)
 	return txCmd
 }
@@ -41,7 +55,7 @@ func NewRegisterRevenue() *cobra.Command {
 	cmd := &cobra.Command{
 		Use:   "register CONTRACT_HEX NONCE... [WITHDRAWER_BECH32]",
 		Short: "Register a contract for fee distribution. **NOTE** Please ensure, that the deployer of the contract (or the factory that deployes the contract) is an account that is owned by your project, to avoid that an individual deployer who leaves your project becomes malicious.",
-		Long:  "Register a contract for fee distribution.\nOnly the contract deployer can register a contract.\nProvide the account nonce(s) used to derive the contract address. E.g.: you have an account nonce of 4 when you send a deployment transaction for a contract A; you use this contract as a factory, to create another contract B. If you register A, the nonces value is \"4\". If you register B, the nonces value is \"4,1\" (B is the first contract created by A). \nThe withdrawer address defaults to the deployer address if not provided.",
+		Long:  "Register a contract for fee distribution.\nOnly the contract deployer can register a contract.\nProvide the account nonce(s) used to derive the contract address. E.g.: you have an account nonce of 4 when you send a deployment transaction for a contract A; you use this contract as a factory, to create another contract B. If you register A, the nonces value is \"4\". If you register B, the nonces value is \"4,1\" (B is the first contract created by A). \nThe withdrawer address defaults to the deployer address if not provided.\nWith the --factory flag, all contracts created directly or indirectly by the registered contract inherit its revenue.",
 		Args:  cobra.RangeArgs(2, 3),
 		RunE: func(cmd *cobra.Command, args []string) error {
 			cliCtx, err := client.GetClientTxContext(cmd)
@@ -82,6 +96,16 @@ func NewRegisterRevenue() *cobra.Command {
 				Nonces:            nonces,
 			}
 
+			msg.Factory, err = cmd.Flags().GetBool(FlagFactory)
+			if err != nil {
+				return err
+			}
+
+			msg.Withdrawers, err = readWithdrawers(cmd)
+			if err != nil {
+				return err
+			}
+
 			if err := msg.ValidateBasic(); err != nil {
 				return err
 			}
@@ -90,6 +114,8 @@ func NewRegisterRevenue() *cobra.Command {
 		},
 	}
 
+	cmd.Flags().Bool(FlagFactory, false, "Register the contract as a factory whose created contracts inherit its revenue")
+	cmd.Flags().String(FlagWithdrawers, "", "Weighted withdrawers receiving the fees, e.g. \"evmos1...:0.6,evmos1...:0.4\". The weights must sum up to 1")
 	flags.AddTxFlagsToCmd(cmd)
 	return cmd
 }
@@ -136,10 +162,10 @@ func NewCancelRevenue() *cobra.Command {
 // address of a contract for fee distribution
 func NewUpdateRevenue() *cobra.Command {
 	cmd := &cobra.Command{
-		Use:   "update CONTRACT_HEX WITHDRAWER_BECH32",
+		Use:   "update CONTRACT_HEX [WITHDRAWER_BECH32]",
 		Short: "Update withdrawer address for a contract registered for fee distribution.",
-		Long:  "Update withdrawer address for a contract registered for fee distribution. \nOnly the contract deployer can update the withdrawer address.",
-		Args:  cobra.ExactArgs(2),
+		Long:  "Update withdrawer address for a contract registered for fee distribution. \nOnly the contract deployer can update the withdrawer address.\nUse the --withdrawers flag instead of the withdrawer address to split the fees among weighted withdrawers.",
+		Args:  cobra.RangeArgs(1, 2),
 		RunE: func(cmd *cobra.Command, args []string) error {
 			cliCtx, err := client.GetClientTxContext(cmd)
 			if err != nil {
@@ -153,15 +179,143 @@ func NewUpdateRevenue() *cobra.Command {
 				return fmt.Errorf("invalid contract hex address %w", err)
 			}
 
-			withdrawer := args[1]
-			if _, err := sdk.AccAddressFromBech32(withdrawer); err != nil {
-				return fmt.Errorf("invalid withdrawer bech32 address %w", err)
+			var withdrawer string
+			if len(args) == 2 {
+				withdrawer = args[1]
+				if _, err := sdk.AccAddressFromBech32(withdrawer); err != nil {
+					return fmt.Errorf("invalid withdrawer bech32 address %w", err)
+				}
+			}
+
+			withdrawers, err := readWithdrawers(cmd)
+			if err != nil {
+				return err
 			}
 
 			msg := &types.MsgUpdateRevenue{
 				ContractAddress:   contract,
 				DeployerAddress:   deployer.String(),
 				WithdrawerAddress: withdrawer,
+				Withdrawers:       withdrawers,
+			}
+
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(cliCtx, cmd.Flags(), msg)
+		},
+	}
+
+	cmd.Flags().String(FlagWithdrawers, "", "Weighted withdrawers receiving the fees, e.g. \"evmos1...:0.6,evmos1...:0.4\". The weights must sum up to 1")
+	flags.AddTxFlagsToCmd(cmd)
+	return cmd
+}
+
+// NewRegisterDerivedContract returns a CLI command handler for registering a
+// contract created with CREATE2 by a registered factory
+func NewRegisterDerivedContract() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "register-derived CONTRACT_HEX CREATOR_HEX SALT_HEX INIT_CODE_HASH_HEX",
+		Short: "Register a contract created with CREATE2 by a factory registered for fee distribution.",
+		Long:  "Register a contract created with CREATE2 by a factory registered for fee distribution, or by one of its derived contracts, so that it inherits the revenue of the factory.\nContracts created with CREATE are registered automatically.\nAny account can register a derived contract by providing the salt and the keccak256 hash of the creation code used for the deployment.",
+		Args:  cobra.ExactArgs(4),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cliCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			sender := cliCtx.GetFromAddress()
+
+			contract := args[0]
+			if err := ethermint.ValidateNonZeroAddress(contract); err != nil {
+				return fmt.Errorf("invalid contract hex address %w", err)
+			}
+
+			creator := args[1]
+			if err := ethermint.ValidateNonZeroAddress(creator); err != nil {
+				return fmt.Errorf("invalid creator hex address %w", err)
+			}
+
+			msg := types.NewMsgRegisterDerivedContract(
+				common.HexToAddress(contract),
+				common.HexToAddress(creator),
+				sender,
+				common.HexToHash(args[2]),
+				common.HexToHash(args[3]),
+			)
+
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(cliCtx, cmd.Flags(), msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+	return cmd
+}
+
+// readWithdrawers parses the weighted withdrawers of the withdrawers flag in
+// the "address:weight,address:weight" format
+func readWithdrawers(cmd *cobra.Command) ([]types.Withdrawer, error) {
+	withdrawersStr, err := cmd.Flags().GetString(FlagWithdrawers)
+	if err != nil || withdrawersStr == "" {
+		return nil, err
+	}
+
+	var withdrawers []types.Withdrawer
+	for _, withdrawerStr := range strings.Split(withdrawersStr, ",") {
+		parts := strings.Split(strings.TrimSpace(withdrawerStr), ":")
+		if len(parts) != 2 {
+			return nil, fmt.Errorf("invalid withdrawer %s, expected address:weight", withdrawerStr)
+		}
+
+		address, err := sdk.AccAddressFromBech32(parts[0])
+		if err != nil {
+			return nil, fmt.Errorf("invalid withdrawer bech32 address %w", err)
+		}
+
+		weight, err := sdk.NewDecFromStr(parts[1])
+		if err != nil {
+			return nil, fmt.Errorf("invalid withdrawer weight %w", err)
+		}
+
+		withdrawers = append(withdrawers, types.NewWithdrawer(address, weight))
+	}
+
+	return withdrawers, nil
+}
+
+// NewWithdrawRevenue returns a CLI command handler for withdrawing the
+// revenue accrued for the sender
+func NewWithdrawRevenue() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "withdraw [CONTRACT_HEX]",
+		Short: "Withdraw the revenue accrued for the sender",
+		Long:  "Withdraw the revenue accrued for the sender from a given contract. If the contract is not provided, the revenue accrued from all contracts is withdrawn.",
+		Args:  cobra.MaximumNArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cliCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			withdrawer := cliCtx.GetFromAddress()
+
+			var contract string
+			if len(args) == 1 {
+				contract = args[0]
+				if err := ethermint.ValidateNonZeroAddress(contract); err != nil {
+					return fmt.Errorf("invalid contract hex address %w", err)
+				}
+			}
+
+			msg := &types.MsgWithdrawRevenue{
+				WithdrawerAddress: withdrawer.String(),
+				ContractAddress:   contract,
 			}
 
 			if err := msg.ValidateBasic(); err != nil {
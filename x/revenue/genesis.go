@@ -2,6 +2,7 @@ package revenue
 
 import (
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	authkeeper "github.com/cosmos/cosmos-sdk/x/auth/keeper"
 
 	"github.com/evmos/evmos/v10/x/revenue/keeper"
 	"github.com/evmos/evmos/v10/x/revenue/types"
@@ -11,29 +12,45 @@ import (
 func InitGenesis(
 	ctx sdk.Context,
 	k keeper.Keeper,
+	accountKeeper authkeeper.AccountKeeper,
 	data types.GenesisState,
 ) {
 	k.SetParams(ctx, data.Params)
 
+	// Ensure revenue module account is set on genesis
+	if acc := accountKeeper.GetModuleAccount(ctx, types.ModuleName); acc == nil {
+		panic("the revenue module account has not been set")
+	}
+
 	for _, revenue := range data.Revenues {
 		contract := revenue.GetContractAddr()
 		deployer := revenue.GetDeployerAddr()
-		withdrawer := revenue.GetWithdrawerAddr()
 
 		// Set initial contracts receiving transaction fees
 		k.SetRevenue(ctx, revenue)
 		k.SetDeployerMap(ctx, deployer, contract)
 
-		if len(withdrawer) != 0 {
+		for _, withdrawer := range revenue.GetWithdrawerMapAddrs() {
 			k.SetWithdrawerMap(ctx, withdrawer, contract)
 		}
 	}
+
+	for _, derivedContract := range data.DerivedContracts {
+		k.SetDerivedContract(ctx, derivedContract)
+	}
+
+	// Set the revenues escrowed on the module account
+	for _, accruedRevenue := range data.AccruedRevenues {
+		k.SetAccruedRevenue(ctx, accruedRevenue)
+	}
 }
 
 // ExportGenesis export module state
 func ExportGenesis(ctx sdk.Context, k keeper.Keeper) *types.GenesisState {
 	return &types.GenesisState{
-		Params:   k.GetParams(ctx),
-		Revenues: k.GetRevenues(ctx),
+		Params:           k.GetParams(ctx),
+		Revenues:         k.GetRevenues(ctx),
+		DerivedContracts: k.GetDerivedContracts(ctx),
+		AccruedRevenues:  k.GetAccruedRevenues(ctx),
 	}
 }
@@ -96,11 +96,11 @@ func (suite *GenesisTestSuite) TestRevenueInitGenesis() {
 
 			if tc.expPanic {
 				suite.Require().Panics(func() {
-					revenue.InitGenesis(suite.ctx, suite.app.RevenueKeeper, tc.genesis)
+					revenue.InitGenesis(suite.ctx, suite.app.RevenueKeeper, suite.app.AccountKeeper, tc.genesis)
 				})
 			} else {
 				suite.Require().NotPanics(func() {
-					revenue.InitGenesis(suite.ctx, suite.app.RevenueKeeper, tc.genesis)
+					revenue.InitGenesis(suite.ctx, suite.app.RevenueKeeper, suite.app.AccountKeeper, tc.genesis)
 				})
 
 				params := suite.app.RevenueKeeper.GetParams(suite.ctx)
@@ -111,8 +111,15 @@ func (suite *GenesisTestSuite) TestRevenueInitGenesis() {
 }
 
 func (suite *GenesisTestSuite) TestRevenueExportGenesis() {
-	revenue.InitGenesis(suite.ctx, suite.app.RevenueKeeper, suite.genesis)
+	accruedRevenue := types.NewAccruedRevenue(
+		tests.GenerateAddress(),
+		sdk.AccAddress(tests.GenerateAddress().Bytes()),
+		sdk.NewCoins(sdk.NewCoin("aevmos", sdk.NewInt(100))),
+	)
+	suite.genesis.AccruedRevenues = []types.AccruedRevenue{accruedRevenue}
+	revenue.InitGenesis(suite.ctx, suite.app.RevenueKeeper, suite.app.AccountKeeper, suite.genesis)
 
 	genesisExported := revenue.ExportGenesis(suite.ctx, suite.app.RevenueKeeper)
 	suite.Require().Equal(genesisExported.Params, suite.genesis.Params)
+	suite.Require().Equal([]types.AccruedRevenue{accruedRevenue}, genesisExported.AccruedRevenues)
 }
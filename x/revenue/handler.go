@@ -23,6 +23,12 @@ func NewHandler(server types.MsgServer) sdk.Handler {
 		case *types.MsgCancelRevenue:
 			res, err := server.CancelRevenue(sdk.WrapSDKContext(ctx), msg)
 			return sdk.WrapServiceResult(ctx, res, err)
+		case *types.MsgRegisterDerivedContract:
+			res, err := server.RegisterDerivedContract(sdk.WrapSDKContext(ctx), msg)
+			return sdk.WrapServiceResult(ctx, res, err)
+		case *types.MsgWithdrawRevenue:
+			res, err := server.WithdrawRevenue(sdk.WrapSDKContext(ctx), msg)
+			return sdk.WrapServiceResult(ctx, res, err)
 		default:
 			return nil, errorsmod.Wrapf(errortypes.ErrUnknownRequest, "unrecognized %s message type: %T", types.ModuleName, msg)
 		}
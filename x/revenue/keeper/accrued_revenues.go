@@ -0,0 +1,170 @@
+package keeper
+
+import (
+	errorsmod "cosmossdk.io/errors"
+	"github.com/cosmos/cosmos-sdk/store/prefix"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/ethereum/go-ethereum/common"
+
+	"github.com/evmos/evmos/v10/x/revenue/types"
+)
+
+// GetAccruedRevenues returns the revenues accrued for all contracts and
+// withdrawers that have not been withdrawn yet
+func (k Keeper) GetAccruedRevenues(ctx sdk.Context) []types.AccruedRevenue {
+	accruedRevenues := []types.AccruedRevenue{}
+
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefixAccruedRevenue)
+	iterator := store.Iterator(nil, nil)
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		var accruedRevenue types.AccruedRevenue
+		k.cdc.MustUnmarshal(iterator.Value(), &accruedRevenue)
+		accruedRevenues = append(accruedRevenues, accruedRevenue)
+	}
+
+	return accruedRevenues
+}
+
+// GetWithdrawerAccruedRevenues returns the revenues accrued for a withdrawer
+// that have not been withdrawn yet
+func (k Keeper) GetWithdrawerAccruedRevenues(
+	ctx sdk.Context,
+	withdrawer sdk.AccAddress,
+) []types.AccruedRevenue {
+	accruedRevenues := []types.AccruedRevenue{}
+
+	store := prefix.NewStore(
+		ctx.KVStore(k.storeKey),
+		types.GetKeyPrefixWithdrawerAccruedRevenue(withdrawer),
+	)
+	iterator := store.Iterator(nil, nil)
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		contract := common.BytesToAddress(iterator.Key())
+		if accruedRevenue, found := k.GetAccruedRevenue(ctx, contract, withdrawer); found {
+			accruedRevenues = append(accruedRevenues, accruedRevenue)
+		}
+	}
+
+	return accruedRevenues
+}
+
+// GetAccruedRevenue returns the revenue accrued from a contract for a
+// withdrawer
+func (k Keeper) GetAccruedRevenue(
+	ctx sdk.Context,
+	contract common.Address,
+	withdrawer sdk.AccAddress,
+) (types.AccruedRevenue, bool) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.GetKeyPrefixAccruedRevenue(contract))
+	bz := store.Get(withdrawer.Bytes())
+	if len(bz) == 0 {
+		return types.AccruedRevenue{}, false
+	}
+
+	var accruedRevenue types.AccruedRevenue
+	k.cdc.MustUnmarshal(bz, &accruedRevenue)
+	return accruedRevenue, true
+}
+
+// SetAccruedRevenue stores an AccruedRevenue and its contract-by-withdrawer
+// mapping
+func (k Keeper) SetAccruedRevenue(ctx sdk.Context, accruedRevenue types.AccruedRevenue) {
+	contract := accruedRevenue.GetContractAddr()
+	withdrawer := accruedRevenue.GetWithdrawerAddr()
+
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.GetKeyPrefixAccruedRevenue(contract))
+	store.Set(withdrawer.Bytes(), k.cdc.MustMarshal(&accruedRevenue))
+
+	withdrawerStore := prefix.NewStore(
+		ctx.KVStore(k.storeKey),
+		types.GetKeyPrefixWithdrawerAccruedRevenue(withdrawer),
+	)
+	withdrawerStore.Set(contract.Bytes(), []byte{1})
+}
+
+// DeleteAccruedRevenue deletes an AccruedRevenue and its contract-by-withdrawer
+// mapping
+func (k Keeper) DeleteAccruedRevenue(ctx sdk.Context, accruedRevenue types.AccruedRevenue) {
+	contract := accruedRevenue.GetContractAddr()
+	withdrawer := accruedRevenue.GetWithdrawerAddr()
+
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.GetKeyPrefixAccruedRevenue(contract))
+	store.Delete(withdrawer.Bytes())
+
+	withdrawerStore := prefix.NewStore(
+		ctx.KVStore(k.storeKey),
+		types.GetKeyPrefixWithdrawerAccruedRevenue(withdrawer),
+	)
+	withdrawerStore.Delete(contract.Bytes())
+}
+
+// accrueRevenue adds the developer fees of a contract, which are already
+// escrowed on the module account, to the revenue accrued for a withdrawer
+func (k Keeper) accrueRevenue(
+	ctx sdk.Context,
+	contract common.Address,
+	withdrawer sdk.AccAddress,
+	amount sdk.Coins,
+) {
+	accruedRevenue, found := k.GetAccruedRevenue(ctx, contract, withdrawer)
+	if !found {
+		accruedRevenue = types.NewAccruedRevenue(contract, withdrawer, sdk.Coins{})
+	}
+
+	accruedRevenue.Amount = accruedRevenue.Amount.Add(amount...)
+	k.SetAccruedRevenue(ctx, accruedRevenue)
+}
+
+// withdrawRevenue transfers the revenues accrued for a withdrawer from the
+// module account to the withdrawer and removes them. If the contract is nil,
+// the revenues accrued from all contracts are withdrawn.
+func (k Keeper) withdrawRevenue(
+	ctx sdk.Context,
+	withdrawer sdk.AccAddress,
+	contract *common.Address,
+) (sdk.Coins, error) {
+	var accruedRevenues []types.AccruedRevenue
+	if contract != nil {
+		if accruedRevenue, found := k.GetAccruedRevenue(ctx, *contract, withdrawer); found {
+			accruedRevenues = append(accruedRevenues, accruedRevenue)
+		}
+	} else {
+		accruedRevenues = k.GetWithdrawerAccruedRevenues(ctx, withdrawer)
+	}
+
+	if len(accruedRevenues) == 0 {
+		return nil, errorsmod.Wrapf(
+			types.ErrRevenueNothingToWithdraw,
+			"withdrawer %s", withdrawer,
+		)
+	}
+
+	total := sdk.Coins{}
+	for _, accruedRevenue := range accruedRevenues {
+		total = total.Add(accruedRevenue.Amount...)
+		k.DeleteAccruedRevenue(ctx, accruedRevenue)
+
+		ctx.EventManager().EmitEvent(
+			sdk.NewEvent(
+				types.EventTypeWithdrawRevenue,
+				sdk.NewAttribute(types.AttributeKeyContract, accruedRevenue.ContractAddress),
+				sdk.NewAttribute(types.AttributeKeyWithdrawerAddress, accruedRevenue.WithdrawerAddress),
+				sdk.NewAttribute(sdk.AttributeKeyAmount, accruedRevenue.Amount.String()),
+			),
+		)
+	}
+
+	if err := k.bankKeeper.SendCoinsFromModuleToAccount(ctx, types.ModuleName, withdrawer, total); err != nil {
+		return nil, errorsmod.Wrapf(
+			err,
+			"module account failed to transfer accrued revenue (%s) to withdraw address %s",
+			total, withdrawer,
+		)
+	}
+
+	return total, nil
+}
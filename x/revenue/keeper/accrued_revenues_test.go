@@ -0,0 +1,111 @@
+package keeper_test
+
+import (
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/evmos/ethermint/tests"
+
+	"github.com/evmos/evmos/v10/testutil"
+	"github.com/evmos/evmos/v10/x/revenue/types"
+)
+
+func (suite *KeeperTestSuite) TestWithdrawRevenue() {
+	contract2 := tests.GenerateAddress()
+	amount := sdk.NewCoins(sdk.NewCoin(suite.denom, sdk.NewInt(100)))
+
+	testCases := []struct {
+		name      string
+		contract  *common.Address
+		malleate  func()
+		expPass   bool
+		expAmount sdk.Coins
+	}{
+		{
+			"fail - nothing to withdraw",
+			nil,
+			func() {},
+			false,
+			nil,
+		},
+		{
+			"fail - nothing to withdraw from contract",
+			&contract2,
+			func() {
+				suite.app.RevenueKeeper.SetAccruedRevenue(suite.ctx, types.NewAccruedRevenue(contract, withdraw, amount))
+			},
+			false,
+			nil,
+		},
+		{
+			"ok - withdraw from contract",
+			&contract,
+			func() {
+				suite.app.RevenueKeeper.SetAccruedRevenue(suite.ctx, types.NewAccruedRevenue(contract, withdraw, amount))
+				suite.app.RevenueKeeper.SetAccruedRevenue(suite.ctx, types.NewAccruedRevenue(contract2, withdraw, amount))
+			},
+			true,
+			amount,
+		},
+		{
+			"ok - withdraw from all contracts",
+			nil,
+			func() {
+				suite.app.RevenueKeeper.SetAccruedRevenue(suite.ctx, types.NewAccruedRevenue(contract, withdraw, amount))
+				suite.app.RevenueKeeper.SetAccruedRevenue(suite.ctx, types.NewAccruedRevenue(contract2, withdraw, amount))
+			},
+			true,
+			amount.Add(amount...),
+		},
+	}
+	for _, tc := range testCases {
+		suite.Run(fmt.Sprintf("Case %s", tc.name), func() {
+			suite.SetupTest()
+
+			// the accrued revenues are escrowed on the module account
+			err := testutil.FundModuleAccount(suite.ctx, suite.app.BankKeeper, types.ModuleName, amount.Add(amount...))
+			suite.Require().NoError(err)
+
+			tc.malleate()
+
+			ctx := sdk.WrapSDKContext(suite.ctx)
+			msg := types.NewMsgWithdrawRevenue(withdraw, tc.contract)
+			res, err := suite.app.RevenueKeeper.WithdrawRevenue(ctx, msg)
+
+			if tc.expPass {
+				suite.Require().NoError(err)
+				suite.Require().Equal(tc.expAmount, res.Amount)
+
+				balance := suite.app.BankKeeper.GetBalance(suite.ctx, withdraw, suite.denom)
+				suite.Require().Equal(tc.expAmount.AmountOf(suite.denom), balance.Amount)
+
+				_, found := suite.app.RevenueKeeper.GetAccruedRevenue(suite.ctx, contract, withdraw)
+				suite.Require().False(found)
+				_, found = suite.app.RevenueKeeper.GetAccruedRevenue(suite.ctx, contract2, withdraw)
+				suite.Require().Equal(tc.contract != nil, found)
+			} else {
+				suite.Require().Error(err)
+				suite.Require().ErrorIs(err, types.ErrRevenueNothingToWithdraw)
+			}
+		})
+	}
+}
+
+func (suite *KeeperTestSuite) TestGetWithdrawerAccruedRevenues() {
+	suite.SetupTest()
+
+	amount := sdk.NewCoins(sdk.NewCoin(suite.denom, sdk.NewInt(100)))
+	contract2 := tests.GenerateAddress()
+
+	suite.app.RevenueKeeper.SetAccruedRevenue(suite.ctx, types.NewAccruedRevenue(contract, withdraw, amount))
+	suite.app.RevenueKeeper.SetAccruedRevenue(suite.ctx, types.NewAccruedRevenue(contract2, withdraw, amount))
+	suite.app.RevenueKeeper.SetAccruedRevenue(suite.ctx, types.NewAccruedRevenue(contract, deployer, amount))
+
+	suite.Require().Len(suite.app.RevenueKeeper.GetAccruedRevenues(suite.ctx), 3)
+	suite.Require().Len(suite.app.RevenueKeeper.GetWithdrawerAccruedRevenues(suite.ctx, withdraw), 2)
+
+	suite.app.RevenueKeeper.DeleteAccruedRevenue(suite.ctx, types.NewAccruedRevenue(contract2, withdraw, nil))
+	accruedRevenues := suite.app.RevenueKeeper.GetWithdrawerAccruedRevenues(suite.ctx, withdraw)
+	suite.Require().Equal([]types.AccruedRevenue{types.NewAccruedRevenue(contract, withdraw, amount)}, accruedRevenues)
+}
@@ -0,0 +1,226 @@
+package keeper
+
+import (
+	"github.com/cosmos/cosmos-sdk/store/prefix"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/crypto"
+
+	"github.com/evmos/evmos/v10/x/revenue/types"
+)
+
+// maxDerivationsPerTx bounds the number of contract addresses derived within a
+// transaction to index the contracts created by factories, either by the EVM
+// hook or when registering a factory or a derived contract. The remaining
+// contracts are indexed lazily by the following transactions that call them or
+// their creators. The bound is needed as the gas consumed by the hook is not
+// charged to the sender: the EVM module resets the gas meter of the tx to the
+// gas used by the EVM execution.
+const maxDerivationsPerTx = 64
+
+// GetDerivedContracts returns all contracts derived from registered factories.
+func (k Keeper) GetDerivedContracts(ctx sdk.Context) []types.DerivedContract {
+	derivedContracts := []types.DerivedContract{}
+
+	store := ctx.KVStore(k.storeKey)
+	iterator := sdk.KVStorePrefixIterator(store, types.KeyPrefixDerivedContract)
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		var derivedContract types.DerivedContract
+		k.cdc.MustUnmarshal(iterator.Value(), &derivedContract)
+
+		derivedContracts = append(derivedContracts, derivedContract)
+	}
+
+	return derivedContracts
+}
+
+// GetDerivedContract returns the DerivedContract of a contract created by a
+// registered factory
+func (k Keeper) GetDerivedContract(
+	ctx sdk.Context,
+	contract common.Address,
+) (types.DerivedContract, bool) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefixDerivedContract)
+	bz := store.Get(contract.Bytes())
+	if len(bz) == 0 {
+		return types.DerivedContract{}, false
+	}
+
+	var derivedContract types.DerivedContract
+	k.cdc.MustUnmarshal(bz, &derivedContract)
+	return derivedContract, true
+}
+
+// SetDerivedContract stores a DerivedContract and its contract-by-factory
+// mapping
+func (k Keeper) SetDerivedContract(ctx sdk.Context, derivedContract types.DerivedContract) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefixDerivedContract)
+	contract := derivedContract.GetContractAddr()
+	bz := k.cdc.MustMarshal(&derivedContract)
+	store.Set(contract.Bytes(), bz)
+
+	factoryStore := prefix.NewStore(
+		ctx.KVStore(k.storeKey),
+		types.GetKeyPrefixFactory(derivedContract.GetFactoryAddr()),
+	)
+	factoryStore.Set(contract.Bytes(), []byte{1})
+}
+
+// DeleteDerivedContract deletes a DerivedContract and its contract-by-factory
+// mapping
+func (k Keeper) DeleteDerivedContract(ctx sdk.Context, derivedContract types.DerivedContract) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefixDerivedContract)
+	contract := derivedContract.GetContractAddr()
+	store.Delete(contract.Bytes())
+
+	factoryStore := prefix.NewStore(
+		ctx.KVStore(k.storeKey),
+		types.GetKeyPrefixFactory(derivedContract.GetFactoryAddr()),
+	)
+	factoryStore.Delete(contract.Bytes())
+}
+
+// DeleteFactoryDerivedContracts deletes all contracts derived from a given
+// factory
+func (k Keeper) DeleteFactoryDerivedContracts(ctx sdk.Context, factory common.Address) {
+	factoryStore := prefix.NewStore(ctx.KVStore(k.storeKey), types.GetKeyPrefixFactory(factory))
+	iterator := factoryStore.Iterator(nil, nil)
+
+	var contracts []common.Address
+	for ; iterator.Valid(); iterator.Next() {
+		contracts = append(contracts, common.BytesToAddress(iterator.Key()))
+	}
+	iterator.Close()
+
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefixDerivedContract)
+	for _, contract := range contracts {
+		store.Delete(contract.Bytes())
+		factoryStore.Delete(contract.Bytes())
+	}
+}
+
+// IsDerivedContract checks if a contract was indexed as derived from a
+// registered factory
+func (k Keeper) IsDerivedContract(
+	ctx sdk.Context,
+	contract common.Address,
+) bool {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefixDerivedContract)
+	return store.Has(contract.Bytes())
+}
+
+// GetContractRevenue returns the Revenue that applies to a contract. It is
+// either the Revenue registered for the contract itself or, for a contract
+// derived from a registered factory, the Revenue of the factory.
+func (k Keeper) GetContractRevenue(
+	ctx sdk.Context,
+	contract common.Address,
+) (types.Revenue, bool) {
+	if revenue, found := k.GetRevenue(ctx, contract); found {
+		return revenue, true
+	}
+
+	derivedContract, found := k.GetDerivedContract(ctx, contract)
+	if !found {
+		return types.Revenue{}, false
+	}
+
+	return k.GetRevenue(ctx, derivedContract.GetFactoryAddr())
+}
+
+// indexCreatedContracts indexes the contracts created with CREATE by a
+// registered factory or by one of its derived contracts, starting from the
+// given nonce of the creator, and returns the next nonce to be indexed. The
+// contracts created by the newly indexed contracts are indexed recursively.
+// At most budget addresses are derived.
+func (k Keeper) indexCreatedContracts(
+	ctx sdk.Context,
+	creator,
+	factory common.Address,
+	nonce uint64,
+	budget *uint64,
+) uint64 {
+	account := k.evmKeeper.GetAccountWithoutBalance(ctx, creator)
+	if account == nil {
+		return nonce
+	}
+
+	params := k.GetParams(ctx)
+	for ; nonce < account.Nonce && *budget > 0; nonce++ {
+		*budget--
+		ctx.GasMeter().ConsumeGas(
+			params.AddrDerivationCostCreate,
+			"revenue factory: address derivation CREATE opcode",
+		)
+
+		k.indexDerivedContract(ctx, crypto.CreateAddress(creator, nonce), factory, budget)
+	}
+
+	return nonce
+}
+
+// indexDerivedContract indexes a contract created by a registered factory or
+// by one of its derived contracts, along with the contracts it created. Failed
+// deployments and the nonces used by CREATE2, which don't hold any contract
+// code at the derived address, are skipped, as well as contracts that are
+// registered or already indexed.
+func (k Keeper) indexDerivedContract(
+	ctx sdk.Context,
+	contract,
+	factory common.Address,
+	budget *uint64,
+) {
+	if k.IsRevenueRegistered(ctx, contract) || k.IsDerivedContract(ctx, contract) {
+		return
+	}
+
+	account := k.evmKeeper.GetAccountWithoutBalance(ctx, contract)
+	if account == nil || !account.IsContract() {
+		return
+	}
+
+	derivedContract := types.NewDerivedContract(contract, factory)
+	derivedContract.Nonce = k.indexCreatedContracts(ctx, contract, factory, 0, budget)
+	k.SetDerivedContract(ctx, derivedContract)
+}
+
+// syncCreatedContracts indexes the contracts created by a registered factory
+// or by one of its derived contracts since their last indexed nonce. It is a
+// no-op for any other contract.
+func (k Keeper) syncCreatedContracts(
+	ctx sdk.Context,
+	contract common.Address,
+	budget *uint64,
+) {
+	if revenue, found := k.GetRevenue(ctx, contract); found {
+		if !revenue.Factory {
+			return
+		}
+
+		nonce := k.indexCreatedContracts(ctx, contract, contract, revenue.Nonce, budget)
+		if nonce != revenue.Nonce {
+			revenue.Nonce = nonce
+			k.SetRevenue(ctx, revenue)
+		}
+		return
+	}
+
+	derivedContract, found := k.GetDerivedContract(ctx, contract)
+	if !found {
+		return
+	}
+
+	nonce := k.indexCreatedContracts(
+		ctx,
+		contract,
+		derivedContract.GetFactoryAddr(),
+		derivedContract.Nonce,
+		budget,
+	)
+	if nonce != derivedContract.Nonce {
+		derivedContract.Nonce = nonce
+		k.SetDerivedContract(ctx, derivedContract)
+	}
+}
@@ -0,0 +1,126 @@
+package keeper_test
+
+import (
+	"fmt"
+	"math/big"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+
+	"github.com/ethereum/go-ethereum/common"
+	ethtypes "github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/evmos/ethermint/tests"
+	"github.com/evmos/ethermint/x/evm/statedb"
+
+	"github.com/evmos/evmos/v10/testutil"
+	"github.com/evmos/evmos/v10/x/revenue/types"
+)
+
+func (suite *KeeperTestSuite) TestGetContractRevenue() {
+	factory := tests.GenerateAddress()
+	child := crypto.CreateAddress(factory, 0)
+
+	testCases := []struct {
+		name        string
+		malleate    func()
+		expFound    bool
+		expContract common.Address
+	}{
+		{
+			"contract not registered",
+			func() {},
+			false,
+			common.Address{},
+		},
+		{
+			"derived contract inherits the revenue of the factory",
+			func() {
+				suite.app.RevenueKeeper.SetRevenue(suite.ctx, types.NewRevenue(factory, deployer, withdraw))
+				suite.app.RevenueKeeper.SetDerivedContract(suite.ctx, types.NewDerivedContract(child, factory))
+			},
+			true,
+			factory,
+		},
+		{
+			"registered derived contract uses its own revenue",
+			func() {
+				suite.app.RevenueKeeper.SetRevenue(suite.ctx, types.NewRevenue(factory, deployer, withdraw))
+				suite.app.RevenueKeeper.SetRevenue(suite.ctx, types.NewRevenue(child, deployer, nil))
+				suite.app.RevenueKeeper.SetDerivedContract(suite.ctx, types.NewDerivedContract(child, factory))
+			},
+			true,
+			child,
+		},
+	}
+	for _, tc := range testCases {
+		suite.Run(fmt.Sprintf("Case %s", tc.name), func() {
+			suite.SetupTest() // reset
+			tc.malleate()
+
+			revenue, found := suite.app.RevenueKeeper.GetContractRevenue(suite.ctx, child)
+			suite.Require().Equal(tc.expFound, found)
+			if tc.expFound {
+				suite.Require().Equal(tc.expContract, revenue.GetContractAddr())
+			}
+		})
+	}
+}
+
+func (suite *KeeperTestSuite) TestPostTxProcessingDerivedContracts() {
+	suite.SetupTest()
+
+	sender := tests.GenerateAddress()
+	factory := tests.GenerateAddress()
+	child := crypto.CreateAddress(factory, 1)
+	codeHash := common.Hex2Bytes("fa98cd094c09bb300de0037ba34e94f569b145ce8baa36ed863a08d7b7433f8d")
+	gasPrice := big.NewInt(1000000000)
+	gasUsed := uint64(100000)
+
+	err := testutil.FundModuleAccount(
+		suite.ctx,
+		suite.app.BankKeeper,
+		authtypes.FeeCollectorName,
+		sdk.NewCoins(sdk.NewCoin(suite.denom, sdk.NewInt(1e18))),
+	)
+	suite.Require().NoError(err)
+
+	revenue := types.NewRevenue(factory, deployer, withdraw)
+	revenue.Factory = true
+	revenue.Nonce = 1
+	suite.app.RevenueKeeper.SetRevenue(suite.ctx, revenue)
+
+	postTxProcessing := func(to common.Address, logs ...*ethtypes.Log) {
+		msg := ethtypes.NewMessage(sender, &to, 0, nil, gasUsed, gasPrice, nil, nil, nil, nil, true)
+		receipt := &ethtypes.Receipt{GasUsed: gasUsed, Logs: logs}
+		err := suite.app.RevenueKeeper.PostTxProcessing(suite.ctx, msg, receipt)
+		suite.Require().NoError(err)
+	}
+
+	// the factory creates a contract
+	err = suite.app.EvmKeeper.SetAccount(suite.ctx, factory, statedb.Account{Nonce: 2, Balance: big.NewInt(0), CodeHash: codeHash})
+	suite.Require().NoError(err)
+	err = suite.app.EvmKeeper.SetAccount(suite.ctx, child, statedb.Account{Nonce: 1, Balance: big.NewInt(0), CodeHash: codeHash})
+	suite.Require().NoError(err)
+
+	// the created contract is not indexed until the factory is called or emits a log
+	postTxProcessing(child)
+	suite.Require().False(suite.app.RevenueKeeper.IsDerivedContract(suite.ctx, child))
+	_, found := suite.app.RevenueKeeper.GetAccruedRevenue(suite.ctx, factory, withdraw)
+	suite.Require().False(found)
+
+	postTxProcessing(tests.GenerateAddress(), &ethtypes.Log{Address: factory})
+	suite.Require().True(suite.app.RevenueKeeper.IsDerivedContract(suite.ctx, child))
+	revenue, _ = suite.app.RevenueKeeper.GetRevenue(suite.ctx, factory)
+	suite.Require().Equal(uint64(2), revenue.Nonce)
+
+	// the derived contract inherits the withdrawer of the factory and its fees
+	// are accrued on the factory registration
+	postTxProcessing(child)
+	params := suite.app.RevenueKeeper.GetParams(suite.ctx)
+	txFee := sdk.NewIntFromUint64(gasUsed).Mul(sdk.NewIntFromBigInt(gasPrice))
+	expFee := params.DeveloperShares.MulInt(txFee).TruncateInt()
+	accruedRevenue, found := suite.app.RevenueKeeper.GetAccruedRevenue(suite.ctx, factory, withdraw)
+	suite.Require().True(found)
+	suite.Require().Equal(expFee, accruedRevenue.Amount.AmountOf(suite.denom))
+}
@@ -2,8 +2,10 @@ package keeper
 
 import (
 	errorsmod "cosmossdk.io/errors"
+	sdkmath "cosmossdk.io/math"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 
+	"github.com/ethereum/go-ethereum/common"
 	"github.com/ethereum/go-ethereum/core"
 	ethtypes "github.com/ethereum/go-ethereum/core/types"
 
@@ -32,8 +34,19 @@ func (h Hooks) PostTxProcessing(ctx sdk.Context, msg core.Message, receipt *etht
 
 // PostTxProcessing implements EvmHooks.PostTxProcessing. After each successful
 // interaction with a registered contract, the contract deployer (or, if set,
-// the withdraw address) receives a share from the transaction fees paid by the
-// transaction sender.
+// the withdraw address or the weighted withdrawers) accrues a share from the
+// transaction fees paid by the transaction sender. The fees are escrowed on
+// the module account until they are withdrawn with MsgWithdrawRevenue.
+// Contracts derived from a registered factory inherit the revenue of the
+// factory.
+//
+// If the internal revenue attribution is enabled, the developer fees are split
+// evenly among all registered contracts that are either the recipient of the
+// tx or emitted logs during its execution, so that the contracts reached
+// through a router or a proxy also earn revenue. The split is not weighted by
+// the gas used per call frame: the hook only receives the message and the
+// receipt, and the EVM tracer of the keeper is a node-local debugging option
+// that can't be relied on in consensus.
 func (k Keeper) PostTxProcessing(
 	ctx sdk.Context,
 	msg core.Message,
@@ -50,48 +63,142 @@ func (k Keeper) PostTxProcessing(
 		return nil
 	}
 
-	// if the contract is not registered to receive fees, do nothing
-	revenue, found := k.GetRevenue(ctx, *contract)
-	if !found {
-		return nil
+	// index the contracts created during the transaction by the factories and
+	// derived contracts that were called or emitted logs
+	budget := uint64(maxDerivationsPerTx)
+	synced := map[common.Address]bool{*contract: true}
+	k.syncCreatedContracts(ctx, *contract, &budget)
+	for _, log := range receipt.Logs {
+		if !synced[log.Address] {
+			synced[log.Address] = true
+			k.syncCreatedContracts(ctx, log.Address, &budget)
+		}
 	}
 
-	withdrawer := revenue.GetWithdrawerAddr()
-	if len(withdrawer) == 0 {
-		withdrawer = revenue.GetDeployerAddr()
+	// if no contract is registered to receive fees, do nothing
+	contracts := k.revenueContracts(ctx, params, *contract, receipt)
+	if len(contracts) == 0 {
+		return nil
 	}
 
 	txFee := sdk.NewIntFromUint64(receipt.GasUsed).Mul(sdk.NewIntFromBigInt(msg.GasPrice()))
 	developerFee := (params.DeveloperShares).MulInt(txFee).TruncateInt()
 	evmDenom := k.evmKeeper.GetParams(ctx).EvmDenom
-	fees := sdk.Coins{{Denom: evmDenom, Amount: developerFee}}
-
-	// distribute the fees to the contract deployer / withdraw address
-	err := k.bankKeeper.SendCoinsFromModuleToAccount(
-		ctx,
-		k.feeCollectorName,
-		withdrawer,
-		fees,
-	)
-	if err != nil {
-		return errorsmod.Wrapf(
-			err,
-			"fee collector account failed to distribute developer fees (%s) to withdraw address %s. contract %s",
-			fees, withdrawer, contract,
+
+	// escrow the developer fees on the module account until they are withdrawn
+	if developerFee.IsPositive() {
+		fees := sdk.Coins{{Denom: evmDenom, Amount: developerFee}}
+		err := k.bankKeeper.SendCoinsFromModuleToModule(
+			ctx,
+			k.feeCollectorName,
+			types.ModuleName,
+			fees,
 		)
+		if err != nil {
+			return errorsmod.Wrapf(
+				err,
+				"fee collector account failed to escrow developer fees (%s). contract %s",
+				fees, contract,
+			)
+		}
 	}
 
-	ctx.EventManager().EmitEvents(
-		sdk.Events{
-			sdk.NewEvent(
-				types.EventTypeDistributeDevRevenue,
-				sdk.NewAttribute(sdk.AttributeKeySender, msg.From().String()),
-				sdk.NewAttribute(types.AttributeKeyContract, contract.String()),
-				sdk.NewAttribute(types.AttributeKeyWithdrawerAddress, withdrawer.String()),
-				sdk.NewAttribute(sdk.AttributeKeyAmount, developerFee.String()),
-			),
-		},
-	)
+	// split the developer fees evenly among the contracts. The remainder of the
+	// division goes to the first contract, i.e. the recipient if it is
+	// registered and otherwise the first registered contract that emitted a log.
+	n := sdk.NewInt(int64(len(contracts)))
+	for i, c := range contracts {
+		share := developerFee.Quo(n)
+		if i == 0 {
+			share = share.Add(developerFee.Mod(n))
+		}
+
+		// NOTE: existence of contract revenue is already checked
+		revenue, _ := k.GetContractRevenue(ctx, c)
+		k.accrueContractRevenue(ctx, msg, c, revenue, share, evmDenom)
+	}
 
 	return nil
 }
+
+// revenueContracts returns the contracts with a registered revenue, directly
+// or through their factory, that the developer fees of a tx are attributed
+// to, without duplicates. These are the recipient of the tx and, if the
+// internal revenue attribution is enabled, the contracts that emitted logs in
+// the receipt. Contracts that are called internally without emitting any log
+// can't be identified from the receipt and are not credited.
+func (k Keeper) revenueContracts(
+	ctx sdk.Context,
+	params types.Params,
+	recipient common.Address,
+	receipt *ethtypes.Receipt,
+) []common.Address {
+	candidates := []common.Address{recipient}
+	if params.EnableInternalRevenueAttribution {
+		for _, log := range receipt.Logs {
+			candidates = append(candidates, log.Address)
+		}
+	}
+
+	contracts := []common.Address{}
+	seen := make(map[common.Address]bool)
+	for _, contract := range candidates {
+		if seen[contract] {
+			continue
+		}
+		seen[contract] = true
+
+		if _, found := k.GetContractRevenue(ctx, contract); found {
+			contracts = append(contracts, contract)
+		}
+	}
+
+	return contracts
+}
+
+// accrueContractRevenue splits the developer fees attributed to a contract
+// among the withdrawers of its revenue according to their weights, and
+// accrues their shares on the revenue registration. The remainder of the
+// truncation goes to the first withdrawer. The fees must already be escrowed
+// on the module account.
+func (k Keeper) accrueContractRevenue(
+	ctx sdk.Context,
+	msg core.Message,
+	contract common.Address,
+	revenue types.Revenue,
+	developerFee sdkmath.Int,
+	evmDenom string,
+) {
+	withdrawers := revenue.GetWithdrawerShares()
+	shares := make([]sdkmath.Int, len(withdrawers))
+	remainder := developerFee
+	for i, w := range withdrawers {
+		shares[i] = w.Weight.MulInt(developerFee).TruncateInt()
+		remainder = remainder.Sub(shares[i])
+	}
+	shares[0] = shares[0].Add(remainder)
+
+	for i, w := range withdrawers {
+		if !shares[i].IsPositive() {
+			continue
+		}
+
+		// accrue the fees for the contract deployer / withdraw addresses. The
+		// fees of derived contracts are accrued on their factory registration.
+		withdrawer := w.GetAddr()
+		fees := sdk.Coins{{Denom: evmDenom, Amount: shares[i]}}
+		k.accrueRevenue(ctx, revenue.GetContractAddr(), withdrawer, fees)
+
+		ctx.EventManager().EmitEvents(
+			sdk.Events{
+				sdk.NewEvent(
+					types.EventTypeDistributeDevRevenue,
+					sdk.NewAttribute(sdk.AttributeKeySender, msg.From().String()),
+					sdk.NewAttribute(types.AttributeKeyContract, contract.String()),
+					sdk.NewAttribute(types.AttributeKeyWithdrawerAddress, withdrawer.String()),
+					sdk.NewAttribute(sdk.AttributeKeyAmount, shares[i].String()),
+				),
+			},
+		)
+	}
+}
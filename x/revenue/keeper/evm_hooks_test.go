@@ -0,0 +1,185 @@
+package keeper_test
+
+import (
+	"math/big"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+
+	"github.com/ethereum/go-ethereum/common"
+	ethtypes "github.com/ethereum/go-ethereum/core/types"
+	"github.com/evmos/ethermint/tests"
+	"github.com/evmos/ethermint/x/evm/statedb"
+
+	"github.com/evmos/evmos/v10/testutil"
+	"github.com/evmos/evmos/v10/x/revenue/types"
+)
+
+func (suite *KeeperTestSuite) TestPostTxProcessingWithdrawers() {
+	suite.SetupTest()
+
+	sender := tests.GenerateAddress()
+	contract := tests.GenerateAddress()
+	codeHash := common.Hex2Bytes("fa98cd094c09bb300de0037ba34e94f569b145ce8baa36ed863a08d7b7433f8d")
+	gasPrice := big.NewInt(1000000001)
+	gasUsed := uint64(100001)
+
+	err := testutil.FundModuleAccount(
+		suite.ctx,
+		suite.app.BankKeeper,
+		authtypes.FeeCollectorName,
+		sdk.NewCoins(sdk.NewCoin(suite.denom, sdk.NewInt(1e18))),
+	)
+	suite.Require().NoError(err)
+
+	err = suite.app.EvmKeeper.SetAccount(suite.ctx, contract, statedb.Account{Nonce: 1, Balance: big.NewInt(0), CodeHash: codeHash})
+	suite.Require().NoError(err)
+
+	withdrawer1 := sdk.AccAddress(tests.GenerateAddress().Bytes())
+	withdrawer2 := sdk.AccAddress(tests.GenerateAddress().Bytes())
+	withdrawer3 := sdk.AccAddress(tests.GenerateAddress().Bytes())
+
+	revenue := types.NewRevenue(contract, deployer, nil)
+	revenue.Withdrawers = []types.Withdrawer{
+		types.NewWithdrawer(withdrawer1, sdk.MustNewDecFromStr("0.333333333333333334")),
+		types.NewWithdrawer(withdrawer2, sdk.MustNewDecFromStr("0.333333333333333333")),
+		types.NewWithdrawer(withdrawer3, sdk.MustNewDecFromStr("0.333333333333333333")),
+	}
+	suite.app.RevenueKeeper.SetRevenue(suite.ctx, revenue)
+
+	msg := ethtypes.NewMessage(sender, &contract, 0, nil, gasUsed, gasPrice, nil, nil, nil, nil, true)
+	receipt := &ethtypes.Receipt{GasUsed: gasUsed}
+	err = suite.app.RevenueKeeper.PostTxProcessing(suite.ctx, msg, receipt)
+	suite.Require().NoError(err)
+
+	params := suite.app.RevenueKeeper.GetParams(suite.ctx)
+	txFee := sdk.NewIntFromUint64(gasUsed).Mul(sdk.NewIntFromBigInt(gasPrice))
+	developerFee := params.DeveloperShares.MulInt(txFee).TruncateInt()
+
+	total := sdk.ZeroInt()
+	for i, withdrawer := range []sdk.AccAddress{withdrawer1, withdrawer2, withdrawer3} {
+		share := revenue.Withdrawers[i].Weight.MulInt(developerFee).TruncateInt()
+		accruedRevenue, found := suite.app.RevenueKeeper.GetAccruedRevenue(suite.ctx, contract, withdrawer)
+		suite.Require().True(found)
+		amount := accruedRevenue.Amount.AmountOf(suite.denom)
+		if i == 0 {
+			// the truncation remainder is accrued by the first withdrawer
+			suite.Require().True(amount.GTE(share))
+		} else {
+			suite.Require().Equal(share, amount)
+		}
+		total = total.Add(amount)
+
+		// the fees are escrowed until they are withdrawn
+		suite.Require().True(suite.app.BankKeeper.GetBalance(suite.ctx, withdrawer, suite.denom).IsZero())
+	}
+	suite.Require().Equal(developerFee, total)
+
+	moduleAddr := suite.app.AccountKeeper.GetModuleAddress(types.ModuleName)
+	suite.Require().Equal(developerFee, suite.app.BankKeeper.GetBalance(suite.ctx, moduleAddr, suite.denom).Amount)
+}
+
+func (suite *KeeperTestSuite) TestPostTxProcessingInternalAttribution() {
+	sender := tests.GenerateAddress()
+	codeHash := common.Hex2Bytes("fa98cd094c09bb300de0037ba34e94f569b145ce8baa36ed863a08d7b7433f8d")
+	gasPrice := big.NewInt(1000000001)
+	gasUsed := uint64(100001)
+
+	testCases := []struct {
+		name                string
+		enableAttribution   bool
+		registeredRecipient bool
+		expRouterShares     int64
+		expTargetShares     int64
+	}{
+		{
+			"attribution disabled - only the recipient is credited",
+			false,
+			true,
+			1,
+			0,
+		},
+		{
+			"attribution enabled - fees are split among the registered contracts",
+			true,
+			true,
+			1,
+			1,
+		},
+		{
+			"attribution enabled, unregistered recipient - fees are split among the contracts that emitted logs",
+			true,
+			false,
+			1,
+			1,
+		},
+	}
+	for _, tc := range testCases {
+		suite.Run(tc.name, func() {
+			suite.SetupTest()
+
+			params := suite.app.RevenueKeeper.GetParams(suite.ctx)
+			params.EnableInternalRevenueAttribution = tc.enableAttribution
+			suite.app.RevenueKeeper.SetParams(suite.ctx, params)
+
+			err := testutil.FundModuleAccount(
+				suite.ctx,
+				suite.app.BankKeeper,
+				authtypes.FeeCollectorName,
+				sdk.NewCoins(sdk.NewCoin(suite.denom, sdk.NewInt(1e18))),
+			)
+			suite.Require().NoError(err)
+
+			router := tests.GenerateAddress()
+			target := tests.GenerateAddress()
+			unregistered := tests.GenerateAddress()
+			routerWithdrawer := sdk.AccAddress(tests.GenerateAddress().Bytes())
+			targetWithdrawer := sdk.AccAddress(tests.GenerateAddress().Bytes())
+
+			for _, contract := range []common.Address{router, target, unregistered} {
+				err = suite.app.EvmKeeper.SetAccount(suite.ctx, contract, statedb.Account{Nonce: 1, Balance: big.NewInt(0), CodeHash: codeHash})
+				suite.Require().NoError(err)
+			}
+			suite.app.RevenueKeeper.SetRevenue(suite.ctx, types.NewRevenue(router, deployer, routerWithdrawer))
+			suite.app.RevenueKeeper.SetRevenue(suite.ctx, types.NewRevenue(target, deployer, targetWithdrawer))
+
+			recipient := router
+			if !tc.registeredRecipient {
+				recipient = unregistered
+			}
+
+			msg := ethtypes.NewMessage(sender, &recipient, 0, nil, gasUsed, gasPrice, nil, nil, nil, nil, true)
+			receipt := &ethtypes.Receipt{
+				GasUsed: gasUsed,
+				Logs: []*ethtypes.Log{
+					{Address: router},
+					{Address: target},
+					{Address: target},
+					{Address: unregistered},
+				},
+			}
+			err = suite.app.RevenueKeeper.PostTxProcessing(suite.ctx, msg, receipt)
+			suite.Require().NoError(err)
+
+			txFee := sdk.NewIntFromUint64(gasUsed).Mul(sdk.NewIntFromBigInt(gasPrice))
+			developerFee := params.DeveloperShares.MulInt(txFee).TruncateInt()
+
+			// the remainder of the split is credited to the recipient, or to the
+			// first registered contract that emitted a log
+			n := sdk.NewInt(tc.expRouterShares + tc.expTargetShares)
+			expRouter := developerFee.Quo(n).Add(developerFee.Mod(n))
+			expTarget := developerFee.Quo(n).MulRaw(tc.expTargetShares)
+
+			accruedRevenue, found := suite.app.RevenueKeeper.GetAccruedRevenue(suite.ctx, router, routerWithdrawer)
+			suite.Require().True(found)
+			suite.Require().Equal(expRouter, accruedRevenue.Amount.AmountOf(suite.denom))
+
+			accruedRevenue, found = suite.app.RevenueKeeper.GetAccruedRevenue(suite.ctx, target, targetWithdrawer)
+			suite.Require().Equal(tc.enableAttribution, found)
+			suite.Require().Equal(expTarget, accruedRevenue.Amount.AmountOf(suite.denom))
+
+			moduleAddr := suite.app.AccountKeeper.GetModuleAddress(types.ModuleName)
+			suite.Require().Equal(developerFee, suite.app.BankKeeper.GetBalance(suite.ctx, moduleAddr, suite.denom).Amount)
+		})
+	}
+}
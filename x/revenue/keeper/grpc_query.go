@@ -190,3 +190,151 @@ func (k Keeper) WithdrawerRevenues( // nolint: dupl
 		Pagination:        pageRes,
 	}, nil
 }
+
+// DerivedContracts returns all contracts that inherit the revenue of a given
+// factory
+func (k Keeper) DerivedContracts(
+	c context.Context,
+	req *types.QueryDerivedContractsRequest,
+) (*types.QueryDerivedContractsResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+
+	if strings.TrimSpace(req.FactoryAddress) == "" {
+		return nil, status.Error(
+			codes.InvalidArgument,
+			"factory address is empty",
+		)
+	}
+
+	// check if the factory is a non-zero hex address
+	if err := ethermint.ValidateNonZeroAddress(req.FactoryAddress); err != nil {
+		return nil, status.Errorf(
+			codes.InvalidArgument,
+			"invalid format for factory %s, should be non-zero hex ('0x...')", req.FactoryAddress,
+		)
+	}
+
+	var contracts []string
+	store := prefix.NewStore(
+		ctx.KVStore(k.storeKey),
+		types.GetKeyPrefixFactory(common.HexToAddress(req.FactoryAddress)),
+	)
+
+	pageRes, err := query.Paginate(store, req.Pagination, func(key, _ []byte) error {
+		contracts = append(contracts, common.BytesToAddress(key).Hex())
+		return nil
+	})
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &types.QueryDerivedContractsResponse{
+		ContractAddresses: contracts,
+		Pagination:        pageRes,
+	}, nil
+}
+
+// AccruedRevenues returns the revenues accrued from a given contract that
+// have not been withdrawn yet
+func (k Keeper) AccruedRevenues(
+	c context.Context,
+	req *types.QueryAccruedRevenuesRequest,
+) (*types.QueryAccruedRevenuesResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+
+	if strings.TrimSpace(req.ContractAddress) == "" {
+		return nil, status.Error(
+			codes.InvalidArgument,
+			"contract address is empty",
+		)
+	}
+
+	// check if the contract is a non-zero hex address
+	if err := ethermint.ValidateNonZeroAddress(req.ContractAddress); err != nil {
+		return nil, status.Errorf(
+			codes.InvalidArgument,
+			"invalid format for contract %s, should be non-zero hex ('0x...')", req.ContractAddress,
+		)
+	}
+
+	var accruedRevenues []types.AccruedRevenue
+	store := prefix.NewStore(
+		ctx.KVStore(k.storeKey),
+		types.GetKeyPrefixAccruedRevenue(common.HexToAddress(req.ContractAddress)),
+	)
+
+	pageRes, err := query.Paginate(store, req.Pagination, func(_, value []byte) error {
+		var accruedRevenue types.AccruedRevenue
+		if err := k.cdc.Unmarshal(value, &accruedRevenue); err != nil {
+			return err
+		}
+		accruedRevenues = append(accruedRevenues, accruedRevenue)
+		return nil
+	})
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &types.QueryAccruedRevenuesResponse{
+		AccruedRevenues: accruedRevenues,
+		Pagination:      pageRes,
+	}, nil
+}
+
+// WithdrawerAccruedRevenues returns the revenues accrued for a given
+// withdrawer that have not been withdrawn yet
+func (k Keeper) WithdrawerAccruedRevenues(
+	c context.Context,
+	req *types.QueryWithdrawerAccruedRevenuesRequest,
+) (*types.QueryWithdrawerAccruedRevenuesResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+
+	if strings.TrimSpace(req.WithdrawerAddress) == "" {
+		return nil, status.Error(
+			codes.InvalidArgument,
+			"withdraw address is empty",
+		)
+	}
+
+	withdrawer, err := sdk.AccAddressFromBech32(req.WithdrawerAddress)
+	if err != nil {
+		return nil, status.Errorf(
+			codes.InvalidArgument,
+			"invalid format for withdraw addr %s, should be bech32 ('evmos...')", req.WithdrawerAddress,
+		)
+	}
+
+	var accruedRevenues []types.AccruedRevenue
+	store := prefix.NewStore(
+		ctx.KVStore(k.storeKey),
+		types.GetKeyPrefixWithdrawerAccruedRevenue(withdrawer),
+	)
+
+	pageRes, err := query.Paginate(store, req.Pagination, func(key, _ []byte) error {
+		accruedRevenue, found := k.GetAccruedRevenue(ctx, common.BytesToAddress(key), withdrawer)
+		if found {
+			accruedRevenues = append(accruedRevenues, accruedRevenue)
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &types.QueryWithdrawerAccruedRevenuesResponse{
+		AccruedRevenues: accruedRevenues,
+		Pagination:      pageRes,
+	}, nil
+}
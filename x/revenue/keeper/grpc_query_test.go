@@ -406,3 +406,251 @@ func (suite *KeeperTestSuite) TestQueryParams() {
 	suite.Require().NoError(err)
 	suite.Require().Equal(expParams, res.Params)
 }
+
+func (suite *KeeperTestSuite) TestDerivedContracts() {
+	var (
+		req    *types.QueryDerivedContractsRequest
+		expRes *types.QueryDerivedContractsResponse
+	)
+
+	testCases := []struct {
+		name     string
+		malleate func()
+		expPass  bool
+	}{
+		{
+			"empty factory address",
+			func() {
+				req = &types.QueryDerivedContractsRequest{}
+				expRes = &types.QueryDerivedContractsResponse{Pagination: &query.PageResponse{}}
+			},
+			false,
+		},
+		{
+			"invalid factory address",
+			func() {
+				req = &types.QueryDerivedContractsRequest{
+					FactoryAddress: "123",
+				}
+				expRes = &types.QueryDerivedContractsResponse{Pagination: &query.PageResponse{}}
+			},
+			false,
+		},
+		{
+			"no derived contracts",
+			func() {
+				req = &types.QueryDerivedContractsRequest{
+					FactoryAddress: contract.Hex(),
+				}
+				expRes = &types.QueryDerivedContractsResponse{Pagination: &query.PageResponse{}}
+			},
+			true,
+		},
+		{
+			"2 derived contracts w/pagination",
+			func() {
+				req = &types.QueryDerivedContractsRequest{
+					Pagination:     &query.PageRequest{Limit: 10, CountTotal: true},
+					FactoryAddress: contract.Hex(),
+				}
+				child1 := tests.GenerateAddress()
+				child2 := tests.GenerateAddress()
+
+				suite.app.RevenueKeeper.SetDerivedContract(suite.ctx, types.NewDerivedContract(child1, contract))
+				suite.app.RevenueKeeper.SetDerivedContract(suite.ctx, types.NewDerivedContract(child2, contract))
+				// derived from another factory
+				suite.app.RevenueKeeper.SetDerivedContract(suite.ctx, types.NewDerivedContract(tests.GenerateAddress(), child1))
+
+				expRes = &types.QueryDerivedContractsResponse{
+					Pagination: &query.PageResponse{Total: 2},
+					ContractAddresses: []string{
+						child1.Hex(),
+						child2.Hex(),
+					},
+				}
+			},
+			true,
+		},
+	}
+	for _, tc := range testCases {
+		suite.Run(fmt.Sprintf("Case %s", tc.name), func() {
+			suite.SetupTest() // reset
+
+			ctx := sdk.WrapSDKContext(suite.ctx)
+			tc.malleate()
+
+			res, err := suite.queryClient.DerivedContracts(ctx, req)
+			if tc.expPass {
+				suite.Require().NoError(err)
+				suite.Require().Equal(expRes.Pagination, res.Pagination)
+				suite.Require().ElementsMatch(expRes.ContractAddresses, res.ContractAddresses)
+			} else {
+				suite.Require().Error(err)
+			}
+		})
+	}
+}
+
+func (suite *KeeperTestSuite) TestAccruedRevenues() {
+	var (
+		req    *types.QueryAccruedRevenuesRequest
+		expRes *types.QueryAccruedRevenuesResponse
+	)
+
+	testCases := []struct {
+		name     string
+		malleate func()
+		expPass  bool
+	}{
+		{
+			"empty contract address",
+			func() {
+				req = &types.QueryAccruedRevenuesRequest{}
+				expRes = &types.QueryAccruedRevenuesResponse{Pagination: &query.PageResponse{}}
+			},
+			false,
+		},
+		{
+			"invalid contract address",
+			func() {
+				req = &types.QueryAccruedRevenuesRequest{
+					ContractAddress: "123",
+				}
+				expRes = &types.QueryAccruedRevenuesResponse{Pagination: &query.PageResponse{}}
+			},
+			false,
+		},
+		{
+			"no accrued revenues",
+			func() {
+				req = &types.QueryAccruedRevenuesRequest{
+					ContractAddress: contract.Hex(),
+				}
+				expRes = &types.QueryAccruedRevenuesResponse{Pagination: &query.PageResponse{}}
+			},
+			true,
+		},
+		{
+			"2 accrued revenues w/pagination",
+			func() {
+				req = &types.QueryAccruedRevenuesRequest{
+					Pagination:      &query.PageRequest{Limit: 10, CountTotal: true},
+					ContractAddress: contract.Hex(),
+				}
+				amount := sdk.NewCoins(sdk.NewCoin(suite.denom, sdk.NewInt(100)))
+				accruedRevenue1 := types.NewAccruedRevenue(contract, deployer, amount)
+				accruedRevenue2 := types.NewAccruedRevenue(contract, withdraw, amount)
+
+				suite.app.RevenueKeeper.SetAccruedRevenue(suite.ctx, accruedRevenue1)
+				suite.app.RevenueKeeper.SetAccruedRevenue(suite.ctx, accruedRevenue2)
+				// accrued from another contract
+				suite.app.RevenueKeeper.SetAccruedRevenue(suite.ctx, types.NewAccruedRevenue(tests.GenerateAddress(), deployer, amount))
+
+				expRes = &types.QueryAccruedRevenuesResponse{
+					Pagination:      &query.PageResponse{Total: 2},
+					AccruedRevenues: []types.AccruedRevenue{accruedRevenue1, accruedRevenue2},
+				}
+			},
+			true,
+		},
+	}
+	for _, tc := range testCases {
+		suite.Run(fmt.Sprintf("Case %s", tc.name), func() {
+			suite.SetupTest() // reset
+
+			ctx := sdk.WrapSDKContext(suite.ctx)
+			tc.malleate()
+
+			res, err := suite.queryClient.AccruedRevenues(ctx, req)
+			if tc.expPass {
+				suite.Require().NoError(err)
+				suite.Require().Equal(expRes.Pagination, res.Pagination)
+				suite.Require().ElementsMatch(expRes.AccruedRevenues, res.AccruedRevenues)
+			} else {
+				suite.Require().Error(err)
+			}
+		})
+	}
+}
+
+func (suite *KeeperTestSuite) TestWithdrawerAccruedRevenues() {
+	var (
+		req    *types.QueryWithdrawerAccruedRevenuesRequest
+		expRes *types.QueryWithdrawerAccruedRevenuesResponse
+	)
+
+	testCases := []struct {
+		name     string
+		malleate func()
+		expPass  bool
+	}{
+		{
+			"empty withdrawer address",
+			func() {
+				req = &types.QueryWithdrawerAccruedRevenuesRequest{}
+				expRes = &types.QueryWithdrawerAccruedRevenuesResponse{Pagination: &query.PageResponse{}}
+			},
+			false,
+		},
+		{
+			"invalid withdrawer address",
+			func() {
+				req = &types.QueryWithdrawerAccruedRevenuesRequest{
+					WithdrawerAddress: "123",
+				}
+				expRes = &types.QueryWithdrawerAccruedRevenuesResponse{Pagination: &query.PageResponse{}}
+			},
+			false,
+		},
+		{
+			"no accrued revenues",
+			func() {
+				req = &types.QueryWithdrawerAccruedRevenuesRequest{
+					WithdrawerAddress: withdraw.String(),
+				}
+				expRes = &types.QueryWithdrawerAccruedRevenuesResponse{Pagination: &query.PageResponse{}}
+			},
+			true,
+		},
+		{
+			"2 accrued revenues w/pagination",
+			func() {
+				req = &types.QueryWithdrawerAccruedRevenuesRequest{
+					Pagination:        &query.PageRequest{Limit: 10, CountTotal: true},
+					WithdrawerAddress: withdraw.String(),
+				}
+				amount := sdk.NewCoins(sdk.NewCoin(suite.denom, sdk.NewInt(100)))
+				accruedRevenue1 := types.NewAccruedRevenue(contract, withdraw, amount)
+				accruedRevenue2 := types.NewAccruedRevenue(tests.GenerateAddress(), withdraw, amount)
+
+				suite.app.RevenueKeeper.SetAccruedRevenue(suite.ctx, accruedRevenue1)
+				suite.app.RevenueKeeper.SetAccruedRevenue(suite.ctx, accruedRevenue2)
+				// accrued for another withdrawer
+				suite.app.RevenueKeeper.SetAccruedRevenue(suite.ctx, types.NewAccruedRevenue(contract, deployer, amount))
+
+				expRes = &types.QueryWithdrawerAccruedRevenuesResponse{
+					Pagination:      &query.PageResponse{Total: 2},
+					AccruedRevenues: []types.AccruedRevenue{accruedRevenue1, accruedRevenue2},
+				}
+			},
+			true,
+		},
+	}
+	for _, tc := range testCases {
+		suite.Run(fmt.Sprintf("Case %s", tc.name), func() {
+			suite.SetupTest() // reset
+
+			ctx := sdk.WrapSDKContext(suite.ctx)
+			tc.malleate()
+
+			res, err := suite.queryClient.WithdrawerAccruedRevenues(ctx, req)
+			if tc.expPass {
+				suite.Require().NoError(err)
+				suite.Require().Equal(expRes.Pagination, res.Pagination)
+				suite.Require().ElementsMatch(expRes.AccruedRevenues, res.AccruedRevenues)
+			} else {
+				suite.Require().Error(err)
+			}
+		})
+	}
+}
@@ -126,12 +126,12 @@ var _ = Describe("Fee distribution:", Ordered, func() {
 		})
 
 		It("should not distribute tx fees for previously registered contracts", func() {
-			preBalance := s.app.BankKeeper.GetBalance(s.ctx, deployerAddress, denom)
+			preBalance := getAccruedRevenue(registeredContract, deployerAddress, denom)
 			gasPrice := big.NewInt(2000000000)
 			contractInteract(userKey, &registeredContract, gasPrice, nil, nil, nil)
 			s.Commit()
 
-			balance := s.app.BankKeeper.GetBalance(s.ctx, deployerAddress, denom)
+			balance := getAccruedRevenue(registeredContract, deployerAddress, denom)
 			Expect(balance).To(Equal(preBalance))
 		})
 
@@ -192,14 +192,14 @@ var _ = Describe("Fee distribution:", Ordered, func() {
 					s.Commit()
 				})
 
-				It("should result in sending the tx fees to the deployer address", func() {
-					preBalance := s.app.BankKeeper.GetBalance(s.ctx, deployerAddress, denom)
+				It("should result in accruing the tx fees for the deployer address", func() {
+					preBalance := getAccruedRevenue(contractAddress, deployerAddress, denom)
 					gasPrice := big.NewInt(2000000000)
 					res := contractInteract(userKey, &contractAddress, gasPrice, nil, nil, nil)
 					s.Commit()
 
 					developerCoins, _ := calculateFees(denom, params, res, gasPrice, 14)
-					balance := s.app.BankKeeper.GetBalance(s.ctx, deployerAddress, denom)
+					balance := getAccruedRevenue(contractAddress, deployerAddress, denom)
 					Expect(developerCoins.IsPositive()).To(BeTrue())
 					Expect(balance).To(Equal(preBalance.Add(developerCoins)))
 				})
@@ -260,14 +260,14 @@ var _ = Describe("Fee distribution:", Ordered, func() {
 					Expect(fee.WithdrawerAddress).To(Equal(withdrawerAddress.String()))
 				})
 
-				It("should send the fees to the withdraw address", func() {
-					preBalance := s.app.BankKeeper.GetBalance(s.ctx, withdrawerAddress, denom)
+				It("should accrue the fees for the withdraw address", func() {
+					preBalance := getAccruedRevenue(contractAddress, withdrawerAddress, denom)
 					gasPrice := big.NewInt(2000000000)
 					res := contractInteract(userKey, &contractAddress, gasPrice, nil, nil, nil)
 					s.Commit()
 
 					developerCoins, _ := calculateFees(denom, params, res, gasPrice, 14)
-					balance := s.app.BankKeeper.GetBalance(s.ctx, withdrawerAddress, denom)
+					balance := getAccruedRevenue(contractAddress, withdrawerAddress, denom)
 					Expect(developerCoins.IsPositive()).To(BeTrue())
 					Expect(balance).To(Equal(preBalance.Add(developerCoins)))
 				})
@@ -294,13 +294,13 @@ var _ = Describe("Fee distribution:", Ordered, func() {
 
 				It("should transfer legacy tx fees to validators and contract developer evenly", func() {
 					preFeeColectorBalance := s.app.BankKeeper.GetBalance(s.ctx, feeCollectorAddr, denom)
-					preBalance := s.app.BankKeeper.GetBalance(s.ctx, deployerAddress, denom)
+					preBalance := getAccruedRevenue(contractAddress, deployerAddress, denom)
 					gasPrice := big.NewInt(2000000000)
 					res := contractInteract(userKey, &contractAddress, gasPrice, nil, nil, nil)
 
 					developerCoins, validatorCoins := calculateFees(denom, params, res, gasPrice, 14)
 					feeColectorBalance := s.app.BankKeeper.GetBalance(s.ctx, feeCollectorAddr, denom)
-					balance := s.app.BankKeeper.GetBalance(s.ctx, deployerAddress, denom)
+					balance := getAccruedRevenue(contractAddress, deployerAddress, denom)
 
 					Expect(balance).To(Equal(preBalance.Add(developerCoins)))
 					Expect(feeColectorBalance).To(Equal(
@@ -311,7 +311,7 @@ var _ = Describe("Fee distribution:", Ordered, func() {
 
 				It("should transfer dynamic tx fees to validators and contract developer evenly", func() {
 					preFeeColectorBalance := s.app.BankKeeper.GetBalance(s.ctx, feeCollectorAddr, denom)
-					preBalance := s.app.BankKeeper.GetBalance(s.ctx, deployerAddress, denom)
+					preBalance := getAccruedRevenue(contractAddress, deployerAddress, denom)
 					gasTipCap := big.NewInt(10000)
 					gasFeeCap := new(big.Int).Add(s.app.FeeMarketKeeper.GetBaseFee(s.ctx), gasTipCap)
 					res := contractInteract(
@@ -325,7 +325,7 @@ var _ = Describe("Fee distribution:", Ordered, func() {
 
 					developerCoins, validatorCoins := calculateFees(denom, params, res, gasFeeCap, 14)
 					feeColectorBalance := s.app.BankKeeper.GetBalance(s.ctx, feeCollectorAddr, denom)
-					balance := s.app.BankKeeper.GetBalance(s.ctx, deployerAddress, denom)
+					balance := getAccruedRevenue(contractAddress, deployerAddress, denom)
 					Expect(balance).To(Equal(preBalance.Add(developerCoins)))
 					Expect(feeColectorBalance).To(Equal(preFeeColectorBalance.Add(validatorCoins)))
 					s.Commit()
@@ -341,7 +341,7 @@ var _ = Describe("Fee distribution:", Ordered, func() {
 
 				It("should transfer all tx fees to validators", func() {
 					preFeeColectorBalance := s.app.BankKeeper.GetBalance(s.ctx, feeCollectorAddr, denom)
-					preBalance := s.app.BankKeeper.GetBalance(s.ctx, deployerAddress, denom)
+					preBalance := getAccruedRevenue(contractAddress, deployerAddress, denom)
 					gasTipCap := big.NewInt(10000)
 					gasFeeCap := new(big.Int).Add(s.app.FeeMarketKeeper.GetBaseFee(s.ctx), gasTipCap)
 					res := contractInteract(
@@ -355,7 +355,7 @@ var _ = Describe("Fee distribution:", Ordered, func() {
 
 					_, validatorCoins := calculateFees(denom, params, res, gasFeeCap, 10)
 					feeColectorBalance := s.app.BankKeeper.GetBalance(s.ctx, feeCollectorAddr, denom)
-					balance := s.app.BankKeeper.GetBalance(s.ctx, deployerAddress, denom)
+					balance := getAccruedRevenue(contractAddress, deployerAddress, denom)
 					Expect(balance).To(Equal(preBalance))
 					Expect(feeColectorBalance).To(Equal(preFeeColectorBalance.Add(validatorCoins)))
 					s.Commit()
@@ -371,7 +371,7 @@ var _ = Describe("Fee distribution:", Ordered, func() {
 
 				It("should transfer all tx fees to developers", func() {
 					preFeeColectorBalance := s.app.BankKeeper.GetBalance(s.ctx, feeCollectorAddr, denom)
-					preBalance := s.app.BankKeeper.GetBalance(s.ctx, deployerAddress, denom)
+					preBalance := getAccruedRevenue(contractAddress, deployerAddress, denom)
 					gasTipCap := big.NewInt(10000)
 					gasFeeCap := new(big.Int).Add(s.app.FeeMarketKeeper.GetBaseFee(s.ctx), gasTipCap)
 					res := contractInteract(
@@ -385,12 +385,41 @@ var _ = Describe("Fee distribution:", Ordered, func() {
 
 					developerCoins, _ := calculateFees(denom, params, res, gasFeeCap, 14)
 					feeColectorBalance := s.app.BankKeeper.GetBalance(s.ctx, feeCollectorAddr, denom)
-					balance := s.app.BankKeeper.GetBalance(s.ctx, deployerAddress, denom)
+					balance := getAccruedRevenue(contractAddress, deployerAddress, denom)
 					Expect(balance).To(Equal(preBalance.Add(developerCoins)))
 					Expect(feeColectorBalance).To(Equal(preFeeColectorBalance))
 					s.Commit()
 				})
 			})
+
+			Context("withdrawing the accrued revenue", func() {
+				It("should transfer the accrued fees to the deployer", func() {
+					accrued := getAccruedRevenue(contractAddress, deployerAddress, denom)
+					Expect(accrued.IsPositive()).To(BeTrue())
+					preBalance := s.app.BankKeeper.GetBalance(s.ctx, deployerAddress, denom)
+
+					msg := types.NewMsgWithdrawRevenue(deployerAddress, &contractAddress)
+					res := deliverTx(deployerKey, nil, msg)
+					Expect(res.IsOK()).To(Equal(true), "revenue withdrawal failed: "+res.GetLog())
+					s.Commit()
+
+					// the withdrawal transaction pays a fee of 1000000 (gas limit * gas price)
+					txFee := sdk.NewCoin(denom, sdk.NewInt(1000000))
+					balance := s.app.BankKeeper.GetBalance(s.ctx, deployerAddress, denom)
+					Expect(balance).To(Equal(preBalance.Add(accrued).Sub(txFee)))
+					Expect(getAccruedRevenue(contractAddress, deployerAddress, denom).IsZero()).To(BeTrue())
+				})
+
+				It("should fail without accrued fees", func() {
+					msg := types.NewMsgWithdrawRevenue(deployerAddress, &contractAddress)
+					res := deliverTx(deployerKey, nil, msg)
+					Expect(res.IsOK()).To(Equal(false), "revenue withdrawal succeeded: "+res.GetLog())
+					Expect(
+						strings.Contains(res.GetLog(), "no accrued revenue to withdraw"),
+					).To(BeTrue(), res.GetLog())
+					s.Commit()
+				})
+			})
 		})
 
 		Describe("Updating registered revenue", func() {
@@ -435,16 +464,16 @@ var _ = Describe("Fee distribution:", Ordered, func() {
 					s.Commit()
 				})
 
-				It("should send tx fees to the new withdraw address", func() {
-					preBalanceD := s.app.BankKeeper.GetBalance(s.ctx, deployerAddress, denom)
-					preBalanceW := s.app.BankKeeper.GetBalance(s.ctx, withdrawerAddress, denom)
+				It("should accrue tx fees for the new withdraw address", func() {
+					preBalanceD := getAccruedRevenue(contractAddress, deployerAddress, denom)
+					preBalanceW := getAccruedRevenue(contractAddress, withdrawerAddress, denom)
 					gasPrice := big.NewInt(2000000000)
 					res := contractInteract(userKey, &contractAddress, gasPrice, nil, nil, nil)
 					s.Commit()
 
 					developerCoins, _ := calculateFees(denom, params, res, gasPrice, 14)
-					balanceD := s.app.BankKeeper.GetBalance(s.ctx, deployerAddress, denom)
-					balanceW := s.app.BankKeeper.GetBalance(s.ctx, withdrawerAddress, denom)
+					balanceD := getAccruedRevenue(contractAddress, deployerAddress, denom)
+					balanceW := getAccruedRevenue(contractAddress, withdrawerAddress, denom)
 					Expect(balanceW).To(Equal(preBalanceW.Add(developerCoins)))
 					Expect(balanceD).To(Equal(preBalanceD))
 				})
@@ -543,13 +572,13 @@ var _ = Describe("Fee distribution:", Ordered, func() {
 				})
 
 				It("should no longer distribute fees to the contract deployer", func() {
-					preBalanceD := s.app.BankKeeper.GetBalance(s.ctx, deployerAddress, denom)
+					preBalanceD := getAccruedRevenue(contractAddress, deployerAddress, denom)
 					gasPrice := big.NewInt(2000000000)
 
 					contractInteract(userKey, &contractAddress, gasPrice, nil, nil, nil)
 					s.Commit()
 
-					balanceD := s.app.BankKeeper.GetBalance(s.ctx, deployerAddress, denom)
+					balanceD := getAccruedRevenue(contractAddress, deployerAddress, denom)
 					Expect(balanceD).To(Equal(preBalanceD))
 				})
 			})
@@ -602,20 +631,20 @@ var _ = Describe("Fee distribution:", Ordered, func() {
 				})
 
 				It("should transfer legacy tx fees evenly to validator and deployer", func() {
-					preBalance := s.app.BankKeeper.GetBalance(s.ctx, deployerAddress, denom)
+					preBalance := getAccruedRevenue(contractAddress, deployerAddress, denom)
 
 					// User interaction with registered contract
 					gasPrice := big.NewInt(2000000000)
 					res := contractInteract(userKey, &contractAddress, gasPrice, nil, nil, nil)
 
 					developerCoins, _ := calculateFees(denom, params, res, gasPrice, 14)
-					balance := s.app.BankKeeper.GetBalance(s.ctx, deployerAddress, denom)
+					balance := getAccruedRevenue(contractAddress, deployerAddress, denom)
 					Expect(balance).To(Equal(preBalance.Add(developerCoins)))
 					s.Commit()
 				})
 
 				It("should transfer dynamic tx fees evenly to validator and deployer", func() {
-					preBalance := s.app.BankKeeper.GetBalance(s.ctx, deployerAddress, denom)
+					preBalance := getAccruedRevenue(contractAddress, deployerAddress, denom)
 
 					// User interaction with registered contract
 					gasTipCap := big.NewInt(10000)
@@ -630,7 +659,7 @@ var _ = Describe("Fee distribution:", Ordered, func() {
 					)
 
 					developerCoins, _ := calculateFees(denom, params, res, gasFeeCap, 14)
-					balance := s.app.BankKeeper.GetBalance(s.ctx, deployerAddress, denom)
+					balance := getAccruedRevenue(contractAddress, deployerAddress, denom)
 					Expect(balance).To(Equal(preBalance.Add(developerCoins)))
 					s.Commit()
 				})
@@ -736,6 +765,11 @@ func calculateFees(
 	return developerCoins, validatorCoins
 }
 
+func getAccruedRevenue(contract common.Address, withdrawer sdk.AccAddress, denom string) sdk.Coin {
+	accruedRevenue, _ := s.app.RevenueKeeper.GetAccruedRevenue(s.ctx, contract, withdrawer)
+	return sdk.NewCoin(denom, accruedRevenue.Amount.AmountOf(denom))
+}
+
 func getNonce(addressBytes []byte) uint64 {
 	return s.app.EvmKeeper.GetNonce(
 		s.ctx,
@@ -2,6 +2,7 @@ package keeper
 
 import (
 	"context"
+	"strconv"
 
 	errorsmod "cosmossdk.io/errors"
 	sdk "github.com/cosmos/cosmos-sdk/types"
@@ -92,8 +93,29 @@ func (k Keeper) RegisterRevenue(
 		)
 	}
 
+	// a derived contract inherits the revenue of its factory, so it cannot be
+	// registered as a factory on its own
+	if msg.Factory {
+		if derivedContract, found := k.GetDerivedContract(ctx, contract); found {
+			return nil, errorsmod.Wrapf(
+				types.ErrRevenueAlreadyRegistered,
+				"contract %s is derived from factory %s", contract, derivedContract.FactoryAddress,
+			)
+		}
+	}
+
 	// prevent storing the same address for deployer and withdrawer
 	revenue := types.NewRevenue(contract, deployer, withdrawer)
+	revenue.Withdrawers = msg.Withdrawers
+
+	// index the contracts that the factory has already created, so that they
+	// inherit its revenue without registering each of them
+	if msg.Factory {
+		budget := uint64(maxDerivationsPerTx)
+		revenue.Factory = true
+		revenue.Nonce = k.indexCreatedContracts(ctx, contract, contract, 0, &budget)
+	}
+
 	k.SetRevenue(ctx, revenue)
 	k.SetDeployerMap(ctx, deployer, contract)
 
@@ -104,14 +126,17 @@ func (k Keeper) RegisterRevenue(
 	effectiveWithdrawer := msg.DeployerAddress
 
 	if len(withdrawer) != 0 {
-		k.SetWithdrawerMap(ctx, withdrawer, contract)
 		effectiveWithdrawer = msg.WithdrawerAddress
 	}
 
+	for _, withdrawerAddr := range revenue.GetWithdrawerMapAddrs() {
+		k.SetWithdrawerMap(ctx, withdrawerAddr, contract)
+	}
+
 	k.Logger(ctx).Debug(
 		"registering contract for transaction fees",
 		"contract", msg.ContractAddress, "deployer", msg.DeployerAddress,
-		"withdraw", effectiveWithdrawer,
+		"withdraw", effectiveWithdrawer, "factory", msg.Factory,
 	)
 
 	ctx.EventManager().EmitEvents(
@@ -121,6 +146,8 @@ func (k Keeper) RegisterRevenue(
 				sdk.NewAttribute(sdk.AttributeKeySender, msg.DeployerAddress),
 				sdk.NewAttribute(types.AttributeKeyContract, msg.ContractAddress),
 				sdk.NewAttribute(types.AttributeKeyWithdrawerAddress, effectiveWithdrawer),
+				sdk.NewAttribute(types.AttributeKeyWithdrawers, types.FormatWithdrawers(msg.Withdrawers)),
+				sdk.NewAttribute(types.AttributeKeyFactory, strconv.FormatBool(msg.Factory)),
 			),
 		},
 	)
@@ -164,31 +191,32 @@ func (k Keeper) UpdateRevenue(
 		msg.WithdrawerAddress = ""
 	}
 
-	// revenue with the given withdraw address is already registered
-	if msg.WithdrawerAddress == revenue.WithdrawerAddress {
+	// revenue with the given withdraw address or withdrawers is already
+	// registered
+	if msg.WithdrawerAddress == revenue.WithdrawerAddress &&
+		types.EqualWithdrawers(msg.Withdrawers, revenue.Withdrawers) {
 		return nil, errorsmod.Wrapf(
 			types.ErrRevenueAlreadyRegistered,
-			"revenue with withdraw address %s", msg.WithdrawerAddress,
+			"revenue with withdraw address %s and withdrawers %s",
+			msg.WithdrawerAddress, types.FormatWithdrawers(msg.Withdrawers),
 		)
 	}
 
-	// only delete withdrawer map if is not default
-	if revenue.WithdrawerAddress != "" {
-		k.DeleteWithdrawerMap(ctx, sdk.MustAccAddressFromBech32(revenue.WithdrawerAddress), contract)
+	// only delete withdrawer maps if not default
+	for _, withdrawerAddr := range revenue.GetWithdrawerMapAddrs() {
+		k.DeleteWithdrawerMap(ctx, withdrawerAddr, contract)
 	}
 
-	// only add withdrawer map if new entry is not default
-	if msg.WithdrawerAddress != "" {
-		k.SetWithdrawerMap(
-			ctx,
-			sdk.MustAccAddressFromBech32(msg.WithdrawerAddress),
-			contract,
-		)
-	}
 	// update revenue
 	revenue.WithdrawerAddress = msg.WithdrawerAddress
+	revenue.Withdrawers = msg.Withdrawers
 	k.SetRevenue(ctx, revenue)
 
+	// only add withdrawer maps if new entries are not default
+	for _, withdrawerAddr := range revenue.GetWithdrawerMapAddrs() {
+		k.SetWithdrawerMap(ctx, withdrawerAddr, contract)
+	}
+
 	ctx.EventManager().EmitEvents(
 		sdk.Events{
 			sdk.NewEvent(
@@ -196,6 +224,7 @@ func (k Keeper) UpdateRevenue(
 				sdk.NewAttribute(types.AttributeKeyContract, msg.ContractAddress),
 				sdk.NewAttribute(sdk.AttributeKeySender, msg.DeployerAddress),
 				sdk.NewAttribute(types.AttributeKeyWithdrawerAddress, msg.WithdrawerAddress),
+				sdk.NewAttribute(types.AttributeKeyWithdrawers, types.FormatWithdrawers(msg.Withdrawers)),
 			),
 		},
 	)
@@ -233,19 +262,18 @@ func (k Keeper) CancelRevenue(
 	}
 
 	k.DeleteRevenue(ctx, fee)
+	if fee.Factory {
+		k.DeleteFactoryDerivedContracts(ctx, contract)
+	}
 	k.DeleteDeployerMap(
 		ctx,
 		fee.GetDeployerAddr(),
 		contract,
 	)
 
-	// delete entry from withdrawer map if not default
-	if fee.WithdrawerAddress != "" {
-		k.DeleteWithdrawerMap(
-			ctx,
-			fee.GetWithdrawerAddr(),
-			contract,
-		)
+	// delete entries from withdrawer map if not default
+	for _, withdrawerAddr := range fee.GetWithdrawerMapAddrs() {
+		k.DeleteWithdrawerMap(ctx, withdrawerAddr, contract)
 	}
 
 	ctx.EventManager().EmitEvents(
@@ -260,3 +288,114 @@ func (k Keeper) CancelRevenue(
 
 	return &types.MsgCancelRevenueResponse{}, nil
 }
+
+// RegisterDerivedContract registers a contract created with CREATE2 by a
+// registered factory or by one of its derived contracts, so that it inherits
+// the revenue of the factory. Contracts created with CREATE are indexed
+// automatically, as their address can be derived from the creator nonce, but
+// the address of a CREATE2 contract can't be derived without its salt and init
+// code hash. Until it is registered, a CREATE2 contract doesn't earn revenue.
+func (k Keeper) RegisterDerivedContract(
+	goCtx context.Context,
+	msg *types.MsgRegisterDerivedContract,
+) (*types.MsgRegisterDerivedContractResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	params := k.GetParams(ctx)
+	if !params.EnableRevenue {
+		return nil, types.ErrRevenueDisabled
+	}
+
+	contract := common.HexToAddress(msg.ContractAddress)
+	creator := common.HexToAddress(msg.CreatorAddress)
+
+	if k.IsRevenueRegistered(ctx, contract) || k.IsDerivedContract(ctx, contract) {
+		return nil, errorsmod.Wrapf(
+			types.ErrRevenueAlreadyRegistered,
+			"contract is already registered %s", contract,
+		)
+	}
+
+	// the creator must be a registered factory or one of its derived contracts
+	var factory common.Address
+	if revenue, found := k.GetRevenue(ctx, creator); found && revenue.Factory {
+		factory = creator
+	} else if derivedContract, found := k.GetDerivedContract(ctx, creator); found {
+		factory = derivedContract.GetFactoryAddr()
+	} else {
+		return nil, errorsmod.Wrapf(
+			types.ErrRevenueNotFactory,
+			"creator %s", msg.CreatorAddress,
+		)
+	}
+
+	ctx.GasMeter().ConsumeGas(
+		params.AddrDerivationCostCreate,
+		"revenue registration: address derivation CREATE2 opcode",
+	)
+
+	derivedAddress := crypto.CreateAddress2(
+		creator,
+		common.HexToHash(msg.Salt),
+		common.HexToHash(msg.InitCodeHash).Bytes(),
+	)
+	if contract != derivedAddress {
+		return nil, errorsmod.Wrapf(
+			errortypes.ErrInvalidAddress,
+			"contract not created by %s or wrong salt and init code hash: expected %s instead of %s",
+			creator, derivedAddress, msg.ContractAddress,
+		)
+	}
+
+	contractAccount := k.evmKeeper.GetAccountWithoutBalance(ctx, contract)
+	if contractAccount == nil || !contractAccount.IsContract() {
+		return nil, errorsmod.Wrapf(
+			types.ErrRevenueNoContractDeployed,
+			"no contract code found at address %s", msg.ContractAddress,
+		)
+	}
+
+	budget := uint64(maxDerivationsPerTx)
+	derivedContract := types.NewDerivedContract(contract, factory)
+	derivedContract.Nonce = k.indexCreatedContracts(ctx, contract, factory, 0, &budget)
+	k.SetDerivedContract(ctx, derivedContract)
+
+	ctx.EventManager().EmitEvents(
+		sdk.Events{
+			sdk.NewEvent(
+				types.EventTypeRegisterDerived,
+				sdk.NewAttribute(sdk.AttributeKeySender, msg.SenderAddress),
+				sdk.NewAttribute(types.AttributeKeyContract, msg.ContractAddress),
+				sdk.NewAttribute(types.AttributeKeyFactory, factory.String()),
+			),
+		},
+	)
+
+	return &types.MsgRegisterDerivedContractResponse{}, nil
+}
+
+// WithdrawRevenue transfers the revenue accrued for the withdrawer from a given
+// contract, or from all contracts, to the withdrawer account. Withdrawals are
+// allowed when the module is disabled, as the accrued fees are already owed to
+// the withdrawers.
+func (k Keeper) WithdrawRevenue(
+	goCtx context.Context,
+	msg *types.MsgWithdrawRevenue,
+) (*types.MsgWithdrawRevenueResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	withdrawer := sdk.MustAccAddressFromBech32(msg.WithdrawerAddress)
+
+	var contract *common.Address
+	if msg.ContractAddress != "" {
+		addr := common.HexToAddress(msg.ContractAddress)
+		contract = &addr
+	}
+
+	amount, err := k.withdrawRevenue(ctx, withdrawer, contract)
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.MsgWithdrawRevenueResponse{Amount: amount}, nil
+}
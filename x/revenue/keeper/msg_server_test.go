@@ -630,3 +630,357 @@ func (suite *KeeperTestSuite) TestCancelRevenue() {
 		})
 	}
 }
+
+func (suite *KeeperTestSuite) TestRegisterFactoryRevenue() {
+	deployer := tests.GenerateAddress()
+	deployerAddr := sdk.AccAddress(deployer.Bytes())
+	factory := crypto.CreateAddress(deployer, 1)
+	child1 := crypto.CreateAddress(factory, 0)
+	child2 := crypto.CreateAddress(factory, 2)
+	grandchild := crypto.CreateAddress(child2, 0)
+	codeHash := common.Hex2Bytes("fa98cd094c09bb300de0037ba34e94f569b145ce8baa36ed863a08d7b7433f8d")
+	deployerAccount := statedb.Account{
+		Balance:  big.NewInt(0),
+		CodeHash: crypto.Keccak256(nil),
+	}
+	contractAccount := func(nonce uint64) statedb.Account {
+		return statedb.Account{
+			Nonce:    nonce,
+			Balance:  big.NewInt(0),
+			CodeHash: codeHash,
+		}
+	}
+
+	testCases := []struct {
+		name         string
+		contract     common.Address
+		nonces       []uint64
+		malleate     func()
+		expDerived   []common.Address
+		expNonce     uint64
+		expPass      bool
+		errorMessage string
+	}{
+		{
+			"ok - factory without contracts",
+			factory,
+			[]uint64{1},
+			func() {
+				err := s.app.EvmKeeper.SetAccount(s.ctx, factory, contractAccount(0))
+				s.Require().NoError(err)
+			},
+			nil,
+			0,
+			true,
+			"",
+		},
+		{
+			"ok - contracts created by the factory and its derived contracts are indexed",
+			factory,
+			[]uint64{1},
+			func() {
+				// the factory nonce 1 is used by a CREATE2 deployment, which
+				// cannot be derived
+				err := s.app.EvmKeeper.SetAccount(s.ctx, factory, contractAccount(3))
+				s.Require().NoError(err)
+				err = s.app.EvmKeeper.SetAccount(s.ctx, child1, contractAccount(1))
+				s.Require().NoError(err)
+				err = s.app.EvmKeeper.SetAccount(s.ctx, child2, contractAccount(1))
+				s.Require().NoError(err)
+				err = s.app.EvmKeeper.SetAccount(s.ctx, grandchild, contractAccount(1))
+				s.Require().NoError(err)
+			},
+			[]common.Address{child1, child2, grandchild},
+			3,
+			true,
+			"",
+		},
+		{
+			"ok - the nonces beyond the derivation budget are left to lazy indexing",
+			factory,
+			[]uint64{1},
+			func() {
+				// the nonce of child1 uses one derivation of the budget
+				err := s.app.EvmKeeper.SetAccount(s.ctx, factory, contractAccount(100))
+				s.Require().NoError(err)
+				err = s.app.EvmKeeper.SetAccount(s.ctx, child1, contractAccount(1))
+				s.Require().NoError(err)
+			},
+			[]common.Address{child1},
+			63,
+			true,
+			"",
+		},
+		{
+			"ok - registered contracts are not indexed",
+			factory,
+			[]uint64{1},
+			func() {
+				err := s.app.EvmKeeper.SetAccount(s.ctx, factory, contractAccount(3))
+				s.Require().NoError(err)
+				err = s.app.EvmKeeper.SetAccount(s.ctx, child1, contractAccount(1))
+				s.Require().NoError(err)
+				err = s.app.EvmKeeper.SetAccount(s.ctx, child2, contractAccount(1))
+				s.Require().NoError(err)
+				err = s.app.EvmKeeper.SetAccount(s.ctx, grandchild, contractAccount(1))
+				s.Require().NoError(err)
+
+				msg := types.NewMsgRegisterRevenue(child2, deployerAddr, nil, []uint64{1, 2})
+				_, err = suite.app.RevenueKeeper.RegisterRevenue(sdk.WrapSDKContext(suite.ctx), msg)
+				suite.Require().NoError(err)
+			},
+			[]common.Address{child1},
+			3,
+			true,
+			"",
+		},
+		{
+			"fail - derived contract cannot be registered as factory",
+			child2,
+			[]uint64{1, 2},
+			func() {
+				err := s.app.EvmKeeper.SetAccount(s.ctx, factory, contractAccount(3))
+				s.Require().NoError(err)
+				err = s.app.EvmKeeper.SetAccount(s.ctx, child2, contractAccount(1))
+				s.Require().NoError(err)
+
+				msg := types.NewMsgRegisterRevenue(factory, deployerAddr, nil, []uint64{1})
+				msg.Factory = true
+				_, err = suite.app.RevenueKeeper.RegisterRevenue(sdk.WrapSDKContext(suite.ctx), msg)
+				suite.Require().NoError(err)
+			},
+			nil,
+			0,
+			false,
+			"is derived from factory",
+		},
+	}
+	for _, tc := range testCases {
+		suite.Run(fmt.Sprintf("Case %s", tc.name), func() {
+			suite.SetupTest()
+			err := s.app.EvmKeeper.SetAccount(s.ctx, deployer, deployerAccount)
+			s.Require().NoError(err)
+			tc.malleate()
+
+			msg := types.NewMsgRegisterRevenue(tc.contract, deployerAddr, nil, tc.nonces)
+			msg.Factory = true
+
+			_, err = suite.app.RevenueKeeper.RegisterRevenue(sdk.WrapSDKContext(suite.ctx), msg)
+			if tc.expPass {
+				suite.Require().NoError(err, tc.name)
+
+				revenue, found := suite.app.RevenueKeeper.GetRevenue(suite.ctx, tc.contract)
+				suite.Require().True(found)
+				suite.Require().True(revenue.Factory)
+				suite.Require().Equal(tc.expNonce, revenue.Nonce)
+
+				derivedContracts := suite.app.RevenueKeeper.GetDerivedContracts(suite.ctx)
+				suite.Require().Len(derivedContracts, len(tc.expDerived))
+				for _, contract := range tc.expDerived {
+					derivedContract, found := suite.app.RevenueKeeper.GetDerivedContract(suite.ctx, contract)
+					suite.Require().True(found)
+					suite.Require().Equal(tc.contract, derivedContract.GetFactoryAddr())
+				}
+
+				// cancelling the factory removes its derived contracts
+				_, err = suite.app.RevenueKeeper.CancelRevenue(
+					sdk.WrapSDKContext(suite.ctx),
+					types.NewMsgCancelRevenue(tc.contract, deployerAddr),
+				)
+				suite.Require().NoError(err)
+				suite.Require().Empty(suite.app.RevenueKeeper.GetDerivedContracts(suite.ctx))
+			} else {
+				suite.Require().Error(err, tc.name)
+				suite.Require().Contains(err.Error(), tc.errorMessage)
+			}
+		})
+	}
+}
+
+func (suite *KeeperTestSuite) TestRegisterDerivedContract() {
+	deployer := tests.GenerateAddress()
+	deployerAddr := sdk.AccAddress(deployer.Bytes())
+	sender := sdk.AccAddress(tests.GenerateAddress().Bytes())
+	factory := crypto.CreateAddress(deployer, 1)
+	child := crypto.CreateAddress(factory, 0)
+	salt := common.BytesToHash([]byte("salt"))
+	initCodeHash := crypto.Keccak256Hash([]byte("code"))
+	codeHash := common.Hex2Bytes("fa98cd094c09bb300de0037ba34e94f569b145ce8baa36ed863a08d7b7433f8d")
+	deployerAccount := statedb.Account{
+		Balance:  big.NewInt(0),
+		CodeHash: crypto.Keccak256(nil),
+	}
+	contractAccount := statedb.Account{
+		Nonce:    1,
+		Balance:  big.NewInt(0),
+		CodeHash: codeHash,
+	}
+
+	testCases := []struct {
+		name         string
+		contract     common.Address
+		creator      common.Address
+		malleate     func()
+		expPass      bool
+		errorMessage string
+	}{
+		{
+			"ok - created by the factory",
+			crypto.CreateAddress2(factory, salt, initCodeHash.Bytes()),
+			factory,
+			func() {
+				err := s.app.EvmKeeper.SetAccount(s.ctx, crypto.CreateAddress2(factory, salt, initCodeHash.Bytes()), contractAccount)
+				s.Require().NoError(err)
+			},
+			true,
+			"",
+		},
+		{
+			"ok - created by a derived contract",
+			crypto.CreateAddress2(child, salt, initCodeHash.Bytes()),
+			child,
+			func() {
+				err := s.app.EvmKeeper.SetAccount(s.ctx, crypto.CreateAddress2(child, salt, initCodeHash.Bytes()), contractAccount)
+				s.Require().NoError(err)
+				suite.app.RevenueKeeper.SetDerivedContract(suite.ctx, types.NewDerivedContract(child, factory))
+			},
+			true,
+			"",
+		},
+		{
+			"fail - creator is not a factory",
+			crypto.CreateAddress2(child, salt, initCodeHash.Bytes()),
+			child,
+			func() {},
+			false,
+			types.ErrRevenueNotFactory.Error(),
+		},
+		{
+			"fail - wrong salt or init code hash",
+			crypto.CreateAddress2(factory, initCodeHash, salt.Bytes()),
+			factory,
+			func() {},
+			false,
+			"wrong salt and init code hash",
+		},
+		{
+			"fail - contract not deployed",
+			crypto.CreateAddress2(factory, salt, initCodeHash.Bytes()),
+			factory,
+			func() {},
+			false,
+			"no contract code found at address",
+		},
+		{
+			"fail - contract already registered",
+			crypto.CreateAddress2(factory, salt, initCodeHash.Bytes()),
+			factory,
+			func() {
+				contract := crypto.CreateAddress2(factory, salt, initCodeHash.Bytes())
+				suite.app.RevenueKeeper.SetDerivedContract(suite.ctx, types.NewDerivedContract(contract, factory))
+			},
+			false,
+			types.ErrRevenueAlreadyRegistered.Error(),
+		},
+	}
+	for _, tc := range testCases {
+		suite.Run(fmt.Sprintf("Case %s", tc.name), func() {
+			suite.SetupTest()
+			err := s.app.EvmKeeper.SetAccount(s.ctx, deployer, deployerAccount)
+			s.Require().NoError(err)
+			err = s.app.EvmKeeper.SetAccount(s.ctx, factory, contractAccount)
+			s.Require().NoError(err)
+
+			msg := types.NewMsgRegisterRevenue(factory, deployerAddr, nil, []uint64{1})
+			msg.Factory = true
+			_, err = suite.app.RevenueKeeper.RegisterRevenue(sdk.WrapSDKContext(suite.ctx), msg)
+			suite.Require().NoError(err)
+
+			tc.malleate()
+
+			res, err := suite.app.RevenueKeeper.RegisterDerivedContract(
+				sdk.WrapSDKContext(suite.ctx),
+				types.NewMsgRegisterDerivedContract(tc.contract, tc.creator, sender, salt, initCodeHash),
+			)
+			if tc.expPass {
+				suite.Require().NoError(err, tc.name)
+				suite.Require().Equal(&types.MsgRegisterDerivedContractResponse{}, res)
+
+				derivedContract, found := suite.app.RevenueKeeper.GetDerivedContract(suite.ctx, tc.contract)
+				suite.Require().True(found)
+				suite.Require().Equal(factory, derivedContract.GetFactoryAddr())
+
+				revenue, found := suite.app.RevenueKeeper.GetContractRevenue(suite.ctx, tc.contract)
+				suite.Require().True(found)
+				suite.Require().Equal(factory.String(), revenue.ContractAddress)
+			} else {
+				suite.Require().Error(err, tc.name)
+				suite.Require().Contains(err.Error(), tc.errorMessage)
+			}
+		})
+	}
+}
+
+func (suite *KeeperTestSuite) TestUpdateRevenueWithdrawers() {
+	suite.SetupTest()
+
+	deployer := tests.GenerateAddress()
+	deployerAddr := sdk.AccAddress(deployer.Bytes())
+	withdrawer := sdk.AccAddress(tests.GenerateAddress().Bytes())
+	withdrawer1 := sdk.AccAddress(tests.GenerateAddress().Bytes())
+	withdrawer2 := sdk.AccAddress(tests.GenerateAddress().Bytes())
+	contract1 := crypto.CreateAddress(deployer, 1)
+	codeHash := common.Hex2Bytes("fa98cd094c09bb300de0037ba34e94f569b145ce8baa36ed863a08d7b7433f8d")
+
+	err := s.app.EvmKeeper.SetAccount(s.ctx, deployer, statedb.Account{Nonce: 2, Balance: big.NewInt(0), CodeHash: crypto.Keccak256(nil)})
+	s.Require().NoError(err)
+	err = s.app.EvmKeeper.SetAccount(s.ctx, contract1, statedb.Account{Nonce: 1, Balance: big.NewInt(0), CodeHash: codeHash})
+	s.Require().NoError(err)
+
+	ctx := sdk.WrapSDKContext(suite.ctx)
+	_, err = suite.app.RevenueKeeper.RegisterRevenue(
+		ctx,
+		types.NewMsgRegisterRevenue(contract1, deployerAddr, withdrawer, []uint64{1}),
+	)
+	suite.Require().NoError(err)
+
+	withdrawers := []types.Withdrawer{
+		types.NewWithdrawer(withdrawer1, sdk.NewDecWithPrec(6, 1)),
+		types.NewWithdrawer(withdrawer2, sdk.NewDecWithPrec(4, 1)),
+	}
+	msgUpdate := &types.MsgUpdateRevenue{
+		ContractAddress: contract1.String(),
+		DeployerAddress: deployerAddr.String(),
+		Withdrawers:     withdrawers,
+	}
+
+	// split the revenue among weighted withdrawers
+	_, err = suite.app.RevenueKeeper.UpdateRevenue(ctx, msgUpdate)
+	suite.Require().NoError(err)
+
+	revenue, found := suite.app.RevenueKeeper.GetRevenue(suite.ctx, contract1)
+	suite.Require().True(found)
+	suite.Require().Equal("", revenue.WithdrawerAddress)
+	suite.Require().Equal(withdrawers, revenue.Withdrawers)
+	suite.Require().False(suite.app.RevenueKeeper.IsWithdrawerMapSet(suite.ctx, withdrawer, contract1))
+	suite.Require().True(suite.app.RevenueKeeper.IsWithdrawerMapSet(suite.ctx, withdrawer1, contract1))
+	suite.Require().True(suite.app.RevenueKeeper.IsWithdrawerMapSet(suite.ctx, withdrawer2, contract1))
+
+	// the same split cannot be set twice
+	_, err = suite.app.RevenueKeeper.UpdateRevenue(ctx, msgUpdate)
+	suite.Require().Error(err)
+
+	// go back to a single withdrawer
+	_, err = suite.app.RevenueKeeper.UpdateRevenue(
+		ctx,
+		types.NewMsgUpdateRevenue(contract1, deployerAddr, withdrawer),
+	)
+	suite.Require().NoError(err)
+
+	revenue, _ = suite.app.RevenueKeeper.GetRevenue(suite.ctx, contract1)
+	suite.Require().Equal(withdrawer.String(), revenue.WithdrawerAddress)
+	suite.Require().Empty(revenue.Withdrawers)
+	suite.Require().True(suite.app.RevenueKeeper.IsWithdrawerMapSet(suite.ctx, withdrawer, contract1))
+	suite.Require().False(suite.app.RevenueKeeper.IsWithdrawerMapSet(suite.ctx, withdrawer1, contract1))
+	suite.Require().False(suite.app.RevenueKeeper.IsWithdrawerMapSet(suite.ctx, withdrawer2, contract1))
+}
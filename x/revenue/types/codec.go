@@ -26,6 +26,8 @@ const (
 	cancelRevenueName   = "evmos/MsgCancelRevenue"
 	registerRevenueName = "evmos/MsgRegisterRevenue"
 	updateRevenueName   = "evmos/MsgUpdateRevenue"
+	registerDerivedName = "evmos/MsgRegisterDerivedContract"
+	withdrawRevenueName = "evmos/MsgWithdrawRevenue"
 )
 
 // NOTE: This is required for the GetSignBytes function
@@ -41,6 +43,8 @@ func RegisterInterfaces(registry codectypes.InterfaceRegistry) {
 		&MsgRegisterRevenue{},
 		&MsgCancelRevenue{},
 		&MsgUpdateRevenue{},
+		&MsgRegisterDerivedContract{},
+		&MsgWithdrawRevenue{},
 	)
 
 	msgservice.RegisterMsgServiceDesc(registry, &_Msg_serviceDesc)
@@ -53,4 +57,6 @@ func RegisterLegacyAminoCodec(cdc *codec.LegacyAmino) {
 	cdc.RegisterConcrete(&MsgCancelRevenue{}, cancelRevenueName, nil)
 	cdc.RegisterConcrete(&MsgRegisterRevenue{}, registerRevenueName, nil)
 	cdc.RegisterConcrete(&MsgUpdateRevenue{}, updateRevenueName, nil)
+	cdc.RegisterConcrete(&MsgRegisterDerivedContract{}, registerDerivedName, nil)
+	cdc.RegisterConcrete(&MsgWithdrawRevenue{}, withdrawRevenueName, nil)
 }
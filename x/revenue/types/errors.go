@@ -12,4 +12,6 @@ var (
 	ErrRevenueNoContractDeployed    = errorsmod.Register(ModuleName, 5, "no contract deployed")
 	ErrRevenueContractNotRegistered = errorsmod.Register(ModuleName, 6, "no revenue registered for contract")
 	ErrRevenueDeployerIsNotEOA      = errorsmod.Register(ModuleName, 7, "no revenue registered for contract")
+	ErrRevenueNotFactory            = errorsmod.Register(ModuleName, 8, "contract is not derived from a registered factory")
+	ErrRevenueNothingToWithdraw     = errorsmod.Register(ModuleName, 9, "no accrued revenue to withdraw")
 )
@@ -6,7 +6,11 @@ const (
 	EventTypeCancelRevenue        = "cancel_revenue"
 	EventTypeUpdateRevenue        = "update_revenue"
 	EventTypeDistributeDevRevenue = "distribute_dev_revenue"
+	EventTypeRegisterDerived      = "register_derived_contract"
+	EventTypeWithdrawRevenue      = "withdraw_revenue"
 
 	AttributeKeyContract          = "contract"
 	AttributeKeyWithdrawerAddress = "withdrawer_address"
+	AttributeKeyFactory           = "factory"
+	AttributeKeyWithdrawers       = "withdrawers"
 )
@@ -3,10 +3,17 @@ package types
 import "fmt"
 
 // NewGenesisState creates a new genesis state.
-func NewGenesisState(params Params, revenues []Revenue) GenesisState {
+func NewGenesisState(
+	params Params,
+	revenues []Revenue,
+	derivedContracts []DerivedContract,
+	accruedRevenues []AccruedRevenue,
+) GenesisState {
 	return GenesisState{
-		Params:   params,
-		Revenues: revenues,
+		Params:           params,
+		Revenues:         revenues,
+		DerivedContracts: derivedContracts,
+		AccruedRevenues:  accruedRevenues,
 	}
 }
 
@@ -22,6 +29,7 @@ func DefaultGenesisState() *GenesisState {
 // failure.
 func (gs GenesisState) Validate() error {
 	seenContract := make(map[string]bool)
+	seenFactory := make(map[string]bool)
 	for _, fs := range gs.Revenues {
 		// only one fee per contract
 		if seenContract[fs.ContractAddress] {
@@ -33,6 +41,46 @@ func (gs GenesisState) Validate() error {
 		}
 
 		seenContract[fs.ContractAddress] = true
+		if fs.Factory {
+			seenFactory[fs.GetContractAddr().String()] = true
+		}
+	}
+
+	seenDerived := make(map[string]bool)
+	for _, dc := range gs.DerivedContracts {
+		// only one factory per derived contract
+		if seenDerived[dc.GetContractAddr().String()] {
+			return fmt.Errorf("derived contract duplicated on genesis '%s'", dc.ContractAddress)
+		}
+
+		if err := dc.Validate(); err != nil {
+			return err
+		}
+
+		// derived contracts are removed along with their factory
+		if !seenFactory[dc.GetFactoryAddr().String()] {
+			return fmt.Errorf("derived contract without registered factory on genesis '%s'", dc.ContractAddress)
+		}
+
+		seenDerived[dc.GetContractAddr().String()] = true
+	}
+
+	seenAccrued := make(map[string]bool)
+	for _, ar := range gs.AccruedRevenues {
+		if err := ar.Validate(); err != nil {
+			return err
+		}
+
+		// only one accrued revenue per contract and withdrawer
+		key := ar.GetContractAddr().String() + ar.GetWithdrawerAddr().String()
+		if seenAccrued[key] {
+			return fmt.Errorf(
+				"accrued revenue duplicated on genesis for contract '%s' and withdrawer '%s'",
+				ar.ContractAddress, ar.WithdrawerAddress,
+			)
+		}
+
+		seenAccrued[key] = true
 	}
 
 	return gs.Params.Validate()
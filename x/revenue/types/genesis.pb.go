@@ -30,6 +30,10 @@ type GenesisState struct {
 	Params Params `protobuf:"bytes,1,opt,name=params,proto3" json:"params"`
 	// revenues is a slice of active registered contracts for fee distribution
 	Revenues []Revenue `protobuf:"bytes,2,rep,name=revenues,proto3" json:"revenues"`
+	// derived_contracts is a slice of contracts that inherit the revenue of a registered factory
+	DerivedContracts []DerivedContract `protobuf:"bytes,3,rep,name=derived_contracts,json=derivedContracts,proto3" json:"derived_contracts"`
+	// accrued_revenues is a slice of developer fees escrowed for withdrawers
+	AccruedRevenues []AccruedRevenue `protobuf:"bytes,4,rep,name=accrued_revenues,json=accruedRevenues,proto3" json:"accrued_revenues"`
 }
 
 func (m *GenesisState) Reset()         { *m = GenesisState{} }
@@ -79,6 +83,20 @@ func (m *GenesisState) GetRevenues() []Revenue {
 	return nil
 }
 
+func (m *GenesisState) GetDerivedContracts() []DerivedContract {
+	if m != nil {
+		return m.DerivedContracts
+	}
+	return nil
+}
+
+func (m *GenesisState) GetAccruedRevenues() []AccruedRevenue {
+	if m != nil {
+		return m.AccruedRevenues
+	}
+	return nil
+}
+
 // Params defines the revenue module params
 type Params struct {
 	// enable_revenue defines a parameter to enable the revenue module
@@ -89,6 +107,10 @@ type Params struct {
 	// addr_derivation_cost_create defines the cost of address derivation for
 	// verifying the contract deployer at fee registration
 	AddrDerivationCostCreate uint64 `protobuf:"varint,3,opt,name=addr_derivation_cost_create,json=addrDerivationCostCreate,proto3" json:"addr_derivation_cost_create,omitempty"`
+	// enable_internal_revenue_attribution defines a parameter to split the
+	// developer fees of a transaction evenly among its recipient and the
+	// registered contracts that emitted logs during its execution
+	EnableInternalRevenueAttribution bool `protobuf:"varint,4,opt,name=enable_internal_revenue_attribution,json=enableInternalRevenueAttribution,proto3" json:"enable_internal_revenue_attribution,omitempty"`
 }
 
 func (m *Params) Reset()         { *m = Params{} }
@@ -138,6 +160,13 @@ func (m *Params) GetAddrDerivationCostCreate() uint64 {
 	return 0
 }
 
+func (m *Params) GetEnableInternalRevenueAttribution() bool {
+	if m != nil {
+		return m.EnableInternalRevenueAttribution
+	}
+	return false
+}
+
 func init() {
 	proto.RegisterType((*GenesisState)(nil), "evmos.revenue.v1.GenesisState")
 	proto.RegisterType((*Params)(nil), "evmos.revenue.v1.Params")
@@ -146,29 +175,35 @@ func init() {
 func init() { proto.RegisterFile("evmos/revenue/v1/genesis.proto", fileDescriptor_649d64d9c3438055) }
 
 var fileDescriptor_649d64d9c3438055 = []byte{
-	// 351 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x64, 0x91, 0x4f, 0x4b, 0xf3, 0x40,
-	0x10, 0xc6, 0xb3, 0x6f, 0x4b, 0xe9, 0xbb, 0xf5, 0x4f, 0x09, 0x1e, 0x62, 0x85, 0xb4, 0x14, 0x94,
-	0x20, 0xb8, 0xb1, 0x15, 0xbc, 0x88, 0x97, 0xb6, 0xe0, 0x55, 0xd2, 0x93, 0x5e, 0xc2, 0x36, 0x19,
-	0xd2, 0x60, 0x9b, 0x0d, 0xbb, 0xdb, 0xa0, 0x67, 0xbf, 0x80, 0x1f, 0xab, 0xde, 0x7a, 0x14, 0x0f,
-	0x45, 0xda, 0x2f, 0x22, 0xd9, 0x8d, 0x41, 0xec, 0x25, 0x19, 0xe6, 0x79, 0x9e, 0xdf, 0x0c, 0x3b,
-	0xd8, 0x86, 0x6c, 0xce, 0x84, 0xcb, 0x21, 0x83, 0x64, 0x01, 0x6e, 0xd6, 0x73, 0x23, 0x48, 0x40,
-	0xc4, 0x82, 0xa4, 0x9c, 0x49, 0x66, 0x36, 0x95, 0x4e, 0x0a, 0x9d, 0x64, 0xbd, 0xd6, 0x6e, 0xe2,
-	0x47, 0x54, 0x89, 0xd6, 0x51, 0xc4, 0x22, 0xa6, 0x4a, 0x37, 0xaf, 0x74, 0xb7, 0xfb, 0x8a, 0xf0,
-	0xde, 0x9d, 0x26, 0x8f, 0x25, 0x95, 0x60, 0x5e, 0xe3, 0x5a, 0x4a, 0x39, 0x9d, 0x0b, 0x0b, 0x75,
-	0x90, 0xd3, 0xe8, 0x5b, 0xe4, 0xef, 0x24, 0x72, 0xaf, 0xf4, 0x41, 0x75, 0xb9, 0x6e, 0x1b, 0x5e,
-	0xe1, 0x36, 0x6f, 0x70, 0xbd, 0xb0, 0x08, 0xeb, 0x5f, 0xa7, 0xe2, 0x34, 0xfa, 0xc7, 0xbb, 0x49,
-	0x4f, 0x97, 0x45, 0xb4, 0x0c, 0x74, 0xdf, 0x11, 0xae, 0x69, 0xaa, 0x79, 0x8a, 0x0f, 0x20, 0xa1,
-	0x93, 0x19, 0xf8, 0x85, 0xaa, 0xf6, 0xa8, 0x7b, 0xfb, 0xba, 0x5b, 0x10, 0xcc, 0x07, 0xdc, 0x0c,
-	0x21, 0x83, 0x19, 0x4b, 0x81, 0xfb, 0x62, 0x4a, 0xb9, 0x1a, 0x8b, 0x9c, 0xff, 0x03, 0x92, 0xb3,
-	0x3f, 0xd7, 0xed, 0xb3, 0x28, 0x96, 0xd3, 0xc5, 0x84, 0x04, 0x6c, 0xee, 0x06, 0x4c, 0xe4, 0x6f,
-	0xa3, 0x7f, 0x17, 0x22, 0x7c, 0x72, 0xe5, 0x4b, 0x0a, 0x82, 0x8c, 0x20, 0xf0, 0x0e, 0x4b, 0xce,
-	0x58, 0x61, 0xcc, 0x5b, 0x7c, 0x42, 0xc3, 0x90, 0xfb, 0x21, 0xf0, 0x38, 0xa3, 0x32, 0x66, 0x89,
-	0x1f, 0x30, 0x21, 0xfd, 0x80, 0x03, 0x95, 0x60, 0x55, 0x3a, 0xc8, 0xa9, 0x7a, 0x56, 0x6e, 0x19,
-	0x95, 0x8e, 0x21, 0x13, 0x72, 0xa8, 0xf4, 0xc1, 0x68, 0xb9, 0xb1, 0xd1, 0x6a, 0x63, 0xa3, 0xaf,
-	0x8d, 0x8d, 0xde, 0xb6, 0xb6, 0xb1, 0xda, 0xda, 0xc6, 0xc7, 0xd6, 0x36, 0x1e, 0xcf, 0x7f, 0x6d,
-	0xa4, 0x8f, 0xa5, 0xbf, 0x59, 0xef, 0xd2, 0x7d, 0x2e, 0x0f, 0xa7, 0x36, 0x9b, 0xd4, 0xd4, 0x79,
-	0xae, 0xbe, 0x03, 0x00, 0x00, 0xff, 0xff, 0x16, 0xe7, 0x21, 0x0b, 0x08, 0x02, 0x00, 0x00,
+	// 447 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x64, 0x92, 0xc1, 0x8e, 0xd3, 0x30,
+	0x10, 0x86, 0x9b, 0xb6, 0xaa, 0x16, 0x2f, 0xb0, 0xc5, 0xe2, 0x10, 0x16, 0x29, 0x1b, 0x16, 0x81,
+	0x2a, 0x24, 0x1c, 0xba, 0x48, 0x5c, 0x10, 0x87, 0x6d, 0x2b, 0x21, 0x0e, 0x48, 0x90, 0xe5, 0x02,
+	0x97, 0xc8, 0xb1, 0x47, 0xd9, 0x88, 0x36, 0x8e, 0x6c, 0x27, 0x82, 0xb7, 0xe0, 0xc8, 0x43, 0xf0,
+	0x20, 0x7b, 0xdc, 0x23, 0xe2, 0x50, 0xa1, 0xf6, 0x45, 0x50, 0x6c, 0x37, 0x50, 0x72, 0x69, 0x47,
+	0x33, 0xdf, 0xff, 0xdb, 0xbf, 0x33, 0x28, 0x80, 0x7a, 0x25, 0x54, 0x24, 0xa1, 0x86, 0xa2, 0x82,
+	0xa8, 0x9e, 0x46, 0x19, 0x14, 0xa0, 0x72, 0x45, 0x4a, 0x29, 0xb4, 0xc0, 0x63, 0x33, 0x27, 0x6e,
+	0x4e, 0xea, 0xe9, 0x71, 0x57, 0xb1, 0x1b, 0x1a, 0xc5, 0xf1, 0xdd, 0x4c, 0x64, 0xc2, 0x94, 0x51,
+	0x53, 0xd9, 0xee, 0xe9, 0x8f, 0x3e, 0xba, 0xf9, 0xda, 0x3a, 0x5f, 0x68, 0xaa, 0x01, 0xbf, 0x40,
+	0xa3, 0x92, 0x4a, 0xba, 0x52, 0xbe, 0x17, 0x7a, 0x93, 0xc3, 0x33, 0x9f, 0xfc, 0x7f, 0x12, 0x79,
+	0x67, 0xe6, 0xb3, 0xe1, 0xd5, 0xfa, 0xa4, 0x17, 0x3b, 0x1a, 0xbf, 0x44, 0x07, 0x0e, 0x51, 0x7e,
+	0x3f, 0x1c, 0x4c, 0x0e, 0xcf, 0xee, 0x75, 0x95, 0xb1, 0x2d, 0x9d, 0xb4, 0x15, 0xe0, 0x0f, 0xe8,
+	0x0e, 0x07, 0x99, 0xd7, 0xc0, 0x13, 0x26, 0x0a, 0x2d, 0x29, 0xd3, 0xca, 0x1f, 0x18, 0x97, 0x07,
+	0x5d, 0x97, 0x85, 0x45, 0xe7, 0x8e, 0x74, 0x6e, 0x63, 0xbe, 0xdf, 0x56, 0xf8, 0x3d, 0x1a, 0x53,
+	0xc6, 0x64, 0x05, 0x3c, 0x69, 0xaf, 0x36, 0x34, 0xa6, 0x61, 0xd7, 0xf4, 0xdc, 0x92, 0xfb, 0x37,
+	0x3c, 0xa2, 0x7b, 0x5d, 0x75, 0xfa, 0xbd, 0x8f, 0x46, 0x36, 0x3e, 0x7e, 0x84, 0x6e, 0x43, 0x41,
+	0xd3, 0x25, 0xec, 0xcc, 0xcd, 0x83, 0x1d, 0xc4, 0xb7, 0x6c, 0xd7, 0x49, 0xf0, 0x47, 0x34, 0xe6,
+	0x50, 0xc3, 0x52, 0x94, 0x20, 0x13, 0x75, 0x49, 0xa5, 0x79, 0x1f, 0x6f, 0x72, 0x63, 0x46, 0x9a,
+	0x23, 0x7e, 0xad, 0x4f, 0x1e, 0x67, 0xb9, 0xbe, 0xac, 0x52, 0xc2, 0xc4, 0x2a, 0x62, 0x42, 0x35,
+	0x1f, 0xd1, 0xfe, 0x3d, 0x55, 0xfc, 0x73, 0xa4, 0xbf, 0x96, 0xa0, 0xc8, 0x02, 0x58, 0x7c, 0xd4,
+	0xfa, 0x5c, 0x18, 0x1b, 0xfc, 0x0a, 0xdd, 0xa7, 0x9c, 0xcb, 0xc4, 0x04, 0xa7, 0x3a, 0x17, 0x45,
+	0xc2, 0x84, 0xd2, 0x09, 0x93, 0x40, 0x35, 0xf8, 0x83, 0xd0, 0x9b, 0x0c, 0x63, 0xbf, 0x41, 0x16,
+	0x2d, 0x31, 0x17, 0x4a, 0xcf, 0xcd, 0x1c, 0xbf, 0x45, 0x0f, 0x5d, 0x80, 0xbc, 0xd0, 0x20, 0x0b,
+	0xba, 0xdc, 0x25, 0x49, 0xa8, 0xd6, 0x32, 0x4f, 0xab, 0x46, 0xe0, 0x0f, 0x4d, 0xaa, 0xd0, 0xa2,
+	0x6f, 0x1c, 0xe9, 0xd2, 0x9d, 0xff, 0xe5, 0x66, 0x8b, 0xab, 0x4d, 0xe0, 0x5d, 0x6f, 0x02, 0xef,
+	0xf7, 0x26, 0xf0, 0xbe, 0x6d, 0x83, 0xde, 0xf5, 0x36, 0xe8, 0xfd, 0xdc, 0x06, 0xbd, 0x4f, 0x4f,
+	0xfe, 0x09, 0x68, 0x97, 0xd4, 0xfe, 0xd6, 0xd3, 0x67, 0xd1, 0x97, 0x76, 0x61, 0x4d, 0xd0, 0x74,
+	0x64, 0xd6, 0xf2, 0xf9, 0x9f, 0x01, 0x00, 0xe0, 0xb3, 0xe1, 0x56, 0x00, 0x03, 0x00, 0x00,
 }
 
 func (m *GenesisState) Marshal() (dAtA []byte, err error) {
@@ -191,6 +226,34 @@ func (m *GenesisState) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.AccruedRevenues) > 0 {
+		for iNdEx := len(m.AccruedRevenues) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.AccruedRevenues[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x22
+		}
+	}
+	if len(m.DerivedContracts) > 0 {
+		for iNdEx := len(m.DerivedContracts) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.DerivedContracts[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
 	if len(m.Revenues) > 0 {
 		for iNdEx := len(m.Revenues) - 1; iNdEx >= 0; iNdEx-- {
 			{
@@ -238,6 +301,16 @@ func (m *Params) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.EnableInternalRevenueAttribution {
+		i--
+		if m.EnableInternalRevenueAttribution {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x20
+	}
 	if m.AddrDerivationCostCreate != 0 {
 		i = encodeVarintGenesis(dAtA, i, uint64(m.AddrDerivationCostCreate))
 		i--
@@ -291,6 +364,18 @@ func (m *GenesisState) Size() (n int) {
 			n += 1 + l + sovGenesis(uint64(l))
 		}
 	}
+	if len(m.DerivedContracts) > 0 {
+		for _, e := range m.DerivedContracts {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.AccruedRevenues) > 0 {
+		for _, e := range m.AccruedRevenues {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
 	return n
 }
 
@@ -308,6 +393,9 @@ func (m *Params) Size() (n int) {
 	if m.AddrDerivationCostCreate != 0 {
 		n += 1 + sovGenesis(uint64(m.AddrDerivationCostCreate))
 	}
+	if m.EnableInternalRevenueAttribution {
+		n += 2
+	}
 	return n
 }
 
@@ -413,6 +501,74 @@ func (m *GenesisState) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DerivedContracts", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.DerivedContracts = append(m.DerivedContracts, DerivedContract{})
+			if err := m.DerivedContracts[len(m.DerivedContracts)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AccruedRevenues", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.AccruedRevenues = append(m.AccruedRevenues, AccruedRevenue{})
+			if err := m.AccruedRevenues[len(m.AccruedRevenues)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenesis(dAtA[iNdEx:])
@@ -536,6 +692,26 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 					break
 				}
 			}
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EnableInternalRevenueAttribution", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.EnableInternalRevenueAttribution = bool(v != 0)
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenesis(dAtA[iNdEx:])
@@ -24,7 +24,7 @@ func (suite *GenesisTestSuite) SetupTest() {
 }
 
 func (suite *GenesisTestSuite) TestValidateGenesis() {
-	newGen := NewGenesisState(DefaultParams(), []Revenue{})
+	newGen := NewGenesisState(DefaultParams(), []Revenue{}, []DerivedContract{}, []AccruedRevenue{})
 	testCases := []struct {
 		name     string
 		genState *GenesisState
@@ -66,6 +66,27 @@ func (suite *GenesisTestSuite) TestValidateGenesis() {
 			},
 			expPass: true,
 		},
+		{
+			name: "valid genesis - with derived contract",
+			genState: &GenesisState{
+				Params: DefaultParams(),
+				Revenues: []Revenue{
+					{
+						ContractAddress: "0xdac17f958d2ee523a2206206994597c13d831ec7",
+						DeployerAddress: suite.address1,
+						Factory:         true,
+						Nonce:           2,
+					},
+				},
+				DerivedContracts: []DerivedContract{
+					{
+						ContractAddress: "0xdac17f958d2ee523a2206206994597c13d831ec8",
+						FactoryAddress:  "0xdAC17F958D2ee523a2206206994597C13D831ec7",
+					},
+				},
+			},
+			expPass: true,
+		},
 		{
 			name:     "empty genesis",
 			genState: &GenesisState{},
@@ -159,6 +180,113 @@ func (suite *GenesisTestSuite) TestValidateGenesis() {
 			},
 			expPass: false,
 		},
+		{
+			name: "invalid genesis - duplicated derived contract",
+			genState: &GenesisState{
+				Params: DefaultParams(),
+				Revenues: []Revenue{
+					{
+						ContractAddress: "0xdac17f958d2ee523a2206206994597c13d831ec7",
+						DeployerAddress: suite.address1,
+						Factory:         true,
+					},
+				},
+				DerivedContracts: []DerivedContract{
+					{
+						ContractAddress: "0xdac17f958d2ee523a2206206994597c13d831ec8",
+						FactoryAddress:  "0xdac17f958d2ee523a2206206994597c13d831ec7",
+					},
+					{
+						ContractAddress: "0xdAC17F958D2ee523a2206206994597C13D831ec8",
+						FactoryAddress:  "0xdac17f958d2ee523a2206206994597c13d831ec7",
+					},
+				},
+			},
+			expPass: false,
+		},
+		{
+			name: "valid genesis - with accrued revenues",
+			genState: &GenesisState{
+				Params: DefaultParams(),
+				AccruedRevenues: []AccruedRevenue{
+					{
+						ContractAddress:   "0xdac17f958d2ee523a2206206994597c13d831ec7",
+						WithdrawerAddress: suite.address1,
+						Amount:            sdk.NewCoins(sdk.NewCoin("aevmos", sdk.NewInt(100))),
+					},
+					{
+						ContractAddress:   "0xdac17f958d2ee523a2206206994597c13d831ec7",
+						WithdrawerAddress: suite.address2,
+						Amount:            sdk.NewCoins(sdk.NewCoin("aevmos", sdk.NewInt(100))),
+					},
+				},
+			},
+			expPass: true,
+		},
+		{
+			name: "invalid genesis - duplicated accrued revenue",
+			genState: &GenesisState{
+				Params: DefaultParams(),
+				AccruedRevenues: []AccruedRevenue{
+					{
+						ContractAddress:   "0xdac17f958d2ee523a2206206994597c13d831ec7",
+						WithdrawerAddress: suite.address1,
+						Amount:            sdk.NewCoins(sdk.NewCoin("aevmos", sdk.NewInt(100))),
+					},
+					{
+						ContractAddress:   "0xdac17f958d2ee523a2206206994597c13d831ec7",
+						WithdrawerAddress: suite.address1,
+						Amount:            sdk.NewCoins(sdk.NewCoin("aevmos", sdk.NewInt(200))),
+					},
+				},
+			},
+			expPass: false,
+		},
+		{
+			name: "invalid genesis - empty accrued revenue",
+			genState: &GenesisState{
+				Params: DefaultParams(),
+				AccruedRevenues: []AccruedRevenue{
+					{
+						ContractAddress:   "0xdac17f958d2ee523a2206206994597c13d831ec7",
+						WithdrawerAddress: suite.address1,
+					},
+				},
+			},
+			expPass: false,
+		},
+		{
+			name: "invalid genesis - derived contract of unregistered factory",
+			genState: &GenesisState{
+				Params: DefaultParams(),
+				DerivedContracts: []DerivedContract{
+					{
+						ContractAddress: "0xdac17f958d2ee523a2206206994597c13d831ec8",
+						FactoryAddress:  "0xdac17f958d2ee523a2206206994597c13d831ec7",
+					},
+				},
+			},
+			expPass: false,
+		},
+		{
+			name: "invalid genesis - derived contract of contract not registered as factory",
+			genState: &GenesisState{
+				Params: DefaultParams(),
+				Revenues: []Revenue{
+					{
+						ContractAddress: "0xdac17f958d2ee523a2206206994597c13d831ec7",
+						DeployerAddress: suite.address1,
+					},
+				},
+				DerivedContracts: []DerivedContract{
+					{
+						ContractAddress: "0xdac17f958d2ee523a2206206994597c13d831ec8",
+						FactoryAddress:  "0xdac17f958d2ee523a2206206994597c13d831ec7",
+					},
+				},
+			},
+			expPass: false,
+		},
 	}
 
 	for _, tc := range testCases {
@@ -1,6 +1,9 @@
 package types
 
-import sdk "github.com/cosmos/cosmos-sdk/types"
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/ethereum/go-ethereum/common"
+)
 
 // constants
 const (
@@ -12,6 +15,9 @@ const (
 
 	// RouterKey to be used for message routing
 	RouterKey = ModuleName
+
+	// MaxWithdrawers is the maximum number of withdrawers of a revenue
+	MaxWithdrawers = 10
 )
 
 // prefix bytes for the fees persistent store
@@ -19,13 +25,22 @@ const (
 	prefixRevenue = iota + 1
 	prefixDeployer
 	prefixWithdrawer
+	prefixDerivedContract
+	prefixFactory
+	prefixAccruedRevenue
+	prefixWithdrawerAccruedRevenue
 )
 
 // KVStore key prefixes
 var (
-	KeyPrefixRevenue    = []byte{prefixRevenue}
-	KeyPrefixDeployer   = []byte{prefixDeployer}
-	KeyPrefixWithdrawer = []byte{prefixWithdrawer}
+	KeyPrefixRevenue         = []byte{prefixRevenue}
+	KeyPrefixDeployer        = []byte{prefixDeployer}
+	KeyPrefixWithdrawer      = []byte{prefixWithdrawer}
+	KeyPrefixDerivedContract = []byte{prefixDerivedContract}
+	KeyPrefixFactory         = []byte{prefixFactory}
+
+	KeyPrefixAccruedRevenue           = []byte{prefixAccruedRevenue}
+	KeyPrefixWithdrawerAccruedRevenue = []byte{prefixWithdrawerAccruedRevenue}
 )
 
 // GetKeyPrefixDeployer returns the KVStore key prefix for storing
@@ -39,3 +54,21 @@ func GetKeyPrefixDeployer(deployerAddress sdk.AccAddress) []byte {
 func GetKeyPrefixWithdrawer(withdrawerAddress sdk.AccAddress) []byte {
 	return append(KeyPrefixWithdrawer, withdrawerAddress.Bytes()...)
 }
+
+// GetKeyPrefixFactory returns the KVStore key prefix for storing the derived
+// contracts of a registered factory
+func GetKeyPrefixFactory(factory common.Address) []byte {
+	return append(KeyPrefixFactory, factory.Bytes()...)
+}
+
+// GetKeyPrefixAccruedRevenue returns the KVStore key prefix for storing the
+// accrued revenues of a contract
+func GetKeyPrefixAccruedRevenue(contract common.Address) []byte {
+	return append(KeyPrefixAccruedRevenue, contract.Bytes()...)
+}
+
+// GetKeyPrefixWithdrawerAccruedRevenue returns the KVStore key prefix for
+// storing the contracts with accrued revenue for a withdrawer
+func GetKeyPrefixWithdrawerAccruedRevenue(withdrawerAddress sdk.AccAddress) []byte {
+	return append(KeyPrefixWithdrawerAccruedRevenue, withdrawerAddress.Bytes()...)
+}
@@ -5,6 +5,7 @@ import (
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	errortypes "github.com/cosmos/cosmos-sdk/types/errors"
 	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
 	ethermint "github.com/evmos/ethermint/types"
 )
 
@@ -12,12 +13,16 @@ var (
 	_ sdk.Msg = &MsgRegisterRevenue{}
 	_ sdk.Msg = &MsgCancelRevenue{}
 	_ sdk.Msg = &MsgUpdateRevenue{}
+	_ sdk.Msg = &MsgRegisterDerivedContract{}
+	_ sdk.Msg = &MsgWithdrawRevenue{}
 )
 
 const (
 	TypeMsgRegisterRevenue = "register_revenue"
 	TypeMsgCancelRevenue   = "cancel_revenue"
 	TypeMsgUpdateRevenue   = "update_revenue"
+	TypeMsgRegisterDerived = "register_derived_contract"
+	TypeMsgWithdrawRevenue = "withdraw_revenue"
 )
 
 // NewMsgRegisterRevenue creates new instance of MsgRegisterRevenue
@@ -62,6 +67,10 @@ func (msg MsgRegisterRevenue) ValidateBasic() error {
 		}
 	}
 
+	if err := validateMsgWithdrawers(msg.WithdrawerAddress, msg.Withdrawers); err != nil {
+		return err
+	}
+
 	if len(msg.Nonces) < 1 {
 		return errorsmod.Wrapf(errortypes.ErrInvalidRequest, "invalid nonces - empty array")
 	}
@@ -154,11 +163,13 @@ func (msg MsgUpdateRevenue) ValidateBasic() error {
 		return errorsmod.Wrapf(err, "invalid contract address %s", msg.ContractAddress)
 	}
 
-	if _, err := sdk.AccAddressFromBech32(msg.WithdrawerAddress); err != nil {
-		return errorsmod.Wrapf(err, "invalid withdraw address %s", msg.WithdrawerAddress)
+	if len(msg.Withdrawers) == 0 {
+		if _, err := sdk.AccAddressFromBech32(msg.WithdrawerAddress); err != nil {
+			return errorsmod.Wrapf(err, "invalid withdraw address %s", msg.WithdrawerAddress)
+		}
 	}
 
-	return nil
+	return validateMsgWithdrawers(msg.WithdrawerAddress, msg.Withdrawers)
 }
 
 // GetSignBytes encodes the message for signing
@@ -171,3 +182,133 @@ func (msg MsgUpdateRevenue) GetSigners() []sdk.AccAddress {
 	from := sdk.MustAccAddressFromBech32(msg.DeployerAddress)
 	return []sdk.AccAddress{from}
 }
+
+// NewMsgRegisterDerivedContract creates new instance of
+// MsgRegisterDerivedContract
+func NewMsgRegisterDerivedContract(
+	contract,
+	creator common.Address,
+	sender sdk.AccAddress,
+	salt,
+	initCodeHash common.Hash,
+) *MsgRegisterDerivedContract {
+	return &MsgRegisterDerivedContract{
+		SenderAddress:   sender.String(),
+		ContractAddress: contract.String(),
+		CreatorAddress:  creator.String(),
+		Salt:            salt.Hex(),
+		InitCodeHash:    initCodeHash.Hex(),
+	}
+}
+
+// Route returns the name of the module
+func (msg MsgRegisterDerivedContract) Route() string { return RouterKey }
+
+// Type returns the the action
+func (msg MsgRegisterDerivedContract) Type() string { return TypeMsgRegisterDerived }
+
+// ValidateBasic runs stateless checks on the message
+func (msg MsgRegisterDerivedContract) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(msg.SenderAddress); err != nil {
+		return errorsmod.Wrapf(err, "invalid sender address %s", msg.SenderAddress)
+	}
+
+	if err := ethermint.ValidateNonZeroAddress(msg.ContractAddress); err != nil {
+		return errorsmod.Wrapf(err, "invalid contract address %s", msg.ContractAddress)
+	}
+
+	if err := ethermint.ValidateNonZeroAddress(msg.CreatorAddress); err != nil {
+		return errorsmod.Wrapf(err, "invalid creator address %s", msg.CreatorAddress)
+	}
+
+	if bz, err := hexutil.Decode(msg.Salt); err != nil || len(bz) != common.HashLength {
+		return errorsmod.Wrapf(errortypes.ErrInvalidRequest, "invalid salt %s", msg.Salt)
+	}
+
+	if bz, err := hexutil.Decode(msg.InitCodeHash); err != nil || len(bz) != common.HashLength {
+		return errorsmod.Wrapf(errortypes.ErrInvalidRequest, "invalid init code hash %s", msg.InitCodeHash)
+	}
+
+	return nil
+}
+
+// GetSignBytes encodes the message for signing
+func (msg *MsgRegisterDerivedContract) GetSignBytes() []byte {
+	return sdk.MustSortJSON(AminoCdc.MustMarshalJSON(msg))
+}
+
+// GetSigners defines whose signature is required
+func (msg MsgRegisterDerivedContract) GetSigners() []sdk.AccAddress {
+	from := sdk.MustAccAddressFromBech32(msg.SenderAddress)
+	return []sdk.AccAddress{from}
+}
+
+// NewMsgWithdrawRevenue creates new instance of MsgWithdrawRevenue. If the
+// contract is nil, the revenue accrued from all contracts is withdrawn.
+func NewMsgWithdrawRevenue(
+	withdrawer sdk.AccAddress,
+	contract *common.Address,
+) *MsgWithdrawRevenue {
+	contractAddress := ""
+	if contract != nil {
+		contractAddress = contract.String()
+	}
+
+	return &MsgWithdrawRevenue{
+		WithdrawerAddress: withdrawer.String(),
+		ContractAddress:   contractAddress,
+	}
+}
+
+// Route returns the name of the module
+func (msg MsgWithdrawRevenue) Route() string { return RouterKey }
+
+// Type returns the the action
+func (msg MsgWithdrawRevenue) Type() string { return TypeMsgWithdrawRevenue }
+
+// ValidateBasic runs stateless checks on the message
+func (msg MsgWithdrawRevenue) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(msg.WithdrawerAddress); err != nil {
+		return errorsmod.Wrapf(err, "invalid withdraw address %s", msg.WithdrawerAddress)
+	}
+
+	if msg.ContractAddress != "" {
+		if err := ethermint.ValidateNonZeroAddress(msg.ContractAddress); err != nil {
+			return errorsmod.Wrapf(err, "invalid contract address %s", msg.ContractAddress)
+		}
+	}
+
+	return nil
+}
+
+// GetSignBytes encodes the message for signing
+func (msg *MsgWithdrawRevenue) GetSignBytes() []byte {
+	return sdk.MustSortJSON(AminoCdc.MustMarshalJSON(msg))
+}
+
+// GetSigners defines whose signature is required
+func (msg MsgWithdrawRevenue) GetSigners() []sdk.AccAddress {
+	from := sdk.MustAccAddressFromBech32(msg.WithdrawerAddress)
+	return []sdk.AccAddress{from}
+}
+
+// validateMsgWithdrawers checks that the weighted list of withdrawers of a
+// message is valid and not set along with a single withdraw address
+func validateMsgWithdrawers(withdrawerAddress string, withdrawers []Withdrawer) error {
+	if len(withdrawers) == 0 {
+		return nil
+	}
+
+	if withdrawerAddress != "" {
+		return errorsmod.Wrapf(
+			errortypes.ErrInvalidRequest,
+			"invalid withdrawers - cannot be set along with withdraw address %s", withdrawerAddress,
+		)
+	}
+
+	if err := ValidateWithdrawers(withdrawers); err != nil {
+		return errorsmod.Wrapf(errortypes.ErrInvalidRequest, "invalid withdrawers - %s", err)
+	}
+
+	return nil
+}
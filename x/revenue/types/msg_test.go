@@ -290,3 +290,155 @@ func (suite *MsgsTestSuite) TestMsgUpdateRevenueNew() {
 		}
 	}
 }
+
+func (suite *MsgsTestSuite) TestMsgRegisterDerivedContractGetters() {
+	msgInvalid := MsgRegisterDerivedContract{}
+	msg := NewMsgRegisterDerivedContract(
+		suite.contract,
+		tests.GenerateAddress(),
+		suite.deployer,
+		common.Hash{},
+		common.Hash{},
+	)
+	suite.Require().Equal(RouterKey, msg.Route())
+	suite.Require().Equal(TypeMsgRegisterDerived, msg.Type())
+	suite.Require().NotNil(msgInvalid.GetSignBytes())
+	suite.Require().NotNil(msg.GetSigners())
+}
+
+func (suite *MsgsTestSuite) TestMsgRegisterDerivedContractNew() {
+	creator := tests.GenerateAddress().String()
+	salt := common.BytesToHash([]byte("salt")).Hex()
+	initCodeHash := crypto.Keccak256Hash([]byte("code")).Hex()
+
+	testCases := []struct {
+		msg          string
+		sender       string
+		contract     string
+		creator      string
+		salt         string
+		initCodeHash string
+		expectPass   bool
+	}{
+		{"pass", suite.deployerStr, suite.contract.String(), creator, salt, initCodeHash, true},
+		{"invalid sender address", "", suite.contract.String(), creator, salt, initCodeHash, false},
+		{"invalid contract address", suite.deployerStr, "", creator, salt, initCodeHash, false},
+		{"invalid creator address", suite.deployerStr, suite.contract.String(), "0x0000000000000000000000000000000000000000", salt, initCodeHash, false},
+		{"invalid salt", suite.deployerStr, suite.contract.String(), creator, "0x01", initCodeHash, false},
+		{"invalid init code hash", suite.deployerStr, suite.contract.String(), creator, salt, "code", false},
+	}
+
+	for i, tc := range testCases {
+		tx := MsgRegisterDerivedContract{
+			SenderAddress:   tc.sender,
+			ContractAddress: tc.contract,
+			CreatorAddress:  tc.creator,
+			Salt:            tc.salt,
+			InitCodeHash:    tc.initCodeHash,
+		}
+		err := tx.ValidateBasic()
+
+		if tc.expectPass {
+			suite.Require().NoError(err, "valid test %d failed: %s", i, tc.msg)
+		} else {
+			suite.Require().Error(err, "invalid test %d passed: %s", i, tc.msg)
+			suite.Require().Contains(err.Error(), tc.msg)
+		}
+	}
+}
+
+func (suite *MsgsTestSuite) TestMsgWithdrawers() {
+	withdrawers := []Withdrawer{
+		NewWithdrawer(suite.deployer, sdk.NewDecWithPrec(6, 1)),
+		NewWithdrawer(sdk.AccAddress(tests.GenerateAddress().Bytes()), sdk.NewDecWithPrec(4, 1)),
+	}
+
+	testCases := []struct {
+		msg         string
+		withdraw    string
+		withdrawers []Withdrawer
+		expectPass  bool
+	}{
+		{
+			"pass - weighted withdrawers",
+			"",
+			withdrawers,
+			true,
+		},
+		{
+			"invalid withdrawers - cannot be set along with withdraw address",
+			suite.withdrawerStr,
+			withdrawers,
+			false,
+		},
+		{
+			"invalid withdrawers - withdrawer weights must sum up to 1",
+			"",
+			withdrawers[:1],
+			false,
+		},
+	}
+
+	for i, tc := range testCases {
+		register := MsgRegisterRevenue{
+			ContractAddress:   suite.contract.String(),
+			DeployerAddress:   suite.deployerStr,
+			WithdrawerAddress: tc.withdraw,
+			Nonces:            []uint64{1},
+			Withdrawers:       tc.withdrawers,
+		}
+		update := MsgUpdateRevenue{
+			ContractAddress:   suite.contract.String(),
+			DeployerAddress:   suite.deployerStr,
+			WithdrawerAddress: tc.withdraw,
+			Withdrawers:       tc.withdrawers,
+		}
+
+		for _, err := range []error{register.ValidateBasic(), update.ValidateBasic()} {
+			if tc.expectPass {
+				suite.Require().NoError(err, "valid test %d failed: %s", i, tc.msg)
+			} else {
+				suite.Require().Error(err, "invalid test %d passed: %s", i, tc.msg)
+				suite.Require().Contains(err.Error(), tc.msg)
+			}
+		}
+	}
+}
+
+func (suite *MsgsTestSuite) TestMsgWithdrawRevenueGetters() {
+	msgInvalid := MsgWithdrawRevenue{}
+	msg := NewMsgWithdrawRevenue(suite.deployer, &suite.contract)
+	suite.Require().Equal(RouterKey, msg.Route())
+	suite.Require().Equal(TypeMsgWithdrawRevenue, msg.Type())
+	suite.Require().NotNil(msgInvalid.GetSignBytes())
+	suite.Require().NotNil(msg.GetSigners())
+}
+
+func (suite *MsgsTestSuite) TestMsgWithdrawRevenueNew() {
+	testCases := []struct {
+		msg        string
+		withdraw   string
+		contract   string
+		expectPass bool
+	}{
+		{"pass", suite.withdrawerStr, suite.contract.String(), true},
+		{"pass - all contracts", suite.withdrawerStr, "", true},
+		{"invalid withdraw address", "", suite.contract.String(), false},
+		{"invalid contract address", suite.withdrawerStr, "0x0000000000000000000000000000000000000000", false},
+	}
+
+	for i, tc := range testCases {
+		tx := MsgWithdrawRevenue{
+			WithdrawerAddress: tc.withdraw,
+			ContractAddress:   tc.contract,
+		}
+		err := tx.ValidateBasic()
+
+		if tc.expectPass {
+			suite.Require().NoError(err, "valid test %d failed: %s", i, tc.msg)
+		} else {
+			suite.Require().Error(err, "invalid test %d passed: %s", i, tc.msg)
+			suite.Require().Contains(err.Error(), tc.msg)
+		}
+	}
+}
@@ -13,11 +13,16 @@ var (
 	DefaultDeveloperShares = sdk.NewDecWithPrec(50, 2) // 50%
 	// Cost for executing `crypto.CreateAddress` must be at least 36 gas for the
 	// contained keccak256(word) operation
-	DefaultAddrDerivationCostCreate = uint64(50)
+	DefaultAddrDerivationCostCreate         = uint64(50)
+	DefaultEnableInternalRevenueAttribution = false
 
 	ParamStoreKeyEnableRevenue            = []byte("EnableRevenue")
 	ParamStoreKeyDeveloperShares          = []byte("DeveloperShares")
 	ParamStoreKeyAddrDerivationCostCreate = []byte("AddrDerivationCostCreate")
+	// ParamStoreKeyEnableInternalRevenueAttribution is the store key of the
+	// flag that splits the developer fees among the registered contracts that
+	// emitted logs
+	ParamStoreKeyEnableInternalRevenueAttribution = []byte("EnableInternalRevenueAttribution")
 )
 
 // ParamKeyTable returns the parameter key table.
@@ -30,19 +35,22 @@ func NewParams(
 	enableRevenue bool,
 	developerShares sdk.Dec,
 	addrDerivationCostCreate uint64,
+	enableInternalRevenueAttribution bool,
 ) Params {
 	return Params{
-		EnableRevenue:            enableRevenue,
-		DeveloperShares:          developerShares,
-		AddrDerivationCostCreate: addrDerivationCostCreate,
+		EnableRevenue:                    enableRevenue,
+		DeveloperShares:                  developerShares,
+		AddrDerivationCostCreate:         addrDerivationCostCreate,
+		EnableInternalRevenueAttribution: enableInternalRevenueAttribution,
 	}
 }
 
 func DefaultParams() Params {
 	return Params{
-		EnableRevenue:            DefaultEnableRevenue,
-		DeveloperShares:          DefaultDeveloperShares,
-		AddrDerivationCostCreate: DefaultAddrDerivationCostCreate,
+		EnableRevenue:                    DefaultEnableRevenue,
+		DeveloperShares:                  DefaultDeveloperShares,
+		AddrDerivationCostCreate:         DefaultAddrDerivationCostCreate,
+		EnableInternalRevenueAttribution: DefaultEnableInternalRevenueAttribution,
 	}
 }
 
@@ -52,6 +60,7 @@ func (p *Params) ParamSetPairs() paramtypes.ParamSetPairs {
 		paramtypes.NewParamSetPair(ParamStoreKeyEnableRevenue, &p.EnableRevenue, validateBool),
 		paramtypes.NewParamSetPair(ParamStoreKeyDeveloperShares, &p.DeveloperShares, validateShares),
 		paramtypes.NewParamSetPair(ParamStoreKeyAddrDerivationCostCreate, &p.AddrDerivationCostCreate, validateUint64),
+		paramtypes.NewParamSetPair(ParamStoreKeyEnableInternalRevenueAttribution, &p.EnableInternalRevenueAttribution, validateBool),
 	}
 }
 
@@ -102,5 +111,8 @@ func (p Params) Validate() error {
 	if err := validateShares(p.DeveloperShares); err != nil {
 		return err
 	}
-	return validateUint64(p.AddrDerivationCostCreate)
+	if err := validateUint64(p.AddrDerivationCostCreate); err != nil {
+		return err
+	}
+	return validateBool(p.EnableInternalRevenueAttribution)
 }
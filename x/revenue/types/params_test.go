@@ -30,17 +30,22 @@ func TestParamsValidate(t *testing.T) {
 		{"default", DefaultParams(), false},
 		{
 			"valid: enabled",
-			NewParams(true, devShares, derivCostCreate),
+			NewParams(true, devShares, derivCostCreate, false),
 			false,
 		},
 		{
 			"valid: disabled",
-			NewParams(false, devShares, derivCostCreate),
+			NewParams(false, devShares, derivCostCreate, false),
+			false,
+		},
+		{
+			"valid: internal revenue attribution",
+			NewParams(true, devShares, derivCostCreate, true),
 			false,
 		},
 		{
 			"valid: 100% devs",
-			Params{true, sdk.NewDecFromInt(sdk.NewInt(1)), derivCostCreate},
+			Params{true, sdk.NewDecFromInt(sdk.NewInt(1)), derivCostCreate, false},
 			false,
 		},
 		{
@@ -50,17 +55,17 @@ func TestParamsValidate(t *testing.T) {
 		},
 		{
 			"invalid: share > 1",
-			Params{true, sdk.NewDecFromInt(sdk.NewInt(2)), derivCostCreate},
+			Params{true, sdk.NewDecFromInt(sdk.NewInt(2)), derivCostCreate, false},
 			true,
 		},
 		{
 			"invalid: share < 0",
-			Params{true, sdk.NewDecFromInt(sdk.NewInt(-1)), derivCostCreate},
+			Params{true, sdk.NewDecFromInt(sdk.NewInt(-1)), derivCostCreate, false},
 			true,
 		},
 		{
 			"invalid: wrong address derivation cost",
-			NewParams(true, devShares, 50),
+			NewParams(true, devShares, 50, false),
 			false,
 		},
 	}
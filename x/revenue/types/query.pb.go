@@ -530,6 +530,344 @@ func (m *QueryWithdrawerRevenuesResponse) GetPagination() *query.PageResponse {
 	return nil
 }
 
+// QueryDerivedContractsRequest is the request type for the
+// Query/DerivedContracts RPC method.
+type QueryDerivedContractsRequest struct {
+	// factory_address of a registered factory in hex format
+	FactoryAddress string `protobuf:"bytes,1,opt,name=factory_address,json=factoryAddress,proto3" json:"factory_address,omitempty"`
+	// pagination defines an optional pagination for the request.
+	Pagination *query.PageRequest `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
+}
+
+func (m *QueryDerivedContractsRequest) Reset()         { *m = QueryDerivedContractsRequest{} }
+func (m *QueryDerivedContractsRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryDerivedContractsRequest) ProtoMessage()    {}
+func (*QueryDerivedContractsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_5e4b17cda6e8e927, []int{10}
+}
+func (m *QueryDerivedContractsRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryDerivedContractsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryDerivedContractsRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryDerivedContractsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryDerivedContractsRequest.Merge(m, src)
+}
+func (m *QueryDerivedContractsRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryDerivedContractsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryDerivedContractsRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryDerivedContractsRequest proto.InternalMessageInfo
+
+func (m *QueryDerivedContractsRequest) GetFactoryAddress() string {
+	if m != nil {
+		return m.FactoryAddress
+	}
+	return ""
+}
+
+func (m *QueryDerivedContractsRequest) GetPagination() *query.PageRequest {
+	if m != nil {
+		return m.Pagination
+	}
+	return nil
+}
+
+// QueryDerivedContractsResponse is the response type for the
+// Query/DerivedContracts RPC method.
+type QueryDerivedContractsResponse struct {
+	// contract_addresses is the slice of contract addresses derived from the factory
+	ContractAddresses []string `protobuf:"bytes,1,rep,name=contract_addresses,json=contractAddresses,proto3" json:"contract_addresses,omitempty"`
+	// pagination defines the pagination in the response.
+	Pagination *query.PageResponse `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
+}
+
+func (m *QueryDerivedContractsResponse) Reset()         { *m = QueryDerivedContractsResponse{} }
+func (m *QueryDerivedContractsResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryDerivedContractsResponse) ProtoMessage()    {}
+func (*QueryDerivedContractsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_5e4b17cda6e8e927, []int{11}
+}
+func (m *QueryDerivedContractsResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryDerivedContractsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryDerivedContractsResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryDerivedContractsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryDerivedContractsResponse.Merge(m, src)
+}
+func (m *QueryDerivedContractsResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryDerivedContractsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryDerivedContractsResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryDerivedContractsResponse proto.InternalMessageInfo
+
+func (m *QueryDerivedContractsResponse) GetContractAddresses() []string {
+	if m != nil {
+		return m.ContractAddresses
+	}
+	return nil
+}
+
+func (m *QueryDerivedContractsResponse) GetPagination() *query.PageResponse {
+	if m != nil {
+		return m.Pagination
+	}
+	return nil
+}
+
+// QueryAccruedRevenuesRequest is the request type for the
+// Query/AccruedRevenues RPC method.
+type QueryAccruedRevenuesRequest struct {
+	// contract_address of a contract in hex format
+	ContractAddress string `protobuf:"bytes,1,opt,name=contract_address,json=contractAddress,proto3" json:"contract_address,omitempty"`
+	// pagination defines an optional pagination for the request.
+	Pagination *query.PageRequest `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
+}
+
+func (m *QueryAccruedRevenuesRequest) Reset()         { *m = QueryAccruedRevenuesRequest{} }
+func (m *QueryAccruedRevenuesRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryAccruedRevenuesRequest) ProtoMessage()    {}
+func (*QueryAccruedRevenuesRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_5e4b17cda6e8e927, []int{12}
+}
+func (m *QueryAccruedRevenuesRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryAccruedRevenuesRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryAccruedRevenuesRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryAccruedRevenuesRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryAccruedRevenuesRequest.Merge(m, src)
+}
+func (m *QueryAccruedRevenuesRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryAccruedRevenuesRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryAccruedRevenuesRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryAccruedRevenuesRequest proto.InternalMessageInfo
+
+func (m *QueryAccruedRevenuesRequest) GetContractAddress() string {
+	if m != nil {
+		return m.ContractAddress
+	}
+	return ""
+}
+
+func (m *QueryAccruedRevenuesRequest) GetPagination() *query.PageRequest {
+	if m != nil {
+		return m.Pagination
+	}
+	return nil
+}
+
+// QueryAccruedRevenuesResponse is the response type for the
+// Query/AccruedRevenues RPC method.
+type QueryAccruedRevenuesResponse struct {
+	// accrued_revenues is the slice of fees accrued by the contract per withdrawer
+	AccruedRevenues []AccruedRevenue `protobuf:"bytes,1,rep,name=accrued_revenues,json=accruedRevenues,proto3" json:"accrued_revenues"`
+	// pagination defines the pagination in the response.
+	Pagination *query.PageResponse `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
+}
+
+func (m *QueryAccruedRevenuesResponse) Reset()         { *m = QueryAccruedRevenuesResponse{} }
+func (m *QueryAccruedRevenuesResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryAccruedRevenuesResponse) ProtoMessage()    {}
+func (*QueryAccruedRevenuesResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_5e4b17cda6e8e927, []int{13}
+}
+func (m *QueryAccruedRevenuesResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryAccruedRevenuesResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryAccruedRevenuesResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryAccruedRevenuesResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryAccruedRevenuesResponse.Merge(m, src)
+}
+func (m *QueryAccruedRevenuesResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryAccruedRevenuesResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryAccruedRevenuesResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryAccruedRevenuesResponse proto.InternalMessageInfo
+
+func (m *QueryAccruedRevenuesResponse) GetAccruedRevenues() []AccruedRevenue {
+	if m != nil {
+		return m.AccruedRevenues
+	}
+	return nil
+}
+
+func (m *QueryAccruedRevenuesResponse) GetPagination() *query.PageResponse {
+	if m != nil {
+		return m.Pagination
+	}
+	return nil
+}
+
+// QueryWithdrawerAccruedRevenuesRequest is the request type for the
+// Query/WithdrawerAccruedRevenues RPC method.
+type QueryWithdrawerAccruedRevenuesRequest struct {
+	// withdrawer_address in bech32 format
+	WithdrawerAddress string `protobuf:"bytes,1,opt,name=withdrawer_address,json=withdrawerAddress,proto3" json:"withdrawer_address,omitempty"`
+	// pagination defines an optional pagination for the request.
+	Pagination *query.PageRequest `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
+}
+
+func (m *QueryWithdrawerAccruedRevenuesRequest) Reset()         { *m = QueryWithdrawerAccruedRevenuesRequest{} }
+func (m *QueryWithdrawerAccruedRevenuesRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryWithdrawerAccruedRevenuesRequest) ProtoMessage()    {}
+func (*QueryWithdrawerAccruedRevenuesRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_5e4b17cda6e8e927, []int{14}
+}
+func (m *QueryWithdrawerAccruedRevenuesRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryWithdrawerAccruedRevenuesRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryWithdrawerAccruedRevenuesRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryWithdrawerAccruedRevenuesRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryWithdrawerAccruedRevenuesRequest.Merge(m, src)
+}
+func (m *QueryWithdrawerAccruedRevenuesRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryWithdrawerAccruedRevenuesRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryWithdrawerAccruedRevenuesRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryWithdrawerAccruedRevenuesRequest proto.InternalMessageInfo
+
+func (m *QueryWithdrawerAccruedRevenuesRequest) GetWithdrawerAddress() string {
+	if m != nil {
+		return m.WithdrawerAddress
+	}
+	return ""
+}
+
+func (m *QueryWithdrawerAccruedRevenuesRequest) GetPagination() *query.PageRequest {
+	if m != nil {
+		return m.Pagination
+	}
+	return nil
+}
+
+// QueryWithdrawerAccruedRevenuesResponse is the response type for the
+// Query/WithdrawerAccruedRevenues RPC method.
+type QueryWithdrawerAccruedRevenuesResponse struct {
+	// accrued_revenues is the slice of fees accrued by the withdrawer per contract
+	AccruedRevenues []AccruedRevenue `protobuf:"bytes,1,rep,name=accrued_revenues,json=accruedRevenues,proto3" json:"accrued_revenues"`
+	// pagination defines the pagination in the response.
+	Pagination *query.PageResponse `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
+}
+
+func (m *QueryWithdrawerAccruedRevenuesResponse) Reset() {
+	*m = QueryWithdrawerAccruedRevenuesResponse{}
+}
+func (m *QueryWithdrawerAccruedRevenuesResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryWithdrawerAccruedRevenuesResponse) ProtoMessage()    {}
+func (*QueryWithdrawerAccruedRevenuesResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_5e4b17cda6e8e927, []int{15}
+}
+func (m *QueryWithdrawerAccruedRevenuesResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryWithdrawerAccruedRevenuesResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryWithdrawerAccruedRevenuesResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryWithdrawerAccruedRevenuesResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryWithdrawerAccruedRevenuesResponse.Merge(m, src)
+}
+func (m *QueryWithdrawerAccruedRevenuesResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryWithdrawerAccruedRevenuesResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryWithdrawerAccruedRevenuesResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryWithdrawerAccruedRevenuesResponse proto.InternalMessageInfo
+
+func (m *QueryWithdrawerAccruedRevenuesResponse) GetAccruedRevenues() []AccruedRevenue {
+	if m != nil {
+		return m.AccruedRevenues
+	}
+	return nil
+}
+
+func (m *QueryWithdrawerAccruedRevenuesResponse) GetPagination() *query.PageResponse {
+	if m != nil {
+		return m.Pagination
+	}
+	return nil
+}
+
 func init() {
 	proto.RegisterType((*QueryRevenuesRequest)(nil), "evmos.revenue.v1.QueryRevenuesRequest")
 	proto.RegisterType((*QueryRevenuesResponse)(nil), "evmos.revenue.v1.QueryRevenuesResponse")
@@ -541,54 +879,73 @@ func init() {
 	proto.RegisterType((*QueryDeployerRevenuesResponse)(nil), "evmos.revenue.v1.QueryDeployerRevenuesResponse")
 	proto.RegisterType((*QueryWithdrawerRevenuesRequest)(nil), "evmos.revenue.v1.QueryWithdrawerRevenuesRequest")
 	proto.RegisterType((*QueryWithdrawerRevenuesResponse)(nil), "evmos.revenue.v1.QueryWithdrawerRevenuesResponse")
+	proto.RegisterType((*QueryDerivedContractsRequest)(nil), "evmos.revenue.v1.QueryDerivedContractsRequest")
+	proto.RegisterType((*QueryDerivedContractsResponse)(nil), "evmos.revenue.v1.QueryDerivedContractsResponse")
+	proto.RegisterType((*QueryAccruedRevenuesRequest)(nil), "evmos.revenue.v1.QueryAccruedRevenuesRequest")
+	proto.RegisterType((*QueryAccruedRevenuesResponse)(nil), "evmos.revenue.v1.QueryAccruedRevenuesResponse")
+	proto.RegisterType((*QueryWithdrawerAccruedRevenuesRequest)(nil), "evmos.revenue.v1.QueryWithdrawerAccruedRevenuesRequest")
+	proto.RegisterType((*QueryWithdrawerAccruedRevenuesResponse)(nil), "evmos.revenue.v1.QueryWithdrawerAccruedRevenuesResponse")
 }
 
 func init() { proto.RegisterFile("evmos/revenue/v1/query.proto", fileDescriptor_5e4b17cda6e8e927) }
 
 var fileDescriptor_5e4b17cda6e8e927 = []byte{
-	// 660 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xc4, 0x55, 0x4f, 0x6b, 0x13, 0x41,
-	0x14, 0xcf, 0x54, 0xfb, 0xef, 0xf5, 0x60, 0x3a, 0x56, 0x88, 0x4b, 0xdc, 0x96, 0xc5, 0xfe, 0x85,
-	0xee, 0x74, 0x2b, 0x2a, 0xe2, 0x45, 0x4b, 0xd1, 0x93, 0xd0, 0xee, 0x45, 0xf0, 0xa0, 0x4c, 0x92,
-	0x61, 0xbb, 0xd0, 0xee, 0x6c, 0x77, 0x36, 0x5b, 0x83, 0x04, 0xc1, 0x2f, 0xa0, 0xe2, 0xa1, 0x78,
-	0xf0, 0x0b, 0x78, 0xf4, 0x53, 0xf4, 0x58, 0xf0, 0xe2, 0x49, 0x24, 0xf1, 0x2b, 0x78, 0x97, 0xcc,
-	0xcc, 0xa6, 0xcd, 0xae, 0xdb, 0x58, 0x29, 0x78, 0x09, 0xcb, 0xfb, 0xf7, 0xfb, 0xbd, 0xdf, 0xbc,
-	0xf7, 0x02, 0x55, 0x96, 0xec, 0x71, 0x41, 0x22, 0x96, 0xb0, 0xa0, 0xc9, 0x48, 0xe2, 0x90, 0xfd,
-	0x26, 0x8b, 0x5a, 0x76, 0x18, 0xf1, 0x98, 0xe3, 0xb2, 0xf4, 0xda, 0xda, 0x6b, 0x27, 0x8e, 0xb1,
-	0x52, 0xe7, 0xa2, 0x97, 0x50, 0xa3, 0x82, 0xa9, 0x50, 0x92, 0x38, 0x35, 0x16, 0x53, 0x87, 0x84,
-	0xd4, 0xf3, 0x03, 0x1a, 0xfb, 0x3c, 0x50, 0xd9, 0x86, 0x99, 0xab, 0xed, 0xb1, 0x80, 0x09, 0x5f,
-	0x14, 0xfa, 0x53, 0x20, 0xe5, 0x9f, 0xf1, 0xb8, 0xc7, 0xe5, 0x27, 0xe9, 0x7d, 0x69, 0x6b, 0xd5,
-	0xe3, 0xdc, 0xdb, 0x65, 0x84, 0x86, 0x3e, 0xa1, 0x41, 0xc0, 0x63, 0x09, 0xa9, 0x6b, 0x5a, 0xcf,
-	0x61, 0x66, 0xbb, 0xc7, 0xca, 0x55, 0x95, 0x84, 0xcb, 0xf6, 0x9b, 0x4c, 0xc4, 0xf8, 0x11, 0xc0,
-	0x09, 0xbf, 0x0a, 0x9a, 0x43, 0x4b, 0x53, 0xeb, 0x0b, 0xb6, 0x6a, 0xc6, 0xee, 0x35, 0x63, 0xab,
-	0xbe, 0x75, 0x33, 0xf6, 0x16, 0xf5, 0x98, 0xce, 0x75, 0x4f, 0x65, 0x5a, 0x9f, 0x10, 0x5c, 0xcb,
-	0x00, 0x88, 0x90, 0x07, 0x82, 0xe1, 0xfb, 0x30, 0xa1, 0xe9, 0x8b, 0x0a, 0x9a, 0xbb, 0xb4, 0x34,
-	0xb5, 0x7e, 0xdd, 0xce, 0xca, 0x67, 0xeb, 0xac, 0x8d, 0xcb, 0x47, 0xdf, 0x67, 0x4b, 0x6e, 0x3f,
-	0x01, 0x3f, 0x1e, 0xa0, 0x37, 0x22, 0xe9, 0x2d, 0x0e, 0xa5, 0xa7, 0x90, 0x07, 0xf8, 0x3d, 0x80,
-	0xab, 0xa7, 0xe9, 0xa5, 0xed, 0x2f, 0x43, 0xb9, 0xce, 0x83, 0x38, 0xa2, 0xf5, 0xf8, 0x05, 0x6d,
-	0x34, 0x22, 0x26, 0x84, 0x14, 0x61, 0xd2, 0xbd, 0x92, 0xda, 0x1f, 0x2a, 0xb3, 0xb5, 0x3d, 0xa8,
-	0x60, 0xbf, 0xbf, 0x7b, 0x30, 0xae, 0xe9, 0x6a, 0xf9, 0x86, 0xb6, 0x97, 0xc6, 0x5b, 0x33, 0x80,
-	0x65, 0xc9, 0x2d, 0x1a, 0xd1, 0xbd, 0xf4, 0x49, 0xac, 0x27, 0x9a, 0x6a, 0x6a, 0xd5, 0x38, 0x77,
-	0x60, 0x2c, 0x94, 0x16, 0x0d, 0x53, 0xc9, 0xc3, 0xa8, 0x0c, 0x8d, 0xa2, 0xa3, 0xad, 0xf7, 0x08,
-	0xaa, 0xb2, 0xde, 0x26, 0x0b, 0x77, 0x79, 0x8b, 0x45, 0xd9, 0x11, 0x58, 0x86, 0x72, 0x43, 0xbb,
-	0xb2, 0x1a, 0xa4, 0x76, 0xad, 0x41, 0x66, 0x5a, 0x46, 0xfe, 0x79, 0x5a, 0x0e, 0x11, 0xdc, 0x28,
-	0xe0, 0xa4, 0xbb, 0x5d, 0x05, 0x9c, 0x7d, 0x18, 0x3d, 0x3f, 0x93, 0xee, 0x74, 0xe6, 0x69, 0x2e,
-	0x72, 0x4e, 0x0e, 0x11, 0x98, 0x92, 0xd9, 0x53, 0x3f, 0xde, 0x69, 0x44, 0xf4, 0x20, 0xaf, 0xd7,
-	0x2a, 0xe0, 0x83, 0xbe, 0x33, 0xa3, 0xd8, 0xf4, 0x89, 0xe7, 0xa2, 0x35, 0xfb, 0x88, 0x60, 0xb6,
-	0x90, 0xd9, 0xff, 0x55, 0x6d, 0xfd, 0xd7, 0x28, 0x8c, 0x4a, 0x6e, 0xf8, 0x35, 0x4c, 0xa4, 0xac,
-	0xf0, 0x42, 0x7e, 0x42, 0xff, 0x74, 0x83, 0x8c, 0xc5, 0xa1, 0x71, 0x0a, 0xd2, 0xb2, 0xde, 0x7c,
-	0xfd, 0xf9, 0x61, 0xa4, 0x8a, 0x0d, 0x52, 0x74, 0x21, 0x05, 0x7e, 0x8b, 0x60, 0x5c, 0x27, 0xe2,
-	0xf9, 0xb3, 0x0b, 0xa7, 0xf8, 0x0b, 0xc3, 0xc2, 0x34, 0xfc, 0x6d, 0x09, 0x4f, 0xf0, 0x6a, 0x31,
-	0x3c, 0x79, 0x95, 0xd5, 0xbf, 0x8d, 0x0f, 0x60, 0x4c, 0x2d, 0x26, 0xbe, 0x59, 0x00, 0x34, 0xb0,
-	0xff, 0xc6, 0xfc, 0x90, 0x28, 0xcd, 0x66, 0x4e, 0xb2, 0x31, 0x70, 0x25, 0xcf, 0x46, 0x6d, 0x3e,
-	0xfe, 0x8c, 0xa0, 0x9c, 0x5d, 0x30, 0x6c, 0x17, 0x54, 0x2f, 0xb8, 0x0e, 0x06, 0xf9, 0xeb, 0xf8,
-	0xf3, 0xa8, 0x94, 0x3d, 0x38, 0x6d, 0xfc, 0x05, 0x01, 0xce, 0x4f, 0x36, 0x5e, 0x2b, 0x80, 0x2f,
-	0x5c, 0x4f, 0xc3, 0x39, 0x47, 0x86, 0xa6, 0x7c, 0x57, 0x52, 0x76, 0x30, 0x39, 0x8b, 0x72, 0x7e,
-	0xe7, 0xdb, 0x1b, 0x9b, 0x47, 0x1d, 0x13, 0x1d, 0x77, 0x4c, 0xf4, 0xa3, 0x63, 0xa2, 0x77, 0x5d,
-	0xb3, 0x74, 0xdc, 0x35, 0x4b, 0xdf, 0xba, 0x66, 0xe9, 0xd9, 0x8a, 0xe7, 0xc7, 0x3b, 0xcd, 0x9a,
-	0x5d, 0xe7, 0x7b, 0xba, 0xa8, 0xfa, 0x4d, 0x9c, 0x35, 0xf2, 0xb2, 0x0f, 0x10, 0xb7, 0x42, 0x26,
-	0x6a, 0x63, 0xf2, 0x2f, 0xfa, 0xd6, 0xef, 0x00, 0x00, 0x00, 0xff, 0xff, 0x29, 0x18, 0xab, 0x3a,
-	0x74, 0x08, 0x00, 0x00,
+	// 866 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xcc, 0x57, 0x4f, 0x4b, 0x1b, 0x5b,
+	0x14, 0xcf, 0xf5, 0xbd, 0xe7, 0x9f, 0x23, 0xbc, 0xc4, 0xab, 0x0f, 0xe2, 0xbc, 0xbc, 0x31, 0x0c,
+	0xcf, 0xf8, 0x07, 0x32, 0xd7, 0xf8, 0xf0, 0xd9, 0x52, 0x0a, 0xd5, 0x8a, 0x85, 0x42, 0x41, 0xb3,
+	0x29, 0x74, 0x51, 0x99, 0x24, 0xb7, 0x63, 0x40, 0x67, 0xe2, 0xcc, 0x24, 0x36, 0x88, 0x14, 0xfa,
+	0x05, 0xb4, 0x74, 0x21, 0x5d, 0xb4, 0x1f, 0xa0, 0x9b, 0x42, 0x77, 0xed, 0xba, 0x0b, 0x97, 0x42,
+	0x37, 0xa5, 0x8b, 0x52, 0xb4, 0x1f, 0xa4, 0xe4, 0xde, 0x3b, 0x89, 0xb9, 0x93, 0x71, 0x62, 0x49,
+	0xb1, 0x1b, 0x09, 0xf7, 0xfc, 0xf9, 0xfd, 0xce, 0xef, 0x9e, 0x7b, 0xce, 0x08, 0x29, 0x5a, 0xdb,
+	0xb6, 0x5d, 0xe2, 0xd0, 0x1a, 0xb5, 0xaa, 0x94, 0xd4, 0x72, 0x64, 0xa7, 0x4a, 0x9d, 0xba, 0x5e,
+	0x71, 0x6c, 0xcf, 0xc6, 0x09, 0x66, 0xd5, 0x85, 0x55, 0xaf, 0xe5, 0x94, 0xd9, 0xa2, 0xed, 0x36,
+	0x02, 0x0a, 0x86, 0x4b, 0xb9, 0x2b, 0xa9, 0xe5, 0x0a, 0xd4, 0x33, 0x72, 0xa4, 0x62, 0x98, 0x65,
+	0xcb, 0xf0, 0xca, 0xb6, 0xc5, 0xa3, 0x15, 0x35, 0x90, 0xdb, 0xa4, 0x16, 0x75, 0xcb, 0x6e, 0xa8,
+	0xdd, 0x07, 0xe2, 0xf6, 0x31, 0xd3, 0x36, 0x6d, 0xf6, 0x93, 0x34, 0x7e, 0x89, 0xd3, 0x94, 0x69,
+	0xdb, 0xe6, 0x16, 0x25, 0x46, 0xa5, 0x4c, 0x0c, 0xcb, 0xb2, 0x3d, 0x06, 0x29, 0x72, 0x6a, 0x0f,
+	0x61, 0x6c, 0xbd, 0xc1, 0x2a, 0xcf, 0x33, 0xb9, 0x79, 0xba, 0x53, 0xa5, 0xae, 0x87, 0x57, 0x01,
+	0x5a, 0xfc, 0x92, 0x28, 0x8d, 0xa6, 0x87, 0xe7, 0x33, 0x3a, 0x2f, 0x46, 0x6f, 0x14, 0xa3, 0xf3,
+	0xba, 0x45, 0x31, 0xfa, 0x9a, 0x61, 0x52, 0x11, 0x9b, 0x3f, 0x17, 0xa9, 0xbd, 0x44, 0xf0, 0x97,
+	0x04, 0xe0, 0x56, 0x6c, 0xcb, 0xa5, 0xf8, 0x06, 0x0c, 0x0a, 0xfa, 0x6e, 0x12, 0xa5, 0x7f, 0x9b,
+	0x1e, 0x9e, 0x1f, 0xd7, 0x65, 0xf9, 0x74, 0x11, 0xb5, 0xfc, 0xfb, 0xf1, 0x97, 0x89, 0x58, 0xbe,
+	0x19, 0x80, 0xef, 0xb4, 0xd1, 0xeb, 0x63, 0xf4, 0xa6, 0x22, 0xe9, 0x71, 0xe4, 0x36, 0x7e, 0xb7,
+	0x60, 0xf4, 0x3c, 0x3d, 0xbf, 0xfc, 0x19, 0x48, 0x14, 0x6d, 0xcb, 0x73, 0x8c, 0xa2, 0xb7, 0x61,
+	0x94, 0x4a, 0x0e, 0x75, 0x5d, 0x26, 0xc2, 0x50, 0x3e, 0xee, 0x9f, 0x2f, 0xf1, 0x63, 0x6d, 0xbd,
+	0x5d, 0xc1, 0x66, 0x7d, 0xd7, 0x61, 0x40, 0xd0, 0x15, 0xf2, 0x45, 0x96, 0xe7, 0xfb, 0x6b, 0x63,
+	0x80, 0x59, 0xca, 0x35, 0xc3, 0x31, 0xb6, 0xfd, 0x2b, 0xd1, 0xee, 0xc1, 0x68, 0xdb, 0xa9, 0xc0,
+	0xf9, 0x1f, 0xfa, 0x2b, 0xec, 0x44, 0xc0, 0x24, 0x83, 0x30, 0x3c, 0x42, 0xa0, 0x08, 0x6f, 0xed,
+	0x19, 0x82, 0x14, 0xcb, 0xb7, 0x42, 0x2b, 0x5b, 0x76, 0x9d, 0x3a, 0x72, 0x0b, 0xcc, 0x40, 0xa2,
+	0x24, 0x4c, 0xb2, 0x06, 0xfe, 0xb9, 0xd0, 0x00, 0xaf, 0x76, 0xb8, 0x8e, 0x1f, 0xe9, 0x96, 0x23,
+	0x04, 0xff, 0x84, 0x70, 0x12, 0xd5, 0x66, 0x01, 0xcb, 0x17, 0x23, 0xfa, 0x67, 0x28, 0x3f, 0x22,
+	0x5d, 0x4d, 0x2f, 0xfb, 0xe4, 0x08, 0x81, 0xca, 0x98, 0xdd, 0x2f, 0x7b, 0x9b, 0x25, 0xc7, 0xd8,
+	0x0d, 0xea, 0x95, 0x05, 0xbc, 0xdb, 0x34, 0x4a, 0x8a, 0x8d, 0xb4, 0x2c, 0xbd, 0xd6, 0xec, 0x05,
+	0x82, 0x89, 0x50, 0x66, 0x57, 0xac, 0xda, 0x41, 0xab, 0xc7, 0x9c, 0x72, 0x8d, 0x96, 0x6e, 0x0b,
+	0xa8, 0xa6, 0x66, 0x53, 0x10, 0x7f, 0x64, 0x14, 0x3d, 0xdb, 0xa9, 0x4b, 0x82, 0xfd, 0x29, 0x8e,
+	0x7f, 0x62, 0x87, 0xc9, 0x8c, 0xae, 0x58, 0xab, 0x43, 0x04, 0x7f, 0x33, 0x66, 0x4b, 0xc5, 0xa2,
+	0x53, 0xa5, 0xa5, 0x0e, 0xcf, 0xb1, 0xcb, 0x91, 0xd4, 0x33, 0xb1, 0xde, 0xfb, 0xd7, 0x17, 0xa0,
+	0x24, 0xb4, 0x5a, 0x87, 0x84, 0xc1, 0x4d, 0x1b, 0xd2, 0x2c, 0x4f, 0x07, 0xa7, 0x50, 0x7b, 0x12,
+	0x31, 0x8d, 0xe2, 0x46, 0x7b, 0xea, 0xde, 0xe9, 0xf9, 0x0a, 0xc1, 0xa4, 0xf4, 0x2e, 0x42, 0x94,
+	0xbd, 0xa2, 0x87, 0xfb, 0x01, 0x41, 0x26, 0x8a, 0xe0, 0xaf, 0xaf, 0xf3, 0xfc, 0x3b, 0x80, 0x3f,
+	0x58, 0x19, 0xf8, 0x09, 0x0c, 0x36, 0xd3, 0x67, 0x82, 0xbc, 0x3a, 0x7d, 0x67, 0x28, 0x53, 0x91,
+	0x7e, 0x1c, 0x52, 0xd3, 0x9e, 0x7e, 0xfc, 0xf6, 0xbc, 0x2f, 0x85, 0x15, 0x12, 0xf6, 0x15, 0xe4,
+	0xe2, 0x03, 0x04, 0x03, 0x22, 0x10, 0x4f, 0x5e, 0x9c, 0xd8, 0xc7, 0xcf, 0x44, 0xb9, 0x09, 0xf8,
+	0x05, 0x06, 0x4f, 0x70, 0x36, 0x1c, 0x9e, 0xec, 0xc9, 0xef, 0x73, 0x1f, 0xef, 0x42, 0x3f, 0x5f,
+	0xbe, 0xf8, 0xdf, 0x10, 0xa0, 0xb6, 0x1d, 0xaf, 0x4c, 0x46, 0x78, 0x09, 0x36, 0x69, 0xc6, 0x46,
+	0xc1, 0xc9, 0x20, 0x1b, 0xbe, 0xdd, 0xf1, 0x6b, 0x04, 0x09, 0x79, 0x89, 0x62, 0x3d, 0x24, 0x7b,
+	0xc8, 0x17, 0x80, 0x42, 0xba, 0xf6, 0xbf, 0x8c, 0x4a, 0xf2, 0x47, 0xc5, 0x3e, 0x7e, 0x8b, 0x00,
+	0x07, 0xb7, 0x17, 0x9e, 0x0b, 0x81, 0x0f, 0x5d, 0xc1, 0x4a, 0xee, 0x12, 0x11, 0x82, 0xf2, 0x22,
+	0xa3, 0x9c, 0xc3, 0xe4, 0x22, 0xca, 0xc1, 0xf1, 0xc0, 0x48, 0x27, 0xe4, 0x25, 0x72, 0x81, 0xc2,
+	0x1d, 0xf7, 0x9f, 0x42, 0xba, 0xf6, 0x17, 0x74, 0x6f, 0x32, 0xba, 0x8b, 0x78, 0x21, 0x48, 0xb7,
+	0xc4, 0x63, 0x36, 0xfc, 0x2e, 0x74, 0xc9, 0x9e, 0xb4, 0x5b, 0xf7, 0xf1, 0x1b, 0x04, 0x71, 0x69,
+	0xc8, 0xe0, 0x6c, 0x08, 0x87, 0xce, 0xd3, 0x52, 0xd1, 0xbb, 0x75, 0x8f, 0x66, 0x2c, 0xcf, 0xb4,
+	0x4e, 0x2f, 0xe8, 0x33, 0x82, 0xf1, 0xd0, 0x01, 0x89, 0x17, 0x23, 0x2f, 0x3c, 0xa4, 0x8a, 0x6b,
+	0x97, 0x0f, 0x14, 0xf5, 0xdc, 0x65, 0xf5, 0xac, 0xe0, 0xe5, 0x2e, 0xea, 0x69, 0xf5, 0x4d, 0xc7,
+	0x1e, 0x5a, 0x5e, 0x39, 0x3e, 0x55, 0xd1, 0xc9, 0xa9, 0x8a, 0xbe, 0x9e, 0xaa, 0xe8, 0xf0, 0x4c,
+	0x8d, 0x9d, 0x9c, 0xa9, 0xb1, 0x4f, 0x67, 0x6a, 0xec, 0xc1, 0xac, 0x59, 0xf6, 0x36, 0xab, 0x05,
+	0xbd, 0x68, 0x6f, 0x0b, 0x1c, 0xfe, 0xb7, 0x96, 0x9b, 0x23, 0x8f, 0x9b, 0x98, 0x5e, 0xbd, 0x42,
+	0xdd, 0x42, 0x3f, 0xfb, 0x57, 0xee, 0xbf, 0xef, 0x03, 0x00, 0x9d, 0x3c, 0x9d, 0x7d, 0x9c, 0x0e,
+	0x00, 0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -615,6 +972,15 @@ type QueryClient interface {
 	// WithdrawerRevenues retrieves all revenues with a given withdrawer
 	// address
 	WithdrawerRevenues(ctx context.Context, in *QueryWithdrawerRevenuesRequest, opts ...grpc.CallOption) (*QueryWithdrawerRevenuesResponse, error)
+	// DerivedContracts retrieves all contracts that inherit the revenue of a
+	// given factory
+	DerivedContracts(ctx context.Context, in *QueryDerivedContractsRequest, opts ...grpc.CallOption) (*QueryDerivedContractsResponse, error)
+	// AccruedRevenues retrieves the developer fees of a given contract that
+	// have not been withdrawn yet
+	AccruedRevenues(ctx context.Context, in *QueryAccruedRevenuesRequest, opts ...grpc.CallOption) (*QueryAccruedRevenuesResponse, error)
+	// WithdrawerAccruedRevenues retrieves the developer fees of a given
+	// withdrawer that have not been withdrawn yet
+	WithdrawerAccruedRevenues(ctx context.Context, in *QueryWithdrawerAccruedRevenuesRequest, opts ...grpc.CallOption) (*QueryWithdrawerAccruedRevenuesResponse, error)
 }
 
 type queryClient struct {
@@ -670,6 +1036,33 @@ func (c *queryClient) WithdrawerRevenues(ctx context.Context, in *QueryWithdrawe
 	return out, nil
 }
 
+func (c *queryClient) DerivedContracts(ctx context.Context, in *QueryDerivedContractsRequest, opts ...grpc.CallOption) (*QueryDerivedContractsResponse, error) {
+	out := new(QueryDerivedContractsResponse)
+	err := c.cc.Invoke(ctx, "/evmos.revenue.v1.Query/DerivedContracts", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) AccruedRevenues(ctx context.Context, in *QueryAccruedRevenuesRequest, opts ...grpc.CallOption) (*QueryAccruedRevenuesResponse, error) {
+	out := new(QueryAccruedRevenuesResponse)
+	err := c.cc.Invoke(ctx, "/evmos.revenue.v1.Query/AccruedRevenues", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) WithdrawerAccruedRevenues(ctx context.Context, in *QueryWithdrawerAccruedRevenuesRequest, opts ...grpc.CallOption) (*QueryWithdrawerAccruedRevenuesResponse, error) {
+	out := new(QueryWithdrawerAccruedRevenuesResponse)
+	err := c.cc.Invoke(ctx, "/evmos.revenue.v1.Query/WithdrawerAccruedRevenues", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // QueryServer is the server API for Query service.
 type QueryServer interface {
 	// Revenues retrieves all registered revenues
@@ -684,6 +1077,15 @@ type QueryServer interface {
 	// WithdrawerRevenues retrieves all revenues with a given withdrawer
 	// address
 	WithdrawerRevenues(context.Context, *QueryWithdrawerRevenuesRequest) (*QueryWithdrawerRevenuesResponse, error)
+	// DerivedContracts retrieves all contracts that inherit the revenue of a
+	// given factory
+	DerivedContracts(context.Context, *QueryDerivedContractsRequest) (*QueryDerivedContractsResponse, error)
+	// AccruedRevenues retrieves the developer fees of a given contract that
+	// have not been withdrawn yet
+	AccruedRevenues(context.Context, *QueryAccruedRevenuesRequest) (*QueryAccruedRevenuesResponse, error)
+	// WithdrawerAccruedRevenues retrieves the developer fees of a given
+	// withdrawer that have not been withdrawn yet
+	WithdrawerAccruedRevenues(context.Context, *QueryWithdrawerAccruedRevenuesRequest) (*QueryWithdrawerAccruedRevenuesResponse, error)
 }
 
 // UnimplementedQueryServer can be embedded to have forward compatible implementations.
@@ -705,6 +1107,15 @@ func (*UnimplementedQueryServer) DeployerRevenues(ctx context.Context, req *Quer
 func (*UnimplementedQueryServer) WithdrawerRevenues(ctx context.Context, req *QueryWithdrawerRevenuesRequest) (*QueryWithdrawerRevenuesResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method WithdrawerRevenues not implemented")
 }
+func (*UnimplementedQueryServer) DerivedContracts(ctx context.Context, req *QueryDerivedContractsRequest) (*QueryDerivedContractsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DerivedContracts not implemented")
+}
+func (*UnimplementedQueryServer) AccruedRevenues(ctx context.Context, req *QueryAccruedRevenuesRequest) (*QueryAccruedRevenuesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AccruedRevenues not implemented")
+}
+func (*UnimplementedQueryServer) WithdrawerAccruedRevenues(ctx context.Context, req *QueryWithdrawerAccruedRevenuesRequest) (*QueryWithdrawerAccruedRevenuesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method WithdrawerAccruedRevenues not implemented")
+}
 
 func RegisterQueryServer(s grpc1.Server, srv QueryServer) {
 	s.RegisterService(&_Query_serviceDesc, srv)
@@ -800,29 +1211,95 @@ func _Query_WithdrawerRevenues_Handler(srv interface{}, ctx context.Context, dec
 	return interceptor(ctx, in, info, handler)
 }
 
-var _Query_serviceDesc = grpc.ServiceDesc{
-	ServiceName: "evmos.revenue.v1.Query",
-	HandlerType: (*QueryServer)(nil),
-	Methods: []grpc.MethodDesc{
-		{
-			MethodName: "Revenues",
-			Handler:    _Query_Revenues_Handler,
-		},
-		{
-			MethodName: "Revenue",
-			Handler:    _Query_Revenue_Handler,
-		},
-		{
-			MethodName: "Params",
-			Handler:    _Query_Params_Handler,
-		},
-		{
-			MethodName: "DeployerRevenues",
-			Handler:    _Query_DeployerRevenues_Handler,
-		},
-		{
-			MethodName: "WithdrawerRevenues",
-			Handler:    _Query_WithdrawerRevenues_Handler,
+func _Query_DerivedContracts_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryDerivedContractsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).DerivedContracts(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/evmos.revenue.v1.Query/DerivedContracts",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).DerivedContracts(ctx, req.(*QueryDerivedContractsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_AccruedRevenues_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryAccruedRevenuesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).AccruedRevenues(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/evmos.revenue.v1.Query/AccruedRevenues",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).AccruedRevenues(ctx, req.(*QueryAccruedRevenuesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_WithdrawerAccruedRevenues_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryWithdrawerAccruedRevenuesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).WithdrawerAccruedRevenues(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/evmos.revenue.v1.Query/WithdrawerAccruedRevenues",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).WithdrawerAccruedRevenues(ctx, req.(*QueryWithdrawerAccruedRevenuesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+var _Query_serviceDesc = grpc.ServiceDesc{
+	ServiceName: "evmos.revenue.v1.Query",
+	HandlerType: (*QueryServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "Revenues",
+			Handler:    _Query_Revenues_Handler,
+		},
+		{
+			MethodName: "Revenue",
+			Handler:    _Query_Revenue_Handler,
+		},
+		{
+			MethodName: "Params",
+			Handler:    _Query_Params_Handler,
+		},
+		{
+			MethodName: "DeployerRevenues",
+			Handler:    _Query_DeployerRevenues_Handler,
+		},
+		{
+			MethodName: "WithdrawerRevenues",
+			Handler:    _Query_WithdrawerRevenues_Handler,
+		},
+		{
+			MethodName: "DerivedContracts",
+			Handler:    _Query_DerivedContracts_Handler,
+		},
+		{
+			MethodName: "AccruedRevenues",
+			Handler:    _Query_AccruedRevenues_Handler,
+		},
+		{
+			MethodName: "WithdrawerAccruedRevenues",
+			Handler:    _Query_WithdrawerAccruedRevenues_Handler,
 		},
 	},
 	Streams:  []grpc.StreamDesc{},
@@ -1204,139 +1681,291 @@ func (m *QueryWithdrawerRevenuesResponse) MarshalToSizedBuffer(dAtA []byte) (int
 	return len(dAtA) - i, nil
 }
 
-func encodeVarintQuery(dAtA []byte, offset int, v uint64) int {
-	offset -= sovQuery(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
+func (m *QueryDerivedContractsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	dAtA[offset] = uint8(v)
-	return base
+	return dAtA[:n], nil
 }
-func (m *QueryRevenuesRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if m.Pagination != nil {
-		l = m.Pagination.Size()
-		n += 1 + l + sovQuery(uint64(l))
-	}
-	return n
+
+func (m *QueryDerivedContractsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *QueryRevenuesResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+func (m *QueryDerivedContractsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	if len(m.Revenues) > 0 {
-		for _, e := range m.Revenues {
-			l = e.Size()
-			n += 1 + l + sovQuery(uint64(l))
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
 		}
+		i--
+		dAtA[i] = 0x12
 	}
-	if m.Pagination != nil {
-		l = m.Pagination.Size()
-		n += 1 + l + sovQuery(uint64(l))
+	if len(m.FactoryAddress) > 0 {
+		i -= len(m.FactoryAddress)
+		copy(dAtA[i:], m.FactoryAddress)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.FactoryAddress)))
+		i--
+		dAtA[i] = 0xa
 	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *QueryRevenueRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.ContractAddress)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
+func (m *QueryDerivedContractsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	return n
+	return dAtA[:n], nil
 }
 
-func (m *QueryRevenueResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = m.Revenue.Size()
-	n += 1 + l + sovQuery(uint64(l))
-	return n
+func (m *QueryDerivedContractsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *QueryParamsRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+func (m *QueryDerivedContractsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	return n
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.ContractAddresses) > 0 {
+		for iNdEx := len(m.ContractAddresses) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.ContractAddresses[iNdEx])
+			copy(dAtA[i:], m.ContractAddresses[iNdEx])
+			i = encodeVarintQuery(dAtA, i, uint64(len(m.ContractAddresses[iNdEx])))
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
 }
 
-func (m *QueryParamsResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *QueryAccruedRevenuesRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	l = m.Params.Size()
-	n += 1 + l + sovQuery(uint64(l))
-	return n
+	return dAtA[:n], nil
 }
 
-func (m *QueryDeployerRevenuesRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.DeployerAddress)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
-	}
-	if m.Pagination != nil {
-		l = m.Pagination.Size()
-		n += 1 + l + sovQuery(uint64(l))
-	}
-	return n
+func (m *QueryAccruedRevenuesRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *QueryDeployerRevenuesResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+func (m *QueryAccruedRevenuesRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	if len(m.ContractAddresses) > 0 {
-		for _, s := range m.ContractAddresses {
-			l = len(s)
-			n += 1 + l + sovQuery(uint64(l))
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
 		}
+		i--
+		dAtA[i] = 0x12
 	}
-	if m.Pagination != nil {
-		l = m.Pagination.Size()
-		n += 1 + l + sovQuery(uint64(l))
+	if len(m.ContractAddress) > 0 {
+		i -= len(m.ContractAddress)
+		copy(dAtA[i:], m.ContractAddress)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.ContractAddress)))
+		i--
+		dAtA[i] = 0xa
 	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *QueryWithdrawerRevenuesRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.WithdrawerAddress)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
+func (m *QueryAccruedRevenuesResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryAccruedRevenuesResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryAccruedRevenuesResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.AccruedRevenues) > 0 {
+		for iNdEx := len(m.AccruedRevenues) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.AccruedRevenues[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryWithdrawerAccruedRevenuesRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryWithdrawerAccruedRevenuesRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryWithdrawerAccruedRevenuesRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.WithdrawerAddress) > 0 {
+		i -= len(m.WithdrawerAddress)
+		copy(dAtA[i:], m.WithdrawerAddress)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.WithdrawerAddress)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryWithdrawerAccruedRevenuesResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryWithdrawerAccruedRevenuesResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryWithdrawerAccruedRevenuesResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.AccruedRevenues) > 0 {
+		for iNdEx := len(m.AccruedRevenues) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.AccruedRevenues[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintQuery(dAtA []byte, offset int, v uint64) int {
+	offset -= sovQuery(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *QueryRevenuesRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
 	if m.Pagination != nil {
 		l = m.Pagination.Size()
 		n += 1 + l + sovQuery(uint64(l))
@@ -1344,7 +1973,87 @@ func (m *QueryWithdrawerRevenuesRequest) Size() (n int) {
 	return n
 }
 
-func (m *QueryWithdrawerRevenuesResponse) Size() (n int) {
+func (m *QueryRevenuesResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Revenues) > 0 {
+		for _, e := range m.Revenues {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryRevenueRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ContractAddress)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryRevenueResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Revenue.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *QueryParamsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *QueryParamsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Params.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *QueryDeployerRevenuesRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.DeployerAddress)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryDeployerRevenuesResponse) Size() (n int) {
 	if m == nil {
 		return 0
 	}
@@ -1363,13 +2072,779 @@ func (m *QueryWithdrawerRevenuesResponse) Size() (n int) {
 	return n
 }
 
-func sovQuery(x uint64) (n int) {
-	return (math_bits.Len64(x|1) + 6) / 7
+func (m *QueryWithdrawerRevenuesRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.WithdrawerAddress)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
 }
-func sozQuery(x uint64) (n int) {
-	return sovQuery(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+
+func (m *QueryWithdrawerRevenuesResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.ContractAddresses) > 0 {
+		for _, s := range m.ContractAddresses {
+			l = len(s)
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
 }
-func (m *QueryRevenuesRequest) Unmarshal(dAtA []byte) error {
+
+func (m *QueryDerivedContractsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.FactoryAddress)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryDerivedContractsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.ContractAddresses) > 0 {
+		for _, s := range m.ContractAddresses {
+			l = len(s)
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryAccruedRevenuesRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ContractAddress)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryAccruedRevenuesResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.AccruedRevenues) > 0 {
+		for _, e := range m.AccruedRevenues {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryWithdrawerAccruedRevenuesRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.WithdrawerAddress)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryWithdrawerAccruedRevenuesResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.AccruedRevenues) > 0 {
+		for _, e := range m.AccruedRevenues {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func sovQuery(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozQuery(x uint64) (n int) {
+	return sovQuery(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *QueryRevenuesRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryRevenuesRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryRevenuesRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageRequest{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryRevenuesResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryRevenuesResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryRevenuesResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Revenues", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Revenues = append(m.Revenues, Revenue{})
+			if err := m.Revenues[len(m.Revenues)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageResponse{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryRevenueRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryRevenueRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryRevenueRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ContractAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ContractAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryRevenueResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryRevenueResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryRevenueResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Revenue", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Revenue.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryParamsRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryParamsRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryParamsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryParamsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryParamsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryParamsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Params", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Params.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryDeployerRevenuesRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryDeployerRevenuesRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryDeployerRevenuesRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DeployerAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.DeployerAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageRequest{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryDeployerRevenuesResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1382,23 +2857,55 @@ func (m *QueryRevenuesRequest) Unmarshal(dAtA []byte) error {
 			if iNdEx >= l {
 				return io.ErrUnexpectedEOF
 			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
-			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: QueryRevenuesRequest: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryRevenuesRequest: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryDeployerRevenuesResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryDeployerRevenuesResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ContractAddresses", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ContractAddresses = append(m.ContractAddresses, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 2:
 			if wireType != 2 {
 				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
 			}
@@ -1428,7 +2935,7 @@ func (m *QueryRevenuesRequest) Unmarshal(dAtA []byte) error {
 				return io.ErrUnexpectedEOF
 			}
 			if m.Pagination == nil {
-				m.Pagination = &query.PageRequest{}
+				m.Pagination = &query.PageResponse{}
 			}
 			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
@@ -1455,7 +2962,7 @@ func (m *QueryRevenuesRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryRevenuesResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryWithdrawerRevenuesRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1478,17 +2985,17 @@ func (m *QueryRevenuesResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryRevenuesResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryWithdrawerRevenuesRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryRevenuesResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryWithdrawerRevenuesRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Revenues", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field WithdrawerAddress", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -1498,25 +3005,23 @@ func (m *QueryRevenuesResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthQuery
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Revenues = append(m.Revenues, Revenue{})
-			if err := m.Revenues[len(m.Revenues)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.WithdrawerAddress = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
@@ -1548,7 +3053,7 @@ func (m *QueryRevenuesResponse) Unmarshal(dAtA []byte) error {
 				return io.ErrUnexpectedEOF
 			}
 			if m.Pagination == nil {
-				m.Pagination = &query.PageResponse{}
+				m.Pagination = &query.PageRequest{}
 			}
 			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
@@ -1575,7 +3080,7 @@ func (m *QueryRevenuesResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryRevenueRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryWithdrawerRevenuesResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1598,15 +3103,15 @@ func (m *QueryRevenueRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryRevenueRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryWithdrawerRevenuesResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryRevenueRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryWithdrawerRevenuesResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ContractAddress", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ContractAddresses", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -1634,7 +3139,43 @@ func (m *QueryRevenueRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ContractAddress = string(dAtA[iNdEx:postIndex])
+			m.ContractAddresses = append(m.ContractAddresses, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageResponse{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -1657,7 +3198,7 @@ func (m *QueryRevenueRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryRevenueResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryDerivedContractsRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1680,15 +3221,47 @@ func (m *QueryRevenueResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryRevenueResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryDerivedContractsRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryRevenueResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryDerivedContractsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Revenue", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field FactoryAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.FactoryAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -1715,7 +3288,10 @@ func (m *QueryRevenueResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Revenue.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if m.Pagination == nil {
+				m.Pagination = &query.PageRequest{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -1740,7 +3316,7 @@ func (m *QueryRevenueResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryParamsRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryDerivedContractsResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1763,65 +3339,47 @@ func (m *QueryParamsRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryParamsRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryDerivedContractsResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryParamsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryDerivedContractsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
-		default:
-			iNdEx = preIndex
-			skippy, err := skipQuery(dAtA[iNdEx:])
-			if err != nil {
-				return err
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ContractAddresses", wireType)
 			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
-				return ErrInvalidLengthQuery
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
 			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *QueryParamsResponse) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowQuery
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
 			}
-			if iNdEx >= l {
+			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
-			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: QueryParamsResponse: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryParamsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+			m.ContractAddresses = append(m.ContractAddresses, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Params", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -1848,7 +3406,10 @@ func (m *QueryParamsResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Params.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if m.Pagination == nil {
+				m.Pagination = &query.PageResponse{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -1873,7 +3434,7 @@ func (m *QueryParamsResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryDeployerRevenuesRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryAccruedRevenuesRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1896,15 +3457,15 @@ func (m *QueryDeployerRevenuesRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryDeployerRevenuesRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryAccruedRevenuesRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryDeployerRevenuesRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryAccruedRevenuesRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field DeployerAddress", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ContractAddress", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -1932,7 +3493,7 @@ func (m *QueryDeployerRevenuesRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.DeployerAddress = string(dAtA[iNdEx:postIndex])
+			m.ContractAddress = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
@@ -1991,7 +3552,7 @@ func (m *QueryDeployerRevenuesRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryDeployerRevenuesResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryAccruedRevenuesResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2014,17 +3575,17 @@ func (m *QueryDeployerRevenuesResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryDeployerRevenuesResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryAccruedRevenuesResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryDeployerRevenuesResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryAccruedRevenuesResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ContractAddresses", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field AccruedRevenues", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -2034,23 +3595,25 @@ func (m *QueryDeployerRevenuesResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthQuery
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ContractAddresses = append(m.ContractAddresses, string(dAtA[iNdEx:postIndex]))
+			m.AccruedRevenues = append(m.AccruedRevenues, AccruedRevenue{})
+			if err := m.AccruedRevenues[len(m.AccruedRevenues)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
@@ -2109,7 +3672,7 @@ func (m *QueryDeployerRevenuesResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryWithdrawerRevenuesRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryWithdrawerAccruedRevenuesRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2132,10 +3695,10 @@ func (m *QueryWithdrawerRevenuesRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryWithdrawerRevenuesRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryWithdrawerAccruedRevenuesRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryWithdrawerRevenuesRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryWithdrawerAccruedRevenuesRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -2227,7 +3790,7 @@ func (m *QueryWithdrawerRevenuesRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryWithdrawerRevenuesResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryWithdrawerAccruedRevenuesResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2250,17 +3813,17 @@ func (m *QueryWithdrawerRevenuesResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryWithdrawerRevenuesResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryWithdrawerAccruedRevenuesResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryWithdrawerRevenuesResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryWithdrawerAccruedRevenuesResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ContractAddresses", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field AccruedRevenues", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -2270,23 +3833,25 @@ func (m *QueryWithdrawerRevenuesResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthQuery
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ContractAddresses = append(m.ContractAddresses, string(dAtA[iNdEx:postIndex]))
+			m.AccruedRevenues = append(m.AccruedRevenues, AccruedRevenue{})
+			if err := m.AccruedRevenues[len(m.AccruedRevenues)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
@@ -1,6 +1,9 @@
 package types
 
 import (
+	"fmt"
+	"strings"
+
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/ethereum/go-ethereum/common"
 	ethermint "github.com/evmos/ethermint/types"
@@ -58,5 +61,209 @@ func (fs Revenue) Validate() error {
 		}
 	}
 
+	if len(fs.Withdrawers) > 0 {
+		if fs.WithdrawerAddress != "" {
+			return fmt.Errorf("withdrawer address and withdrawers cannot be both set")
+		}
+
+		if err := ValidateWithdrawers(fs.Withdrawers); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// GetWithdrawerShares returns the accounts receiving the transaction fees of
+// the contract, weighted by their share. If the withdrawers are not defined,
+// it defaults to the withdraw address, or the deployer address, with the full
+// share.
+func (fs Revenue) GetWithdrawerShares() []Withdrawer {
+	if len(fs.Withdrawers) > 0 {
+		return fs.Withdrawers
+	}
+
+	withdrawer := fs.GetWithdrawerAddr()
+	if len(withdrawer) == 0 {
+		withdrawer = fs.GetDeployerAddr()
+	}
+
+	return []Withdrawer{NewWithdrawer(withdrawer, sdk.OneDec())}
+}
+
+// GetWithdrawerMapAddrs returns the addresses of the accounts for which a
+// contract-by-withdrawer mapping is stored
+func (fs Revenue) GetWithdrawerMapAddrs() []sdk.AccAddress {
+	if len(fs.Withdrawers) == 0 {
+		if withdrawer := fs.GetWithdrawerAddr(); len(withdrawer) != 0 {
+			return []sdk.AccAddress{withdrawer}
+		}
+		return nil
+	}
+
+	addrs := make([]sdk.AccAddress, len(fs.Withdrawers))
+	for i, w := range fs.Withdrawers {
+		addrs[i] = w.GetAddr()
+	}
+	return addrs
+}
+
+// NewWithdrawer returns an instance of Withdrawer
+func NewWithdrawer(address sdk.AccAddress, weight sdk.Dec) Withdrawer {
+	return Withdrawer{
+		Address: address.String(),
+		Weight:  weight,
+	}
+}
+
+// GetAddr returns the account address of the withdrawer
+func (w Withdrawer) GetAddr() sdk.AccAddress {
+	return sdk.MustAccAddressFromBech32(w.Address)
+}
+
+// Validate performs a stateless validation of a Withdrawer
+func (w Withdrawer) Validate() error {
+	if _, err := sdk.AccAddressFromBech32(w.Address); err != nil {
+		return err
+	}
+
+	if w.Weight.IsNil() || !w.Weight.IsPositive() {
+		return fmt.Errorf("withdrawer weight must be positive: %s", w.Weight)
+	}
+
+	return nil
+}
+
+// ValidateWithdrawers performs a stateless validation of a weighted list of
+// withdrawers. The withdrawers must be unique and their weights must sum up
+// to one.
+func ValidateWithdrawers(withdrawers []Withdrawer) error {
+	if len(withdrawers) > MaxWithdrawers {
+		return fmt.Errorf("withdrawers cannot exceed %d, got %d", MaxWithdrawers, len(withdrawers))
+	}
+
+	seenWithdrawers := make(map[string]bool)
+	totalWeight := sdk.ZeroDec()
+	for _, w := range withdrawers {
+		if err := w.Validate(); err != nil {
+			return err
+		}
+
+		// only one share per account
+		addr := w.GetAddr().String()
+		if seenWithdrawers[addr] {
+			return fmt.Errorf("duplicated withdrawer %s", w.Address)
+		}
+
+		seenWithdrawers[addr] = true
+		totalWeight = totalWeight.Add(w.Weight)
+	}
+
+	if !totalWeight.Equal(sdk.OneDec()) {
+		return fmt.Errorf("withdrawer weights must sum up to 1, got %s", totalWeight)
+	}
+
+	return nil
+}
+
+// EqualWithdrawers returns true if both weighted lists of withdrawers are
+// equal
+func EqualWithdrawers(a, b []Withdrawer) bool {
+	if len(a) != len(b) {
+		return false
+	}
+
+	for i := range a {
+		if a[i].Address != b[i].Address || !a[i].Weight.Equal(b[i].Weight) {
+			return false
+		}
+	}
+
+	return true
+}
+
+// NewDerivedContract returns an instance of DerivedContract
+func NewDerivedContract(contract, factory common.Address) DerivedContract {
+	return DerivedContract{
+		ContractAddress: contract.String(),
+		FactoryAddress:  factory.String(),
+	}
+}
+
+// GetContractAddr returns the derived contract address
+func (dc DerivedContract) GetContractAddr() common.Address {
+	return common.HexToAddress(dc.ContractAddress)
+}
+
+// GetFactoryAddr returns the address of the registered factory
+func (dc DerivedContract) GetFactoryAddr() common.Address {
+	return common.HexToAddress(dc.FactoryAddress)
+}
+
+// Validate performs a stateless validation of a DerivedContract
+func (dc DerivedContract) Validate() error {
+	if err := ethermint.ValidateNonZeroAddress(dc.ContractAddress); err != nil {
+		return err
+	}
+
+	if err := ethermint.ValidateNonZeroAddress(dc.FactoryAddress); err != nil {
+		return err
+	}
+
+	if dc.GetContractAddr() == dc.GetFactoryAddr() {
+		return fmt.Errorf("contract cannot be derived from itself %s", dc.ContractAddress)
+	}
+
 	return nil
 }
+
+// NewAccruedRevenue returns an instance of AccruedRevenue
+func NewAccruedRevenue(
+	contract common.Address,
+	withdrawer sdk.AccAddress,
+	amount sdk.Coins,
+) AccruedRevenue {
+	return AccruedRevenue{
+		ContractAddress:   contract.String(),
+		WithdrawerAddress: withdrawer.String(),
+		Amount:            amount,
+	}
+}
+
+// GetContractAddr returns the address of the registered contract that accrued
+// the fees
+func (ar AccruedRevenue) GetContractAddr() common.Address {
+	return common.HexToAddress(ar.ContractAddress)
+}
+
+// GetWithdrawerAddr returns the address of the withdrawer entitled to the fees
+func (ar AccruedRevenue) GetWithdrawerAddr() sdk.AccAddress {
+	return sdk.MustAccAddressFromBech32(ar.WithdrawerAddress)
+}
+
+// Validate performs a stateless validation of an AccruedRevenue
+func (ar AccruedRevenue) Validate() error {
+	if err := ethermint.ValidateNonZeroAddress(ar.ContractAddress); err != nil {
+		return err
+	}
+
+	if _, err := sdk.AccAddressFromBech32(ar.WithdrawerAddress); err != nil {
+		return err
+	}
+
+	if !ar.Amount.IsValid() || ar.Amount.IsZero() {
+		return fmt.Errorf("invalid accrued revenue amount %s", ar.Amount)
+	}
+
+	return nil
+}
+
+// FormatWithdrawers returns a comma separated list of the withdrawers and
+// their weights in the "address:weight" format
+func FormatWithdrawers(withdrawers []Withdrawer) string {
+	parts := make([]string, len(withdrawers))
+	for i, w := range withdrawers {
+		parts[i] = fmt.Sprintf("%s:%s", w.Address, w.Weight)
+	}
+	return strings.Join(parts, ",")
+}
@@ -5,6 +5,9 @@ package types
 
 import (
 	fmt "fmt"
+	github_com_cosmos_cosmos_sdk_types "github.com/cosmos/cosmos-sdk/types"
+	types "github.com/cosmos/cosmos-sdk/types"
+	_ "github.com/cosmos/gogoproto/gogoproto"
 	proto "github.com/gogo/protobuf/proto"
 	io "io"
 	math "math"
@@ -33,6 +36,13 @@ type Revenue struct {
 	// withdrawer_address is the bech32 address of account receiving the transaction fees it defaults to
 	// deployer_address
 	WithdrawerAddress string `protobuf:"bytes,3,opt,name=withdrawer_address,json=withdrawerAddress,proto3" json:"withdrawer_address,omitempty"`
+	// factory defines if the contracts derived from the registered contract inherit its revenue
+	Factory bool `protobuf:"varint,4,opt,name=factory,proto3" json:"factory,omitempty"`
+	// nonce is the next creation nonce of the factory that has not been indexed yet
+	Nonce uint64 `protobuf:"varint,5,opt,name=nonce,proto3" json:"nonce,omitempty"`
+	// withdrawers is the weighted list of accounts receiving the transaction fees. If set, it replaces
+	// withdrawer_address
+	Withdrawers []Withdrawer `protobuf:"bytes,6,rep,name=withdrawers,proto3" json:"withdrawers"`
 }
 
 func (m *Revenue) Reset()         { *m = Revenue{} }
@@ -89,27 +99,246 @@ func (m *Revenue) GetWithdrawerAddress() string {
 	return ""
 }
 
+func (m *Revenue) GetFactory() bool {
+	if m != nil {
+		return m.Factory
+	}
+	return false
+}
+
+func (m *Revenue) GetNonce() uint64 {
+	if m != nil {
+		return m.Nonce
+	}
+	return 0
+}
+
+func (m *Revenue) GetWithdrawers() []Withdrawer {
+	if m != nil {
+		return m.Withdrawers
+	}
+	return nil
+}
+
+// Withdrawer defines an account receiving a share of the transaction fees of a
+// registered contract
+type Withdrawer struct {
+	// address is the bech32 address of the account receiving the fees
+	Address string `protobuf:"bytes,1,opt,name=address,proto3" json:"address,omitempty"`
+	// weight is the share of the transaction fees received by the account
+	Weight github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,2,opt,name=weight,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"weight"`
+}
+
+func (m *Withdrawer) Reset()         { *m = Withdrawer{} }
+func (m *Withdrawer) String() string { return proto.CompactTextString(m) }
+func (*Withdrawer) ProtoMessage()    {}
+func (*Withdrawer) Descriptor() ([]byte, []int) {
+	return fileDescriptor_33d087165192e447, []int{1}
+}
+func (m *Withdrawer) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *Withdrawer) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_Withdrawer.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *Withdrawer) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Withdrawer.Merge(m, src)
+}
+func (m *Withdrawer) XXX_Size() int {
+	return m.Size()
+}
+func (m *Withdrawer) XXX_DiscardUnknown() {
+	xxx_messageInfo_Withdrawer.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_Withdrawer proto.InternalMessageInfo
+
+func (m *Withdrawer) GetAddress() string {
+	if m != nil {
+		return m.Address
+	}
+	return ""
+}
+
+// DerivedContract defines a contract created directly or indirectly by a
+// registered factory, which inherits the revenue of the factory
+type DerivedContract struct {
+	// contract_address is the hex address of the derived contract
+	ContractAddress string `protobuf:"bytes,1,opt,name=contract_address,json=contractAddress,proto3" json:"contract_address,omitempty"`
+	// factory_address is the hex address of the registered factory
+	FactoryAddress string `protobuf:"bytes,2,opt,name=factory_address,json=factoryAddress,proto3" json:"factory_address,omitempty"`
+	// nonce is the next creation nonce of the derived contract that has not been indexed yet
+	Nonce uint64 `protobuf:"varint,3,opt,name=nonce,proto3" json:"nonce,omitempty"`
+}
+
+func (m *DerivedContract) Reset()         { *m = DerivedContract{} }
+func (m *DerivedContract) String() string { return proto.CompactTextString(m) }
+func (*DerivedContract) ProtoMessage()    {}
+func (*DerivedContract) Descriptor() ([]byte, []int) {
+	return fileDescriptor_33d087165192e447, []int{2}
+}
+func (m *DerivedContract) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *DerivedContract) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_DerivedContract.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *DerivedContract) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_DerivedContract.Merge(m, src)
+}
+func (m *DerivedContract) XXX_Size() int {
+	return m.Size()
+}
+func (m *DerivedContract) XXX_DiscardUnknown() {
+	xxx_messageInfo_DerivedContract.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_DerivedContract proto.InternalMessageInfo
+
+func (m *DerivedContract) GetContractAddress() string {
+	if m != nil {
+		return m.ContractAddress
+	}
+	return ""
+}
+
+func (m *DerivedContract) GetFactoryAddress() string {
+	if m != nil {
+		return m.FactoryAddress
+	}
+	return ""
+}
+
+func (m *DerivedContract) GetNonce() uint64 {
+	if m != nil {
+		return m.Nonce
+	}
+	return 0
+}
+
+// AccruedRevenue defines the developer fees of a registered contract that are
+// escrowed on the module account for a withdrawer and have not been withdrawn
+// yet
+type AccruedRevenue struct {
+	// contract_address is the hex address of the registered contract, or factory, whose revenue accrued the fees
+	ContractAddress string `protobuf:"bytes,1,opt,name=contract_address,json=contractAddress,proto3" json:"contract_address,omitempty"`
+	// withdrawer_address is the bech32 address of the account entitled to the fees
+	WithdrawerAddress string `protobuf:"bytes,2,opt,name=withdrawer_address,json=withdrawerAddress,proto3" json:"withdrawer_address,omitempty"`
+	// amount is the amount of escrowed fees
+	Amount github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,3,rep,name=amount,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"amount"`
+}
+
+func (m *AccruedRevenue) Reset()         { *m = AccruedRevenue{} }
+func (m *AccruedRevenue) String() string { return proto.CompactTextString(m) }
+func (*AccruedRevenue) ProtoMessage()    {}
+func (*AccruedRevenue) Descriptor() ([]byte, []int) {
+	return fileDescriptor_33d087165192e447, []int{3}
+}
+func (m *AccruedRevenue) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *AccruedRevenue) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_AccruedRevenue.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *AccruedRevenue) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_AccruedRevenue.Merge(m, src)
+}
+func (m *AccruedRevenue) XXX_Size() int {
+	return m.Size()
+}
+func (m *AccruedRevenue) XXX_DiscardUnknown() {
+	xxx_messageInfo_AccruedRevenue.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_AccruedRevenue proto.InternalMessageInfo
+
+func (m *AccruedRevenue) GetContractAddress() string {
+	if m != nil {
+		return m.ContractAddress
+	}
+	return ""
+}
+
+func (m *AccruedRevenue) GetWithdrawerAddress() string {
+	if m != nil {
+		return m.WithdrawerAddress
+	}
+	return ""
+}
+
+func (m *AccruedRevenue) GetAmount() github_com_cosmos_cosmos_sdk_types.Coins {
+	if m != nil {
+		return m.Amount
+	}
+	return nil
+}
+
 func init() {
 	proto.RegisterType((*Revenue)(nil), "evmos.revenue.v1.Revenue")
+	proto.RegisterType((*Withdrawer)(nil), "evmos.revenue.v1.Withdrawer")
+	proto.RegisterType((*DerivedContract)(nil), "evmos.revenue.v1.DerivedContract")
+	proto.RegisterType((*AccruedRevenue)(nil), "evmos.revenue.v1.AccruedRevenue")
 }
 
 func init() { proto.RegisterFile("evmos/revenue/v1/revenue.proto", fileDescriptor_33d087165192e447) }
 
 var fileDescriptor_33d087165192e447 = []byte{
-	// 201 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xe2, 0x92, 0x4b, 0x2d, 0xcb, 0xcd,
-	0x2f, 0xd6, 0x2f, 0x4a, 0x2d, 0x4b, 0xcd, 0x2b, 0x4d, 0xd5, 0x2f, 0x33, 0x84, 0x31, 0xf5, 0x0a,
-	0x8a, 0xf2, 0x4b, 0xf2, 0x85, 0x04, 0xc0, 0xf2, 0x7a, 0x30, 0xc1, 0x32, 0x43, 0xa5, 0x3e, 0x46,
-	0x2e, 0xf6, 0x20, 0x08, 0x57, 0x48, 0x93, 0x4b, 0x20, 0x39, 0x3f, 0xaf, 0xa4, 0x28, 0x31, 0xb9,
-	0x24, 0x3e, 0x31, 0x25, 0xa5, 0x28, 0xb5, 0xb8, 0x58, 0x82, 0x51, 0x81, 0x51, 0x83, 0x33, 0x88,
-	0x1f, 0x26, 0xee, 0x08, 0x11, 0x06, 0x29, 0x4d, 0x49, 0x2d, 0xc8, 0xc9, 0xaf, 0x4c, 0x2d, 0x82,
-	0x2b, 0x65, 0x82, 0x28, 0x85, 0x89, 0xc3, 0x94, 0xea, 0x72, 0x09, 0x95, 0x67, 0x96, 0x64, 0xa4,
-	0x14, 0x25, 0x96, 0x23, 0x29, 0x66, 0x06, 0x2b, 0x16, 0x44, 0xc8, 0x40, 0x95, 0x3b, 0xb9, 0x9c,
-	0x78, 0x24, 0xc7, 0x78, 0xe1, 0x91, 0x1c, 0xe3, 0x83, 0x47, 0x72, 0x8c, 0x13, 0x1e, 0xcb, 0x31,
-	0x5c, 0x78, 0x2c, 0xc7, 0x70, 0xe3, 0xb1, 0x1c, 0x43, 0x94, 0x56, 0x7a, 0x66, 0x49, 0x46, 0x69,
-	0x92, 0x5e, 0x72, 0x7e, 0xae, 0x3e, 0xc4, 0x9f, 0x10, 0xb2, 0xcc, 0xd0, 0x40, 0xbf, 0x02, 0xee,
-	0xe7, 0x92, 0xca, 0x82, 0xd4, 0xe2, 0x24, 0x36, 0xb0, 0x7f, 0x8d, 0x01, 0x01, 0x00, 0x00, 0xff,
-	0xff, 0xea, 0x33, 0x00, 0x72, 0x11, 0x01, 0x00, 0x00,
+	// 448 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x9c, 0x93, 0xc1, 0x6e, 0xd3, 0x30,
+	0x18, 0xc7, 0xeb, 0xb6, 0xeb, 0xc0, 0x93, 0xd6, 0x11, 0xed, 0x10, 0x26, 0xe4, 0x56, 0x3d, 0x40,
+	0x40, 0x9a, 0xb3, 0xc0, 0x13, 0x2c, 0x8b, 0x78, 0x80, 0x5c, 0x90, 0xb8, 0xa0, 0xc4, 0x31, 0x69,
+	0x04, 0xb5, 0x2b, 0xdb, 0x49, 0xa9, 0xb8, 0xf2, 0x00, 0x3c, 0x07, 0x4f, 0xb2, 0x1b, 0x3b, 0x22,
+	0x0e, 0x03, 0xb5, 0x2f, 0x82, 0x1c, 0xc7, 0x69, 0xa8, 0x40, 0x82, 0x5d, 0x5a, 0xfb, 0xef, 0xdf,
+	0xf7, 0x57, 0xfc, 0xff, 0xfc, 0x41, 0x44, 0xab, 0x05, 0x97, 0xbe, 0xa0, 0x15, 0x65, 0x25, 0xf5,
+	0xab, 0xc0, 0x2e, 0xf1, 0x52, 0x70, 0xc5, 0x9d, 0x93, 0xfa, 0x1c, 0x5b, 0xb1, 0x0a, 0xce, 0x10,
+	0xe1, 0x52, 0x97, 0xa4, 0x89, 0xd4, 0x7c, 0x4a, 0x55, 0x12, 0xf8, 0x84, 0x17, 0xcc, 0x54, 0x9c,
+	0x9d, 0xe6, 0x3c, 0xe7, 0xf5, 0xd2, 0xd7, 0x2b, 0xa3, 0xce, 0x3e, 0xf5, 0xe1, 0x61, 0x6c, 0x4c,
+	0x9c, 0xa7, 0xf0, 0x84, 0x70, 0xa6, 0x44, 0x42, 0xd4, 0x9b, 0x24, 0xcb, 0x04, 0x95, 0xd2, 0x05,
+	0x53, 0xe0, 0xdd, 0x8f, 0xc7, 0x56, 0xbf, 0x34, 0xb2, 0x46, 0x33, 0xba, 0x7c, 0xcf, 0xd7, 0x54,
+	0xb4, 0x68, 0xdf, 0xa0, 0x56, 0xb7, 0xe8, 0x39, 0x74, 0x56, 0x85, 0x9a, 0x67, 0x22, 0x59, 0x75,
+	0xe0, 0x41, 0x0d, 0x3f, 0xd8, 0x9d, 0x58, 0xdc, 0x85, 0x87, 0x6f, 0x13, 0xa2, 0xb8, 0x58, 0xbb,
+	0xc3, 0x29, 0xf0, 0xee, 0xc5, 0x76, 0xeb, 0x9c, 0xc2, 0x03, 0xc6, 0x19, 0xa1, 0xee, 0xc1, 0x14,
+	0x78, 0xc3, 0xd8, 0x6c, 0x9c, 0x08, 0x1e, 0xed, 0x4c, 0xa4, 0x3b, 0x9a, 0x0e, 0xbc, 0xa3, 0xe7,
+	0x8f, 0xf0, 0x7e, 0x3c, 0xf8, 0x55, 0x0b, 0x85, 0xc3, 0xeb, 0xdb, 0x49, 0x2f, 0xee, 0x96, 0xcd,
+	0x18, 0x84, 0x3b, 0x40, 0x7f, 0xc3, 0xef, 0xf7, 0xb7, 0x5b, 0xe7, 0x25, 0x1c, 0xad, 0x68, 0x91,
+	0xcf, 0x95, 0xb9, 0x6d, 0x88, 0xb5, 0xd5, 0xf7, 0xdb, 0xc9, 0xe3, 0xbc, 0x50, 0xf3, 0x32, 0xc5,
+	0x84, 0x2f, 0xfc, 0xa6, 0x0f, 0xe6, 0xef, 0x5c, 0x66, 0xef, 0x7c, 0xb5, 0x5e, 0x52, 0x89, 0x23,
+	0x4a, 0xe2, 0xa6, 0x7a, 0xf6, 0x11, 0x8e, 0x23, 0x2a, 0x8a, 0x8a, 0x66, 0x57, 0x4d, 0xb2, 0xff,
+	0x93, 0xfe, 0x13, 0x38, 0x6e, 0x42, 0xd9, 0x0b, 0xff, 0xb8, 0x91, 0x2d, 0xd8, 0x46, 0x36, 0xe8,
+	0x44, 0x36, 0xfb, 0x0a, 0xe0, 0xf1, 0x25, 0x21, 0xa2, 0xa4, 0xd9, 0x1d, 0x5a, 0xff, 0xe7, 0x7e,
+	0xf6, 0xff, 0xd6, 0x4f, 0x02, 0x47, 0xc9, 0x82, 0x97, 0x4c, 0xb9, 0x83, 0xba, 0x35, 0x0f, 0xb1,
+	0x09, 0x06, 0xeb, 0x77, 0x8a, 0x9b, 0x77, 0x8a, 0xaf, 0x78, 0xc1, 0xc2, 0x0b, 0x1d, 0xe6, 0x97,
+	0x1f, 0x13, 0xef, 0x1f, 0xc2, 0xd4, 0x05, 0x32, 0x6e, 0xac, 0xc3, 0xe8, 0x7a, 0x83, 0xc0, 0xcd,
+	0x06, 0x81, 0x9f, 0x1b, 0x04, 0x3e, 0x6f, 0x51, 0xef, 0x66, 0x8b, 0x7a, 0xdf, 0xb6, 0xa8, 0xf7,
+	0xfa, 0x59, 0xc7, 0xcb, 0x8c, 0x94, 0xf9, 0xad, 0x82, 0x0b, 0xff, 0x43, 0x3b, 0x5e, 0xb5, 0x67,
+	0x3a, 0xaa, 0x47, 0xe2, 0xc5, 0xaf, 0x01, 0x00, 0x67, 0xfe, 0xfb, 0xa0, 0x7c, 0x03, 0x00, 0x00,
 }
 
 func (m *Revenue) Marshal() (dAtA []byte, err error) {
@@ -132,6 +361,35 @@ func (m *Revenue) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.Withdrawers) > 0 {
+		for iNdEx := len(m.Withdrawers) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Withdrawers[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintRevenue(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x32
+		}
+	}
+	if m.Nonce != 0 {
+		i = encodeVarintRevenue(dAtA, i, uint64(m.Nonce))
+		i--
+		dAtA[i] = 0x28
+	}
+	if m.Factory {
+		i--
+		if m.Factory {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x20
+	}
 	if len(m.WithdrawerAddress) > 0 {
 		i -= len(m.WithdrawerAddress)
 		copy(dAtA[i:], m.WithdrawerAddress)
@@ -156,6 +414,139 @@ func (m *Revenue) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *Withdrawer) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *Withdrawer) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *Withdrawer) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size := m.Weight.Size()
+		i -= size
+		if _, err := m.Weight.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintRevenue(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if len(m.Address) > 0 {
+		i -= len(m.Address)
+		copy(dAtA[i:], m.Address)
+		i = encodeVarintRevenue(dAtA, i, uint64(len(m.Address)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *DerivedContract) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *DerivedContract) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *DerivedContract) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Nonce != 0 {
+		i = encodeVarintRevenue(dAtA, i, uint64(m.Nonce))
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.FactoryAddress) > 0 {
+		i -= len(m.FactoryAddress)
+		copy(dAtA[i:], m.FactoryAddress)
+		i = encodeVarintRevenue(dAtA, i, uint64(len(m.FactoryAddress)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.ContractAddress) > 0 {
+		i -= len(m.ContractAddress)
+		copy(dAtA[i:], m.ContractAddress)
+		i = encodeVarintRevenue(dAtA, i, uint64(len(m.ContractAddress)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *AccruedRevenue) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *AccruedRevenue) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *AccruedRevenue) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Amount) > 0 {
+		for iNdEx := len(m.Amount) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Amount[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintRevenue(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	if len(m.WithdrawerAddress) > 0 {
+		i -= len(m.WithdrawerAddress)
+		copy(dAtA[i:], m.WithdrawerAddress)
+		i = encodeVarintRevenue(dAtA, i, uint64(len(m.WithdrawerAddress)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.ContractAddress) > 0 {
+		i -= len(m.ContractAddress)
+		copy(dAtA[i:], m.ContractAddress)
+		i = encodeVarintRevenue(dAtA, i, uint64(len(m.ContractAddress)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
 func encodeVarintRevenue(dAtA []byte, offset int, v uint64) int {
 	offset -= sovRevenue(v)
 	base := offset
@@ -181,20 +572,425 @@ func (m *Revenue) Size() (n int) {
 	if l > 0 {
 		n += 1 + l + sovRevenue(uint64(l))
 	}
-	l = len(m.WithdrawerAddress)
-	if l > 0 {
-		n += 1 + l + sovRevenue(uint64(l))
+	l = len(m.WithdrawerAddress)
+	if l > 0 {
+		n += 1 + l + sovRevenue(uint64(l))
+	}
+	if m.Factory {
+		n += 2
+	}
+	if m.Nonce != 0 {
+		n += 1 + sovRevenue(uint64(m.Nonce))
+	}
+	if len(m.Withdrawers) > 0 {
+		for _, e := range m.Withdrawers {
+			l = e.Size()
+			n += 1 + l + sovRevenue(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *Withdrawer) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Address)
+	if l > 0 {
+		n += 1 + l + sovRevenue(uint64(l))
+	}
+	l = m.Weight.Size()
+	n += 1 + l + sovRevenue(uint64(l))
+	return n
+}
+
+func (m *DerivedContract) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ContractAddress)
+	if l > 0 {
+		n += 1 + l + sovRevenue(uint64(l))
+	}
+	l = len(m.FactoryAddress)
+	if l > 0 {
+		n += 1 + l + sovRevenue(uint64(l))
+	}
+	if m.Nonce != 0 {
+		n += 1 + sovRevenue(uint64(m.Nonce))
+	}
+	return n
+}
+
+func (m *AccruedRevenue) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ContractAddress)
+	if l > 0 {
+		n += 1 + l + sovRevenue(uint64(l))
+	}
+	l = len(m.WithdrawerAddress)
+	if l > 0 {
+		n += 1 + l + sovRevenue(uint64(l))
+	}
+	if len(m.Amount) > 0 {
+		for _, e := range m.Amount {
+			l = e.Size()
+			n += 1 + l + sovRevenue(uint64(l))
+		}
+	}
+	return n
+}
+
+func sovRevenue(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozRevenue(x uint64) (n int) {
+	return sovRevenue(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *Revenue) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowRevenue
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Revenue: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Revenue: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ContractAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRevenue
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthRevenue
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthRevenue
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ContractAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DeployerAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRevenue
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthRevenue
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthRevenue
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.DeployerAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field WithdrawerAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRevenue
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthRevenue
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthRevenue
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.WithdrawerAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Factory", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRevenue
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Factory = bool(v != 0)
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Nonce", wireType)
+			}
+			m.Nonce = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRevenue
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Nonce |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Withdrawers", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRevenue
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthRevenue
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthRevenue
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Withdrawers = append(m.Withdrawers, Withdrawer{})
+			if err := m.Withdrawers[len(m.Withdrawers)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipRevenue(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthRevenue
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
 	}
-	return n
+	return nil
 }
+func (m *Withdrawer) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowRevenue
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Withdrawer: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Withdrawer: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRevenue
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthRevenue
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthRevenue
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Address = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Weight", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRevenue
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthRevenue
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthRevenue
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Weight.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipRevenue(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthRevenue
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
 
-func sovRevenue(x uint64) (n int) {
-	return (math_bits.Len64(x|1) + 6) / 7
-}
-func sozRevenue(x uint64) (n int) {
-	return sovRevenue(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
 }
-func (m *Revenue) Unmarshal(dAtA []byte) error {
+func (m *DerivedContract) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -217,10 +1013,10 @@ func (m *Revenue) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: Revenue: wiretype end group for non-group")
+			return fmt.Errorf("proto: DerivedContract: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: Revenue: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: DerivedContract: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -257,7 +1053,7 @@ func (m *Revenue) Unmarshal(dAtA []byte) error {
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field DeployerAddress", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field FactoryAddress", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -285,9 +1081,110 @@ func (m *Revenue) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.DeployerAddress = string(dAtA[iNdEx:postIndex])
+			m.FactoryAddress = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Nonce", wireType)
+			}
+			m.Nonce = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRevenue
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Nonce |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipRevenue(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthRevenue
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *AccruedRevenue) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowRevenue
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: AccruedRevenue: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: AccruedRevenue: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ContractAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRevenue
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthRevenue
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthRevenue
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ContractAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
 			if wireType != 2 {
 				return fmt.Errorf("proto: wrong wireType = %d for field WithdrawerAddress", wireType)
 			}
@@ -319,6 +1216,40 @@ func (m *Revenue) Unmarshal(dAtA []byte) error {
 			}
 			m.WithdrawerAddress = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRevenue
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthRevenue
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthRevenue
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Amount = append(m.Amount, types.Coin{})
+			if err := m.Amount[len(m.Amount)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipRevenue(dAtA[iNdEx:])
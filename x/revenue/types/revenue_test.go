@@ -87,6 +87,9 @@ func (suite *RevenueTestSuite) TestFee() {
 				tests.GenerateAddress().String(),
 				suite.address1.String(),
 				suite.address2.String(),
+				false,
+				0,
+				nil,
 			},
 			true,
 		},
@@ -96,6 +99,9 @@ func (suite *RevenueTestSuite) TestFee() {
 				"0x5dCA2483280D9727c80b5518faC4556617fb19ZZ",
 				suite.address1.String(),
 				suite.address2.String(),
+				false,
+				0,
+				nil,
 			},
 			false,
 		},
@@ -105,6 +111,9 @@ func (suite *RevenueTestSuite) TestFee() {
 				"0x5dCA2483280D9727c80b5518faC4556617fb19",
 				suite.address1.String(),
 				suite.address2.String(),
+				false,
+				0,
+				nil,
 			},
 			false,
 		},
@@ -114,6 +123,9 @@ func (suite *RevenueTestSuite) TestFee() {
 				"0x5dCA2483280D9727c80b5518faC4556617fb194FFF",
 				suite.address1.String(),
 				suite.address2.String(),
+				false,
+				0,
+				nil,
 			},
 			false,
 		},
@@ -123,6 +135,36 @@ func (suite *RevenueTestSuite) TestFee() {
 				tests.GenerateAddress().String(),
 				"evmos14mq5c8yn9jx295ahaxye2f0xw3tlell0lt542Z",
 				suite.address2.String(),
+				false,
+				0,
+				nil,
+			},
+			false,
+		},
+		{
+			"Create revenue- weighted withdrawers",
+			Revenue{
+				tests.GenerateAddress().String(),
+				suite.address1.String(),
+				"",
+				false,
+				0,
+				[]Withdrawer{
+					NewWithdrawer(suite.address1, sdk.NewDecWithPrec(6, 1)),
+					NewWithdrawer(suite.address2, sdk.NewDecWithPrec(4, 1)),
+				},
+			},
+			true,
+		},
+		{
+			"Create revenue- withdraw address and weighted withdrawers",
+			Revenue{
+				tests.GenerateAddress().String(),
+				suite.address1.String(),
+				suite.address2.String(),
+				false,
+				0,
+				[]Withdrawer{NewWithdrawer(suite.address1, sdk.OneDec())},
 			},
 			false,
 		},
@@ -132,6 +174,9 @@ func (suite *RevenueTestSuite) TestFee() {
 				tests.GenerateAddress().String(),
 				suite.address1.String(),
 				"evmos14mq5c8yn9jx295ahaxye2f0xw3tlell0lt542Z",
+				false,
+				0,
+				nil,
 			},
 			false,
 		},
@@ -154,6 +199,9 @@ func (suite *RevenueTestSuite) TestRevenueGetters() {
 		contract.String(),
 		suite.address1.String(),
 		suite.address2.String(),
+		false,
+		0,
+		nil,
 	}
 	suite.Equal(fs.GetContractAddr(), contract)
 	suite.Equal(fs.GetDeployerAddr(), suite.address1)
@@ -163,8 +211,167 @@ func (suite *RevenueTestSuite) TestRevenueGetters() {
 		contract.String(),
 		suite.address1.String(),
 		"",
+		false,
+		0,
+		nil,
 	}
 	suite.Equal(fs.GetContractAddr(), contract)
 	suite.Equal(fs.GetDeployerAddr(), suite.address1)
 	suite.Equal(len(fs.GetWithdrawerAddr()), 0)
 }
+
+func (suite *RevenueTestSuite) TestDerivedContract() {
+	factory := tests.GenerateAddress()
+
+	testCases := []struct {
+		msg             string
+		derivedContract DerivedContract
+		expectPass      bool
+	}{
+		{
+			"Create derived contract - pass",
+			NewDerivedContract(tests.GenerateAddress(), factory),
+			true,
+		},
+		{
+			"Create derived contract - invalid contract address",
+			NewDerivedContract(common.Address{}, factory),
+			false,
+		},
+		{
+			"Create derived contract - invalid factory address",
+			NewDerivedContract(tests.GenerateAddress(), common.Address{}),
+			false,
+		},
+		{
+			"Create derived contract - derived from itself",
+			NewDerivedContract(factory, factory),
+			false,
+		},
+	}
+
+	for _, tc := range testCases {
+		err := tc.derivedContract.Validate()
+
+		if tc.expectPass {
+			suite.Require().NoError(err, tc.msg)
+		} else {
+			suite.Require().Error(err, tc.msg)
+		}
+	}
+}
+
+func (suite *RevenueTestSuite) TestValidateWithdrawers() {
+	withdrawers := func(weights ...int64) []Withdrawer {
+		ws := make([]Withdrawer, len(weights))
+		for i, weight := range weights {
+			ws[i] = NewWithdrawer(sdk.AccAddress(tests.GenerateAddress().Bytes()), sdk.NewDecWithPrec(weight, 2))
+		}
+		return ws
+	}
+
+	testCases := []struct {
+		name        string
+		withdrawers []Withdrawer
+		expectPass  bool
+	}{
+		{"pass - single withdrawer", withdrawers(100), true},
+		{"pass - weights sum up to 1", withdrawers(50, 30, 20), true},
+		{"fail - weights sum up to less than 1", withdrawers(50, 30), false},
+		{"fail - weights sum up to more than 1", withdrawers(50, 60), false},
+		{"fail - zero weight", withdrawers(100, 0), false},
+		{"fail - negative weight", withdrawers(110, -10), false},
+		{"fail - too many withdrawers", withdrawers(10, 10, 10, 10, 10, 10, 10, 10, 10, 5, 5), false},
+		{
+			"fail - duplicated withdrawer",
+			[]Withdrawer{
+				NewWithdrawer(suite.address1, sdk.NewDecWithPrec(5, 1)),
+				NewWithdrawer(suite.address1, sdk.NewDecWithPrec(5, 1)),
+			},
+			false,
+		},
+		{
+			"fail - invalid address",
+			[]Withdrawer{{Address: "evmos14mq5c8yn9jx295ahaxye2f0xw3tlell0lt542Z", Weight: sdk.OneDec()}},
+			false,
+		},
+	}
+
+	for _, tc := range testCases {
+		err := ValidateWithdrawers(tc.withdrawers)
+
+		if tc.expectPass {
+			suite.Require().NoError(err, tc.name)
+		} else {
+			suite.Require().Error(err, tc.name)
+		}
+	}
+}
+
+func (suite *RevenueTestSuite) TestGetWithdrawerShares() {
+	contract := tests.GenerateAddress()
+
+	revenue := NewRevenue(contract, suite.address1, nil)
+	suite.Require().Equal([]Withdrawer{NewWithdrawer(suite.address1, sdk.OneDec())}, revenue.GetWithdrawerShares())
+	suite.Require().Empty(revenue.GetWithdrawerMapAddrs())
+
+	revenue = NewRevenue(contract, suite.address1, suite.address2)
+	suite.Require().Equal([]Withdrawer{NewWithdrawer(suite.address2, sdk.OneDec())}, revenue.GetWithdrawerShares())
+	suite.Require().Equal([]sdk.AccAddress{suite.address2}, revenue.GetWithdrawerMapAddrs())
+
+	withdrawers := []Withdrawer{
+		NewWithdrawer(suite.address1, sdk.NewDecWithPrec(6, 1)),
+		NewWithdrawer(suite.address2, sdk.NewDecWithPrec(4, 1)),
+	}
+	revenue = NewRevenue(contract, suite.address1, nil)
+	revenue.Withdrawers = withdrawers
+	suite.Require().Equal(withdrawers, revenue.GetWithdrawerShares())
+	suite.Require().Equal([]sdk.AccAddress{suite.address1, suite.address2}, revenue.GetWithdrawerMapAddrs())
+}
+
+func (suite *RevenueTestSuite) TestAccruedRevenue() {
+	contract := tests.GenerateAddress()
+	amount := sdk.NewCoins(sdk.NewCoin("aevmos", sdk.NewInt(100)))
+
+	testCases := []struct {
+		msg            string
+		accruedRevenue AccruedRevenue
+		expectPass     bool
+	}{
+		{
+			"Create accrued revenue - pass",
+			NewAccruedRevenue(contract, suite.address1, amount),
+			true,
+		},
+		{
+			"Create accrued revenue - invalid contract address",
+			NewAccruedRevenue(common.Address{}, suite.address1, amount),
+			false,
+		},
+		{
+			"Create accrued revenue - invalid withdrawer address",
+			AccruedRevenue{ContractAddress: contract.String(), Amount: amount},
+			false,
+		},
+		{
+			"Create accrued revenue - empty amount",
+			NewAccruedRevenue(contract, suite.address1, sdk.Coins{}),
+			false,
+		},
+		{
+			"Create accrued revenue - invalid amount",
+			NewAccruedRevenue(contract, suite.address1, sdk.Coins{{Denom: "aevmos", Amount: sdk.NewInt(-1)}}),
+			false,
+		},
+	}
+
+	for _, tc := range testCases {
+		err := tc.accruedRevenue.Validate()
+
+		if tc.expectPass {
+			suite.Require().NoError(err, tc.msg)
+		} else {
+			suite.Require().Error(err, tc.msg)
+		}
+	}
+}
@@ -6,6 +6,8 @@ package types
 import (
 	context "context"
 	fmt "fmt"
+	github_com_cosmos_cosmos_sdk_types "github.com/cosmos/cosmos-sdk/types"
+	types "github.com/cosmos/cosmos-sdk/types"
 	_ "github.com/cosmos/gogoproto/gogoproto"
 	grpc1 "github.com/gogo/protobuf/grpc"
 	proto "github.com/gogo/protobuf/proto"
@@ -42,6 +44,11 @@ type MsgRegisterRevenue struct {
 	// that determines the contract's address - it can be an EOA nonce or a
 	// factory contract nonce
 	Nonces []uint64 `protobuf:"varint,4,rep,packed,name=nonces,proto3" json:"nonces,omitempty"`
+	// factory defines if the contracts created by the registered contract inherit its revenue
+	Factory bool `protobuf:"varint,5,opt,name=factory,proto3" json:"factory,omitempty"`
+	// withdrawers is the weighted list of accounts receiving the transaction fees. It cannot be set
+	// along with withdrawer_address
+	Withdrawers []Withdrawer `protobuf:"bytes,6,rep,name=withdrawers,proto3" json:"withdrawers"`
 }
 
 func (m *MsgRegisterRevenue) Reset()         { *m = MsgRegisterRevenue{} }
@@ -105,6 +112,20 @@ func (m *MsgRegisterRevenue) GetNonces() []uint64 {
 	return nil
 }
 
+func (m *MsgRegisterRevenue) GetFactory() bool {
+	if m != nil {
+		return m.Factory
+	}
+	return false
+}
+
+func (m *MsgRegisterRevenue) GetWithdrawers() []Withdrawer {
+	if m != nil {
+		return m.Withdrawers
+	}
+	return nil
+}
+
 // MsgRegisterRevenueResponse defines the MsgRegisterRevenue response type
 type MsgRegisterRevenueResponse struct {
 }
@@ -152,6 +173,9 @@ type MsgUpdateRevenue struct {
 	DeployerAddress string `protobuf:"bytes,2,opt,name=deployer_address,json=deployerAddress,proto3" json:"deployer_address,omitempty"`
 	// withdrawer_address is the bech32 address of account receiving the transaction fees
 	WithdrawerAddress string `protobuf:"bytes,3,opt,name=withdrawer_address,json=withdrawerAddress,proto3" json:"withdrawer_address,omitempty"`
+	// withdrawers is the weighted list of accounts receiving the transaction fees. It cannot be set
+	// along with withdrawer_address
+	Withdrawers []Withdrawer `protobuf:"bytes,4,rep,name=withdrawers,proto3" json:"withdrawers"`
 }
 
 func (m *MsgUpdateRevenue) Reset()         { *m = MsgUpdateRevenue{} }
@@ -208,6 +232,13 @@ func (m *MsgUpdateRevenue) GetWithdrawerAddress() string {
 	return ""
 }
 
+func (m *MsgUpdateRevenue) GetWithdrawers() []Withdrawer {
+	if m != nil {
+		return m.Withdrawers
+	}
+	return nil
+}
+
 // MsgUpdateRevenueResponse defines the MsgUpdateRevenue response type
 type MsgUpdateRevenueResponse struct {
 }
@@ -338,6 +369,230 @@ func (m *MsgCancelRevenueResponse) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_MsgCancelRevenueResponse proto.InternalMessageInfo
 
+// MsgRegisterDerivedContract defines a message that registers a contract
+// created with CREATE2 by a registered factory or by one of its derived
+// contracts
+type MsgRegisterDerivedContract struct {
+	// sender_address is the bech32 address of message sender
+	SenderAddress string `protobuf:"bytes,1,opt,name=sender_address,json=senderAddress,proto3" json:"sender_address,omitempty"`
+	// contract_address of the derived contract in hex format
+	ContractAddress string `protobuf:"bytes,2,opt,name=contract_address,json=contractAddress,proto3" json:"contract_address,omitempty"`
+	// creator_address is the hex address of the contract that created the derived contract
+	CreatorAddress string `protobuf:"bytes,3,opt,name=creator_address,json=creatorAddress,proto3" json:"creator_address,omitempty"`
+	// salt used for the CREATE2 deployment in hex format
+	Salt string `protobuf:"bytes,4,opt,name=salt,proto3" json:"salt,omitempty"`
+	// init_code_hash is the keccak256 hash of the contract creation code in hex format
+	InitCodeHash string `protobuf:"bytes,5,opt,name=init_code_hash,json=initCodeHash,proto3" json:"init_code_hash,omitempty"`
+}
+
+func (m *MsgRegisterDerivedContract) Reset()         { *m = MsgRegisterDerivedContract{} }
+func (m *MsgRegisterDerivedContract) String() string { return proto.CompactTextString(m) }
+func (*MsgRegisterDerivedContract) ProtoMessage()    {}
+func (*MsgRegisterDerivedContract) Descriptor() ([]byte, []int) {
+	return fileDescriptor_2a6d23524264890a, []int{6}
+}
+func (m *MsgRegisterDerivedContract) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgRegisterDerivedContract) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgRegisterDerivedContract.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgRegisterDerivedContract) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgRegisterDerivedContract.Merge(m, src)
+}
+func (m *MsgRegisterDerivedContract) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgRegisterDerivedContract) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgRegisterDerivedContract.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgRegisterDerivedContract proto.InternalMessageInfo
+
+func (m *MsgRegisterDerivedContract) GetSenderAddress() string {
+	if m != nil {
+		return m.SenderAddress
+	}
+	return ""
+}
+
+func (m *MsgRegisterDerivedContract) GetContractAddress() string {
+	if m != nil {
+		return m.ContractAddress
+	}
+	return ""
+}
+
+func (m *MsgRegisterDerivedContract) GetCreatorAddress() string {
+	if m != nil {
+		return m.CreatorAddress
+	}
+	return ""
+}
+
+func (m *MsgRegisterDerivedContract) GetSalt() string {
+	if m != nil {
+		return m.Salt
+	}
+	return ""
+}
+
+func (m *MsgRegisterDerivedContract) GetInitCodeHash() string {
+	if m != nil {
+		return m.InitCodeHash
+	}
+	return ""
+}
+
+// MsgRegisterDerivedContractResponse defines the MsgRegisterDerivedContract response type
+type MsgRegisterDerivedContractResponse struct {
+}
+
+func (m *MsgRegisterDerivedContractResponse) Reset()         { *m = MsgRegisterDerivedContractResponse{} }
+func (m *MsgRegisterDerivedContractResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgRegisterDerivedContractResponse) ProtoMessage()    {}
+func (*MsgRegisterDerivedContractResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_2a6d23524264890a, []int{7}
+}
+func (m *MsgRegisterDerivedContractResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgRegisterDerivedContractResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgRegisterDerivedContractResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgRegisterDerivedContractResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgRegisterDerivedContractResponse.Merge(m, src)
+}
+func (m *MsgRegisterDerivedContractResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgRegisterDerivedContractResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgRegisterDerivedContractResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgRegisterDerivedContractResponse proto.InternalMessageInfo
+
+// MsgWithdrawRevenue defines a message that withdraws the accrued developer
+// fees of a withdrawer
+type MsgWithdrawRevenue struct {
+	// withdrawer_address is the bech32 address of message sender
+	WithdrawerAddress string `protobuf:"bytes,1,opt,name=withdrawer_address,json=withdrawerAddress,proto3" json:"withdrawer_address,omitempty"`
+	// contract_address is the hex address of the contract to withdraw the fees
+	// from. If empty, the fees accrued from all contracts are withdrawn
+	ContractAddress string `protobuf:"bytes,2,opt,name=contract_address,json=contractAddress,proto3" json:"contract_address,omitempty"`
+}
+
+func (m *MsgWithdrawRevenue) Reset()         { *m = MsgWithdrawRevenue{} }
+func (m *MsgWithdrawRevenue) String() string { return proto.CompactTextString(m) }
+func (*MsgWithdrawRevenue) ProtoMessage()    {}
+func (*MsgWithdrawRevenue) Descriptor() ([]byte, []int) {
+	return fileDescriptor_2a6d23524264890a, []int{8}
+}
+func (m *MsgWithdrawRevenue) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgWithdrawRevenue) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgWithdrawRevenue.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgWithdrawRevenue) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgWithdrawRevenue.Merge(m, src)
+}
+func (m *MsgWithdrawRevenue) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgWithdrawRevenue) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgWithdrawRevenue.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgWithdrawRevenue proto.InternalMessageInfo
+
+func (m *MsgWithdrawRevenue) GetWithdrawerAddress() string {
+	if m != nil {
+		return m.WithdrawerAddress
+	}
+	return ""
+}
+
+func (m *MsgWithdrawRevenue) GetContractAddress() string {
+	if m != nil {
+		return m.ContractAddress
+	}
+	return ""
+}
+
+// MsgWithdrawRevenueResponse returns the withdrawn fees
+type MsgWithdrawRevenueResponse struct {
+	// amount is the amount of withdrawn fees
+	Amount github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,1,rep,name=amount,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"amount"`
+}
+
+func (m *MsgWithdrawRevenueResponse) Reset()         { *m = MsgWithdrawRevenueResponse{} }
+func (m *MsgWithdrawRevenueResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgWithdrawRevenueResponse) ProtoMessage()    {}
+func (*MsgWithdrawRevenueResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_2a6d23524264890a, []int{9}
+}
+func (m *MsgWithdrawRevenueResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgWithdrawRevenueResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgWithdrawRevenueResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgWithdrawRevenueResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgWithdrawRevenueResponse.Merge(m, src)
+}
+func (m *MsgWithdrawRevenueResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgWithdrawRevenueResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgWithdrawRevenueResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgWithdrawRevenueResponse proto.InternalMessageInfo
+
+func (m *MsgWithdrawRevenueResponse) GetAmount() github_com_cosmos_cosmos_sdk_types.Coins {
+	if m != nil {
+		return m.Amount
+	}
+	return nil
+}
+
 func init() {
 	proto.RegisterType((*MsgRegisterRevenue)(nil), "evmos.revenue.v1.MsgRegisterRevenue")
 	proto.RegisterType((*MsgRegisterRevenueResponse)(nil), "evmos.revenue.v1.MsgRegisterRevenueResponse")
@@ -345,40 +600,62 @@ func init() {
 	proto.RegisterType((*MsgUpdateRevenueResponse)(nil), "evmos.revenue.v1.MsgUpdateRevenueResponse")
 	proto.RegisterType((*MsgCancelRevenue)(nil), "evmos.revenue.v1.MsgCancelRevenue")
 	proto.RegisterType((*MsgCancelRevenueResponse)(nil), "evmos.revenue.v1.MsgCancelRevenueResponse")
+	proto.RegisterType((*MsgRegisterDerivedContract)(nil), "evmos.revenue.v1.MsgRegisterDerivedContract")
+	proto.RegisterType((*MsgRegisterDerivedContractResponse)(nil), "evmos.revenue.v1.MsgRegisterDerivedContractResponse")
+	proto.RegisterType((*MsgWithdrawRevenue)(nil), "evmos.revenue.v1.MsgWithdrawRevenue")
+	proto.RegisterType((*MsgWithdrawRevenueResponse)(nil), "evmos.revenue.v1.MsgWithdrawRevenueResponse")
 }
 
 func init() { proto.RegisterFile("evmos/revenue/v1/tx.proto", fileDescriptor_2a6d23524264890a) }
 
 var fileDescriptor_2a6d23524264890a = []byte{
-	// 437 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xcc, 0x94, 0x4f, 0x8b, 0xd3, 0x40,
-	0x14, 0xc0, 0x33, 0x9b, 0xb2, 0xe0, 0x80, 0x6c, 0x0d, 0x22, 0x35, 0x2c, 0x71, 0x89, 0x2e, 0xae,
-	0xd5, 0x66, 0xac, 0xde, 0xbc, 0xf9, 0xe7, 0xda, 0x4b, 0xc0, 0x8b, 0x97, 0x32, 0x4d, 0x1e, 0xd3,
-	0x40, 0x3b, 0x13, 0x66, 0xa6, 0x69, 0x7b, 0xf5, 0xec, 0x41, 0xd0, 0xab, 0xe0, 0xb7, 0x10, 0xfc,
-	0x04, 0x1e, 0x0b, 0x5e, 0x3c, 0x4a, 0xeb, 0xc1, 0x8f, 0x21, 0x9d, 0xfc, 0xd1, 0xb4, 0x91, 0x7a,
-	0x11, 0xf6, 0x12, 0x92, 0xf7, 0x7e, 0xef, 0xcd, 0x6f, 0x78, 0x8f, 0xe0, 0x9b, 0x90, 0x4d, 0x85,
-	0x22, 0x12, 0x32, 0xe0, 0x33, 0x20, 0x59, 0x9f, 0xe8, 0x45, 0x90, 0x4a, 0xa1, 0x85, 0xd3, 0x36,
-	0xa9, 0xa0, 0x48, 0x05, 0x59, 0xdf, 0xbd, 0xce, 0x04, 0x13, 0x26, 0x49, 0xb6, 0x6f, 0x39, 0xe7,
-	0x9e, 0x32, 0x21, 0xd8, 0x04, 0x08, 0x4d, 0x13, 0x42, 0x39, 0x17, 0x9a, 0xea, 0x44, 0x70, 0x95,
-	0x67, 0xfd, 0x4f, 0x08, 0x3b, 0x03, 0xc5, 0x42, 0x60, 0x89, 0xd2, 0x20, 0xc3, 0xbc, 0x9b, 0x73,
-	0x0f, 0xb7, 0x23, 0xc1, 0xb5, 0xa4, 0x91, 0x1e, 0xd2, 0x38, 0x96, 0xa0, 0x54, 0x07, 0x9d, 0xa1,
-	0x8b, 0x2b, 0xe1, 0x49, 0x19, 0x7f, 0x9a, 0x87, 0xb7, 0x68, 0x0c, 0xe9, 0x44, 0x2c, 0x41, 0x56,
-	0xe8, 0x51, 0x8e, 0x96, 0xf1, 0x12, 0xed, 0x61, 0x67, 0x9e, 0xe8, 0x71, 0x2c, 0xe9, 0xfc, 0x0f,
-	0xd8, 0x36, 0xf0, 0xb5, 0xdf, 0x99, 0x12, 0xbf, 0x81, 0x8f, 0xb9, 0xe0, 0x11, 0xa8, 0x4e, 0xeb,
-	0xcc, 0xbe, 0x68, 0x85, 0xc5, 0xd7, 0x93, 0xd6, 0xcf, 0x8f, 0xb7, 0x2c, 0xff, 0x14, 0xbb, 0xfb,
-	0xe2, 0x21, 0xa8, 0x54, 0x70, 0x05, 0xfe, 0x07, 0x84, 0xdb, 0x03, 0xc5, 0x5e, 0xa6, 0x31, 0xd5,
-	0x70, 0x99, 0x6e, 0x55, 0xd8, 0xbb, 0xb8, 0xb3, 0xab, 0x57, 0xb9, 0x73, 0xa3, 0xfe, 0x9c, 0xf2,
-	0x08, 0x26, 0xff, 0x55, 0xbd, 0xe6, 0x52, 0x3b, 0xaf, 0x74, 0x79, 0xf4, 0xd9, 0xc6, 0xf6, 0x40,
-	0x31, 0xe7, 0x3d, 0xc2, 0x27, 0xbb, 0x4b, 0x72, 0x27, 0xd8, 0x5d, 0xc1, 0x60, 0x7f, 0x22, 0xee,
-	0x83, 0x7f, 0xa1, 0xaa, 0xbb, 0xf7, 0x5e, 0x7f, 0xfd, 0xf1, 0xee, 0xe8, 0xae, 0x7f, 0x4e, 0x1a,
-	0x36, 0x9f, 0xc8, 0xa2, 0x6a, 0x58, 0x84, 0x9d, 0x37, 0x08, 0x5f, 0xad, 0xcf, 0xd8, 0x6f, 0x3c,
-	0xae, 0xc6, 0xb8, 0xdd, 0xc3, 0x4c, 0x25, 0x74, 0xdf, 0x08, 0x9d, 0xfb, 0xb7, 0x1b, 0x85, 0x66,
-	0xa6, 0xa6, 0xa6, 0x53, 0x9f, 0x5b, 0xb3, 0x4e, 0x8d, 0xf9, 0x8b, 0x4e, 0xe3, 0x3c, 0x0e, 0xe8,
-	0x44, 0xa6, 0xa6, 0xd4, 0x79, 0xf6, 0xe2, 0xcb, 0xda, 0x43, 0xab, 0xb5, 0x87, 0xbe, 0xaf, 0x3d,
-	0xf4, 0x76, 0xe3, 0x59, 0xab, 0x8d, 0x67, 0x7d, 0xdb, 0x78, 0xd6, 0xab, 0x2e, 0x4b, 0xf4, 0x78,
-	0x36, 0x0a, 0x22, 0x31, 0x2d, 0x1a, 0xe5, 0xcf, 0xac, 0xff, 0x90, 0x2c, 0xaa, 0xa6, 0x7a, 0x99,
-	0x82, 0x1a, 0x1d, 0x9b, 0x3f, 0xc5, 0xe3, 0x5f, 0x01, 0x00, 0x00, 0xff, 0xff, 0xf1, 0xd5, 0x96,
-	0x46, 0x8c, 0x04, 0x00, 0x00,
+	// 734 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xcc, 0x56, 0xbf, 0x6f, 0xd3, 0x40,
+	0x14, 0x8e, 0x13, 0x13, 0xe8, 0x95, 0xfe, 0xc0, 0x42, 0xe0, 0x5a, 0x95, 0x1b, 0x99, 0x56, 0x0d,
+	0xa5, 0xb5, 0x9b, 0x82, 0x18, 0xd8, 0x68, 0x3a, 0xb0, 0x74, 0xb1, 0x84, 0x90, 0x58, 0xa2, 0x8b,
+	0x7d, 0x38, 0x16, 0xe9, 0x5d, 0xe4, 0xbb, 0xa4, 0xed, 0x08, 0x1b, 0x12, 0x03, 0x12, 0x48, 0xac,
+	0xcc, 0xec, 0xfc, 0x0f, 0x1d, 0x2b, 0x31, 0xc0, 0x04, 0xa8, 0x61, 0xe0, 0xcf, 0x40, 0xbe, 0xf3,
+	0xb9, 0xb1, 0xe3, 0xb6, 0x41, 0x08, 0x89, 0xa5, 0x3d, 0xbf, 0xf7, 0xbd, 0x77, 0xdf, 0x7d, 0xef,
+	0x87, 0x02, 0x16, 0xd0, 0x60, 0x8f, 0x50, 0x27, 0x42, 0x03, 0x84, 0xfb, 0xc8, 0x19, 0x34, 0x1c,
+	0x76, 0x60, 0xf7, 0x22, 0xc2, 0x88, 0x36, 0xcf, 0x5d, 0x76, 0xe2, 0xb2, 0x07, 0x0d, 0xc3, 0xf4,
+	0x08, 0x8d, 0xd1, 0x6d, 0x48, 0x63, 0x68, 0x1b, 0x31, 0xd8, 0x70, 0x3c, 0x12, 0x62, 0x11, 0x61,
+	0x98, 0x63, 0xc9, 0x64, 0xb0, 0xf0, 0x5f, 0x0f, 0x48, 0x40, 0xf8, 0xd1, 0x89, 0x4f, 0x89, 0x75,
+	0x31, 0x20, 0x24, 0xe8, 0x22, 0x07, 0xf6, 0x42, 0x07, 0x62, 0x4c, 0x18, 0x64, 0x21, 0xc1, 0x54,
+	0x78, 0xad, 0xf7, 0x65, 0xa0, 0xed, 0xd2, 0xc0, 0x45, 0x41, 0x48, 0x19, 0x8a, 0x5c, 0x91, 0x50,
+	0xbb, 0x0d, 0xe6, 0x3d, 0x82, 0x59, 0x04, 0x3d, 0xd6, 0x82, 0xbe, 0x1f, 0x21, 0x4a, 0x75, 0xa5,
+	0xa6, 0xd4, 0xa7, 0xdc, 0x39, 0x69, 0x7f, 0x28, 0xcc, 0x31, 0xd4, 0x47, 0xbd, 0x2e, 0x39, 0x44,
+	0x51, 0x0a, 0x2d, 0x0b, 0xa8, 0xb4, 0x4b, 0xe8, 0x06, 0xd0, 0xf6, 0x43, 0xd6, 0xf1, 0x23, 0xb8,
+	0x3f, 0x02, 0xae, 0x70, 0xf0, 0xb5, 0x53, 0x8f, 0x84, 0xdf, 0x00, 0x55, 0x4c, 0xb0, 0x87, 0xa8,
+	0xae, 0xd6, 0x2a, 0x75, 0xd5, 0x4d, 0xbe, 0x34, 0x1d, 0x5c, 0x7e, 0x06, 0x3d, 0x46, 0xa2, 0x43,
+	0xfd, 0x52, 0x4d, 0xa9, 0x5f, 0x71, 0xe5, 0xa7, 0xb6, 0x03, 0xa6, 0x4f, 0xd3, 0x50, 0xbd, 0x5a,
+	0xab, 0xd4, 0xa7, 0xb7, 0x16, 0xed, 0xbc, 0xd2, 0xf6, 0x93, 0x14, 0xb4, 0xad, 0x1e, 0x7d, 0x5b,
+	0x2a, 0xb9, 0xa3, 0x61, 0x0f, 0xd4, 0x5f, 0x1f, 0x96, 0x4a, 0xd6, 0x22, 0x30, 0xc6, 0x85, 0x71,
+	0x11, 0xed, 0x11, 0x4c, 0x91, 0x35, 0x54, 0xc0, 0xfc, 0x2e, 0x0d, 0x1e, 0xf7, 0x7c, 0xc8, 0xd0,
+	0x7f, 0xa5, 0x5a, 0x4e, 0x03, 0xf5, 0x6f, 0x34, 0x30, 0x80, 0x9e, 0x7f, 0x64, 0xaa, 0x00, 0xe6,
+	0x02, 0x34, 0x21, 0xf6, 0x50, 0xf7, 0x9f, 0x0a, 0x90, 0xe1, 0x92, 0xb9, 0x2f, 0xe5, 0xf2, 0x45,
+	0xc9, 0x14, 0x6b, 0x07, 0x45, 0xe1, 0x00, 0xf9, 0xcd, 0xe4, 0x4a, 0x6d, 0x05, 0xcc, 0x52, 0x84,
+	0x7d, 0x14, 0xe5, 0x48, 0xcd, 0x08, 0xeb, 0x08, 0xa5, 0x31, 0xf6, 0xe5, 0x62, 0xf6, 0xab, 0x60,
+	0xce, 0x8b, 0x10, 0x64, 0x24, 0x5f, 0x90, 0xd9, 0xc4, 0x2c, 0x81, 0x1a, 0x50, 0x29, 0xec, 0x32,
+	0x5d, 0xe5, 0x5e, 0x7e, 0xd6, 0x96, 0xc1, 0x6c, 0x88, 0x43, 0xd6, 0xf2, 0x88, 0x8f, 0x5a, 0x1d,
+	0x48, 0x3b, 0xbc, 0x8d, 0xa7, 0xdc, 0xab, 0xb1, 0xb5, 0x49, 0x7c, 0xf4, 0x08, 0xd2, 0x4e, 0xf2,
+	0xea, 0x65, 0x60, 0x9d, 0xfd, 0xb0, 0x91, 0x5a, 0xc4, 0x43, 0x2c, 0x2b, 0x2a, 0xab, 0x51, 0xdc,
+	0x38, 0xca, 0x59, 0x8d, 0x33, 0xf9, 0xf3, 0xad, 0x17, 0x42, 0xef, 0xdc, 0x85, 0x92, 0x8e, 0xe6,
+	0x81, 0x2a, 0xdc, 0x23, 0x7d, 0xcc, 0x74, 0x85, 0x77, 0xdf, 0x82, 0x2d, 0x36, 0x9b, 0x1d, 0x6f,
+	0x36, 0x3b, 0xd9, 0x6c, 0x76, 0x93, 0x84, 0x78, 0x7b, 0x33, 0x6e, 0xbd, 0x8f, 0xdf, 0x97, 0xea,
+	0x41, 0xc8, 0x3a, 0xfd, 0xb6, 0xed, 0x91, 0x3d, 0x27, 0x59, 0x83, 0xe2, 0xdf, 0x06, 0xf5, 0x9f,
+	0x3b, 0xec, 0xb0, 0x87, 0x28, 0x0f, 0xa0, 0x6e, 0x92, 0x7a, 0xeb, 0x55, 0x15, 0x54, 0x76, 0x69,
+	0xa0, 0xbd, 0x53, 0xc0, 0x5c, 0x7e, 0x7d, 0x2d, 0x8f, 0xb7, 0xfb, 0xf8, 0x2c, 0x1b, 0xeb, 0x93,
+	0xa0, 0x52, 0x8d, 0x37, 0x5e, 0x7e, 0xfe, 0xf9, 0xb6, 0xbc, 0x6a, 0xad, 0x38, 0x05, 0x3b, 0xdd,
+	0x89, 0x92, 0xa8, 0x56, 0x62, 0xd6, 0x5e, 0x2b, 0x60, 0x26, 0xbb, 0x1d, 0xac, 0xc2, 0xeb, 0x32,
+	0x18, 0x63, 0xed, 0x62, 0x4c, 0x4a, 0xe8, 0x0e, 0x27, 0xb4, 0x62, 0xdd, 0x2a, 0x24, 0xd4, 0xe7,
+	0x31, 0x19, 0x3a, 0xd9, 0x59, 0x2d, 0xa6, 0x93, 0xc1, 0x18, 0x6b, 0x17, 0x63, 0x26, 0xa4, 0xe3,
+	0xf1, 0x98, 0x94, 0xce, 0x27, 0x05, 0xdc, 0x3c, 0x6b, 0x5a, 0xcf, 0x2f, 0x4b, 0x0e, 0x6d, 0xdc,
+	0xfb, 0x13, 0x74, 0x4a, 0xf6, 0x3e, 0x27, 0xbb, 0x69, 0xd9, 0xe7, 0x17, 0xd3, 0x17, 0xe1, 0x2d,
+	0xd9, 0xff, 0xbc, 0xd9, 0xf2, 0x63, 0x56, 0xdc, 0x6c, 0x39, 0x94, 0xb1, 0x3e, 0x09, 0x6a, 0xc2,
+	0x66, 0x93, 0xb3, 0x2b, 0xe5, 0xdc, 0xde, 0x39, 0x3a, 0x31, 0x95, 0xe3, 0x13, 0x53, 0xf9, 0x71,
+	0x62, 0x2a, 0x6f, 0x86, 0x66, 0xe9, 0x78, 0x68, 0x96, 0xbe, 0x0e, 0xcd, 0xd2, 0xd3, 0xb5, 0x91,
+	0xb9, 0x12, 0xa9, 0xc4, 0xdf, 0x41, 0x63, 0xd3, 0x39, 0x48, 0xd3, 0xf2, 0xf9, 0x6a, 0x57, 0xf9,
+	0x4f, 0x82, 0xbb, 0xbf, 0x07, 0x00, 0x13, 0xfb, 0xed, 0x00, 0xb5, 0x08, 0x00, 0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -400,6 +677,12 @@ type MsgClient interface {
 	// CancelRevenue cancels a contract's fee registration and further receival
 	// of transaction fees
 	CancelRevenue(ctx context.Context, in *MsgCancelRevenue, opts ...grpc.CallOption) (*MsgCancelRevenueResponse, error)
+	// RegisterDerivedContract registers a contract created with CREATE2 by a
+	// registered factory to inherit its revenue
+	RegisterDerivedContract(ctx context.Context, in *MsgRegisterDerivedContract, opts ...grpc.CallOption) (*MsgRegisterDerivedContractResponse, error)
+	// WithdrawRevenue transfers the developer fees accrued for the sender to its
+	// account
+	WithdrawRevenue(ctx context.Context, in *MsgWithdrawRevenue, opts ...grpc.CallOption) (*MsgWithdrawRevenueResponse, error)
 }
 
 type msgClient struct {
@@ -437,6 +720,24 @@ func (c *msgClient) CancelRevenue(ctx context.Context, in *MsgCancelRevenue, opt
 	return out, nil
 }
 
+func (c *msgClient) RegisterDerivedContract(ctx context.Context, in *MsgRegisterDerivedContract, opts ...grpc.CallOption) (*MsgRegisterDerivedContractResponse, error) {
+	out := new(MsgRegisterDerivedContractResponse)
+	err := c.cc.Invoke(ctx, "/evmos.revenue.v1.Msg/RegisterDerivedContract", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) WithdrawRevenue(ctx context.Context, in *MsgWithdrawRevenue, opts ...grpc.CallOption) (*MsgWithdrawRevenueResponse, error) {
+	out := new(MsgWithdrawRevenueResponse)
+	err := c.cc.Invoke(ctx, "/evmos.revenue.v1.Msg/WithdrawRevenue", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // MsgServer is the server API for Msg service.
 type MsgServer interface {
 	// RegisterRevenue registers a new contract for receiving transaction fees
@@ -446,6 +747,12 @@ type MsgServer interface {
 	// CancelRevenue cancels a contract's fee registration and further receival
 	// of transaction fees
 	CancelRevenue(context.Context, *MsgCancelRevenue) (*MsgCancelRevenueResponse, error)
+	// RegisterDerivedContract registers a contract created with CREATE2 by a
+	// registered factory to inherit its revenue
+	RegisterDerivedContract(context.Context, *MsgRegisterDerivedContract) (*MsgRegisterDerivedContractResponse, error)
+	// WithdrawRevenue transfers the developer fees accrued for the sender to its
+	// account
+	WithdrawRevenue(context.Context, *MsgWithdrawRevenue) (*MsgWithdrawRevenueResponse, error)
 }
 
 // UnimplementedMsgServer can be embedded to have forward compatible implementations.
@@ -461,6 +768,12 @@ func (*UnimplementedMsgServer) UpdateRevenue(ctx context.Context, req *MsgUpdate
 func (*UnimplementedMsgServer) CancelRevenue(ctx context.Context, req *MsgCancelRevenue) (*MsgCancelRevenueResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method CancelRevenue not implemented")
 }
+func (*UnimplementedMsgServer) RegisterDerivedContract(ctx context.Context, req *MsgRegisterDerivedContract) (*MsgRegisterDerivedContractResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RegisterDerivedContract not implemented")
+}
+func (*UnimplementedMsgServer) WithdrawRevenue(ctx context.Context, req *MsgWithdrawRevenue) (*MsgWithdrawRevenueResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method WithdrawRevenue not implemented")
+}
 
 func RegisterMsgServer(s grpc1.Server, srv MsgServer) {
 	s.RegisterService(&_Msg_serviceDesc, srv)
@@ -520,6 +833,42 @@ func _Msg_CancelRevenue_Handler(srv interface{}, ctx context.Context, dec func(i
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Msg_RegisterDerivedContract_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgRegisterDerivedContract)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).RegisterDerivedContract(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/evmos.revenue.v1.Msg/RegisterDerivedContract",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).RegisterDerivedContract(ctx, req.(*MsgRegisterDerivedContract))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_WithdrawRevenue_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgWithdrawRevenue)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).WithdrawRevenue(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/evmos.revenue.v1.Msg/WithdrawRevenue",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).WithdrawRevenue(ctx, req.(*MsgWithdrawRevenue))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 var _Msg_serviceDesc = grpc.ServiceDesc{
 	ServiceName: "evmos.revenue.v1.Msg",
 	HandlerType: (*MsgServer)(nil),
@@ -536,6 +885,14 @@ var _Msg_serviceDesc = grpc.ServiceDesc{
 			MethodName: "CancelRevenue",
 			Handler:    _Msg_CancelRevenue_Handler,
 		},
+		{
+			MethodName: "RegisterDerivedContract",
+			Handler:    _Msg_RegisterDerivedContract_Handler,
+		},
+		{
+			MethodName: "WithdrawRevenue",
+			Handler:    _Msg_WithdrawRevenue_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "evmos/revenue/v1/tx.proto",
@@ -561,6 +918,30 @@ func (m *MsgRegisterRevenue) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.Withdrawers) > 0 {
+		for iNdEx := len(m.Withdrawers) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Withdrawers[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintTx(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x32
+		}
+	}
+	if m.Factory {
+		i--
+		if m.Factory {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x28
+	}
 	if len(m.Nonces) > 0 {
 		dAtA2 := make([]byte, len(m.Nonces)*10)
 		var j1 int
@@ -646,6 +1027,20 @@ func (m *MsgUpdateRevenue) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.Withdrawers) > 0 {
+		for iNdEx := len(m.Withdrawers) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Withdrawers[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintTx(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x22
+		}
+	}
 	if len(m.WithdrawerAddress) > 0 {
 		i -= len(m.WithdrawerAddress)
 		copy(dAtA[i:], m.WithdrawerAddress)
@@ -753,50 +1148,214 @@ func (m *MsgCancelRevenueResponse) MarshalToSizedBuffer(dAtA []byte) (int, error
 	return len(dAtA) - i, nil
 }
 
-func encodeVarintTx(dAtA []byte, offset int, v uint64) int {
-	offset -= sovTx(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
+func (m *MsgRegisterDerivedContract) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	dAtA[offset] = uint8(v)
-	return base
+	return dAtA[:n], nil
 }
-func (m *MsgRegisterRevenue) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+
+func (m *MsgRegisterDerivedContract) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgRegisterDerivedContract) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.ContractAddress)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	if len(m.InitCodeHash) > 0 {
+		i -= len(m.InitCodeHash)
+		copy(dAtA[i:], m.InitCodeHash)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.InitCodeHash)))
+		i--
+		dAtA[i] = 0x2a
 	}
-	l = len(m.DeployerAddress)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	if len(m.Salt) > 0 {
+		i -= len(m.Salt)
+		copy(dAtA[i:], m.Salt)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Salt)))
+		i--
+		dAtA[i] = 0x22
 	}
-	l = len(m.WithdrawerAddress)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	if len(m.CreatorAddress) > 0 {
+		i -= len(m.CreatorAddress)
+		copy(dAtA[i:], m.CreatorAddress)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.CreatorAddress)))
+		i--
+		dAtA[i] = 0x1a
 	}
-	if len(m.Nonces) > 0 {
-		l = 0
-		for _, e := range m.Nonces {
-			l += sovTx(uint64(e))
-		}
-		n += 1 + sovTx(uint64(l)) + l
+	if len(m.ContractAddress) > 0 {
+		i -= len(m.ContractAddress)
+		copy(dAtA[i:], m.ContractAddress)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.ContractAddress)))
+		i--
+		dAtA[i] = 0x12
 	}
-	return n
+	if len(m.SenderAddress) > 0 {
+		i -= len(m.SenderAddress)
+		copy(dAtA[i:], m.SenderAddress)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.SenderAddress)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
 }
 
-func (m *MsgRegisterRevenueResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
+func (m *MsgRegisterDerivedContractResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgRegisterDerivedContractResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgRegisterDerivedContractResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgWithdrawRevenue) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgWithdrawRevenue) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgWithdrawRevenue) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.ContractAddress) > 0 {
+		i -= len(m.ContractAddress)
+		copy(dAtA[i:], m.ContractAddress)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.ContractAddress)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.WithdrawerAddress) > 0 {
+		i -= len(m.WithdrawerAddress)
+		copy(dAtA[i:], m.WithdrawerAddress)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.WithdrawerAddress)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgWithdrawRevenueResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgWithdrawRevenueResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgWithdrawRevenueResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Amount) > 0 {
+		for iNdEx := len(m.Amount) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Amount[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintTx(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintTx(dAtA []byte, offset int, v uint64) int {
+	offset -= sovTx(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *MsgRegisterRevenue) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ContractAddress)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.DeployerAddress)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.WithdrawerAddress)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if len(m.Nonces) > 0 {
+		l = 0
+		for _, e := range m.Nonces {
+			l += sovTx(uint64(e))
+		}
+		n += 1 + sovTx(uint64(l)) + l
+	}
+	if m.Factory {
+		n += 2
+	}
+	if len(m.Withdrawers) > 0 {
+		for _, e := range m.Withdrawers {
+			l = e.Size()
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *MsgRegisterRevenueResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
 	_ = l
 	return n
 }
@@ -819,6 +1378,12 @@ func (m *MsgUpdateRevenue) Size() (n int) {
 	if l > 0 {
 		n += 1 + l + sovTx(uint64(l))
 	}
+	if len(m.Withdrawers) > 0 {
+		for _, e := range m.Withdrawers {
+			l = e.Size()
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
 	return n
 }
 
@@ -857,6 +1422,76 @@ func (m *MsgCancelRevenueResponse) Size() (n int) {
 	return n
 }
 
+func (m *MsgRegisterDerivedContract) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.SenderAddress)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.ContractAddress)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.CreatorAddress)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.Salt)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.InitCodeHash)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgRegisterDerivedContractResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgWithdrawRevenue) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.WithdrawerAddress)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.ContractAddress)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgWithdrawRevenueResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Amount) > 0 {
+		for _, e := range m.Amount {
+			l = e.Size()
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
+	return n
+}
+
 func sovTx(x uint64) (n int) {
 	return (math_bits.Len64(x|1) + 6) / 7
 }
@@ -1064,6 +1699,60 @@ func (m *MsgRegisterRevenue) Unmarshal(dAtA []byte) error {
 			} else {
 				return fmt.Errorf("proto: wrong wireType = %d for field Nonces", wireType)
 			}
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Factory", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Factory = bool(v != 0)
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Withdrawers", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Withdrawers = append(m.Withdrawers, Withdrawer{})
+			if err := m.Withdrawers[len(m.Withdrawers)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTx(dAtA[iNdEx:])
@@ -1260,6 +1949,40 @@ func (m *MsgUpdateRevenue) Unmarshal(dAtA []byte) error {
 			}
 			m.WithdrawerAddress = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Withdrawers", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Withdrawers = append(m.Withdrawers, Withdrawer{})
+			if err := m.Withdrawers[len(m.Withdrawers)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTx(dAtA[iNdEx:])
@@ -1495,6 +2218,464 @@ func (m *MsgCancelRevenueResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *MsgRegisterDerivedContract) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgRegisterDerivedContract: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgRegisterDerivedContract: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SenderAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.SenderAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ContractAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ContractAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CreatorAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.CreatorAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Salt", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Salt = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field InitCodeHash", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.InitCodeHash = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgRegisterDerivedContractResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgRegisterDerivedContractResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgRegisterDerivedContractResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgWithdrawRevenue) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgWithdrawRevenue: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgWithdrawRevenue: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field WithdrawerAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.WithdrawerAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ContractAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ContractAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgWithdrawRevenueResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgWithdrawRevenueResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgWithdrawRevenueResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Amount = append(m.Amount, types.Coin{})
+			if err := m.Amount[len(m.Amount)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func skipTx(dAtA []byte) (n int, err error) {
 	l := len(dAtA)
 	iNdEx := 0